@@ -2,34 +2,44 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
 	"time"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/getmentor/getmentor-api/config"
 	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/database/postgres"
 	"github.com/getmentor/getmentor-api/internal/handlers"
 	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/cdn"
 	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/jwt"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
 	"github.com/getmentor/getmentor-api/pkg/profiling"
+	"github.com/getmentor/getmentor-api/pkg/redis"
 	"github.com/getmentor/getmentor-api/pkg/tracing"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/getmentor/getmentor-api/pkg/warehouse"
+	"github.com/getmentor/getmentor-api/pkg/watchdog"
 	"github.com/getmentor/getmentor-api/pkg/yandex"
-	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.uber.org/zap"
 )
 
@@ -37,12 +47,26 @@ import (
 func registerAPIRoutes(
 	group *gin.RouterGroup,
 	cfg *config.Config,
-	generalRateLimiter, contactRateLimiter, registrationRateLimiter *middleware.RateLimiter,
+	generalRateLimiter, contactRateLimiter, registrationRateLimiter, questionRateLimiter *middleware.RateLimiter,
 	mentorHandler *handlers.MentorHandler,
 	contactHandler *handlers.ContactHandler,
 	logsHandler *handlers.LogsHandler,
 	registrationHandler *handlers.RegistrationHandler,
 	reviewHandler *handlers.ReviewHandler,
+	availabilityHandler *handlers.AvailabilityHandler,
+	programHandler *handlers.ProgramHandler,
+	leaderboardHandler *handlers.LeaderboardHandler,
+	abuseReportHandler *handlers.AbuseReportHandler,
+	sessionCalendarHandler *handlers.SessionCalendarHandler,
+	sessionRescheduleHandler *handlers.SessionRescheduleHandler,
+	publicStatsHandler *handlers.PublicStatsHandler,
+	tagSuggestionHandler *handlers.TagSuggestionHandler,
+	mentorProfileHandler *handlers.MentorProfileHandler,
+	ogImageHandler *handlers.OGImageHandler,
+	communityEventHandler *handlers.CommunityEventHandler,
+	mentorQuestionHandler *handlers.MentorQuestionHandler,
+	cohortHandler *handlers.CohortHandler,
+	cohortCertificateHandler *handlers.CohortCertificateHandler,
 ) {
 
 	publicTokens := []string{
@@ -51,10 +75,39 @@ func registerAPIRoutes(
 		cfg.Auth.MentorsAPITokenAIKB,
 	}
 	group.GET("/mentors", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(publicTokens...), mentorHandler.GetPublicMentors)
+	group.GET("/mentors/new", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(publicTokens...), mentorHandler.GetNewMentors)
+	group.GET("/mentors/search", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(publicTokens...), mentorHandler.SearchMentors)
 	group.GET("/mentor/:id", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), mentorHandler.GetPublicMentorByID)
-	group.POST("/internal/mentors", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), mentorHandler.GetInternalMentors)
+	// Share card for link previews; public because social crawlers can't send a token. :id is the slug.
+	group.GET("/mentor/:id/og-image", generalRateLimiter.Middleware(), ogImageHandler.GetOGImage)
+	group.GET("/mentor/:id/availability", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), availabilityHandler.GetAvailability)
+	// Anonymous question box: answered questions are read by the site, new ones come from visitors
+	group.GET("/mentor/:id/questions", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), mentorQuestionHandler.ListPublished)
+	group.POST("/mentor/:id/question", questionRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(10*1024), mentorQuestionHandler.AskQuestion)
+	// Approved reviews with the mentor's approved replies
+	group.GET("/mentor/:id/reviews", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), reviewHandler.ListPublished)
+	group.GET("/mentor/:id/programs", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), programHandler.ListMentorPrograms)
+	group.GET("/programs", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), programHandler.ListPrograms)
+	// Community events are public: the site, the bot and subscribed calendar apps read them without a token
+	group.GET("/events", generalRateLimiter.Middleware(), communityEventHandler.ListEvents)
+	group.GET("/events.ics", generalRateLimiter.Middleware(), communityEventHandler.GetCalendar)
+	group.POST("/programs/:id/register", contactRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), programHandler.RegisterAttendee)
+	group.GET("/cohorts", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), cohortHandler.ListCohorts)
+	group.POST("/cohorts/:id/enroll", contactRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), cohortHandler.Enroll)
+	// Certificate verification is public: the link is printed on the PDF
+	group.GET("/certificates/:id", generalRateLimiter.Middleware(), cohortCertificateHandler.VerifyCertificate)
+	group.GET("/program-registrations/:token/calendar.ics", generalRateLimiter.Middleware(), programHandler.GetRegistrationCalendar)
+	group.POST("/program-registrations/:token/cancel", contactRateLimiter.Middleware(), programHandler.CancelRegistration)
+	group.GET("/public-stats", generalRateLimiter.Middleware(), publicStatsHandler.GetStats)
+	group.GET("/session-feeds/:token/sessions.ics", generalRateLimiter.Middleware(), sessionCalendarHandler.GetFeedByToken)
+	group.GET("/session-reschedules/:token", generalRateLimiter.Middleware(), sessionRescheduleHandler.GetProposal)
+	group.POST("/session-reschedules/:token/confirm", contactRateLimiter.Middleware(), sessionRescheduleHandler.ConfirmReschedule)
+	group.POST("/mentor-email-changes/:token/confirm", contactRateLimiter.Middleware(), mentorProfileHandler.ConfirmEmailChange)
+	group.GET("/leaderboard", generalRateLimiter.Middleware(), middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno), leaderboardHandler.GetLeaderboard)
 	group.POST("/contact-mentor", contactRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), contactHandler.ContactMentor)
+	group.POST("/report", contactRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), abuseReportHandler.SubmitReport)
 	group.POST("/register-mentor", registrationRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(10*1024*1024), registrationHandler.RegisterMentor)
+	group.POST("/register-mentor/tag-suggestions", generalRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), tagSuggestionHandler.SuggestForText)
 	group.POST("/logs", generalRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(1*1024*1024), logsHandler.ReceiveFrontendLogs)
 
 	// Review routes (public - uses captcha for protection)
@@ -62,6 +115,59 @@ func registerAPIRoutes(
 	group.POST("/reviews/:requestId", contactRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), reviewHandler.SubmitReview)
 }
 
+// partnerTokenNames maps the audit IDs of the configured partner tokens to readable names
+func partnerTokenNames(cfg *config.Config) map[string]string {
+	names := make(map[string]string)
+	for name, token := range map[string]string{
+		"mentors_api": cfg.Auth.MentorsAPIToken,
+		"inno":        cfg.Auth.MentorsAPITokenInno,
+		"aikb":        cfg.Auth.MentorsAPITokenAIKB,
+	} {
+		if token != "" {
+			names[middleware.PartnerTokenID(token)] = name
+		}
+	}
+	return names
+}
+
+// registerInternalAPIRoutes registers service-to-service routes guarded by the internal API token.
+// They move to the internal port when it is enabled.
+func registerInternalAPIRoutes(
+	group *gin.RouterGroup,
+	cfg *config.Config,
+	generalRateLimiter *middleware.RateLimiter,
+	mentorHandler *handlers.MentorHandler,
+	eventSchemaHandler *handlers.EventSchemaHandler,
+	mentorStatsHandler *handlers.MentorStatsHandler,
+	cacheVersionHandler *handlers.CacheVersionHandler,
+	cacheAdminHandler *handlers.CacheAdminHandler,
+	tagAdminHandler *handlers.TagAdminHandler,
+) {
+	group.POST("/internal/mentors", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), mentorHandler.GetInternalMentors)
+	group.GET("/internal/event-schemas", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), eventSchemaHandler.GetSchemas)
+	group.GET("/internal/mentors/:id/stats", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), mentorStatsHandler.GetStats)
+	group.GET("/internal/cache/versions", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), cacheVersionHandler.GetVersions)
+	group.GET("/internal/cache/status", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), cacheAdminHandler.GetStatus)
+	group.POST("/internal/cache/refresh", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), cacheAdminHandler.Refresh)
+	group.POST("/internal/cache/invalidate/:slug", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), cacheAdminHandler.Invalidate)
+	group.GET("/internal/tags", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), tagAdminHandler.List)
+	group.POST("/internal/tags", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), tagAdminHandler.Create)
+	group.DELETE("/internal/tags", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), tagAdminHandler.Delete)
+}
+
+// registerBotRoutes registers the Telegram bot's routes. The bot runs outside the internal network,
+// so they stay on the public port, guarded by the internal API token.
+func registerBotRoutes(
+	group *gin.RouterGroup,
+	cfg *config.Config,
+	generalRateLimiter *middleware.RateLimiter,
+	mentorRequestsHandler *handlers.MentorRequestsHandler,
+	botHeartbeatHandler *handlers.BotHeartbeatHandler,
+) {
+	group.POST("/request/:id/schedule", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), mentorRequestsHandler.BotScheduleRequest)
+	group.POST("/heartbeat", generalRateLimiter.Middleware(), middleware.InternalAPIAuthMiddleware(cfg.Auth.InternalMentorsAPI), middleware.BodySizeLimitMiddleware(10*1024), botHeartbeatHandler.Heartbeat)
+}
+
 // registerMentorAdminRoutes registers mentor admin routes for authentication, request management, and profile
 func registerMentorAdminRoutes(
 	router *gin.Engine,
@@ -71,6 +177,21 @@ func registerMentorAdminRoutes(
 	mentorAuthHandler *handlers.MentorAuthHandler,
 	mentorRequestsHandler *handlers.MentorRequestsHandler,
 	mentorProfileHandler *handlers.MentorProfileHandler,
+	returningMentorHandler *handlers.ReturningMentorHandler,
+	mentorSurveyHandler *handlers.MentorSurveyHandler,
+	mentorInsightsHandler *handlers.MentorInsightsHandler,
+	programHandler *handlers.ProgramHandler,
+	leaderboardHandler *handlers.LeaderboardHandler,
+	sessionCalendarHandler *handlers.SessionCalendarHandler,
+	sessionRescheduleHandler *handlers.SessionRescheduleHandler,
+	replyTemplateHandler *handlers.ReplyTemplateHandler,
+	mentorDeviceSessionHandler *handlers.MentorDeviceSessionHandler,
+	shortLinkHandler *handlers.ShortLinkHandler,
+	mentorQuestionHandler *handlers.MentorQuestionHandler,
+	reviewHandler *handlers.ReviewHandler,
+	cohortHandler *handlers.CohortHandler,
+	notificationHandler *handlers.NotificationHandler,
+	mentorSessions middleware.MentorSessionChecker,
 	tokenManager *jwt.TokenManager,
 ) {
 	// Skip mentor admin routes if JWT is not configured
@@ -84,22 +205,74 @@ func registerMentorAdminRoutes(
 	auth.POST("/request-login", authRateLimiter.Middleware(), mentorAuthHandler.RequestLogin)
 	auth.POST("/verify", mentorAuthHandler.VerifyLogin)
 	auth.POST("/logout", mentorAuthHandler.Logout)
-	auth.GET("/session", middleware.MentorSessionMiddleware(tokenManager, cfg.MentorSession.CookieDomain, cfg.MentorSession.CookieSecure), mentorAuthHandler.GetSession)
+	sessionMiddleware := middleware.MentorSessionMiddleware(tokenManager, mentorSessions, cfg.MentorSession.CookieDomain, cfg.MentorSession.CookieSecure)
+	auth.GET("/session", sessionMiddleware, mentorAuthHandler.GetSession)
+	auth.GET("/sessions", sessionMiddleware, mentorDeviceSessionHandler.ListSessions)
+	auth.DELETE("/sessions/:id", sessionMiddleware, mentorDeviceSessionHandler.RevokeSession)
 
 	// Mentor admin routes (protected)
 	mentor := router.Group("/api/v1/mentor")
-	mentor.Use(middleware.MentorSessionMiddleware(tokenManager, cfg.MentorSession.CookieDomain, cfg.MentorSession.CookieSecure))
+	mentor.Use(sessionMiddleware)
 
 	// Request management routes
 	mentor.GET("/requests", mentorRequestsHandler.GetRequests)
 	mentor.GET("/requests/:id", mentorRequestsHandler.GetRequestByID)
 	mentor.POST("/requests/:id/status", mentorRequestsHandler.UpdateStatus)
 	mentor.POST("/requests/:id/decline", mentorRequestsHandler.DeclineRequest)
+	mentor.POST("/requests/:id/reschedule", sessionRescheduleHandler.ProposeReschedule)
+	mentor.POST("/requests/:id/schedule", mentorRequestsHandler.ScheduleRequest)
+
+	// Saved reply templates
+	mentor.GET("/templates", replyTemplateHandler.ListTemplates)
+	mentor.POST("/templates", profileRateLimiter.Middleware(), replyTemplateHandler.CreateTemplate)
+	mentor.POST("/templates/:id", profileRateLimiter.Middleware(), replyTemplateHandler.UpdateTemplate)
+	mentor.DELETE("/templates/:id", profileRateLimiter.Middleware(), replyTemplateHandler.DeleteTemplate)
+	mentor.GET("/templates/:id/render", replyTemplateHandler.RenderTemplate)
+
+	// Scheduled sessions calendar
+	mentor.GET("/sessions.ics", sessionCalendarHandler.GetMyFeed)
+	mentor.GET("/sessions/feed", sessionCalendarHandler.GetFeedURL)
+	mentor.POST("/sessions/feed/rotate", profileRateLimiter.Middleware(), sessionCalendarHandler.RotateFeedURL)
 
 	// Profile routes
 	mentor.GET("/profile", mentorProfileHandler.GetProfile)
 	mentor.POST("/profile", profileRateLimiter.Middleware(), mentorProfileHandler.UpdateProfile)
+	mentor.PATCH("/profile", profileRateLimiter.Middleware(), mentorProfileHandler.PatchProfile)
+	mentor.GET("/profile/email", mentorProfileHandler.GetEmailChange)
+	mentor.POST("/profile/email", profileRateLimiter.Middleware(), mentorProfileHandler.RequestEmailChange)
 	mentor.POST("/profile/picture", profileRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(10*1024*1024), mentorProfileHandler.UploadPicture)
+	mentor.POST("/leaderboard", profileRateLimiter.Middleware(), leaderboardHandler.SetOptIn)
+	mentor.GET("/notifications", notificationHandler.GetMySettings)
+	mentor.POST("/notifications/quiet-hours", profileRateLimiter.Middleware(), notificationHandler.SetMyQuietHours)
+	mentor.POST("/reactivate", profileRateLimiter.Middleware(), returningMentorHandler.Reactivate)
+	mentor.GET("/survey", mentorSurveyHandler.GetMySurvey)
+	mentor.POST("/survey", profileRateLimiter.Middleware(), mentorSurveyHandler.SubmitMySurvey)
+	mentor.GET("/insights", mentorInsightsHandler.GetMyInsights)
+
+	// Program routes
+	mentor.GET("/programs", programHandler.GetMyPrograms)
+	mentor.POST("/programs", profileRateLimiter.Middleware(), programHandler.CreateMyProgram)
+	mentor.POST("/programs/:id", profileRateLimiter.Middleware(), programHandler.UpdateMyProgram)
+	mentor.DELETE("/programs/:id", profileRateLimiter.Middleware(), programHandler.DeleteMyProgram)
+
+	// Short profile links
+	mentor.GET("/short-links", shortLinkHandler.GetMyLinks)
+	mentor.POST("/short-links", profileRateLimiter.Middleware(), shortLinkHandler.CreateMyLink)
+	mentor.DELETE("/short-links/:code", profileRateLimiter.Middleware(), shortLinkHandler.DeleteMyLink)
+
+	// Anonymous questions: answering publishes a question, rejecting hides it
+	mentor.GET("/questions", mentorQuestionHandler.GetMyQuestions)
+	mentor.POST("/questions/:id/answer", profileRateLimiter.Middleware(), mentorQuestionHandler.AnswerMyQuestion)
+	mentor.POST("/questions/:id/reject", profileRateLimiter.Middleware(), mentorQuestionHandler.RejectMyQuestion)
+
+	// Published reviews; the mentor can reply once to each
+	mentor.GET("/reviews", reviewHandler.GetMyReviews)
+	mentor.POST("/reviews/:id/reply", profileRateLimiter.Middleware(), reviewHandler.ReplyToMyReview)
+
+	// Cohorts the mentor takes part in and their mentees' progress
+	mentor.GET("/cohorts", cohortHandler.GetMyCohorts)
+	mentor.POST("/cohorts/:id/enroll", profileRateLimiter.Middleware(), cohortHandler.JoinCohort)
+	mentor.POST("/cohorts/:id/mentees/:participantId/progress", profileRateLimiter.Middleware(), cohortHandler.RecordMyMenteeProgress)
 }
 
 // registerAdminModerationRoutes registers moderator/admin web routes.
@@ -110,6 +283,29 @@ func registerAdminModerationRoutes(
 	profileRateLimiter *middleware.RateLimiter,
 	adminAuthHandler *handlers.AdminAuthHandler,
 	adminMentorsHandler *handlers.AdminMentorsHandler,
+	adminWebhooksHandler *handlers.AdminWebhooksHandler,
+	programHandler *handlers.ProgramHandler,
+	abuseReportHandler *handlers.AbuseReportHandler,
+	blocklistHandler *handlers.BlocklistHandler,
+	contactLimitHandler *handlers.ContactLimitHandler,
+	quarantineHandler *handlers.QuarantineHandler,
+	mentorDataIssueHandler *handlers.MentorDataIssueHandler,
+	reviewHandler *handlers.ReviewHandler,
+	tagSuggestionHandler *handlers.TagSuggestionHandler,
+	mentorMergeHandler *handlers.MentorMergeHandler,
+	triggerDeadLetterHandler *handlers.TriggerDeadLetterHandler,
+	partnerAuditHandler *handlers.PartnerAuditHandler,
+	auditLogHandler *handlers.AuditLogHandler,
+	partnerQuotaHandler *handlers.PartnerQuotaHandler,
+	shortLinkHandler *handlers.ShortLinkHandler,
+	communityEventHandler *handlers.CommunityEventHandler,
+	cohortHandler *handlers.CohortHandler,
+	cohortCertificateHandler *handlers.CohortCertificateHandler,
+	mentorImportHandler *handlers.MentorImportHandler,
+	moderationRulesHandler *handlers.ModerationRulesHandler,
+	mentorSurveyHandler *handlers.MentorSurveyHandler,
+	corsOriginHandler *handlers.CORSOriginHandler,
+	botHeartbeatHandler *handlers.BotHeartbeatHandler,
 	tokenManager *jwt.TokenManager,
 ) {
 
@@ -128,14 +324,77 @@ func registerAdminModerationRoutes(
 	admin.Use(middleware.AdminSessionMiddleware(tokenManager, cfg.MentorSession.CookieDomain, cfg.MentorSession.CookieSecure))
 	admin.GET("/mentors", adminMentorsHandler.ListMentors)
 	admin.GET("/mentors/:id", adminMentorsHandler.GetMentor)
+	admin.POST("/mentors/merge", profileRateLimiter.Middleware(), mentorMergeHandler.MergeMentors)
+	admin.POST("/mentors/import", profileRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(5*1024*1024), mentorImportHandler.ImportMentors)
 	admin.POST("/mentors/:id", profileRateLimiter.Middleware(), adminMentorsHandler.UpdateMentor)
+	admin.PATCH("/mentors/:id", profileRateLimiter.Middleware(), adminMentorsHandler.PatchMentor)
 	admin.POST("/mentors/:id/approve", adminMentorsHandler.ApproveMentor)
 	admin.POST("/mentors/:id/decline", adminMentorsHandler.DeclineMentor)
 	admin.POST("/mentors/:id/status", adminMentorsHandler.UpdateMentorStatus)
+	admin.DELETE("/mentors/:id", profileRateLimiter.Middleware(), adminMentorsHandler.DeleteMentor)
+	admin.GET("/mentors/:id/tag-suggestions", tagSuggestionHandler.SuggestForMentor)
+	admin.GET("/mentors/:id/short-links", shortLinkHandler.AdminListLinks)
+	admin.POST("/mentors/:id/short-links", profileRateLimiter.Middleware(), shortLinkHandler.AdminCreateLink)
+	admin.POST("/tags/suggest", middleware.BodySizeLimitMiddleware(100*1024), tagSuggestionHandler.SuggestForText)
 	admin.POST("/mentors/:id/picture", profileRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(10*1024*1024), adminMentorsHandler.UploadMentorPicture)
+	admin.POST("/webhooks/test", profileRateLimiter.Middleware(), middleware.BodySizeLimitMiddleware(100*1024), adminWebhooksHandler.TestWebhook)
+	admin.GET("/trigger-dead-letters", triggerDeadLetterHandler.ListDeadLetters)
+	admin.GET("/trigger-dead-letters/:id", triggerDeadLetterHandler.GetDeadLetter)
+	admin.POST("/trigger-dead-letters/redrive", profileRateLimiter.Middleware(), triggerDeadLetterHandler.RedriveMany)
+	admin.POST("/trigger-dead-letters/:id/redrive", profileRateLimiter.Middleware(), triggerDeadLetterHandler.Redrive)
+	admin.GET("/programs", programHandler.AdminListPrograms)
+	admin.POST("/programs", profileRateLimiter.Middleware(), programHandler.AdminCreateProgram)
+	admin.POST("/programs/:id", profileRateLimiter.Middleware(), programHandler.AdminUpdateProgram)
+	admin.DELETE("/programs/:id", profileRateLimiter.Middleware(), programHandler.AdminDeleteProgram)
+	admin.GET("/reports", abuseReportHandler.ListReports)
+	admin.POST("/reports/:id/status", profileRateLimiter.Middleware(), abuseReportHandler.ResolveReport)
+	admin.GET("/blocklist", blocklistHandler.ListEntries)
+	admin.POST("/blocklist", profileRateLimiter.Middleware(), blocklistHandler.AddEntry)
+	admin.DELETE("/blocklist/:id", profileRateLimiter.Middleware(), blocklistHandler.RemoveEntry)
+	admin.GET("/contact-limits", contactLimitHandler.ListCounters)
+	admin.DELETE("/contact-limits", profileRateLimiter.Middleware(), contactLimitHandler.ResetCounter)
+	admin.GET("/quarantine", quarantineHandler.ListQuarantined)
+	admin.POST("/quarantine/:id/verdict", profileRateLimiter.Middleware(), quarantineHandler.SetVerdict)
+	admin.GET("/data-issues", mentorDataIssueHandler.ListIssues)
+	admin.GET("/reviews", reviewHandler.AdminListReviews)
+	admin.POST("/reviews/:id/approve", profileRateLimiter.Middleware(), reviewHandler.AdminApproveReview)
+	admin.POST("/reviews/:id/reject", profileRateLimiter.Middleware(), reviewHandler.AdminRejectReview)
+	admin.POST("/reviews/:id/reply/approve", profileRateLimiter.Middleware(), reviewHandler.AdminApproveReply)
+	admin.POST("/reviews/:id/reply/reject", profileRateLimiter.Middleware(), reviewHandler.AdminRejectReply)
+	admin.GET("/moderation-rules", moderationRulesHandler.GetRules)
+	admin.POST("/moderation-rules", profileRateLimiter.Middleware(), moderationRulesHandler.UpdateRules)
+	admin.GET("/moderation-rules/versions", moderationRulesHandler.ListVersions)
+	admin.POST("/moderation-rules/versions/:version/restore", profileRateLimiter.Middleware(), moderationRulesHandler.RestoreVersion)
+	admin.GET("/analytics/mentor-surveys", mentorSurveyHandler.GetSummary)
+	admin.GET("/cors-origins", corsOriginHandler.ListOrigins)
+	admin.POST("/cors-origins", profileRateLimiter.Middleware(), corsOriginHandler.UpsertOrigin)
+	admin.DELETE("/cors-origins", profileRateLimiter.Middleware(), corsOriginHandler.DeleteOrigin)
+	admin.GET("/bot-status", botHeartbeatHandler.GetStatus)
+	admin.GET("/partner-audit", partnerAuditHandler.ListEntries)
+	admin.GET("/audit-log", auditLogHandler.ListEntries)
+	admin.GET("/partner-quotas", partnerQuotaHandler.ListUsage)
+	admin.POST("/partner-quotas/:token", profileRateLimiter.Middleware(), partnerQuotaHandler.SetOverride)
+	admin.DELETE("/partner-quotas/:token", profileRateLimiter.Middleware(), partnerQuotaHandler.DeleteOverride)
+	admin.GET("/events", communityEventHandler.AdminListEvents)
+	admin.POST("/events", profileRateLimiter.Middleware(), communityEventHandler.CreateEvent)
+	admin.POST("/events/:id", profileRateLimiter.Middleware(), communityEventHandler.UpdateEvent)
+	admin.DELETE("/events/:id", profileRateLimiter.Middleware(), communityEventHandler.DeleteEvent)
+	admin.GET("/cohorts", cohortHandler.AdminListCohorts)
+	admin.POST("/cohorts", profileRateLimiter.Middleware(), cohortHandler.AdminCreateCohort)
+	admin.GET("/cohorts/:id", cohortHandler.AdminGetCohort)
+	admin.POST("/cohorts/:id", profileRateLimiter.Middleware(), cohortHandler.AdminUpdateCohort)
+	admin.POST("/cohorts/:id/pairs", profileRateLimiter.Middleware(), cohortHandler.AdminPair)
+	admin.POST("/cohorts/:id/participants", profileRateLimiter.Middleware(), cohortHandler.AdminAddParticipant)
+	admin.POST("/cohorts/:id/participants/:participantId", profileRateLimiter.Middleware(), cohortHandler.AdminUpdateParticipant)
+	admin.POST("/cohorts/:id/participants/:participantId/progress", profileRateLimiter.Middleware(), cohortHandler.AdminRecordProgress)
+	admin.GET("/cohorts/:id/report", cohortCertificateHandler.AdminGetReport)
+	admin.POST("/cohorts/:id/certificates", profileRateLimiter.Middleware(), cohortCertificateHandler.AdminIssueCertificates)
 }
 
 func main() { //nolint:gocyclo
+	runMigrations := flag.Bool("migrate", false, "apply pending database migrations before starting")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -201,15 +460,44 @@ func main() { //nolint:gocyclo
 	// Start infrastructure metrics collection
 	metrics.RecordInfrastructureMetrics()
 
-	// Initialize PostgreSQL connection pool
+	// Migrations normally run separately via the migrate command (./migrate or docker-compose run migrate);
+	// --migrate applies the embedded migrations here instead, for deployments without a migrate step.
+	// They run before the pool connects, so the first queries already see the migrated schema
+	if *runMigrations {
+		logger.Info("Applying database migrations")
+		if err := db.RunMigrations(cfg.Database.URL); err != nil {
+			logger.Fatal("Failed to run database migrations", zap.Error(err))
+		}
+	}
+
+	// Initialize PostgreSQL connection pool. With a mentor cache snapshot the API may start
+	// while the database is down and serve the snapshot until it is back
+	startFromSnapshot := cfg.Cache.SnapshotPath != "" && !cfg.Cache.DisableMentorsCache
 	pool, err := db.NewPool(context.Background(), cfg.Database)
+	databaseUnreachable := false
+	if err != nil && startFromSnapshot {
+		logger.Error("Database unreachable at startup, continuing with the mentor cache snapshot", zap.Error(err))
+		pool, err = db.OpenPool(context.Background(), cfg.Database)
+		databaseUnreachable = true
+	}
 	if err != nil {
 		logger.Fatal("Failed to initialize database connection pool", zap.Error(err))
 	}
 	defer pool.Close()
-
-	// NOTE: Database migrations are now run separately via the migrate command
-	// Run migrations before starting the app: ./migrate or docker-compose run migrate
+	db.RecordPoolMetrics(pool)
+
+	// Ping the database in the background and reset the pool when it stays unreachable
+	var databaseReady func() bool
+	if cfg.Database.HealthCheckSeconds > 0 {
+		supervisor := postgres.NewSupervisor(pool, postgres.SupervisorConfig{
+			Interval:         time.Duration(cfg.Database.HealthCheckSeconds) * time.Second,
+			PingTimeout:      5 * time.Second,
+			FailureThreshold: cfg.Database.HealthFailureThreshold,
+			Unreachable:      databaseUnreachable,
+		})
+		supervisor.Start()
+		databaseReady = supervisor.Ready
+	}
 
 	// Initialize Yandex Object Storage client
 	var yandexClient *yandex.StorageClient
@@ -226,6 +514,56 @@ func main() { //nolint:gocyclo
 		}
 	}
 
+	// Initialize HTTP client for external API calls
+	httpClient := httpclient.NewStandardClient()
+
+	// Optional event bus for domain events, next to the HTTP triggers
+	eventPublisher, err := eventbus.New(eventbus.Config{
+		Provider:      cfg.EventBus.Provider,
+		NATSURL:       cfg.EventBus.NATSURL,
+		SubjectPrefix: cfg.EventBus.SubjectPrefix,
+	})
+	if err != nil {
+		logger.Fatal("Failed to initialize event bus", zap.Error(err))
+	}
+
+	// Analytics warehouse client; the export itself is started with the services
+	var clickHouse *warehouse.ClickHouse
+	if cfg.IsWarehouseExportEnabled() {
+		clickHouse, err = warehouse.NewClickHouse(cfg.Warehouse.ClickHouseURL, cfg.Warehouse.ClickHouseDatabase,
+			cfg.Warehouse.ClickHouseUser, cfg.Warehouse.ClickHousePassword, httpClient)
+		if err != nil {
+			logger.Fatal("Failed to initialize warehouse client", zap.Error(err))
+		}
+	}
+
+	// Check the configured dependencies before wiring services
+	runPreflight(cfg, pool, yandexClient, eventPublisher, clickHouse, startFromSnapshot)
+
+	// Runtime watchdog capturing profiles when memory or goroutines grow out of bounds
+	if cfg.Watchdog.Enabled {
+		dumpDir := cfg.Watchdog.DumpDir
+		if dumpDir == "" {
+			dumpDir = filepath.Join(cfg.Logging.Dir, "profiles")
+		}
+		var uploader watchdog.Uploader
+		if cfg.Watchdog.UploadDumps && yandexClient != nil {
+			uploader = yandexClient
+		}
+		var rssThreshold uint64
+		if cfg.Watchdog.RSSThresholdMB > 0 {
+			rssThreshold = uint64(cfg.Watchdog.RSSThresholdMB) << 20
+		}
+		watchdog.New(watchdog.Config{
+			Interval:           time.Duration(cfg.Watchdog.IntervalSeconds) * time.Second,
+			RSSThresholdBytes:  rssThreshold,
+			GoroutineThreshold: cfg.Watchdog.GoroutineThreshold,
+			Cooldown:           time.Duration(cfg.Watchdog.CooldownMinutes) * time.Minute,
+			DumpDir:            dumpDir,
+			KeepDumps:          cfg.Watchdog.KeepDumps,
+		}, uploader, cfg.Observability.ServiceInstanceID).Start()
+	}
+
 	// Initialize repositories (needed for cache fetchers)
 	// First create caches with dummy fetchers, then update with real fetchers
 	mentorCache := cache.NewMentorCache(
@@ -244,6 +582,7 @@ func main() { //nolint:gocyclo
 			// This fetcher will be replaced after repository is fully initialized
 			return make(map[string]string), nil
 		},
+		cfg.Cache.TagsTTLSeconds,
 	)
 
 	// Initialize repositories with pool and caches
@@ -251,17 +590,97 @@ func main() { //nolint:gocyclo
 	moderatorRepo := repository.NewModeratorRepository(pool)
 	clientRequestRepo := repository.NewClientRequestRepository(pool)
 
-	// Now update cache with actual fetcher functions from repository
-	mentorCache = cache.NewMentorCache(
-		mentorRepo.FetchAllMentorsFromDB,
-		mentorRepo.FetchSingleMentorFromDB,
+	// Now update cache with actual fetcher functions from repository. CACHE_BACKEND=redis keeps
+	// the mentors in Redis, shared by all replicas
+	mentorStore := cache.NewMemoryMentorStore()
+	if cfg.Cache.Backend == cache.BackendRedis {
+		redisClient, redisErr := redis.NewClient(cfg.Cache.RedisURL)
+		if redisErr != nil {
+			logger.Fatal("Failed to initialize Redis client", zap.Error(redisErr))
+		}
+		pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		redisErr = redisClient.Ping(pingCtx)
+		cancel()
+		if redisErr != nil {
+			logger.Fatal("Failed to connect to Redis", zap.Error(redisErr))
+		}
+		defer redisClient.Close()
+		mentorStore = cache.NewRedisMentorStore(redisClient, cfg.Cache.RedisKeyPrefix)
+		logger.Info("Mentor cache is kept in Redis")
+	}
+	// The legacy Airtable base. The reverse sync, DATA_SOURCE=airtable and dual writes share one
+	// client and so its rate limit. A finalized cutover retired the base, which is no longer kept
+	// up to date, so it is neither read nor written then.
+	var airtableClient *airtable.Client
+	airtableRetired := false
+	if cfg.IsAirtableSyncConfigured() {
+		airtableClient, err = airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpClient)
+		if err != nil {
+			logger.Fatal("Failed to initialize Airtable client", zap.Error(err))
+		}
+		cutoverCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		cutover, cutoverErr := repository.NewAirtableSyncRepository(pool).GetCutoverState(cutoverCtx)
+		cancel()
+		if cutoverErr != nil {
+			// Startup goes on without the database (see MENTOR_CACHE_SNAPSHOT_PATH); dual writes check again
+			logger.Warn("Failed to read the Airtable cutover state", zap.Error(cutoverErr))
+		} else {
+			airtableRetired = cutover.DataSource == models.DataSourcePostgres
+		}
+	}
+
+	fetchMentors, fetchMentor := cache.MentorFetcher(mentorRepo.FetchAllMentorsFromDB), cache.SingleMentorFetcher(mentorRepo.FetchSingleMentorFromDB)
+	if cfg.Cache.DataSource == models.DataSourceAirtable {
+		if airtableRetired {
+			logger.Warn("DATA_SOURCE=airtable is ignored: the Airtable cutover was finalized")
+		} else {
+			dataSource := repository.NewAirtableMentorDataSource(airtableClient, cfg.AirtableSync.MentorsTable, mentorRepo)
+			fetchMentors, fetchMentor = dataSource.FetchAllMentors, dataSource.FetchSingleMentor
+			logger.Info("Mentor cache reads mentor profiles from Airtable")
+		}
+	}
+	mentorCache = cache.NewMentorCacheWithStore(
+		mentorStore,
+		fetchMentors,
+		fetchMentor,
 		cfg.Cache.MentorTTLSeconds,
 	)
-	tagsCache = cache.NewTagsCache(mentorRepo.FetchAllTagsFromDB)
+	if cfg.Cache.SnapshotPath != "" {
+		mentorCache.EnableSnapshots(cfg.Cache.SnapshotPath, time.Duration(cfg.Cache.SnapshotIntervalSeconds)*time.Second)
+	}
+	tagsCache = cache.NewTagsCache(mentorRepo.FetchAllTagsFromDB, cfg.Cache.TagsTTLSeconds)
+	refreshPolicy := cache.RefreshPolicy{
+		Jitter:     cfg.Cache.RefreshJitter,
+		MaxBackoff: time.Duration(cfg.Cache.RefreshMaxBackoffSeconds) * time.Second,
+		Disabled:   cfg.Cache.RefreshDisabled,
+	}
+	refreshPolicy.Export()
+	mentorCache.SetRefreshPolicy(refreshPolicy)
+	tagsCache.SetRefreshPolicy(refreshPolicy)
 
 	// Re-initialize repository with updated caches
 	mentorRepo = repository.NewMentorRepository(pool, mentorCache, tagsCache, cfg.Cache.DisableMentorsCache)
 
+	unitOfWork := repository.NewUnitOfWork(pool)
+
+	// CDN purge on mentor changes: mentor.updated events published by any service purge the mentor
+	cdnPurger, err := cdn.New(cdn.Config{
+		Provider:           cfg.CDN.Provider,
+		APIURL:             cfg.CDN.APIURL,
+		CloudflareZoneID:   cfg.CDN.CloudflareZoneID,
+		CloudflareAPIToken: cfg.CDN.CloudflareAPIToken,
+		YandexResourceID:   cfg.CDN.YandexResourceID,
+		YandexAPIKey:       cfg.CDN.YandexAPIKey,
+	}, httpClient)
+	if err != nil {
+		logger.Fatal("Failed to initialize CDN purger", zap.Error(err))
+	}
+	if cdnPurger != nil {
+		cdnPurgeService := services.NewCDNPurgeService(cdnPurger, mentorRepo, cfg)
+		cdnPurgeService.Start()
+		eventPublisher = cdnPurgeService.Publisher(eventPublisher)
+	}
+
 	// Initialize mentor cache synchronously before accepting requests
 	// This ensures the cache is populated before the container is marked as healthy
 	if cfg.Cache.DisableMentorsCache {
@@ -272,13 +691,37 @@ func main() { //nolint:gocyclo
 		}
 	}
 
-	// Initialize tags cache synchronously
+	// Initialize tags cache synchronously. When the mentors come from the snapshot the database
+	// is down, so the tags are loaded once it is back
 	if err := tagsCache.Initialize(); err != nil {
-		logger.Fatal("Failed to initialize tags cache", zap.Error(err))
+		if !mentorCache.IsStale() {
+			logger.Fatal("Failed to initialize tags cache", zap.Error(err))
+		}
+		tagsCache.InitializeInBackground()
 	}
 
-	// Initialize HTTP client for external API calls
-	httpClient := httpclient.NewStandardClient()
+	// Mark responses while the mentor cache serves its last known data
+	var dataStale func() bool
+	if !cfg.Cache.DisableMentorsCache {
+		dataStale = mentorCache.IsStale
+	}
+
+	// Keep the mentor caches of all replicas on the same version: mentor.updated events bump the
+	// shared version and every replica reloads when it falls behind
+	cacheVersionService := services.NewCacheVersionService(repository.NewCacheVersionRepository(pool), mentorCache, cfg)
+	var cacheVersionHeader func() string
+	var sharedCacheVersions *services.CacheVersionService
+	if !cfg.Cache.DisableMentorsCache && cfg.Cache.VersionPollSeconds > 0 {
+		sharedCacheVersions = cacheVersionService
+		cacheVersionService.Start()
+		eventPublisher = cacheVersionService.Publisher(eventPublisher)
+		cacheVersionHeader = cacheVersionService.VersionHeader
+	}
+
+	// CORS origins: ALLOWED_CORS_ORIGINS plus the admin-managed ones, reloaded without restarts
+	corsOriginService := services.NewCORSOriginService(repository.NewCORSOriginRepository(pool), cfg)
+	corsOriginService.Start()
+
 	analyticsTracker := analytics.NewTracker(&analytics.Config{
 		Provider:               cfg.ResolvedAnalyticsProvider(),
 		SourceSystem:           "api",
@@ -296,63 +739,193 @@ func main() { //nolint:gocyclo
 
 	// Initialize repositories for reviews
 	reviewRepo := repository.NewReviewRepository(pool)
+	programRepo := repository.NewProgramRepository(pool)
+	leaderboardRepo := repository.NewLeaderboardRepository(pool)
+	abuseReportRepo := repository.NewAbuseReportRepository(pool)
+	blocklistRepo := repository.NewBlocklistRepository(pool)
+	sessionRescheduleRepo := repository.NewSessionRescheduleRepository(pool)
+	replyTemplateRepo := repository.NewReplyTemplateRepository(pool)
+	statsRepo := repository.NewStatsRepository(pool)
+	mentorMergeRepo := repository.NewMentorMergeRepository(pool)
+	emailChangeRepo := repository.NewEmailChangeRepository(pool)
+	deviceSessionRepo := repository.NewMentorDeviceSessionRepository(pool)
+	triggerDeadLetterRepo := repository.NewTriggerDeadLetterRepository(pool)
+
+	// Retry failed trigger calls and keep the ones that never got through
+	trigger.Configure(trigger.RetryPolicy{
+		MaxAttempts: cfg.EventTriggers.RetryMaxAttempts,
+		BaseDelay:   time.Duration(cfg.EventTriggers.RetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:    time.Duration(cfg.EventTriggers.RetryMaxDelayMs) * time.Millisecond,
+	}, triggerDeadLetterRepo)
 
 	// Initialize services
+	auditLogger := services.NewAuditLogger(repository.NewAuditLogRepository(pool), unitOfWork)
 	mentorService := services.NewMentorService(mentorRepo, cfg)
-	contactService := services.NewContactService(clientRequestRepo, mentorRepo, cfg, httpClient, analyticsTracker)
-	profileService := services.NewProfileService(mentorRepo, yandexClient, cfg, httpClient, analyticsTracker)
-	registrationService := services.NewRegistrationService(mentorRepo, yandexClient, cfg, httpClient, analyticsTracker)
-	mcpService := services.NewMCPService(mentorRepo, cfg.Server.BaseURL)
-	mentorAuthService := services.NewMentorAuthService(mentorRepo, cfg, httpClient, analyticsTracker)
+	blocklistService := services.NewBlocklistService(blocklistRepo, analyticsTracker)
+	// Mentor notifications held during quiet hours are delivered in the background
+	notificationDispatcher := services.NewNotificationDispatcher(repository.NewNotificationRepository(pool), cfg, httpClient)
+	notificationDispatcher.Start()
+	moderationRulesService := services.NewModerationRulesService(repository.NewModerationSettingsRepository(pool))
+	returningMentorService := services.NewReturningMentorService(mentorRepo, unitOfWork, moderationRulesService, cfg, httpClient, eventPublisher, auditLogger)
+	contactLimitService := services.NewContactLimitService(repository.NewContactLimitRepository(pool), cfg)
+	contactLimitService.Start()
+	contactService := services.NewContactService(clientRequestRepo, mentorRepo, blocklistService, contactLimitService, cfg, httpClient, notificationDispatcher, analyticsTracker, eventPublisher)
+	// AIRTABLE_DUAL_WRITE mirrors profile saves, status changes and picture uploads to Airtable
+	var profileMentors services.ProfileMentorStore = mentorRepo
+	var adminMentors services.AdminMentorStore = mentorRepo
+	if cfg.AirtableSync.DualWrite {
+		if airtableRetired {
+			logger.Warn("AIRTABLE_DUAL_WRITE is ignored: the Airtable cutover was finalized")
+		} else {
+			dualWrite := repository.NewDualWriteMentorRepository(mentorRepo, repository.NewAirtableSyncRepository(pool),
+				airtableClient, cfg.AirtableSync.MentorsTable)
+			profileMentors, adminMentors = dualWrite, dualWrite
+			logger.Info("Mentor writes are mirrored to Airtable")
+		}
+	}
+	profileService := services.NewProfileService(profileMentors, emailChangeRepo, unitOfWork, yandexClient, cfg, httpClient, analyticsTracker, eventPublisher, auditLogger)
+	registrationService := services.NewRegistrationService(mentorRepo, unitOfWork, blocklistService, moderationRulesService, returningMentorService, yandexClient, cfg, httpClient, analyticsTracker)
+	// MCP cursors carry the shared cache version, or this replica's cache population without one
+	mcpCacheVersion := cacheVersionHeader
+	if mcpCacheVersion == nil && !cfg.Cache.DisableMentorsCache {
+		mcpCacheVersion = func() string {
+			metadata, err := mentorCache.GetMetadata()
+			if err != nil {
+				return ""
+			}
+			return strconv.FormatInt(metadata.Version, 10)
+		}
+	}
+	mcpService := services.NewMCPService(mentorRepo, cfg.Server.BaseURL, mcpCacheVersion)
+	deviceSessionService := services.NewMentorDeviceSessionService(deviceSessionRepo, cfg, httpClient, analyticsTracker)
+	mentorAuthService := services.NewMentorAuthService(mentorRepo, deviceSessionService, cfg, httpClient, analyticsTracker)
 	adminAuthService := services.NewAdminAuthService(moderatorRepo, cfg, httpClient, analyticsTracker)
-	mentorRequestsService := services.NewMentorRequestsService(clientRequestRepo, cfg, httpClient, analyticsTracker)
-	reviewService := services.NewReviewService(reviewRepo, cfg, httpClient, analyticsTracker)
-	adminMentorsService := services.NewAdminMentorsService(mentorRepo, profileService, cfg, httpClient, analyticsTracker)
+	mentorRequestsService := services.NewMentorRequestsService(clientRequestRepo, cfg, httpClient, analyticsTracker, eventPublisher, auditLogger)
+	reviewService := services.NewReviewService(reviewRepo, mentorRepo, cfg, httpClient, analyticsTracker)
+	mentorAnnouncementService := services.NewMentorAnnouncementService(mentorRepo, yandexClient, cfg, httpClient)
+	adminMentorsService := services.NewAdminMentorsService(adminMentors, unitOfWork, profileService, mentorAnnouncementService, cfg, httpClient, analyticsTracker, eventPublisher, auditLogger)
+	availabilityService := services.NewAvailabilityService(mentorRepo, cfg)
+	adminWebhooksService := services.NewAdminWebhooksService(cfg, httpClient, analyticsTracker)
+	leaderboardService := services.NewLeaderboardService(leaderboardRepo, mentorRepo, cfg, analyticsTracker, auditLogger)
+	leaderboardService.Start()
+	programService := services.NewProgramService(programRepo, mentorRepo, unitOfWork, cfg, httpClient, analyticsTracker)
+	sessionCalendarService := services.NewSessionCalendarService(clientRequestRepo, mentorRepo, cfg)
+	publicStatsService := services.NewPublicStatsService(statsRepo)
+	tagSuggestionService := services.NewTagSuggestionService(mentorRepo)
+	mentorMergeService := services.NewMentorMergeService(mentorMergeRepo, mentorRepo, unitOfWork, analyticsTracker)
+	triggerDeadLetterService := services.NewTriggerDeadLetterService(triggerDeadLetterRepo, httpClient, analyticsTracker)
+	replyTemplateService := services.NewReplyTemplateService(replyTemplateRepo, clientRequestRepo, mentorRepo)
+	sessionRescheduleService := services.NewSessionRescheduleService(clientRequestRepo, sessionRescheduleRepo, unitOfWork, cfg, httpClient, analyticsTracker, eventPublisher)
+	quarantineService := services.NewQuarantineService(clientRequestRepo, cfg, httpClient, notificationDispatcher, analyticsTracker, eventPublisher)
+
+	// Scheduled export of anonymized activity to the analytics warehouse
+	if clickHouse != nil {
+		warehouseExportService := services.NewWarehouseExportService(repository.NewWarehouseExportRepository(pool), unitOfWork,
+			clickHouse, warehouse.NewHasher(cfg.Warehouse.HashSalt),
+			time.Duration(cfg.Warehouse.ExportIntervalMinutes)*time.Minute, cfg.Warehouse.ExportBatchSize)
+		warehouseExportService.Start()
+	}
+	// Reverse sync of mentor and request changes to the legacy Airtable base
+	if cfg.AirtableSync.IntervalMinutes > 0 {
+		services.NewAirtableSyncService(repository.NewAirtableSyncRepository(pool), repository.NewWarehouseExportRepository(pool),
+			unitOfWork, airtableClient, cfg.AirtableSync).Start()
+	}
+	abuseReportService := services.NewAbuseReportService(abuseReportRepo, mentorRepo, clientRequestRepo, cfg, httpClient, analyticsTracker)
+	partnerAuditService := services.NewPartnerAuditService(repository.NewPartnerAuditRepository(pool), cfg.PartnerAudit.RetentionDays)
+	if cfg.PartnerAudit.Enabled {
+		partnerAuditService.Start()
+	}
+	mentorSurveyService := services.NewMentorSurveyService(repository.NewMentorSurveyRepository(pool), unitOfWork, cfg, httpClient)
+	if cfg.MentorSurvey.DispatchIntervalHours > 0 {
+		mentorSurveyService.Start()
+	}
+	mentorInsightsService := services.NewMentorInsightsService(repository.NewMentorInsightsRepository(pool), unitOfWork, availabilityService, cfg)
+	if cfg.MentorInsights.RefreshHours > 0 {
+		mentorInsightsService.Start()
+	}
+	mentorStatsService := services.NewMentorStatsService(repository.NewMentorStatsRepository(pool), unitOfWork, cfg)
+	if cfg.MentorStats.RefreshMinutes > 0 {
+		mentorStatsService.Start()
+	}
+	// Periodic checks of mentors' calendar links
+	if cfg.CalendarCheck.IntervalMinutes > 0 {
+		services.NewCalendarLinkCheckService(repository.NewCalendarLinkRepository(pool), mentorRepo, cfg, httpClient).Start()
+	}
+	// Telegram bot heartbeats and the bot-silent alert
+	botHeartbeatService := services.NewBotHeartbeatService(repository.NewBotHeartbeatRepository(pool), cfg, httpClient)
+	botHeartbeatService.Start()
+	partnerQuotaService := services.NewPartnerQuotaService(repository.NewPartnerQuotaRepository(pool), cfg.PartnerQuota.DefaultMonthlyLimit, partnerTokenNames(cfg))
 
 	// Initialize handlers
 	mentorHandler := handlers.NewMentorHandler(mentorService, cfg.Server.BaseURL)
 	contactHandler := handlers.NewContactHandler(contactService)
 	registrationHandler := handlers.NewRegistrationHandler(registrationService)
 	reviewHandler := handlers.NewReviewHandler(reviewService)
-	mcpHandler := handlers.NewMCPHandler(mcpService)
+	// Limited inside the handler, which answers with a JSON-RPC error instead of a 429
+	mcpRateLimiter := middleware.NewRateLimiter(20, 40) // 20 req/sec, burst of 40 (for AI tool usage)
+	mcpHandler := handlers.NewMCPHandler(mcpService, mcpRateLimiter)
+	availabilityHandler := handlers.NewAvailabilityHandler(availabilityService)
+	programHandler := handlers.NewProgramHandler(programService)
+	leaderboardHandler := handlers.NewLeaderboardHandler(leaderboardService)
+	abuseReportHandler := handlers.NewAbuseReportHandler(abuseReportService)
+	blocklistHandler := handlers.NewBlocklistHandler(blocklistService)
+	contactLimitHandler := handlers.NewContactLimitHandler(contactLimitService)
+	quarantineHandler := handlers.NewQuarantineHandler(quarantineService)
+	mentorDataIssueHandler := handlers.NewMentorDataIssueHandler(services.NewMentorDataValidationService(repository.NewMentorDataIssueRepository(pool), nil))
+	sessionCalendarHandler := handlers.NewSessionCalendarHandler(sessionCalendarService)
+	sessionRescheduleHandler := handlers.NewSessionRescheduleHandler(sessionRescheduleService)
+	replyTemplateHandler := handlers.NewReplyTemplateHandler(replyTemplateService)
+	mentorDeviceSessionHandler := handlers.NewMentorDeviceSessionHandler(deviceSessionService, cfg.MentorSession.CookieDomain, cfg.MentorSession.CookieSecure)
+	publicStatsHandler := handlers.NewPublicStatsHandler(publicStatsService)
+	tagSuggestionHandler := handlers.NewTagSuggestionHandler(tagSuggestionService)
+	mentorMergeHandler := handlers.NewMentorMergeHandler(mentorMergeService)
+	triggerDeadLetterHandler := handlers.NewTriggerDeadLetterHandler(triggerDeadLetterService)
+	eventSchemaHandler := handlers.NewEventSchemaHandler()
+	partnerAuditHandler := handlers.NewPartnerAuditHandler(partnerAuditService)
+	auditLogHandler := handlers.NewAuditLogHandler(auditLogger)
+	partnerQuotaHandler := handlers.NewPartnerQuotaHandler(partnerQuotaService)
+	shortLinkHandler := handlers.NewShortLinkHandler(services.NewShortLinkService(repository.NewShortLinkRepository(pool), cfg.Server.BaseURL, cfg.Server.ShortLinkBaseURL))
+	ogImageHandler := handlers.NewOGImageHandler(services.NewOGImageService(mentorRepo, yandexClient, cfg.Server.BaseURL))
+	mentorQuestionHandler := handlers.NewMentorQuestionHandler(services.NewMentorQuestionService(repository.NewMentorQuestionRepository(pool), mentorRepo, cfg, httpClient, notificationDispatcher))
+	mentorImportHandler := handlers.NewMentorImportHandler(services.NewMentorImportService(mentorRepo, unitOfWork, blocklistService, moderationRulesService, cfg, httpClient))
+	moderationRulesHandler := handlers.NewModerationRulesHandler(moderationRulesService)
+	cohortRepo := repository.NewCohortRepository(pool)
+	cohortHandler := handlers.NewCohortHandler(services.NewCohortService(cohortRepo, mentorRepo, unitOfWork, cfg, httpClient))
+	cohortCertificateHandler := handlers.NewCohortCertificateHandler(services.NewCohortCertificateService(cohortRepo, repository.NewCohortCertificateRepository(pool), yandexClient, cfg, httpClient))
+	communityEventHandler := handlers.NewCommunityEventHandler(services.NewCommunityEventService(repository.NewCommunityEventRepository(pool), cfg, httpClient))
 	// Health check: If cache is disabled, always return true for cache readiness
 	cacheReadyFunc := mentorCache.IsReady
 	if cfg.Cache.DisableMentorsCache {
 		cacheReadyFunc = func() bool { return true }
 	}
-	healthHandler := handlers.NewHealthHandler(pool, cacheReadyFunc)
+	healthHandler := handlers.NewHealthHandler(pool, cacheReadyFunc, databaseReady, botHeartbeatService.Status)
+	botHeartbeatHandler := handlers.NewBotHeartbeatHandler(botHeartbeatService)
 	logsHandler := handlers.NewLogsHandler(cfg.Logging.Dir)
 	mentorAuthHandler := handlers.NewMentorAuthHandler(mentorAuthService)
 	adminAuthHandler := handlers.NewAdminAuthHandler(adminAuthService)
 	mentorRequestsHandler := handlers.NewMentorRequestsHandler(mentorRequestsService)
 	mentorProfileHandler := handlers.NewMentorProfileHandler(mentorService, profileService)
+	returningMentorHandler := handlers.NewReturningMentorHandler(returningMentorService)
+	mentorSurveyHandler := handlers.NewMentorSurveyHandler(mentorSurveyService)
+	mentorInsightsHandler := handlers.NewMentorInsightsHandler(mentorInsightsService)
+	mentorStatsHandler := handlers.NewMentorStatsHandler(mentorStatsService)
+	cacheVersionHandler := handlers.NewCacheVersionHandler(cacheVersionService)
+	cacheAdminHandler := handlers.NewCacheAdminHandler(services.NewCacheAdminService(mentorCache, mentorRepo, sharedCacheVersions, cfg.Cache.Backend))
+	notificationHandler := handlers.NewNotificationHandler(notificationDispatcher)
+	tagAdminHandler := handlers.NewTagAdminHandler(services.NewTagAdminService(repository.NewTagRepository(pool), tagsCache))
+	corsOriginHandler := handlers.NewCORSOriginHandler(corsOriginService)
 	adminMentorsHandler := handlers.NewAdminMentorsHandler(adminMentorsService)
+	adminWebhooksHandler := handlers.NewAdminWebhooksHandler(adminWebhooksService)
 
-	// Set up Gin router
+	// Set up Gin routers. With INTERNAL_PORT set, operational and admin endpoints are
+	// served by a second router on their own port; otherwise everything shares one.
 	gin.SetMode(cfg.Server.GinMode)
-	router := gin.New()
-
-	// Global middleware
-	router.Use(gin.Recovery())
-	router.Use(otelgin.Middleware(cfg.Observability.ServiceName)) // OpenTelemetry tracing
-	router.Use(middleware.ObservabilityMiddleware())
-	router.Use(middleware.SecurityHeadersMiddleware())
-
-	// CORS configuration - SECURITY: Only allow specific origins
-	allowedOrigins := cfg.Server.AllowedOrigins
-	// Allow localhost in development
-	if cfg.IsDevelopment() {
-		allowedOrigins = append(allowedOrigins, "http://localhost:3000", "http://127.0.0.1:3000")
-	}
-
-	router.Use(cors.New(cors.Config{
-		AllowOrigins:     allowedOrigins,
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "mentors_api_auth_token", "x-internal-mentors-api-auth-token", "X-Webhook-Secret", "X-Mentor-ID", "X-Auth-Token", "X-CSRF-Token", "traceparent", "tracestate"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true, // Required for mentor session cookies
-		MaxAge:           12 * time.Hour,
-	}))
+	router := newRouter(cfg, cacheVersionHeader, dataStale, corsOriginService.Policy)
+	internalRouter := router
+	if cfg.IsInternalServerEnabled() {
+		internalRouter = newRouter(cfg, cacheVersionHeader, dataStale, corsOriginService.Policy)
+		registerInternalServerRoutes(internalRouter, healthHandler)
+	}
 
 	// SECURITY: Rate limiters to prevent abuse and DoS attacks
 	// Different limits for different endpoint types
@@ -360,51 +933,67 @@ func main() { //nolint:gocyclo
 	contactRateLimiter := middleware.NewRateLimiter(5, 10)           // 5 req/sec, burst of 10 (prevent spam)
 	profileRateLimiter := middleware.NewRateLimiter(10, 20)          // 10 req/sec, burst of 20
 	registrationRateLimiter := middleware.NewRateLimiter(0.00667, 3) // 2 req/5min (0.00667 req/sec), burst of 3
-	mcpRateLimiter := middleware.NewRateLimiter(20, 40)              // 20 req/sec, burst of 40 (for AI tool usage)
 	mentorAuthRateLimiter := middleware.NewRateLimiter(0.00667, 2)   // 2 req/5min (0.00667 req/sec), burst of 2 (login abuse prevention)
 	adminAuthRateLimiter := middleware.NewRateLimiter(0.00667, 2)    // 2 req/5min (0.00667 req/sec), burst of 2 (login abuse prevention)
+	questionRateLimiter := middleware.NewRateLimiter(0.00111, 3)     // 1 req/15min (0.00111 req/sec), burst of 3 (anonymous questions)
 
 	// API routes
 	api := router.Group("/api")
+	internalAPI := internalRouter.Group("/api")
 	// Utility endpoints (not versioned - operational endpoints)
 	api.GET("/healthcheck", generalRateLimiter.Middleware(), healthHandler.Healthcheck)
-	api.GET("/metrics", generalRateLimiter.Middleware(), gin.WrapH(promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})))
+	internalAPI.GET("/metrics", generalRateLimiter.Middleware(), gin.WrapH(promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true, // required to expose trace exemplars
+	})))
+	// Short profile links
+	router.GET("/m/:code", generalRateLimiter.Middleware(), shortLinkHandler.Redirect)
 	// MCP endpoint (for AI tools to search mentors)
-	api.POST("/internal/mcp", mcpRateLimiter.Middleware(), middleware.MCPServerAuthMiddleware(cfg.Auth.MCPAuthToken, cfg.Auth.MCPAllowAll), mcpHandler.HandleMCPRequest)
+	api.POST("/internal/mcp", middleware.MCPServerAuthMiddleware(cfg.Auth.MCPAuthToken, cfg.Auth.MCPAllowAll), mcpHandler.HandleMCPRequest)
 
 	// API v1 routes
 	// SECURITY: Apply body size limits to prevent DoS attacks
 	v1 := router.Group("/api/v1")
-	registerAPIRoutes(v1, cfg, generalRateLimiter, contactRateLimiter, registrationRateLimiter,
-		mentorHandler, contactHandler, logsHandler, registrationHandler, reviewHandler)
+	if cfg.PartnerAudit.Enabled {
+		v1.Use(middleware.PartnerAuditMiddleware(partnerAuditService, cfg.PartnerAudit.SampleRate, partnerTokenNames(cfg)))
+	}
+	if cfg.PartnerQuota.Enabled {
+		v1.Use(middleware.PartnerQuotaMiddleware(partnerQuotaService))
+		// Checking usage doesn't count against the quota, so it lives outside the v1 group
+		router.GET("/api/v1/usage", generalRateLimiter.Middleware(),
+			middleware.TokenAuthMiddleware(cfg.Auth.MentorsAPIToken, cfg.Auth.MentorsAPITokenInno, cfg.Auth.MentorsAPITokenAIKB),
+			partnerQuotaHandler.GetUsage)
+	}
+	registerAPIRoutes(v1, cfg, generalRateLimiter, contactRateLimiter, registrationRateLimiter, questionRateLimiter,
+		mentorHandler, contactHandler, logsHandler, registrationHandler, reviewHandler, availabilityHandler, programHandler, leaderboardHandler, abuseReportHandler, sessionCalendarHandler, sessionRescheduleHandler, publicStatsHandler, tagSuggestionHandler, mentorProfileHandler, ogImageHandler, communityEventHandler, mentorQuestionHandler, cohortHandler, cohortCertificateHandler)
+	registerInternalAPIRoutes(internalRouter.Group("/api/v1"), cfg, generalRateLimiter, mentorHandler, eventSchemaHandler, mentorStatsHandler, cacheVersionHandler, cacheAdminHandler, tagAdminHandler)
+	// Outside the v1 group: the bot is not a partner, so partner audit and quotas don't apply
+	registerBotRoutes(router.Group("/api/v1/bot"), cfg, generalRateLimiter, mentorRequestsHandler, botHeartbeatHandler)
 
 	// Mentor admin routes (authentication, request management, and profile)
-	registerMentorAdminRoutes(router, cfg, mentorAuthRateLimiter, profileRateLimiter, mentorAuthHandler, mentorRequestsHandler, mentorProfileHandler, mentorAuthService.GetTokenManager())
+	registerMentorAdminRoutes(router, cfg, mentorAuthRateLimiter, profileRateLimiter, mentorAuthHandler, mentorRequestsHandler, mentorProfileHandler, returningMentorHandler, mentorSurveyHandler, mentorInsightsHandler, programHandler, leaderboardHandler, sessionCalendarHandler, sessionRescheduleHandler, replyTemplateHandler, mentorDeviceSessionHandler, shortLinkHandler, mentorQuestionHandler, reviewHandler, cohortHandler, notificationHandler, deviceSessionService, mentorAuthService.GetTokenManager())
 
 	// Moderator/Admin web moderation routes
-	registerAdminModerationRoutes(router, cfg, adminAuthRateLimiter, profileRateLimiter, adminAuthHandler, adminMentorsHandler, adminAuthService.GetTokenManager())
+	registerAdminModerationRoutes(internalRouter, cfg, adminAuthRateLimiter, profileRateLimiter, adminAuthHandler, adminMentorsHandler, adminWebhooksHandler, programHandler, abuseReportHandler, blocklistHandler, contactLimitHandler, quarantineHandler, mentorDataIssueHandler, reviewHandler, tagSuggestionHandler, mentorMergeHandler, triggerDeadLetterHandler, partnerAuditHandler, auditLogHandler, partnerQuotaHandler, shortLinkHandler, communityEventHandler, cohortHandler, cohortCertificateHandler, mentorImportHandler, moderationRulesHandler, mentorSurveyHandler, corsOriginHandler, botHeartbeatHandler, adminAuthService.GetTokenManager())
 
-	// Create HTTP server
+	// Create HTTP servers
 	// SECURITY: Bind to all interfaces for Docker Compose networking
 	// Network isolation is enforced by Docker Compose (backend has no public ports)
 	// In Docker Compose, frontend container needs to access backend via service name
-	srv := &http.Server{
-		Addr:              "0.0.0.0:" + cfg.Server.Port,
-		Handler:           router,
-		ReadHeaderTimeout: 15 * time.Second,
-		ReadTimeout:       30 * time.Second,
-		WriteTimeout:      30 * time.Second,
-		IdleTimeout:       60 * time.Second,
-		MaxHeaderBytes:    1 << 20, // SECURITY: 1 MB max header size
-	}
-
-	// Start server in a goroutine
-	go func() {
-		logger.Info("Server started", zap.String("port", cfg.Server.Port))
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.Fatal("Server failed to start", zap.Error(err))
-		}
-	}()
+	servers := []*http.Server{newHTTPServer("0.0.0.0:"+cfg.Server.Port, router, 30*time.Second)}
+	if cfg.IsInternalServerEnabled() {
+		// pprof CPU profiles and traces stream for up to a minute, so writes get more time
+		servers = append(servers, newHTTPServer(net.JoinHostPort(cfg.Server.InternalHost, cfg.Server.InternalPort), internalRouter, 90*time.Second))
+	}
+
+	// Start servers in goroutines
+	for _, srv := range servers {
+		go func(srv *http.Server) {
+			logger.Info("Server started", zap.String("addr", srv.Addr))
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("Server failed to start", zap.String("addr", srv.Addr), zap.Error(err))
+			}
+		}(srv)
+	}
 
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
@@ -416,8 +1005,10 @@ func main() { //nolint:gocyclo
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
-		logger.Fatal("Server forced to shutdown", zap.Error(err))
+	for _, srv := range servers {
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Fatal("Server forced to shutdown", zap.String("addr", srv.Addr), zap.Error(err))
+		}
 	}
 
 	logger.Info("Server exited")
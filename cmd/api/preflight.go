@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/preflight"
+	"github.com/getmentor/getmentor-api/pkg/warehouse"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const defaultPreflightTimeout = 5 * time.Second
+
+// runPreflight checks every dependency the API talks to, prints a status table and
+// exits if a dependency with fail strictness is unavailable
+func runPreflight(cfg *config.Config, pool *pgxpool.Pool, yandexClient *yandex.StorageClient,
+	publisher eventbus.Publisher, clickHouse *warehouse.ClickHouse, startFromSnapshot bool) {
+	// Caches are loaded from the database on startup, so it can't be optional unless the
+	// mentor cache can start from its snapshot
+	postgresStrictness := preflight.StrictnessFail
+	if startFromSnapshot {
+		postgresStrictness = preflight.StrictnessWarn
+	}
+	checks := []preflight.Check{
+		{
+			Name:       "postgres",
+			Strictness: postgresStrictness,
+			Run:        pool.Ping,
+		},
+		{
+			Name:       "object_storage",
+			Strictness: preflightStrictness(cfg.Preflight.ObjectStorage),
+			Run: func(ctx context.Context) error {
+				if yandexClient == nil {
+					return errors.New("YANDEX_STORAGE credentials are not set; profile picture uploads are disabled")
+				}
+				return yandexClient.Ping(ctx)
+			},
+		},
+		{
+			Name:       "event_bus",
+			Strictness: preflightStrictness(cfg.Preflight.EventBus),
+			Run: func(ctx context.Context) error {
+				bus, ok := publisher.(*eventbus.Bus)
+				if !ok {
+					return preflight.ErrDisabled
+				}
+				return bus.Ping(ctx)
+			},
+		},
+		{
+			Name:       "warehouse",
+			Strictness: preflightStrictness(cfg.Preflight.Warehouse),
+			Run: func(ctx context.Context) error {
+				if clickHouse == nil {
+					return preflight.ErrDisabled
+				}
+				return clickHouse.Ping(ctx)
+			},
+		},
+	}
+
+	timeout := time.Duration(cfg.Preflight.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultPreflightTimeout
+	}
+
+	results, err := preflight.Run(context.Background(), timeout, checks)
+	fmt.Fprintf(os.Stderr, "Startup preflight:\n%s", preflight.Table(results))
+
+	for _, result := range results {
+		fields := []zap.Field{
+			zap.String("dependency", result.Name),
+			zap.String("strictness", string(result.Strictness)),
+			zap.String("status", string(result.Status)),
+			zap.Duration("duration", result.Duration),
+		}
+		if result.Detail != "" {
+			fields = append(fields, zap.String("detail", result.Detail))
+		}
+		if result.Status == preflight.StatusFailed {
+			logger.Warn("Preflight check failed", fields...)
+		} else {
+			logger.Info("Preflight check", fields...)
+		}
+	}
+
+	if err != nil {
+		logger.Fatal("Required dependency is unavailable", zap.Error(err))
+	}
+}
+
+// preflightStrictness maps a validated config value to a strictness, defaulting to warn
+func preflightStrictness(value string) preflight.Strictness {
+	strictness, err := preflight.ParseStrictness(value, preflight.StrictnessWarn)
+	if err != nil {
+		return preflight.StrictnessWarn
+	}
+	return strictness
+}
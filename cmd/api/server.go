@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// newRouter creates a Gin engine with the global middleware shared by the public
+// and the internal server. cacheVersion, when set, reports the mentor cache version
+// for the X-Cache-Version header; dataStale, when set, reports whether the mentor cache serves
+// stale data for the X-Data-Stale header; corsPolicy returns the CORS policy in force.
+func newRouter(cfg *config.Config, cacheVersion func() string, dataStale func() bool, corsPolicy func() *models.CORSPolicy) *gin.Engine {
+	router := gin.New()
+
+	// Global middleware
+	router.Use(gin.CustomRecovery(middleware.RecoveryHandler))
+	router.Use(otelgin.Middleware(cfg.Observability.ServiceName)) // OpenTelemetry tracing
+	router.Use(middleware.ErrorReferenceMiddleware())             // Trace ID and incident code on 5xx responses
+	router.Use(middleware.ObservabilityMiddleware())
+	router.Use(middleware.SecurityHeadersMiddleware())
+	if cacheVersion != nil {
+		router.Use(middleware.CacheVersionMiddleware(cacheVersion))
+	}
+	if dataStale != nil {
+		router.Use(middleware.DataStaleMiddleware(dataStale))
+	}
+	if cfg.HTTPCache.Enabled {
+		router.Use(middleware.CachePolicyMiddleware(middleware.CachePolicy{
+			ListRoutes:               cfg.HTTPCache.ListRoutes,
+			ListSMaxAge:              cfg.HTTPCache.ListSMaxAgeSeconds,
+			ListStaleWhileRevalidate: cfg.HTTPCache.ListStaleWhileRevalidateSeconds,
+			DetailRoutes:             cfg.HTTPCache.DetailRoutes,
+			DetailMaxAge:             cfg.HTTPCache.DetailMaxAgeSeconds,
+		}))
+	}
+
+	// CORS - SECURITY: only origins in the policy, with the methods and headers allowed to each.
+	// Public counters are embedded on third-party pages too, so they are open to any origin
+	router.Use(middleware.PublicCORSMiddleware(middleware.CORSMiddleware(
+		corsPolicy,
+		[]string{"Content-Length", "ETag", middleware.CacheVersionHeader, middleware.DataStaleHeader, middleware.TraceIDHeader, middleware.IncidentCodeHeader},
+		12*time.Hour,
+	), "/api/v1/public-stats"))
+
+	return router
+}
+
+// registerInternalServerRoutes registers the endpoints that only exist on the internal
+// port: the readiness probe, detailed health and the Go profiler
+func registerInternalServerRoutes(router *gin.Engine, healthHandler *handlers.HealthHandler) {
+	router.GET("/api/healthcheck", healthHandler.Healthcheck)
+	router.GET("/api/healthcheck/detail", healthHandler.Detail)
+
+	debug := router.Group("/debug/pprof")
+	debug.GET("/", gin.WrapF(pprof.Index))
+	debug.GET("/cmdline", gin.WrapF(pprof.Cmdline))
+	debug.GET("/profile", gin.WrapF(pprof.Profile))
+	debug.GET("/symbol", gin.WrapF(pprof.Symbol))
+	debug.POST("/symbol", gin.WrapF(pprof.Symbol))
+	debug.GET("/trace", gin.WrapF(pprof.Trace))
+	debug.GET("/:profile", gin.WrapF(pprof.Index))
+}
+
+// newHTTPServer creates an HTTP server with the timeouts used by the API
+func newHTTPServer(addr string, handler http.Handler, writeTimeout time.Duration) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 15 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       60 * time.Second,
+		MaxHeaderBytes:    1 << 20, // SECURITY: 1 MB max header size
+	}
+}
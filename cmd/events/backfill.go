@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const sendTimeout = 10 * time.Second
+
+type backfillOptions struct {
+	eventType  string
+	sinceRaw   string
+	untilRaw   string
+	since      time.Time
+	until      time.Time
+	webhookURL string
+	skipBus    bool
+	dryRun     bool
+	batchSize  int
+}
+
+func parseBackfillOptions(args []string) (*backfillOptions, error) {
+	opts := &backfillOptions{}
+	fs := newBackfillFlags(opts)
+	fs.SetOutput(io.Discard) // errors are reported together with the usage text
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if !validTypes[opts.eventType] {
+		return nil, fmt.Errorf("--type must be %s or %s", events.TypeRequestCreated, events.TypeRequestStatusChanged)
+	}
+	if opts.sinceRaw == "" {
+		return nil, errors.New("--since is required")
+	}
+
+	var err error
+	if opts.since, err = parseTime(opts.sinceRaw); err != nil {
+		return nil, fmt.Errorf("invalid --since: %w", err)
+	}
+	opts.until = time.Now().UTC()
+	if opts.untilRaw != "" {
+		if opts.until, err = parseTime(opts.untilRaw); err != nil {
+			return nil, fmt.Errorf("invalid --until: %w", err)
+		}
+	}
+	if !opts.since.Before(opts.until) {
+		return nil, errors.New("--since must be before --until")
+	}
+	if opts.skipBus && opts.webhookURL == "" && !opts.dryRun {
+		return nil, errors.New("--no-bus requires --webhook-url")
+	}
+	if opts.batchSize < 1 || opts.batchSize > 5000 {
+		return nil, errors.New("--batch-size must be between 1 and 5000")
+	}
+	return opts, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// backfiller reads request history page by page and sends one event per request,
+// in the order the events occurred
+type backfiller struct {
+	requests   *repository.ClientRequestRepository
+	bus        *eventbus.Bus
+	webhookURL string
+	httpClient httpclient.Client
+	dryRun     bool
+}
+
+func (b *backfiller) run(ctx context.Context, opts *backfillOptions) (int, error) {
+	list := b.requests.ListCreatedBetween
+	if opts.eventType == events.TypeRequestStatusChanged {
+		list = b.requests.ListStatusChangedBetween
+	}
+
+	sent := 0
+	var cursor *models.HistoryCursor
+	for {
+		entries, err := list(ctx, opts.since, opts.until, cursor, opts.batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, entry := range entries {
+			payload, occurredAt := historyEvent(opts.eventType, entry)
+			if !b.dryRun {
+				if err := b.send(ctx, payload, occurredAt); err != nil {
+					// Events are sent oldest first, so the run can resume from the failed one
+					return sent, fmt.Errorf("failed to send %s for request %s (resume with --since %s): %w",
+						opts.eventType, entry.ID, occurredAt.Format(time.RFC3339Nano), err)
+				}
+			}
+			sent++
+			cursor = &models.HistoryCursor{At: occurredAt, ID: entry.ID}
+		}
+
+		if len(entries) < opts.batchSize {
+			return sent, nil
+		}
+		logger.Info("Backfill progress", zap.Int("events", sent), zap.Time("at", cursor.At))
+	}
+}
+
+// historyEvent rebuilds the event of a stored request and the time it occurred
+func historyEvent(eventType string, entry *models.RequestHistoryEntry) (events.Payload, time.Time) {
+	if eventType == events.TypeRequestStatusChanged {
+		payload := events.RequestStatusChanged{
+			RequestID: entry.ID,
+			MentorID:  entry.MentorID,
+			ToStatus:  string(entry.Status),
+		}
+		if entry.Status == models.StatusDeclined {
+			payload.DeclineReason = entry.DeclineReason
+		}
+		return payload, *entry.StatusChangedAt
+	}
+	return events.RequestCreated{
+		RequestID: entry.ID,
+		MentorID:  entry.MentorID,
+		Level:     entry.Level,
+	}, entry.CreatedAt
+}
+
+func (b *backfiller) send(ctx context.Context, payload events.Payload, occurredAt time.Time) error {
+	event, err := events.NewEvent(payload)
+	if err != nil {
+		return err
+	}
+	event.OccurredAt = occurredAt.UTC()
+	event.Replayed = true
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	if b.bus != nil {
+		if err := b.bus.Send(ctx, event); err != nil {
+			return err
+		}
+	}
+	if b.webhookURL != "" {
+		if _, err := trigger.CallWithPayload(ctx, b.webhookURL, event, b.httpClient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: events backfill --type <event type> --since <time> [flags]
+
+Replays past domain events from PostgreSQL to the event bus (EVENT_BUS_PROVIDER)
+and/or a webhook, so new consumers can bootstrap without database access.
+
+Supported types: request.created, request.status_changed
+Times are RFC 3339 (2024-05-01T00:00:00Z) or dates (2024-05-01, UTC).
+
+Flags:
+`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "backfill" {
+		fmt.Fprint(os.Stderr, usage)
+		newBackfillFlags(&backfillOptions{}).PrintDefaults()
+		os.Exit(2)
+	}
+
+	opts, err := parseBackfillOptions(os.Args[2:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n", err)
+		fmt.Fprint(os.Stderr, usage)
+		newBackfillFlags(&backfillOptions{}).PrintDefaults()
+		os.Exit(2)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	err = logger.Initialize(logger.Config{
+		Level:       cfg.Logging.Level,
+		LogDir:      cfg.Logging.Dir,
+		ServiceName: "getmentor-events",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	if err := run(cfg, opts); err != nil {
+		logger.Error("Backfill failed", zap.Error(err))
+		logger.Sync() //nolint:errcheck // Best effort sync before exit
+		os.Exit(1)    //nolint:gocritic // Manually synced logger above
+	}
+}
+
+func run(cfg *config.Config, opts *backfillOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var bus *eventbus.Bus
+	if !opts.skipBus {
+		var err error
+		bus, err = eventbus.NewConfiguredBus(eventbus.Config{
+			Provider:      cfg.EventBus.Provider,
+			NATSURL:       cfg.EventBus.NATSURL,
+			SubjectPrefix: cfg.EventBus.SubjectPrefix,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize event bus: %w", err)
+		}
+	}
+	if bus == nil && opts.webhookURL == "" && !opts.dryRun {
+		return errors.New("nothing to send events to: set EVENT_BUS_PROVIDER or pass --webhook-url")
+	}
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	defer pool.Close()
+
+	b := &backfiller{
+		requests:   repository.NewClientRequestRepository(pool),
+		bus:        bus,
+		webhookURL: opts.webhookURL,
+		httpClient: httpclient.NewStandardClient(),
+		dryRun:     opts.dryRun,
+	}
+
+	logger.Info("Starting event backfill",
+		zap.String("event_type", opts.eventType),
+		zap.Time("since", opts.since),
+		zap.Time("until", opts.until),
+		zap.Bool("bus", bus != nil),
+		zap.Bool("webhook", opts.webhookURL != ""),
+		zap.Bool("dry_run", opts.dryRun))
+
+	started := time.Now()
+	sent, err := b.run(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Event backfill completed",
+		zap.String("event_type", opts.eventType),
+		zap.Int("events", sent),
+		zap.Duration("duration", time.Since(started)))
+	return nil
+}
+
+func newBackfillFlags(opts *backfillOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("backfill", flag.ContinueOnError)
+	fs.StringVar(&opts.eventType, "type", "", "event type to replay (required)")
+	fs.StringVar(&opts.sinceRaw, "since", "", "replay events that occurred at or after this time (required)")
+	fs.StringVar(&opts.untilRaw, "until", "", "replay events that occurred before this time (default: now)")
+	fs.StringVar(&opts.webhookURL, "webhook-url", "", "also POST every event envelope to this URL")
+	fs.BoolVar(&opts.skipBus, "no-bus", false, "don't publish to the event bus, only to --webhook-url")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "count the events without sending them")
+	fs.IntVar(&opts.batchSize, "batch-size", 500, "rows read from the database per query")
+	return fs
+}
+
+// validTypes lists the event types that can be rebuilt from stored data. mentor.updated is
+// not among them: profile history is not stored, consumers bootstrap from the mentors API.
+var validTypes = map[string]bool{
+	events.TypeRequestCreated:       true,
+	events.TypeRequestStatusChanged: true,
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: import --file mentors.csv [flags]
+
+Creates mentors from a CSV file in pending status, checking every row against the
+registration and moderation rules and the blocklist. Rows are saved one by one: invalid rows are
+reported and skipped. Exits with 1 when a row is invalid or failed.
+
+Columns (header required, any order; tags and languages separated by ";"):
+  %s
+
+Flags:
+`
+
+type importOptions struct {
+	file   string
+	dryRun bool
+	json   bool
+}
+
+func main() {
+	opts := &importOptions{}
+	fs := newImportFlags(opts)
+	fs.SetOutput(io.Discard) // errors are reported together with the usage text
+	err := fs.Parse(os.Args[1:])
+	if err == nil && opts.file == "" {
+		err = errors.New("--file is required")
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n", err)
+		fmt.Fprintf(os.Stderr, usage, strings.Join(models.MentorImportColumns, ", "))
+		fs.SetOutput(os.Stderr)
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	err = logger.Initialize(logger.Config{
+		Level:       cfg.Logging.Level,
+		LogDir:      cfg.Logging.Dir,
+		ServiceName: "getmentor-import",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	report, err := run(cfg, opts)
+	if err != nil {
+		logger.Error("Import failed", zap.Error(err))
+		logger.Sync() //nolint:errcheck // Best effort sync before exit
+		os.Exit(1)    //nolint:gocritic // Manually synced logger above
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(report) //nolint:errcheck // stdout
+	} else {
+		printReport(report)
+	}
+	if report.Invalid > 0 || report.Failed > 0 {
+		logger.Sync() //nolint:errcheck // Best effort sync before exit
+		os.Exit(1)
+	}
+}
+
+func newImportFlags(opts *importOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.StringVar(&opts.file, "file", "", "CSV file with mentors (required)")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "validate the rows without creating mentors")
+	fs.BoolVar(&opts.json, "json", false, "print the report as JSON")
+	return fs
+}
+
+func run(cfg *config.Config, opts *importOptions) (*models.MentorImportReport, error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	file, err := os.Open(opts.file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", opts.file, err)
+	}
+	defer file.Close()
+
+	rows, err := services.ParseMentorImportCSV(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	defer pool.Close()
+
+	// Tag names are resolved through the tags cache, loaded once for the run; mentors are
+	// never read, so the mentor cache stays off
+	tagsCache := cache.NewTagsCache(repository.NewMentorRepository(pool, nil, nil, true).FetchAllTagsFromDB, 0)
+	if err := tagsCache.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to load tags: %w", err)
+	}
+	mentorRepo := repository.NewMentorRepository(pool, nil, tagsCache, true)
+
+	service := services.NewMentorImportService(
+		mentorRepo,
+		repository.NewUnitOfWork(pool),
+		services.NewBlocklistService(repository.NewBlocklistRepository(pool), nil),
+		services.NewModerationRulesService(repository.NewModerationSettingsRepository(pool)),
+		cfg,
+		httpclient.NewStandardClient(),
+	)
+
+	if opts.dryRun {
+		ctx = services.WithDryRun(ctx)
+	}
+	logger.Info("Starting mentor import",
+		zap.String("file", opts.file),
+		zap.Int("rows", len(rows)),
+		zap.Bool("dry_run", opts.dryRun))
+	return service.Import(ctx, rows), nil
+}
+
+func printReport(report *models.MentorImportReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tSTATUS\tEMAIL\tDETAIL")
+	for _, row := range report.Rows {
+		detail := strings.Join(row.Errors, "; ")
+		if row.Slug != "" {
+			detail = row.Slug
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", row.Line, row.Status, row.Email, detail)
+	}
+	_ = w.Flush() //nolint:errcheck // stdout
+
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run)"
+	}
+	fmt.Printf("\n%d rows%s: %d created, %d valid, %d invalid, %d failed\n",
+		report.Total, mode, report.Created, report.Valid, report.Invalid, report.Failed)
+}
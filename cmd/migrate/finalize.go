@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// thawTimeout bounds lifting the write freeze after a failed cutover, which may run after an interrupt
+const thawTimeout = 10 * time.Second
+
+// cutoverReport is printed by --finalize and kept with the cutover state when it succeeds
+type cutoverReport struct {
+	StartedAt    time.Time                    `json:"startedAt"`
+	FinishedAt   time.Time                    `json:"finishedAt"`
+	OK           bool                         `json:"ok"`
+	DataSource   string                       `json:"dataSource"`
+	WritesFrozen bool                         `json:"writesFrozen"`
+	SyncedUntil  *time.Time                   `json:"syncedUntil,omitempty"`
+	Sync         []models.AirtableSyncResult  `json:"sync"`
+	Streams      []models.AirtableStreamCheck `json:"streams"`
+	Integrity    *verifyReport                `json:"integrity,omitempty"`
+	Error        string                       `json:"error,omitempty"`
+}
+
+// runFinalize runs the Airtable cutover, prints its report and returns the exit code
+func runFinalize(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	if !cfg.IsAirtableSyncConfigured() {
+		logger.Error("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required for --finalize")
+		return 1
+	}
+	client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpclient.NewStandardClient())
+	if err != nil {
+		logger.Error("Failed to initialize Airtable client", zap.Error(err))
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return 1
+	}
+	defer pool.Close()
+
+	service := services.NewAirtableSyncService(repository.NewAirtableSyncRepository(pool), repository.NewWarehouseExportRepository(pool),
+		repository.NewUnitOfWork(pool), client, cfg.AirtableSync)
+
+	report := &cutoverReport{
+		StartedAt:  time.Now().UTC(),
+		DataSource: models.DataSourceAirtable,
+		Sync:       []models.AirtableSyncResult{},
+		Streams:    []models.AirtableStreamCheck{},
+	}
+	err = finalize(ctx, pool, service, report)
+	report.FinishedAt = time.Now().UTC()
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if encodeErr := encoder.Encode(report); encodeErr != nil {
+		logger.Error("Failed to write the report", zap.Error(encodeErr))
+		return 1
+	}
+	if err != nil {
+		logger.Error("Airtable cutover failed", zap.Error(err))
+		return 1
+	}
+	logger.Info("Airtable cutover finalized; PostgreSQL is the only data source")
+	return 0
+}
+
+// finalize runs the cutover runbook: freeze the Airtable sync of every replica, push the last
+// changes, compare counts and field hashes with Airtable, check the data's integrity and make
+// PostgreSQL the only data source. When a step fails the freeze is lifted so the periodic sync
+// resumes, and the cutover can be run again.
+func finalize(ctx context.Context, pool *pgxpool.Pool, service *services.AirtableSyncService, report *cutoverReport) (err error) {
+	state, err := service.CutoverState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.DataSource == models.DataSourcePostgres {
+		report.DataSource = models.DataSourcePostgres
+		return errors.New("the cutover was already finalized")
+	}
+
+	if err := service.FreezeWrites(ctx); err != nil {
+		return err
+	}
+	report.WritesFrozen = true
+	logger.Info("Airtable writes frozen")
+	defer func() {
+		if err == nil {
+			return
+		}
+		thawCtx, cancel := context.WithTimeout(context.Background(), thawTimeout)
+		defer cancel()
+		if thawErr := service.ThawWrites(thawCtx); thawErr != nil {
+			logger.Error("Failed to lift the Airtable write freeze", zap.Error(thawErr))
+			return
+		}
+		report.WritesFrozen = false
+	}()
+
+	syncedUntil, results, err := service.FinalSync(ctx)
+	report.Sync = results
+	if err != nil {
+		return fmt.Errorf("final sync failed: %w", err)
+	}
+	syncedUntil = syncedUntil.UTC()
+	report.SyncedUntil = &syncedUntil
+
+	checks, err := service.Verify(ctx, syncedUntil)
+	report.Streams = checks
+	if err != nil {
+		return err
+	}
+	integrity, err := verify(ctx, pool)
+	if err != nil {
+		return fmt.Errorf("integrity checks failed to run: %w", err)
+	}
+	report.Integrity = integrity
+
+	report.OK = integrity.OK
+	for _, check := range checks {
+		report.OK = report.OK && check.OK
+	}
+	if !report.OK {
+		return errors.New("verification found differences; fix them and run --finalize again")
+	}
+
+	report.DataSource = models.DataSourcePostgres
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode the report: %w", err)
+	}
+	if err := service.FinalizeCutover(ctx, data); err != nil {
+		report.DataSource = models.DataSourceAirtable
+		report.OK = false
+		return err
+	}
+	return nil
+}
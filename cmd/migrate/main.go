@@ -1,16 +1,40 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
 	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"go.uber.org/zap"
 )
 
 func main() {
+	verifyOnly := flag.Bool("verify", false,
+		"check the migrated data instead of migrating, and compare it with Airtable when configured: print a JSON report and exit with 1 when a check fails")
+	airtableSync := flag.Bool("airtable-sync", false,
+		"push mentor and request changes made since the last sync back to Airtable once, print a JSON summary and exit")
+	migrateMentors := flag.Bool("migrate-mentors", false,
+		"copy the Airtable mentors into mentors in one bulk upsert by slug and print a JSON report")
+	migrateRequests := flag.Bool("migrate-requests", false,
+		"copy the Airtable client requests into client_requests, linked to their migrated mentors, and print a JSON report")
+	finalizeCutover := flag.Bool("finalize", false,
+		"retire Airtable: freeze its sync, push the last changes, verify counts and field hashes, make PostgreSQL the only data source and print a JSON cutover report")
+	down := flag.Int("down", 0, "roll back the given number of applied migrations instead of migrating up")
+	toVersion := flag.Uint("to", 0, "migrate up or down to the given schema version instead of the latest one")
+	showVersion := flag.Bool("version", false, "print the applied schema version and exit")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -30,17 +54,165 @@ func main() {
 	}
 	defer logger.Sync()
 
+	if *verifyOnly {
+		os.Exit(runVerify(cfg)) //nolint:gocritic // runVerify syncs the logger
+	}
+	if *airtableSync {
+		os.Exit(runAirtableSync(cfg)) //nolint:gocritic // runAirtableSync syncs the logger
+	}
+
+	if *migrateMentors {
+		os.Exit(runMigrateMentors(cfg)) //nolint:gocritic // runMigrateMentors syncs the logger
+	}
+
+	if *migrateRequests {
+		os.Exit(runMigrateRequests(cfg)) //nolint:gocritic // runMigrateRequests syncs the logger
+	}
+
+	if *finalizeCutover {
+		os.Exit(runFinalize(cfg)) //nolint:gocritic // runFinalize syncs the logger
+	}
+
+	if *showVersion {
+		os.Exit(runShowVersion(cfg)) //nolint:gocritic // runShowVersion syncs the logger
+	}
+
 	logger.Info("Starting database migrations",
 		zap.String("database", maskDatabaseURL(cfg.Database.URL)))
 
 	// Run migrations
-	if err := db.RunMigrations(cfg.Database.URL, "file://migrations"); err != nil {
+	switch {
+	case *down > 0:
+		logger.Warn("Rolling back migrations", zap.Int("steps", *down))
+		err = db.RollbackMigrations(cfg.Database.URL, *down)
+	case *toVersion > 0:
+		logger.Info("Migrating to schema version", zap.Uint("version", *toVersion))
+		err = db.MigrateTo(cfg.Database.URL, *toVersion)
+	default:
+		err = db.RunMigrations(cfg.Database.URL)
+	}
+	if err != nil {
 		logger.Error("Failed to run migrations", zap.Error(err))
 		logger.Sync() //nolint:errcheck // Best effort sync before exit
 		os.Exit(1)    //nolint:gocritic // Manually synced logger above
 	}
 
-	logger.Info("Database migrations completed successfully")
+	version, _, err := db.MigrationVersion(cfg.Database.URL)
+	if err != nil {
+		logger.Warn("Failed to read schema version", zap.Error(err))
+	}
+	logger.Info("Database migrations completed successfully", zap.Uint("version", version))
+}
+
+// runShowVersion prints the applied schema version and returns the exit code;
+// a dirty schema (a migration failed halfway) exits with 1
+func runShowVersion(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	version, dirty, err := db.MigrationVersion(cfg.Database.URL)
+	if err != nil {
+		logger.Error("Failed to read schema version", zap.Error(err))
+		return 1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	if err := encoder.Encode(map[string]interface{}{"version": version, "dirty": dirty}); err != nil {
+		logger.Error("Failed to write the version", zap.Error(err))
+		return 1
+	}
+	if dirty {
+		return 1
+	}
+	return 0
+}
+
+// runVerify prints the verification report and returns the exit code
+func runVerify(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return 1
+	}
+	defer pool.Close()
+
+	report, err := verify(ctx, pool)
+	if err != nil {
+		logger.Error("Verification failed", zap.Error(err))
+		return 1
+	}
+
+	if cfg.IsAirtableSyncConfigured() {
+		client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpclient.NewStandardClient())
+		if err != nil {
+			logger.Error("Failed to initialize Airtable client", zap.Error(err))
+			return 1
+		}
+		service := services.NewAirtableSyncService(repository.NewAirtableSyncRepository(pool), repository.NewWarehouseExportRepository(pool),
+			repository.NewUnitOfWork(pool), client, cfg.AirtableSync)
+		if err := verifyAirtable(ctx, service, report); err != nil {
+			logger.Error("Airtable verification failed", zap.Error(err))
+			return 1
+		}
+	} else {
+		logger.Info("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN not set; skipping the Airtable comparison")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Error("Failed to write the report", zap.Error(err))
+		return 1
+	}
+	if !report.OK {
+		return 1
+	}
+	return 0
+}
+
+// runAirtableSync pushes pending changes to Airtable once and returns the exit code
+func runAirtableSync(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	if !cfg.IsAirtableSyncConfigured() {
+		logger.Error("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required for --airtable-sync")
+		return 1
+	}
+	client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpclient.NewStandardClient())
+	if err != nil {
+		logger.Error("Failed to initialize Airtable client", zap.Error(err))
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return 1
+	}
+	defer pool.Close()
+
+	service := services.NewAirtableSyncService(repository.NewAirtableSyncRepository(pool), repository.NewWarehouseExportRepository(pool),
+		repository.NewUnitOfWork(pool), client, cfg.AirtableSync)
+	results, err := service.SyncAll(ctx)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if encodeErr := encoder.Encode(results); encodeErr != nil {
+		logger.Error("Failed to write the summary", zap.Error(encodeErr))
+		return 1
+	}
+	if err != nil {
+		logger.Error("Airtable sync failed", zap.Error(err))
+		return 1
+	}
+	return 0
 }
 
 // maskDatabaseURL masks the password in database URL for logging
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// mentorStatuses are the statuses the mentors table allows
+var mentorStatuses = map[string]bool{"pending": true, "active": true, "inactive": true, "declined": true}
+
+// mentorMigrationReport is the machine-readable result of migrate --migrate-mentors
+type mentorMigrationReport struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	OK         bool      `json:"ok"`
+	Table      string    `json:"table"`
+	// Airtable is the number of records listed
+	Airtable int `json:"airtable"`
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	// Deleted mentors were soft-deleted in PostgreSQL and are left as they are
+	Deleted int `json:"deleted"`
+	// Invalid records have no alias, name or known status, or repeat an alias; they are not copied
+	Invalid int      `json:"invalid"`
+	Samples []string `json:"samples,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// runMigrateMentors copies the Airtable mentors, prints the report and returns the exit code
+func runMigrateMentors(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	if !cfg.IsAirtableSyncConfigured() {
+		logger.Error("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required for --migrate-mentors")
+		return 1
+	}
+	client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpclient.NewStandardClient())
+	if err != nil {
+		logger.Error("Failed to initialize Airtable client", zap.Error(err))
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return 1
+	}
+	defer pool.Close()
+
+	report := &mentorMigrationReport{StartedAt: time.Now().UTC(), Table: cfg.AirtableSync.MentorsTable}
+	err = migrateMentors(ctx, pool, client, report)
+	report.FinishedAt = time.Now().UTC()
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if encodeErr := encoder.Encode(report); encodeErr != nil {
+		logger.Error("Failed to write the report", zap.Error(encodeErr))
+		return 1
+	}
+	if err != nil {
+		logger.Error("Mentor migration failed", zap.Error(err))
+		return 1
+	}
+	if !report.OK {
+		return 1
+	}
+	return 0
+}
+
+// migrateMentors copies every record of the Mentors table into mentors with one bulk
+// MentorRepository.UpsertMentors, keyed by slug. A mentor migrated before keeps its slug; the
+// other fields are overwritten with the record's values. It can be run again until the cutover
+// starts.
+func migrateMentors(ctx context.Context, pool *pgxpool.Pool, client *airtable.Client, report *mentorMigrationReport) error {
+	state, err := repository.NewAirtableSyncRepository(pool).GetCutoverState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.WritesFrozen() {
+		return errors.New("the Airtable cutover has started; Airtable is no longer the source of mentors")
+	}
+
+	slugs, err := migratedSlugs(ctx, pool)
+	if err != nil {
+		return err
+	}
+	records, err := client.List(ctx, report.Table, models.AirtableMentorImportFields)
+	if err != nil {
+		return fmt.Errorf("failed to list Airtable mentors: %w", err)
+	}
+	report.Airtable = len(records)
+
+	samples := []string{}
+	seen := make(map[string]bool, len(records))
+	mentors := make([]*models.Mentor, 0, len(records))
+	for _, record := range records {
+		mentor := &models.Mentor{}
+		mentor.ApplyAirtableImportFields(record.Fields)
+		if slug, ok := slugs[record.ID]; ok {
+			mentor.Slug = slug
+		}
+		airtableID := record.ID
+		mentor.AirtableID = &airtableID
+
+		switch {
+		case mentor.Slug == "" || mentor.Name == "":
+			report.Invalid++
+			samples = append(samples, "missing alias or name "+record.ID)
+			continue
+		case !mentorStatuses[mentor.Status]:
+			report.Invalid++
+			samples = append(samples, fmt.Sprintf("invalid status %q %s", mentor.Status, record.ID))
+			continue
+		case seen[mentor.Slug]:
+			report.Invalid++
+			samples = append(samples, "duplicate alias "+mentor.Slug+" "+record.ID)
+			continue
+		}
+		seen[mentor.Slug] = true
+		mentors = append(mentors, mentor)
+	}
+
+	result, err := repository.NewMentorRepository(pool, nil, nil, true).UpsertMentors(ctx, mentors)
+	if err != nil {
+		return err
+	}
+	report.Inserted = result.Inserted
+	report.Updated = result.Updated
+	report.Deleted = result.Skipped
+
+	sort.Strings(samples)
+	if len(samples) > verifySampleSize {
+		samples = samples[:verifySampleSize]
+	}
+	if len(samples) > 0 {
+		report.Samples = samples
+	}
+	report.OK = report.Invalid == 0
+	logger.Info("Mentors migrated from Airtable",
+		zap.Int("airtable", report.Airtable),
+		zap.Int("inserted", report.Inserted),
+		zap.Int("updated", report.Updated),
+		zap.Int("deleted", report.Deleted),
+		zap.Int("invalid", report.Invalid))
+	return nil
+}
+
+// migratedSlugs reads the slugs of the mentors migrated before by their Airtable record ID
+func migratedSlugs(ctx context.Context, pool *pgxpool.Pool) (map[string]string, error) {
+	rows, err := pool.Query(ctx, `SELECT airtable_id, slug FROM mentors WHERE airtable_id IS NOT NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+	}
+	defer rows.Close()
+
+	slugs := map[string]string{}
+	for rows.Next() {
+		var airtableID, slug string
+		if err := rows.Scan(&airtableID, &slug); err != nil {
+			return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+		}
+		slugs[airtableID] = slug
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+	}
+	return slugs, nil
+}
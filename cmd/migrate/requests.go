@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// requestMigrationBatchSize is how many requests are inserted per round trip
+const requestMigrationBatchSize = 100
+
+// insertClientRequest copies one Airtable request. A request copied before is left as it is,
+// except that a mentor missing then is filled in once its mentor has been migrated. The row
+// comes back only when it was inserted or its mentor filled in.
+const insertClientRequest = `
+	INSERT INTO client_requests (
+		airtable_id, mentor_id, email, name, telegram, description, level, status,
+		created_at, updated_at, status_changed_at, scheduled_at, decline_reason, decline_comment
+	) VALUES (
+		$1, $2, $3, $4, $5, $6, $7, $8,
+		COALESCE($9, now()), COALESCE($10, $9, now()), $10, $11, $12, $13
+	)
+	ON CONFLICT (airtable_id) DO UPDATE SET mentor_id = EXCLUDED.mentor_id
+		WHERE client_requests.mentor_id IS NULL AND EXCLUDED.mentor_id IS NOT NULL
+	RETURNING (xmax = 0)`
+
+// requestMigrationReport is the machine-readable result of migrate --migrate-requests
+type requestMigrationReport struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	OK         bool      `json:"ok"`
+	Table      string    `json:"table"`
+	// Airtable is the number of records listed
+	Airtable int `json:"airtable"`
+	Inserted int `json:"inserted"`
+	// MentorsLinked are requests copied before whose mentor has now been filled in
+	MentorsLinked int `json:"mentorsLinked"`
+	// Existing requests were copied before and left as they are
+	Existing int `json:"existing"`
+	// UnknownMentor requests link to a mentor without a row; they are copied without a mentor
+	UnknownMentor int `json:"unknownMentor"`
+	// InvalidStatus requests have a status client_requests doesn't allow and are not copied
+	InvalidStatus int      `json:"invalidStatus"`
+	Samples       []string `json:"samples,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// runMigrateRequests copies the Airtable client requests, prints the report and returns the exit code
+func runMigrateRequests(cfg *config.Config) int {
+	defer logger.Sync() //nolint:errcheck // Best effort sync before exit
+
+	if !cfg.IsAirtableSyncConfigured() {
+		logger.Error("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required for --migrate-requests")
+		return 1
+	}
+	client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpclient.NewStandardClient())
+	if err != nil {
+		logger.Error("Failed to initialize Airtable client", zap.Error(err))
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return 1
+	}
+	defer pool.Close()
+
+	report := &requestMigrationReport{StartedAt: time.Now().UTC(), Table: cfg.AirtableSync.RequestsTable}
+	err = migrateClientRequests(ctx, pool, client, report)
+	report.FinishedAt = time.Now().UTC()
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if encodeErr := encoder.Encode(report); encodeErr != nil {
+		logger.Error("Failed to write the report", zap.Error(encodeErr))
+		return 1
+	}
+	if err != nil {
+		logger.Error("Client request migration failed", zap.Error(err))
+		return 1
+	}
+	if !report.OK {
+		return 1
+	}
+	return 0
+}
+
+// migrateClientRequests copies every record of the Client Requests table into client_requests,
+// linking each request to the mentor migrated from the Mentors record it links to. It can be
+// run again: copied requests are kept and only gain the mentors migrated since.
+func migrateClientRequests(ctx context.Context, pool *pgxpool.Pool, client *airtable.Client, report *requestMigrationReport) error {
+	state, err := repository.NewAirtableSyncRepository(pool).GetCutoverState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.WritesFrozen() {
+		return errors.New("the Airtable cutover has started; Airtable is no longer the source of client requests")
+	}
+
+	mentorIDs, err := migratedMentorIDs(ctx, pool)
+	if err != nil {
+		return err
+	}
+	requests, err := client.GetAllClientRequests(ctx, report.Table)
+	if err != nil {
+		return fmt.Errorf("failed to list Airtable client requests: %w", err)
+	}
+	report.Airtable = len(requests)
+
+	samples := []string{}
+	batch := &pgx.Batch{}
+	flush := func() error {
+		if batch.Len() == 0 {
+			return nil
+		}
+		err := sendRequestBatch(ctx, pool, batch, report)
+		batch = &pgx.Batch{}
+		return err
+	}
+	for i := range requests {
+		request := &requests[i]
+		if request.Status == "" {
+			report.InvalidStatus++
+			samples = append(samples, fmt.Sprintf("invalid status %q %s", request.RawStatus, request.AirtableID))
+			continue
+		}
+		var mentorID *string
+		if request.MentorAirtableID != "" {
+			if id, ok := mentorIDs[request.MentorAirtableID]; ok {
+				mentorID = &id
+			} else {
+				report.UnknownMentor++
+				samples = append(samples, "unknown mentor "+request.MentorAirtableID+" "+request.AirtableID)
+			}
+		}
+
+		var createdAt *time.Time
+		if !request.CreatedAt.IsZero() {
+			createdAt = &request.CreatedAt
+		}
+		batch.Queue(insertClientRequest,
+			request.AirtableID, mentorID, nullable(request.Email), nullable(request.Name), nullable(request.Telegram),
+			nullable(request.Description), nullable(request.Level), request.Status,
+			createdAt, request.StatusChangedAt, request.ScheduledAt,
+			nullable(request.DeclineReason), nullable(request.DeclineComment))
+		if batch.Len() == requestMigrationBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	sort.Strings(samples)
+	if len(samples) > verifySampleSize {
+		samples = samples[:verifySampleSize]
+	}
+	if len(samples) > 0 {
+		report.Samples = samples
+	}
+	report.OK = report.InvalidStatus == 0 && report.UnknownMentor == 0
+	logger.Info("Client requests migrated from Airtable",
+		zap.Int("airtable", report.Airtable),
+		zap.Int("inserted", report.Inserted),
+		zap.Int("mentors_linked", report.MentorsLinked),
+		zap.Int("existing", report.Existing),
+		zap.Int("unknown_mentor", report.UnknownMentor),
+		zap.Int("invalid_status", report.InvalidStatus))
+	return nil
+}
+
+// migratedMentorIDs maps the Airtable record ID of every migrated mentor to its mentor ID
+func migratedMentorIDs(ctx context.Context, pool *pgxpool.Pool) (map[string]string, error) {
+	rows, err := pool.Query(ctx, `SELECT airtable_id, id::text FROM mentors WHERE airtable_id IS NOT NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+	}
+	defer rows.Close()
+
+	ids := map[string]string{}
+	for rows.Next() {
+		var airtableID, id string
+		if err := rows.Scan(&airtableID, &id); err != nil {
+			return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+		}
+		ids[airtableID] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read migrated mentors: %w", err)
+	}
+	return ids, nil
+}
+
+func sendRequestBatch(ctx context.Context, pool *pgxpool.Pool, batch *pgx.Batch, report *requestMigrationReport) error {
+	results := pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for i := 0; i < batch.Len(); i++ {
+		var inserted bool
+		err := results.QueryRow().Scan(&inserted)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			report.Existing++
+		case err != nil:
+			return fmt.Errorf("failed to insert client request: %w", err)
+		case inserted:
+			report.Inserted++
+		default:
+			report.MentorsLinked++
+		}
+	}
+	return results.Close()
+}
+
+// nullable stores empty Airtable fields as NULL
+func nullable(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// verifySampleSize caps the rows listed per failed check; the count covers all of them
+const verifySampleSize = 20
+
+// integrityCheck is a query returning (total, id, detail) for every row breaking a rule.
+// total is COUNT(*) OVER () so the sample limit doesn't hide how many rows are affected.
+type integrityCheck struct {
+	name        string
+	description string
+	query       string
+}
+
+var integrityChecks = []integrityCheck{
+	{
+		name:        "orphaned_tags",
+		description: "Tags no mentor uses",
+		query: `
+			SELECT COUNT(*) OVER (), t.id::text, t.name
+			FROM tags t
+			WHERE NOT EXISTS (SELECT 1 FROM mentor_tags mt WHERE mt.tag_id = t.id)
+			ORDER BY t.name`,
+	},
+	{
+		name:        "requests_without_mentor",
+		description: "Client requests whose mentor is missing",
+		query: `
+			SELECT COUNT(*) OVER (), cr.id::text, COALESCE(cr.airtable_id, '')
+			FROM client_requests cr
+			WHERE cr.mentor_id IS NULL
+			ORDER BY cr.created_at DESC`,
+	},
+	{
+		name:        "requests_of_merged_mentors",
+		description: "Client requests left on a merged duplicate mentor",
+		query: `
+			SELECT COUNT(*) OVER (), cr.id::text, m.slug
+			FROM client_requests cr
+			JOIN mentors m ON m.id = cr.mentor_id
+			WHERE m.merged_into IS NOT NULL
+			ORDER BY cr.created_at DESC`,
+	},
+	{
+		name:        "mentors_missing_fields",
+		description: "Active and pending mentors without an email, Telegram, job title or description",
+		query: `
+			SELECT COUNT(*) OVER (), m.id::text, m.slug || ': ' || concat_ws(', ',
+				CASE WHEN COALESCE(m.email::text, '') = '' THEN 'email' END,
+				CASE WHEN COALESCE(m.telegram, '') = '' THEN 'telegram' END,
+				CASE WHEN COALESCE(m.job_title, '') = '' THEN 'job_title' END,
+				CASE WHEN COALESCE(m.details, '') = '' THEN 'details' END)
+			FROM mentors m
+			WHERE m.status IN ('active', 'pending') AND m.merged_into IS NULL
+				AND (COALESCE(m.email::text, '') = '' OR COALESCE(m.telegram, '') = ''
+					OR COALESCE(m.job_title, '') = '' OR COALESCE(m.details, '') = '')
+			ORDER BY m.slug`,
+	},
+	{
+		name:        "duplicate_emails",
+		description: "Emails shared by several mentors that are not declined or merged",
+		query: `
+			SELECT COUNT(*) OVER (), MIN(m.id::text), m.email::text || ': ' || string_agg(m.slug, ', ' ORDER BY m.slug)
+			FROM mentors m
+			WHERE m.email IS NOT NULL AND m.status <> 'declined' AND m.merged_into IS NULL
+			GROUP BY m.email
+			HAVING COUNT(*) > 1
+			ORDER BY m.email::text`,
+	},
+	{
+		name:        "mentors_without_tags",
+		description: "Active mentors without tags",
+		query: `
+			SELECT COUNT(*) OVER (), m.id::text, m.slug
+			FROM mentors m
+			WHERE m.status = 'active' AND m.merged_into IS NULL
+				AND NOT EXISTS (SELECT 1 FROM mentor_tags mt WHERE mt.mentor_id = m.id)
+			ORDER BY m.slug`,
+	},
+}
+
+// verifyReport is the machine-readable result of migrate --verify
+type verifyReport struct {
+	CheckedAt time.Time        `json:"checkedAt"`
+	OK        bool             `json:"ok"`
+	Counts    map[string]int64 `json:"counts"`
+	Checks    []verifyResult   `json:"checks"`
+	// SyncedUntil and Airtable compare the rows linked to Airtable with its base, as long as
+	// it is configured and the cutover hasn't retired it
+	SyncedUntil *time.Time                   `json:"syncedUntil,omitempty"`
+	Airtable    []models.AirtableStreamCheck `json:"airtable,omitempty"`
+}
+
+type verifyResult struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Count       int64          `json:"count"`
+	Samples     []verifySample `json:"samples"`
+}
+
+type verifySample struct {
+	ID     string `json:"id"`
+	Detail string `json:"detail"`
+}
+
+// verify counts the migrated records and runs the integrity checks
+func verify(ctx context.Context, pool *pgxpool.Pool) (*verifyReport, error) {
+	report := &verifyReport{
+		CheckedAt: time.Now().UTC(),
+		OK:        true,
+		Counts:    map[string]int64{},
+		Checks:    make([]verifyResult, 0, len(integrityChecks)),
+	}
+
+	rows, err := pool.Query(ctx, `
+		SELECT 'mentors_' || status, COUNT(*) FROM mentors WHERE merged_into IS NULL GROUP BY status
+		UNION ALL SELECT 'mentors_merged', COUNT(*) FROM mentors WHERE merged_into IS NOT NULL
+		UNION ALL SELECT 'tags', COUNT(*) FROM tags
+		UNION ALL SELECT 'client_requests', COUNT(*) FROM client_requests
+		UNION ALL SELECT 'legacy_mentor_ids', COUNT(*) FROM mentors WHERE airtable_id IS NOT NULL
+		UNION ALL SELECT 'legacy_request_ids', COUNT(*) FROM client_requests WHERE airtable_id IS NOT NULL
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count records: %w", err)
+	}
+	for rows.Next() {
+		var name string
+		var count int64
+		if err := rows.Scan(&name, &count); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan record count: %w", err)
+		}
+		report.Counts[name] = count
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count records: %w", err)
+	}
+
+	for _, check := range integrityChecks {
+		result, err := runCheck(ctx, pool, check)
+		if err != nil {
+			return nil, err
+		}
+		if result.Count > 0 {
+			report.OK = false
+		}
+		report.Checks = append(report.Checks, *result)
+	}
+	return report, nil
+}
+
+// verifyAirtable compares the counts and field hashes of the mentors and client requests
+// linked to Airtable with its records. Rows the reverse sync hasn't pushed yet are counted but
+// not compared. A retired base is no longer kept in sync and is skipped.
+func verifyAirtable(ctx context.Context, service *services.AirtableSyncService, report *verifyReport) error {
+	state, err := service.CutoverState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.DataSource == models.DataSourcePostgres {
+		logger.Info("Airtable cutover finalized; skipping the Airtable comparison")
+		return nil
+	}
+
+	syncedUntil, err := service.SyncedUntil(ctx)
+	if err != nil {
+		return err
+	}
+	syncedUntil = syncedUntil.UTC()
+	report.SyncedUntil = &syncedUntil
+
+	checks, err := service.Verify(ctx, syncedUntil)
+	report.Airtable = checks
+	if err != nil {
+		return err
+	}
+	for _, check := range checks {
+		report.OK = report.OK && check.OK
+	}
+	return nil
+}
+
+func runCheck(ctx context.Context, pool *pgxpool.Pool, check integrityCheck) (*verifyResult, error) {
+	rows, err := pool.Query(ctx, check.query+fmt.Sprintf(" LIMIT %d", verifySampleSize))
+	if err != nil {
+		return nil, fmt.Errorf("check %s failed: %w", check.name, err)
+	}
+	defer rows.Close()
+
+	result := &verifyResult{Name: check.name, Description: check.description, Samples: []verifySample{}}
+	for rows.Next() {
+		var sample verifySample
+		if err := rows.Scan(&result.Count, &sample.ID, &sample.Detail); err != nil {
+			return nil, fmt.Errorf("check %s failed: %w", check.name, err)
+		}
+		result.Samples = append(result.Samples, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("check %s failed: %w", check.name, err)
+	}
+	return result, nil
+}
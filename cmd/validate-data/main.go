@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: validate-data [flags]
+
+Scans every mentor that is not deleted or declined for data quality problems: broken calendar
+links (requested over HTTP), missing profile photos, empty competencies and invalid Telegram
+handles. Prints a report and exits with 1 when issues were found.
+
+With --flag the issues are recorded in PostgreSQL and show up in the admin moderation queue
+(GET /api/v1/admin/data-issues); issues a run no longer finds are resolved.
+
+Flags:
+`
+
+type validateOptions struct {
+	flag          bool
+	airtableField string
+	skipLinks     bool
+	json          bool
+}
+
+func main() {
+	opts := &validateOptions{}
+	fs := newValidateFlags(opts)
+	fs.SetOutput(io.Discard) // errors are reported together with the usage text
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n%s", err, usage)
+		fs.SetOutput(os.Stderr)
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	err = logger.Initialize(logger.Config{
+		Level:       cfg.Logging.Level,
+		LogDir:      cfg.Logging.Dir,
+		ServiceName: "getmentor-validate-data",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	report, err := run(cfg, opts)
+	if err != nil {
+		logger.Error("Mentor data validation failed", zap.Error(err))
+		logger.Sync() //nolint:errcheck // Best effort sync before exit
+		os.Exit(1)    //nolint:gocritic // Manually synced logger above
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(report) //nolint:errcheck // stdout
+	} else {
+		printReport(report)
+	}
+	if len(report.Issues) > 0 {
+		logger.Sync() //nolint:errcheck // Best effort sync before exit
+		os.Exit(1)
+	}
+}
+
+func newValidateFlags(opts *validateOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("validate-data", flag.ContinueOnError)
+	fs.BoolVar(&opts.flag, "flag", false, "record the issues in PostgreSQL for the admin moderation queue")
+	fs.StringVar(&opts.airtableField, "airtable-field", "", "also write each mentor's failed checks into this field of the Airtable mentors table")
+	fs.BoolVar(&opts.skipLinks, "skip-links", false, "only check that calendar links are well-formed, without requesting them")
+	fs.BoolVar(&opts.json, "json", false, "print the report as JSON")
+	return fs
+}
+
+func run(cfg *config.Config, opts *validateOptions) (*models.MentorDataReport, error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	httpClient := httpclient.NewStandardClient()
+	var airtableClient *airtable.Client
+	if opts.airtableField != "" {
+		if !cfg.IsAirtableSyncConfigured() {
+			return nil, fmt.Errorf("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required for --airtable-field")
+		}
+		client, err := airtable.NewClient(cfg.AirtableSync.APIURL, cfg.AirtableSync.BaseID, cfg.AirtableSync.Token, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Airtable client: %w", err)
+		}
+		airtableClient = client
+	}
+
+	// Without object storage the photo check is skipped
+	var storage services.PhotoStorage
+	if cfg.YandexStorage.AccessKeyID != "" && cfg.YandexStorage.SecretAccessKey != "" {
+		client, err := yandex.NewStorageClient(
+			cfg.YandexStorage.AccessKeyID,
+			cfg.YandexStorage.SecretAccessKey,
+			cfg.YandexStorage.BucketName,
+			cfg.YandexStorage.Endpoint,
+			cfg.YandexStorage.Region,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Yandex Storage client: %w", err)
+		}
+		storage = client
+	}
+
+	pool, err := db.NewPool(ctx, cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	defer pool.Close()
+
+	service := services.NewMentorDataValidationService(repository.NewMentorDataIssueRepository(pool), storage)
+
+	logger.Info("Starting mentor data validation",
+		zap.Bool("flag", opts.flag),
+		zap.String("airtable_field", opts.airtableField),
+		zap.Bool("skip_links", opts.skipLinks))
+	report, subjects, err := service.Validate(ctx, !opts.skipLinks)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.flag {
+		if err := service.Flag(ctx, report); err != nil {
+			return nil, err
+		}
+	}
+	if airtableClient != nil {
+		updated, err := service.FlagAirtable(ctx, airtableClient, cfg.AirtableSync.MentorsTable, opts.airtableField, subjects, report)
+		if err != nil {
+			return nil, fmt.Errorf("failed to flag issues in Airtable after %d records: %w", updated, err)
+		}
+		logger.Info("Flagged mentor data issues in Airtable", zap.Int("records", updated))
+	}
+	return report, nil
+}
+
+func printReport(report *models.MentorDataReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SLUG\tCHECK\tDETAIL")
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", issue.Slug, issue.Check, issue.Detail)
+	}
+	_ = w.Flush() //nolint:errcheck // stdout
+
+	fmt.Printf("\n%d mentors scanned, %d issues", report.Scanned, len(report.Issues))
+	for _, check := range report.Checks {
+		fmt.Printf(", %s: %d", check, report.Counts[check])
+	}
+	if report.Resolved > 0 {
+		fmt.Printf(", %d resolved", report.Resolved)
+	}
+	fmt.Println()
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -19,22 +20,41 @@ const (
 //
 //nolint:govet // Field alignment optimization would reduce readability
 type Config struct {
-	Server        ServerConfig
-	Database      DatabaseConfig
-	YandexStorage YandexStorageConfig
-	Auth          AuthConfig
-	Analytics     AnalyticsConfig
-	Mixpanel      MixpanelConfig
-	PostHog       PostHogConfig
-	ReCAPTCHA     ReCAPTCHAConfig
-	EventTriggers EventTriggerFunctionsConfig
-	NextJS        NextJSConfig
-	Grafana       GrafanaConfig
-	Logging       LoggingConfig
-	Observability ObservabilityConfig
-	Profiling     ProfilingConfig
-	Cache         CacheConfig
-	MentorSession MentorSessionConfig
+	Server         ServerConfig
+	Database       DatabaseConfig
+	YandexStorage  YandexStorageConfig
+	Auth           AuthConfig
+	Analytics      AnalyticsConfig
+	Mixpanel       MixpanelConfig
+	PostHog        PostHogConfig
+	ReCAPTCHA      ReCAPTCHAConfig
+	BotCheck       BotCheckConfig
+	EventTriggers  EventTriggerFunctionsConfig
+	EventBus       EventBusConfig
+	Warehouse      WarehouseConfig
+	NextJS         NextJSConfig
+	Grafana        GrafanaConfig
+	Logging        LoggingConfig
+	Observability  ObservabilityConfig
+	Profiling      ProfilingConfig
+	Watchdog       WatchdogConfig
+	Preflight      PreflightConfig
+	PartnerAudit   PartnerAuditConfig
+	PartnerQuota   PartnerQuotaConfig
+	Cache          CacheConfig
+	HTTPCache      HTTPCacheConfig
+	CDN            CDNConfig
+	MentorSession  MentorSessionConfig
+	Leaderboard    LeaderboardConfig
+	Certificates   CertificatesConfig
+	MentorSurvey   MentorSurveyConfig
+	MentorInsights MentorInsightsConfig
+	MentorStats    MentorStatsConfig
+	CalendarCheck  CalendarCheckConfig
+	AirtableSync   AirtableSyncConfig
+	BotHeartbeat   BotHeartbeatConfig
+	Notifications  NotificationConfig
+	ContactLimits  ContactLimitConfig
 }
 
 type ServerConfig struct {
@@ -43,6 +63,17 @@ type ServerConfig struct {
 	AppEnv         string
 	BaseURL        string
 	AllowedOrigins []string
+
+	// InternalPort serves metrics, pprof, health detail, admin and internal API routes
+	// separately from the public API. Empty keeps everything on Port.
+	InternalPort string
+	InternalHost string // bind address of the internal port, e.g. the internal network interface
+	// ShortLinkBaseURL is where /m/:code short links are served; defaults to BaseURL
+	ShortLinkBaseURL string
+	// Region names the deployment region of this replica, for comparing replicas across regions
+	Region string
+	// CORSRefreshSeconds is how often the admin-managed CORS origins are reloaded; 0 loads them once
+	CORSRefreshSeconds int
 }
 
 type DatabaseConfig struct {
@@ -50,6 +81,10 @@ type DatabaseConfig struct {
 	MaxConns    int32
 	MinConns    int32
 	WorkOffline bool
+	// HealthCheckSeconds is how often the supervisor pings the database; 0 disables the supervisor
+	HealthCheckSeconds int
+	// HealthFailureThreshold is how many failed pings in a row mark the database not ready and reset the pool
+	HealthFailureThreshold int
 }
 
 type YandexStorageConfig struct {
@@ -96,6 +131,14 @@ type ReCAPTCHAConfig struct {
 	SiteKey   string
 }
 
+// BotCheckConfig drives the honeypot and minimum fill time checks of the public forms
+type BotCheckConfig struct {
+	// TimestampSecret signs the render timestamps the frontend embeds in the forms; empty checks only the honeypot
+	TimestampSecret   string
+	MinFillSeconds    int // Forms sent sooner after rendering are rejected
+	TimestampMaxHours int // Render timestamps older than this are rejected; 0 accepts any age
+}
+
 type EventTriggerFunctionsConfig struct {
 	MentorCreatedTriggerURL          string
 	MentorUpdatedTriggerURL          string
@@ -105,6 +148,64 @@ type EventTriggerFunctionsConfig struct {
 	MentorModerationTriggerURL       string
 	RequestProcessFinishedTriggerURL string
 	ReviewCreatedTriggerURL          string
+	ProgramRegistrationTriggerURL    string
+	AbuseReportTriggerURL            string
+	MentorChannelPostTriggerURL      string
+	SessionRescheduleTriggerURL      string
+	MentorEmailChangeTriggerURL      string
+	MentorNewDeviceLoginTriggerURL   string
+	CommunityEventTriggerURL         string
+	MentorQuestionTriggerURL         string
+	CohortEnrollmentTriggerURL       string
+	CohortCertificateTriggerURL      string
+	MentorAutoApprovedTriggerURL     string
+	MentorSurveyTriggerURL           string
+	MentorCalendarBrokenTriggerURL   string
+	BotSilentTriggerURL              string
+	RequestScheduledTriggerURL       string
+	NotificationDigestTriggerURL     string
+
+	// WebhookTestSandboxURL receives the admin webhook tests sent with dryRun=false instead of
+	// the production trigger URLs; without it they are not sent
+	WebhookTestSandboxURL string
+
+	// Retries of failed asynchronous trigger calls before they go to the dead-letter table
+	RetryMaxAttempts int
+	RetryBaseDelayMs int
+	RetryMaxDelayMs  int
+}
+
+// EventBusConfig configures the optional message bus receiving domain events
+// (mentor.updated, request.created, request.status_changed) next to the HTTP triggers
+type EventBusConfig struct {
+	Provider      string // "" / none, log or nats
+	NATSURL       string
+	SubjectPrefix string
+}
+
+// WarehouseConfig configures the scheduled export of anonymized activity to the analytics warehouse
+type WarehouseConfig struct {
+	Provider              string // "" / none or clickhouse
+	ClickHouseURL         string
+	ClickHouseDatabase    string
+	ClickHouseUser        string
+	ClickHousePassword    string
+	HashSalt              string // key for hashing mentee emails; changing it breaks joins across exports
+	ExportIntervalMinutes int
+	ExportBatchSize       int
+}
+
+// AirtableSyncConfig configures pushing PostgreSQL changes back to the legacy Airtable base
+type AirtableSyncConfig struct {
+	APIURL          string
+	BaseID          string
+	Token           string // personal access token with data.records:read and write
+	MentorsTable    string
+	RequestsTable   string
+	ModifiedField   string // last-modified-time field of both tables, used to detect conflicts
+	IntervalMinutes int    // 0 runs no background sync; cmd/migrate --airtable-sync runs it once
+	// DualWrite mirrors each profile save, status change and picture upload to Airtable once it commits
+	DualWrite bool
 }
 
 type NextJSConfig struct {
@@ -141,9 +242,142 @@ type ProfilingConfig struct {
 	UploadIntervalSeconds int
 }
 
+// WatchdogConfig configures the runtime watchdog that captures heap/goroutine profiles
+// when memory or goroutine counts cross their thresholds
+type WatchdogConfig struct {
+	Enabled            bool
+	IntervalSeconds    int
+	RSSThresholdMB     int // 0 disables the RSS check
+	GoroutineThreshold int // 0 disables the goroutine check
+	CooldownMinutes    int // minimum time between two captures
+	DumpDir            string
+	KeepDumps          int  // number of profile files kept in DumpDir
+	UploadDumps        bool // also upload profiles to object storage
+}
+
+// PreflightConfig sets how strictly each optional dependency is checked at startup:
+// fail aborts startup, warn reports and continues degraded, skip doesn't check.
+// PostgreSQL is always required.
+type PreflightConfig struct {
+	ObjectStorage  string
+	EventBus       string
+	Warehouse      string
+	TimeoutSeconds int
+}
+
+// PartnerAuditConfig configures the audit log of requests made with partner API tokens
+type PartnerAuditConfig struct {
+	Enabled       bool
+	SampleRate    float64 // share of successful requests recorded; failures are always recorded
+	RetentionDays int
+}
+
+// PartnerQuotaConfig configures monthly request quotas of partner API tokens
+type PartnerQuotaConfig struct {
+	Enabled             bool
+	DefaultMonthlyLimit int64 // 0 means unlimited; admins can override per token
+}
+
 type CacheConfig struct {
-	MentorTTLSeconds    int  // Mentor cache TTL in seconds
-	DisableMentorsCache bool // Experimental: disable cache and read from DB on every request
+	MentorTTLSeconds       int  // Mentor cache TTL in seconds
+	TagsTTLSeconds         int  // How often the tags cache is reloaded; 0 loads it once
+	DisableMentorsCache    bool // Experimental: disable cache and read from DB on every request
+	CalendarFeedTTLSeconds int  // How long parsed mentor iCal feeds are cached
+	// VersionPollSeconds is how often replicas check the shared mentor cache version; 0 disables the check
+	VersionPollSeconds int
+	// Backend keeps the mentor cache in the process ("memory") or in Redis shared by all replicas ("redis")
+	Backend        string
+	RedisURL       string // redis://[[user]:password@]host[:port][/db]
+	RedisKeyPrefix string // Namespaces the cache keys in a shared Redis
+	// SnapshotPath is the file the mentor cache is saved to and loaded from at startup when the
+	// database is unreachable; empty disables snapshots
+	SnapshotPath            string
+	SnapshotIntervalSeconds int // How often the snapshot is rewritten
+	// RefreshJitter moves each scheduled cache refresh by up to this fraction of its interval
+	RefreshJitter float64
+	// RefreshMaxBackoffSeconds caps the refresh interval, doubled after each failed refresh; 0 disables backoff
+	RefreshMaxBackoffSeconds int
+	RefreshDisabled          bool // Stops the scheduled refreshes of the mentor and tags caches
+	// DataSource is where the mentor cache reads mentor profiles: "postgres" or "airtable", the
+	// legacy base (through the AIRTABLE_SYNC_* settings) during the transition. Writes always go to PostgreSQL.
+	DataSource string
+}
+
+// HTTPCacheConfig drives the Cache-Control and Surrogate-Control headers the CDN honours.
+// Routes are Gin route templates; routes in neither list keep the default no-store.
+type HTTPCacheConfig struct {
+	Enabled                         bool
+	ListRoutes                      []string // Shared lists, cached by the CDN only
+	ListSMaxAgeSeconds              int      // How long the CDN serves a list as fresh
+	ListStaleWhileRevalidateSeconds int      // How long the CDN may serve a stale list while refetching
+	DetailRoutes                    []string // Single resources, cached briefly by the CDN and browsers
+	DetailMaxAgeSeconds             int
+}
+
+// CDNConfig selects the CDN whose cache is purged when a mentor changes
+type CDNConfig struct {
+	Provider           string // none, cloudflare or yandex
+	APIURL             string // overrides the provider's purge API URL
+	PurgeBaseURL       string // public URL of the API as served by the CDN
+	PurgeMaxAttempts   int
+	CloudflareZoneID   string
+	CloudflareAPIToken string
+	YandexResourceID   string
+	YandexAPIKey       string
+}
+
+type LeaderboardConfig struct {
+	Periods        []string // Periods like "30d" or "all"; the first one is the default
+	Limit          int      // Number of mentors per leaderboard
+	RefreshMinutes int      // How often leaderboards are recomputed
+}
+
+type CertificatesConfig struct {
+	SigningSecret string // HMAC key of cohort completion certificates; empty disables issuing
+}
+
+type MentorSurveyConfig struct {
+	DispatchIntervalHours int // How often survey invitations are sent; 0 disables them
+	BatchSize             int // Invitations per run
+	ResponseWindowDays    int // How long an invitation can be answered
+	MinTenureDays         int // Days a mentor must have been registered before the first survey
+}
+
+type CalendarCheckConfig struct {
+	IntervalMinutes  int // How often mentors' calendar links are checked; 0 disables the checks
+	FailureThreshold int // Consecutive failed checks before a link is marked broken
+	TimeoutSeconds   int // Timeout of a single check
+}
+
+type BotHeartbeatConfig struct {
+	// SilentMinutes without a heartbeat from the Telegram bot raise the bot-silent alert; 0 disables it
+	SilentMinutes int
+}
+
+// NotificationConfig drives the quiet hours of mentor notifications
+type NotificationConfig struct {
+	// QuietHoursChannels maps the channels held during a mentor's quiet hours to how they are delivered
+	// once those end: "digest" or "individual". Channels not listed are always sent at once.
+	QuietHoursChannels      map[string]string
+	DeliveryIntervalSeconds int // How often held notifications that are due are delivered
+}
+
+// ContactLimitConfig drives the contact form submission limits kept in PostgreSQL, which survive
+// restarts unlike the in-memory rate limiters
+type ContactLimitConfig struct {
+	EmailLimit    int // Submissions allowed per email in the sliding window; 0 disables the limit
+	IPLimit       int // Submissions allowed per client IP in the sliding window; 0 disables the limit
+	WindowMinutes int
+}
+
+type MentorInsightsConfig struct {
+	RefreshHours     int // How often per-mentor insights are recomputed; 0 disables them
+	WindowDays       int // Requests and reviews of the last WindowDays are aggregated
+	ResponseSLAHours int // Target time from a new request to the mentor's first answer
+}
+
+type MentorStatsConfig struct {
+	RefreshMinutes int // How often the mentor_stats view is refreshed; 0 disables refreshes
 }
 
 type MentorSessionConfig struct {
@@ -161,6 +395,9 @@ func Load() (*Config, error) {
 
 	// Set defaults
 	v.SetDefault("PORT", "8081")
+	v.SetDefault("INTERNAL_PORT", "")
+	v.SetDefault("INTERNAL_HOST", "0.0.0.0")
+	v.SetDefault("SHORT_LINK_BASE_URL", "")
 	v.SetDefault("GIN_MODE", "release")
 	v.SetDefault("APP_ENV", "production")
 	v.SetDefault("BASE_URL", "https://getmentor.dev")
@@ -176,9 +413,88 @@ func Load() (*Config, error) {
 	v.SetDefault("O11Y_PROFILING_APP_NAME", "getmentor-api")
 	v.SetDefault("O11Y_PROFILING_SAMPLE_TYPES", "cpu,alloc_space,alloc_objects,goroutines,mutex,block")
 	v.SetDefault("O11Y_PROFILING_UPLOAD_INTERVAL_SECONDS", 15)
-	v.SetDefault("MENTOR_CACHE_TTL", 600)        // 10 minutes in seconds
-	v.SetDefault("DISABLE_MENTORS_CACHE", false) // Experimental: disable cache
+
+	// Database supervisor defaults
+	v.SetDefault("DB_HEALTH_CHECK_SECONDS", 10)
+	v.SetDefault("DB_HEALTH_FAILURE_THRESHOLD", 3)
+
+	// Runtime watchdog defaults
+	v.SetDefault("WATCHDOG_ENABLED", false)
+	v.SetDefault("WATCHDOG_INTERVAL_SECONDS", 30)
+	v.SetDefault("WATCHDOG_RSS_THRESHOLD_MB", 768)
+	v.SetDefault("WATCHDOG_GOROUTINE_THRESHOLD", 10000)
+	v.SetDefault("WATCHDOG_COOLDOWN_MINUTES", 30)
+	v.SetDefault("WATCHDOG_KEEP_DUMPS", 20)
+	v.SetDefault("WATCHDOG_UPLOAD_DUMPS", false)
+
+	// Startup preflight defaults
+	v.SetDefault("PREFLIGHT_OBJECT_STORAGE", "warn")
+	v.SetDefault("PREFLIGHT_EVENT_BUS", "warn")
+	v.SetDefault("PREFLIGHT_WAREHOUSE", "warn")
+	v.SetDefault("PREFLIGHT_TIMEOUT_SECONDS", 5)
+
+	// Partner audit log defaults
+	v.SetDefault("PARTNER_AUDIT_ENABLED", false)
+	v.SetDefault("PARTNER_AUDIT_SAMPLE_RATE", 1.0)
+	v.SetDefault("PARTNER_AUDIT_RETENTION_DAYS", 30)
+
+	// Partner quota defaults
+	v.SetDefault("PARTNER_QUOTA_ENABLED", false)
+	v.SetDefault("PARTNER_QUOTA_DEFAULT_MONTHLY_LIMIT", 0)
+	v.SetDefault("MENTOR_CACHE_TTL", 600)         // 10 minutes in seconds
+	v.SetDefault("TAGS_CACHE_TTL", 600)           // 10 minutes in seconds
+	v.SetDefault("DISABLE_MENTORS_CACHE", false)  // Experimental: disable cache
+	v.SetDefault("CALENDAR_FEED_CACHE_TTL", 1800) // 30 minutes in seconds
+	v.SetDefault("CACHE_VERSION_POLL_SECONDS", 15)
+	v.SetDefault("CACHE_BACKEND", "memory")
+	v.SetDefault("DATA_SOURCE", "postgres")
+	v.SetDefault("CORS_ORIGINS_REFRESH_SECONDS", 30)
+	v.SetDefault("REDIS_KEY_PREFIX", "getmentor:")
+	v.SetDefault("MENTOR_CACHE_SNAPSHOT_INTERVAL_SECONDS", 300)
+	v.SetDefault("CACHE_REFRESH_JITTER", 0.1)
+	v.SetDefault("CACHE_REFRESH_MAX_BACKOFF_SECONDS", 3600)
+	v.SetDefault("CACHE_REFRESH_DISABLED", false)
 	v.SetDefault("MCP_ALLOW_ALL", false)
+	v.SetDefault("LEADERBOARD_PERIODS", "30d,90d,365d,all")
+	v.SetDefault("LEADERBOARD_LIMIT", 20)
+	v.SetDefault("LEADERBOARD_REFRESH_MINUTES", 60)
+	v.SetDefault("MENTOR_SURVEY_DISPATCH_INTERVAL_HOURS", 0)
+	v.SetDefault("MENTOR_SURVEY_BATCH_SIZE", 50)
+	v.SetDefault("MENTOR_SURVEY_RESPONSE_WINDOW_DAYS", 14)
+	v.SetDefault("MENTOR_SURVEY_MIN_TENURE_DAYS", 30)
+
+	// Response caching headers defaults
+	v.SetDefault("HTTP_CACHE_POLICY_ENABLED", false)
+	v.SetDefault("HTTP_CACHE_LIST_ROUTES", "/api/v1/mentors,/api/v1/mentors/new,/api/v1/mentors/search,/api/v1/programs,/api/v1/cohorts")
+	v.SetDefault("HTTP_CACHE_LIST_S_MAXAGE_SECONDS", 60)
+	v.SetDefault("HTTP_CACHE_LIST_STALE_WHILE_REVALIDATE_SECONDS", 300)
+	v.SetDefault("HTTP_CACHE_DETAIL_ROUTES", "/api/v1/mentor/:id,/api/v1/mentor/:id/reviews,/api/v1/mentor/:id/questions,/api/v1/mentor/:id/programs")
+	v.SetDefault("HTTP_CACHE_DETAIL_MAX_AGE_SECONDS", 30)
+
+	// CDN purge defaults
+	v.SetDefault("CDN_PROVIDER", "none")
+	v.SetDefault("CDN_PURGE_MAX_ATTEMPTS", 5)
+
+	// Calendar link check defaults
+	v.SetDefault("CALENDAR_CHECK_INTERVAL_MINUTES", 0)
+	v.SetDefault("CALENDAR_CHECK_FAILURE_THRESHOLD", 3)
+	v.SetDefault("CALENDAR_CHECK_TIMEOUT_SECONDS", 10)
+	v.SetDefault("BOT_HEARTBEAT_SILENT_MINUTES", 10)
+	v.SetDefault("NOTIFICATION_QUIET_HOURS_CHANNELS", "mentor_request_created=digest,mentor_question=digest")
+	v.SetDefault("NOTIFICATION_DELIVERY_INTERVAL_SECONDS", 60)
+	v.SetDefault("FORM_MIN_FILL_SECONDS", 3)
+	v.SetDefault("FORM_TIMESTAMP_MAX_AGE_HOURS", 24)
+	v.SetDefault("CONTACT_LIMIT_EMAIL", 10)
+	v.SetDefault("CONTACT_LIMIT_IP", 30)
+	v.SetDefault("CONTACT_LIMIT_WINDOW_MINUTES", 1440) // 24 hours
+
+	// Mentor insights defaults
+	v.SetDefault("MENTOR_INSIGHTS_REFRESH_HOURS", 24)
+	v.SetDefault("MENTOR_INSIGHTS_WINDOW_DAYS", 90)
+	v.SetDefault("MENTOR_INSIGHTS_RESPONSE_SLA_HOURS", 48)
+
+	// Mentor stats defaults
+	v.SetDefault("MENTOR_STATS_REFRESH_MINUTES", 60)
 	v.SetDefault("ANALYTICS_PROVIDER", "")
 	v.SetDefault("ANALYTICS_EVENT_VERSION", defaultEventVersion)
 	v.SetDefault("MIXPANEL_ENABLED", false)
@@ -195,6 +511,29 @@ func Load() (*Config, error) {
 	v.SetDefault("COOKIE_DOMAIN", "")
 	v.SetDefault("COOKIE_SECURE", true)
 
+	// Outbound trigger retry defaults
+	v.SetDefault("TRIGGER_RETRY_MAX_ATTEMPTS", 4)
+	v.SetDefault("TRIGGER_RETRY_BASE_DELAY_MS", 500)
+	v.SetDefault("TRIGGER_RETRY_MAX_DELAY_MS", 10000)
+
+	// Event bus defaults
+	v.SetDefault("EVENT_BUS_PROVIDER", "")
+	v.SetDefault("EVENT_BUS_SUBJECT_PREFIX", "getmentor")
+
+	// Warehouse export defaults
+	v.SetDefault("WAREHOUSE_PROVIDER", "")
+	v.SetDefault("WAREHOUSE_CLICKHOUSE_DATABASE", "getmentor")
+	v.SetDefault("WAREHOUSE_EXPORT_INTERVAL_MINUTES", 60)
+	v.SetDefault("WAREHOUSE_EXPORT_BATCH_SIZE", 1000)
+
+	// Airtable reverse sync defaults
+	v.SetDefault("AIRTABLE_SYNC_API_URL", "https://api.airtable.com/v0")
+	v.SetDefault("AIRTABLE_SYNC_MENTORS_TABLE", "Mentors")
+	v.SetDefault("AIRTABLE_SYNC_REQUESTS_TABLE", "Client Requests")
+	v.SetDefault("AIRTABLE_SYNC_MODIFIED_FIELD", "Last Modified")
+	v.SetDefault("AIRTABLE_SYNC_INTERVAL_MINUTES", 0)
+	v.SetDefault("AIRTABLE_DUAL_WRITE", false)
+
 	// Automatically read environment variables
 	v.AutomaticEnv()
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
@@ -226,17 +565,24 @@ func Load() (*Config, error) {
 
 	cfg := &Config{
 		Server: ServerConfig{
-			Port:           v.GetString("PORT"),
-			GinMode:        v.GetString("GIN_MODE"),
-			AppEnv:         v.GetString("APP_ENV"),
-			BaseURL:        v.GetString("BASE_URL"),
-			AllowedOrigins: allowedOrigins,
+			Port:               v.GetString("PORT"),
+			GinMode:            v.GetString("GIN_MODE"),
+			AppEnv:             v.GetString("APP_ENV"),
+			BaseURL:            v.GetString("BASE_URL"),
+			AllowedOrigins:     allowedOrigins,
+			InternalPort:       strings.TrimSpace(v.GetString("INTERNAL_PORT")),
+			InternalHost:       strings.TrimSpace(v.GetString("INTERNAL_HOST")),
+			ShortLinkBaseURL:   strings.TrimSpace(v.GetString("SHORT_LINK_BASE_URL")),
+			Region:             strings.TrimSpace(v.GetString("REGION")),
+			CORSRefreshSeconds: v.GetInt("CORS_ORIGINS_REFRESH_SECONDS"),
 		},
 		Database: DatabaseConfig{
-			URL:         v.GetString("DATABASE_URL"),
-			MaxConns:    20,
-			MinConns:    2,
-			WorkOffline: v.GetBool("DB_WORK_OFFLINE"),
+			URL:                    v.GetString("DATABASE_URL"),
+			MaxConns:               20,
+			MinConns:               2,
+			WorkOffline:            v.GetBool("DB_WORK_OFFLINE"),
+			HealthCheckSeconds:     v.GetInt("DB_HEALTH_CHECK_SECONDS"),
+			HealthFailureThreshold: v.GetInt("DB_HEALTH_FAILURE_THRESHOLD"),
 		},
 		YandexStorage: YandexStorageConfig{
 			AccessKeyID:     v.GetString("YANDEX_STORAGE_ACCESS_KEY_ID"),
@@ -276,6 +622,11 @@ func Load() (*Config, error) {
 			SecretKey: v.GetString("RECAPTCHA_V2_SECRET_KEY"),
 			SiteKey:   v.GetString("NEXT_PUBLIC_RECAPTCHA_V2_SITE_KEY"),
 		},
+		BotCheck: BotCheckConfig{
+			TimestampSecret:   v.GetString("FORM_TIMESTAMP_SECRET"),
+			MinFillSeconds:    v.GetInt("FORM_MIN_FILL_SECONDS"),
+			TimestampMaxHours: v.GetInt("FORM_TIMESTAMP_MAX_AGE_HOURS"),
+		},
 		EventTriggers: EventTriggerFunctionsConfig{
 			MentorCreatedTriggerURL:          v.GetString("MENTOR_CREATED_TRIGGER_URL"),
 			MentorUpdatedTriggerURL:          v.GetString("MENTOR_UPDATED_TRIGGER_URL"),
@@ -285,6 +636,51 @@ func Load() (*Config, error) {
 			MentorModerationTriggerURL:       v.GetString("MENTOR_MODERATION_TRIGGER_URL"),
 			RequestProcessFinishedTriggerURL: v.GetString("REQUEST_PROCESS_FINISHED_TRIGGER_URL"),
 			ReviewCreatedTriggerURL:          v.GetString("REVIEW_CREATED_TRIGGER_URL"),
+			ProgramRegistrationTriggerURL:    v.GetString("PROGRAM_REGISTRATION_TRIGGER_URL"),
+			AbuseReportTriggerURL:            v.GetString("ABUSE_REPORT_TRIGGER_URL"),
+			MentorChannelPostTriggerURL:      v.GetString("MENTOR_CHANNEL_POST_TRIGGER_URL"),
+			SessionRescheduleTriggerURL:      v.GetString("SESSION_RESCHEDULE_TRIGGER_URL"),
+			MentorEmailChangeTriggerURL:      v.GetString("MENTOR_EMAIL_CHANGE_TRIGGER_URL"),
+			MentorNewDeviceLoginTriggerURL:   v.GetString("MENTOR_NEW_DEVICE_LOGIN_TRIGGER_URL"),
+			CommunityEventTriggerURL:         v.GetString("COMMUNITY_EVENT_TRIGGER_URL"),
+			MentorQuestionTriggerURL:         v.GetString("MENTOR_QUESTION_TRIGGER_URL"),
+			CohortEnrollmentTriggerURL:       v.GetString("COHORT_ENROLLMENT_TRIGGER_URL"),
+			CohortCertificateTriggerURL:      v.GetString("COHORT_CERTIFICATE_TRIGGER_URL"),
+			MentorAutoApprovedTriggerURL:     v.GetString("MENTOR_AUTO_APPROVED_TRIGGER_URL"),
+			MentorSurveyTriggerURL:           v.GetString("MENTOR_SURVEY_TRIGGER_URL"),
+			MentorCalendarBrokenTriggerURL:   v.GetString("MENTOR_CALENDAR_BROKEN_TRIGGER_URL"),
+			BotSilentTriggerURL:              v.GetString("BOT_SILENT_TRIGGER_URL"),
+			NotificationDigestTriggerURL:     v.GetString("NOTIFICATION_DIGEST_TRIGGER_URL"),
+			WebhookTestSandboxURL:            v.GetString("WEBHOOK_TEST_SANDBOX_URL"),
+			RequestScheduledTriggerURL:       v.GetString("REQUEST_SCHEDULED_TRIGGER_URL"),
+			RetryMaxAttempts:                 v.GetInt("TRIGGER_RETRY_MAX_ATTEMPTS"),
+			RetryBaseDelayMs:                 v.GetInt("TRIGGER_RETRY_BASE_DELAY_MS"),
+			RetryMaxDelayMs:                  v.GetInt("TRIGGER_RETRY_MAX_DELAY_MS"),
+		},
+		EventBus: EventBusConfig{
+			Provider:      strings.ToLower(strings.TrimSpace(v.GetString("EVENT_BUS_PROVIDER"))),
+			NATSURL:       v.GetString("EVENT_BUS_NATS_URL"),
+			SubjectPrefix: v.GetString("EVENT_BUS_SUBJECT_PREFIX"),
+		},
+		Warehouse: WarehouseConfig{
+			Provider:              strings.ToLower(strings.TrimSpace(v.GetString("WAREHOUSE_PROVIDER"))),
+			ClickHouseURL:         v.GetString("WAREHOUSE_CLICKHOUSE_URL"),
+			ClickHouseDatabase:    v.GetString("WAREHOUSE_CLICKHOUSE_DATABASE"),
+			ClickHouseUser:        v.GetString("WAREHOUSE_CLICKHOUSE_USER"),
+			ClickHousePassword:    v.GetString("WAREHOUSE_CLICKHOUSE_PASSWORD"),
+			HashSalt:              v.GetString("WAREHOUSE_HASH_SALT"),
+			ExportIntervalMinutes: v.GetInt("WAREHOUSE_EXPORT_INTERVAL_MINUTES"),
+			ExportBatchSize:       v.GetInt("WAREHOUSE_EXPORT_BATCH_SIZE"),
+		},
+		AirtableSync: AirtableSyncConfig{
+			APIURL:          v.GetString("AIRTABLE_SYNC_API_URL"),
+			BaseID:          v.GetString("AIRTABLE_SYNC_BASE_ID"),
+			Token:           v.GetString("AIRTABLE_SYNC_TOKEN"),
+			MentorsTable:    v.GetString("AIRTABLE_SYNC_MENTORS_TABLE"),
+			RequestsTable:   v.GetString("AIRTABLE_SYNC_REQUESTS_TABLE"),
+			ModifiedField:   v.GetString("AIRTABLE_SYNC_MODIFIED_FIELD"),
+			IntervalMinutes: v.GetInt("AIRTABLE_SYNC_INTERVAL_MINUTES"),
+			DualWrite:       v.GetBool("AIRTABLE_DUAL_WRITE"),
 		},
 		NextJS: NextJSConfig{
 			BaseURL:          v.GetString("NEXTJS_BASE_URL"),
@@ -308,9 +704,46 @@ func Load() (*Config, error) {
 			SampleTypes:           v.GetString("O11Y_PROFILING_SAMPLE_TYPES"),
 			UploadIntervalSeconds: v.GetInt("O11Y_PROFILING_UPLOAD_INTERVAL_SECONDS"),
 		},
+		Watchdog: WatchdogConfig{
+			Enabled:            v.GetBool("WATCHDOG_ENABLED"),
+			IntervalSeconds:    v.GetInt("WATCHDOG_INTERVAL_SECONDS"),
+			RSSThresholdMB:     v.GetInt("WATCHDOG_RSS_THRESHOLD_MB"),
+			GoroutineThreshold: v.GetInt("WATCHDOG_GOROUTINE_THRESHOLD"),
+			CooldownMinutes:    v.GetInt("WATCHDOG_COOLDOWN_MINUTES"),
+			DumpDir:            v.GetString("WATCHDOG_DUMP_DIR"),
+			KeepDumps:          v.GetInt("WATCHDOG_KEEP_DUMPS"),
+			UploadDumps:        v.GetBool("WATCHDOG_UPLOAD_DUMPS"),
+		},
+		Preflight: PreflightConfig{
+			ObjectStorage:  strings.ToLower(strings.TrimSpace(v.GetString("PREFLIGHT_OBJECT_STORAGE"))),
+			EventBus:       strings.ToLower(strings.TrimSpace(v.GetString("PREFLIGHT_EVENT_BUS"))),
+			Warehouse:      strings.ToLower(strings.TrimSpace(v.GetString("PREFLIGHT_WAREHOUSE"))),
+			TimeoutSeconds: v.GetInt("PREFLIGHT_TIMEOUT_SECONDS"),
+		},
+		PartnerAudit: PartnerAuditConfig{
+			Enabled:       v.GetBool("PARTNER_AUDIT_ENABLED"),
+			SampleRate:    v.GetFloat64("PARTNER_AUDIT_SAMPLE_RATE"),
+			RetentionDays: v.GetInt("PARTNER_AUDIT_RETENTION_DAYS"),
+		},
+		PartnerQuota: PartnerQuotaConfig{
+			Enabled:             v.GetBool("PARTNER_QUOTA_ENABLED"),
+			DefaultMonthlyLimit: v.GetInt64("PARTNER_QUOTA_DEFAULT_MONTHLY_LIMIT"),
+		},
 		Cache: CacheConfig{
-			MentorTTLSeconds:    v.GetInt("MENTOR_CACHE_TTL"),
-			DisableMentorsCache: v.GetBool("DISABLE_MENTORS_CACHE"),
+			MentorTTLSeconds:         v.GetInt("MENTOR_CACHE_TTL"),
+			TagsTTLSeconds:           v.GetInt("TAGS_CACHE_TTL"),
+			DisableMentorsCache:      v.GetBool("DISABLE_MENTORS_CACHE"),
+			CalendarFeedTTLSeconds:   v.GetInt("CALENDAR_FEED_CACHE_TTL"),
+			VersionPollSeconds:       v.GetInt("CACHE_VERSION_POLL_SECONDS"),
+			Backend:                  strings.ToLower(strings.TrimSpace(v.GetString("CACHE_BACKEND"))),
+			RedisURL:                 strings.TrimSpace(v.GetString("REDIS_URL")),
+			RedisKeyPrefix:           v.GetString("REDIS_KEY_PREFIX"),
+			SnapshotPath:             strings.TrimSpace(v.GetString("MENTOR_CACHE_SNAPSHOT_PATH")),
+			SnapshotIntervalSeconds:  v.GetInt("MENTOR_CACHE_SNAPSHOT_INTERVAL_SECONDS"),
+			RefreshJitter:            v.GetFloat64("CACHE_REFRESH_JITTER"),
+			RefreshMaxBackoffSeconds: v.GetInt("CACHE_REFRESH_MAX_BACKOFF_SECONDS"),
+			RefreshDisabled:          v.GetBool("CACHE_REFRESH_DISABLED"),
+			DataSource:               strings.ToLower(strings.TrimSpace(v.GetString("DATA_SOURCE"))),
 		},
 		MentorSession: MentorSessionConfig{
 			JWTSecret:            v.GetString("JWT_SECRET"),
@@ -320,6 +753,63 @@ func Load() (*Config, error) {
 			CookieDomain:         v.GetString("COOKIE_DOMAIN"),
 			CookieSecure:         v.GetBool("COOKIE_SECURE"),
 		},
+		Leaderboard: LeaderboardConfig{
+			Periods:        splitList(v.GetString("LEADERBOARD_PERIODS")),
+			Limit:          v.GetInt("LEADERBOARD_LIMIT"),
+			RefreshMinutes: v.GetInt("LEADERBOARD_REFRESH_MINUTES"),
+		},
+		Certificates: CertificatesConfig{
+			SigningSecret: v.GetString("CERTIFICATE_SIGNING_SECRET"),
+		},
+		MentorSurvey: MentorSurveyConfig{
+			DispatchIntervalHours: v.GetInt("MENTOR_SURVEY_DISPATCH_INTERVAL_HOURS"),
+			BatchSize:             v.GetInt("MENTOR_SURVEY_BATCH_SIZE"),
+			ResponseWindowDays:    v.GetInt("MENTOR_SURVEY_RESPONSE_WINDOW_DAYS"),
+			MinTenureDays:         v.GetInt("MENTOR_SURVEY_MIN_TENURE_DAYS"),
+		},
+		HTTPCache: HTTPCacheConfig{
+			Enabled:                         v.GetBool("HTTP_CACHE_POLICY_ENABLED"),
+			ListRoutes:                      splitList(v.GetString("HTTP_CACHE_LIST_ROUTES")),
+			ListSMaxAgeSeconds:              v.GetInt("HTTP_CACHE_LIST_S_MAXAGE_SECONDS"),
+			ListStaleWhileRevalidateSeconds: v.GetInt("HTTP_CACHE_LIST_STALE_WHILE_REVALIDATE_SECONDS"),
+			DetailRoutes:                    splitList(v.GetString("HTTP_CACHE_DETAIL_ROUTES")),
+			DetailMaxAgeSeconds:             v.GetInt("HTTP_CACHE_DETAIL_MAX_AGE_SECONDS"),
+		},
+		CDN: CDNConfig{
+			Provider:           strings.ToLower(v.GetString("CDN_PROVIDER")),
+			APIURL:             v.GetString("CDN_API_URL"),
+			PurgeBaseURL:       strings.TrimRight(v.GetString("CDN_PURGE_BASE_URL"), "/"),
+			PurgeMaxAttempts:   v.GetInt("CDN_PURGE_MAX_ATTEMPTS"),
+			CloudflareZoneID:   v.GetString("CLOUDFLARE_ZONE_ID"),
+			CloudflareAPIToken: v.GetString("CLOUDFLARE_API_TOKEN"),
+			YandexResourceID:   v.GetString("YANDEX_CDN_RESOURCE_ID"),
+			YandexAPIKey:       v.GetString("YANDEX_CDN_API_KEY"),
+		},
+		CalendarCheck: CalendarCheckConfig{
+			IntervalMinutes:  v.GetInt("CALENDAR_CHECK_INTERVAL_MINUTES"),
+			FailureThreshold: v.GetInt("CALENDAR_CHECK_FAILURE_THRESHOLD"),
+			TimeoutSeconds:   v.GetInt("CALENDAR_CHECK_TIMEOUT_SECONDS"),
+		},
+		MentorInsights: MentorInsightsConfig{
+			RefreshHours:     v.GetInt("MENTOR_INSIGHTS_REFRESH_HOURS"),
+			WindowDays:       v.GetInt("MENTOR_INSIGHTS_WINDOW_DAYS"),
+			ResponseSLAHours: v.GetInt("MENTOR_INSIGHTS_RESPONSE_SLA_HOURS"),
+		},
+		MentorStats: MentorStatsConfig{
+			RefreshMinutes: v.GetInt("MENTOR_STATS_REFRESH_MINUTES"),
+		},
+		BotHeartbeat: BotHeartbeatConfig{
+			SilentMinutes: v.GetInt("BOT_HEARTBEAT_SILENT_MINUTES"),
+		},
+		Notifications: NotificationConfig{
+			QuietHoursChannels:      splitPairs(v.GetString("NOTIFICATION_QUIET_HOURS_CHANNELS")),
+			DeliveryIntervalSeconds: v.GetInt("NOTIFICATION_DELIVERY_INTERVAL_SECONDS"),
+		},
+		ContactLimits: ContactLimitConfig{
+			EmailLimit:    v.GetInt("CONTACT_LIMIT_EMAIL"),
+			IPLimit:       v.GetInt("CONTACT_LIMIT_IP"),
+			WindowMinutes: v.GetInt("CONTACT_LIMIT_WINDOW_MINUTES"),
+		},
 	}
 
 	// Validate required fields
@@ -347,13 +837,120 @@ func (c *Config) Validate() error {
 	if err := c.validateServerConfig(); err != nil {
 		return err
 	}
+	if err := c.validateLeaderboardConfig(); err != nil {
+		return err
+	}
+	if secret := c.Certificates.SigningSecret; secret != "" && len(secret) < 32 {
+		return fmt.Errorf("CERTIFICATE_SIGNING_SECRET must be at least 32 characters")
+	}
+	if err := c.validateMentorSurveyConfig(); err != nil {
+		return err
+	}
+	if err := c.validateMentorInsightsConfig(); err != nil {
+		return err
+	}
+	if c.MentorStats.RefreshMinutes < 0 {
+		return fmt.Errorf("MENTOR_STATS_REFRESH_MINUTES must not be negative")
+	}
+	if err := c.validateCalendarCheckConfig(); err != nil {
+		return err
+	}
+	if c.BotHeartbeat.SilentMinutes < 0 {
+		return fmt.Errorf("BOT_HEARTBEAT_SILENT_MINUTES must not be negative")
+	}
+	if err := c.validateNotificationConfig(); err != nil {
+		return err
+	}
+	if c.ContactLimits.EmailLimit < 0 || c.ContactLimits.IPLimit < 0 {
+		return fmt.Errorf("CONTACT_LIMIT_EMAIL and CONTACT_LIMIT_IP must not be negative")
+	}
+	if (c.ContactLimits.EmailLimit > 0 || c.ContactLimits.IPLimit > 0) && c.ContactLimits.WindowMinutes <= 0 {
+		return fmt.Errorf("CONTACT_LIMIT_WINDOW_MINUTES must be positive when a contact limit is set")
+	}
+	if err := c.validateHTTPCacheConfig(); err != nil {
+		return err
+	}
+	if err := c.validateCDNConfig(); err != nil {
+		return err
+	}
+	if err := c.validateTriggerRetryConfig(); err != nil {
+		return err
+	}
+	if err := c.validateEventBusConfig(); err != nil {
+		return err
+	}
+	if err := c.validateWarehouseConfig(); err != nil {
+		return err
+	}
+	if err := c.validateAirtableSyncConfig(); err != nil {
+		return err
+	}
+	if err := c.validateWatchdogConfig(); err != nil {
+		return err
+	}
+	if err := c.validatePreflightConfig(); err != nil {
+		return err
+	}
+	if err := c.validatePartnerAuditConfig(); err != nil {
+		return err
+	}
+	if c.PartnerQuota.DefaultMonthlyLimit < 0 {
+		return fmt.Errorf("PARTNER_QUOTA_DEFAULT_MONTHLY_LIMIT must not be negative")
+	}
+	if err := c.validateCacheConfig(); err != nil {
+		return err
+	}
 	return c.validateProfilingConfig()
 }
 
+func (c *Config) validateCacheConfig() error {
+	if c.Cache.VersionPollSeconds < 0 {
+		return fmt.Errorf("CACHE_VERSION_POLL_SECONDS must not be negative")
+	}
+	if c.Cache.TagsTTLSeconds < 0 {
+		return fmt.Errorf("TAGS_CACHE_TTL must not be negative")
+	}
+	if c.Cache.SnapshotPath != "" && c.Cache.SnapshotIntervalSeconds <= 0 {
+		return fmt.Errorf("MENTOR_CACHE_SNAPSHOT_INTERVAL_SECONDS must be positive when MENTOR_CACHE_SNAPSHOT_PATH is set")
+	}
+	if c.Cache.RefreshJitter < 0 || c.Cache.RefreshJitter >= 1 {
+		return fmt.Errorf("CACHE_REFRESH_JITTER must be at least 0 and below 1")
+	}
+	if c.Cache.RefreshMaxBackoffSeconds < 0 {
+		return fmt.Errorf("CACHE_REFRESH_MAX_BACKOFF_SECONDS must not be negative")
+	}
+	switch c.Cache.DataSource {
+	case "", "postgres":
+	case "airtable":
+		if !c.IsAirtableSyncConfigured() {
+			return fmt.Errorf("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required when DATA_SOURCE is airtable")
+		}
+	default:
+		return fmt.Errorf("DATA_SOURCE must be postgres or airtable (got %q)", c.Cache.DataSource)
+	}
+	switch c.Cache.Backend {
+	case "", "memory":
+		return nil
+	case "redis":
+		if c.Cache.RedisURL == "" {
+			return fmt.Errorf("REDIS_URL is required when CACHE_BACKEND is redis")
+		}
+		return nil
+	default:
+		return fmt.Errorf("CACHE_BACKEND must be memory or redis (got %q)", c.Cache.Backend)
+	}
+}
+
 func (c *Config) validateDatabaseConfig() error {
 	if !c.Database.WorkOffline && c.Database.URL == "" {
 		return fmt.Errorf("DATABASE_URL is required when not in offline mode")
 	}
+	if c.Database.HealthCheckSeconds < 0 {
+		return fmt.Errorf("DB_HEALTH_CHECK_SECONDS must not be negative")
+	}
+	if c.Database.HealthCheckSeconds > 0 && c.Database.HealthFailureThreshold < 1 {
+		return fmt.Errorf("DB_HEALTH_FAILURE_THRESHOLD must be at least 1")
+	}
 	return nil
 }
 
@@ -400,6 +997,9 @@ func (c *Config) validateReCAPTCHAConfig() error {
 	if c.ReCAPTCHA.SecretKey == "" {
 		return fmt.Errorf("RECAPTCHA_V2_SECRET_KEY is required")
 	}
+	if c.BotCheck.MinFillSeconds < 0 || c.BotCheck.TimestampMaxHours < 0 {
+		return fmt.Errorf("FORM_MIN_FILL_SECONDS and FORM_TIMESTAMP_MAX_AGE_HOURS must not be negative")
+	}
 	return nil
 }
 
@@ -413,6 +1013,206 @@ func (c *Config) validateServerConfig() error {
 	if len(c.Server.AllowedOrigins) == 0 {
 		return fmt.Errorf("ALLOWED_CORS_ORIGINS is required")
 	}
+	if c.Server.InternalPort != "" && c.Server.InternalPort == c.Server.Port {
+		return fmt.Errorf("INTERNAL_PORT must differ from PORT")
+	}
+	if c.Server.CORSRefreshSeconds < 0 {
+		return fmt.Errorf("CORS_ORIGINS_REFRESH_SECONDS must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) validateLeaderboardConfig() error {
+	for _, period := range c.Leaderboard.Periods {
+		if period == "all" {
+			continue
+		}
+		if days, err := strconv.Atoi(strings.TrimSuffix(period, "d")); err != nil || days <= 0 || !strings.HasSuffix(period, "d") {
+			return fmt.Errorf("LEADERBOARD_PERIODS: invalid period %q, expected <days>d or all", period)
+		}
+	}
+	if c.Leaderboard.Limit < 0 || c.Leaderboard.RefreshMinutes < 0 {
+		return fmt.Errorf("LEADERBOARD_LIMIT and LEADERBOARD_REFRESH_MINUTES must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) validateMentorSurveyConfig() error {
+	ms := c.MentorSurvey
+	if ms.DispatchIntervalHours < 0 || ms.MinTenureDays < 0 {
+		return fmt.Errorf("MENTOR_SURVEY_DISPATCH_INTERVAL_HOURS and MENTOR_SURVEY_MIN_TENURE_DAYS must not be negative")
+	}
+	if ms.DispatchIntervalHours > 0 && (ms.BatchSize <= 0 || ms.ResponseWindowDays <= 0) {
+		return fmt.Errorf("MENTOR_SURVEY_BATCH_SIZE and MENTOR_SURVEY_RESPONSE_WINDOW_DAYS must be positive when surveys are enabled")
+	}
+	return nil
+}
+
+func (c *Config) validateCalendarCheckConfig() error {
+	cc := c.CalendarCheck
+	if cc.IntervalMinutes < 0 {
+		return fmt.Errorf("CALENDAR_CHECK_INTERVAL_MINUTES must not be negative")
+	}
+	if cc.IntervalMinutes > 0 && (cc.FailureThreshold <= 0 || cc.TimeoutSeconds <= 0) {
+		return fmt.Errorf("CALENDAR_CHECK_FAILURE_THRESHOLD and CALENDAR_CHECK_TIMEOUT_SECONDS must be positive when calendar checks are enabled")
+	}
+	return nil
+}
+
+func (c *Config) validateHTTPCacheConfig() error {
+	hc := c.HTTPCache
+	if !hc.Enabled {
+		return nil
+	}
+	if hc.ListSMaxAgeSeconds < 0 || hc.ListStaleWhileRevalidateSeconds < 0 || hc.DetailMaxAgeSeconds < 0 {
+		return fmt.Errorf("HTTP_CACHE_LIST_S_MAXAGE_SECONDS, HTTP_CACHE_LIST_STALE_WHILE_REVALIDATE_SECONDS and HTTP_CACHE_DETAIL_MAX_AGE_SECONDS must not be negative")
+	}
+	for _, route := range hc.DetailRoutes {
+		for _, listRoute := range hc.ListRoutes {
+			if route == listRoute {
+				return fmt.Errorf("route %s is in both HTTP_CACHE_LIST_ROUTES and HTTP_CACHE_DETAIL_ROUTES", route)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateCDNConfig() error {
+	cdn := c.CDN
+	switch cdn.Provider {
+	case "", "none":
+		return nil
+	case "cloudflare":
+		if cdn.CloudflareZoneID == "" || cdn.CloudflareAPIToken == "" {
+			return fmt.Errorf("CLOUDFLARE_ZONE_ID and CLOUDFLARE_API_TOKEN are required when CDN_PROVIDER is cloudflare")
+		}
+	case "yandex":
+		if cdn.YandexResourceID == "" || cdn.YandexAPIKey == "" {
+			return fmt.Errorf("YANDEX_CDN_RESOURCE_ID and YANDEX_CDN_API_KEY are required when CDN_PROVIDER is yandex")
+		}
+	default:
+		return fmt.Errorf("CDN_PROVIDER must be none, cloudflare or yandex")
+	}
+	if cdn.PurgeBaseURL == "" {
+		return fmt.Errorf("CDN_PURGE_BASE_URL is required when a CDN provider is set")
+	}
+	if cdn.PurgeMaxAttempts < 1 {
+		return fmt.Errorf("CDN_PURGE_MAX_ATTEMPTS must be at least 1")
+	}
+	return nil
+}
+
+func (c *Config) validateMentorInsightsConfig() error {
+	mi := c.MentorInsights
+	if mi.RefreshHours < 0 {
+		return fmt.Errorf("MENTOR_INSIGHTS_REFRESH_HOURS must not be negative")
+	}
+	if mi.RefreshHours > 0 && (mi.WindowDays <= 0 || mi.ResponseSLAHours <= 0) {
+		return fmt.Errorf("MENTOR_INSIGHTS_WINDOW_DAYS and MENTOR_INSIGHTS_RESPONSE_SLA_HOURS must be positive when insights are enabled")
+	}
+	return nil
+}
+
+func (c *Config) validateTriggerRetryConfig() error {
+	t := c.EventTriggers
+	if t.RetryMaxAttempts < 0 || t.RetryBaseDelayMs < 0 || t.RetryMaxDelayMs < 0 {
+		return fmt.Errorf("TRIGGER_RETRY_MAX_ATTEMPTS, TRIGGER_RETRY_BASE_DELAY_MS and TRIGGER_RETRY_MAX_DELAY_MS must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) validateEventBusConfig() error {
+	switch c.EventBus.Provider {
+	case "", "none", "log":
+		return nil
+	case "nats":
+		if c.EventBus.NATSURL == "" {
+			return fmt.Errorf("EVENT_BUS_NATS_URL is required when EVENT_BUS_PROVIDER is nats")
+		}
+		return nil
+	default:
+		return fmt.Errorf("EVENT_BUS_PROVIDER must be one of none, log or nats (got %q)", c.EventBus.Provider)
+	}
+}
+
+func (c *Config) validateWarehouseConfig() error {
+	w := c.Warehouse
+	switch w.Provider {
+	case "", "none":
+		return nil
+	case "clickhouse":
+		if w.ClickHouseURL == "" {
+			return fmt.Errorf("WAREHOUSE_CLICKHOUSE_URL is required when WAREHOUSE_PROVIDER is clickhouse")
+		}
+		if len(w.HashSalt) < 16 {
+			return fmt.Errorf("WAREHOUSE_HASH_SALT must be at least 16 characters when the warehouse export is enabled")
+		}
+		if w.ExportIntervalMinutes < 1 || w.ExportBatchSize < 1 {
+			return fmt.Errorf("WAREHOUSE_EXPORT_INTERVAL_MINUTES and WAREHOUSE_EXPORT_BATCH_SIZE must be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("WAREHOUSE_PROVIDER must be none or clickhouse (got %q)", w.Provider)
+	}
+}
+
+func (c *Config) validateAirtableSyncConfig() error {
+	a := c.AirtableSync
+	if a.IntervalMinutes < 0 {
+		return fmt.Errorf("AIRTABLE_SYNC_INTERVAL_MINUTES must not be negative")
+	}
+	if a.IntervalMinutes > 0 && !c.IsAirtableSyncConfigured() {
+		return fmt.Errorf("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required when AIRTABLE_SYNC_INTERVAL_MINUTES is set")
+	}
+	if a.DualWrite && !c.IsAirtableSyncConfigured() {
+		return fmt.Errorf("AIRTABLE_SYNC_BASE_ID and AIRTABLE_SYNC_TOKEN are required when AIRTABLE_DUAL_WRITE is set")
+	}
+	return nil
+}
+
+func (c *Config) validateWatchdogConfig() error {
+	w := c.Watchdog
+	if !w.Enabled {
+		return nil
+	}
+	if w.IntervalSeconds < 1 || w.KeepDumps < 1 || w.CooldownMinutes < 0 {
+		return fmt.Errorf("WATCHDOG_INTERVAL_SECONDS and WATCHDOG_KEEP_DUMPS must be positive and WATCHDOG_COOLDOWN_MINUTES non-negative")
+	}
+	if w.RSSThresholdMB <= 0 && w.GoroutineThreshold <= 0 {
+		return fmt.Errorf("WATCHDOG_RSS_THRESHOLD_MB or WATCHDOG_GOROUTINE_THRESHOLD must be set when the watchdog is enabled")
+	}
+	return nil
+}
+
+func (c *Config) validatePreflightConfig() error {
+	for name, value := range map[string]string{
+		"PREFLIGHT_OBJECT_STORAGE": c.Preflight.ObjectStorage,
+		"PREFLIGHT_EVENT_BUS":      c.Preflight.EventBus,
+		"PREFLIGHT_WAREHOUSE":      c.Preflight.Warehouse,
+	} {
+		switch value {
+		case "", "fail", "warn", "skip":
+		default:
+			return fmt.Errorf("%s must be one of fail, warn or skip (got %q)", name, value)
+		}
+	}
+	if c.Preflight.TimeoutSeconds < 0 {
+		return fmt.Errorf("PREFLIGHT_TIMEOUT_SECONDS must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) validatePartnerAuditConfig() error {
+	a := c.PartnerAudit
+	if !a.Enabled {
+		return nil
+	}
+	if a.SampleRate <= 0 || a.SampleRate > 1 {
+		return fmt.Errorf("PARTNER_AUDIT_SAMPLE_RATE must be in (0, 1] (got %v)", a.SampleRate)
+	}
+	if a.RetentionDays < 1 {
+		return fmt.Errorf("PARTNER_AUDIT_RETENTION_DAYS must be positive")
+	}
 	return nil
 }
 
@@ -466,3 +1266,51 @@ func (c *Config) IsDevelopment() bool {
 func (c *Config) IsProduction() bool {
 	return c.Server.AppEnv == "production"
 }
+
+// IsInternalServerEnabled reports whether operational and admin endpoints are served on a separate port
+func (c *Config) IsInternalServerEnabled() bool {
+	return c.Server.InternalPort != ""
+}
+
+// IsWarehouseExportEnabled reports whether the analytics warehouse export should run
+func (c *Config) IsWarehouseExportEnabled() bool {
+	return c.Warehouse.Provider == "clickhouse"
+}
+
+// IsAirtableSyncConfigured reports whether changes can be pushed back to Airtable
+func (c *Config) IsAirtableSyncConfigured() bool {
+	return c.AirtableSync.BaseID != "" && c.AirtableSync.Token != ""
+}
+
+func (c *Config) validateNotificationConfig() error {
+	for channel, mode := range c.Notifications.QuietHoursChannels {
+		if mode != "digest" && mode != "individual" {
+			return fmt.Errorf("NOTIFICATION_QUIET_HOURS_CHANNELS: channel %q must be held as digest or individual (got %q)", channel, mode)
+		}
+	}
+	if len(c.Notifications.QuietHoursChannels) > 0 && c.Notifications.DeliveryIntervalSeconds <= 0 {
+		return fmt.Errorf("NOTIFICATION_DELIVERY_INTERVAL_SECONDS must be positive when channels are held during quiet hours")
+	}
+	return nil
+}
+
+// splitPairs parses a comma-separated list of key=value pairs; a pair without "=" gets an empty value
+func splitPairs(value string) map[string]string {
+	pairs := make(map[string]string)
+	for _, item := range splitList(value) {
+		key, val, _ := strings.Cut(item, "=")
+		pairs[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+	return pairs
+}
+
+// splitList parses a comma-separated list, dropping empty items
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
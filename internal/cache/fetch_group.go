@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"golang.org/x/sync/singleflight"
+)
+
+// fetchGroup coalesces concurrent fetches of the same key into one data source call.
+// Callers that arrive while a fetch is running wait for it and share its result.
+type fetchGroup struct {
+	group singleflight.Group
+
+	mu      sync.Mutex
+	started map[string]time.Time // start of the in-flight fetch of each key
+}
+
+// do runs fetch once per in-flight key; operation labels the coalesced calls metric
+func (g *fetchGroup) do(operation, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	return g.doSince(operation, key, time.Time{}, fetch)
+}
+
+// doSince is do for callers that must see every write committed before since: they only join
+// a fetch that started at or after it. An older fetch in flight is forgotten, so its callers
+// still get its result and later ones share a new fetch.
+func (g *fetchGroup) doSince(operation, key string, since time.Time, fetch func() (interface{}, error)) (interface{}, error) {
+	flightKey := operation + ":" + key
+
+	g.mu.Lock()
+	if start, ok := g.started[flightKey]; ok && start.Before(since) {
+		g.group.Forget(flightKey)
+		delete(g.started, flightKey)
+	}
+	g.mu.Unlock()
+
+	leader := false
+	value, err, _ := g.group.Do(flightKey, func() (interface{}, error) {
+		leader = true
+		// The start is read under the lock, so a caller that finds no fetch in flight only
+		// joins one starting after its own check
+		g.mu.Lock()
+		start := time.Now()
+		if g.started == nil {
+			g.started = map[string]time.Time{}
+		}
+		g.started[flightKey] = start
+		g.mu.Unlock()
+
+		defer func() {
+			g.mu.Lock()
+			if g.started[flightKey].Equal(start) {
+				delete(g.started, flightKey)
+			}
+			g.mu.Unlock()
+		}()
+		return fetch()
+	})
+	if !leader {
+		metrics.CacheCoalescedFetches.WithLabelValues(operation).Inc()
+	}
+	return value, err
+}
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// LeaderboardFetcher computes leaderboards for all configured periods, keyed by period
+type LeaderboardFetcher func(ctx context.Context) (map[string]*models.Leaderboard, error)
+
+// LeaderboardCache keeps precomputed leaderboards in memory and recomputes them on a schedule.
+// Requests never hit the database; until the first computation succeeds they get a miss.
+type LeaderboardCache struct {
+	fetcher      LeaderboardFetcher
+	interval     time.Duration
+	mu           sync.RWMutex
+	leaderboards map[string]*models.Leaderboard
+}
+
+// NewLeaderboardCache creates a new leaderboard cache refreshed every interval
+func NewLeaderboardCache(fetcher LeaderboardFetcher, interval time.Duration) *LeaderboardCache {
+	return &LeaderboardCache{
+		fetcher:      fetcher,
+		interval:     interval,
+		leaderboards: map[string]*models.Leaderboard{},
+	}
+}
+
+// Start computes the leaderboards in the background and schedules periodic recomputation.
+// Unlike the mentor cache it doesn't block startup: the leaderboard is not critical.
+func (lc *LeaderboardCache) Start() {
+	go func() {
+		if err := lc.refresh(); err != nil {
+			logger.Error("Initial leaderboard computation failed", zap.Error(err))
+		}
+		lc.schedulePeriodicRefresh()
+	}()
+}
+
+// Get returns the leaderboard for the period
+func (lc *LeaderboardCache) Get(period string) (*models.Leaderboard, error) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+
+	leaderboard, found := lc.leaderboards[period]
+	if !found {
+		metrics.CacheMisses.WithLabelValues("leaderboard").Inc()
+		return nil, fmt.Errorf("leaderboard for period %q is not computed yet", period)
+	}
+
+	metrics.CacheHits.WithLabelValues("leaderboard").Inc()
+	return leaderboard, nil
+}
+
+// RemoveMentor drops a mentor from all cached leaderboards without waiting for the next
+// recomputation, e.g. right after they opt out. Ranks of the remaining entries are kept.
+func (lc *LeaderboardCache) RemoveMentor(mentorID string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	for period, leaderboard := range lc.leaderboards {
+		entries := make([]*models.LeaderboardEntry, 0, len(leaderboard.Entries))
+		for _, entry := range leaderboard.Entries {
+			if entry.MentorID != mentorID {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == len(leaderboard.Entries) {
+			continue
+		}
+
+		updated := *leaderboard
+		updated.Entries = entries
+		lc.leaderboards[period] = &updated
+	}
+}
+
+// schedulePeriodicRefresh recomputes the leaderboards at a fixed interval
+func (lc *LeaderboardCache) schedulePeriodicRefresh() {
+	ticker := time.NewTicker(lc.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := lc.refresh(); err != nil {
+			logger.Error("Scheduled leaderboard computation failed", zap.Error(err))
+			// Keep serving the previous leaderboards - will retry on next tick
+		}
+	}
+}
+
+func (lc *LeaderboardCache) refresh() error {
+	startTime := time.Now()
+
+	leaderboards, err := lc.fetcher(context.Background())
+	if err != nil {
+		return err
+	}
+
+	lc.mu.Lock()
+	lc.leaderboards = leaderboards
+	lc.mu.Unlock()
+
+	metrics.CacheSize.WithLabelValues("leaderboard").Set(float64(len(leaderboards)))
+	logger.Info("Leaderboards computed",
+		zap.Int("periods", len(leaderboards)),
+		zap.Duration("duration", time.Since(startTime)))
+
+	return nil
+}
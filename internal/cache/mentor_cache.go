@@ -3,7 +3,9 @@ package cache
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/getmentor/getmentor-api/internal/models"
@@ -20,6 +22,10 @@ const (
 	cacheCheckPeriod = 10 * time.Second
 	maxRetries       = 3
 	initialRetryWait = 2 * time.Second
+	// maxRetryWait caps the wait between refreshes retried after starting from a snapshot
+	maxRetryWait = time.Minute
+	// indexCheckInterval is how often reads compare the stored revision with the indexes'
+	indexCheckInterval = time.Second
 )
 
 // MentorFetcher is a function that fetches all mentors from the data source
@@ -33,39 +39,113 @@ type CacheMetadata struct {
 	LastRefreshTime time.Time
 	MentorCount     int
 	Version         int64
+	// Revision changes with every write of the stored mentors or list, full or single, so
+	// replicas sharing a store know when to rebuild their indexes
+	Revision int64
 }
 
-// MentorCache manages the in-memory cache for mentors using slug-based storage
+// MentorCache manages the cache for mentors using slug-based storage, kept in a MentorStore.
+// The mentors and the all-mentors list live in the store, shared by the replicas when it is
+// Redis. The presorted orders, the filter indexes and lastSlugs are derived from them and kept
+// per process: every write bumps the stored metadata revision, and reads rebuild the indexes
+// from the stored list when the revision moved on since they were built.
 type MentorCache struct {
-	cache         *gocache.Cache
+	store         MentorStore
 	fetcher       MentorFetcher
 	singleFetcher SingleMentorFetcher
+	fetches       fetchGroup
 	mu            sync.RWMutex
-	refreshing    bool
-	ready         bool
-	ttl           time.Duration
-	lastRefresh   time.Time
+	// sorted holds the slugs in each presorted order, rebuilt whenever the mentor set changes
+	sorted map[models.MentorSort][]string
+	// sortedSeed is the daily shuffle seed the presorted orders were built with
+	sortedSeed string
+	// tagIndex and experienceIndex map a lowercased tag or experience level to the slugs of
+	// its mentors; rebuilt with the presorted orders and never modified in place
+	tagIndex        map[string]map[string]struct{}
+	experienceIndex map[string]map[string]struct{}
+	refreshing      bool
+	ready           bool
+	ttl             time.Duration
+	lastRefresh     time.Time
+	// lastSlugs is the last known all-mentors list, served while the stored one has expired
+	lastSlugs []string
+	// indexRevision is the stored revision the indexes and lastSlugs were built from, and
+	// indexCheckedAt when reads last compared it with the store
+	indexRevision  int64
+	indexCheckedAt time.Time
+	// staleSince is when reads started falling back to lastSlugs; zero while the data is fresh
+	staleSince time.Time
+	// lookups counts the hits and misses of each lookup for Status, next to the Prometheus counters
+	lookups map[string]*lookupCounts
+	// snapshotPath is where the cache is saved every snapshotInterval; empty when snapshots are off
+	snapshotPath     string
+	snapshotInterval time.Duration
+	// fromSnapshot is set while the cache serves the snapshot loaded at startup
+	fromSnapshot bool
+	// refreshPolicy spaces out the scheduled refreshes
+	refreshPolicy RefreshPolicy
+	// singleFetchedAt is when the stored single update of each slug started reading, so a
+	// slower, older fetch never replaces a newer one
+	singleFetchedAt map[string]time.Time
 }
 
-// NewMentorCache creates a new mentor cache with slug-based storage
+// singleFetch is a mentor read by fetchSingle and when the read started
+type singleFetch struct {
+	mentor    *models.Mentor
+	startedAt time.Time
+}
+
+// lookupCounts are the hits and misses of one lookup
+type lookupCounts struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// Lookups counted by the mentor cache
+var mentorCacheLookups = []string{"mentor_by_slug", "mentor_all", "mentor_sorted", "mentor_filtered"}
+
+// NewMentorCache creates a new in-memory mentor cache with slug-based storage
 func NewMentorCache(fetcher MentorFetcher, singleFetcher SingleMentorFetcher, ttlSeconds int) *MentorCache {
+	return NewMentorCacheWithStore(NewMemoryMentorStore(), fetcher, singleFetcher, ttlSeconds)
+}
+
+// NewMentorCacheWithStore creates a mentor cache kept in store
+func NewMentorCacheWithStore(store MentorStore, fetcher MentorFetcher, singleFetcher SingleMentorFetcher, ttlSeconds int) *MentorCache {
 	ttl := time.Duration(ttlSeconds) * time.Second
-	cache := gocache.New(gocache.NoExpiration, cacheCheckPeriod)
 
 	mc := &MentorCache{
-		cache:         cache,
+		store:         store,
 		fetcher:       fetcher,
 		singleFetcher: singleFetcher,
 		refreshing:    false,
 		ready:         false,
 		ttl:           ttl,
+		lookups:       make(map[string]*lookupCounts, len(mentorCacheLookups)),
+
+		singleFetchedAt: map[string]time.Time{},
+	}
+	for _, lookup := range mentorCacheLookups {
+		mc.lookups[lookup] = &lookupCounts{}
 	}
 
 	return mc
 }
 
+// EnableSnapshots saves the cache to path every interval, and lets Initialize start from that
+// file when the data source is unreachable. Call it before Initialize.
+func (mc *MentorCache) EnableSnapshots(path string, interval time.Duration) {
+	mc.snapshotPath, mc.snapshotInterval = path, interval
+}
+
+// SetRefreshPolicy sets how the scheduled refreshes are spaced out. Call it before Initialize.
+func (mc *MentorCache) SetRefreshPolicy(policy RefreshPolicy) {
+	mc.refreshPolicy = policy
+}
+
 // Initialize performs initial cache population (synchronous, blocks until ready)
-// Should be called during application startup before accepting requests
+// Should be called during application startup before accepting requests.
+// With snapshots enabled, a failed population falls back to the snapshot: its data is
+// served as stale while the refresh is retried in the background.
 func (mc *MentorCache) Initialize() error {
 	logger.Info("Initializing mentor cache...")
 	startTime := time.Now()
@@ -73,12 +153,20 @@ func (mc *MentorCache) Initialize() error {
 	err := mc.refreshWithRetry()
 	if err != nil {
 		logger.Error("Failed to initialize mentor cache", zap.Error(err))
-		return err
+		if mc.snapshotPath == "" {
+			return err
+		}
+		if snapshotErr := mc.loadSnapshot(); snapshotErr != nil {
+			return fmt.Errorf("%w; %w", err, snapshotErr)
+		}
+		go mc.retryUntilFresh()
 	}
 
 	mc.mu.Lock()
 	mc.ready = true
-	mc.lastRefresh = time.Now()
+	if err == nil {
+		mc.lastRefresh = time.Now()
+	}
 	mc.mu.Unlock()
 
 	duration := time.Since(startTime)
@@ -87,7 +175,78 @@ func (mc *MentorCache) Initialize() error {
 
 	// Start background refresh scheduler
 	go mc.schedulePeriodicRefresh()
+	if mc.snapshotPath != "" {
+		go mc.scheduleSnapshots()
+	}
+
+	return nil
+}
+
+// loadSnapshot populates the cache from the snapshot file and marks the data stale
+func (mc *MentorCache) loadSnapshot() error {
+	mentors, refreshedAt, err := ReadSnapshot(mc.snapshotPath)
+	if err != nil {
+		metrics.CacheSnapshots.WithLabelValues("load", "error").Inc()
+		logger.Error("Failed to load mentor cache snapshot", zap.String("path", mc.snapshotPath), zap.Error(err))
+		return err
+	}
+	mc.populateCache(mentors, refreshedAt)
+	metrics.CacheSnapshots.WithLabelValues("load", "success").Inc()
+
+	mc.mu.Lock()
+	mc.staleSince, mc.fromSnapshot = time.Now(), true
+	mc.lastRefresh = refreshedAt
+	mc.mu.Unlock()
 
+	logger.Warn("Data source unreachable, serving the mentor cache snapshot as stale data",
+		zap.String("path", mc.snapshotPath),
+		zap.Int("count", len(mentors)),
+		zap.Time("refreshed_at", refreshedAt))
+	return nil
+}
+
+// retryUntilFresh refreshes with a growing wait until the cache no longer serves stale data
+func (mc *MentorCache) retryUntilFresh() {
+	wait := initialRetryWait
+	for mc.IsStale() {
+		time.Sleep(wait)
+		if err := mc.refreshInBackground(); err != nil {
+			logger.Warn("Mentor cache still serving stale data, refresh failed",
+				zap.Duration("next_attempt_in", min(2*wait, maxRetryWait)),
+				zap.Error(err))
+		}
+		wait = min(2*wait, maxRetryWait)
+	}
+	logger.Info("Mentor cache refreshed from the data source")
+}
+
+// scheduleSnapshots saves the cache every snapshotInterval
+func (mc *MentorCache) scheduleSnapshots() {
+	ticker := time.NewTicker(mc.snapshotInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := mc.SaveSnapshot(); err != nil {
+			logger.Error("Failed to save mentor cache snapshot", zap.String("path", mc.snapshotPath), zap.Error(err))
+		}
+	}
+}
+
+// SaveSnapshot writes the listed mentors to the snapshot file. Stale data is not saved, so the
+// snapshot keeps the time its data was actually read.
+func (mc *MentorCache) SaveSnapshot() error {
+	mc.mu.RLock()
+	slugs, refreshedAt, stale := mc.lastSlugs, mc.lastRefresh, !mc.staleSince.IsZero()
+	mc.mu.RUnlock()
+	if stale || slugs == nil {
+		return nil
+	}
+
+	if err := WriteSnapshot(mc.snapshotPath, mc.store.GetMentors(slugs), refreshedAt); err != nil {
+		metrics.CacheSnapshots.WithLabelValues("save", "error").Inc()
+		return err
+	}
+	metrics.CacheSnapshots.WithLabelValues("save", "success").Inc()
 	return nil
 }
 
@@ -105,24 +264,15 @@ func (mc *MentorCache) GetBySlug(slug string) (*models.Mentor, error) {
 		return nil, fmt.Errorf("cache not initialized")
 	}
 
-	key := mentorKeyPrefix + slug
-
 	// Simple cache lookup - no fetch on miss
-	data, found := mc.cache.Get(key)
+	mentor, found := mc.store.GetMentor(slug)
 	if !found {
-		metrics.CacheMisses.WithLabelValues("mentor_by_slug").Inc()
+		mc.miss("mentor_by_slug")
 		logger.Debug("Mentor not found in cache", zap.String("slug", slug))
 		return nil, fmt.Errorf("mentor not found")
 	}
 
-	metrics.CacheHits.WithLabelValues("mentor_by_slug").Inc()
-
-	mentor, ok := data.(*models.Mentor)
-	if !ok {
-		logger.Error("Invalid cache data type", zap.String("slug", slug))
-		mc.cache.Delete(key)
-		return nil, fmt.Errorf("invalid cache data")
-	}
+	mc.hit("mentor_by_slug")
 
 	// Return immediately, even if data might be stale
 	return mentor, nil
@@ -135,37 +285,111 @@ func (mc *MentorCache) Get() ([]*models.Mentor, error) {
 		return nil, fmt.Errorf("cache not initialized")
 	}
 
-	// Get slug list
-	slugsData, found := mc.cache.Get(allMentorsKey)
+	// Fetch the mentors from cache, skipping missing ones rather than failing
+	return mc.store.GetMentors(mc.listSlugs()), nil
+}
+
+// listSlugs returns the all-mentors list in the curated order
+func (mc *MentorCache) listSlugs() []string {
+	slugs, found := mc.store.GetSlugs()
 	if !found {
-		// This should rarely happen - means cache expired
-		// Return empty rather than blocking
-		metrics.CacheMisses.WithLabelValues("mentor_all").Inc()
-		logger.Warn("All mentors list not in cache (expired), returning empty")
-		return []*models.Mentor{}, nil
+		// The list expired because refreshes keep failing (or one is just due): serve the last
+		// known list, marked stale, and revalidate in the background rather than blocking
+		mc.miss("mentor_all")
+		slugs = mc.markStale()
+		if slugs == nil {
+			logger.Warn("All mentors list not in cache (expired) and no earlier list, returning empty")
+			return []string{}
+		}
+		metrics.CacheStaleReads.WithLabelValues("mentor_all").Inc()
+		return slugs
 	}
 
-	slugs, ok := slugsData.([]string)
-	if !ok {
-		logger.Error("Invalid cache data type for all mentors list")
-		return []*models.Mentor{}, nil
+	mc.hit("mentor_all")
+	return slugs
+}
+
+// hit counts a cache hit of lookup
+func (mc *MentorCache) hit(lookup string) {
+	metrics.CacheHits.WithLabelValues(lookup).Inc()
+	mc.lookups[lookup].hits.Add(1)
+}
+
+// miss counts a cache miss of lookup
+func (mc *MentorCache) miss(lookup string) {
+	metrics.CacheMisses.WithLabelValues(lookup).Inc()
+	mc.lookups[lookup].misses.Add(1)
+}
+
+// Status describes the cache for operators. Backend and SharedVersion are left to the caller.
+func (mc *MentorCache) Status() *models.MentorCacheStatus {
+	status := &models.MentorCacheStatus{
+		TTLSeconds: int(mc.ttl / time.Second),
+		Lookups:    make(map[string]models.CacheLookupStats, len(mc.lookups)),
+	}
+	for lookup, counts := range mc.lookups {
+		status.Lookups[lookup] = models.NewCacheLookupStats(counts.hits.Load(), counts.misses.Load())
 	}
 
-	metrics.CacheHits.WithLabelValues("mentor_all").Inc()
+	if metadata, found := mc.store.GetMetadata(); found {
+		refreshed := metadata.LastRefreshTime
+		status.LastRefreshTime = &refreshed
+		status.AgeSeconds = time.Since(refreshed).Seconds()
+		status.Version = metadata.Version
+		status.MentorCount = metadata.MentorCount
+	}
+	slugs, found := mc.store.GetSlugs()
+	status.Expired = !found
 
-	// Fetch each mentor from cache
-	mentors := make([]*models.Mentor, 0, len(slugs))
-	for _, slug := range slugs {
-		mentor, err := mc.GetBySlug(slug)
-		if err != nil {
-			// Skip missing mentors rather than failing
-			logger.Debug("Mentor missing from cache", zap.String("slug", slug))
-			continue
-		}
-		mentors = append(mentors, mentor)
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	status.Ready = mc.ready
+	status.Refreshing = mc.refreshing
+	status.TagCount = len(mc.tagIndex)
+	if !found {
+		slugs = mc.lastSlugs
+	}
+	status.ListedCount = len(slugs)
+	if !mc.staleSince.IsZero() {
+		since := mc.staleSince
+		status.Stale, status.StaleSince = true, &since
 	}
+	status.FromSnapshot = mc.fromSnapshot
+	return status
+}
 
-	return mentors, nil
+// Staleness reports whether reads are served from the last known data because the stored list
+// expired, and since when
+func (mc *MentorCache) Staleness() (stale bool, since time.Time) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	return !mc.staleSince.IsZero(), mc.staleSince
+}
+
+// IsStale reports whether reads are served from the last known data
+func (mc *MentorCache) IsStale() bool {
+	stale, _ := mc.Staleness()
+	return stale
+}
+
+// markStale records that the stored list expired, starts a background refresh and returns
+// the last known list
+func (mc *MentorCache) markStale() []string {
+	mc.mu.Lock()
+	if mc.staleSince.IsZero() {
+		mc.staleSince = time.Now()
+		logger.Warn("All mentors list expired, serving the last known list while revalidating",
+			zap.Int("count", len(mc.lastSlugs)))
+	}
+	slugs := mc.lastSlugs
+	mc.mu.Unlock()
+
+	go func() {
+		if err := mc.refreshInBackground(); err != nil {
+			logger.Error("Revalidating stale mentor cache failed", zap.Error(err))
+		}
+	}()
+	return slugs
 }
 
 // UpdateSingleMentor updates ONE mentor in cache
@@ -177,27 +401,36 @@ func (mc *MentorCache) UpdateSingleMentor(slug string) error {
 
 	logger.Info("Updating single mentor in cache", zap.String("slug", slug))
 
-	// Fetch fresh data using the single mentor fetcher
-	mentor, err := mc.singleFetcher(context.Background(), slug)
+	// Fetch fresh data using the single mentor fetcher. The write that triggered the update has
+	// committed, so a fetch already in flight may have read the old row and is not joined.
+	fetched, err := mc.fetchSingle(slug, time.Now())
 	if err != nil {
 		logger.Error("Failed to fetch mentor",
 			zap.String("slug", slug),
 			zap.Error(err))
 		return err
 	}
+	mentor := fetched.mentor
 
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
 
+	if fetched.startedAt.Before(mc.singleFetchedAt[slug]) {
+		logger.Info("Newer mentor update already cached", zap.String("slug", slug))
+		return nil
+	}
+	mc.singleFetchedAt[slug] = fetched.startedAt
+
 	// Update the individual mentor cache entry (no expiration)
-	key := mentorKeyPrefix + slug
-	mc.cache.Set(key, mentor, gocache.NoExpiration)
+	mc.store.SetMentors([]*models.Mentor{mentor})
 
 	// Ensure slug is in the all-mentors list
 	if err := mc.ensureMentorInListLocked(slug); err != nil {
 		logger.Error("Failed to update all-mentors list", zap.Error(err))
 		// Non-fatal - mentor is still cached
 	}
+	mc.resortLocked()
+	mc.bumpRevisionLocked()
 
 	metrics.CacheSize.WithLabelValues("mentor_single_update").Inc()
 	logger.Info("Single mentor updated successfully", zap.String("slug", slug))
@@ -217,20 +450,15 @@ func (mc *MentorCache) RemoveMentor(slug string) error {
 	defer mc.mu.Unlock()
 
 	// Remove mentor entry
-	key := mentorKeyPrefix + slug
-	mc.cache.Delete(key)
+	mc.store.DeleteMentor(slug)
+	defer mc.bumpRevisionLocked()
 
 	// Remove from all-mentors list
-	slugsData, found := mc.cache.Get(allMentorsKey)
+	slugs, found := mc.store.GetSlugs()
 	if !found {
 		return nil // List expired
 	}
 
-	slugs, ok := slugsData.([]string)
-	if !ok {
-		return fmt.Errorf("invalid all-mentors list type")
-	}
-
 	// Filter out the slug
 	newSlugs := make([]string, 0, len(slugs))
 	for _, s := range slugs {
@@ -240,7 +468,9 @@ func (mc *MentorCache) RemoveMentor(slug string) error {
 	}
 
 	// Update list with remaining TTL
-	mc.cache.Set(allMentorsKey, newSlugs, mc.ttl)
+	mc.store.SetSlugs(newSlugs, mc.listTTL())
+	mc.lastSlugs = newSlugs
+	mc.resortLocked()
 
 	logger.Info("Mentor removed from cache", zap.String("slug", slug))
 	return nil
@@ -261,19 +491,39 @@ func (mc *MentorCache) ForceRefresh() ([]*models.Mentor, error) {
 	return mc.Get()
 }
 
-// schedulePeriodicRefresh runs background refresh at TTL intervals
-func (mc *MentorCache) schedulePeriodicRefresh() {
-	ticker := time.NewTicker(mc.ttl)
-	defer ticker.Stop()
+// Reload fetches all mentors and repopulates the cache before returning. Unlike the background
+// refresh it neither skips nor joins a fetch already in flight, so the data is at least as new
+// as the call.
+func (mc *MentorCache) Reload() error {
+	mentors, err := mc.fetcher(context.Background())
+	if err != nil {
+		return err
+	}
+	refreshedAt := time.Now()
+	mc.populateCache(mentors, refreshedAt)
 
-	for range ticker.C {
+	mc.mu.Lock()
+	mc.lastRefresh = refreshedAt
+	mc.mu.Unlock()
+	return nil
+}
+
+// schedulePeriodicRefresh runs background refresh about every TTL, as the refresh policy allows
+func (mc *MentorCache) schedulePeriodicRefresh() {
+	runRefreshLoop("mentors", mc.ttl, mc.refreshPolicy, func() error {
 		logger.Info("Starting scheduled cache refresh")
+		return mc.refreshInBackground()
+	})
+}
 
-		if err := mc.refreshInBackground(); err != nil {
-			logger.Error("Scheduled cache refresh failed", zap.Error(err))
-			// Don't stop the scheduler - will retry on next tick
-		}
+// listTTL is how long the stored all-mentors list lives. It outlasts the latest scheduled
+// refresh, so reads of an expired list don't refresh ahead of the jittered schedule, and
+// never expires while scheduled refreshes are disabled.
+func (mc *MentorCache) listTTL() time.Duration {
+	if mc.refreshPolicy.Disabled {
+		return gocache.NoExpiration
 	}
+	return mc.refreshPolicy.maxDelay(mc.ttl)
 }
 
 // refreshInBackground performs non-blocking background refresh
@@ -300,17 +550,18 @@ func (mc *MentorCache) refreshInBackground() error {
 	startTime := time.Now()
 
 	// Fetch all mentors
-	mentors, err := mc.fetcher(context.Background())
+	mentors, err := mc.fetchAll()
 	if err != nil {
 		logger.Error("Failed to fetch mentors in background refresh", zap.Error(err))
 		return err
 	}
 
 	// Update cache atomically
-	mc.populateCache(mentors)
+	refreshedAt := time.Now()
+	mc.populateCache(mentors, refreshedAt)
 
 	mc.mu.Lock()
-	mc.lastRefresh = time.Now()
+	mc.lastRefresh = refreshedAt
 	mc.mu.Unlock()
 
 	duration := time.Since(startTime)
@@ -337,7 +588,7 @@ func (mc *MentorCache) refreshWithRetry() error {
 		}
 
 		// Fetch all mentors
-		mentors, fetchErr := mc.fetcher(context.Background())
+		mentors, fetchErr := mc.fetchAll()
 		if fetchErr != nil {
 			err = fetchErr
 			logger.Error("Cache refresh attempt failed",
@@ -347,7 +598,7 @@ func (mc *MentorCache) refreshWithRetry() error {
 		}
 
 		// Populate cache
-		mc.populateCache(mentors)
+		mc.populateCache(mentors, time.Now())
 
 		return nil
 	}
@@ -355,81 +606,330 @@ func (mc *MentorCache) refreshWithRetry() error {
 	return fmt.Errorf("failed to refresh cache after %d attempts: %w", maxRetries, err)
 }
 
-// populateCache stores all mentors in cache with individual keys
-func (mc *MentorCache) populateCache(mentors []*models.Mentor) {
-	slugs := make([]string, 0, len(mentors))
-
-	for _, mentor := range mentors {
-		key := mentorKeyPrefix + mentor.Slug
+// fetchAll fetches all mentors, sharing the result with concurrent callers
+func (mc *MentorCache) fetchAll() ([]*models.Mentor, error) {
+	value, err := mc.fetches.do("mentor_all", "", func() (interface{}, error) {
+		return mc.fetcher(context.Background())
+	})
+	if err != nil {
+		return nil, err
+	}
+	mentors, _ := value.([]*models.Mentor) //nolint:errcheck // type assertion, the fetch returns mentors
+	return mentors, nil
+}
 
-		// Store each mentor individually with NO expiration
-		// Expiration is controlled at the "mentor:all" level
-		mc.cache.Set(key, mentor, gocache.NoExpiration)
+// fetchSingle fetches one mentor as of since, sharing a fetch with the concurrent refreshes of
+// the same slug that started after since
+func (mc *MentorCache) fetchSingle(slug string, since time.Time) (*singleFetch, error) {
+	value, err := mc.fetches.doSince("mentor_by_slug", slug, since, func() (interface{}, error) {
+		startedAt := time.Now()
+		mentor, err := mc.singleFetcher(context.Background(), slug)
+		if err != nil {
+			return nil, err
+		}
+		return &singleFetch{mentor: mentor, startedAt: startedAt}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	fetched, _ := value.(*singleFetch) //nolint:errcheck // type assertion, the fetch returns a singleFetch
+	return fetched, nil
+}
 
+// populateCache stores all mentors, read from the data source at refreshedAt, in cache with individual keys
+func (mc *MentorCache) populateCache(mentors []*models.Mentor, refreshedAt time.Time) {
+	slugs := make([]string, 0, len(mentors))
+	for _, mentor := range mentors {
 		slugs = append(slugs, mentor.Slug)
 	}
 
+	// Store each mentor individually with NO expiration
+	// Expiration is controlled at the "mentor:all" level
+	mc.store.SetMentors(mentors)
+
 	// Store slug list with TTL - this controls cache expiration
-	mc.cache.Set(allMentorsKey, slugs, mc.ttl)
+	mc.store.SetSlugs(slugs, mc.listTTL())
 
 	// Store metadata
-	mc.cache.Set(metadataKey, &CacheMetadata{
-		LastRefreshTime: time.Now(),
+	revision := time.Now().UnixNano()
+	mc.store.SetMetadata(&CacheMetadata{
+		LastRefreshTime: refreshedAt,
 		MentorCount:     len(mentors),
 		Version:         time.Now().Unix(),
-	}, gocache.NoExpiration)
+		Revision:        revision,
+	})
+
+	seed := models.DailyShuffleSeed(time.Now())
+	sorted := buildSortedIndexes(mentors, seed)
+	tagIndex, experienceIndex := buildFilterIndexes(mentors)
+	mc.mu.Lock()
+	mc.sorted, mc.sortedSeed = sorted, seed
+	mc.tagIndex, mc.experienceIndex = tagIndex, experienceIndex
+	mc.lastSlugs, mc.staleSince, mc.fromSnapshot = slugs, time.Time{}, false
+	mc.indexRevision, mc.indexCheckedAt = revision, time.Now()
+	mc.mu.Unlock()
 
 	metrics.CacheSize.WithLabelValues("mentors").Set(float64(len(mentors)))
 
 	logger.Info("Cache populated successfully", zap.Int("count", len(mentors)))
 }
 
+// GetSorted returns all mentors from cache in a presorted order (see models.PresortedMentorSorts).
+// Other orders return the curated order, like Get.
+func (mc *MentorCache) GetSorted(sortBy models.MentorSort) ([]*models.Mentor, error) {
+	if !mc.IsReady() {
+		return nil, fmt.Errorf("cache not initialized")
+	}
+
+	slugs, ok := mc.sortedSlugs(sortBy)
+	if !ok {
+		return mc.Get()
+	}
+
+	mentors := mc.store.GetMentors(slugs)
+	mc.hit("mentor_sorted")
+	return mentors, nil
+}
+
+// GetByTag returns the mentors with the tag (case-insensitive) in the curated order
+func (mc *MentorCache) GetByTag(tag string) ([]*models.Mentor, error) {
+	return mc.GetFiltered(models.MentorSortDefault, []string{tag}, "")
+}
+
+// GetByExperience returns the mentors with the experience level (case-insensitive) in the curated order
+func (mc *MentorCache) GetByExperience(experience string) ([]*models.Mentor, error) {
+	return mc.GetFiltered(models.MentorSortDefault, nil, experience)
+}
+
+// GetFiltered returns the mentors with any of tags and the experience level, looked up in the
+// indexes built at populate time, in a presorted order or else the curated one. Empty filters
+// match every mentor.
+func (mc *MentorCache) GetFiltered(sortBy models.MentorSort, tags []string, experience string) ([]*models.Mentor, error) {
+	if !mc.IsReady() {
+		return nil, fmt.Errorf("cache not initialized")
+	}
+
+	mc.syncIndexes()
+	mc.mu.RLock()
+	matches, filtered := mc.matchingSlugsLocked(tags, experience)
+	mc.mu.RUnlock()
+
+	slugs, ok := mc.sortedSlugs(sortBy)
+	if !ok {
+		slugs = mc.listSlugs()
+	}
+	if filtered {
+		picked := make([]string, 0, len(matches))
+		for _, slug := range slugs {
+			if _, found := matches[slug]; found {
+				picked = append(picked, slug)
+			}
+		}
+		slugs = picked
+	}
+
+	mc.hit("mentor_filtered")
+	return mc.store.GetMentors(slugs), nil
+}
+
+// sortedSlugs returns the slugs in a presorted order, reshuffling the daily order when the day
+// rolled over since the last refresh; ok is false for orders that are not presorted
+func (mc *MentorCache) sortedSlugs(sortBy models.MentorSort) ([]string, bool) {
+	mc.syncIndexes()
+	mc.mu.RLock()
+	slugs, ok := mc.sorted[sortBy]
+	stale := sortBy == models.MentorSortDailyShuffle && mc.sortedSeed != models.DailyShuffleSeed(time.Now())
+	mc.mu.RUnlock()
+	if ok && stale {
+		mc.mu.Lock()
+		mc.resortLocked()
+		slugs = mc.sorted[sortBy]
+		mc.mu.Unlock()
+	}
+	return slugs, ok
+}
+
+// matchingSlugsLocked intersects the union of the tags' slugs with the experience level's slugs.
+// filtered is false when neither filter is set. MUST be called with mc.mu locked for reading
+func (mc *MentorCache) matchingSlugsLocked(tags []string, experience string) (matches map[string]struct{}, filtered bool) {
+	var sets []map[string]struct{}
+	if len(tags) > 0 {
+		union := map[string]struct{}{}
+		for _, tag := range tags {
+			for slug := range mc.tagIndex[normalizeIndexKey(tag)] {
+				union[slug] = struct{}{}
+			}
+		}
+		sets = append(sets, union)
+	}
+	if experience = normalizeIndexKey(experience); experience != "" {
+		sets = append(sets, mc.experienceIndex[experience])
+	}
+	if len(sets) == 0 {
+		return nil, false
+	}
+	if len(sets) == 1 {
+		return sets[0], true
+	}
+
+	small, large := sets[0], sets[1]
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	matches = make(map[string]struct{}, len(small))
+	for slug := range small {
+		if _, found := large[slug]; found {
+			matches[slug] = struct{}{}
+		}
+	}
+	return matches, true
+}
+
+// resortLocked rebuilds the presorted orders after a single mentor was updated or removed.
+// MUST be called with mc.mu locked
+func (mc *MentorCache) resortLocked() {
+	slugs, found := mc.store.GetSlugs()
+	if !found {
+		return
+	}
+
+	mentors := mc.store.GetMentors(slugs)
+	mc.sortedSeed = models.DailyShuffleSeed(time.Now())
+	mc.sorted = buildSortedIndexes(mentors, mc.sortedSeed)
+	mc.tagIndex, mc.experienceIndex = buildFilterIndexes(mentors)
+}
+
+// bumpRevisionLocked records in the store that a mentor or the list changed, so the other
+// replicas rebuild their indexes. MUST be called with mc.mu locked
+func (mc *MentorCache) bumpRevisionLocked() {
+	metadata, found := mc.store.GetMetadata()
+	if !found {
+		metadata = &CacheMetadata{}
+	}
+	metadata.Revision = time.Now().UnixNano()
+	mc.store.SetMetadata(metadata)
+	mc.indexRevision = metadata.Revision
+}
+
+// syncIndexes rebuilds the indexes and lastSlugs from the stored list when another replica
+// changed the stored mentors since they were built. The store is checked at most every
+// indexCheckInterval, so a change reaches the indexes of the other replicas within it.
+func (mc *MentorCache) syncIndexes() {
+	mc.mu.RLock()
+	due := time.Since(mc.indexCheckedAt) >= indexCheckInterval
+	mc.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	metadata, found := mc.store.GetMetadata()
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.indexCheckedAt = time.Now()
+	if !found || metadata.Revision == mc.indexRevision {
+		return
+	}
+	if slugs, listed := mc.store.GetSlugs(); listed {
+		mc.lastSlugs = slugs
+	}
+	mc.resortLocked()
+	mc.indexRevision = metadata.Revision
+	metrics.CacheIndexRebuilds.Inc()
+	logger.Debug("Mentor cache indexes rebuilt after a change by another replica",
+		zap.Int64("revision", metadata.Revision))
+}
+
+// buildFilterIndexes maps every tag and experience level to the slugs of its mentors
+func buildFilterIndexes(mentors []*models.Mentor) (tags, experience map[string]map[string]struct{}) {
+	tags = map[string]map[string]struct{}{}
+	experience = map[string]map[string]struct{}{}
+	add := func(index map[string]map[string]struct{}, key, slug string) {
+		if key = normalizeIndexKey(key); key == "" {
+			return
+		}
+		if index[key] == nil {
+			index[key] = map[string]struct{}{}
+		}
+		index[key][slug] = struct{}{}
+	}
+	for _, mentor := range mentors {
+		for _, tag := range mentor.Tags {
+			add(tags, tag, mentor.Slug)
+		}
+		add(experience, mentor.Experience, mentor.Slug)
+	}
+	return tags, experience
+}
+
+func normalizeIndexKey(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+// buildSortedIndexes computes the slug order of every presorted ordering
+func buildSortedIndexes(mentors []*models.Mentor, dailySeed string) map[models.MentorSort][]string {
+	indexes := make(map[models.MentorSort][]string, len(models.PresortedMentorSorts))
+	ordered := make([]*models.Mentor, len(mentors))
+	for _, sortBy := range models.PresortedMentorSorts {
+		copy(ordered, mentors)
+		models.SortMentors(ordered, sortBy, dailySeed)
+
+		slugs := make([]string, len(ordered))
+		for i, mentor := range ordered {
+			slugs[i] = mentor.Slug
+		}
+		indexes[sortBy] = slugs
+	}
+	return indexes
+}
+
 // ensureMentorInListLocked ensures slug is in all-mentors list
 // MUST be called with mc.mu locked
 func (mc *MentorCache) ensureMentorInListLocked(slug string) error {
-	slugsData, found := mc.cache.Get(allMentorsKey)
+	slugs, found := mc.store.GetSlugs()
 	if !found {
-		// List expired - will be recreated on next full refresh
+		// List expired - will be recreated on next full refresh; keep the mentor in the list
+		// served meanwhile
 		logger.Debug("All-mentors list not found, skipping update")
+		if mc.lastSlugs != nil && !containsSlug(mc.lastSlugs, slug) {
+			mc.lastSlugs = append(append([]string{}, mc.lastSlugs...), slug)
+		}
 		return nil
 	}
 
-	slugs, ok := slugsData.([]string)
-	if !ok {
-		return fmt.Errorf("invalid all-mentors list type")
-	}
-
 	// Check if slug already exists
-	for _, s := range slugs {
-		if s == slug {
-			return nil // Already in list
-		}
+	if containsSlug(slugs, slug) {
+		return nil // Already in list
 	}
 
 	// Add to list (preserve TTL)
 	slugs = append(slugs, slug)
-	mc.cache.Set(allMentorsKey, slugs, mc.ttl)
+	mc.store.SetSlugs(slugs, mc.listTTL())
+	mc.lastSlugs = slugs
 
 	return nil
 }
 
+func containsSlug(slugs []string, slug string) bool {
+	for _, s := range slugs {
+		if s == slug {
+			return true
+		}
+	}
+	return false
+}
+
 // Clear clears the entire cache
 func (mc *MentorCache) Clear() {
-	mc.cache.Flush()
+	mc.store.Flush()
 	logger.Info("Mentor cache cleared")
 }
 
 // GetMetadata returns cache metadata
 func (mc *MentorCache) GetMetadata() (*CacheMetadata, error) {
-	data, found := mc.cache.Get(metadataKey)
+	metadata, found := mc.store.GetMetadata()
 	if !found {
 		return nil, fmt.Errorf("metadata not found")
 	}
 
-	metadata, ok := data.(*CacheMetadata)
-	if !ok {
-		return nil, fmt.Errorf("invalid metadata type")
-	}
-
 	return metadata, nil
 }
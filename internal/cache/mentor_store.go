@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const (
+	// BackendMemory keeps the mentor cache in the process
+	BackendMemory = "memory"
+	// BackendRedis keeps the mentor cache in Redis, shared by all replicas
+	BackendRedis = "redis"
+)
+
+// MentorStore holds the mentors of a MentorCache, the all-mentors slug list (the only entry
+// with a TTL) and the cache metadata. Reads that fail are reported as misses.
+type MentorStore interface {
+	GetMentor(slug string) (*models.Mentor, bool)
+	// GetMentors returns the stored mentors of slugs in order, skipping missing ones
+	GetMentors(slugs []string) []*models.Mentor
+	SetMentors(mentors []*models.Mentor)
+	DeleteMentor(slug string)
+	GetSlugs() ([]string, bool)
+	SetSlugs(slugs []string, ttl time.Duration)
+	GetMetadata() (*CacheMetadata, bool)
+	SetMetadata(metadata *CacheMetadata)
+	Flush()
+}
+
+// memoryMentorStore keeps the mentors in a go-cache local to the replica
+type memoryMentorStore struct {
+	cache *gocache.Cache
+}
+
+// NewMemoryMentorStore creates the in-process mentor store
+func NewMemoryMentorStore() MentorStore {
+	return &memoryMentorStore{cache: gocache.New(gocache.NoExpiration, cacheCheckPeriod)}
+}
+
+func (s *memoryMentorStore) GetMentor(slug string) (*models.Mentor, bool) {
+	data, found := s.cache.Get(mentorKeyPrefix + slug)
+	if !found {
+		return nil, false
+	}
+	mentor, ok := data.(*models.Mentor)
+	return mentor, ok
+}
+
+func (s *memoryMentorStore) GetMentors(slugs []string) []*models.Mentor {
+	mentors := make([]*models.Mentor, 0, len(slugs))
+	for _, slug := range slugs {
+		if mentor, found := s.GetMentor(slug); found {
+			mentors = append(mentors, mentor)
+		}
+	}
+	return mentors
+}
+
+func (s *memoryMentorStore) SetMentors(mentors []*models.Mentor) {
+	for _, mentor := range mentors {
+		// No expiration: expiry is controlled by the slug list
+		s.cache.Set(mentorKeyPrefix+mentor.Slug, mentor, gocache.NoExpiration)
+	}
+}
+
+func (s *memoryMentorStore) DeleteMentor(slug string) {
+	s.cache.Delete(mentorKeyPrefix + slug)
+}
+
+func (s *memoryMentorStore) GetSlugs() ([]string, bool) {
+	data, found := s.cache.Get(allMentorsKey)
+	if !found {
+		return nil, false
+	}
+	slugs, ok := data.([]string)
+	return slugs, ok
+}
+
+func (s *memoryMentorStore) SetSlugs(slugs []string, ttl time.Duration) {
+	s.cache.Set(allMentorsKey, slugs, ttl)
+}
+
+func (s *memoryMentorStore) GetMetadata() (*CacheMetadata, bool) {
+	data, found := s.cache.Get(metadataKey)
+	if !found {
+		return nil, false
+	}
+	metadata, ok := data.(*CacheMetadata)
+	return metadata, ok
+}
+
+func (s *memoryMentorStore) SetMetadata(metadata *CacheMetadata) {
+	s.cache.Set(metadataKey, metadata, gocache.NoExpiration)
+}
+
+func (s *memoryMentorStore) Flush() {
+	s.cache.Flush()
+}
@@ -0,0 +1,219 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/redis"
+	"go.uber.org/zap"
+)
+
+const (
+	redisStoreTimeout = 5 * time.Second
+	// redisMSetBatch bounds the mentors written by one MSET
+	redisMSetBatch = 200
+)
+
+// storedMentor is the JSON stored for a mentor in Redis and in snapshots. It carries the
+// internal fields that models.Mentor leaves out of its JSON.
+type storedMentor struct {
+	*models.Mentor
+	AirtableID     *string   `json:"airtableId,omitempty"`
+	TelegramChatID *int64    `json:"telegramChatId,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+func newStoredMentor(mentor *models.Mentor) storedMentor {
+	return storedMentor{
+		Mentor:         mentor,
+		AirtableID:     mentor.AirtableID,
+		TelegramChatID: mentor.TelegramChatID,
+		CreatedAt:      mentor.CreatedAt,
+	}
+}
+
+// mentor restores the internal fields on the decoded mentor; nil when there was none
+func (s storedMentor) mentor() *models.Mentor {
+	if s.Mentor == nil {
+		return nil
+	}
+	mentor := s.Mentor
+	mentor.AirtableID, mentor.TelegramChatID, mentor.CreatedAt = s.AirtableID, s.TelegramChatID, s.CreatedAt
+	return mentor
+}
+
+// redisMentorStore keeps the mentors in Redis as JSON, so every replica reads the same data
+// and a single-mentor update made by one replica is seen by all of them at once
+type redisMentorStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisMentorStore creates a mentor store on client; keyPrefix namespaces its keys
+func NewRedisMentorStore(client *redis.Client, keyPrefix string) MentorStore {
+	return &redisMentorStore{client: client, prefix: keyPrefix}
+}
+
+func (s *redisMentorStore) GetMentor(slug string) (*models.Mentor, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	data, found, err := s.client.Get(ctx, s.prefix+mentorKeyPrefix+slug)
+	if err != nil {
+		s.fail("get_mentor", err)
+		return nil, false
+	}
+	if !found {
+		return nil, false
+	}
+	return s.decodeMentor(data)
+}
+
+func (s *redisMentorStore) GetMentors(slugs []string) []*models.Mentor {
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	keys := make([]string, len(slugs))
+	for i, slug := range slugs {
+		keys[i] = s.prefix + mentorKeyPrefix + slug
+	}
+	values, err := s.client.MGet(ctx, keys...)
+	if err != nil {
+		s.fail("get_mentors", err)
+		return []*models.Mentor{}
+	}
+
+	mentors := make([]*models.Mentor, 0, len(values))
+	for _, data := range values {
+		if data == nil {
+			continue
+		}
+		if mentor, ok := s.decodeMentor(data); ok {
+			mentors = append(mentors, mentor)
+		}
+	}
+	return mentors
+}
+
+func (s *redisMentorStore) SetMentors(mentors []*models.Mentor) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	pairs := make([]string, 0, 2*redisMSetBatch)
+	for i, mentor := range mentors {
+		data, err := json.Marshal(newStoredMentor(mentor))
+		if err != nil {
+			s.fail("set_mentors", err)
+			continue
+		}
+		pairs = append(pairs, s.prefix+mentorKeyPrefix+mentor.Slug, string(data))
+		if len(pairs) == 2*redisMSetBatch || i == len(mentors)-1 {
+			if err := s.client.MSet(ctx, pairs...); err != nil {
+				s.fail("set_mentors", err)
+				return
+			}
+			pairs = pairs[:0]
+		}
+	}
+}
+
+func (s *redisMentorStore) DeleteMentor(slug string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	if _, err := s.client.Del(ctx, s.prefix+mentorKeyPrefix+slug); err != nil {
+		s.fail("delete_mentor", err)
+	}
+}
+
+func (s *redisMentorStore) GetSlugs() ([]string, bool) {
+	var slugs []string
+	if !s.getJSON("get_slugs", allMentorsKey, &slugs) {
+		return nil, false
+	}
+	return slugs, true
+}
+
+func (s *redisMentorStore) SetSlugs(slugs []string, ttl time.Duration) {
+	s.setJSON("set_slugs", allMentorsKey, slugs, ttl)
+}
+
+func (s *redisMentorStore) GetMetadata() (*CacheMetadata, bool) {
+	var metadata CacheMetadata
+	if !s.getJSON("get_metadata", metadataKey, &metadata) {
+		return nil, false
+	}
+	return &metadata, true
+}
+
+func (s *redisMentorStore) SetMetadata(metadata *CacheMetadata) {
+	s.setJSON("set_metadata", metadataKey, metadata, 0)
+}
+
+// Flush removes the listed mentors, the list and the metadata. Mentors dropped from the list
+// earlier are left to be overwritten, since Redis is shared with other data.
+func (s *redisMentorStore) Flush() {
+	slugs, _ := s.GetSlugs()
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	keys := []string{s.prefix + allMentorsKey, s.prefix + metadataKey}
+	for _, slug := range slugs {
+		keys = append(keys, s.prefix+mentorKeyPrefix+slug)
+	}
+	if _, err := s.client.Del(ctx, keys...); err != nil {
+		s.fail("flush", err)
+	}
+}
+
+func (s *redisMentorStore) getJSON(operation, key string, target interface{}) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+
+	data, found, err := s.client.Get(ctx, s.prefix+key)
+	if err != nil {
+		s.fail(operation, err)
+		return false
+	}
+	if !found {
+		return false
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		s.fail(operation, err)
+		return false
+	}
+	return true
+}
+
+func (s *redisMentorStore) setJSON(operation, key string, value interface{}, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		s.fail(operation, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisStoreTimeout)
+	defer cancel()
+	if err := s.client.Set(ctx, s.prefix+key, data, ttl); err != nil {
+		s.fail(operation, err)
+	}
+}
+
+func (s *redisMentorStore) decodeMentor(data []byte) (*models.Mentor, bool) {
+	var stored storedMentor
+	if err := json.Unmarshal(data, &stored); err != nil || stored.Mentor == nil {
+		s.fail("decode_mentor", err)
+		return nil, false
+	}
+	return stored.mentor(), true
+}
+
+func (s *redisMentorStore) fail(operation string, err error) {
+	metrics.CacheStoreErrors.WithLabelValues(BackendRedis, operation).Inc()
+	logger.Warn("Redis mentor store operation failed", zap.String("operation", operation), zap.Error(err))
+}
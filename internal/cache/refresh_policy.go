@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// RefreshPolicy spaces out the scheduled refreshes of the caches, so replicas started together
+// don't hit the data source at the same moment and a failing source isn't retried every TTL
+type RefreshPolicy struct {
+	// Jitter moves each refresh by up to this fraction of the interval, earlier or later
+	Jitter float64
+	// MaxBackoff caps the interval, doubled after each consecutive failed refresh; zero (or
+	// anything under the interval) keeps refreshing every interval
+	MaxBackoff time.Duration
+	// Disabled stops the scheduled refreshes; the caches still load at startup and on demand
+	Disabled bool
+}
+
+// Delay returns how long to wait for the next refresh after failures consecutive failed ones
+func (p RefreshPolicy) Delay(interval time.Duration, failures int) time.Duration {
+	delay := interval
+	for i := 0; i < failures && delay < p.MaxBackoff; i++ {
+		delay = min(2*delay, p.MaxBackoff)
+	}
+	if p.Jitter <= 0 {
+		return delay
+	}
+	//nolint:gosec // G404: jitter doesn't need a cryptographic source
+	return time.Duration(float64(delay) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
+
+// maxDelay is the longest Delay after a successful refresh
+func (p RefreshPolicy) maxDelay(interval time.Duration) time.Duration {
+	return time.Duration(float64(interval) * (1 + max(p.Jitter, 0)))
+}
+
+// Export publishes the policy as Prometheus gauges
+func (p RefreshPolicy) Export() {
+	disabled := 0.0
+	if p.Disabled {
+		disabled = 1
+	}
+	metrics.CacheRefreshPolicy.WithLabelValues("jitter_ratio").Set(p.Jitter)
+	metrics.CacheRefreshPolicy.WithLabelValues("max_backoff_seconds").Set(p.MaxBackoff.Seconds())
+	metrics.CacheRefreshPolicy.WithLabelValues("disabled").Set(disabled)
+}
+
+// runRefreshLoop calls refresh of the named cache after each Delay until the process exits
+func runRefreshLoop(name string, interval time.Duration, policy RefreshPolicy, refresh func() error) {
+	if policy.Disabled {
+		logger.Warn("Scheduled cache refresh disabled", zap.String("cache", name))
+		return
+	}
+
+	failures := 0
+	for {
+		delay := policy.Delay(interval, failures)
+		metrics.CacheRefreshDelay.WithLabelValues(name).Set(delay.Seconds())
+		time.Sleep(delay)
+
+		if err := refresh(); err != nil {
+			failures++
+			logger.Error("Scheduled cache refresh failed",
+				zap.String("cache", name),
+				zap.Int("consecutive_failures", failures),
+				zap.Error(err))
+		} else {
+			failures = 0
+		}
+		metrics.CacheRefreshFailures.WithLabelValues(name).Set(float64(failures))
+	}
+}
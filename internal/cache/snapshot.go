@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+)
+
+// snapshotFormatVersion is bumped when the snapshot layout changes; files of other versions are ignored
+const snapshotFormatVersion = 1
+
+// mentorSnapshot is the file a MentorCache is saved to
+type mentorSnapshot struct {
+	FormatVersion int `json:"formatVersion"`
+	// RefreshedAt is when the mentors were read from the database
+	RefreshedAt time.Time      `json:"refreshedAt"`
+	Mentors     []storedMentor `json:"mentors"`
+}
+
+// WriteSnapshot saves mentors, read from the database at refreshedAt, to path as JSON. The file
+// is written next to path and renamed over it, so readers never see a partial snapshot.
+func WriteSnapshot(path string, mentors []*models.Mentor, refreshedAt time.Time) error {
+	snapshot := mentorSnapshot{
+		FormatVersion: snapshotFormatVersion,
+		RefreshedAt:   refreshedAt.UTC(),
+		Mentors:       make([]storedMentor, 0, len(mentors)),
+	}
+	for _, mentor := range mentors {
+		snapshot.Mentors = append(snapshot.Mentors, newStoredMentor(mentor))
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode mentor cache snapshot: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create mentor cache snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // already renamed on success
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close() //nolint:errcheck // the write error is reported
+		return fmt.Errorf("failed to write mentor cache snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close() //nolint:errcheck // the sync error is reported
+		return fmt.Errorf("failed to write mentor cache snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write mentor cache snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace mentor cache snapshot: %w", err)
+	}
+	return nil
+}
+
+// ReadSnapshot loads the mentors saved by WriteSnapshot and when they were read from the database
+func ReadSnapshot(path string) ([]*models.Mentor, time.Time, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read mentor cache snapshot: %w", err)
+	}
+
+	var snapshot mentorSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decode mentor cache snapshot: %w", err)
+	}
+	if snapshot.FormatVersion != snapshotFormatVersion {
+		return nil, time.Time{}, fmt.Errorf("unsupported mentor cache snapshot format %d", snapshot.FormatVersion)
+	}
+
+	mentors := make([]*models.Mentor, 0, len(snapshot.Mentors))
+	for _, stored := range snapshot.Mentors {
+		if mentor := stored.mentor(); mentor != nil {
+			mentors = append(mentors, mentor)
+		}
+	}
+	return mentors, snapshot.RefreshedAt, nil
+}
@@ -11,33 +11,41 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	tagsCacheKey = "tags"
-	tagsCacheTTL = 24 * time.Hour
-)
+const tagsCacheKey = "tags"
 
 // TagsFetcher is a function that fetches all tags from the data source
 type TagsFetcher func(ctx context.Context) (map[string]string, error)
 
-// TagsCache manages the in-memory cache for tags
+// TagsCache manages the in-memory cache for tags. Like MentorCache it is reloaded in the
+// background every TTL and keeps the last tags it had when a reload fails.
 type TagsCache struct {
-	cache   *gocache.Cache
-	fetcher TagsFetcher
-	mu      sync.RWMutex
-	ready   bool
+	cache     *gocache.Cache
+	fetcher   TagsFetcher
+	fetches   fetchGroup
+	ttl       time.Duration
+	policy    RefreshPolicy
+	mu        sync.RWMutex
+	ready     bool
+	scheduled bool
 }
 
-// NewTagsCache creates a new tags cache
-func NewTagsCache(fetcher TagsFetcher) *TagsCache {
-	cache := gocache.New(tagsCacheTTL, time.Hour)
+// NewTagsCache creates a new tags cache reloaded every ttlSeconds; 0 loads the tags only once
+func NewTagsCache(fetcher TagsFetcher, ttlSeconds int) *TagsCache {
+	cache := gocache.New(gocache.NoExpiration, time.Hour)
 
 	return &TagsCache{
 		cache:   cache,
 		fetcher: fetcher,
+		ttl:     time.Duration(ttlSeconds) * time.Second,
 		ready:   false,
 	}
 }
 
+// SetRefreshPolicy sets how the scheduled reloads are spaced out. Call it before Initialize.
+func (tc *TagsCache) SetRefreshPolicy(policy RefreshPolicy) {
+	tc.policy = policy
+}
+
 // Initialize performs initial cache population (synchronous, blocks until ready)
 // Should be called during application startup before accepting requests
 func (tc *TagsCache) Initialize() error {
@@ -50,12 +58,46 @@ func (tc *TagsCache) Initialize() error {
 
 	tc.mu.Lock()
 	tc.ready = true
+	schedule := !tc.scheduled && tc.ttl > 0
+	tc.scheduled = tc.scheduled || schedule
 	tc.mu.Unlock()
 
 	logger.Info("Tags cache initialized successfully")
+
+	// Start background refresh scheduler
+	if schedule {
+		go tc.schedulePeriodicRefresh()
+	}
 	return nil
 }
 
+// Reload fetches the tags from the data source now, e.g. after they were changed
+func (tc *TagsCache) Reload() error {
+	_, err := tc.refresh()
+	return err
+}
+
+// schedulePeriodicRefresh reloads the tags about every TTL, as the refresh policy allows; a
+// failed reload keeps the last tags
+func (tc *TagsCache) schedulePeriodicRefresh() {
+	runRefreshLoop("tags", tc.ttl, tc.policy, func() error {
+		_, err := tc.refresh()
+		return err
+	})
+}
+
+// InitializeInBackground retries Initialize with exponential backoff until it succeeds. It is
+// used when the API starts from the mentor cache snapshot while the database is down.
+func (tc *TagsCache) InitializeInBackground() {
+	go func() {
+		wait := initialRetryWait
+		for tc.Initialize() != nil {
+			time.Sleep(wait)
+			wait = min(2*wait, maxRetryWait)
+		}
+	}()
+}
+
 // IsReady returns true if the cache has been successfully initialized
 func (tc *TagsCache) IsReady() bool {
 	tc.mu.RLock()
@@ -89,14 +131,17 @@ func (tc *TagsCache) Get() (map[string]string, error) {
 
 // refresh fetches tags from the data source and updates the cache
 func (tc *TagsCache) refresh() (map[string]string, error) {
-	tags, err := tc.fetcher(context.Background())
+	value, err := tc.fetches.do("tags", "", func() (interface{}, error) {
+		return tc.fetcher(context.Background())
+	})
 	if err != nil {
 		logger.Error("Failed to refresh tags cache", zap.Error(err))
 		return nil, err
 	}
+	tags, _ := value.(map[string]string) //nolint:errcheck // type assertion, the fetch returns tags
 
 	// Update cache
-	tc.cache.Set(tagsCacheKey, tags, tagsCacheTTL)
+	tc.cache.Set(tagsCacheKey, tags, gocache.NoExpiration)
 
 	logger.Info("Tags cache refreshed", zap.Int("count", len(tags)))
 
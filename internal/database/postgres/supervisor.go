@@ -0,0 +1,127 @@
+// Package postgres supervises the health of the PostgreSQL connection pool.
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// Pinger is the part of the connection pool the supervisor needs; *pgxpool.Pool implements it
+type Pinger interface {
+	Ping(ctx context.Context) error
+	Reset()
+}
+
+// SupervisorConfig configures the connection health supervisor
+type SupervisorConfig struct {
+	Interval         time.Duration
+	PingTimeout      time.Duration
+	FailureThreshold int // consecutive failed pings before the database is marked not ready
+	// Unreachable is set for a pool opened while the database was down: the supervisor starts
+	// not ready, until a ping succeeds
+	Unreachable bool
+}
+
+// Supervisor pings the database periodically. After FailureThreshold failed pings in a row it
+// marks the database not ready and resets the pool, so connections broken by a database restart
+// are dropped and new ones are dialled once it is back. The reset is repeated every
+// FailureThreshold failures until a ping succeeds. Readiness is published as the db_client_ready
+// gauge and read by the healthcheck.
+type Supervisor struct {
+	pool     Pinger
+	cfg      SupervisorConfig
+	ready    atomic.Bool
+	failures int
+}
+
+// NewSupervisor creates a supervisor for a pool that has just been connected, so it starts ready
+// unless cfg.Unreachable is set
+func NewSupervisor(pool Pinger, cfg SupervisorConfig) *Supervisor {
+	if cfg.FailureThreshold < 1 {
+		cfg.FailureThreshold = 1
+	}
+	s := &Supervisor{pool: pool, cfg: cfg}
+	s.ready.Store(!cfg.Unreachable)
+	if metrics.DBReady != nil {
+		value := 1.0
+		if cfg.Unreachable {
+			value = 0
+		}
+		metrics.DBReady.Set(value)
+	}
+	return s
+}
+
+// Start runs the ping loop in the background
+func (s *Supervisor) Start() {
+	logger.Info("Database supervisor started",
+		zap.Duration("interval", s.cfg.Interval),
+		zap.Int("failure_threshold", s.cfg.FailureThreshold))
+
+	go func() {
+		ticker := time.NewTicker(s.cfg.Interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			s.Check(context.Background())
+		}
+	}()
+}
+
+// Ready reports whether the database answered the latest pings
+func (s *Supervisor) Ready() bool {
+	return s.ready.Load()
+}
+
+// Check pings the database once and updates the readiness state. It is not safe for concurrent use.
+func (s *Supervisor) Check(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, s.cfg.PingTimeout)
+	err := s.pool.Ping(pingCtx)
+	cancel()
+
+	if err == nil {
+		s.failures = 0
+		s.setReady(true, nil)
+		return
+	}
+
+	s.failures++
+	logger.Warn("Database ping failed", zap.Int("consecutive_failures", s.failures), zap.Error(err))
+	if s.failures%s.cfg.FailureThreshold != 0 {
+		return
+	}
+
+	s.setReady(false, err)
+	s.pool.Reset()
+	if metrics.DBPoolResets != nil {
+		metrics.DBPoolResets.Inc()
+	}
+	logger.Warn("Reset database connection pool", zap.Int("consecutive_failures", s.failures))
+}
+
+// setReady records a readiness change; repeated states are ignored so only transitions are logged
+func (s *Supervisor) setReady(ready bool, err error) {
+	if s.ready.Swap(ready) == ready {
+		return
+	}
+
+	if metrics.DBReady != nil {
+		value := 0.0
+		if ready {
+			value = 1
+		}
+		metrics.DBReady.Set(value)
+	}
+	if ready {
+		logger.Info("Database is reachable again")
+		return
+	}
+	logger.Error("Database is unreachable, marking the API not ready",
+		zap.Int("consecutive_failures", s.failures),
+		zap.Error(err))
+}
@@ -0,0 +1,95 @@
+// Package events defines the domain events published to the event bus.
+// Every payload carries its schema version and has a JSON schema under schemas/.
+// Bump the version (adding a new schema file) whenever a field is renamed, removed
+// or changes meaning; adding an optional field only needs the schema updated.
+package events
+
+import (
+	"context"
+
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// Event types, used as the last part of the bus subject
+const (
+	TypeMentorUpdated        = "mentor.updated"
+	TypeRequestCreated       = "request.created"
+	TypeRequestStatusChanged = "request.status_changed"
+)
+
+// Actors of mentor.updated
+const (
+	ActorMentor = "mentor"
+	ActorAdmin  = "admin"
+)
+
+// Payload is the data of a domain event
+type Payload interface {
+	EventType() string
+	SchemaVersion() int
+}
+
+// NewEvent validates the payload against its schema and wraps it in an envelope
+func NewEvent(payload Payload) (eventbus.Event, error) {
+	data, err := Validate(payload)
+	if err != nil {
+		return eventbus.Event{}, err
+	}
+	return eventbus.NewEvent(payload.EventType(), payload.SchemaVersion(), data)
+}
+
+// Publish validates the payload against its schema and sends it to the bus.
+// It never blocks and never fails the caller: invalid payloads are logged and dropped.
+func Publish(ctx context.Context, publisher eventbus.Publisher, payload Payload) {
+	event, err := NewEvent(payload)
+	if err != nil {
+		logger.Error("Dropping invalid event",
+			zap.String("event_type", payload.EventType()),
+			zap.Int("schema_version", payload.SchemaVersion()),
+			zap.Error(err))
+		if metrics.EventBusPublished != nil {
+			metrics.EventBusPublished.WithLabelValues(payload.EventType(), "invalid").Inc()
+		}
+		return
+	}
+	publisher.Publish(ctx, event)
+}
+
+// MentorUpdated is emitted after a mentor profile, picture or status was saved.
+// Consumers re-read the mentor from the public API when they need its data.
+type MentorUpdated struct {
+	MentorID      string   `json:"mentor_id"`
+	Slug          string   `json:"slug"`
+	Status        string   `json:"status"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	Actor         string   `json:"actor"` // ActorMentor or ActorAdmin
+}
+
+func (MentorUpdated) EventType() string  { return TypeMentorUpdated }
+func (MentorUpdated) SchemaVersion() int { return 1 }
+
+// RequestCreated is emitted when a mentee request reaches the mentor: on submission,
+// or once released from quarantine. Contact details of the mentee are not included.
+type RequestCreated struct {
+	RequestID string `json:"request_id"`
+	MentorID  string `json:"mentor_id"`
+	Level     string `json:"level,omitempty"`
+}
+
+func (RequestCreated) EventType() string  { return TypeRequestCreated }
+func (RequestCreated) SchemaVersion() int { return 1 }
+
+// RequestStatusChanged is emitted after a request moved from one status to another
+type RequestStatusChanged struct {
+	RequestID     string `json:"request_id"`
+	MentorID      string `json:"mentor_id"`
+	FromStatus    string `json:"from_status,omitempty"` // empty on backfilled events: past statuses are not stored
+	ToStatus      string `json:"to_status"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+}
+
+func (RequestStatusChanged) EventType() string  { return TypeRequestStatusChanged }
+func (RequestStatusChanged) SchemaVersion() int { return 1 }
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// jsonSchema is the subset of JSON Schema (draft 2020-12) used by the event schemas:
+// type, required, properties, additionalProperties, enum, minLength, format and items
+type jsonSchema struct {
+	Type                 schemaTypes            `json:"type"`
+	Required             []string               `json:"required"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Enum                 []interface{}          `json:"enum"`
+	MinLength            int                    `json:"minLength"`
+	Format               string                 `json:"format"`
+	Items                *jsonSchema            `json:"items"`
+}
+
+// schemaTypes accepts both "type": "string" and "type": ["string", "null"]
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// validate checks a decoded JSON value (as produced by encoding/json into interface{})
+// and returns the first violation, prefixed with its path
+func (s *jsonSchema) validate(path string, value interface{}) error {
+	if len(s.Type) > 0 && !s.allowsType(value) {
+		return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(s.Type, " or "), jsonTypeOf(value))
+	}
+	if len(s.Enum) > 0 && !s.inEnum(value) {
+		return fmt.Errorf("%s: value %v is not allowed", path, value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		if len(v) < s.MinLength {
+			return fmt.Errorf("%s: must be at least %d characters", path, s.MinLength)
+		}
+		if s.Format == "uuid" && !looksLikeUUID(v) {
+			return fmt.Errorf("%s: must be a UUID", path)
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range v {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s.%s: is required", path, name)
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			property, known := s.Properties[key]
+			if !known {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%s.%s: is not allowed", path, key)
+				}
+				continue
+			}
+			if err := property.validate(path+"."+key, v[key]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) allowsType(value interface{}) bool {
+	actual := jsonTypeOf(value)
+	for _, t := range s.Type {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *jsonSchema) inEnum(value interface{}) bool {
+	for _, allowed := range s.Enum {
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func looksLikeUUID(value string) bool {
+	if len(value) != 36 {
+		return false
+	}
+	for i, r := range value {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+				return false
+			}
+		}
+	}
+	return true
+}
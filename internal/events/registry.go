@@ -0,0 +1,118 @@
+package events
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrUnknownSchema is returned for an event type and version without a registered schema
+	ErrUnknownSchema = errors.New("unknown event schema")
+	// ErrInvalidPayload is returned when an event payload does not match its schema
+	ErrInvalidPayload = errors.New("invalid event payload")
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// Schema is a registered JSON schema of an event payload
+type Schema struct {
+	Type    string          `json:"type"`
+	Version int             `json:"version"`
+	Schema  json.RawMessage `json:"schema"`
+
+	compiled *jsonSchema
+}
+
+// SchemasResponse lists the envelope and every event schema for consumers
+type SchemasResponse struct {
+	Envelope json.RawMessage `json:"envelope"`
+	Events   []Schema        `json:"events"`
+}
+
+type schemaKey struct {
+	eventType string
+	version   int
+}
+
+// registered lists every schema file; a new version of an event is a new entry (and file),
+// old versions stay while consumers may still receive them
+var registered = []struct {
+	eventType string
+	version   int
+	file      string
+}{
+	{TypeMentorUpdated, 1, "schemas/mentor.updated.v1.json"},
+	{TypeRequestCreated, 1, "schemas/request.created.v1.json"},
+	{TypeRequestStatusChanged, 1, "schemas/request.status_changed.v1.json"},
+}
+
+var (
+	envelopeSchema json.RawMessage
+	registry       = map[schemaKey]*Schema{}
+)
+
+func init() {
+	raw, err := schemaFiles.ReadFile("schemas/envelope.v1.json")
+	if err != nil {
+		panic(err)
+	}
+	envelopeSchema = raw
+
+	for _, entry := range registered {
+		raw, err := schemaFiles.ReadFile(entry.file)
+		if err != nil {
+			panic(err)
+		}
+		var compiled jsonSchema
+		if err := json.Unmarshal(raw, &compiled); err != nil {
+			panic(fmt.Sprintf("invalid event schema %s: %v", entry.file, err))
+		}
+		registry[schemaKey{entry.eventType, entry.version}] = &Schema{
+			Type:     entry.eventType,
+			Version:  entry.version,
+			Schema:   raw,
+			compiled: &compiled,
+		}
+	}
+}
+
+// Schemas returns the envelope and all registered event schemas, sorted by type and version
+func Schemas() SchemasResponse {
+	list := make([]Schema, 0, len(registry))
+	for _, schema := range registry {
+		list = append(list, *schema)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Type != list[j].Type {
+			return list[i].Type < list[j].Type
+		}
+		return list[i].Version < list[j].Version
+	})
+	return SchemasResponse{Envelope: envelopeSchema, Events: list}
+}
+
+// Validate checks the JSON form of a payload against the schema of its type and version
+// and returns the encoded payload
+func Validate(payload Payload) (json.RawMessage, error) {
+	schema, ok := registry[schemaKey{payload.EventType(), payload.SchemaVersion()}]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnknownSchema, payload.EventType(), payload.SchemaVersion())
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if err := schema.compiled.validate("data", decoded); err != nil {
+		return nil, fmt.Errorf("%w: %s v%d: %v", ErrInvalidPayload, payload.EventType(), payload.SchemaVersion(), err)
+	}
+	return raw, nil
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// AbuseReportHandler serves the public abuse report form and the admin moderation queue
+type AbuseReportHandler struct {
+	service services.AbuseReportServiceInterface
+}
+
+// NewAbuseReportHandler creates a new AbuseReportHandler
+func NewAbuseReportHandler(service services.AbuseReportServiceInterface) *AbuseReportHandler {
+	return &AbuseReportHandler{service: service}
+}
+
+// SubmitReport handles POST /api/v1/report
+func (h *AbuseReportHandler) SubmitReport(c *gin.Context) {
+	var req models.AbuseReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	resp, err := h.service.SubmitReport(c.Request.Context(), &req)
+	if err != nil {
+		if errors.Is(err, services.ErrAbuseTargetNotFound) {
+			respondError(c, http.StatusNotFound, "Reported mentor or request not found", err)
+			return
+		}
+		if resp != nil && resp.Error != "" {
+			attachError(c, err)
+			c.JSON(http.StatusBadRequest, resp)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListReports handles GET /api/v1/admin/reports?status=open|closed|all
+func (h *AbuseReportHandler) ListReports(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	filter := models.AbuseReportFilter(c.DefaultQuery("status", string(models.AbuseReportFilterOpen)))
+	reports, err := h.service.ListReports(c.Request.Context(), session, filter)
+	if err != nil {
+		respondAbuseReportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.AbuseReportsListResponse{
+		Reports: reports,
+		Total:   len(reports),
+	})
+}
+
+// ResolveReport handles POST /api/v1/admin/reports/:id/status
+func (h *AbuseReportHandler) ResolveReport(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.ResolveAbuseReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	report, err := h.service.ResolveReport(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondAbuseReportError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"report": report})
+}
+
+func respondAbuseReportError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrAbuseReportNotFound):
+		respondError(c, http.StatusNotFound, "Report not found", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
@@ -67,14 +69,66 @@ func (h *AdminMentorsHandler) UpdateMentor(c *gin.Context) {
 		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", gin.H{"message": bindErr.Error()}, bindErr)
 		return
 	}
+	version, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+	if version != nil {
+		req.ExpectedVersion = version
+	}
+
+	ctx, dryRun := requestContext(c)
+	mentor, err := h.service.UpdateMentorProfile(ctx, session, mentorID, &req)
+	if err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mentorResponse(mentor, dryRun))
+}
+
+func (h *AdminMentorsHandler) PatchMentor(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	mentorID := c.Param("id")
+	if mentorID == "" {
+		respondError(c, http.StatusBadRequest, "Invalid mentor ID", errors.New("missing route param: id"))
+		return
+	}
 
-	mentor, err := h.service.UpdateMentorProfile(c.Request.Context(), session, mentorID, &req)
+	version, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	ctx, dryRun := requestContext(c)
+	var mentor *models.AdminMentorDetails
+	var changes []models.ProfileFieldChange
+	if c.ContentType() == models.JSONPatchContentType {
+		patch, ok := bindJSONPatch(c)
+		if !ok {
+			return
+		}
+		mentor, changes, err = h.service.JSONPatchMentorProfile(ctx, session, mentorID, jsonPatchWithVersion(patch, version))
+	} else {
+		patch, ok := bindMergePatch(c)
+		if !ok {
+			return
+		}
+		mentor, changes, err = h.service.PatchMentorProfile(ctx, session, mentorID, mergePatchWithVersion(patch, version))
+	}
 	if err != nil {
 		h.respondServiceError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, models.AdminMentorResponse{Mentor: mentor})
+	resp := mentorResponse(mentor, dryRun)
+	resp.Changes = changes
+	c.JSON(http.StatusOK, resp)
 }
 
 func (h *AdminMentorsHandler) ApproveMentor(c *gin.Context) {
@@ -102,13 +156,15 @@ func (h *AdminMentorsHandler) withAdminMentor(
 		return
 	}
 
-	mentor, err := action(c.Request.Context(), session, mentorID)
+	ctx, dryRun := requestContext(c)
+	mentor, err := action(ctx, session, mentorID)
 	if err != nil {
 		h.respondServiceError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, models.AdminMentorResponse{Mentor: mentor})
+	c.Header("ETag", models.VersionETag(mentor.Version))
+	c.JSON(http.StatusOK, mentorResponse(mentor, dryRun))
 }
 
 func (h *AdminMentorsHandler) UpdateMentorStatus(c *gin.Context) {
@@ -130,13 +186,14 @@ func (h *AdminMentorsHandler) UpdateMentorStatus(c *gin.Context) {
 		return
 	}
 
-	mentor, err := h.service.UpdateMentorStatus(c.Request.Context(), session, mentorID, req.Status)
+	ctx, dryRun := requestContext(c)
+	mentor, err := h.service.UpdateMentorStatus(ctx, session, mentorID, req.Status)
 	if err != nil {
 		h.respondServiceError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, models.AdminMentorResponse{Mentor: mentor})
+	c.JSON(http.StatusOK, mentorResponse(mentor, dryRun))
 }
 
 func (h *AdminMentorsHandler) UploadMentorPicture(c *gin.Context) {
@@ -171,12 +228,74 @@ func (h *AdminMentorsHandler) UploadMentorPicture(c *gin.Context) {
 	})
 }
 
+func (h *AdminMentorsHandler) DeleteMentor(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	mentorID := c.Param("id")
+	if mentorID == "" {
+		respondError(c, http.StatusBadRequest, "Invalid mentor ID", errors.New("missing route param: id"))
+		return
+	}
+
+	ctx, dryRun := requestContext(c)
+	if err := h.service.DeleteMentor(ctx, session, mentorID); err != nil {
+		h.respondServiceError(c, err)
+		return
+	}
+
+	if dryRun {
+		c.JSON(http.StatusOK, gin.H{"success": true, "dryRun": true})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// requestContext returns the request context, switched to dry-run mode when ?dryRun=true is set
+func requestContext(c *gin.Context) (context.Context, bool) {
+	if c.Query("dryRun") != "true" {
+		return c.Request.Context(), false
+	}
+	return services.WithDryRun(c.Request.Context()), true
+}
+
+func mentorResponse(mentor *models.AdminMentorDetails, dryRun bool) models.AdminMentorResponse {
+	return models.AdminMentorResponse{Mentor: mentor, DryRun: dryRun}
+}
+
 func (h *AdminMentorsHandler) respondServiceError(c *gin.Context, err error) {
+	if respondVersionConflict(c, err) {
+		return
+	}
+
+	if errors.Is(err, models.ErrJSONPatchTestFailed) {
+		respondError(c, http.StatusConflict, "Profile was modified by someone else", err)
+		return
+	}
+
+	if errors.Is(err, models.ErrJSONPatchPathNotFound) {
+		respondError(c, http.StatusUnprocessableEntity, "Patch target does not exist", err)
+		return
+	}
+
 	if errors.Is(err, services.ErrAdminForbiddenAction) {
 		respondError(c, http.StatusForbidden, "Access denied", err)
 		return
 	}
 
+	if errors.Is(err, apperrors.ErrInvalidInput) {
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+		return
+	}
+
+	if errors.Is(err, repository.ErrEmailInUse) {
+		respondError(c, http.StatusConflict, "Email is already used by another mentor", err)
+		return
+	}
+
 	msg := strings.ToLower(err.Error())
 	if strings.Contains(msg, "not found") {
 		respondError(c, http.StatusNotFound, "Mentor not found", err)
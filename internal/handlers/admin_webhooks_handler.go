@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminWebhooksHandler struct {
+	service services.AdminWebhooksServiceInterface
+}
+
+func NewAdminWebhooksHandler(service services.AdminWebhooksServiceInterface) *AdminWebhooksHandler {
+	return &AdminWebhooksHandler{service: service}
+}
+
+// TestWebhook handles POST /api/v1/admin/webhooks/test
+func (h *AdminWebhooksHandler) TestWebhook(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.AdminWebhookTestRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", gin.H{"message": bindErr.Error()}, bindErr)
+		return
+	}
+
+	resp, err := h.service.TestTrigger(c.Request.Context(), session, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAdminForbiddenAction):
+			respondError(c, http.StatusForbidden, "Access denied", err)
+		case errors.Is(err, services.ErrUnknownTrigger):
+			respondErrorWithDetails(c, http.StatusBadRequest, "Unknown trigger", gin.H{"triggers": h.service.TriggerNames()}, err)
+		default:
+			respondError(c, http.StatusInternalServerError, "Internal server error", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
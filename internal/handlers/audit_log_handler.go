@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogHandler exposes the audit log of mentor and request mutations to admins
+type AuditLogHandler struct {
+	service services.AuditLogServiceInterface
+}
+
+// NewAuditLogHandler creates a new AuditLogHandler
+func NewAuditLogHandler(service services.AuditLogServiceInterface) *AuditLogHandler {
+	return &AuditLogHandler{service: service}
+}
+
+// ListEntries handles GET /api/v1/admin/audit-log?entityType=...&entityId=...&actorType=...&actorId=...
+// &operation=...&from=...&to=...&before=...&limit=...
+// from and to take the same formats as the partner audit log; before is the nextBefore of the previous page.
+func (h *AuditLogHandler) ListEntries(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	filter := models.AuditLogFilter{
+		EntityType: c.Query("entityType"),
+		EntityID:   c.Query("entityId"),
+		ActorType:  c.Query("actorType"),
+		ActorID:    c.Query("actorId"),
+		Operation:  c.Query("operation"),
+	}
+	if filter.From, err = parseAuditTime(c.Query("from")); err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid from", err)
+		return
+	}
+	if filter.To, err = parseAuditTime(c.Query("to")); err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid to", err)
+		return
+	}
+	if beforeStr := c.Query("before"); beforeStr != "" {
+		if filter.Before, err = strconv.ParseInt(beforeStr, 10, 64); err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid before", err)
+			return
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if filter.Limit, err = strconv.Atoi(limitStr); err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid limit", err)
+			return
+		}
+	}
+
+	page, err := h.service.List(c.Request.Context(), session, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAdminForbiddenAction):
+			respondError(c, http.StatusForbidden, "Access denied", err)
+		case errors.Is(err, apperrors.ErrInvalidInput):
+			respondError(c, http.StatusBadRequest, "Invalid request", err)
+		default:
+			respondError(c, http.StatusInternalServerError, "Failed to load audit log", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
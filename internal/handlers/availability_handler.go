@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// AvailabilityHandler serves mentor availability (contact hours and calendar busy periods)
+type AvailabilityHandler struct {
+	service services.AvailabilityServiceInterface
+}
+
+// NewAvailabilityHandler creates a new AvailabilityHandler
+func NewAvailabilityHandler(service services.AvailabilityServiceInterface) *AvailabilityHandler {
+	return &AvailabilityHandler{service: service}
+}
+
+// GetAvailability handles GET /api/v1/mentor/:id/availability
+func (h *AvailabilityHandler) GetAvailability(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid ID", fmt.Errorf("invalid mentor id %q: %w", idStr, err))
+		return
+	}
+
+	availability, err := h.service.GetAvailability(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, http.StatusNotFound, "Mentor not found", fmt.Errorf("mentor id=%d not found: %w", id, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, availability)
+}
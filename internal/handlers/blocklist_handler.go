@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// BlocklistHandler serves the admin-managed mentee blocklist
+type BlocklistHandler struct {
+	service services.BlocklistServiceInterface
+}
+
+// NewBlocklistHandler creates a new BlocklistHandler
+func NewBlocklistHandler(service services.BlocklistServiceInterface) *BlocklistHandler {
+	return &BlocklistHandler{service: service}
+}
+
+// ListEntries handles GET /api/v1/admin/blocklist?includeInactive=true
+func (h *BlocklistHandler) ListEntries(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	entries, err := h.service.ListEntries(c.Request.Context(), session, c.Query("includeInactive") == "true")
+	if err != nil {
+		respondBlocklistError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.BlocklistResponse{
+		Entries: entries,
+		Total:   len(entries),
+	})
+}
+
+// AddEntry handles POST /api/v1/admin/blocklist
+func (h *BlocklistHandler) AddEntry(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CreateBlocklistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	entry, err := h.service.AddEntry(c.Request.Context(), session, &req)
+	if err != nil {
+		respondBlocklistError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"entry": entry})
+}
+
+// RemoveEntry handles DELETE /api/v1/admin/blocklist/:id
+func (h *BlocklistHandler) RemoveEntry(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.RemoveEntry(c.Request.Context(), session, c.Param("id")); err != nil {
+		respondBlocklistError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondBlocklistError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrBlocklistEntryNotFound):
+		respondError(c, http.StatusNotFound, "Blocklist entry not found", err)
+	case errors.Is(err, repository.ErrBlocklistEntryExists):
+		respondError(c, http.StatusConflict, "Value is already on the blocklist", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// BotHeartbeatHandler receives the Telegram bot's heartbeats and shows its liveness to admins
+type BotHeartbeatHandler struct {
+	service services.BotHeartbeatServiceInterface
+}
+
+// NewBotHeartbeatHandler creates a new BotHeartbeatHandler
+func NewBotHeartbeatHandler(service services.BotHeartbeatServiceInterface) *BotHeartbeatHandler {
+	return &BotHeartbeatHandler{service: service}
+}
+
+// Heartbeat handles POST /api/v1/bot/heartbeat
+func (h *BotHeartbeatHandler) Heartbeat(c *gin.Context) {
+	var req models.BotHeartbeatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	status, err := h.service.RecordHeartbeat(c.Request.Context(), &req)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to record heartbeat", err)
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+// GetStatus handles GET /api/v1/admin/bot-status
+func (h *BotHeartbeatHandler) GetStatus(c *gin.Context) {
+	if _, err := middleware.GetAdminSession(c); err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	status, err := h.service.Status(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to read bot status", err)
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// CacheAdminHandler lets operators manage a replica's mentor cache without restarting it
+type CacheAdminHandler struct {
+	service services.CacheAdminServiceInterface
+}
+
+// NewCacheAdminHandler creates a new CacheAdminHandler
+func NewCacheAdminHandler(service services.CacheAdminServiceInterface) *CacheAdminHandler {
+	return &CacheAdminHandler{service: service}
+}
+
+// GetStatus handles GET /api/v1/internal/cache/status
+func (h *CacheAdminHandler) GetStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, h.service.Status(c.Request.Context()))
+}
+
+// Refresh handles POST /api/v1/internal/cache/refresh
+func (h *CacheAdminHandler) Refresh(c *gin.Context) {
+	status, err := h.service.Refresh(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to refresh mentor cache", err)
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+// Invalidate handles POST /api/v1/internal/cache/invalidate/:slug
+func (h *CacheAdminHandler) Invalidate(c *gin.Context) {
+	result, err := h.service.Invalidate(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidInput) {
+			respondError(c, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to invalidate mentor cache entry", err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
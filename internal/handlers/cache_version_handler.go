@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CacheVersionHandler lets operators check that the replicas' mentor caches have converged
+type CacheVersionHandler struct {
+	service services.CacheVersionServiceInterface
+}
+
+// NewCacheVersionHandler creates a new CacheVersionHandler
+func NewCacheVersionHandler(service services.CacheVersionServiceInterface) *CacheVersionHandler {
+	return &CacheVersionHandler{service: service}
+}
+
+// GetVersions handles GET /api/v1/internal/cache/versions
+func (h *CacheVersionHandler) GetVersions(c *gin.Context) {
+	report, err := h.service.Report(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to load cache versions", err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
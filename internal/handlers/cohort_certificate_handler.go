@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CohortCertificateHandler serves cohort completion reports, certificate issuing and
+// public certificate verification
+type CohortCertificateHandler struct {
+	service services.CohortCertificateServiceInterface
+}
+
+// NewCohortCertificateHandler creates a new CohortCertificateHandler
+func NewCohortCertificateHandler(service services.CohortCertificateServiceInterface) *CohortCertificateHandler {
+	return &CohortCertificateHandler{service: service}
+}
+
+// VerifyCertificate handles GET /api/v1/certificates/:id
+func (h *CohortCertificateHandler) VerifyCertificate(c *gin.Context) {
+	verification, err := h.service.VerifyCertificate(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, verification)
+}
+
+// AdminGetReport handles GET /api/v1/admin/cohorts/:id/report
+func (h *CohortCertificateHandler) AdminGetReport(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	report, err := h.service.GetReport(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// AdminIssueCertificates handles POST /api/v1/admin/cohorts/:id/certificates.
+// An empty body issues certificates to every eligible mentee.
+func (h *CohortCertificateHandler) AdminIssueCertificates(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.IssueCohortCertificatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	resp, err := h.service.IssueCertificates(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondCertificateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func respondCertificateError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrCertificateNotFound):
+		respondError(c, http.StatusNotFound, "Certificate not found", err)
+	case errors.Is(err, services.ErrCertificatesDisabled):
+		respondError(c, http.StatusServiceUnavailable, "Certificates are not configured", err)
+	default:
+		respondCohortError(c, err)
+	}
+}
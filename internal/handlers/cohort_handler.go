@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// CohortHandler serves cohort enrollment, the mentor view of cohorts and the admin cohort dashboard
+type CohortHandler struct {
+	service services.CohortServiceInterface
+}
+
+// NewCohortHandler creates a new CohortHandler
+func NewCohortHandler(service services.CohortServiceInterface) *CohortHandler {
+	return &CohortHandler{service: service}
+}
+
+// ListCohorts handles GET /api/v1/cohorts
+func (h *CohortHandler) ListCohorts(c *gin.Context) {
+	cohorts, err := h.service.ListOpenCohorts(c.Request.Context())
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"cohorts": cohorts})
+}
+
+// Enroll handles POST /api/v1/cohorts/:id/enroll
+func (h *CohortHandler) Enroll(c *gin.Context) {
+	var req models.CohortEnrollRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	resp, err := h.service.EnrollMentee(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		if resp != nil && resp.Error != "" {
+			attachError(c, err)
+			c.JSON(http.StatusBadRequest, resp)
+			return
+		}
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetMyCohorts handles GET /api/v1/mentor/cohorts
+func (h *CohortHandler) GetMyCohorts(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	cohorts, err := h.service.ListMentorCohorts(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"cohorts": cohorts})
+}
+
+// JoinCohort handles POST /api/v1/mentor/cohorts/:id/enroll
+func (h *CohortHandler) JoinCohort(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	participant, err := h.service.EnrollMentor(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"participant": participant})
+}
+
+// RecordMyMenteeProgress handles POST /api/v1/mentor/cohorts/:id/mentees/:participantId/progress
+func (h *CohortHandler) RecordMyMenteeProgress(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CohortProgressRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	mentee, err := h.service.RecordMenteeProgress(c.Request.Context(), session.MentorID, c.Param("id"), c.Param("participantId"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participant": mentee})
+}
+
+// AdminListCohorts handles GET /api/v1/admin/cohorts
+func (h *CohortHandler) AdminListCohorts(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	cohorts, err := h.service.ListCohorts(c.Request.Context(), session)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"cohorts": cohorts})
+}
+
+// AdminGetCohort handles GET /api/v1/admin/cohorts/:id
+func (h *CohortHandler) AdminGetCohort(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	dashboard, err := h.service.GetDashboard(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dashboard)
+}
+
+// AdminCreateCohort handles POST /api/v1/admin/cohorts
+func (h *CohortHandler) AdminCreateCohort(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CohortRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	cohort, err := h.service.CreateCohort(c.Request.Context(), session, &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"cohort": cohort})
+}
+
+// AdminUpdateCohort handles POST /api/v1/admin/cohorts/:id
+func (h *CohortHandler) AdminUpdateCohort(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CohortRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	cohort, err := h.service.UpdateCohort(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"cohort": cohort})
+}
+
+// AdminAddParticipant handles POST /api/v1/admin/cohorts/:id/participants
+func (h *CohortHandler) AdminAddParticipant(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.AddCohortParticipantRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	participant, err := h.service.AddParticipant(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"participant": participant})
+}
+
+// AdminUpdateParticipant handles POST /api/v1/admin/cohorts/:id/participants/:participantId
+func (h *CohortHandler) AdminUpdateParticipant(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.UpdateCohortParticipantRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	participant, err := h.service.UpdateParticipant(c.Request.Context(), session, c.Param("id"), c.Param("participantId"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participant": participant})
+}
+
+// AdminPair handles POST /api/v1/admin/cohorts/:id/pairs
+func (h *CohortHandler) AdminPair(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CohortPairRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	mentee, err := h.service.PairParticipants(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participant": mentee})
+}
+
+// AdminRecordProgress handles POST /api/v1/admin/cohorts/:id/participants/:participantId/progress
+func (h *CohortHandler) AdminRecordProgress(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CohortProgressRequest
+	if !bindCohortRequest(c, &req) {
+		return
+	}
+
+	mentee, err := h.service.RecordProgress(c.Request.Context(), session, c.Param("id"), c.Param("participantId"), &req)
+	if err != nil {
+		respondCohortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participant": mentee})
+}
+
+func bindCohortRequest(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return false
+	}
+	return true
+}
+
+func respondCohortError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction), errors.Is(err, services.ErrNotCohortMentor):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrCohortNotFound):
+		respondError(c, http.StatusNotFound, "Cohort not found", err)
+	case errors.Is(err, repository.ErrCohortParticipantNotFound):
+		respondError(c, http.StatusNotFound, "Participant not found", err)
+	case errors.Is(err, repository.ErrAlreadyEnrolled):
+		respondError(c, http.StatusConflict, "Already enrolled in this cohort", err)
+	case errors.Is(err, services.ErrCohortClosed):
+		respondError(c, http.StatusConflict, "Cohort is not open for enrollment", err)
+	case errors.Is(err, services.ErrCohortFull):
+		respondError(c, http.StatusConflict, "Cohort is full", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// CommunityEventHandler serves the public community event feed and admin event management
+type CommunityEventHandler struct {
+	service services.CommunityEventServiceInterface
+}
+
+// NewCommunityEventHandler creates a new CommunityEventHandler
+func NewCommunityEventHandler(service services.CommunityEventServiceInterface) *CommunityEventHandler {
+	return &CommunityEventHandler{service: service}
+}
+
+// ListEvents handles GET /api/v1/events
+func (h *CommunityEventHandler) ListEvents(c *gin.Context) {
+	events, err := h.service.ListUpcoming(c.Request.Context())
+	if err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+// GetCalendar handles GET /api/v1/events.ics, a feed calendar apps can subscribe to
+func (h *CommunityEventHandler) GetCalendar(c *gin.Context) {
+	data, err := h.service.GetCalendar(c.Request.Context())
+	if err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="getmentor-events.ics"`)
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", data)
+}
+
+// AdminListEvents handles GET /api/v1/admin/events
+func (h *CommunityEventHandler) AdminListEvents(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	events, err := h.service.ListEvents(c.Request.Context(), session)
+	if err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+// CreateEvent handles POST /api/v1/admin/events
+func (h *CommunityEventHandler) CreateEvent(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CommunityEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	event, err := h.service.CreateEvent(c.Request.Context(), session, &req)
+	if err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"event": event})
+}
+
+// UpdateEvent handles POST /api/v1/admin/events/:id
+func (h *CommunityEventHandler) UpdateEvent(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.CommunityEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	event, err := h.service.UpdateEvent(c.Request.Context(), session, c.Param("id"), &req)
+	if err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"event": event})
+}
+
+// DeleteEvent handles DELETE /api/v1/admin/events/:id
+func (h *CommunityEventHandler) DeleteEvent(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteEvent(c.Request.Context(), session, c.Param("id")); err != nil {
+		respondCommunityEventError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondCommunityEventError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrCommunityEventNotFound):
+		respondError(c, http.StatusNotFound, "Event not found", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
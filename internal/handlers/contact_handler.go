@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/getmentor/getmentor-api/internal/models"
@@ -23,12 +24,17 @@ func (h *ContactHandler) ContactMentor(c *gin.Context) {
 		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
 		return
 	}
+	req.ClientIP = c.ClientIP()
 
 	resp, err := h.service.SubmitContactForm(c.Request.Context(), &req)
 	if err != nil {
 		if resp != nil && resp.Error != "" {
 			attachError(c, err)
-			c.JSON(http.StatusBadRequest, resp)
+			status := http.StatusBadRequest
+			if errors.Is(err, services.ErrContactRateLimited) {
+				status = http.StatusTooManyRequests
+			}
+			c.JSON(status, resp)
 			return
 		}
 		respondError(c, http.StatusInternalServerError, "Internal server error", err)
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ContactLimitHandler shows and resets the persistent contact form limits
+type ContactLimitHandler struct {
+	service services.ContactLimitServiceInterface
+}
+
+// NewContactLimitHandler creates a new ContactLimitHandler
+func NewContactLimitHandler(service services.ContactLimitServiceInterface) *ContactLimitHandler {
+	return &ContactLimitHandler{service: service}
+}
+
+// ListCounters handles GET /api/v1/admin/contact-limits?kind=email&subject=
+func (h *ContactLimitHandler) ListCounters(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	resp, err := h.service.ListCounters(c.Request.Context(), session, c.Query("kind"), c.Query("subject"))
+	if err != nil {
+		respondContactLimitError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ResetCounter handles DELETE /api/v1/admin/contact-limits?kind=email&subject=
+func (h *ContactLimitHandler) ResetCounter(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.ResetCounter(c.Request.Context(), session, c.Query("kind"), c.Query("subject")); err != nil {
+		respondContactLimitError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondContactLimitError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, services.ErrContactLimitNotFound):
+		respondError(c, http.StatusNotFound, "No submissions counted", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// CORSOriginHandler serves the admin-managed CORS origins
+type CORSOriginHandler struct {
+	service services.CORSOriginServiceInterface
+}
+
+// NewCORSOriginHandler creates a new CORSOriginHandler
+func NewCORSOriginHandler(service services.CORSOriginServiceInterface) *CORSOriginHandler {
+	return &CORSOriginHandler{service: service}
+}
+
+// ListOrigins handles GET /api/v1/admin/cors-origins
+func (h *CORSOriginHandler) ListOrigins(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	origins, err := h.service.ListOrigins(c.Request.Context(), session)
+	if err != nil {
+		respondCORSOriginError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, models.CORSOriginsResponse{
+		Origins: origins,
+		Total:   len(origins),
+	})
+}
+
+// UpsertOrigin handles POST /api/v1/admin/cors-origins
+func (h *CORSOriginHandler) UpsertOrigin(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.UpsertCORSOriginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	origin, err := h.service.UpsertOrigin(c.Request.Context(), session, &req)
+	if err != nil {
+		respondCORSOriginError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"origin": origin})
+}
+
+// DeleteOrigin handles DELETE /api/v1/admin/cors-origins?origin=https://partner.example
+func (h *CORSOriginHandler) DeleteOrigin(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteOrigin(c.Request.Context(), session, c.Query("origin")); err != nil {
+		respondCORSOriginError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondCORSOriginError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrCORSOriginNotFound):
+		respondError(c, http.StatusNotFound, "CORS origin not found", err)
+	case errors.Is(err, services.ErrStaticCORSOrigin):
+		respondError(c, http.StatusConflict, "Origin is configured by ALLOWED_CORS_ORIGINS and cannot be changed here", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
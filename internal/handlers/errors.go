@@ -1,6 +1,13 @@
 package handlers
 
 import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,11 +24,44 @@ func attachError(c *gin.Context, err error) {
 // so the observability middleware can include the reason in the request log.
 func respondError(c *gin.Context, status int, message string, err error) {
 	attachError(c, err)
-	c.JSON(status, gin.H{"error": message})
+	c.JSON(status, withErrorReference(c, status, gin.H{"error": message}))
 }
 
 // respondErrorWithDetails sends an error response with an additional details field.
 func respondErrorWithDetails(c *gin.Context, status int, message string, details any, err error) { //nolint:unparam
 	attachError(c, err)
-	c.JSON(status, gin.H{"error": message, "details": details})
+	c.JSON(status, withErrorReference(c, status, gin.H{"error": message, "details": details}))
+}
+
+// withErrorReference adds the trace ID and incident code to the body of a 5xx response,
+// so users can quote them in bug reports
+func withErrorReference(c *gin.Context, status int, body gin.H) gin.H {
+	if status < http.StatusInternalServerError {
+		return body
+	}
+	ref := middleware.GetErrorReference(c)
+	body["incidentCode"] = ref.IncidentCode
+	if ref.TraceID != "" {
+		body["traceId"] = ref.TraceID
+	}
+	return body
+}
+
+// respondVersionConflict sends 409 Conflict with the record's current version when err is
+// an optimistic concurrency failure, and 428 Precondition Required when the write carried
+// no version to check. It reports whether the response was written.
+func respondVersionConflict(c *gin.Context, err error) bool {
+	if errors.Is(err, apperrors.ErrPreconditionRequired) {
+		respondError(c, http.StatusPreconditionRequired, "Send the version you last read in If-Match or expectedVersion", err)
+		return true
+	}
+
+	var conflictErr *repository.VersionConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+	c.Header("ETag", models.VersionETag(conflictErr.CurrentVersion))
+	respondErrorWithDetails(c, http.StatusConflict, "Profile was modified by someone else",
+		gin.H{"currentVersion": conflictErr.CurrentVersion, "currentUpdatedAt": conflictErr.CurrentUpdatedAt}, err)
+	return true
 }
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/gin-gonic/gin"
+)
+
+// EventSchemaHandler publishes the schemas of the events sent to the event bus
+type EventSchemaHandler struct{}
+
+// NewEventSchemaHandler creates a new EventSchemaHandler
+func NewEventSchemaHandler() *EventSchemaHandler {
+	return &EventSchemaHandler{}
+}
+
+// GetSchemas handles GET /api/v1/internal/event-schemas
+func (h *EventSchemaHandler) GetSchemas(c *gin.Context) {
+	c.JSON(http.StatusOK, events.Schemas())
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"runtime"
 	"time"
 
+	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -12,18 +14,41 @@ import (
 type HealthHandler struct {
 	pool             *pgxpool.Pool
 	mentorCacheReady func() bool
+	databaseReady    func() bool
+	botStatus        func(ctx context.Context) (*models.BotStatus, error)
+	startedAt        time.Time
 }
 
-func NewHealthHandler(pool *pgxpool.Pool, mentorCacheReady func() bool) *HealthHandler {
+// NewHealthHandler creates a health handler. databaseReady is the database supervisor's verdict;
+// when it is nil, only the per-request ping is used. botStatus adds the Telegram bot's liveness to
+// the detail report when it is set.
+func NewHealthHandler(
+	pool *pgxpool.Pool,
+	mentorCacheReady, databaseReady func() bool,
+	botStatus func(ctx context.Context) (*models.BotStatus, error),
+) *HealthHandler {
+
 	return &HealthHandler{
 		pool:             pool,
 		mentorCacheReady: mentorCacheReady,
+		databaseReady:    databaseReady,
+		botStatus:        botStatus,
+		startedAt:        time.Now(),
 	}
 }
 
 func (h *HealthHandler) Healthcheck(c *gin.Context) {
 	c.Header("Cache-Control", "no-cache, no-store, max-age=0, must-revalidate")
 
+	// The supervisor has already seen the database fail; don't wait for another ping to time out
+	if h.databaseReady != nil && !h.databaseReady() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "unhealthy",
+			"reason": "database unreachable",
+		})
+		return
+	}
+
 	// Check database connectivity
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -49,3 +74,56 @@ func (h *HealthHandler) Healthcheck(c *gin.Context) {
 		"status": "healthy",
 	})
 }
+
+// Detail reports database pool usage, cache readiness and runtime stats for operators.
+// It is only served on the internal port.
+func (h *HealthHandler) Detail(c *gin.Context) {
+	c.Header("Cache-Control", "no-cache, no-store, max-age=0, must-revalidate")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	pingErr := h.pool.Ping(ctx)
+	latency := time.Since(start)
+
+	cacheReady := h.mentorCacheReady()
+	stat := h.pool.Stat()
+
+	database := gin.H{
+		"reachable":     pingErr == nil,
+		"latencyMs":     latency.Milliseconds(),
+		"totalConns":    stat.TotalConns(),
+		"idleConns":     stat.IdleConns(),
+		"acquiredConns": stat.AcquiredConns(),
+		"maxConns":      stat.MaxConns(),
+	}
+	if pingErr != nil {
+		database["error"] = pingErr.Error()
+	}
+	if h.databaseReady != nil {
+		database["ready"] = h.databaseReady()
+	}
+
+	status, code := "healthy", http.StatusOK
+	if pingErr != nil || !cacheReady {
+		status, code = "unhealthy", http.StatusServiceUnavailable
+	}
+
+	report := gin.H{
+		"status":           status,
+		"database":         database,
+		"mentorCacheReady": cacheReady,
+		"goroutines":       runtime.NumGoroutine(),
+		"uptimeSeconds":    int64(time.Since(h.startedAt).Seconds()),
+	}
+	// The bot is a separate service: a silent bot is reported but doesn't make the API unhealthy
+	if h.botStatus != nil && pingErr == nil {
+		if bot, err := h.botStatus(ctx); err != nil {
+			report["bot"] = gin.H{"error": err.Error()}
+		} else {
+			report["bot"] = bot
+		}
+	}
+	c.JSON(code, report)
+}
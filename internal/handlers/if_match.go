@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// bindIfMatch reads the mentor version sent in If-Match; nil means the header was not set.
+// It writes the error response and returns false on failure.
+func bindIfMatch(c *gin.Context) (*int, bool) {
+	version, err := models.ParseIfMatchVersion(c.GetHeader("If-Match"))
+	if err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid If-Match header", gin.H{"message": err.Error()}, err)
+		return nil, false
+	}
+	return version, true
+}
+
+// mergePatchWithVersion adds the If-Match version to a merge patch as its expected version
+func mergePatchWithVersion(patch models.MergePatch, version *int) models.MergePatch {
+	if version != nil {
+		patch[models.ExpectedVersionKey] = json.RawMessage(strconv.Itoa(*version))
+	}
+	return patch
+}
+
+// jsonPatchWithVersion prepends a test of the If-Match version to a JSON Patch
+func jsonPatchWithVersion(patch models.JSONPatch, version *int) models.JSONPatch {
+	if version == nil {
+		return patch
+	}
+	test := models.JSONPatchOperation{Op: models.JSONPatchTest, Path: "/version", Value: json.RawMessage(strconv.Itoa(*version))}
+	return append(models.JSONPatch{test}, patch...)
+}
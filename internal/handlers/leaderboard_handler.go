@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// LeaderboardHandler serves the opt-in mentor leaderboard
+type LeaderboardHandler struct {
+	service services.LeaderboardServiceInterface
+}
+
+// NewLeaderboardHandler creates a new LeaderboardHandler
+func NewLeaderboardHandler(service services.LeaderboardServiceInterface) *LeaderboardHandler {
+	return &LeaderboardHandler{service: service}
+}
+
+// GetLeaderboard handles GET /api/v1/leaderboard?period=30d
+func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
+	leaderboard, err := h.service.GetLeaderboard(c.Request.Context(), c.Query("period"))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownLeaderboardPeriod):
+			respondError(c, http.StatusBadRequest, "Unknown period", err)
+		case errors.Is(err, services.ErrLeaderboardNotReady):
+			c.Header("Retry-After", "60")
+			respondError(c, http.StatusServiceUnavailable, "Leaderboard is not ready yet", err)
+		default:
+			respondError(c, http.StatusInternalServerError, "Failed to fetch leaderboard", err)
+		}
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, leaderboard)
+}
+
+// SetOptIn handles POST /api/v1/mentor/leaderboard
+func (h *LeaderboardHandler) SetOptIn(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.LeaderboardOptInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	if err := h.service.SetMentorOptIn(c.Request.Context(), session.MentorID, *req.OptIn); err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to update leaderboard settings", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true, "optIn": *req.OptIn})
+}
@@ -1,15 +1,23 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
+	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/tracing"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -18,15 +26,18 @@ type LogsHandler struct {
 	mu     sync.Mutex
 }
 
-type LogEntry struct {
-	Timestamp string                 `json:"timestamp"`
-	Level     string                 `json:"level"`
-	Message   string                 `json:"message"`
-	Context   map[string]interface{} `json:"context,omitempty"`
+// LogEntryError lists the validation errors of a rejected log entry
+type LogEntryError struct {
+	Index  int               `json:"index"`
+	Errors []ValidationError `json:"errors"`
 }
 
-type LogBatchRequest struct {
-	Logs []LogEntry `json:"logs" binding:"required,max=100,dive"`
+// LogBatchResponse reports how many entries were accepted and why the others were rejected
+type LogBatchResponse struct {
+	Success  bool            `json:"success"`
+	Received int             `json:"received"`
+	Rejected int             `json:"rejected"`
+	Errors   []LogEntryError `json:"errors,omitempty"`
 }
 
 func NewLogsHandler(logDir string) *LogsHandler {
@@ -36,29 +47,95 @@ func NewLogsHandler(logDir string) *LogsHandler {
 }
 
 func (h *LogsHandler) ReceiveFrontendLogs(c *gin.Context) {
-	var req LogBatchRequest
+	var req models.FrontendLogBatchRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", ParseValidationErrors(err), err)
 		return
 	}
 
-	if len(req.Logs) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "No logs provided"})
+	// Entries are validated one by one: malformed entries are rejected with
+	// per-field errors while the rest of the batch is still written
+	entries := make([]models.FrontendLogEntry, 0, len(req.Logs))
+	var entryErrors []LogEntryError
+	for i, raw := range req.Logs {
+		entry, validationErrors := parseLogEntry(raw)
+		if validationErrors != nil {
+			metrics.FrontendLogEntries.WithLabelValues(logLevelLabel(entry.Level), "rejected").Inc()
+			entryErrors = append(entryErrors, LogEntryError{Index: i, Errors: validationErrors})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		respondErrorWithDetails(c, http.StatusBadRequest, "No valid log entries", entryErrors, errors.New("all log entries rejected"))
 		return
 	}
 
 	// Write logs to frontend.log file
-	if err := h.writeLogsToFile(req.Logs); err != nil {
+	if err := h.writeLogsToFile(entries); err != nil {
 		logger.Error("Failed to write frontend logs", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to write logs"})
+		respondError(c, http.StatusInternalServerError, "Failed to write logs", err)
+		return
+	}
+
+	for i := range entries {
+		metrics.FrontendLogEntries.WithLabelValues(entries[i].Level, "accepted").Inc()
+		attachToTrace(c.Request.Context(), &entries[i])
+	}
+
+	logger.Info("Received frontend logs", zap.Int("count", len(entries)), zap.Int("rejected", len(entryErrors)))
+	c.JSON(http.StatusOK, LogBatchResponse{
+		Success:  true,
+		Received: len(entries),
+		Rejected: len(entryErrors),
+		Errors:   entryErrors,
+	})
+}
+
+func parseLogEntry(raw json.RawMessage) (models.FrontendLogEntry, []ValidationError) {
+	var entry models.FrontendLogEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return entry, []ValidationError{{Field: "entry", Message: "Entry must be an object with string fields"}}
+	}
+	if err := binding.Validator.ValidateStruct(&entry); err != nil {
+		return entry, ParseValidationErrors(err)
+	}
+	if entry.Traceparent != "" {
+		if _, ok := tracing.ParseTraceparent(entry.Traceparent); !ok {
+			return entry, []ValidationError{{Field: "Traceparent", Message: "Traceparent must be a valid W3C traceparent header"}}
+		}
+	}
+	return entry, nil
+}
+
+// attachToTrace records frontend errors as span events in the trace the frontend reported,
+// next to the backend spans of the calls involved
+func attachToTrace(ctx context.Context, entry *models.FrontendLogEntry) {
+	if !entry.IsError() || entry.Traceparent == "" {
 		return
 	}
+	parent, ok := tracing.ParseTraceparent(entry.Traceparent)
+	if !ok {
+		return
+	}
+	tracing.RecordRemoteEvent(ctx, parent, "frontend.error", "exception",
+		attribute.String("exception.message", entry.Message),
+		attribute.String("log.level", entry.Level),
+		attribute.String("http.route", entry.Route),
+		attribute.String("request.id", entry.RequestID),
+	)
+}
 
-	logger.Info("Received frontend logs", zap.Int("count", len(req.Logs)))
-	c.JSON(http.StatusOK, gin.H{"success": true, "received": len(req.Logs)})
+// logLevelLabel keeps the level label bounded for rejected entries
+func logLevelLabel(level string) string {
+	if models.FrontendLogLevels[level] {
+		return level
+	}
+	return "unknown"
 }
 
-func (h *LogsHandler) writeLogsToFile(logs []LogEntry) error {
+func (h *LogsHandler) writeLogsToFile(logs []models.FrontendLogEntry) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -82,13 +159,32 @@ func (h *LogsHandler) writeLogsToFile(logs []LogEntry) error {
 	// Write each log entry as a JSON line
 	encoder := json.NewEncoder(f)
 	for _, entry := range logs {
+		timestamp := entry.Timestamp
+		if timestamp == "" {
+			timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+		}
+
 		// Reformat log entry to match backend format
 		logLine := map[string]interface{}{
-			"ts":      entry.Timestamp,
+			"ts":      timestamp,
 			"level":   entry.Level,
 			"msg":     entry.Message,
 			"service": "nextjs",
 		}
+		if entry.Route != "" {
+			logLine["route"] = entry.Route
+		}
+		if entry.UserAgent != "" {
+			logLine["user_agent"] = entry.UserAgent
+		}
+		if entry.RequestID != "" {
+			logLine["request_id"] = entry.RequestID
+		}
+		// Same field names as backend logs, so log-to-trace links work for frontend entries too
+		if spanContext, ok := tracing.ParseTraceparent(entry.Traceparent); ok {
+			logLine["trace_id"] = spanContext.TraceID().String()
+			logLine["span_id"] = spanContext.SpanID().String()
+		}
 
 		// Add context fields if present
 		if entry.Context != nil {
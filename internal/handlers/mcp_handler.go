@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/services"
 	"github.com/getmentor/getmentor-api/pkg/logger"
@@ -14,18 +17,42 @@ import (
 	"go.uber.org/zap"
 )
 
+// mcpQuotaKey holds the caller's MCPQuota for sendSuccess
+const mcpQuotaKey = "mcp_quota"
+
+// mcpMethodLabels are the JSON-RPC methods the handler routes; other methods are labeled "other"
+var mcpMethodLabels = metrics.NewKnownLabels("initialize", "tools/list", "tools/call")
+
 type MCPHandler struct {
 	service *services.MCPService
+	limiter *middleware.RateLimiter
+	// toolLabels are the names of the tools the service offers
+	toolLabels metrics.KnownLabels
 }
 
-func NewMCPHandler(service *services.MCPService) *MCPHandler {
-	return &MCPHandler{service: service}
+// NewMCPHandler creates the handler. Requests are counted against limiter per client IP and
+// limited with a JSON-RPC error rather than a 429, which AI clients tend to treat as fatal.
+// A nil limiter turns limiting off.
+func NewMCPHandler(service *services.MCPService, limiter *middleware.RateLimiter) *MCPHandler {
+	tools := service.GetAvailableTools()
+	toolNames := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		toolNames = append(toolNames, tool.Name)
+	}
+	return &MCPHandler{service: service, limiter: limiter, toolLabels: metrics.NewKnownLabels(toolNames...)}
 }
 
 // HandleMCPRequest handles MCP JSON-RPC 2.0 requests
 func (h *MCPHandler) HandleMCPRequest(c *gin.Context) {
 	start := time.Now()
 
+	// Counted before parsing, so malformed requests use up the budget too
+	var decision *middleware.RateLimitDecision
+	if h.limiter != nil {
+		taken := h.limiter.Take(c.ClientIP())
+		decision = &taken
+	}
+
 	var req models.MCPRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logger.Warn("Invalid MCP request format",
@@ -44,12 +71,29 @@ func (h *MCPHandler) HandleMCPRequest(c *gin.Context) {
 			zap.String("version", req.JSONRPC),
 			zap.String("remote_addr", c.ClientIP()))
 
-		metrics.MCPRequestTotal.WithLabelValues(req.Method, "400").Inc()
+		metrics.MCPRequestTotal.WithLabelValues(mcpMethodLabels.Value(req.Method), "400").Inc()
 
 		h.sendError(c, req.ID, models.InvalidRequest, "Invalid JSON-RPC version", "Must be '2.0'")
 		return
 	}
 
+	if decision != nil {
+		quota := mcpQuota(decision)
+		if !decision.Allowed {
+			logger.Warn("MCP rate limit exceeded",
+				zap.String("method", req.Method),
+				zap.Int("retry_after_seconds", quota.RetryAfterSeconds),
+				zap.String("remote_addr", c.ClientIP()))
+
+			metrics.MCPRequestTotal.WithLabelValues(mcpMethodLabels.Value(req.Method), "429").Inc()
+
+			c.Header("Retry-After", strconv.Itoa(quota.RetryAfterSeconds))
+			h.sendError(c, req.ID, models.RateLimited, "Rate limit exceeded, retry later", quota)
+			return
+		}
+		c.Set(mcpQuotaKey, quota)
+	}
+
 	logger.Info("MCP request received",
 		zap.String("method", req.Method),
 		zap.Any("id", req.ID),
@@ -58,7 +102,7 @@ func (h *MCPHandler) HandleMCPRequest(c *gin.Context) {
 	// Track request duration
 	defer func() {
 		duration := metrics.MeasureDuration(start)
-		metrics.MCPRequestDuration.WithLabelValues(req.Method).Observe(duration)
+		metrics.MCPRequestDuration.WithLabelValues(mcpMethodLabels.Value(req.Method)).Observe(duration)
 	}()
 
 	// Route to appropriate handler
@@ -74,7 +118,7 @@ func (h *MCPHandler) HandleMCPRequest(c *gin.Context) {
 			zap.String("method", req.Method),
 			zap.String("remote_addr", c.ClientIP()))
 
-		metrics.MCPRequestTotal.WithLabelValues(req.Method, "400").Inc()
+		metrics.MCPRequestTotal.WithLabelValues(mcpMethodLabels.Value(req.Method), "400").Inc()
 
 		h.sendError(c, req.ID, models.MethodNotFound, "Method not found", fmt.Sprintf("Unknown method: %s", req.Method))
 	}
@@ -153,7 +197,7 @@ func (h *MCPHandler) handleToolsCall(c *gin.Context, req models.MCPRequest) {
 			zap.String("remote_addr", c.ClientIP()))
 
 		metrics.MCPRequestTotal.WithLabelValues("tools/call", "400").Inc()
-		metrics.MCPToolInvocations.WithLabelValues(toolName, "error").Inc()
+		metrics.MCPToolInvocations.WithLabelValues(h.toolLabels.Value(toolName), "error").Inc()
 
 		h.sendError(c, req.ID, models.MethodNotFound, "Tool not found", fmt.Sprintf("Unknown tool: %s", toolName))
 	}
@@ -372,8 +416,14 @@ func (h *MCPHandler) handleSearchMentors(c *gin.Context, id interface{}, args ma
 	h.sendSuccess(c, id, toolResult)
 }
 
-// sendSuccess sends a successful JSON-RPC response
+// sendSuccess sends a successful JSON-RPC response, with the caller's quota under _meta
 func (h *MCPHandler) sendSuccess(c *gin.Context, id interface{}, result interface{}) {
+	if quota, ok := c.Get(mcpQuotaKey); ok {
+		if fields, isMap := result.(map[string]interface{}); isMap {
+			fields["_meta"] = map[string]interface{}{"quota": quota}
+		}
+	}
+
 	response := models.MCPResponse{
 		JSONRPC: "2.0",
 		Result:  result,
@@ -406,6 +456,19 @@ func (h *MCPHandler) sendError(c *gin.Context, id interface{}, code int, message
 	c.JSON(httpStatus, response)
 }
 
+// mcpQuota reports decision to the client, rounding the retry delay up to whole seconds
+func mcpQuota(decision *middleware.RateLimitDecision) models.MCPQuota {
+	quota := models.MCPQuota{
+		Limit:           decision.Limit,
+		Remaining:       decision.Remaining,
+		RefillPerSecond: decision.RefillPerSecond,
+	}
+	if !decision.Allowed {
+		quota.RetryAfterSeconds = max(int(math.Ceil(decision.RetryAfter.Seconds())), 1)
+	}
+	return quota
+}
+
 // getKeywordRange returns a range label for keyword count metrics
 func getKeywordRange(count int) string {
 	switch {
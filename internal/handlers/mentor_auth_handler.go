@@ -61,7 +61,8 @@ func (h *MentorAuthHandler) VerifyLogin(c *gin.Context) {
 		return
 	}
 
-	session, jwtToken, err := h.service.VerifyLogin(c.Request.Context(), req.Token)
+	client := models.DeviceClient{UserAgent: c.Request.UserAgent(), IP: c.ClientIP()}
+	session, jwtToken, err := h.service.VerifyLogin(c.Request.Context(), req.Token, client)
 	if err != nil {
 		if errors.Is(err, services.ErrInvalidLoginToken) {
 			respondError(c, http.StatusUnauthorized, "Invalid token", err)
@@ -95,8 +96,14 @@ func (h *MentorAuthHandler) VerifyLogin(c *gin.Context) {
 }
 
 // Logout handles POST /api/v1/auth/mentor/logout
-// Clears the session cookie
+// Revokes the device session and clears the session cookie
 func (h *MentorAuthHandler) Logout(c *gin.Context) {
+	if cookie, err := c.Cookie(middleware.MentorSessionCookieName); err == nil {
+		if logoutErr := h.service.Logout(c.Request.Context(), cookie); logoutErr != nil {
+			_ = c.Error(logoutErr) //nolint:errcheck
+		}
+	}
+
 	middleware.ClearSessionCookie(
 		c,
 		h.service.GetCookieDomain(),
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorDataIssueHandler serves the admin queue of mentor data issues flagged by cmd/validate-data
+type MentorDataIssueHandler struct {
+	service services.MentorDataValidationServiceInterface
+}
+
+// NewMentorDataIssueHandler creates a new MentorDataIssueHandler
+func NewMentorDataIssueHandler(service services.MentorDataValidationServiceInterface) *MentorDataIssueHandler {
+	return &MentorDataIssueHandler{service: service}
+}
+
+// ListIssues handles GET /api/v1/admin/data-issues
+func (h *MentorDataIssueHandler) ListIssues(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	issues, err := h.service.ListOpenIssues(c.Request.Context(), session)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch mentor data issues", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.MentorDataIssuesResponse{
+		Issues: issues,
+		Total:  len(issues),
+	})
+}
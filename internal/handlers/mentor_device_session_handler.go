@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorDeviceSessionHandler lets mentors see and revoke their portal sessions
+type MentorDeviceSessionHandler struct {
+	service      services.MentorDeviceSessionServiceInterface
+	cookieDomain string
+	cookieSecure bool
+}
+
+// NewMentorDeviceSessionHandler creates a new MentorDeviceSessionHandler
+func NewMentorDeviceSessionHandler(service services.MentorDeviceSessionServiceInterface, cookieDomain string, cookieSecure bool) *MentorDeviceSessionHandler {
+	return &MentorDeviceSessionHandler{
+		service:      service,
+		cookieDomain: cookieDomain,
+		cookieSecure: cookieSecure,
+	}
+}
+
+// ListSessions handles GET /api/v1/auth/mentor/sessions
+func (h *MentorDeviceSessionHandler) ListSessions(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	sessions, err := h.service.ListSessions(c.Request.Context(), session.MentorID, session.SessionID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch sessions", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.DeviceSessionsResponse{Sessions: sessions})
+}
+
+// RevokeSession handles DELETE /api/v1/auth/mentor/sessions/:id
+// Revoking the current session logs the mentor out, so the cookie is cleared as well
+func (h *MentorDeviceSessionHandler) RevokeSession(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	sessionID := c.Param("id")
+	if err := h.service.RevokeSession(c.Request.Context(), session.MentorID, sessionID); err != nil {
+		if errors.Is(err, repository.ErrDeviceSessionNotFound) {
+			respondError(c, http.StatusNotFound, "Session not found", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to revoke session", err)
+		return
+	}
+
+	current := sessionID == session.SessionID
+	if current {
+		middleware.ClearSessionCookie(c, h.cookieDomain, h.cookieSecure)
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true, "current": current})
+}
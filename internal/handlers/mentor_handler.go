@@ -1,13 +1,22 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/feed"
 	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/tabular"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -24,15 +33,59 @@ func NewMentorHandler(service services.MentorServiceInterface, baseURL string) *
 	}
 }
 
+// GetPublicMentors handles GET /api/v1/mentors with optional country, city, remoteOnly and languages filters
+// and an optional sort (with seed for random_seeded)
 func (h *MentorHandler) GetPublicMentors(c *gin.Context) {
-	mentors, err := h.service.GetAllMentors(c.Request.Context(), models.FilterOptions{
+	filter, err := parseMentorSearchFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+
+	sortBy, err := models.ParseMentorSort(c.Query("sort"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+
+	opts := models.FilterOptions{
 		OnlyVisible: true,
-	})
+		Sort:        sortBy,
+		SortSeed:    c.Query("seed"),
+		Experience:  strings.TrimSpace(c.Query("experience")),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		opts.Tags = strings.Split(tags, ",")
+	}
+
+	mentors, err := h.service.GetAllMentors(c.Request.Context(), opts)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, "Failed to fetch mentors", err)
 		return
 	}
 
+	mentors = filter.Apply(mentors)
+	middleware.SetResultCount(c, len(mentors))
+	recordMentorListExposures(sortBy, mentors)
+
+	// Partners that can't ingest JSON ask for XML or CSV with the Accept header
+	switch c.NegotiateFormat(gin.MIMEJSON, gin.MIMEXML, gin.MIMEXML2, "text/csv") {
+	case gin.MIMEXML, gin.MIMEXML2:
+		h.streamPublicMentors(c, tabular.XMLContentType, func(w io.Writer) error {
+			return tabular.WriteXML(w, "mentors", "mentor", len(mentors), func(i int) interface{} {
+				return mentors[i].ToPublicResponse(h.baseURL)
+			})
+		})
+		return
+	case "text/csv":
+		h.streamPublicMentors(c, tabular.CSVContentType, func(w io.Writer) error {
+			return tabular.WriteCSV(w, models.PublicMentorCSVHeader, len(mentors), func(i int) []string {
+				return mentors[i].ToPublicResponse(h.baseURL).CSVRow()
+			})
+		})
+		return
+	}
+
 	publicMentors := make([]models.PublicMentorResponse, 0, len(mentors))
 	for _, mentor := range mentors {
 		publicMentors = append(publicMentors, mentor.ToPublicResponse(h.baseURL))
@@ -41,6 +94,214 @@ func (h *MentorHandler) GetPublicMentors(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"mentors": publicMentors})
 }
 
+// SearchMentors handles GET /api/v1/mentors/search?q=...&tags=...&limit=...&offset=... with the same
+// location and language filters as the list. q uses web search syntax: words, "quoted phrases", or, -word.
+func (h *MentorHandler) SearchMentors(c *gin.Context) {
+	location, err := parseMentorSearchFilter(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	filters := models.MentorTextSearchFilters{MentorSearchFilter: location}
+	if tags := c.Query("tags"); tags != "" {
+		filters.Tags = strings.Split(tags, ",")
+	}
+
+	limit, offset := 0, 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid limit", err)
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if offset, err = strconv.Atoi(offsetStr); err != nil || offset < 0 {
+			respondError(c, http.StatusBadRequest, "Invalid offset", err)
+			return
+		}
+	}
+	limit, offset = models.ClampMentorSearchPage(limit, offset)
+
+	mentors, err := h.service.SearchMentors(c.Request.Context(), c.Query("q"), filters, limit, offset)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidInput) {
+			respondError(c, http.StatusBadRequest, "Invalid request", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to search mentors", err)
+		return
+	}
+	middleware.SetResultCount(c, len(mentors))
+
+	publicMentors := make([]models.PublicMentorResponse, 0, len(mentors))
+	for _, mentor := range mentors {
+		publicMentors = append(publicMentors, mentor.ToPublicResponse(h.baseURL))
+	}
+	c.JSON(http.StatusOK, models.MentorSearchResponse{
+		Mentors: publicMentors,
+		Count:   len(publicMentors),
+		Limit:   limit,
+		Offset:  offset,
+	})
+}
+
+// streamPublicMentors writes an encoded mentor list straight to the response.
+// The status is sent before encoding starts, so a failure midway can only be logged.
+func (h *MentorHandler) streamPublicMentors(c *gin.Context, contentType string, encode func(w io.Writer) error) {
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+	if err := encode(c.Writer); err != nil {
+		logger.Error("Failed to stream mentor list",
+			zap.String("content_type", contentType),
+			zap.Error(err))
+	}
+}
+
+// recordMentorListExposures counts the exposure buckets of the mentors on the first screen
+func recordMentorListExposures(sortBy models.MentorSort, mentors []*models.Mentor) {
+	sortLabel := string(sortBy)
+	if sortBy == models.MentorSortDefault {
+		sortLabel = "default"
+	}
+	for i := 0; i < len(mentors) && i < models.MentorListFoldSize; i++ {
+		metrics.MentorListExposures.WithLabelValues(sortLabel, models.MentorExposureBucket(mentors[i])).Inc()
+	}
+}
+
+// parseMentorSearchFilter reads the location and language filters from the query string.
+// languages is a comma-separated list; mentors speaking any of them match.
+func parseMentorSearchFilter(c *gin.Context) (models.MentorSearchFilter, error) {
+	country, err := models.NormalizeCountryCode(c.Query("country"))
+	if err != nil {
+		return models.MentorSearchFilter{}, fmt.Errorf("invalid country: %w", err)
+	}
+
+	filter := models.MentorSearchFilter{
+		Country: country,
+		City:    strings.TrimSpace(c.Query("city")),
+	}
+	if remoteOnly := c.Query("remoteOnly"); remoteOnly != "" {
+		parsed, err := strconv.ParseBool(remoteOnly)
+		if err != nil {
+			return models.MentorSearchFilter{}, fmt.Errorf("invalid remoteOnly, expected true or false")
+		}
+		filter.RemoteOnly = &parsed
+	}
+	if languages := c.Query("languages"); languages != "" {
+		normalized, err := models.NormalizeLanguages(strings.Split(languages, ","))
+		if err != nil {
+			return models.MentorSearchFilter{}, fmt.Errorf("invalid languages: %w", err)
+		}
+		filter.Languages = normalized
+	}
+
+	return filter, nil
+}
+
+const (
+	newMentorsDefaultWindow = 7 * 24 * time.Hour
+	newMentorsDefaultLimit  = 50
+	newMentorsMaxLimit      = 100
+)
+
+// GetNewMentors handles GET /api/v1/mentors/new?since=<RFC 3339>&format=json|rss|atom.
+// The format can also be negotiated with the Accept header.
+func (h *MentorHandler) GetNewMentors(c *gin.Context) {
+	since := time.Now().Add(-newMentorsDefaultWindow)
+	if sinceStr := c.Query("since"); sinceStr != "" {
+		parsed, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid since, expected RFC 3339 timestamp", err)
+			return
+		}
+		since = parsed
+	}
+
+	limit := newMentorsDefaultLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > newMentorsMaxLimit {
+			respondError(c, http.StatusBadRequest, fmt.Sprintf("Invalid limit, expected 1-%d", newMentorsMaxLimit), err)
+			return
+		}
+		limit = parsed
+	}
+
+	newMentors, err := h.service.GetNewMentors(c.Request.Context(), since, limit)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch new mentors", err)
+		return
+	}
+	middleware.SetResultCount(c, len(newMentors))
+
+	format := c.Query("format")
+	if format == "" {
+		format = c.NegotiateFormat("application/json", "application/rss+xml", "application/atom+xml")
+	}
+
+	switch format {
+	case "rss", "application/rss+xml":
+		h.respondNewMentorsFeed(c, newMentors, feed.RSS, feed.RSSContentType)
+	case "atom", "application/atom+xml":
+		h.respondNewMentorsFeed(c, newMentors, feed.Atom, feed.AtomContentType)
+	default:
+		entries := make([]models.NewMentorResponse, 0, len(newMentors))
+		for _, m := range newMentors {
+			entries = append(entries, models.NewMentorResponse{
+				PublicMentorResponse: m.Mentor.ToPublicResponse(h.baseURL),
+				ApprovedAt:           m.ApprovedAt,
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{"mentors": entries})
+	}
+}
+
+func (h *MentorHandler) respondNewMentorsFeed(
+	c *gin.Context,
+	newMentors []models.NewMentor,
+	render func(feed.Feed, []feed.Item) ([]byte, error),
+	contentType string,
+) {
+
+	updated := time.Now()
+	if len(newMentors) > 0 {
+		updated = newMentors[0].ApprovedAt
+	}
+
+	items := make([]feed.Item, 0, len(newMentors))
+	for _, m := range newMentors {
+		link := h.baseURL + "/mentor/" + m.Mentor.Slug
+		title := m.Mentor.Name
+		if m.Mentor.Job != "" {
+			title += " — " + m.Mentor.Job
+		}
+		if m.Mentor.Workplace != "" {
+			title += " @ " + m.Mentor.Workplace
+		}
+		items = append(items, feed.Item{
+			ID:          link,
+			Title:       title,
+			Link:        link,
+			Description: m.Mentor.Description,
+			Published:   m.ApprovedAt,
+		})
+	}
+
+	data, err := render(feed.Feed{
+		ID:          h.baseURL + "/api/v1/mentors/new",
+		Title:       "GetMentor: новые менторы",
+		Link:        h.baseURL,
+		Description: "Менторы, недавно прошедшие модерацию",
+		Updated:     updated,
+	}, items)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to render feed", err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, data)
+}
+
 func (h *MentorHandler) GetPublicMentorByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorImportHandler serves bulk mentor imports from CSV
+type MentorImportHandler struct {
+	service services.MentorImportServiceInterface
+}
+
+// NewMentorImportHandler creates a new MentorImportHandler
+func NewMentorImportHandler(service services.MentorImportServiceInterface) *MentorImportHandler {
+	return &MentorImportHandler{service: service}
+}
+
+// ImportMentors handles POST /api/v1/admin/mentors/import. The CSV comes as the "file"
+// field of a multipart form or as the raw request body; ?dryRun=true only validates.
+func (h *MentorImportHandler) ImportMentors(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+	if session.Role != models.ModeratorRoleAdmin {
+		respondError(c, http.StatusForbidden, "Access denied", services.ErrAdminForbiddenAction)
+		return
+	}
+
+	var file io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "CSV file is required", err)
+			return
+		}
+		opened, err := header.Open()
+		if err != nil {
+			respondError(c, http.StatusBadRequest, "Failed to read the file", err)
+			return
+		}
+		defer opened.Close()
+		file = opened
+	}
+
+	rows, err := services.ParseMentorImportCSV(file)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidInput) {
+			respondErrorWithDetails(c, http.StatusBadRequest, "Invalid CSV file", gin.H{"message": err.Error()}, err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+		return
+	}
+
+	ctx, _ := requestContext(c)
+	c.JSON(http.StatusOK, h.service.Import(ctx, rows))
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorInsightsHandler serves mentors their private feedback insights
+type MentorInsightsHandler struct {
+	service services.MentorInsightsServiceInterface
+}
+
+// NewMentorInsightsHandler creates a new MentorInsightsHandler
+func NewMentorInsightsHandler(service services.MentorInsightsServiceInterface) *MentorInsightsHandler {
+	return &MentorInsightsHandler{service: service}
+}
+
+// GetMyInsights handles GET /api/v1/mentor/insights
+func (h *MentorInsightsHandler) GetMyInsights(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	report, err := h.service.GetReport(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to load insights", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"insights": report})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorMergeHandler merges duplicate mentor records
+type MentorMergeHandler struct {
+	service services.MentorMergeServiceInterface
+}
+
+// NewMentorMergeHandler creates a new MentorMergeHandler
+func NewMentorMergeHandler(service services.MentorMergeServiceInterface) *MentorMergeHandler {
+	return &MentorMergeHandler{service: service}
+}
+
+// MergeMentors handles POST /api/v1/admin/mentors/merge; with ?dryRun=true it reports what the
+// merge would move and rolls it back
+func (h *MentorMergeHandler) MergeMentors(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.MergeMentorsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	ctx, dryRun := requestContext(c)
+	result, err := h.service.MergeMentors(ctx, session, &req)
+	if err != nil {
+		respondMentorMergeError(c, err)
+		return
+	}
+
+	if dryRun {
+		c.JSON(http.StatusOK, gin.H{"merge": result, "dryRun": true})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"merge": result})
+}
+
+func respondMentorMergeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	case errors.Is(err, repository.ErrMergeMentorNotFound):
+		respondError(c, http.StatusNotFound, "Mentor not found", err)
+	case errors.Is(err, repository.ErrMentorAlreadyMerged):
+		respondError(c, http.StatusConflict, "Mentor already merged", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
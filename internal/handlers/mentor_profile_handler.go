@@ -1,11 +1,15 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/getmentor/getmentor-api/internal/events"
 	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -44,7 +48,15 @@ func (h *MentorProfileHandler) GetProfile(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"mentor": mentor})
+	// The version is read from the database: the cached mentor may lag behind the last save
+	version, err := h.profileService.GetProfileVersion(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to load profile", err)
+		return
+	}
+
+	c.Header("ETag", models.VersionETag(version))
+	c.JSON(http.StatusOK, gin.H{"mentor": mentor, "version": version})
 }
 
 // UpdateProfile handles POST /api/v1/mentor/profile
@@ -61,8 +73,22 @@ func (h *MentorProfileHandler) UpdateProfile(c *gin.Context) {
 		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", gin.H{"message": bindErr.Error()}, bindErr)
 		return
 	}
+	version, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+	if version != nil {
+		req.ExpectedVersion = version
+	}
 
 	err = h.profileService.SaveProfileByMentorId(c.Request.Context(), session.MentorID, &req)
+	if respondVersionConflict(c, err) {
+		return
+	}
+	if errors.Is(err, apperrors.ErrInvalidInput) {
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+		return
+	}
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, "Failed to update profile", err)
 		return
@@ -75,6 +101,40 @@ func (h *MentorProfileHandler) UpdateProfile(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SaveProfileResponse{Success: true})
 }
 
+// PatchProfile handles PATCH /api/v1/mentor/profile
+// Applies a JSON Merge Patch to the authenticated mentor's profile
+func (h *MentorProfileHandler) PatchProfile(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	version, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+	patch, ok := bindMergePatch(c)
+	if !ok {
+		return
+	}
+
+	changes, err := h.profileService.PatchProfileByMentorId(c.Request.Context(), session.MentorID, mergePatchWithVersion(patch, version))
+	if respondVersionConflict(c, err) {
+		return
+	}
+	if errors.Is(err, apperrors.ErrInvalidInput) {
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+		return
+	}
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to update profile", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PatchProfileResponse{Success: true, Changes: changes})
+}
+
 // UploadPicture handles POST /api/v1/mentor/profile/picture
 // Uploads a new profile picture for the authenticated mentor
 func (h *MentorProfileHandler) UploadPicture(c *gin.Context) {
@@ -100,6 +160,7 @@ func (h *MentorProfileHandler) UploadPicture(c *gin.Context) {
 		c.Request.Context(),
 		session.MentorID,
 		mentor.Slug,
+		events.ActorMentor,
 		&req,
 	)
 	if err != nil {
@@ -118,3 +179,73 @@ func (h *MentorProfileHandler) UploadPicture(c *gin.Context) {
 		ImageURL: imageURL,
 	})
 }
+
+// GetEmailChange handles GET /api/v1/mentor/profile/email
+// Returns the pending email change, if any
+func (h *MentorProfileHandler) GetEmailChange(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	change, err := h.profileService.GetPendingEmailChange(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch email change", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.EmailChangeResponse{Change: change})
+}
+
+// RequestEmailChange handles POST /api/v1/mentor/profile/email
+// Sends a confirmation link to the new address; the email changes once it is followed
+func (h *MentorProfileHandler) RequestEmailChange(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.RequestEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	change, err := h.profileService.RequestEmailChange(c.Request.Context(), session.MentorID, req.Email, "")
+	if err != nil {
+		respondEmailChangeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, models.EmailChangeResponse{Change: change})
+}
+
+// ConfirmEmailChange handles POST /api/v1/mentor-email-changes/:token/confirm
+// Public: the link is opened from the new mailbox, possibly on a device without a session
+func (h *MentorProfileHandler) ConfirmEmailChange(c *gin.Context) {
+	change, err := h.profileService.ConfirmEmailChange(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondEmailChangeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.EmailChangeResponse{Change: change})
+}
+
+func respondEmailChangeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	case errors.Is(err, repository.ErrEmailInUse):
+		respondError(c, http.StatusConflict, "Email is already used by another mentor", err)
+	case errors.Is(err, repository.ErrEmailChangeNotFound):
+		respondError(c, http.StatusNotFound, "Email change not found", err)
+	case errors.Is(err, repository.ErrEmailChangeClosed):
+		respondError(c, http.StatusGone, "Email change link has expired or was replaced", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
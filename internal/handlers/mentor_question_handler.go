@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorQuestionHandler serves the anonymous question box of mentor profiles
+type MentorQuestionHandler struct {
+	service services.MentorQuestionServiceInterface
+}
+
+// NewMentorQuestionHandler creates a new MentorQuestionHandler
+func NewMentorQuestionHandler(service services.MentorQuestionServiceInterface) *MentorQuestionHandler {
+	return &MentorQuestionHandler{service: service}
+}
+
+// AskQuestion handles POST /api/v1/mentor/:id/question
+func (h *MentorQuestionHandler) AskQuestion(c *gin.Context) {
+	id, ok := parseMentorLegacyID(c)
+	if !ok {
+		return
+	}
+
+	var req models.AskMentorQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	resp, err := h.service.AskQuestion(c.Request.Context(), id, &req)
+	if err != nil {
+		if resp != nil && resp.Error != "" {
+			attachError(c, err)
+			c.JSON(http.StatusBadRequest, resp)
+			return
+		}
+		respondMentorQuestionError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListPublished handles GET /api/v1/mentor/:id/questions
+func (h *MentorQuestionHandler) ListPublished(c *gin.Context) {
+	id, ok := parseMentorLegacyID(c)
+	if !ok {
+		return
+	}
+
+	questions, err := h.service.ListPublished(c.Request.Context(), id)
+	if err != nil {
+		respondMentorQuestionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"questions": questions})
+}
+
+// GetMyQuestions handles GET /api/v1/mentor/questions?status=pending|published|rejected
+func (h *MentorQuestionHandler) GetMyQuestions(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	questions, err := h.service.ListQuestions(c.Request.Context(), session.MentorID, c.Query("status"))
+	if err != nil {
+		respondMentorQuestionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"questions": questions})
+}
+
+// AnswerMyQuestion handles POST /api/v1/mentor/questions/:id/answer
+func (h *MentorQuestionHandler) AnswerMyQuestion(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.AnswerMentorQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	question, err := h.service.AnswerQuestion(c.Request.Context(), session.MentorID, c.Param("id"), &req)
+	if err != nil {
+		respondMentorQuestionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"question": question})
+}
+
+// RejectMyQuestion handles POST /api/v1/mentor/questions/:id/reject
+func (h *MentorQuestionHandler) RejectMyQuestion(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.RejectQuestion(c.Request.Context(), session.MentorID, c.Param("id")); err != nil {
+		respondMentorQuestionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func parseMentorLegacyID(c *gin.Context) (int, bool) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid ID", fmt.Errorf("invalid mentor id %q: %w", idStr, err))
+		return 0, false
+	}
+	return id, true
+}
+
+func respondMentorQuestionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrQuestionMentorNotFound):
+		respondError(c, http.StatusNotFound, "Mentor not found", err)
+	case errors.Is(err, repository.ErrMentorQuestionNotFound):
+		respondError(c, http.StatusNotFound, "Question not found", err)
+	case errors.Is(err, services.ErrQuestionInboxFull):
+		respondError(c, http.StatusTooManyRequests, "The mentor has too many unanswered questions, try again later", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
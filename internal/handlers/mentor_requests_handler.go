@@ -8,6 +8,7 @@ import (
 	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
@@ -139,6 +140,58 @@ func (h *MentorRequestsHandler) DeclineRequest(c *gin.Context) {
 	c.JSON(http.StatusOK, request)
 }
 
+// ScheduleRequest handles POST /api/v1/mentor/requests/:id/schedule
+func (h *MentorRequestsHandler) ScheduleRequest(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	requestID := c.Param("id")
+	if requestID == "" {
+		respondError(c, http.StatusBadRequest, "Invalid request ID", fmt.Errorf("missing route param: id"))
+		return
+	}
+
+	var payload models.ScheduleRequestPayload
+	if bindErr := c.ShouldBindJSON(&payload); bindErr != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(bindErr), bindErr)
+		return
+	}
+
+	request, err := h.service.ScheduleRequest(c.Request.Context(), session.MentorID, requestID, payload.ScheduledAt)
+	if err != nil {
+		h.handleRequestError(c, err, fmt.Errorf("failed to schedule request id=%q: %w", requestID, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, request)
+}
+
+// BotScheduleRequest handles POST /api/v1/bot/request/:id/schedule
+func (h *MentorRequestsHandler) BotScheduleRequest(c *gin.Context) {
+	requestID := c.Param("id")
+	if requestID == "" {
+		respondError(c, http.StatusBadRequest, "Invalid request ID", fmt.Errorf("missing route param: id"))
+		return
+	}
+
+	var payload models.ScheduleRequestPayload
+	if bindErr := c.ShouldBindJSON(&payload); bindErr != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(bindErr), bindErr)
+		return
+	}
+
+	request, err := h.service.ScheduleRequestFromBot(c.Request.Context(), requestID, payload.ScheduledAt)
+	if err != nil {
+		h.handleRequestError(c, err, fmt.Errorf("failed to schedule request id=%q from bot: %w", requestID, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, request)
+}
+
 // handleRequestError maps common request service errors to HTTP responses.
 func (h *MentorRequestsHandler) handleRequestError(c *gin.Context, err error, detail error) {
 	attachError(c, detail)
@@ -158,5 +211,13 @@ func (h *MentorRequestsHandler) handleRequestError(c *gin.Context, err error, de
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot decline request", "details": err.Error()})
 		return
 	}
-	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	if errors.Is(err, services.ErrCannotScheduleRequest) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot schedule request", "details": err.Error()})
+		return
+	}
+	if errors.Is(err, apperrors.ErrInvalidInput) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid scheduled time", "details": err.Error()})
+		return
+	}
+	respondError(c, http.StatusInternalServerError, "Internal server error", nil)
 }
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorStatsHandler serves per-mentor statistics to the admin UI
+type MentorStatsHandler struct {
+	service services.MentorStatsServiceInterface
+}
+
+// NewMentorStatsHandler creates a new MentorStatsHandler
+func NewMentorStatsHandler(service services.MentorStatsServiceInterface) *MentorStatsHandler {
+	return &MentorStatsHandler{service: service}
+}
+
+// GetStats handles GET /api/v1/internal/mentors/:id/stats
+func (h *MentorStatsHandler) GetStats(c *gin.Context) {
+	stats, err := h.service.GetStats(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrMentorStatsNotFound) {
+			respondError(c, http.StatusNotFound, "Mentor stats not found", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to load mentor stats", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"stats": stats})
+}
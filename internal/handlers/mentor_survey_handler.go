@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// MentorSurveyHandler serves mentor surveys to mentors and their results to moderators
+type MentorSurveyHandler struct {
+	service services.MentorSurveyServiceInterface
+}
+
+// NewMentorSurveyHandler creates a new MentorSurveyHandler
+func NewMentorSurveyHandler(service services.MentorSurveyServiceInterface) *MentorSurveyHandler {
+	return &MentorSurveyHandler{service: service}
+}
+
+// GetMySurvey handles GET /api/v1/mentor/survey
+func (h *MentorSurveyHandler) GetMySurvey(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	survey, err := h.service.GetOpen(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondMentorSurveyError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"survey": survey})
+}
+
+// SubmitMySurvey handles POST /api/v1/mentor/survey
+func (h *MentorSurveyHandler) SubmitMySurvey(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.SubmitMentorSurveyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	survey, err := h.service.Submit(c.Request.Context(), session.MentorID, &req)
+	if err != nil {
+		respondMentorSurveyError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"survey": survey})
+}
+
+// GetSummary handles GET /api/v1/admin/analytics/mentor-surveys
+func (h *MentorSurveyHandler) GetSummary(c *gin.Context) {
+	quarters := 0
+	if quartersStr := c.Query("quarters"); quartersStr != "" {
+		var err error
+		if quarters, err = strconv.Atoi(quartersStr); err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid quarters", err)
+			return
+		}
+	}
+
+	summary, err := h.service.Summary(c.Request.Context(), quarters)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to load survey results", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"quarters": summary})
+}
+
+func respondMentorSurveyError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrMentorSurveyNotFound):
+		respondError(c, http.StatusNotFound, "No open survey", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Failed to process survey", err)
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// bindMergePatch reads a JSON Merge Patch body. Both application/merge-patch+json and
+// application/json are accepted. It writes the error response and returns false on failure.
+func bindMergePatch(c *gin.Context) (models.MergePatch, bool) {
+	contentType := c.ContentType()
+	if contentType != models.MergePatchContentType && contentType != gin.MIMEJSON {
+		respondError(c, http.StatusUnsupportedMediaType, "Unsupported content type",
+			fmt.Errorf("unsupported content type %q", contentType))
+		return nil, false
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid request body", err)
+		return nil, false
+	}
+
+	patch, err := models.ParseMergePatch(body)
+	if err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", gin.H{"message": err.Error()}, err)
+		return nil, false
+	}
+	return patch, true
+}
+
+// bindJSONPatch reads a JSON Patch body sent as application/json-patch+json.
+// It writes the error response and returns false on failure.
+func bindJSONPatch(c *gin.Context) (models.JSONPatch, bool) {
+	body, err := c.GetRawData()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid request body", err)
+		return nil, false
+	}
+
+	patch, err := models.ParseJSONPatch(body)
+	if err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", gin.H{"message": err.Error()}, err)
+		return nil, false
+	}
+	return patch, true
+}
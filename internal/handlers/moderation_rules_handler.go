@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ModerationRulesHandler serves the versioned moderation rules to moderators and admins
+type ModerationRulesHandler struct {
+	service services.ModerationRulesServiceInterface
+}
+
+// NewModerationRulesHandler creates a new ModerationRulesHandler
+func NewModerationRulesHandler(service services.ModerationRulesServiceInterface) *ModerationRulesHandler {
+	return &ModerationRulesHandler{service: service}
+}
+
+// GetRules handles GET /api/v1/admin/moderation-rules
+func (h *ModerationRulesHandler) GetRules(c *gin.Context) {
+	settings, err := h.service.GetCurrent(c.Request.Context())
+	if err != nil {
+		respondModerationRulesError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+// ListVersions handles GET /api/v1/admin/moderation-rules/versions
+func (h *ModerationRulesHandler) ListVersions(c *gin.Context) {
+	versions, err := h.service.ListVersions(c.Request.Context())
+	if err != nil {
+		respondModerationRulesError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"versions": versions})
+}
+
+// UpdateRules handles POST /api/v1/admin/moderation-rules
+func (h *ModerationRulesHandler) UpdateRules(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.UpdateModerationRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	settings, err := h.service.Update(c.Request.Context(), session, &req)
+	if err != nil {
+		respondModerationRulesError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+// RestoreVersion handles POST /api/v1/admin/moderation-rules/versions/:version/restore
+func (h *ModerationRulesHandler) RestoreVersion(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version <= 0 {
+		respondError(c, http.StatusBadRequest, "Invalid version", err)
+		return
+	}
+
+	settings, err := h.service.Restore(c.Request.Context(), session, version)
+	if err != nil {
+		respondModerationRulesError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func respondModerationRulesError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrModerationSettingsNotFound):
+		respondError(c, http.StatusNotFound, "Version not found", err)
+	case errors.Is(err, repository.ErrModerationSettingsConflict):
+		respondError(c, http.StatusConflict, "Moderation rules were changed by someone else, reload and try again", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Failed to load moderation rules", err)
+	}
+}
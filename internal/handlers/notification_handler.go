@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler lets mentors manage the quiet hours of their notifications
+type NotificationHandler struct {
+	service services.NotificationServiceInterface
+}
+
+// NewNotificationHandler creates a new NotificationHandler
+func NewNotificationHandler(service services.NotificationServiceInterface) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// GetMySettings handles GET /api/v1/mentor/notifications
+func (h *NotificationHandler) GetMySettings(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	settings, err := h.service.GetSettings(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to read notification settings", err)
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
+
+// SetMyQuietHours handles POST /api/v1/mentor/notifications/quiet-hours
+func (h *NotificationHandler) SetMyQuietHours(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.SetQuietHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	settings, err := h.service.SetQuietHours(c.Request.Context(), session.MentorID, *req.QuietHours)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidInput) {
+			respondError(c, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to update quiet hours", err)
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ogImageRedirectCacheControl lets crawlers reuse the redirect for a while; the target
+// itself is immutable
+const ogImageRedirectCacheControl = "public, max-age=3600"
+
+// OGImageHandler serves mentor share cards
+type OGImageHandler struct {
+	service services.OGImageServiceInterface
+}
+
+// NewOGImageHandler creates a new OGImageHandler
+func NewOGImageHandler(service services.OGImageServiceInterface) *OGImageHandler {
+	return &OGImageHandler{service: service}
+}
+
+// GetOGImage handles GET /api/v1/mentor/:id/og-image, where :id is the mentor slug.
+// It redirects to the stored PNG card, or returns the PNG when object storage is off.
+func (h *OGImageHandler) GetOGImage(c *gin.Context) {
+	slug := c.Param("id")
+
+	card, err := h.service.GetCard(c.Request.Context(), slug)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			respondError(c, http.StatusNotFound, "Mentor not found", fmt.Errorf("share card for %q: %w", slug, err))
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to render share card", err)
+		return
+	}
+
+	c.Header("Cache-Control", ogImageRedirectCacheControl)
+	if card.URL != "" {
+		c.Redirect(http.StatusFound, card.URL)
+		return
+	}
+	c.Data(http.StatusOK, "image/png", card.PNG)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// PartnerAuditHandler exposes the audit log of partner-token requests to admins
+type PartnerAuditHandler struct {
+	service services.PartnerAuditServiceInterface
+}
+
+// NewPartnerAuditHandler creates a new PartnerAuditHandler
+func NewPartnerAuditHandler(service services.PartnerAuditServiceInterface) *PartnerAuditHandler {
+	return &PartnerAuditHandler{service: service}
+}
+
+// ListEntries handles GET /api/v1/admin/partner-audit?token=...&route=...&from=...&to=...&limit=...
+// from and to accept RFC 3339 timestamps or YYYY-MM-DD dates (midnight UTC); to is exclusive.
+func (h *PartnerAuditHandler) ListEntries(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	filter := models.PartnerRequestLogFilter{
+		TokenID: c.Query("token"),
+		Route:   c.Query("route"),
+	}
+	if filter.From, err = parseAuditTime(c.Query("from")); err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid from", err)
+		return
+	}
+	if filter.To, err = parseAuditTime(c.Query("to")); err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid to", err)
+		return
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if filter.Limit, err = strconv.Atoi(limitStr); err != nil {
+			respondError(c, http.StatusBadRequest, "Invalid limit", err)
+			return
+		}
+	}
+
+	entries, err := h.service.List(c.Request.Context(), session, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAdminForbiddenAction):
+			respondError(c, http.StatusForbidden, "Access denied", err)
+		case errors.Is(err, apperrors.ErrInvalidInput):
+			respondError(c, http.StatusBadRequest, "Invalid request", err)
+		default:
+			respondError(c, http.StatusInternalServerError, "Failed to load partner audit log", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PartnerRequestLogListResponse{
+		Entries: entries,
+		Total:   len(entries),
+	})
+}
+
+func parseAuditTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("expected RFC 3339 timestamp or YYYY-MM-DD date, got %q", value)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// PartnerQuotaHandler exposes monthly quota usage to partners and quota management to admins
+type PartnerQuotaHandler struct {
+	service services.PartnerQuotaServiceInterface
+}
+
+// NewPartnerQuotaHandler creates a new PartnerQuotaHandler
+func NewPartnerQuotaHandler(service services.PartnerQuotaServiceInterface) *PartnerQuotaHandler {
+	return &PartnerQuotaHandler{service: service}
+}
+
+// GetUsage handles GET /api/v1/usage for the partner token of the request
+func (h *PartnerQuotaHandler) GetUsage(c *gin.Context) {
+	usage, err := h.service.GetUsage(c.Request.Context(), middleware.GetPartnerTokenID(c))
+	if err != nil {
+		respondPartnerQuotaError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"usage": usage})
+}
+
+// ListUsage handles GET /api/v1/admin/partner-quotas
+func (h *PartnerQuotaHandler) ListUsage(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	usage, err := h.service.ListUsage(c.Request.Context(), session)
+	if err != nil {
+		respondPartnerQuotaError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"quotas": usage})
+}
+
+// SetOverride handles POST /api/v1/admin/partner-quotas/:token
+func (h *PartnerQuotaHandler) SetOverride(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.SetPartnerQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	override, err := h.service.SetOverride(c.Request.Context(), session, c.Param("token"), &req)
+	if err != nil {
+		respondPartnerQuotaError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"override": override})
+}
+
+// DeleteOverride handles DELETE /api/v1/admin/partner-quotas/:token
+func (h *PartnerQuotaHandler) DeleteOverride(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteOverride(c.Request.Context(), session, c.Param("token")); err != nil {
+		respondPartnerQuotaError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondPartnerQuotaError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, apperrors.ErrNotFound):
+		respondError(c, http.StatusNotFound, "Quota not found", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Failed to load partner quotas", err)
+	}
+}
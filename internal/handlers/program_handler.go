@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ProgramHandler serves mentor programs (group workshops): public listings,
+// attendee registration, and CRUD for mentors and admins
+type ProgramHandler struct {
+	service services.ProgramServiceInterface
+}
+
+// NewProgramHandler creates a new ProgramHandler
+func NewProgramHandler(service services.ProgramServiceInterface) *ProgramHandler {
+	return &ProgramHandler{service: service}
+}
+
+// ListPrograms handles GET /api/v1/programs
+func (h *ProgramHandler) ListPrograms(c *gin.Context) {
+	programs, err := h.service.ListPublicPrograms(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch programs", err)
+		return
+	}
+	respondPrograms(c, programs)
+}
+
+// ListMentorPrograms handles GET /api/v1/mentor/:id/programs
+func (h *ProgramHandler) ListMentorPrograms(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "Invalid ID", fmt.Errorf("invalid mentor id %q: %w", idStr, err))
+		return
+	}
+
+	programs, err := h.service.ListMentorPublicPrograms(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, http.StatusNotFound, "Mentor not found", fmt.Errorf("mentor id=%d not found: %w", id, err))
+		return
+	}
+	respondPrograms(c, programs)
+}
+
+// RegisterAttendee handles POST /api/v1/programs/:id/register
+func (h *ProgramHandler) RegisterAttendee(c *gin.Context) {
+	var req models.RegisterProgramAttendeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	resp, err := h.service.RegisterAttendee(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		switch {
+		case resp != nil:
+			attachError(c, err)
+			status := http.StatusInternalServerError
+			if !resp.Success && resp.Error == "Captcha verification failed" {
+				status = http.StatusBadRequest
+			}
+			c.JSON(status, resp)
+		case errors.Is(err, repository.ErrProgramNotFound):
+			respondError(c, http.StatusNotFound, "Program not found", err)
+		case errors.Is(err, services.ErrProgramClosed):
+			respondError(c, http.StatusConflict, "Program is not open for registration", err)
+		case errors.Is(err, repository.ErrAlreadyRegistered):
+			respondError(c, http.StatusConflict, "Already registered for this program", err)
+		default:
+			respondError(c, http.StatusInternalServerError, "Internal server error", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CancelRegistration handles POST /api/v1/program-registrations/:token/cancel
+func (h *ProgramHandler) CancelRegistration(c *gin.Context) {
+	resp, err := h.service.CancelRegistration(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondRegistrationError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetRegistrationCalendar handles GET /api/v1/program-registrations/:token/calendar.ics
+func (h *ProgramHandler) GetRegistrationCalendar(c *gin.Context) {
+	data, err := h.service.GetRegistrationCalendar(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondRegistrationError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="program.ics"`)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", data)
+}
+
+// GetMyPrograms handles GET /api/v1/mentor/programs
+func (h *ProgramHandler) GetMyPrograms(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	programs, err := h.service.ListMentorPrograms(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch programs", err)
+		return
+	}
+	respondPrograms(c, programs)
+}
+
+// CreateMyProgram handles POST /api/v1/mentor/programs
+func (h *ProgramHandler) CreateMyProgram(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindProgramRequest(c)
+	if !ok {
+		return
+	}
+
+	program, err := h.service.CreateMentorProgram(c.Request.Context(), session.MentorID, req)
+	if err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"program": program})
+}
+
+// UpdateMyProgram handles POST /api/v1/mentor/programs/:id
+func (h *ProgramHandler) UpdateMyProgram(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindProgramRequest(c)
+	if !ok {
+		return
+	}
+
+	program, err := h.service.UpdateMentorProgram(c.Request.Context(), session.MentorID, c.Param("id"), req)
+	if err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"program": program})
+}
+
+// DeleteMyProgram handles DELETE /api/v1/mentor/programs/:id
+func (h *ProgramHandler) DeleteMyProgram(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteMentorProgram(c.Request.Context(), session.MentorID, c.Param("id")); err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// AdminListPrograms handles GET /api/v1/admin/programs
+func (h *ProgramHandler) AdminListPrograms(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	programs, err := h.service.ListPrograms(c.Request.Context(), session, c.Query("mentorId"))
+	if err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	respondPrograms(c, programs)
+}
+
+// AdminCreateProgram handles POST /api/v1/admin/programs
+func (h *ProgramHandler) AdminCreateProgram(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindProgramRequest(c)
+	if !ok {
+		return
+	}
+
+	program, err := h.service.CreateProgram(c.Request.Context(), session, req)
+	if err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"program": program})
+}
+
+// AdminUpdateProgram handles POST /api/v1/admin/programs/:id
+func (h *ProgramHandler) AdminUpdateProgram(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindProgramRequest(c)
+	if !ok {
+		return
+	}
+
+	program, err := h.service.UpdateProgram(c.Request.Context(), session, c.Param("id"), req)
+	if err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"program": program})
+}
+
+// AdminDeleteProgram handles DELETE /api/v1/admin/programs/:id
+func (h *ProgramHandler) AdminDeleteProgram(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteProgram(c.Request.Context(), session, c.Param("id")); err != nil {
+		respondProgramError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func bindProgramRequest(c *gin.Context) (*models.ProgramRequest, bool) {
+	var req models.ProgramRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return nil, false
+	}
+	return &req, true
+}
+
+func respondPrograms(c *gin.Context, programs []*models.Program) {
+	c.JSON(http.StatusOK, models.ProgramsListResponse{
+		Programs: programs,
+		Total:    len(programs),
+	})
+}
+
+func respondRegistrationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrRegistrationNotFound):
+		respondError(c, http.StatusNotFound, "Registration not found", err)
+	case errors.Is(err, repository.ErrProgramNotFound):
+		respondError(c, http.StatusNotFound, "Program not found", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
+
+func respondProgramError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrProgramNotFound):
+		respondError(c, http.StatusNotFound, "Program not found", err)
+	case errors.Is(err, apperrors.ErrNotFound):
+		respondError(c, http.StatusNotFound, "Mentor not found", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// PublicStatsHandler serves the landing page counters
+type PublicStatsHandler struct {
+	service services.PublicStatsServiceInterface
+}
+
+// NewPublicStatsHandler creates a new PublicStatsHandler
+func NewPublicStatsHandler(service services.PublicStatsServiceInterface) *PublicStatsHandler {
+	return &PublicStatsHandler{service: service}
+}
+
+// GetStats handles GET /api/v1/public-stats
+func (h *PublicStatsHandler) GetStats(c *gin.Context) {
+	stats, err := h.service.GetStats(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch stats", err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Header("Pragma", "")
+	c.JSON(http.StatusOK, stats)
+}
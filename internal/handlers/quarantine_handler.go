@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// QuarantineHandler serves the admin queue of requests held back by shadow antispam rules
+type QuarantineHandler struct {
+	service services.QuarantineServiceInterface
+}
+
+// NewQuarantineHandler creates a new QuarantineHandler
+func NewQuarantineHandler(service services.QuarantineServiceInterface) *QuarantineHandler {
+	return &QuarantineHandler{service: service}
+}
+
+// ListQuarantined handles GET /api/v1/admin/quarantine
+func (h *QuarantineHandler) ListQuarantined(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	requests, err := h.service.ListQuarantined(c.Request.Context(), session)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to fetch quarantined requests", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.QuarantinedRequestsResponse{
+		Requests: requests,
+		Total:    len(requests),
+	})
+}
+
+// SetVerdict handles POST /api/v1/admin/quarantine/:id/verdict
+func (h *QuarantineHandler) SetVerdict(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.QuarantineVerdictRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	if err := h.service.SetVerdict(c.Request.Context(), session, c.Param("id"), req.Verdict); err != nil {
+		if errors.Is(err, repository.ErrQuarantinedRequestNotFound) {
+			respondError(c, http.StatusNotFound, "Quarantined request not found", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
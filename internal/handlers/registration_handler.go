@@ -26,6 +26,7 @@ func (h *RegistrationHandler) RegisterMentor(c *gin.Context) {
 		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
 		return
 	}
+	req.ClientIP = c.ClientIP()
 
 	resp, err := h.service.RegisterMentor(c.Request.Context(), &req)
 	if err != nil {
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ReplyTemplateHandler handles mentors' saved reply templates
+type ReplyTemplateHandler struct {
+	service services.ReplyTemplateServiceInterface
+}
+
+// NewReplyTemplateHandler creates a new ReplyTemplateHandler
+func NewReplyTemplateHandler(service services.ReplyTemplateServiceInterface) *ReplyTemplateHandler {
+	return &ReplyTemplateHandler{service: service}
+}
+
+// ListTemplates handles GET /api/v1/mentor/templates
+func (h *ReplyTemplateHandler) ListTemplates(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	templates, err := h.service.ListTemplates(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondReplyTemplateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"templates": templates, "total": len(templates)})
+}
+
+// CreateTemplate handles POST /api/v1/mentor/templates
+func (h *ReplyTemplateHandler) CreateTemplate(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.ReplyTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	template, err := h.service.CreateTemplate(c.Request.Context(), session.MentorID, &req)
+	if err != nil {
+		respondReplyTemplateError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"template": template})
+}
+
+// UpdateTemplate handles POST /api/v1/mentor/templates/:id
+func (h *ReplyTemplateHandler) UpdateTemplate(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.ReplyTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	template, err := h.service.UpdateTemplate(c.Request.Context(), session.MentorID, c.Param("id"), &req)
+	if err != nil {
+		respondReplyTemplateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"template": template})
+}
+
+// DeleteTemplate handles DELETE /api/v1/mentor/templates/:id
+func (h *ReplyTemplateHandler) DeleteTemplate(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteTemplate(c.Request.Context(), session.MentorID, c.Param("id")); err != nil {
+		respondReplyTemplateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// RenderTemplate handles GET /api/v1/mentor/templates/:id/render?requestId=...
+func (h *ReplyTemplateHandler) RenderTemplate(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	requestID := c.Query("requestId")
+	if requestID == "" {
+		respondError(c, http.StatusBadRequest, "Missing required parameter: requestId", errors.New("missing query param: requestId"))
+		return
+	}
+
+	rendered, err := h.service.RenderTemplate(c.Request.Context(), session.MentorID, c.Param("id"), requestID)
+	if err != nil {
+		respondReplyTemplateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rendered)
+}
+
+func respondReplyTemplateError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrReplyTemplateNotFound):
+		respondError(c, http.StatusNotFound, "Template not found", err)
+	case errors.Is(err, services.ErrRequestNotFound):
+		respondError(c, http.StatusNotFound, "Request not found", err)
+	case errors.Is(err, services.ErrAccessDenied):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ReturningMentorHandler lets former mentors restore their profile
+type ReturningMentorHandler struct {
+	service services.ReturningMentorServiceInterface
+}
+
+// NewReturningMentorHandler creates a new ReturningMentorHandler
+func NewReturningMentorHandler(service services.ReturningMentorServiceInterface) *ReturningMentorHandler {
+	return &ReturningMentorHandler{service: service}
+}
+
+// Reactivate handles POST /api/v1/mentor/reactivate
+func (h *ReturningMentorHandler) Reactivate(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.Reactivate(c.Request.Context(), session.MentorID); err != nil {
+		if errors.Is(err, services.ErrReactivationNotAllowed) {
+			respondError(c, http.StatusForbidden, "Profile can't be restored automatically, please contact support", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to restore profile", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true, "status": "active"})
+}
@@ -1,12 +1,16 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/getmentor/getmentor-api/internal/middleware"
 	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
@@ -78,3 +82,131 @@ func (h *ReviewHandler) SubmitReview(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// ListPublished handles GET /api/v1/mentor/:id/reviews
+func (h *ReviewHandler) ListPublished(c *gin.Context) {
+	id, ok := parseMentorLegacyID(c)
+	if !ok {
+		return
+	}
+
+	reviews, err := h.service.ListPublished(c.Request.Context(), id)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"reviews": reviews})
+}
+
+// GetMyReviews handles GET /api/v1/mentor/reviews
+func (h *ReviewHandler) GetMyReviews(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	reviews, err := h.service.ListMentorReviews(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"reviews": reviews})
+}
+
+// ReplyToMyReview handles POST /api/v1/mentor/reviews/:id/reply
+func (h *ReviewHandler) ReplyToMyReview(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.ReviewReplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	review, err := h.service.ReplyToReview(c.Request.Context(), session.MentorID, c.Param("id"), &req)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"review": review})
+}
+
+// AdminListReviews handles GET /api/v1/admin/reviews?status=pending&replyStatus=pending
+func (h *ReviewHandler) AdminListReviews(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	filter := models.AdminReviewFilter{
+		Status:      c.Query("status"),
+		ReplyStatus: c.Query("replyStatus"),
+	}
+	reviews, err := h.service.ListForModeration(c.Request.Context(), session, filter)
+	if err != nil {
+		respondReviewError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"reviews": reviews, "total": len(reviews)})
+}
+
+// AdminApproveReview handles POST /api/v1/admin/reviews/:id/approve
+func (h *ReviewHandler) AdminApproveReview(c *gin.Context) {
+	h.moderate(c, h.service.ModerateReview, models.ReviewStatusApproved)
+}
+
+// AdminRejectReview handles POST /api/v1/admin/reviews/:id/reject
+func (h *ReviewHandler) AdminRejectReview(c *gin.Context) {
+	h.moderate(c, h.service.ModerateReview, models.ReviewStatusRejected)
+}
+
+// AdminApproveReply handles POST /api/v1/admin/reviews/:id/reply/approve
+func (h *ReviewHandler) AdminApproveReply(c *gin.Context) {
+	h.moderate(c, h.service.ModerateReply, models.ReviewStatusApproved)
+}
+
+// AdminRejectReply handles POST /api/v1/admin/reviews/:id/reply/reject
+func (h *ReviewHandler) AdminRejectReply(c *gin.Context) {
+	h.moderate(c, h.service.ModerateReply, models.ReviewStatusRejected)
+}
+
+func (h *ReviewHandler) moderate(
+	c *gin.Context,
+	action func(ctx context.Context, session *models.AdminSession, reviewID, status string) error,
+	status string,
+) {
+
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := action(c.Request.Context(), session, c.Param("id"), status); err != nil {
+		respondReviewError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondReviewError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrReviewMentorNotFound):
+		respondError(c, http.StatusNotFound, "Mentor not found", err)
+	case errors.Is(err, repository.ErrReviewNotFound):
+		respondError(c, http.StatusNotFound, "Review not found", err)
+	case errors.Is(err, repository.ErrReviewReplyExists):
+		respondError(c, http.StatusConflict, "The review already has a reply", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SessionCalendarHandler serves the iCal feed of a mentor's scheduled sessions
+type SessionCalendarHandler struct {
+	service services.SessionCalendarServiceInterface
+}
+
+// NewSessionCalendarHandler creates a new SessionCalendarHandler
+func NewSessionCalendarHandler(service services.SessionCalendarServiceInterface) *SessionCalendarHandler {
+	return &SessionCalendarHandler{service: service}
+}
+
+// GetMyFeed handles GET /api/v1/mentor/sessions.ics
+func (h *SessionCalendarHandler) GetMyFeed(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	data, err := h.service.GetMentorFeed(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to build calendar", err)
+		return
+	}
+	respondSessionCalendar(c, data)
+}
+
+// GetFeedByToken handles GET /api/v1/session-feeds/:token/sessions.ics
+func (h *SessionCalendarHandler) GetFeedByToken(c *gin.Context) {
+	data, err := h.service.GetFeedByToken(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+			respondError(c, http.StatusNotFound, "Calendar not found", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to build calendar", err)
+		return
+	}
+	respondSessionCalendar(c, data)
+}
+
+// GetFeedURL handles GET /api/v1/mentor/sessions/feed
+func (h *SessionCalendarHandler) GetFeedURL(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	resp, err := h.service.GetFeedURL(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to get calendar feed", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RotateFeedURL handles POST /api/v1/mentor/sessions/feed/rotate
+func (h *SessionCalendarHandler) RotateFeedURL(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	resp, err := h.service.RotateFeedToken(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to rotate calendar feed", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func respondSessionCalendar(c *gin.Context, data []byte) {
+	c.Header("Content-Disposition", `inline; filename="sessions.ics"`)
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", data)
+}
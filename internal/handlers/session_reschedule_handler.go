@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// SessionRescheduleHandler handles moving a scheduled session to a new time
+type SessionRescheduleHandler struct {
+	service services.SessionRescheduleServiceInterface
+}
+
+// NewSessionRescheduleHandler creates a new SessionRescheduleHandler
+func NewSessionRescheduleHandler(service services.SessionRescheduleServiceInterface) *SessionRescheduleHandler {
+	return &SessionRescheduleHandler{service: service}
+}
+
+// ProposeReschedule handles POST /api/v1/mentor/requests/:id/reschedule
+func (h *SessionRescheduleHandler) ProposeReschedule(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	requestID := c.Param("id")
+	if requestID == "" {
+		respondError(c, http.StatusBadRequest, "Invalid request ID", fmt.Errorf("missing route param: id"))
+		return
+	}
+
+	var req models.ProposeRescheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	resp, err := h.service.ProposeReschedule(c.Request.Context(), session.MentorID, requestID, &req)
+	if err != nil {
+		respondRescheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetProposal handles GET /api/v1/session-reschedules/:token
+func (h *SessionRescheduleHandler) GetProposal(c *gin.Context) {
+	proposal, err := h.service.GetProposal(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondRescheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, proposal)
+}
+
+// ConfirmReschedule handles POST /api/v1/session-reschedules/:token/confirm
+func (h *SessionRescheduleHandler) ConfirmReschedule(c *gin.Context) {
+	var req models.ConfirmRescheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	proposal, err := h.service.ConfirmReschedule(c.Request.Context(), c.Param("token"), &req)
+	if err != nil {
+		respondRescheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, proposal)
+}
+
+func respondRescheduleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrRequestNotFound):
+		respondError(c, http.StatusNotFound, "Request not found", err)
+	case errors.Is(err, services.ErrAccessDenied):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, repository.ErrRescheduleProposalNotFound):
+		respondError(c, http.StatusNotFound, "Proposal not found", err)
+	case errors.Is(err, repository.ErrRescheduleProposalClosed):
+		respondError(c, http.StatusConflict, "Proposal is no longer open", err)
+	case errors.Is(err, services.ErrRescheduleNotAllowed):
+		respondError(c, http.StatusConflict, "Request cannot be rescheduled", err)
+	case errors.Is(err, services.ErrRescheduleSlotNotOffered):
+		respondError(c, http.StatusBadRequest, "Slot was not offered", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
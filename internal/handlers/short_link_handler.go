@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ShortLinkHandler serves short profile links and their management API
+type ShortLinkHandler struct {
+	service services.ShortLinkServiceInterface
+}
+
+// NewShortLinkHandler creates a new ShortLinkHandler
+func NewShortLinkHandler(service services.ShortLinkServiceInterface) *ShortLinkHandler {
+	return &ShortLinkHandler{service: service}
+}
+
+// Redirect handles GET /m/:code
+func (h *ShortLinkHandler) Redirect(c *gin.Context) {
+	target, err := h.service.Resolve(c.Request.Context(), c.Param("code"), c.Request.UserAgent())
+	if err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	// Every hit must reach us to be counted
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, target)
+}
+
+// GetMyLinks handles GET /api/v1/mentor/short-links
+func (h *ShortLinkHandler) GetMyLinks(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	list, err := h.service.ListLinks(c.Request.Context(), session.MentorID)
+	if err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// CreateMyLink handles POST /api/v1/mentor/short-links
+func (h *ShortLinkHandler) CreateMyLink(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindShortLinkRequest(c)
+	if !ok {
+		return
+	}
+
+	link, err := h.service.CreateLink(c.Request.Context(), session.MentorID, req)
+	if err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"link": link})
+}
+
+// DeleteMyLink handles DELETE /api/v1/mentor/short-links/:code
+func (h *ShortLinkHandler) DeleteMyLink(c *gin.Context) {
+	session, err := middleware.GetMentorSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	if err := h.service.DeleteLink(c.Request.Context(), session.MentorID, c.Param("code")); err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// AdminListLinks handles GET /api/v1/admin/mentors/:id/short-links
+func (h *ShortLinkHandler) AdminListLinks(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	list, err := h.service.AdminListLinks(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// AdminCreateLink handles POST /api/v1/admin/mentors/:id/short-links
+func (h *ShortLinkHandler) AdminCreateLink(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	req, ok := bindShortLinkRequest(c)
+	if !ok {
+		return
+	}
+
+	link, err := h.service.AdminCreateLink(c.Request.Context(), session, c.Param("id"), req)
+	if err != nil {
+		respondShortLinkError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"link": link})
+}
+
+func bindShortLinkRequest(c *gin.Context) (*models.CreateShortLinkRequest, bool) {
+	var req models.CreateShortLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return nil, false
+	}
+	return &req, true
+}
+
+func respondShortLinkError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrShortLinkNotFound):
+		respondError(c, http.StatusNotFound, "Short link not found", err)
+	case errors.Is(err, repository.ErrShortLinkCodeTaken):
+		respondError(c, http.StatusConflict, "Short link code is taken", err)
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// TagAdminHandler lets operators manage the tags mentors pick from
+type TagAdminHandler struct {
+	service services.TagAdminServiceInterface
+}
+
+// NewTagAdminHandler creates a new TagAdminHandler
+func NewTagAdminHandler(service services.TagAdminServiceInterface) *TagAdminHandler {
+	return &TagAdminHandler{service: service}
+}
+
+// List handles GET /api/v1/internal/tags
+func (h *TagAdminHandler) List(c *gin.Context) {
+	tags, err := h.service.List(c.Request.Context())
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to list tags", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+// Create handles POST /api/v1/internal/tags
+func (h *TagAdminHandler) Create(c *gin.Context) {
+	var req models.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", ParseValidationErrors(err), err)
+		return
+	}
+
+	tag, err := h.service.Create(c.Request.Context(), &req)
+	if err != nil {
+		respondTagError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, tag)
+}
+
+// Delete handles DELETE /api/v1/internal/tags?name=
+func (h *TagAdminHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c.Request.Context(), c.Query("name")); err != nil {
+		respondTagError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func respondTagError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, err.Error(), err)
+	case errors.Is(err, repository.ErrTagNotFound):
+		respondError(c, http.StatusNotFound, "Tag not found", err)
+	case errors.Is(err, repository.ErrTagExists):
+		respondError(c, http.StatusConflict, "Tag already exists", err)
+	case errors.Is(err, repository.ErrTagInUse):
+		respondError(c, http.StatusConflict, "Tag is used by mentors", err)
+	default:
+		respondError(c, http.StatusInternalServerError, "Internal server error", err)
+	}
+}
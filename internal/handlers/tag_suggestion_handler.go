@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// TagSuggestionHandler suggests existing tags for profile text
+type TagSuggestionHandler struct {
+	service services.TagSuggestionServiceInterface
+}
+
+// NewTagSuggestionHandler creates a new TagSuggestionHandler
+func NewTagSuggestionHandler(service services.TagSuggestionServiceInterface) *TagSuggestionHandler {
+	return &TagSuggestionHandler{service: service}
+}
+
+// SuggestForText handles POST /api/v1/register-mentor/tag-suggestions and POST /api/v1/admin/tags/suggest
+func (h *TagSuggestionHandler) SuggestForText(c *gin.Context) {
+	var req models.SuggestTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	suggestions, err := h.service.SuggestForText(c.Request.Context(), req.Text, req.Exclude)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, "Failed to suggest tags", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TagSuggestionsResponse{Suggestions: suggestions})
+}
+
+// SuggestForMentor handles GET /api/v1/admin/mentors/:id/tag-suggestions
+func (h *TagSuggestionHandler) SuggestForMentor(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	suggestions, err := h.service.SuggestForMentor(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, services.ErrAdminForbiddenAction) {
+			respondError(c, http.StatusForbidden, "Access denied", err)
+			return
+		}
+		respondError(c, http.StatusInternalServerError, "Failed to suggest tags", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TagSuggestionsResponse{Suggestions: suggestions})
+}
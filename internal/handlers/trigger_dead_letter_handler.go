@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// TriggerDeadLetterHandler exposes failed outbound trigger deliveries to admins
+type TriggerDeadLetterHandler struct {
+	service services.TriggerDeadLetterServiceInterface
+}
+
+// NewTriggerDeadLetterHandler creates a new TriggerDeadLetterHandler
+func NewTriggerDeadLetterHandler(service services.TriggerDeadLetterServiceInterface) *TriggerDeadLetterHandler {
+	return &TriggerDeadLetterHandler{service: service}
+}
+
+// ListDeadLetters handles GET /api/v1/admin/trigger-dead-letters?status=dead|delivered|all&destination=...
+func (h *TriggerDeadLetterHandler) ListDeadLetters(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	filter := models.TriggerDeadLetterFilter(c.DefaultQuery("status", string(models.TriggerDeadLetterFilterDead)))
+	letters, err := h.service.ListDeadLetters(c.Request.Context(), session, filter, c.Query("destination"))
+	if err != nil {
+		respondTriggerDeadLetterError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TriggerDeadLettersListResponse{
+		DeadLetters: letters,
+		Total:       len(letters),
+	})
+}
+
+// GetDeadLetter handles GET /api/v1/admin/trigger-dead-letters/:id
+func (h *TriggerDeadLetterHandler) GetDeadLetter(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	letter, err := h.service.GetDeadLetter(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondTriggerDeadLetterError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TriggerDeadLetterResponse{DeadLetter: letter})
+}
+
+// RedriveMany handles POST /api/v1/admin/trigger-dead-letters/redrive
+func (h *TriggerDeadLetterHandler) RedriveMany(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	var req models.RedriveTriggerDeadLettersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		validationErrors := ParseValidationErrors(err)
+		respondErrorWithDetails(c, http.StatusBadRequest, "Validation failed", validationErrors, err)
+		return
+	}
+
+	results, err := h.service.RedriveMany(c.Request.Context(), session, req.IDs)
+	if err != nil {
+		respondTriggerDeadLetterError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TriggerRedriveResponse{Results: results})
+}
+
+// Redrive handles POST /api/v1/admin/trigger-dead-letters/:id/redrive
+func (h *TriggerDeadLetterHandler) Redrive(c *gin.Context) {
+	session, err := middleware.GetAdminSession(c)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, "Unauthorized", err)
+		return
+	}
+
+	letter, err := h.service.Redrive(c.Request.Context(), session, c.Param("id"))
+	if err != nil {
+		respondTriggerDeadLetterError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TriggerDeadLetterResponse{DeadLetter: letter})
+}
+
+func respondTriggerDeadLetterError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAdminForbiddenAction):
+		respondError(c, http.StatusForbidden, "Access denied", err)
+	case errors.Is(err, apperrors.ErrInvalidInput):
+		respondError(c, http.StatusBadRequest, "Invalid request", err)
+	case errors.Is(err, repository.ErrTriggerDeadLetterNotFound):
+		respondError(c, http.StatusNotFound, "Dead letter not found", err)
+	default:
+		respondError(c, http.StatusBadGateway, "Re-drive failed", err)
+	}
+}
@@ -65,6 +65,7 @@ func AdminSessionMiddleware(tokenManager *jwt.TokenManager, cookieDomain string,
 		}
 
 		c.Set(AdminSessionContextKey, session)
+		setAuditActor(c, models.AuditActorAdmin, session.ModeratorID)
 		c.Next()
 	}
 }
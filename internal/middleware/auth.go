@@ -3,6 +3,7 @@ package middleware
 import (
 	"net/http"
 
+	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/pkg/jwt"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/gin-gonic/gin"
@@ -28,6 +29,8 @@ func TokenAuthMiddleware(validTokens ...string) gin.HandlerFunc {
 		for _, validToken := range validTokens {
 			if jwt.TimingSafeCompare(token, validToken) {
 				valid = true
+				c.Set(partnerTokenIDKey, PartnerTokenID(validToken))
+				setAuditActor(c, models.AuditActorToken, PartnerTokenID(validToken))
 				break
 			}
 		}
@@ -89,6 +92,12 @@ func InternalAPIAuthMiddleware(validToken string) gin.HandlerFunc {
 			return
 		}
 
+		setAuditActor(c, models.AuditActorInternal, "")
 		c.Next()
 	}
 }
+
+// setAuditActor attaches the authenticated caller to the request context for the audit log
+func setAuditActor(c *gin.Context, actorType, actorID string) {
+	c.Request = c.Request.WithContext(models.WithAuditActor(c.Request.Context(), models.AuditActor{Type: actorType, ID: actorID}))
+}
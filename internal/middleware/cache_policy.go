@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CachePolicy assigns caching headers per route for the CDN in front of the API. Routes are Gin
+// route templates such as /api/v1/mentor/:id.
+type CachePolicy struct {
+	// ListRoutes are shared by the CDN for ListSMaxAge seconds and served stale for up to
+	// ListStaleWhileRevalidate more while it refetches; browsers always revalidate
+	ListRoutes               []string
+	ListSMaxAge              int
+	ListStaleWhileRevalidate int
+	// DetailRoutes may be cached by the CDN and browsers for DetailMaxAge seconds
+	DetailRoutes []string
+	DetailMaxAge int
+}
+
+// cacheHeaders are the Cache-Control and Surrogate-Control values of a cached route
+type cacheHeaders struct {
+	cacheControl     string
+	surrogateControl string
+}
+
+// CachePolicyMiddleware replaces the default no-store headers on the policy's list and detail
+// routes when they answer successfully. Mutations and errors are marked no-store for the CDN too.
+// Cached responses vary on the partner token, so the CDN never serves them to unauthenticated
+// requests. Register it after SecurityHeadersMiddleware.
+func CachePolicyMiddleware(policy CachePolicy) gin.HandlerFunc {
+	routes := make(map[string]cacheHeaders, len(policy.ListRoutes)+len(policy.DetailRoutes))
+	for _, route := range policy.ListRoutes {
+		routes[route] = cacheHeaders{
+			cacheControl: fmt.Sprintf("public, max-age=0, s-maxage=%d, stale-while-revalidate=%d",
+				policy.ListSMaxAge, policy.ListStaleWhileRevalidate),
+			surrogateControl: fmt.Sprintf("max-age=%d, stale-while-revalidate=%d",
+				policy.ListSMaxAge, policy.ListStaleWhileRevalidate),
+		}
+	}
+	for _, route := range policy.DetailRoutes {
+		routes[route] = cacheHeaders{
+			cacheControl:     fmt.Sprintf("public, max-age=%d", policy.DetailMaxAge),
+			surrogateControl: fmt.Sprintf("max-age=%d", policy.DetailMaxAge),
+		}
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead:
+			headers, ok := routes[c.FullPath()]
+			if !ok {
+				break
+			}
+			c.Writer = &cachePolicyWriter{ResponseWriter: c.Writer, headers: headers}
+		case http.MethodOptions:
+		default:
+			c.Header("Surrogate-Control", "no-store")
+		}
+		c.Next()
+	}
+}
+
+// cachePolicyWriter sets the route's caching headers once the status is known
+type cachePolicyWriter struct {
+	gin.ResponseWriter
+	headers cacheHeaders
+	applied bool
+}
+
+func (w *cachePolicyWriter) apply(code int) {
+	if w.applied || w.Written() {
+		return
+	}
+	w.applied = true
+	if code >= http.StatusBadRequest {
+		w.Header().Set("Surrogate-Control", "no-store")
+		return
+	}
+	w.Header().Set("Cache-Control", w.headers.cacheControl)
+	w.Header().Set("Surrogate-Control", w.headers.surrogateControl)
+	w.Header().Del("Pragma")
+	w.Header().Add("Vary", "mentors_api_auth_token")
+}
+
+func (w *cachePolicyWriter) WriteHeader(code int) {
+	w.apply(code)
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *cachePolicyWriter) WriteHeaderNow() {
+	w.apply(w.Status())
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *cachePolicyWriter) Write(data []byte) (int, error) {
+	w.apply(w.Status())
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cachePolicyWriter) WriteString(s string) (int, error) {
+	w.apply(w.Status())
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,15 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// CacheVersionHeader names the mentor cache version a response was served from
+const CacheVersionHeader = "X-Cache-Version"
+
+// CacheVersionMiddleware sets X-Cache-Version on every response, so clients talking to
+// replicas in several regions can tell responses built from different cache versions apart
+func CacheVersionMiddleware(version func() string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(CacheVersionHeader, version())
+		c.Next()
+	}
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// PublicCORSMiddleware opens the given paths to any origin and applies the
+// credentialed, allow-listed CORS policy to everything else. Public paths only
+// serve anonymous read-only data, so they are answered without credentials.
+func PublicCORSMiddleware(restricted gin.HandlerFunc, publicPaths ...string) gin.HandlerFunc {
+	public := make(map[string]bool, len(publicPaths))
+	for _, path := range publicPaths {
+		public[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if !public[c.Request.URL.Path] {
+			restricted(c)
+			return
+		}
+
+		c.Header("Access-Control-Allow-Origin", "*")
+		if c.Request.Method == http.MethodOptions {
+			c.Header("Access-Control-Allow-Methods", "GET, OPTIONS")
+			c.Header("Access-Control-Max-Age", "43200")
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
+
+// CORSMiddleware applies the per-origin CORS policy returned by policy, which may change between
+// requests. Requests without an Origin header pass through. Requests from unknown origins, and
+// requests or preflights with a method or header the origin may not use, are refused with 403 and
+// counted in cors_rejected_requests_total.
+func CORSMiddleware(policy func() *models.CORSPolicy, exposeHeaders []string, maxAge time.Duration) gin.HandlerFunc {
+	expose := strings.Join(exposeHeaders, ", ")
+	maxAgeSeconds := strconv.Itoa(int(maxAge.Seconds()))
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Origin")
+		rule, ok := policy().Lookup(origin)
+		if !ok {
+			rejectCORS(c, origin, "origin")
+			return
+		}
+
+		if c.Request.Method == http.MethodOptions {
+			if method := c.GetHeader("Access-Control-Request-Method"); method != "" && !rule.AllowsMethod(method) {
+				rejectCORS(c, origin, "method")
+				return
+			}
+			for _, header := range strings.Split(c.GetHeader("Access-Control-Request-Headers"), ",") {
+				if header = strings.TrimSpace(header); header != "" && !rule.AllowsHeader(header) {
+					rejectCORS(c, origin, "header")
+					return
+				}
+			}
+
+			setCORSOriginHeaders(c, origin, rule)
+			c.Header("Access-Control-Allow-Methods", strings.Join(rule.Methods(), ", "))
+			c.Header("Access-Control-Allow-Headers", strings.Join(rule.Headers(), ", "))
+			c.Header("Access-Control-Max-Age", maxAgeSeconds)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		if !rule.AllowsMethod(c.Request.Method) {
+			rejectCORS(c, origin, "method")
+			return
+		}
+		setCORSOriginHeaders(c, origin, rule)
+		if expose != "" {
+			c.Header("Access-Control-Expose-Headers", expose)
+		}
+		c.Next()
+	}
+}
+
+func setCORSOriginHeaders(c *gin.Context, origin string, rule *models.CORSOrigin) {
+	c.Header("Access-Control-Allow-Origin", origin)
+	if rule.AllowCredentials {
+		c.Header("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func rejectCORS(c *gin.Context, origin, reason string) {
+	metrics.CORSRejectedRequests.WithLabelValues(metrics.CORSOriginLabels.Value(origin), reason).Inc()
+	c.AbortWithStatus(http.StatusForbidden)
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// DataStaleHeader marks responses that may be built from mentor data kept past its TTL
+const DataStaleHeader = "X-Data-Stale"
+
+// DataStaleMiddleware sets X-Data-Stale: true while the mentor cache serves its last known data
+// because refreshing it keeps failing, so clients and monitoring can tell
+func DataStaleMiddleware(isStale func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isStale() {
+			c.Header(DataStaleHeader, "true")
+		}
+		c.Next()
+	}
+}
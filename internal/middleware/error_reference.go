@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Headers carrying the reference of a 5xx response
+const (
+	TraceIDHeader      = "X-Trace-Id"
+	IncidentCodeHeader = "X-Incident-Code"
+)
+
+const errorReferenceKey = "error_reference"
+
+// ErrorReference identifies a failed request for bug reports: the OpenTelemetry trace ID
+// and a short incident code users can read out to support
+type ErrorReference struct {
+	TraceID      string
+	IncidentCode string
+}
+
+// ErrorReferenceMiddleware adds the trace ID and incident code headers to every 5xx response.
+// Register it after otelgin so the request span exists.
+func ErrorReferenceMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer = &errorReferenceWriter{ResponseWriter: c.Writer, c: c}
+		c.Next()
+	}
+}
+
+// GetErrorReference returns the reference of the current request, created on first use
+func GetErrorReference(c *gin.Context) ErrorReference {
+	if ref, ok := c.Get(errorReferenceKey); ok {
+		return ref.(ErrorReference) //nolint:forcetypeassert // only set here
+	}
+
+	ref := ErrorReference{}
+	if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.IsValid() {
+		ref.TraceID = spanContext.TraceID().String()
+	}
+	ref.IncidentCode = incidentCode(ref.TraceID)
+	c.Set(errorReferenceKey, ref)
+	return ref
+}
+
+// RecoveryHandler answers a panicking request with 500 and its error reference (gin.CustomRecovery)
+func RecoveryHandler(c *gin.Context, _ any) {
+	ref := GetErrorReference(c)
+	body := gin.H{"error": "Internal server error", "incidentCode": ref.IncidentCode}
+	if ref.TraceID != "" {
+		body["traceId"] = ref.TraceID
+	}
+	c.AbortWithStatusJSON(http.StatusInternalServerError, body)
+}
+
+// incidentCode derives a short, readable code like GM-1A2B-3C4D from the trace ID, so support
+// can find the trace from the code alone. Requests without a trace get a random code.
+func incidentCode(traceID string) string {
+	raw := traceID
+	if len(raw) < 8 {
+		buf := make([]byte, 4)
+		_, _ = rand.Read(buf) //nolint:errcheck // crypto/rand never fails on supported platforms
+		raw = hex.EncodeToString(buf)
+	}
+	raw = strings.ToUpper(raw[:8])
+	return "GM-" + raw[:4] + "-" + raw[4:]
+}
+
+// errorReferenceWriter sets the reference headers when a 5xx status is written
+type errorReferenceWriter struct {
+	gin.ResponseWriter
+	c *gin.Context
+}
+
+func (w *errorReferenceWriter) WriteHeader(code int) {
+	if code >= http.StatusInternalServerError && !w.Written() {
+		ref := GetErrorReference(w.c)
+		if ref.TraceID != "" {
+			w.Header().Set(TraceIDHeader, ref.TraceID)
+		}
+		w.Header().Set(IncidentCodeHeader, ref.IncidentCode)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -23,8 +24,14 @@ var (
 	ErrInvalidSession  = errors.New("invalid session type")
 )
 
-// MentorSessionMiddleware validates JWT session cookie and adds session to context
-func MentorSessionMiddleware(tokenManager *jwt.TokenManager, cookieDomain string, cookieSecure bool) gin.HandlerFunc {
+// MentorSessionChecker reports whether a tracked device session is still active
+type MentorSessionChecker interface {
+	IsSessionActive(ctx context.Context, mentorID, sessionID string) (bool, error)
+}
+
+// MentorSessionMiddleware validates JWT session cookie and adds session to context.
+// When sessions is set, tokens bound to a device session are rejected once it is revoked.
+func MentorSessionMiddleware(tokenManager *jwt.TokenManager, sessions MentorSessionChecker, cookieDomain string, cookieSecure bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get session cookie
 		cookie, err := c.Cookie(MentorSessionCookieName)
@@ -52,6 +59,24 @@ func MentorSessionMiddleware(tokenManager *jwt.TokenManager, cookieDomain string
 			return
 		}
 
+		// Tokens issued before device sessions were tracked have no ID and stay valid until they expire
+		if sessions != nil && claims.ID != "" {
+			active, checkErr := sessions.IsSessionActive(c.Request.Context(), claims.MentorUUID, claims.ID)
+			if checkErr != nil {
+				_ = c.Error(fmt.Errorf("failed to check device session: %w", checkErr)) //nolint:errcheck
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service temporarily unavailable"})
+				c.Abort()
+				return
+			}
+			if !active {
+				_ = c.Error(fmt.Errorf("device session %s was revoked", claims.ID)) //nolint:errcheck
+				clearSessionCookie(c, cookieDomain, cookieSecure)
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Session revoked"})
+				c.Abort()
+				return
+			}
+		}
+
 		// Create session from claims
 		session := &models.MentorSession{
 			LegacyID:  claims.LegacyID,
@@ -60,10 +85,12 @@ func MentorSessionMiddleware(tokenManager *jwt.TokenManager, cookieDomain string
 			Name:      claims.Name,
 			ExpiresAt: claims.ExpiresAt.Unix(),
 			IssuedAt:  claims.IssuedAt.Unix(),
+			SessionID: claims.ID,
 		}
 
 		// Add session to context
 		c.Set(MentorSessionContextKey, session)
+		setAuditActor(c, models.AuditActorMentor, session.MentorID)
 		c.Next()
 	}
 }
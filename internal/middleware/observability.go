@@ -22,31 +22,31 @@ func ObservabilityMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		method := c.Request.Method
+		methodLabel := metrics.MethodLabel(method)
 
 		// Track active requests (method only - route not known until after routing)
-		metrics.ActiveRequests.WithLabelValues(method).Inc()
-		defer metrics.ActiveRequests.WithLabelValues(method).Dec()
+		metrics.ActiveRequests.WithLabelValues(methodLabel).Inc()
+		defer metrics.ActiveRequests.WithLabelValues(methodLabel).Dec()
 
 		// Process request - this allows Gin to set the matched route
 		c.Next()
 
 		// Get route template AFTER routing (prevents cardinality explosion)
 		// c.FullPath() returns the route pattern like "/api/v1/mentor/requests/:id"
-		// instead of the actual path like "/api/v1/mentor/requests/recXYZ123"
-		path := c.FullPath()
-		if path == "" {
-			// Fallback for unmatched routes (404s) - use a generic label
-			path = "unmatched"
-		}
+		// instead of the actual path like "/api/v1/mentor/requests/recXYZ123".
+		// Unmatched routes (404s) share a generic label and the number of distinct
+		// routes is capped as a last line of defence.
+		path := metrics.RouteLabel(c.FullPath())
 
 		// Measure duration
 		duration := metrics.MeasureDuration(start)
 		status := c.Writer.Status()
 		statusStr := strconv.Itoa(status)
 
-		// Record metrics with route template (not actual path)
-		metrics.HTTPRequestDuration.WithLabelValues(method, path, statusStr).Observe(duration)
-		metrics.HTTPRequestTotal.WithLabelValues(method, path, statusStr).Inc()
+		// Record metrics with route template (not actual path); the trace ID set by
+		// otelgin is attached as an exemplar
+		metrics.ObserveWithTrace(c.Request.Context(), metrics.HTTPRequestDuration.WithLabelValues(methodLabel, path, statusStr), duration)
+		metrics.HTTPRequestTotal.WithLabelValues(methodLabel, path, statusStr).Inc()
 
 		// Log request (use actual path for debugging, but route template for metrics)
 		actualPath := c.Request.URL.Path
@@ -82,6 +82,9 @@ func ObservabilityMiddleware() gin.HandlerFunc {
 				fields = append(fields, zap.String("error", c.Errors.String()))
 			}
 		}
+		if status >= 500 {
+			fields = append(fields, zap.String("incident_code", GetErrorReference(c).IncidentCode))
+		}
 
 		// Log with actual path for debugging purposes
 		logger.LogHTTPRequest(c.Request.Context(), method, actualPath, status, duration, fields...)
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	partnerTokenIDKey = "partner_token_id"
+	resultCountKey    = "partner_result_count"
+)
+
+// PartnerAuditRecorder receives summaries of partner-token requests
+type PartnerAuditRecorder interface {
+	Record(entry *models.PartnerRequestLog)
+}
+
+// PartnerTokenID returns a stable, non-secret identifier of a partner token
+func PartnerTokenID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "tok_" + hex.EncodeToString(sum[:6])
+}
+
+// SetResultCount records how many items a partner endpoint returned, for the audit log
+func SetResultCount(c *gin.Context, count int) {
+	c.Set(resultCountKey, count)
+}
+
+// PartnerAuditMiddleware records a summary of every request authenticated by
+// TokenAuthMiddleware. Successful requests are sampled with sampleRate; failed ones are
+// always kept because they are what disputes are about. tokenNames maps PartnerTokenID
+// values to readable names; unknown tokens keep their ID.
+func PartnerAuditMiddleware(recorder PartnerAuditRecorder, sampleRate float64, tokenNames map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		tokenID := GetPartnerTokenID(c)
+		if tokenID == "" {
+			return
+		}
+		status := c.Writer.Status()
+		if status < http.StatusBadRequest && rand.Float64() >= sampleRate { //nolint:gosec // sampling, not security
+			metrics.PartnerAuditEntries.WithLabelValues("sampled_out").Inc()
+			return
+		}
+		if name, ok := tokenNames[tokenID]; ok {
+			tokenID = name
+		}
+
+		entry := &models.PartnerRequestLog{
+			OccurredAt:    start,
+			TokenID:       tokenID,
+			Method:        c.Request.Method,
+			Route:         metrics.RouteLabel(c.FullPath()),
+			Path:          c.Request.URL.Path,
+			Query:         sanitizedQuery(c.Request.URL.Query()),
+			Status:        status,
+			ResponseBytes: max(c.Writer.Size(), 0),
+			LatencyMs:     int(time.Since(start).Milliseconds()),
+		}
+		if count, ok := c.Get(resultCountKey); ok {
+			if n, ok := count.(int); ok {
+				entry.ResultCount = &n
+			}
+		}
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.IsValid() {
+			entry.TraceID = spanContext.TraceID().String()
+		}
+
+		recorder.Record(entry)
+	}
+}
+
+// sanitizedQuery re-encodes the query string without sensitive parameters
+func sanitizedQuery(query url.Values) string {
+	for key := range query {
+		if sensitiveQueryParams[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+	return query.Encode()
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PartnerQuotaConsumer counts partner-token requests against their monthly quotas
+type PartnerQuotaConsumer interface {
+	Consume(ctx context.Context, tokenID string) (*models.PartnerQuotaUsage, error)
+}
+
+// GetPartnerTokenID returns the PartnerTokenID of the token accepted by TokenAuthMiddleware
+func GetPartnerTokenID(c *gin.Context) string {
+	return c.GetString(partnerTokenIDKey)
+}
+
+// PartnerQuotaMiddleware counts requests carrying a partner token against the token's
+// monthly quota and rejects them with 402 once it is used up. Quota headers are set on
+// every counted response. Tokens the consumer doesn't track are passed through untouched
+// and left to TokenAuthMiddleware. When usage can't be counted, the request is allowed.
+func PartnerQuotaMiddleware(consumer PartnerQuotaConsumer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("mentors_api_auth_token")
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		tokenID := PartnerTokenID(token)
+		usage, err := consumer.Consume(c.Request.Context(), tokenID)
+		if err != nil {
+			logger.Error("Failed to check partner quota; allowing request",
+				zap.Error(err),
+				zap.String("path", c.Request.URL.Path))
+			c.Next()
+			return
+		}
+		if usage == nil {
+			c.Next()
+			return
+		}
+		// Identify the partner here too so requests rejected below still reach the audit log
+		c.Set(partnerTokenIDKey, tokenID)
+
+		if usage.Limit > 0 {
+			c.Header("X-Quota-Limit", strconv.FormatInt(usage.Limit, 10))
+			c.Header("X-Quota-Remaining", strconv.FormatInt(*usage.Remaining, 10))
+			c.Header("X-Quota-Reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+		}
+		if usage.Exceeded() {
+			logger.Warn("Partner monthly quota exceeded",
+				zap.String("token", usage.Token),
+				zap.Int64("used", usage.Used),
+				zap.Int64("limit", usage.Limit))
+			c.Header("Retry-After", strconv.Itoa(int(time.Until(usage.ResetsAt).Seconds())+1))
+			c.JSON(http.StatusPaymentRequired, gin.H{
+				"error":    "Monthly request quota exceeded",
+				"limit":    usage.Limit,
+				"resetsAt": usage.ResetsAt,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -82,3 +82,39 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RateLimitDecision is the outcome of taking one request from a visitor's bucket
+type RateLimitDecision struct {
+	Allowed bool
+	// Limit is the burst size and Remaining the whole requests left in the bucket
+	Limit     int
+	Remaining int
+	// RefillPerSecond is the rate the bucket refills at
+	RefillPerSecond float64
+	// RetryAfter is how long until the next request is allowed, zero when Allowed
+	RetryAfter time.Duration
+}
+
+// Take counts one request for key and reports what is left. Unlike Middleware it leaves
+// rejecting the request to the caller, so handlers can answer in their own protocol.
+func (rl *RateLimiter) Take(key string) RateLimitDecision {
+	limiter := rl.getVisitor(key)
+	now := time.Now()
+	decision := RateLimitDecision{Limit: rl.b, RefillPerSecond: float64(rl.r)}
+
+	reservation := limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		decision.RetryAfter = time.Second
+		return decision
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// Hand the token back: a rejected request must not delay the next one further
+		reservation.CancelAt(now)
+		decision.RetryAfter = delay
+		return decision
+	}
+
+	decision.Allowed = true
+	decision.Remaining = max(int(limiter.TokensAt(now)), 0)
+	return decision
+}
@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+const (
+	AbuseTargetMentor  = "mentor"
+	AbuseTargetRequest = "request"
+)
+
+const (
+	AbuseReportStatusOpen      = "open"
+	AbuseReportStatusInReview  = "in_review"
+	AbuseReportStatusResolved  = "resolved"
+	AbuseReportStatusDismissed = "dismissed"
+)
+
+// AbuseReportFilter selects reports in the moderation queue
+type AbuseReportFilter string
+
+const (
+	AbuseReportFilterOpen   AbuseReportFilter = "open" // open and in review
+	AbuseReportFilterClosed AbuseReportFilter = "closed"
+	AbuseReportFilterAll    AbuseReportFilter = "all"
+)
+
+// Statuses returns the report statuses matched by the filter (nil matches all)
+// and reports whether the filter is valid
+func (f AbuseReportFilter) Statuses() ([]string, bool) {
+	switch f {
+	case AbuseReportFilterOpen:
+		return []string{AbuseReportStatusOpen, AbuseReportStatusInReview}, true
+	case AbuseReportFilterClosed:
+		return []string{AbuseReportStatusResolved, AbuseReportStatusDismissed}, true
+	case AbuseReportFilterAll:
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// AbuseReportRequest is the public abuse report form.
+// Target is a mentor slug for mentor reports and a request ID for request reports.
+type AbuseReportRequest struct {
+	TargetType     string `json:"targetType" binding:"required,oneof=mentor request"`
+	Target         string `json:"target" binding:"required,max=200"`
+	Category       string `json:"category" binding:"required,oneof=spam fraud harassment inappropriate other"`
+	Description    string `json:"description" binding:"required,min=10,max=4000"`
+	Email          string `json:"email" binding:"omitempty,email,max=255"`
+	RecaptchaToken string `json:"recaptchaToken" binding:"required,min=20"`
+}
+
+// AbuseReportResponse is returned after submitting a report
+type AbuseReportResponse struct {
+	Success  bool   `json:"success"`
+	ReportID string `json:"reportId,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AbuseReport is a stored report as shown in the moderation queue
+type AbuseReport struct {
+	ID                string     `json:"id"`
+	TargetType        string     `json:"targetType"`
+	MentorID          string     `json:"mentorId,omitempty"`
+	MentorSlug        string     `json:"mentorSlug,omitempty"`
+	MentorName        string     `json:"mentorName,omitempty"`
+	ClientRequestID   string     `json:"requestId,omitempty"`
+	Category          string     `json:"category"`
+	Description       string     `json:"description"`
+	ReporterEmail     string     `json:"reporterEmail,omitempty"`
+	Status            string     `json:"status"`
+	ResolutionComment string     `json:"resolutionComment,omitempty"`
+	ResolvedBy        string     `json:"resolvedBy,omitempty"`
+	ResolvedAt        *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
+
+// AbuseReportsListResponse is returned by the moderation queue endpoint
+type AbuseReportsListResponse struct {
+	Reports []*AbuseReport `json:"reports"`
+	Total   int            `json:"total"`
+}
+
+// ResolveAbuseReportRequest moves a report through the moderation workflow
+type ResolveAbuseReportRequest struct {
+	Status  string `json:"status" binding:"required,oneof=in_review resolved dismissed"`
+	Comment string `json:"comment" binding:"max=2000"`
+}
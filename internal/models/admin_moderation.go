@@ -100,11 +100,20 @@ type AdminMentorDetails struct {
 	Description    string    `json:"description"`
 	Competencies   string    `json:"competencies"`
 	CalendarURL    string    `json:"calendarUrl"`
+	Timezone       string    `json:"timezone"`
+	ContactHours   string    `json:"contactHours"`
+	Country        string    `json:"country"`
+	City           string    `json:"city"`
+	RemoteOnly     bool      `json:"remoteOnly"`
+	Languages      []string  `json:"languages"`
 	Status         string    `json:"status"`
 	SortOrder      int       `json:"sortOrder"`
 	TelegramChatID *int64    `json:"telegramChatId"`
+	MergedInto     *string   `json:"mergedInto,omitempty"` // set on duplicates merged into another mentor
 	CreatedAt      time.Time `json:"createdAt"`
 	UpdatedAt      time.Time `json:"updatedAt"`
+	// Version is the row version to send back as If-Match or expectedVersion when saving
+	Version int `json:"version"`
 }
 
 type AdminMentorsListResponse struct {
@@ -114,6 +123,10 @@ type AdminMentorsListResponse struct {
 
 type AdminMentorResponse struct {
 	Mentor *AdminMentorDetails `json:"mentor"`
+	// DryRun responses carry the mentor as the change would have saved it; nothing was kept
+	DryRun bool `json:"dryRun,omitempty"`
+	// Changes are the field-level audit entries of a PATCH update
+	Changes []ProfileFieldChange `json:"changes,omitempty"`
 }
 
 // AdminMentorProfileUpdateRequest intentionally contains only business/profile
@@ -131,8 +144,18 @@ type AdminMentorProfileUpdateRequest struct {
 	About          string   `json:"about" binding:"required,max=10000"`
 	Competencies   string   `json:"competencies" binding:"required,max=5000"`
 	CalendarURL    string   `json:"calendarUrl" binding:"omitempty,url,max=500"`
+	Timezone       *string  `json:"timezone,omitempty" binding:"omitempty,max=64"`
+	ContactHours   *string  `json:"contactHours,omitempty" binding:"omitempty,max=11"`
+	Country        *string  `json:"country,omitempty" binding:"omitempty,max=2"`
+	City           *string  `json:"city,omitempty" binding:"omitempty,max=100"`
+	RemoteOnly     *bool    `json:"remoteOnly,omitempty"`
+	Languages      []string `json:"languages,omitempty" binding:"omitempty,max=3,dive,oneof=ru en other"`
 	Slug           *string  `json:"slug,omitempty" binding:"omitempty,max=200"`
 	TelegramChatID *string  `json:"telegramChatId,omitempty" binding:"omitempty,max=30"`
+	// ExpectedVersion (or If-Match) enables optimistic concurrency: the update is rejected with 409 if the mentor
+	// changed since. ExpectedUpdatedAt is the older equivalent. One of them is required.
+	ExpectedVersion   *int       `json:"expectedVersion,omitempty"`
+	ExpectedUpdatedAt *time.Time `json:"expectedUpdatedAt,omitempty"`
 }
 
 type AdminMentorStatusUpdateRequest struct {
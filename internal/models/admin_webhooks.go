@@ -0,0 +1,35 @@
+package models
+
+import "encoding/json"
+
+// AdminWebhookTestRequest is a synthetic event sent through an outbound trigger for debugging.
+// DryRun defaults to true: the request is built and traced but not delivered. Otherwise it is
+// delivered to WEBHOOK_TEST_SANDBOX_URL, never to the trigger URL.
+type AdminWebhookTestRequest struct {
+	Trigger  string                 `json:"trigger" binding:"required,max=100"`
+	RecordID string                 `json:"recordId,omitempty" binding:"omitempty,max=100"`
+	Payload  map[string]interface{} `json:"payload,omitempty"`
+	DryRun   *bool                  `json:"dryRun,omitempty"`
+}
+
+// IsDryRun returns the effective dry-run flag (true unless explicitly disabled)
+func (r *AdminWebhookTestRequest) IsDryRun() bool {
+	return r.DryRun == nil || *r.DryRun
+}
+
+// AdminWebhookTestResponse describes what was (or would be) sent and the delivery outcome
+type AdminWebhookTestResponse struct {
+	Trigger    string          `json:"trigger"`
+	Configured bool            `json:"configured"`
+	DryRun     bool            `json:"dryRun"`
+	Method     string          `json:"method,omitempty"`
+	URL        string          `json:"url,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	Delivered  bool            `json:"delivered"`
+	StatusCode int             `json:"statusCode,omitempty"`
+	DurationMs int64           `json:"durationMs,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Trace      []string        `json:"trace"`
+	// DroppedKeys are payload keys left out because the real trigger payload carries them
+	DroppedKeys []string `json:"droppedKeys,omitempty"`
+}
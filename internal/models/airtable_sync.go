@@ -0,0 +1,256 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Airtable sync streams. Their progress is kept next to the warehouse export watermarks.
+const (
+	AirtableStreamMentors  = "airtable_mentors"
+	AirtableStreamRequests = "airtable_requests"
+)
+
+// AirtableMentorChange is a mentor with a legacy Airtable record, as pushed back to Airtable
+type AirtableMentorChange struct {
+	ID          string
+	AirtableID  string
+	Slug        string
+	Name        string
+	JobTitle    string
+	Workplace   string
+	Experience  string
+	Price       string
+	Status      string
+	Email       string
+	Telegram    string
+	CalendarURL string
+	// Timezone and ContactHours are the mentor's preferred contact window, "HH:MM-HH:MM" in Timezone
+	Timezone     string
+	ContactHours string
+	Country      string
+	City         string
+	RemoteOnly   bool
+	Languages    []string
+	UpdatedAt    time.Time
+}
+
+// AirtableFields maps the mentor to the fields of the Airtable Mentors table
+func (m *AirtableMentorChange) AirtableFields() map[string]interface{} {
+	return map[string]interface{}{
+		"Alias":         m.Slug,
+		"Name":          m.Name,
+		"JobTitle":      m.JobTitle,
+		"Workplace":     m.Workplace,
+		"Experience":    m.Experience,
+		"Price":         m.Price,
+		"Status":        m.Status,
+		"Email":         m.Email,
+		"Telegram":      m.Telegram,
+		"Calendly Url":  m.CalendarURL,
+		"Timezone":      m.Timezone,
+		"Contact Hours": m.ContactHours,
+		"Country":       m.Country,
+		"City":          m.City,
+		"Remote Only":   m.RemoteOnly,
+		"Languages":     m.Languages,
+	}
+}
+
+// AirtableMentorReadFields are the Mentors table fields read for DATA_SOURCE=airtable: the
+// profile fields the reverse sync writes and operations edit in Airtable
+var AirtableMentorReadFields = []string{
+	"Name", "JobTitle", "Workplace", "Experience", "Price", "Status", "Calendly Url", "Timezone", "Contact Hours",
+	"Country", "City", "Remote Only", "Languages",
+}
+
+// ApplyAirtableFields overwrites the mentor's fields listed in AirtableMentorReadFields with
+// the values of its Airtable record; a field Airtable leaves out is empty. The slug, the IDs
+// and everything else keep their PostgreSQL values.
+func (m *Mentor) ApplyAirtableFields(fields map[string]interface{}) {
+	m.Name = airtableValueString(fields["Name"])
+	m.Job = airtableValueString(fields["JobTitle"])
+	m.Workplace = airtableValueString(fields["Workplace"])
+	m.Experience = airtableValueString(fields["Experience"])
+	m.Price = airtableValueString(fields["Price"])
+	m.Status = airtableValueString(fields["Status"])
+	if calendarURL := airtableValueString(fields["Calendly Url"]); calendarURL != m.CalendarURL {
+		// Another link starts over; the same one keeps its checked type, broken included
+		m.CalendarURL = calendarURL
+		m.CalendarType = GetCalendarType(calendarURL)
+	}
+	m.Timezone = airtableValueString(fields["Timezone"])
+	m.ContactHours = airtableValueString(fields["Contact Hours"])
+	m.Country = airtableValueString(fields["Country"])
+	m.City = airtableValueString(fields["City"])
+	m.RemoteOnly = fields["Remote Only"] == true
+	m.Languages = airtableValueStrings(fields["Languages"])
+	m.IsVisible = m.Status == "active" && m.TelegramChatID != nil
+}
+
+// AirtableMentorImportFields are the Mentors table fields migrate --migrate-mentors copies: the
+// read fields, the slug and the profile fields only the import takes over
+var AirtableMentorImportFields = append([]string{
+	"Alias", "Details", "About", "Competencies", "Tags", "SortOrder", "Telegram Chat Id",
+}, AirtableMentorReadFields...)
+
+// ApplyAirtableImportFields sets the mentor's fields listed in AirtableMentorImportFields from its
+// Airtable record; like ApplyAirtableFields, a field Airtable leaves out is empty
+func (m *Mentor) ApplyAirtableImportFields(fields map[string]interface{}) {
+	m.Slug = airtableValueString(fields["Alias"])
+	m.Description = airtableValueString(fields["Details"])
+	m.About = airtableValueString(fields["About"])
+	m.Competencies = airtableValueString(fields["Competencies"])
+	m.Tags = airtableValueStrings(fields["Tags"])
+	sortOrder, _ := airtableValueInt(fields["SortOrder"])
+	m.SortOrder = int(sortOrder)
+	m.TelegramChatID = nil
+	if chatID, ok := airtableValueInt(fields["Telegram Chat Id"]); ok {
+		m.TelegramChatID = &chatID
+	}
+	m.ApplyAirtableFields(fields)
+}
+
+// AirtableRequestChange is a client request with a legacy Airtable record, as pushed back to Airtable
+type AirtableRequestChange struct {
+	ID              string
+	AirtableID      string
+	Status          string
+	StatusChangedAt *time.Time
+	ScheduledAt     *time.Time
+	DeclineReason   string
+	DeclineComment  string
+	UpdatedAt       time.Time
+}
+
+// AirtableFields maps the request to the fields of the Airtable Client Requests table
+func (r *AirtableRequestChange) AirtableFields() map[string]interface{} {
+	return map[string]interface{}{
+		"Status":             r.Status,
+		"Last Status Change": r.StatusChangedAt,
+		"Scheduled At":       r.ScheduledAt,
+		"Decline Reason":     r.DeclineReason,
+		"Decline Comment":    r.DeclineComment,
+	}
+}
+
+// AirtableSyncResult counts the outcome of a sync run of a stream
+type AirtableSyncResult struct {
+	Stream    string `json:"stream"`
+	Synced    int    `json:"synced"`
+	Conflicts int    `json:"conflicts"`
+	Missing   int    `json:"missing"`
+	Skipped   bool   `json:"skipped,omitempty"`
+}
+
+// Data sources of the Airtable cutover, also the values of DATA_SOURCE. Until the cutover is
+// finalized, the Airtable base is kept in sync for the operations views and may serve mentor profiles.
+const (
+	DataSourceAirtable = "airtable"
+	DataSourcePostgres = "postgres"
+)
+
+// AirtableCutoverState is the progress of the Airtable cutover
+type AirtableCutoverState struct {
+	DataSource     string     `json:"dataSource"`
+	WritesFrozenAt *time.Time `json:"writesFrozenAt,omitempty"`
+	FinalizedAt    *time.Time `json:"finalizedAt,omitempty"`
+}
+
+// WritesFrozen reports whether nothing may write to Airtable anymore: the cutover is running or done
+func (s *AirtableCutoverState) WritesFrozen() bool {
+	return s.WritesFrozenAt != nil || s.DataSource == DataSourcePostgres
+}
+
+// AirtableStreamCheck compares the rows of a stream with their Airtable records
+type AirtableStreamCheck struct {
+	Stream        string `json:"stream"`
+	Table         string `json:"table"`
+	PostgresCount int    `json:"postgresCount"`
+	AirtableCount int    `json:"airtableCount"`
+	// Missing rows link to an Airtable record that no longer exists
+	Missing int `json:"missing"`
+	// Extra records exist in Airtable without a row linking to them
+	Extra int `json:"extra"`
+	// Mismatched records hold field values other than the row's
+	Mismatched int `json:"mismatched"`
+	// ChangedAfterSync rows were updated after the final sync and are not compared
+	ChangedAfterSync int      `json:"changedAfterSync"`
+	Samples          []string `json:"samples,omitempty"`
+	OK               bool     `json:"ok"`
+}
+
+// AirtableFieldsHash hashes the values of the named fields, normalized so that a PostgreSQL
+// row and the Airtable record it was written to hash the same: empty and missing values are
+// equal, an unchecked checkbox (false) is equal to a missing one, and timestamps are compared in
+// UTC to the second.
+func AirtableFieldsHash(fields map[string]interface{}, names []string) string {
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, airtableValueString(fields[name]))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func airtableValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		// Airtable leaves unchecked checkboxes out of the record
+		if !v {
+			return ""
+		}
+		return "true"
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.UTC().Truncate(time.Second).Format(time.RFC3339)
+		}
+		return v
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Truncate(time.Second).Format(time.RFC3339)
+	case time.Time:
+		return v.UTC().Truncate(time.Second).Format(time.RFC3339)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = airtableValueString(item)
+		}
+		return strings.Join(parts, ",")
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// airtableValueStrings reads a multiple select or linked field, which Airtable returns as a JSON
+// array; empty items are dropped
+func airtableValueStrings(value interface{}) []string {
+	values := []string{}
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			if s := airtableValueString(item); s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// airtableValueInt reads a number field, which Airtable returns as a JSON number, or a number
+// kept in a text field
+func airtableValueInt(value interface{}) (int64, bool) {
+	if number, ok := value.(float64); ok {
+		return int64(number), true
+	}
+	number, err := strconv.ParseInt(strings.TrimSpace(airtableValueString(value)), 10, 64)
+	return number, err == nil
+}
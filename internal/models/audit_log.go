@@ -0,0 +1,132 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// Actor types of audit log entries
+const (
+	AuditActorAdmin    = "admin"
+	AuditActorMentor   = "mentor"
+	AuditActorToken    = "token"
+	AuditActorInternal = "internal"
+	// AuditActorSystem covers background jobs and other writes without an authenticated caller
+	AuditActorSystem = "system"
+)
+
+// Entity types of audit log entries
+const (
+	AuditEntityMentor  = "mentor"
+	AuditEntityRequest = "client_request"
+)
+
+// MaxAuditLogEntriesListed caps an audit log page
+const MaxAuditLogEntriesListed = 200
+
+// AuditActor is who made a change: the moderator, mentor or API token behind the request
+type AuditActor struct {
+	Type string
+	ID   string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor attaches the actor of the current request to ctx
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext returns the actor attached to ctx, or the system actor when there is none
+func AuditActorFromContext(ctx context.Context) AuditActor {
+	if actor, ok := ctx.Value(auditActorKey{}).(AuditActor); ok {
+		return actor
+	}
+	return AuditActor{Type: AuditActorSystem}
+}
+
+type auditPatchKey struct{}
+
+// WithAuditPatch attaches the patch document behind the current change to ctx, so the
+// audit log entry records it next to the changed fields
+func WithAuditPatch(ctx context.Context, patch json.RawMessage) context.Context {
+	return context.WithValue(ctx, auditPatchKey{}, patch)
+}
+
+// AuditPatchFromContext returns the patch document attached to ctx, if any
+func AuditPatchFromContext(ctx context.Context) json.RawMessage {
+	patch, _ := ctx.Value(auditPatchKey{}).(json.RawMessage) //nolint:errcheck // nil when absent
+	return patch
+}
+
+// AuditChange is the old and new value of a changed field
+type AuditChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// AuditLogEntry records who changed what and when
+type AuditLogEntry struct {
+	ID         int64                  `json:"id"`
+	OccurredAt time.Time              `json:"occurredAt"`
+	ActorType  string                 `json:"actorType"`
+	ActorID    string                 `json:"actorId,omitempty"`
+	EntityType string                 `json:"entityType"`
+	EntityID   string                 `json:"entityId"`
+	Operation  string                 `json:"operation"`
+	Changes    map[string]AuditChange `json:"changes"`
+	Patch      json.RawMessage        `json:"patch,omitempty"`
+	TraceID    string                 `json:"traceId,omitempty"`
+}
+
+// AuditLogFilter selects audit log entries; empty fields match everything.
+// Before continues a listing below the given entry ID.
+type AuditLogFilter struct {
+	EntityType string
+	EntityID   string
+	ActorType  string
+	ActorID    string
+	Operation  string
+	From       time.Time
+	To         time.Time
+	Before     int64
+	Limit      int
+}
+
+// AuditLogListResponse is a page of the audit log, newest first
+type AuditLogListResponse struct {
+	Entries []*AuditLogEntry `json:"entries"`
+	// NextBefore is passed as ?before= to get the next page; absent on the last page
+	NextBefore *int64 `json:"nextBefore,omitempty"`
+}
+
+// DiffAuditSnapshots returns the fields whose values differ between two snapshots of a row.
+// A nil snapshot stands for a missing row.
+func DiffAuditSnapshots(before, after map[string]interface{}) map[string]AuditChange {
+	changes := map[string]AuditChange{}
+	for field, from := range before {
+		if to, ok := after[field]; !ok || !reflect.DeepEqual(from, to) {
+			changes[field] = AuditChange{From: from, To: after[field]}
+		}
+	}
+	for field, to := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = AuditChange{From: nil, To: to}
+		}
+	}
+	return changes
+}
+
+// ParseAuditSnapshot decodes a JSON row snapshot; an empty snapshot is nil
+func ParseAuditSnapshot(raw []byte) (map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
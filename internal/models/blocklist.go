@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+const (
+	BlocklistKindEmail    = "email"
+	BlocklistKindTelegram = "telegram"
+	BlocklistKindIP       = "ip" // single address or CIDR range
+)
+
+const (
+	// BlocklistActionReject refuses the submission
+	BlocklistActionReject = "reject"
+	// BlocklistActionFlag accepts the submission but marks it for review
+	BlocklistActionFlag = "flag"
+	// BlocklistActionShadow makes the submission look accepted but holds it in quarantine
+	BlocklistActionShadow = "shadow"
+)
+
+// BlocklistEntry blocks submissions from an email, Telegram handle or IP range
+type BlocklistEntry struct {
+	ID            string     `json:"id"`
+	Kind          string     `json:"kind"`
+	Value         string     `json:"value"`
+	Action        string     `json:"action"`
+	Reason        string     `json:"reason,omitempty"`
+	CreatedBy     string     `json:"createdBy,omitempty"`
+	CreatedByName string     `json:"createdByName,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	RemovedBy     string     `json:"removedBy,omitempty"`
+	RemovedAt     *time.Time `json:"removedAt,omitempty"`
+}
+
+// Matches reports whether the submission is covered by the entry.
+// The entry value is expected to be normalized with NormalizeBlocklistValue.
+func (e *BlocklistEntry) Matches(subject BlocklistSubject) bool {
+	switch e.Kind {
+	case BlocklistKindEmail:
+		return subject.Email != "" && normalizeEmail(subject.Email) == e.Value
+	case BlocklistKindTelegram:
+		return subject.Telegram != "" && normalizeTelegram(subject.Telegram) == e.Value
+	case BlocklistKindIP:
+		addr, err := netip.ParseAddr(subject.IP)
+		if err != nil {
+			return false
+		}
+		prefix, err := netip.ParsePrefix(e.Value)
+		if err != nil {
+			return false
+		}
+		return prefix.Contains(addr.Unmap())
+	default:
+		return false
+	}
+}
+
+// BlocklistSubject is what a submission is checked against
+type BlocklistSubject struct {
+	Email    string
+	Telegram string
+	IP       string
+}
+
+// NormalizeBlocklistValue brings a value to the form stored in the blocklist:
+// lowercased emails, Telegram handles without @ or t.me/ prefix, and IPs as CIDR ranges.
+func NormalizeBlocklistValue(kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("empty %s", kind)
+	}
+
+	switch kind {
+	case BlocklistKindEmail:
+		return normalizeEmail(value), nil
+	case BlocklistKindTelegram:
+		handle := normalizeTelegram(value)
+		if handle == "" {
+			return "", fmt.Errorf("invalid telegram handle %q", value)
+		}
+		return handle, nil
+	case BlocklistKindIP:
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return "", fmt.Errorf("invalid IP range %q", value)
+			}
+			return prefix.Masked().String(), nil
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid IP address %q", value)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+	default:
+		return "", fmt.Errorf("unknown blocklist kind %q", kind)
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func normalizeTelegram(handle string) string {
+	handle = strings.TrimSpace(handle)
+	handle = strings.TrimPrefix(handle, "https://")
+	handle = strings.TrimPrefix(handle, "t.me/")
+	handle = strings.TrimPrefix(handle, "@")
+	return strings.ToLower(handle)
+}
+
+// CreateBlocklistEntryRequest adds an entry to the blocklist
+type CreateBlocklistEntryRequest struct {
+	Kind      string     `json:"kind" binding:"required,oneof=email telegram ip"`
+	Value     string     `json:"value" binding:"required,max=255"`
+	Action    string     `json:"action" binding:"omitempty,oneof=reject flag shadow"`
+	Reason    string     `json:"reason" binding:"max=1000"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// BlocklistResponse is returned by the blocklist list endpoint
+type BlocklistResponse struct {
+	Entries []*BlocklistEntry `json:"entries"`
+	Total   int               `json:"total"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// BotHeartbeatRequest is sent by the Telegram bot to POST /api/v1/bot/heartbeat
+type BotHeartbeatRequest struct {
+	Version string `json:"version" binding:"required,max=100"`
+	// LastUpdateAt is when the bot last received an update from Telegram
+	LastUpdateAt *time.Time `json:"lastUpdateAt"`
+}
+
+// BotHeartbeat is the last heartbeat received from the Telegram bot
+type BotHeartbeat struct {
+	Version      string     `json:"version"`
+	LastUpdateAt *time.Time `json:"lastUpdateAt,omitempty"`
+	ReceivedAt   time.Time  `json:"receivedAt"`
+	// SilentAlertedAt is when the bot-silent alert was raised; cleared by the next heartbeat
+	SilentAlertedAt *time.Time `json:"silentAlertedAt,omitempty"`
+}
+
+// BotStatus tells whether the Telegram bot is alive
+type BotStatus struct {
+	// Heartbeat is nil until the bot first reports
+	Heartbeat *BotHeartbeat `json:"heartbeat"`
+	// Silent is set once SilentAfterMinutes passed without a heartbeat
+	Silent                bool  `json:"silent"`
+	SecondsSinceHeartbeat int64 `json:"secondsSinceHeartbeat,omitempty"`
+	SilentAfterMinutes    int   `json:"silentAfterMinutes"`
+}
+
+// NewBotStatus evaluates heartbeat at now. A zero silentAfter never reports the bot silent,
+// and neither does a missing heartbeat: the bot may not be deployed yet.
+func NewBotStatus(heartbeat *BotHeartbeat, silentAfter time.Duration, now time.Time) *BotStatus {
+	status := &BotStatus{
+		Heartbeat:          heartbeat,
+		SilentAfterMinutes: int(silentAfter / time.Minute),
+	}
+	if heartbeat == nil {
+		return status
+	}
+
+	since := now.Sub(heartbeat.ReceivedAt)
+	status.SecondsSinceHeartbeat = int64(since.Seconds())
+	status.Silent = silentAfter > 0 && since >= silentAfter
+	return status
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// MentorCacheStatus describes a replica's mentor cache for operators
+type MentorCacheStatus struct {
+	Ready   bool   `json:"ready"`
+	Backend string `json:"backend"`
+	// LastRefreshTime and Version come from the stored metadata of the last full population
+	LastRefreshTime *time.Time `json:"lastRefreshTime,omitempty"`
+	AgeSeconds      float64    `json:"ageSeconds"`
+	TTLSeconds      int        `json:"ttlSeconds"`
+	Version         int64      `json:"version"`
+	// SharedVersion is the cross-replica version this replica holds, when shared versions are on
+	SharedVersion *int64 `json:"sharedVersion,omitempty"`
+	MentorCount   int    `json:"mentorCount"`
+	// ListedCount is the length of the all-mentors list currently served
+	ListedCount int  `json:"listedCount"`
+	TagCount    int  `json:"tagCount"`
+	Expired     bool `json:"expired"`
+	Stale       bool `json:"stale"`
+	// StaleSince is when reads started falling back to the last known list
+	StaleSince *time.Time `json:"staleSince,omitempty"`
+	// FromSnapshot is set while the replica serves the snapshot it started from
+	FromSnapshot bool                        `json:"fromSnapshot"`
+	Refreshing   bool                        `json:"refreshing"`
+	Lookups      map[string]CacheLookupStats `json:"lookups"`
+}
+
+// CacheLookupStats counts the hits and misses of a cache lookup since the replica started
+type CacheLookupStats struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	HitRatio float64 `json:"hitRatio"`
+}
+
+// NewCacheLookupStats computes the hit ratio; it is 0 before the first lookup
+func NewCacheLookupStats(hits, misses int64) CacheLookupStats {
+	stats := CacheLookupStats{Hits: hits, Misses: misses}
+	if total := hits + misses; total > 0 {
+		stats.HitRatio = float64(hits) / float64(total)
+	}
+	return stats
+}
+
+// CacheInvalidateResponse is the outcome of reloading one mentor into the cache
+type CacheInvalidateResponse struct {
+	Slug string `json:"slug"`
+	// Removed is true when the mentor no longer exists and was dropped from the cache
+	Removed bool `json:"removed"`
+}
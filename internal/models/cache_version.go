@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// CacheVersionMentors names the mentor cache in cache_versions
+const CacheVersionMentors = "mentors"
+
+// CacheReplica is the copy of a cache one API replica holds
+type CacheReplica struct {
+	Replica  string    `json:"replica"`
+	Region   string    `json:"region,omitempty"`
+	Version  int64     `json:"version"`
+	LoadedAt time.Time `json:"loadedAt"`
+	SeenAt   time.Time `json:"seenAt"`
+	// Stale replicas hold an older version than the shared one
+	Stale bool `json:"stale"`
+}
+
+// CacheVersionsReport compares the replicas' copies of a cache with its shared version
+type CacheVersionsReport struct {
+	Cache     string         `json:"cache"`
+	Current   int64          `json:"current"`
+	Converged bool           `json:"converged"`
+	Replicas  []CacheReplica `json:"replicas"`
+}
+
+// NewCacheVersionsReport marks the replicas behind current as stale
+func NewCacheVersionsReport(cache string, current int64, replicas []CacheReplica) *CacheVersionsReport {
+	report := &CacheVersionsReport{Cache: cache, Current: current, Converged: true, Replicas: replicas}
+	for i := range report.Replicas {
+		report.Replicas[i].Stale = report.Replicas[i].Version < current
+		if report.Replicas[i].Stale {
+			report.Converged = false
+		}
+	}
+	if report.Replicas == nil {
+		report.Replicas = []CacheReplica{}
+	}
+	return report
+}
@@ -0,0 +1,17 @@
+package models
+
+// CalendarLink is a mentor's calendar link as seen by the link checker
+type CalendarLink struct {
+	MentorID string
+	Slug     string
+	URL      string
+	Broken   bool
+}
+
+// CalendarCheckResult is the state of a calendar link after a recorded check
+type CalendarCheckResult struct {
+	Failures int
+	// BecameBroken and Recovered report a change of the link's broken state by this check
+	BecameBroken bool
+	Recovered    bool
+}
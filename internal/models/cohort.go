@@ -0,0 +1,264 @@
+package models
+
+import "time"
+
+const (
+	CohortStatusDraft     = "draft"
+	CohortStatusEnrolling = "enrolling" // open for mentor and mentee enrollment
+	CohortStatusActive    = "active"
+	CohortStatusCompleted = "completed"
+)
+
+const (
+	CohortRoleMentor = "mentor"
+	CohortRoleMentee = "mentee"
+)
+
+const (
+	CohortParticipantEnrolled  = "enrolled"
+	CohortParticipantWithdrawn = "withdrawn"
+	CohortParticipantCompleted = "completed"
+)
+
+// Cohort is a structured mentorship run, e.g. "February backend cohort"
+type Cohort struct {
+	ID             string             `json:"id"`
+	Title          string             `json:"title"`
+	Description    string             `json:"description"`
+	StartsAt       time.Time          `json:"startsAt"`
+	EndsAt         time.Time          `json:"endsAt"`
+	Status         string             `json:"status"`
+	MenteeCapacity int                `json:"menteeCapacity"`
+	MentorCount    int                `json:"mentorCount"`
+	MenteeCount    int                `json:"menteeCount"` // enrolled and completed mentees
+	Checkpoints    []CohortCheckpoint `json:"checkpoints"`
+	CreatedBy      string             `json:"createdBy,omitempty"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+// IsOpenForEnrollment reports whether mentors and mentees can enroll
+func (c *Cohort) IsOpenForEnrollment() bool {
+	return c.Status == CohortStatusEnrolling
+}
+
+// MenteeSeatsLeft returns the number of mentees that can still enroll
+func (c *Cohort) MenteeSeatsLeft() int {
+	if c.MenteeCount >= c.MenteeCapacity {
+		return 0
+	}
+	return c.MenteeCapacity - c.MenteeCount
+}
+
+// CohortCheckpoint is a milestone mentees are expected to reach by its due date
+type CohortCheckpoint struct {
+	ID       string    `json:"id"`
+	Title    string    `json:"title"`
+	DueAt    time.Time `json:"dueAt"`
+	Position int       `json:"position"`
+}
+
+// CohortParticipant is an enrolled mentor or mentee
+type CohortParticipant struct {
+	ID       string  `json:"id"`
+	CohortID string  `json:"cohortId"`
+	Role     string  `json:"role"`
+	MentorID *string `json:"mentorId,omitempty"` // set for mentors
+	Name     string  `json:"name"`
+	Email    string  `json:"email"`
+	Telegram string  `json:"telegram,omitempty"`
+	Goal     string  `json:"goal,omitempty"`
+	Status   string  `json:"status"`
+	// PairedWith is the participant ID of a mentee's mentor
+	PairedWith *string `json:"pairedWith,omitempty"`
+	// CompletedCheckpoints are the IDs of checkpoints a mentee has reached
+	CompletedCheckpoints []string  `json:"completedCheckpoints,omitempty"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+// CohortProgress is a checkpoint a mentee has reached
+type CohortProgress struct {
+	ParticipantID string    `json:"participantId"`
+	CheckpointID  string    `json:"checkpointId"`
+	Note          string    `json:"note,omitempty"`
+	RecordedBy    string    `json:"recordedBy"`
+	CompletedAt   time.Time `json:"completedAt"`
+}
+
+// CohortCheckpointStats is how many active mentees reached a checkpoint
+type CohortCheckpointStats struct {
+	CheckpointID string    `json:"checkpointId"`
+	Title        string    `json:"title"`
+	DueAt        time.Time `json:"dueAt"`
+	Completed    int       `json:"completed"`
+	Rate         float64   `json:"rate"` // share of active mentees, from 0 to 1
+}
+
+// CohortDashboard is the admin view of a cohort
+type CohortDashboard struct {
+	Cohort          *Cohort                 `json:"cohort"`
+	Participants    []*CohortParticipant    `json:"participants"`
+	UnpairedMentees int                     `json:"unpairedMentees"`
+	Checkpoints     []CohortCheckpointStats `json:"checkpoints"`
+}
+
+// MentorCohort is a cohort as seen by one of its mentors
+type MentorCohort struct {
+	Cohort        *Cohort              `json:"cohort"`
+	ParticipantID string               `json:"participantId"`
+	Mentees       []*CohortParticipant `json:"mentees"`
+}
+
+// CohortRequest creates or replaces a cohort. Checkpoints without an ID are created;
+// existing checkpoints missing from the list are removed along with their progress.
+type CohortRequest struct {
+	Title          string                    `json:"title" binding:"required,max=200"`
+	Description    string                    `json:"description" binding:"max=10000"`
+	StartsAt       time.Time                 `json:"startsAt" binding:"required"`
+	EndsAt         time.Time                 `json:"endsAt" binding:"required"`
+	Status         string                    `json:"status" binding:"omitempty,oneof=draft enrolling active completed"`
+	MenteeCapacity int                       `json:"menteeCapacity" binding:"required,min=1,max=1000"`
+	Checkpoints    []CohortCheckpointRequest `json:"checkpoints" binding:"max=50,dive"`
+}
+
+// CohortCheckpointRequest is a checkpoint in CohortRequest
+type CohortCheckpointRequest struct {
+	ID    string    `json:"id"`
+	Title string    `json:"title" binding:"required,max=200"`
+	DueAt time.Time `json:"dueAt" binding:"required"`
+}
+
+// CohortEnrollRequest is the public mentee enrollment form
+type CohortEnrollRequest struct {
+	Name             string `json:"name" binding:"required,min=2,max=100"`
+	Email            string `json:"email" binding:"required,email,max=255"`
+	TelegramUsername string `json:"telegramUsername" binding:"omitempty,max=50"`
+	Goal             string `json:"goal" binding:"max=2000"`
+	RecaptchaToken   string `json:"recaptchaToken" binding:"required,min=20"`
+}
+
+// CohortEnrollResponse is returned after enrolling in a cohort
+type CohortEnrollResponse struct {
+	Success       bool   `json:"success"`
+	ParticipantID string `json:"participantId,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// AddCohortParticipantRequest enrolls a participant on behalf of an admin.
+// Mentors are identified by MentorID, mentees by their contact details.
+type AddCohortParticipantRequest struct {
+	Role     string `json:"role" binding:"required,oneof=mentor mentee"`
+	MentorID string `json:"mentorId" binding:"required_if=Role mentor"`
+	Name     string `json:"name" binding:"required_if=Role mentee,max=100"`
+	Email    string `json:"email" binding:"required_if=Role mentee,omitempty,email,max=255"`
+	Telegram string `json:"telegram" binding:"max=50"`
+	Goal     string `json:"goal" binding:"max=2000"`
+}
+
+// UpdateCohortParticipantRequest changes a participant's status
+type UpdateCohortParticipantRequest struct {
+	Status string `json:"status" binding:"required,oneof=enrolled withdrawn completed"`
+}
+
+// CohortPairRequest assigns a mentee to a mentor of the same cohort, both given by
+// participant ID. An empty mentor unpairs the mentee.
+type CohortPairRequest struct {
+	MenteeParticipantID string `json:"menteeParticipantId" binding:"required"`
+	MentorParticipantID string `json:"mentorParticipantId"`
+}
+
+// CohortProgressRequest marks a checkpoint as reached (or not) for a mentee
+type CohortProgressRequest struct {
+	CheckpointID string `json:"checkpointId" binding:"required"`
+	Completed    bool   `json:"completed"`
+	Note         string `json:"note" binding:"max=2000"`
+}
+
+// EligibleForCertificate reports whether a mentee has earned a completion certificate:
+// every checkpoint reached or, in cohorts without checkpoints, marked completed
+func (p *CohortParticipant) EligibleForCertificate(checkpoints []CohortCheckpoint) bool {
+	if p.Role != CohortRoleMentee || p.Status == CohortParticipantWithdrawn {
+		return false
+	}
+	if len(checkpoints) == 0 {
+		return p.Status == CohortParticipantCompleted
+	}
+	completed := make(map[string]bool, len(p.CompletedCheckpoints))
+	for _, id := range p.CompletedCheckpoints {
+		completed[id] = true
+	}
+	for _, checkpoint := range checkpoints {
+		if !completed[checkpoint.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// CohortCertificate is a signed completion certificate of a mentee. RecipientName and
+// CohortTitle are copied when the certificate is issued.
+type CohortCertificate struct {
+	ID                   string    `json:"id"`
+	CohortID             string    `json:"cohortId"`
+	ParticipantID        string    `json:"participantId"`
+	RecipientName        string    `json:"recipientName"`
+	CohortTitle          string    `json:"cohortTitle"`
+	CompletedCheckpoints int       `json:"completedCheckpoints"`
+	Signature            string    `json:"signature"`
+	PDFURL               string    `json:"pdfUrl"`
+	IssuedBy             string    `json:"issuedBy,omitempty"`
+	IssuedAt             time.Time `json:"issuedAt"`
+}
+
+// CohortReportRow is a mentee's completion in the cohort report
+type CohortReportRow struct {
+	ParticipantID        string             `json:"participantId"`
+	Name                 string             `json:"name"`
+	Email                string             `json:"email"`
+	Status               string             `json:"status"`
+	MentorName           string             `json:"mentorName,omitempty"`
+	CompletedCheckpoints int                `json:"completedCheckpoints"`
+	Rate                 float64            `json:"rate"` // share of the cohort's checkpoints, from 0 to 1
+	Eligible             bool               `json:"eligible"`
+	Certificate          *CohortCertificate `json:"certificate,omitempty"`
+}
+
+// CohortReport is the completion report of a cohort's mentees
+type CohortReport struct {
+	Cohort             *Cohort           `json:"cohort"`
+	Mentees            []CohortReportRow `json:"mentees"`
+	Eligible           int               `json:"eligible"`
+	CertificatesIssued int               `json:"certificatesIssued"`
+}
+
+// IssueCohortCertificatesRequest issues certificates to the given mentees, or to every
+// eligible mentee without one when ParticipantIDs is empty
+type IssueCohortCertificatesRequest struct {
+	ParticipantIDs []string `json:"participantIds" binding:"max=1000"`
+}
+
+// CohortCertificateSkip is a mentee that didn't get a certificate and why
+type CohortCertificateSkip struct {
+	ParticipantID string `json:"participantId"`
+	Reason        string `json:"reason"` // not_found, not_eligible, already_issued or failed
+}
+
+// IssueCohortCertificatesResponse is the outcome of a bulk issue. Remaining counts
+// eligible mentees left for the next call when the batch limit was reached.
+type IssueCohortCertificatesResponse struct {
+	Issued    []*CohortCertificate    `json:"issued"`
+	Skipped   []CohortCertificateSkip `json:"skipped"`
+	Remaining int                     `json:"remaining"`
+}
+
+// CertificateVerification is the public view of a certificate. Valid is false when the
+// stored statements don't match their signature.
+type CertificateVerification struct {
+	Valid                bool      `json:"valid"`
+	CertificateID        string    `json:"certificateId"`
+	RecipientName        string    `json:"recipientName"`
+	CohortTitle          string    `json:"cohortTitle"`
+	CompletedCheckpoints int       `json:"completedCheckpoints"`
+	IssuedAt             time.Time `json:"issuedAt"`
+	PDFURL               string    `json:"pdfUrl,omitempty"`
+}
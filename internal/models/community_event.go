@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+const (
+	CommunityEventStatusDraft     = "draft"
+	CommunityEventStatusPublished = "published"
+	CommunityEventStatusCancelled = "cancelled"
+)
+
+// Cross-posting actions sent to the community event trigger
+const (
+	CommunityEventActionPublished = "published"
+	CommunityEventActionUpdated   = "updated"
+	CommunityEventActionCancelled = "cancelled"
+)
+
+// CommunityEvent is a community meetup, webinar or AMA announced on the site and by the bot
+type CommunityEvent struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	StartsAt    time.Time `json:"startsAt"`
+	EndsAt      time.Time `json:"endsAt"`
+	Location    string    `json:"location,omitempty"` // address or "Online"
+	URL         string    `json:"url,omitempty"`      // registration or stream link
+	Status      string    `json:"status"`
+	Sequence    int       `json:"-"`
+	CreatedBy   string    `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// CommunityEventFilter narrows community event listings
+type CommunityEventFilter struct {
+	// Statuses limits results to the given statuses; empty means all
+	Statuses []string
+	// EndsAfter hides events that ended before the moment; zero means no limit
+	EndsAfter time.Time
+}
+
+// CommunityEventRequest creates or replaces a community event
+type CommunityEventRequest struct {
+	Title       string    `json:"title" binding:"required,max=200"`
+	Description string    `json:"description" binding:"max=10000"`
+	StartsAt    time.Time `json:"startsAt" binding:"required"`
+	EndsAt      time.Time `json:"endsAt" binding:"required"`
+	Location    string    `json:"location" binding:"max=300"`
+	URL         string    `json:"url" binding:"omitempty,url,max=500"`
+	Status      string    `json:"status" binding:"omitempty,oneof=draft published cancelled"`
+}
+
+// CommunityEventAnnouncement is sent to the cross-posting trigger when an event is
+// published, changed after publishing, or cancelled
+type CommunityEventAnnouncement struct {
+	Action  string          `json:"action"`
+	Event   *CommunityEvent `json:"event"`
+	PageURL string          `json:"pageUrl"`
+}
@@ -9,6 +9,14 @@ type ContactMentorRequest struct {
 	Intro            string `json:"intro" binding:"required,min=10,max=4000"`
 	TelegramUsername string `json:"telegramUsername" binding:"required,max=50"`
 	RecaptchaToken   string `json:"recaptchaToken" binding:"required,min=20"`
+
+	// Website is a honeypot field hidden from people; bots fill it in
+	Website string `json:"website" binding:"max=500"`
+	// FormRenderedAt is the signed render timestamp the frontend embeds in the form
+	FormRenderedAt string `json:"formRenderedAt" binding:"max=200"`
+
+	// ClientIP is set by the handler and checked against the blocklist
+	ClientIP string `json:"-"`
 }
 
 // ContactMentorResponse represents the response after submitting a contact form
@@ -17,6 +25,15 @@ type ContactMentorResponse struct {
 	RequestID   string `json:"requestId,omitempty"`
 	CalendarURL string `json:"calendar_url,omitempty"`
 	Error       string `json:"error,omitempty"`
+
+	// Mentor availability hints so the confirmation can tell when to expect a reply
+	MentorTimezone     string `json:"mentor_timezone,omitempty"`
+	ContactHours       string `json:"contact_hours,omitempty"`
+	WithinContactHours *bool  `json:"within_contact_hours,omitempty"`
+
+	// Set instead of the calendar link while the mentor's calendar link is broken
+	CalendarUnavailable bool   `json:"calendar_unavailable,omitempty"`
+	TelegramURL         string `json:"telegram_url,omitempty"`
 }
 
 // ClientRequest represents a client request record
@@ -27,6 +44,8 @@ type ClientRequest struct {
 	MentorID    string // Mentor UUID
 	Description string
 	Telegram    string
+	FlagReason  string // Set when the submission matched a flagging blocklist entry
+	Quarantined bool   // Held for admin review instead of being delivered to the mentor
 }
 
 // ReCAPTCHAResponse represents Google's ReCAPTCHA verification response
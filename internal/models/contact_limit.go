@@ -0,0 +1,48 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Subjects the contact form submissions are counted by
+const (
+	ContactLimitKindEmail = "email"
+	ContactLimitKindIP    = "ip"
+)
+
+// ContactLimitCounter is the submissions of one email or client IP in the sliding window
+type ContactLimitCounter struct {
+	Kind    string `json:"kind"`
+	Subject string `json:"subject"`
+	// Submissions is the sliding window estimate: the current window's count plus the previous
+	// window's, weighted by how much of it the sliding window still covers
+	Submissions      float64   `json:"submissions"`
+	Limit            int       `json:"limit"`
+	Limited          bool      `json:"limited"`
+	LastSubmissionAt time.Time `json:"lastSubmissionAt"`
+}
+
+// ContactLimitsResponse lists the counters of the sliding window
+type ContactLimitsResponse struct {
+	Counters      []*ContactLimitCounter `json:"counters"`
+	Total         int                    `json:"total"`
+	WindowMinutes int                    `json:"windowMinutes"`
+}
+
+// NormalizeContactLimitSubject returns an email or IP as it is counted
+func NormalizeContactLimitSubject(subject string) string {
+	return strings.ToLower(strings.TrimSpace(subject))
+}
+
+// SlidingWindowCount estimates the submissions of the window ending at now from the counts of
+// the fixed window starting at windowStart and the one before it
+func SlidingWindowCount(current, previous int, windowStart, now time.Time, window time.Duration) float64 {
+	if window <= 0 {
+		return float64(current)
+	}
+	elapsed := now.Sub(windowStart)
+	overlap := 1 - float64(elapsed)/float64(window)
+	overlap = min(max(overlap, 0), 1)
+	return float64(current) + float64(previous)*overlap
+}
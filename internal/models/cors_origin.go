@@ -0,0 +1,130 @@
+package models
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultCORSMethods are the methods of origins without a narrower list
+var DefaultCORSMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// DefaultCORSHeaders are the request headers of origins without a narrower list
+var DefaultCORSHeaders = []string{
+	"Origin", "Content-Type", "Accept", "Authorization", "mentors_api_auth_token", "x-internal-mentors-api-auth-token",
+	"X-Webhook-Secret", "X-Mentor-ID", "X-Auth-Token", "X-CSRF-Token", "If-Match", "traceparent", "tracestate",
+}
+
+// CORSOrigin is an origin allowed to call the API from browsers and what it may send
+type CORSOrigin struct {
+	Origin           string    `json:"origin"`
+	AllowedMethods   []string  `json:"allowedMethods"`
+	AllowedHeaders   []string  `json:"allowedHeaders"`
+	AllowCredentials bool      `json:"allowCredentials"`
+	Note             string    `json:"note,omitempty"`
+	UpdatedBy        string    `json:"updatedBy,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+	// Static origins come from ALLOWED_CORS_ORIGINS and cannot be changed through the API
+	Static bool `json:"static"`
+}
+
+// Methods returns the allowed methods, the default set when none are listed
+func (o *CORSOrigin) Methods() []string {
+	if len(o.AllowedMethods) == 0 {
+		return DefaultCORSMethods
+	}
+	return o.AllowedMethods
+}
+
+// Headers returns the allowed request headers, the default set when none are listed
+func (o *CORSOrigin) Headers() []string {
+	if len(o.AllowedHeaders) == 0 {
+		return DefaultCORSHeaders
+	}
+	return o.AllowedHeaders
+}
+
+// AllowsMethod reports whether the origin may use method. Preflights are always allowed.
+func (o *CORSOrigin) AllowsMethod(method string) bool {
+	if method == http.MethodOptions {
+		return true
+	}
+	for _, allowed := range o.Methods() {
+		if strings.EqualFold(allowed, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsHeader reports whether the origin may send the request header
+func (o *CORSOrigin) AllowsHeader(header string) bool {
+	for _, allowed := range o.Headers() {
+		if strings.EqualFold(allowed, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// CORSPolicy is the set of origins allowed at one point in time
+type CORSPolicy struct {
+	origins map[string]*CORSOrigin
+}
+
+// NewCORSPolicy indexes origins; later entries for the same origin win
+func NewCORSPolicy(origins []*CORSOrigin) *CORSPolicy {
+	policy := &CORSPolicy{origins: make(map[string]*CORSOrigin, len(origins))}
+	for _, origin := range origins {
+		policy.origins[strings.ToLower(origin.Origin)] = origin
+	}
+	return policy
+}
+
+// Lookup returns the rules of the request origin
+func (p *CORSPolicy) Lookup(origin string) (*CORSOrigin, bool) {
+	if p == nil {
+		return nil, false
+	}
+	rule, ok := p.origins[strings.ToLower(strings.TrimSuffix(origin, "/"))]
+	return rule, ok
+}
+
+// Len returns the number of origins in the policy
+func (p *CORSPolicy) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.origins)
+}
+
+// NormalizeCORSOrigin checks origin is scheme://host[:port] and lowercases it
+func NormalizeCORSOrigin(origin string) (string, error) {
+	origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" ||
+		u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("invalid origin %q, expected scheme://host[:port]", origin)
+	}
+	return strings.ToLower(origin), nil
+}
+
+// UpsertCORSOriginRequest adds an origin or replaces its rules
+type UpsertCORSOriginRequest struct {
+	Origin           string   `json:"origin" binding:"required,max=255"`
+	AllowedMethods   []string `json:"allowedMethods" binding:"max=10,dive,oneof=GET POST PUT PATCH DELETE OPTIONS"`
+	AllowedHeaders   []string `json:"allowedHeaders" binding:"max=30,dive,max=100"`
+	AllowCredentials bool     `json:"allowCredentials"`
+	Note             string   `json:"note" binding:"max=500"`
+}
+
+// CORSOriginsResponse is returned by the CORS origins list endpoint
+type CORSOriginsResponse struct {
+	Origins []*CORSOrigin `json:"origins"`
+	Total   int           `json:"total"`
+}
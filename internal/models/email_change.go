@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	EmailChangePending    = "pending"
+	EmailChangeConfirmed  = "confirmed"
+	EmailChangeSuperseded = "superseded"
+)
+
+// EmailChangeTTL is how long the confirmation link sent to the new address stays valid
+const EmailChangeTTL = 24 * time.Hour
+
+// EmailChange is a mentor email change waiting for (or done after) confirmation from the new address
+type EmailChange struct {
+	ID        string    `json:"id"`
+	MentorID  string    `json:"-"`
+	OldEmail  string    `json:"-"`
+	NewEmail  string    `json:"newEmail"`
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+	// RequestedBy is the moderator who started the change; empty when the mentor did
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// Token is the secret of the confirmation link; never listed
+	Token string `json:"-"`
+}
+
+// IsOpen reports whether the change can still be confirmed
+func (c *EmailChange) IsOpen(now time.Time) bool {
+	return c.Status == EmailChangePending && c.ExpiresAt.After(now)
+}
+
+// NormalizeEmail trims and lowercases an email address for comparison and storage
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// RequestEmailChangeRequest is sent by the mentor to change their login email
+type RequestEmailChangeRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+}
+
+// EmailChangeResponse wraps the pending change, if any
+type EmailChangeResponse struct {
+	Change *EmailChange `json:"change"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "encoding/json"
+
+// FrontendLogLevels are the accepted frontend log levels
+var FrontendLogLevels = map[string]bool{
+	"debug": true,
+	"info":  true,
+	"warn":  true,
+	"error": true,
+	"fatal": true,
+}
+
+// FrontendLogEntry is a single log entry sent by the frontend
+type FrontendLogEntry struct {
+	Timestamp string                 `json:"timestamp" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Level     string                 `json:"level" binding:"required,oneof=debug info warn error fatal"`
+	Message   string                 `json:"message" binding:"required,max=2000"`
+	Route     string                 `json:"route,omitempty" binding:"omitempty,max=500"`
+	UserAgent string                 `json:"userAgent,omitempty" binding:"omitempty,max=500"`
+	Context   map[string]interface{} `json:"context,omitempty" binding:"omitempty,max=50"`
+
+	// Traceparent (W3C trace context) and RequestID correlate the entry with backend traces and logs
+	Traceparent string `json:"traceparent,omitempty" binding:"omitempty,max=55"`
+	RequestID   string `json:"requestId,omitempty" binding:"omitempty,max=128"`
+}
+
+// IsError reports whether the entry should be attached to its trace as an error event
+func (e *FrontendLogEntry) IsError() bool {
+	return e.Level == "error" || e.Level == "fatal"
+}
+
+// FrontendLogBatchRequest is the body of POST /api/v1/logs, capped at 100 entries per batch.
+// Entries are kept raw so that each one is decoded and validated on its own.
+type FrontendLogBatchRequest struct {
+	Logs []json.RawMessage `json:"logs" binding:"required,min=1,max=100"`
+}
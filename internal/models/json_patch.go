@@ -0,0 +1,310 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchContentType is the media type of JSON Patch documents (RFC 6902)
+const JSONPatchContentType = "application/json-patch+json"
+
+// MaxJSONPatchOperations caps the operations of a single JSON Patch document
+const MaxJSONPatchOperations = 100
+
+// ErrJSONPatchTestFailed is returned when a "test" operation doesn't match the target,
+// i.e. the target changed since the client read it
+var ErrJSONPatchTestFailed = errors.New("json patch test failed")
+
+// ErrJSONPatchPathNotFound is returned when an operation's target or from location doesn't
+// exist: a missing member, an array index past the end, or a path through a scalar
+var ErrJSONPatchPathNotFound = errors.New("json patch path not found")
+
+// JSON Patch operations
+const (
+	JSONPatchAdd     = "add"
+	JSONPatchRemove  = "remove"
+	JSONPatchReplace = "replace"
+	JSONPatchMove    = "move"
+	JSONPatchCopy    = "copy"
+	JSONPatchTest    = "test"
+)
+
+// JSONPatchOperation is one operation of a JSON Patch document
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is a JSON Patch document. Paths are JSON Pointers (RFC 6901) into the target's
+// fields: "/tags" is a field, "/tags/0" its first element and "/tags/-" the end of the array.
+type JSONPatch []JSONPatchOperation
+
+// ParseJSONPatch parses a JSON Patch document and checks the shape of every operation.
+// The document must be a non-empty JSON array.
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return nil, errors.New("json patch must be a JSON array")
+	}
+
+	var patch JSONPatch
+	if err := json.Unmarshal(trimmed, &patch); err != nil {
+		return nil, err
+	}
+	if len(patch) == 0 || len(patch) > MaxJSONPatchOperations {
+		return nil, fmt.Errorf("json patch must have between 1 and %d operations", MaxJSONPatchOperations)
+	}
+
+	for i, op := range patch {
+		if _, err := jsonPointerTokens(op.Path); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		switch op.Op {
+		case JSONPatchAdd, JSONPatchReplace, JSONPatchTest:
+			if op.Value == nil {
+				return nil, fmt.Errorf("operation %d: %q requires a value", i, op.Op)
+			}
+		case JSONPatchMove, JSONPatchCopy:
+			if _, err := jsonPointerTokens(op.From); err != nil {
+				return nil, fmt.Errorf("operation %d: from: %w", i, err)
+			}
+			if op.Op == JSONPatchMove && strings.HasPrefix(op.Path, op.From+"/") {
+				return nil, fmt.Errorf("operation %d: cannot move %q into itself", i, op.From)
+			}
+		case JSONPatchRemove:
+		default:
+			return nil, fmt.Errorf("operation %d: unknown op %q", i, op.Op)
+		}
+	}
+	return patch, nil
+}
+
+// Field returns the top-level field the operation's path points at or into
+func (op JSONPatchOperation) Field() string {
+	tokens, _ := jsonPointerTokens(op.Path) //nolint:errcheck // paths are checked by ParseJSONPatch
+	if len(tokens) == 0 {
+		return ""
+	}
+	return tokens[0]
+}
+
+// ToMergePatch applies the operations in order to doc, the target's JSON fields, and returns the
+// top-level fields they touched as a merge patch: removed fields are null, the others hold their
+// final value, whole arrays included. A failing "test" aborts the whole patch with
+// ErrJSONPatchTestFailed, a missing target with ErrJSONPatchPathNotFound. doc is not modified.
+func (p JSONPatch) ToMergePatch(doc map[string]interface{}) (MergePatch, error) {
+	working, _ := copyJSONValue(doc).(map[string]interface{}) //nolint:errcheck // a map copies to a map
+	touched := map[string]bool{}
+
+	for i, op := range p {
+		path, _ := jsonPointerTokens(op.Path) //nolint:errcheck // checked by ParseJSONPatch
+		switch op.Op {
+		case JSONPatchAdd, JSONPatchReplace:
+			var value interface{}
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+			if _, _, err := applyJSONPointer(working, path, op.Op, value); err != nil {
+				return nil, fmt.Errorf("operation %d: %w: %s", i, err, op.Path)
+			}
+		case JSONPatchRemove:
+			if _, _, err := applyJSONPointer(working, path, op.Op, nil); err != nil {
+				return nil, fmt.Errorf("operation %d: %w: %s", i, err, op.Path)
+			}
+		case JSONPatchMove, JSONPatchCopy:
+			from, _ := jsonPointerTokens(op.From) //nolint:errcheck // checked by ParseJSONPatch
+			value, err := getJSONPointer(working, from)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: from: %w: %s", i, err, op.From)
+			}
+			if op.Op == JSONPatchMove {
+				if _, _, err := applyJSONPointer(working, from, JSONPatchRemove, nil); err != nil {
+					return nil, fmt.Errorf("operation %d: from: %w: %s", i, err, op.From)
+				}
+				touched[from[0]] = true
+			} else {
+				value = copyJSONValue(value)
+			}
+			if _, _, err := applyJSONPointer(working, path, JSONPatchAdd, value); err != nil {
+				return nil, fmt.Errorf("operation %d: %w: %s", i, err, op.Path)
+			}
+		case JSONPatchTest:
+			var expected interface{}
+			if err := json.Unmarshal(op.Value, &expected); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+			actual, err := getJSONPointer(working, path)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %w: %s", i, err, op.Path)
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				return nil, fmt.Errorf("%w: %s", ErrJSONPatchTestFailed, op.Path)
+			}
+			continue
+		}
+		touched[path[0]] = true
+	}
+
+	merge := make(MergePatch, len(touched))
+	for field := range touched {
+		value, ok := working[field]
+		if !ok {
+			merge[field] = json.RawMessage("null")
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", field, err)
+		}
+		merge[field] = raw
+	}
+	return merge, nil
+}
+
+// jsonPointerTokens decodes a JSON Pointer into its reference tokens. The whole document ("")
+// can't be patched, only its fields.
+func jsonPointerTokens(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") || len(pointer) == 1 {
+		return nil, fmt.Errorf("path %q must point at a field", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	for i, token := range tokens {
+		tokens[i] = unescape.Replace(token)
+	}
+	return tokens, nil
+}
+
+// getJSONPointer returns the value tokens point at inside container
+func getJSONPointer(container interface{}, tokens []string) (interface{}, error) {
+	for _, token := range tokens {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			value, ok := c[token]
+			if !ok {
+				return nil, ErrJSONPatchPathNotFound
+			}
+			container = value
+		case []interface{}:
+			index, err := jsonArrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			container = c[index]
+		default:
+			return nil, ErrJSONPatchPathNotFound
+		}
+	}
+	return container, nil
+}
+
+// applyJSONPointer runs an add, remove or replace of the value tokens point at inside container.
+// It returns the updated container, which differs from container when an array grew or shrank,
+// and the value removed or replaced. Remove and replace need an existing target; add may create
+// a member, insert at an index up to the array's length, or append with "-".
+func applyJSONPointer(container interface{}, tokens []string, op string, value interface{}) (interface{}, interface{}, error) {
+	token, last := tokens[0], len(tokens) == 1
+	switch c := container.(type) {
+	case map[string]interface{}:
+		current, ok := c[token]
+		if !last {
+			if !ok {
+				return nil, nil, ErrJSONPatchPathNotFound
+			}
+			updated, old, err := applyJSONPointer(current, tokens[1:], op, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			c[token] = updated
+			return c, old, nil
+		}
+		switch {
+		case op == JSONPatchAdd:
+			c[token] = value
+		case !ok:
+			return nil, nil, ErrJSONPatchPathNotFound
+		case op == JSONPatchRemove:
+			delete(c, token)
+		default:
+			c[token] = value
+		}
+		return c, current, nil
+
+	case []interface{}:
+		if last && op == JSONPatchAdd && token == "-" {
+			return append(c, value), nil, nil
+		}
+		maxIndex := len(c) - 1
+		if last && op == JSONPatchAdd {
+			maxIndex = len(c)
+		}
+		index, err := jsonArrayIndex(token, maxIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !last {
+			updated, old, err := applyJSONPointer(c[index], tokens[1:], op, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			c[index] = updated
+			return c, old, nil
+		}
+		switch op {
+		case JSONPatchAdd:
+			c = append(c, nil)
+			copy(c[index+1:], c[index:])
+			c[index] = value
+			return c, nil, nil
+		case JSONPatchRemove:
+			old := c[index]
+			return append(c[:index], c[index+1:]...), old, nil
+		default:
+			old := c[index]
+			c[index] = value
+			return c, old, nil
+		}
+
+	default:
+		return nil, nil, ErrJSONPatchPathNotFound
+	}
+}
+
+// jsonArrayIndex parses an array index token: decimal digits without leading zeros, at most maxIndex
+func jsonArrayIndex(token string, maxIndex int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrJSONPatchPathNotFound
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > maxIndex {
+		return 0, ErrJSONPatchPathNotFound
+	}
+	return index, nil
+}
+
+// copyJSONValue deep-copies a value decoded from JSON, so patching the copy leaves the original alone
+func copyJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = copyJSONValue(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyJSONValue(item)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LeaderboardPeriodAll covers the whole history of the platform
+const LeaderboardPeriodAll = "all"
+
+// LeaderboardEntry is a single mentor on the leaderboard
+type LeaderboardEntry struct {
+	Rank              int    `json:"rank"`
+	MentorID          string `json:"-"`
+	LegacyID          int    `json:"id"`
+	Slug              string `json:"slug"`
+	Name              string `json:"name"`
+	Job               string `json:"job"`
+	Workplace         string `json:"workplace"`
+	CompletedSessions int    `json:"completedSessions"`
+	Reviews           int    `json:"reviews"`
+	Link              string `json:"link"`
+}
+
+// Leaderboard is the precomputed ranking of opted-in mentors for a period
+type Leaderboard struct {
+	Period string `json:"period"`
+	// Since is the start of the period; nil for the "all" period
+	Since       *time.Time          `json:"since,omitempty"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+	Entries     []*LeaderboardEntry `json:"entries"`
+}
+
+// ParseLeaderboardPeriod parses a period like "30d" into a number of days.
+// The "all" period returns 0.
+func ParseLeaderboardPeriod(period string) (int, error) {
+	period = strings.TrimSpace(period)
+	if period == LeaderboardPeriodAll {
+		return 0, nil
+	}
+
+	days, err := strconv.Atoi(strings.TrimSuffix(period, "d"))
+	if err != nil || !strings.HasSuffix(period, "d") || days <= 0 {
+		return 0, fmt.Errorf("invalid leaderboard period %q: expected <days>d or %q", period, LeaderboardPeriodAll)
+	}
+	return days, nil
+}
+
+// LeaderboardOptInRequest toggles the mentor's presence on the leaderboard
+type LeaderboardOptInRequest struct {
+	OptIn *bool `json:"optIn" binding:"required"`
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
 // MCPRequest represents a JSON-RPC 2.0 request
 type MCPRequest struct {
 	JSONRPC string                 `json:"jsonrpc"` // Must be "2.0"
@@ -32,6 +38,19 @@ const (
 	InternalError  = -32603
 )
 
+// RateLimited is the server-defined JSON-RPC error code returned when the caller's MCP
+// request budget is used up; its data is an MCPQuota with RetryAfterSeconds set
+const RateLimited = -32029
+
+// MCPQuota is the caller's MCP request budget. Successful results carry it under
+// _meta.quota so clients can slow down before they are limited.
+type MCPQuota struct {
+	Limit             int     `json:"limit"`
+	Remaining         int     `json:"remaining"`
+	RefillPerSecond   float64 `json:"refillPerSecond"`
+	RetryAfterSeconds int     `json:"retryAfterSeconds,omitempty"`
+}
+
 // MCPTool represents a tool definition following MCP protocol
 type MCPTool struct {
 	Name        string                 `json:"name"`
@@ -46,7 +65,12 @@ type ListMentorsParams struct {
 	MinPrice   string   `json:"minPrice,omitempty"`   // Minimum price (inclusive)
 	MaxPrice   string   `json:"maxPrice,omitempty"`   // Maximum price (inclusive)
 	Workplace  string   `json:"workplace,omitempty"`  // Filter by workplace
+	Country    string   `json:"country,omitempty"`    // Filter by ISO 3166-1 alpha-2 country code
+	City       string   `json:"city,omitempty"`       // Filter by city
+	RemoteOnly *bool    `json:"remoteOnly,omitempty"` // Filter by online-only mentors
+	Languages  []string `json:"languages,omitempty"`  // Filter by mentoring languages (ru, en, other)
 	Limit      int      `json:"limit,omitempty"`      // Limit results (default: 50, max: 200)
+	Cursor     string   `json:"cursor,omitempty"`     // nextCursor of the previous page
 }
 
 // GetMentorParams represents parameters for the get_mentor tool
@@ -57,13 +81,20 @@ type GetMentorParams struct {
 
 // SearchMentorsParams represents parameters for the search_mentors tool
 type SearchMentorsParams struct {
-	Query      string   `json:"query"`                // Search keywords (space-separated)
-	Tags       []string `json:"tags,omitempty"`       // Filter by tags
-	Experience string   `json:"experience,omitempty"` // Filter by experience level
-	MinPrice   string   `json:"minPrice,omitempty"`   // Minimum price (inclusive)
-	MaxPrice   string   `json:"maxPrice,omitempty"`   // Maximum price (inclusive)
-	Workplace  string   `json:"workplace,omitempty"`  // Filter by workplace
-	Limit      int      `json:"limit,omitempty"`      // Limit results (default: 20, max: 100)
+	Query           string             `json:"query"`                     // Search keywords (comma-separated, any matches)
+	KeywordWeights  map[string]float64 `json:"keywordWeights,omitempty"`  // Ranking weight per keyword (default 1, max 10)
+	ExcludeKeywords []string           `json:"excludeKeywords,omitempty"` // Drop mentors matching any of these
+	Tags            []string           `json:"tags,omitempty"`            // Filter by tags
+	Experience      string             `json:"experience,omitempty"`      // Filter by experience level
+	MinPrice        string             `json:"minPrice,omitempty"`        // Minimum price (inclusive)
+	MaxPrice        string             `json:"maxPrice,omitempty"`        // Maximum price (inclusive)
+	Workplace       string             `json:"workplace,omitempty"`       // Filter by workplace
+	Country         string             `json:"country,omitempty"`         // Filter by ISO 3166-1 alpha-2 country code
+	City            string             `json:"city,omitempty"`            // Filter by city
+	RemoteOnly      *bool              `json:"remoteOnly,omitempty"`      // Filter by online-only mentors
+	Languages       []string           `json:"languages,omitempty"`       // Filter by mentoring languages (ru, en, other)
+	Limit           int                `json:"limit,omitempty"`           // Limit results (default: 20, max: 100)
+	Cursor          string             `json:"cursor,omitempty"`          // nextCursor of the previous page
 }
 
 // MCPMentorBasic represents basic mentor information for list_mentors tool
@@ -78,6 +109,10 @@ type MCPMentorBasic struct {
 	Competencies string   `json:"competencies"`
 	Price        string   `json:"price"`
 	DoneSessions int      `json:"doneSessions"`
+	Country      string   `json:"country,omitempty"`
+	City         string   `json:"city,omitempty"`
+	RemoteOnly   bool     `json:"remoteOnly"`
+	Languages    []string `json:"languages"`
 	MentorURL    string   `json:"mentorUrl"`
 }
 
@@ -95,13 +130,18 @@ type MCPMentorExtended struct {
 	DoneSessions int      `json:"doneSessions"`
 	Description  string   `json:"description"`
 	About        string   `json:"about"`
+	Country      string   `json:"country,omitempty"`
+	City         string   `json:"city,omitempty"`
+	RemoteOnly   bool     `json:"remoteOnly"`
+	Languages    []string `json:"languages"`
 	MentorURL    string   `json:"mentorUrl"`
 }
 
 // ListMentorsResult represents the result of list_mentors tool invocation
 type ListMentorsResult struct {
-	Mentors []MCPMentorBasic `json:"mentors"`
-	Count   int              `json:"count"`
+	Mentors    []MCPMentorBasic `json:"mentors"`
+	Count      int              `json:"count"`
+	NextCursor string           `json:"nextCursor,omitempty"` // Set when more mentors match
 }
 
 // GetMentorResult represents the result of get_mentor tool invocation
@@ -111,8 +151,44 @@ type GetMentorResult struct {
 
 // SearchMentorsResult represents the result of search_mentors tool invocation
 type SearchMentorsResult struct {
-	Mentors []MCPMentorExtended `json:"mentors"`
-	Count   int                 `json:"count"`
+	Mentors    []MCPMentorExtended `json:"mentors"`
+	Count      int                 `json:"count"`
+	NextCursor string              `json:"nextCursor,omitempty"` // Set when more mentors may match
+}
+
+// MCPCursor is where the next page of a list_mentors or search_mentors call starts. Clients
+// get it as an opaque string. Version is the mentor cache version the page was read at;
+// LastSlug lets the next page resume after the last mentor returned when the version changed.
+type MCPCursor struct {
+	Tool     string `json:"t"`
+	Offset   int    `json:"o"`
+	Version  string `json:"v,omitempty"`
+	LastSlug string `json:"s,omitempty"`
+}
+
+// Encode returns the opaque form of the cursor
+func (c *MCPCursor) Encode() string {
+	data, _ := json.Marshal(c) //nolint:errcheck // a struct of strings and ints always marshals
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeMCPCursor reads a cursor of tool; an empty string is the first page and returns nil
+func DecodeMCPCursor(encoded, tool string) (*MCPCursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("malformed cursor")
+	}
+	var cursor MCPCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Offset < 0 {
+		return nil, errors.New("malformed cursor")
+	}
+	if cursor.Tool != tool {
+		return nil, errors.New("cursor belongs to another tool")
+	}
+	return &cursor, nil
 }
 
 // ToMCPBasic converts a Mentor to MCPMentorBasic format
@@ -128,6 +204,10 @@ func (m *Mentor) ToMCPBasic(baseURL string) MCPMentorBasic {
 		Competencies: m.Competencies,
 		Price:        m.Price,
 		DoneSessions: m.MenteeCount,
+		Country:      m.Country,
+		City:         m.City,
+		RemoteOnly:   m.RemoteOnly,
+		Languages:    m.Languages,
 		MentorURL:    baseURL + "/mentor/" + m.Slug,
 	}
 }
@@ -147,6 +227,10 @@ func (m *Mentor) ToMCPExtended(baseURL string) MCPMentorExtended {
 		DoneSessions: m.MenteeCount,
 		Description:  m.Description,
 		About:        m.About,
+		Country:      m.Country,
+		City:         m.City,
+		RemoteOnly:   m.RemoteOnly,
+		Languages:    m.Languages,
 		MentorURL:    baseURL + "/mentor/" + m.Slug,
 	}
 }
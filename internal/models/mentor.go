@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +23,8 @@ type Mentor struct {
 	Experience   string    `json:"experience"`
 	Price        string    `json:"price"`
 	MenteeCount  int       `json:"menteeCount"`
+	Rating       *float64  `json:"rating"`      // Average rating of approved reviews, nil until one is rated
+	ReviewCount  int       `json:"reviewCount"` // Approved reviews with a rating
 	Tags         []string  `json:"tags"`
 	SortOrder    int       `json:"sortOrder"`
 	IsVisible    bool      `json:"isVisible"` // Computed: status = 'active' AND telegram_chat_id IS NOT NULL
@@ -33,6 +36,21 @@ type Mentor struct {
 	// Status field for login eligibility checks
 	Status string `json:"status"`
 
+	// Availability: IANA timezone name and preferred contact hours (HH:MM-HH:MM, mentor's local time)
+	Timezone     string `json:"timezone"`
+	ContactHours string `json:"contactHours"`
+
+	// Location: ISO 3166-1 alpha-2 country code, city and whether the mentor only meets online
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	RemoteOnly bool   `json:"remoteOnly"`
+
+	// Languages the mentor mentors in (see MentorLanguages)
+	Languages []string `json:"languages"`
+
+	// LeaderboardOptIn allows showing the mentor on the public leaderboard
+	LeaderboardOptIn bool `json:"leaderboardOptIn"`
+
 	// Secure fields (cleared by repository unless ShowHidden is true)
 	CalendarURL string `json:"calendarUrl"`
 
@@ -43,19 +61,27 @@ type Mentor struct {
 
 // PublicMentorResponse represents the public API response format
 type PublicMentorResponse struct {
-	ID           int       `json:"id"`
-	Name         string    `json:"name"`
-	Title        string    `json:"title"`
-	Workplace    string    `json:"workplace"`
-	About        string    `json:"about"`
-	Description  string    `json:"description"`
-	Competencies string    `json:"competencies"`
-	Experience   string    `json:"experience"`
-	Price        string    `json:"price"`
-	DoneSessions int       `json:"doneSessions"`
-	Tags         string    `json:"tags"`
-	Link         string    `json:"link"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID           int       `json:"id" xml:"id"`
+	Name         string    `json:"name" xml:"name"`
+	Title        string    `json:"title" xml:"title"`
+	Workplace    string    `json:"workplace" xml:"workplace"`
+	About        string    `json:"about" xml:"about"`
+	Description  string    `json:"description" xml:"description"`
+	Competencies string    `json:"competencies" xml:"competencies"`
+	Experience   string    `json:"experience" xml:"experience"`
+	Price        string    `json:"price" xml:"price"`
+	DoneSessions int       `json:"doneSessions" xml:"doneSessions"`
+	Rating       *float64  `json:"rating,omitempty" xml:"rating,omitempty"`
+	ReviewCount  int       `json:"reviewCount" xml:"reviewCount"`
+	Tags         string    `json:"tags" xml:"tags"`
+	Link         string    `json:"link" xml:"link"`
+	Timezone     string    `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	ContactHours string    `json:"contactHours,omitempty" xml:"contactHours,omitempty"`
+	Country      string    `json:"country,omitempty" xml:"country,omitempty"`
+	City         string    `json:"city,omitempty" xml:"city,omitempty"`
+	RemoteOnly   bool      `json:"remoteOnly" xml:"remoteOnly"`
+	Languages    []string  `json:"languages" xml:"languages>language"`
+	UpdatedAt    time.Time `json:"updatedAt" xml:"updatedAt"`
 }
 
 // ToPublicResponse converts a Mentor to PublicMentorResponse
@@ -71,18 +97,89 @@ func (m *Mentor) ToPublicResponse(baseURL string) PublicMentorResponse {
 		Experience:   m.Experience,
 		Price:        m.Price,
 		DoneSessions: m.MenteeCount,
+		Rating:       m.Rating,
+		ReviewCount:  m.ReviewCount,
 		Tags:         strings.Join(m.Tags, ","),
 		Link:         baseURL + "/mentor/" + m.Slug,
+		Timezone:     m.Timezone,
+		ContactHours: m.ContactHours,
+		Country:      m.Country,
+		City:         m.City,
+		RemoteOnly:   m.RemoteOnly,
+		Languages:    m.Languages,
 		UpdatedAt:    m.UpdatedAt,
 	}
 }
 
+// PublicMentorCSVHeader names the columns of PublicMentorResponse.CSVRow
+var PublicMentorCSVHeader = []string{
+	"id", "name", "title", "workplace", "about", "description", "competencies", "experience", "price",
+	"doneSessions", "rating", "reviewCount", "tags", "link", "timezone", "contactHours", "country", "city", "remoteOnly", "languages", "updatedAt",
+}
+
+// CSVRow returns the mentor as CSV cells in PublicMentorCSVHeader order; languages are comma-joined
+func (r PublicMentorResponse) CSVRow() []string {
+	rating := ""
+	if r.Rating != nil {
+		rating = strconv.FormatFloat(*r.Rating, 'f', 1, 64)
+	}
+	return []string{
+		strconv.Itoa(r.ID), r.Name, r.Title, r.Workplace, r.About, r.Description, r.Competencies, r.Experience, r.Price,
+		strconv.Itoa(r.DoneSessions), rating, strconv.Itoa(r.ReviewCount), r.Tags, r.Link, r.Timezone, r.ContactHours, r.Country, r.City,
+		strconv.FormatBool(r.RemoteOnly), strings.Join(r.Languages, ","), r.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// MentorApproval is the moment a mentor profile was approved by moderators
+type MentorApproval struct {
+	Slug       string
+	ApprovedAt time.Time
+}
+
+// NewMentor is a recently approved mentor
+type NewMentor struct {
+	Mentor     *Mentor
+	ApprovedAt time.Time
+}
+
+// NewMentorResponse is an entry of the new mentors feed
+type NewMentorResponse struct {
+	PublicMentorResponse
+	ApprovedAt time.Time `json:"approvedAt"`
+}
+
 // FilterOptions represents options for filtering mentors
 type FilterOptions struct {
 	OnlyVisible    bool
 	ShowHidden     bool
 	DropLongFields bool
 	ForceRefresh   bool
+	// Sort orders the list; SortSeed drives MentorSortRandomSeeded
+	Sort     MentorSort
+	SortSeed string
+	// Tags keeps mentors with any of the tags and Experience those with the experience level,
+	// both case-insensitive; served from the cache's indexes
+	Tags       []string
+	Experience string
+}
+
+// MatchesTagsAndExperience reports whether the mentor has any of tags and the experience
+// level, case-insensitively. Empty filters match.
+func (m *Mentor) MatchesTagsAndExperience(tags []string, experience string) bool {
+	if experience = strings.TrimSpace(experience); experience != "" && !strings.EqualFold(strings.TrimSpace(m.Experience), experience) {
+		return false
+	}
+	if len(tags) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		for _, mentorTag := range m.Tags {
+			if strings.EqualFold(strings.TrimSpace(mentorTag), strings.TrimSpace(tag)) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // ScanMentor scans a single PostgreSQL row into a Mentor struct
@@ -97,6 +194,11 @@ func ScanMentor(row pgx.Row) (*Mentor, error) {
 	var about *string
 	var description *string
 	var competencies *string
+	var timezone *string
+	var contactHours *string
+	var country *string
+	var city *string
+	var calendarBroken bool
 
 	err := row.Scan(
 		&m.MentorID,
@@ -119,6 +221,16 @@ func ScanMentor(row pgx.Row) (*Mentor, error) {
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.MenteeCount,
+		&timezone,
+		&contactHours,
+		&m.LeaderboardOptIn,
+		&country,
+		&city,
+		&m.RemoteOnly,
+		&m.Languages,
+		&calendarBroken,
+		&m.Rating,
+		&m.ReviewCount,
 	)
 	if err != nil {
 		return nil, err
@@ -145,6 +257,21 @@ func ScanMentor(row pgx.Row) (*Mentor, error) {
 	if competencies != nil {
 		m.Competencies = *competencies
 	}
+	if timezone != nil {
+		m.Timezone = *timezone
+	}
+	if contactHours != nil {
+		m.ContactHours = *contactHours
+	}
+	if country != nil {
+		m.Country = *country
+	}
+	if city != nil {
+		m.City = *city
+	}
+	if m.Languages == nil {
+		m.Languages = []string{}
+	}
 
 	// Parse tags from comma-separated string
 	m.Tags = []string{}
@@ -164,8 +291,11 @@ func ScanMentor(row pgx.Row) (*Mentor, error) {
 	fourteenDaysAgo := time.Now().AddDate(0, 0, -14)
 	m.IsNew = m.CreatedAt.After(fourteenDaysAgo)
 
-	// Determine calendar type
+	// Determine calendar type; a link that failed repeated checks is reported as broken
 	m.CalendarType = GetCalendarType(m.CalendarURL)
+	if calendarBroken && m.CalendarURL != "" {
+		m.CalendarType = CalendarTypeBroken
+	}
 
 	// Get sponsor from tags
 	m.Sponsors = GetMentorSponsor(m.Tags)
@@ -193,6 +323,10 @@ func ScanMentors(rows pgx.Rows) ([]*Mentor, error) {
 	return mentors, nil
 }
 
+// CalendarTypeBroken marks a calendar link that failed repeated link checks. Mentees are offered
+// the mentor's Telegram instead until a check succeeds again.
+const CalendarTypeBroken = "broken"
+
 // GetCalendarType determines the calendar service type from URL
 func GetCalendarType(url string) string {
 	if url == "" {
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// MentorAnnouncement is the payload sent to the channel-posting webhook when a mentor becomes active
+type MentorAnnouncement struct {
+	Type     string   `json:"type"`
+	MentorID string   `json:"mentorId"`
+	Name     string   `json:"name"`
+	Job      string   `json:"job"`
+	Tags     []string `json:"tags"`
+	Price    string   `json:"price"`
+	Link     string   `json:"link"`
+	PhotoURL string   `json:"photoUrl,omitempty"`
+	// Text is the rendered post in Telegram HTML formatting
+	Text string `json:"text"`
+}
+
+// NewMentorAnnouncement renders the channel post for the mentor
+func NewMentorAnnouncement(mentor *Mentor, link, photoURL string) *MentorAnnouncement {
+	var text strings.Builder
+	fmt.Fprintf(&text, "<b>%s</b>", html.EscapeString(mentor.Name))
+	if job := jobLine(mentor); job != "" {
+		fmt.Fprintf(&text, "\n%s", html.EscapeString(job))
+	}
+	if len(mentor.Tags) > 0 {
+		hashtags := make([]string, 0, len(mentor.Tags))
+		for _, tag := range mentor.Tags {
+			hashtags = append(hashtags, "#"+hashtagify(tag))
+		}
+		fmt.Fprintf(&text, "\n\n%s", html.EscapeString(strings.Join(hashtags, " ")))
+	}
+	if mentor.Price != "" {
+		fmt.Fprintf(&text, "\n\nЦена: %s", html.EscapeString(mentor.Price))
+	}
+	fmt.Fprintf(&text, "\n\n<a href=\"%s\">Профиль ментора</a>", html.EscapeString(link))
+
+	return &MentorAnnouncement{
+		Type:     "mentor_channel_post",
+		MentorID: mentor.MentorID,
+		Name:     mentor.Name,
+		Job:      jobLine(mentor),
+		Tags:     mentor.Tags,
+		Price:    mentor.Price,
+		Link:     link,
+		PhotoURL: photoURL,
+		Text:     text.String(),
+	}
+}
+
+func jobLine(mentor *Mentor) string {
+	switch {
+	case mentor.Job != "" && mentor.Workplace != "":
+		return mentor.Job + " @ " + mentor.Workplace
+	case mentor.Job != "":
+		return mentor.Job
+	default:
+		return mentor.Workplace
+	}
+}
+
+// hashtagify turns a tag name into a Telegram hashtag body: letters, digits and underscores only
+func hashtagify(tag string) string {
+	var b strings.Builder
+	for _, r := range tag {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		} else if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+			b.WriteRune('_')
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
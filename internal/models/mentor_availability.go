@@ -0,0 +1,106 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ValidateTimezone checks that tz is a known IANA timezone name (e.g. "Europe/Moscow")
+func ValidateTimezone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("unknown timezone %q", tz)
+	}
+	return nil
+}
+
+// ParseContactHours parses a "HH:MM-HH:MM" range into minutes since midnight.
+// End may be earlier than start for ranges crossing midnight (e.g. "22:00-02:00").
+func ParseContactHours(value string) (startMinute, endMinute int, err error) {
+	return parseHoursRange(value, "contact hours")
+}
+
+// ParseQuietHours parses quiet hours, a range in the same format as contact hours
+func ParseQuietHours(value string) (startMinute, endMinute int, err error) {
+	return parseHoursRange(value, "quiet hours")
+}
+
+func parseHoursRange(value, what string) (startMinute, endMinute int, err error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%s must be in HH:MM-HH:MM format", what)
+	}
+
+	start, err := time.Parse("15:04", strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s start: %q", what, parts[0])
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s end: %q", what, parts[1])
+	}
+
+	startMinute = start.Hour()*60 + start.Minute()
+	endMinute = end.Hour()*60 + end.Minute()
+	if startMinute == endMinute {
+		return 0, 0, fmt.Errorf("%s range cannot be empty", what)
+	}
+
+	return startMinute, endMinute, nil
+}
+
+// withinMinuteRange reports whether minute falls into [start, end), which may cross midnight
+func withinMinuteRange(minute, startMinute, endMinute int) bool {
+	if startMinute < endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	// Range crosses midnight
+	return minute >= startMinute || minute < endMinute
+}
+
+// IsWithinContactHours reports whether now falls into the mentor's preferred contact hours.
+// The second return value is false when the mentor has no (valid) timezone or contact hours set.
+func (m *Mentor) IsWithinContactHours(now time.Time) (within bool, known bool) {
+	if m.Timezone == "" || m.ContactHours == "" {
+		return false, false
+	}
+
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return false, false
+	}
+	startMinute, endMinute, err := ParseContactHours(m.ContactHours)
+	if err != nil {
+		return false, false
+	}
+
+	local := now.In(loc)
+	return withinMinuteRange(local.Hour()*60+local.Minute(), startMinute, endMinute), true
+}
+
+// BusyPeriod is a time range when the mentor is unavailable (from their calendar feed)
+type BusyPeriod struct {
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	AllDay bool      `json:"allDay"`
+}
+
+// MentorAvailability is the response for the mentor availability endpoint
+type MentorAvailability struct {
+	Timezone           string       `json:"timezone,omitempty"`
+	ContactHours       string       `json:"contactHours,omitempty"`
+	WithinContactHours *bool        `json:"withinContactHours,omitempty"`
+	Source             string       `json:"source"` // "ical" when busy periods come from a calendar feed, "none" otherwise
+	BusyPeriods        []BusyPeriod `json:"busyPeriods"`
+	OnVacation         bool         `json:"onVacation"`
+	PausedUntil        *time.Time   `json:"pausedUntil,omitempty"`
+	Stale              bool         `json:"stale"` // true when the calendar feed could not be refreshed and cached data is served
+}
+
+// IsPausedAt reports whether the mentor declared a vacation covering t
+func (a *MentorAvailability) IsPausedAt(t time.Time) bool {
+	return a.PausedUntil != nil && t.Before(*a.PausedUntil)
+}
@@ -15,38 +15,68 @@ const (
 	StatusContacted   RequestStatus = "contacted"
 	StatusWorking     RequestStatus = "working"
 	StatusDone        RequestStatus = "done"
+	StatusReschedule  RequestStatus = "reschedule" // waiting for the mentee to confirm a new session time
 	StatusDeclined    RequestStatus = "declined"
 	StatusUnavailable RequestStatus = "unavailable"
 )
 
+// AllStatuses lists every status accepted by the client_requests_status_chk constraint
+var AllStatuses = []RequestStatus{
+	StatusPending, StatusContacted, StatusWorking, StatusDone, StatusReschedule, StatusDeclined, StatusUnavailable,
+}
+
 // ActiveStatuses are statuses shown on the active requests page
-var ActiveStatuses = []RequestStatus{StatusPending, StatusContacted, StatusWorking}
+var ActiveStatuses = []RequestStatus{StatusPending, StatusContacted, StatusWorking, StatusReschedule}
 
 // PastStatuses are statuses shown on the past requests page
 var PastStatuses = []RequestStatus{StatusDone, StatusDeclined, StatusUnavailable}
 
+// requestStatusTransitions is the single source of truth for the request workflow,
+// shared by the mentor portal and the reschedule flow and matching what the bot does
+// in the database. Statuses without outgoing transitions are terminal.
+var requestStatusTransitions = map[RequestStatus][]RequestStatus{
+	StatusPending:     {StatusContacted, StatusDeclined, StatusUnavailable},
+	StatusContacted:   {StatusWorking, StatusReschedule, StatusDeclined, StatusUnavailable},
+	StatusWorking:     {StatusDone, StatusReschedule, StatusDeclined, StatusUnavailable},
+	StatusReschedule:  {StatusContacted, StatusWorking, StatusDeclined, StatusUnavailable},
+	StatusDone:        {},
+	StatusDeclined:    {},
+	StatusUnavailable: {},
+}
+
+// SchedulableStatuses are the statuses in which the session time can be set: the mentor
+// has answered and the session hasn't happened yet
+var SchedulableStatuses = []RequestStatus{StatusContacted, StatusWorking}
+
+// IsValid reports whether the status is known
+func (s RequestStatus) IsValid() bool {
+	_, ok := requestStatusTransitions[s]
+	return ok
+}
+
 // IsTerminalStatus returns true if the status is terminal (no further transitions allowed)
 func (s RequestStatus) IsTerminalStatus() bool {
-	return s == StatusDone || s == StatusDeclined || s == StatusUnavailable
+	return s.IsValid() && len(requestStatusTransitions[s]) == 0
 }
 
 // CanTransitionTo checks if a status transition is valid
 func (s RequestStatus) CanTransitionTo(newStatus RequestStatus) bool {
-	// Terminal statuses cannot transition
-	if s.IsTerminalStatus() {
-		return false
+	for _, next := range requestStatusTransitions[s] {
+		if next == newStatus {
+			return true
+		}
 	}
+	return false
+}
 
-	switch s {
-	case StatusPending:
-		return newStatus == StatusContacted || newStatus == StatusDeclined
-	case StatusContacted:
-		return newStatus == StatusWorking || newStatus == StatusDeclined
-	case StatusWorking:
-		return newStatus == StatusDone || newStatus == StatusDeclined
-	default:
-		return false
+// CanSchedule reports whether the session time can be set in this status
+func (s RequestStatus) CanSchedule() bool {
+	for _, status := range SchedulableStatuses {
+		if status == s {
+			return true
+		}
 	}
+	return false
 }
 
 // DeclineReason represents predefined decline reasons
@@ -91,6 +121,11 @@ type DeclineRequestPayload struct {
 	Comment string        `json:"comment" binding:"max=1000"`
 }
 
+// ScheduleRequestPayload is the payload for setting the session time of a request
+type ScheduleRequestPayload struct {
+	ScheduledAt time.Time `json:"scheduledAt" binding:"required"`
+}
+
 // ClientRequestsResponse is the response for listing requests
 type ClientRequestsResponse struct {
 	Requests []MentorClientRequest `json:"requests"`
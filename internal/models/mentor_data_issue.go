@@ -0,0 +1,106 @@
+package models
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Mentor data checks run by cmd/validate-data
+const (
+	MentorDataCheckCalendarURL  = "broken_calendar_url"
+	MentorDataCheckPhoto        = "missing_photo"
+	MentorDataCheckCompetencies = "empty_competencies"
+	MentorDataCheckTelegram     = "invalid_telegram"
+)
+
+// telegramHandlePattern is a Telegram username: 5-32 letters, digits and underscores, starting with a letter
+var telegramHandlePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{4,31}$`)
+
+// MentorDataSubject is the part of a mentor profile the data checks look at
+type MentorDataSubject struct {
+	MentorID     string
+	AirtableID   *string
+	Slug         string
+	Name         string
+	Status       string
+	Telegram     string
+	CalendarURL  string
+	Competencies string
+}
+
+// MentorDataIssue is a data quality problem found on a mentor profile
+type MentorDataIssue struct {
+	MentorID    string    `json:"mentorId"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Check       string    `json:"check"`
+	Detail      string    `json:"detail,omitempty"`
+	FirstSeenAt time.Time `json:"firstSeenAt,omitempty"`
+	LastSeenAt  time.Time `json:"lastSeenAt,omitempty"`
+}
+
+// MentorDataReport is the outcome of a cmd/validate-data run
+type MentorDataReport struct {
+	Scanned int `json:"scanned"`
+	// Checks are the checks that ran; a skipped check neither reports nor resolves issues
+	Checks []string           `json:"checks"`
+	Counts map[string]int     `json:"counts"`
+	Issues []*MentorDataIssue `json:"issues"`
+	// Resolved counts flagged issues no longer found, when the run flagged issues in PostgreSQL
+	Resolved int `json:"resolved"`
+}
+
+// MentorDataIssuesResponse is the admin queue of open mentor data issues
+type MentorDataIssuesResponse struct {
+	Issues []*MentorDataIssue `json:"issues"`
+	Total  int                `json:"total"`
+}
+
+// NormalizeTelegramHandle strips the @ and t.me link prefixes from a Telegram handle
+func NormalizeTelegramHandle(input string) string {
+	telegram := strings.TrimSpace(input)
+	telegram = strings.TrimPrefix(telegram, "@")
+	telegram = strings.TrimPrefix(telegram, "https://t.me/")
+	telegram = strings.TrimPrefix(telegram, "t.me/")
+	return telegram
+}
+
+// ValidTelegramHandle reports whether the value, with or without @ or a t.me link, is a Telegram username
+func ValidTelegramHandle(value string) bool {
+	return telegramHandlePattern.MatchString(NormalizeTelegramHandle(value))
+}
+
+// ValidCalendarURL reports whether a calendar link is an absolute http(s) URL
+func ValidCalendarURL(value string) bool {
+	u, err := url.Parse(strings.TrimSpace(value))
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// CheckMentorData runs the checks that need no network access: empty competencies, an invalid
+// Telegram handle and a malformed calendar link. An empty calendar link is fine; reachability
+// and the profile photo are checked by the caller.
+func CheckMentorData(subject *MentorDataSubject) []*MentorDataIssue {
+	issues := []*MentorDataIssue{}
+	add := func(check, detail string) {
+		issues = append(issues, &MentorDataIssue{
+			MentorID: subject.MentorID,
+			Slug:     subject.Slug,
+			Name:     subject.Name,
+			Check:    check,
+			Detail:   detail,
+		})
+	}
+
+	if strings.TrimSpace(subject.Competencies) == "" {
+		add(MentorDataCheckCompetencies, "")
+	}
+	if !ValidTelegramHandle(subject.Telegram) {
+		add(MentorDataCheckTelegram, subject.Telegram)
+	}
+	if subject.CalendarURL != "" && !ValidCalendarURL(subject.CalendarURL) {
+		add(MentorDataCheckCalendarURL, "not an http(s) link: "+subject.CalendarURL)
+	}
+	return issues
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxDeviceUserAgentLength bounds the stored User-Agent header
+const MaxDeviceUserAgentLength = 512
+
+// DeviceSessionTouchInterval is how often last_used_at is refreshed for an active session
+const DeviceSessionTouchInterval = 5 * time.Minute
+
+// MentorDeviceSession is a mentor portal login on one device (one issued JWT)
+type MentorDeviceSession struct {
+	ID         string     `json:"id"`
+	MentorID   string     `json:"-"`
+	UserAgent  string     `json:"userAgent"`
+	IP         string     `json:"ip"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt time.Time  `json:"lastUsedAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	RevokedAt  *time.Time `json:"-"`
+	// Current marks the session the request was made with
+	Current bool `json:"current"`
+}
+
+// IsActive reports whether the session can still be used
+func (s *MentorDeviceSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(now)
+}
+
+// DeviceClient describes the device a login came from
+type DeviceClient struct {
+	UserAgent string
+	IP        string
+}
+
+// NormalizeUserAgent trims the User-Agent header and caps its length
+func NormalizeUserAgent(userAgent string) string {
+	userAgent = strings.TrimSpace(userAgent)
+	if len(userAgent) > MaxDeviceUserAgentLength {
+		userAgent = userAgent[:MaxDeviceUserAgentLength]
+	}
+	return userAgent
+}
+
+// DeviceSessionsResponse lists the mentor's active sessions, newest first
+type DeviceSessionsResponse struct {
+	Sessions []MentorDeviceSession `json:"sessions"`
+}
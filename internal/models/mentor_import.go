@@ -0,0 +1,63 @@
+package models
+
+// MaxMentorImportRows is the largest CSV accepted by one import
+const MaxMentorImportRows = 1000
+
+// Outcomes of an imported row
+const (
+	MentorImportCreated = "created" // mentor created in pending status
+	MentorImportValid   = "valid"   // dry run: the row would be created
+	MentorImportInvalid = "invalid" // the row breaks a registration rule
+	MentorImportFailed  = "failed"  // the row is valid but couldn't be saved
+)
+
+// MentorImportColumns are the CSV header names. Tags and languages are separated by ";".
+var MentorImportColumns = []string{
+	"name", "email", "telegram", "job", "workplace", "experience", "price", "tags",
+	"about", "description", "competencies", "calendar_url", "country", "city", "remote_only", "languages",
+}
+
+// MentorImportRow is a mentor from an import file. Its rules are those of
+// RegisterMentorRequest without the picture and captcha, which imports don't have.
+type MentorImportRow struct {
+	Line int `json:"-"` // line in the import file, the header being line 1
+
+	Name         string   `json:"name" binding:"required,max=100"`
+	Email        string   `json:"email" binding:"required,email,max=255"`
+	Telegram     string   `json:"telegram" binding:"required,max=50"`
+	Job          string   `json:"job" binding:"required,max=200"`
+	Workplace    string   `json:"workplace" binding:"required,max=200"`
+	Experience   string   `json:"experience" binding:"required,oneof=2-5 5-10 10+"`
+	Price        string   `json:"price" binding:"required,max=100"`
+	Tags         []string `json:"tags" binding:"required,min=1,max=20,dive,max=50"`
+	About        string   `json:"about" binding:"required,max=10000"`
+	Description  string   `json:"description" binding:"required,max=5000"`
+	Competencies string   `json:"competencies" binding:"required,max=5000"`
+	CalendarURL  string   `json:"calendarUrl" binding:"omitempty,url,max=500"`
+	Country      string   `json:"country" binding:"omitempty,len=2"`
+	City         string   `json:"city" binding:"max=100"`
+	RemoteOnly   bool     `json:"remoteOnly"`
+	Languages    []string `json:"languages" binding:"omitempty,max=3,dive,oneof=ru en other"`
+}
+
+// MentorImportRowResult is the outcome of one row. Line is the line number in the
+// file, counting the header as line 1.
+type MentorImportRowResult struct {
+	Line     int      `json:"line"`
+	Email    string   `json:"email,omitempty"`
+	Status   string   `json:"status"`
+	MentorID string   `json:"mentorId,omitempty"`
+	Slug     string   `json:"slug,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// MentorImportReport is the outcome of an import
+type MentorImportReport struct {
+	DryRun  bool                    `json:"dryRun"`
+	Total   int                     `json:"total"`
+	Created int                     `json:"created"`
+	Valid   int                     `json:"valid"`
+	Invalid int                     `json:"invalid"`
+	Failed  int                     `json:"failed"`
+	Rows    []MentorImportRowResult `json:"rows"`
+}
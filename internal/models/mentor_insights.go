@@ -0,0 +1,169 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// MentorInsightsMinSample is the fewest declined requests or reviews for which a breakdown
+// is shown; below it the breakdown could point at individual mentees
+const MentorInsightsMinSample = 3
+
+// Review sentiments from keyword scoring
+const (
+	ReviewSentimentPositive = "positive"
+	ReviewSentimentNeutral  = "neutral"
+	ReviewSentimentNegative = "negative"
+)
+
+// Keyword stems matched in lowercased review text. Negative phrases are matched first and
+// cut out, so "не помог" doesn't also count as "помог".
+var (
+	negativeReviewKeywords = []string{
+		"не помог", "не понравил", "не пришел", "не пришёл", "не рекоменд", "не полезн",
+		"бесполезн", "разочаров", "плох", "груб", "опозда", "скучн", "зря", "непонятн",
+		"not helpful", "useless", "disappoint", "rude", "waste of time", "boring",
+	}
+	positiveReviewKeywords = []string{
+		"спасибо", "благодар", "полезн", "помог", "рекоменд", "отличн", "круто", "классн",
+		"супер", "понравил", "ценн", "вдохнов", "понятн",
+		"thank", "helpful", "great", "recommend", "useful", "insightful", "amazing",
+	}
+)
+
+// ScoreReviewSentiment classifies a review by counting positive and negative keywords
+func ScoreReviewSentiment(text string) string {
+	text = strings.ToLower(text)
+
+	score := 0
+	for _, keyword := range negativeReviewKeywords {
+		if n := strings.Count(text, keyword); n > 0 {
+			score -= n
+			text = strings.ReplaceAll(text, keyword, " ")
+		}
+	}
+	for _, keyword := range positiveReviewKeywords {
+		score += strings.Count(text, keyword)
+	}
+
+	switch {
+	case score > 0:
+		return ReviewSentimentPositive
+	case score < 0:
+		return ReviewSentimentNegative
+	default:
+		return ReviewSentimentNeutral
+	}
+}
+
+// MentorRequestStats holds a mentor's request counts over the insights window
+type MentorRequestStats struct {
+	MentorID            string
+	Requests            int
+	Declined            int
+	DeclineReasons      map[string]int
+	Responded           int
+	RespondedWithinSLA  int
+	MedianResponseHours *float64
+}
+
+// MentorRequestCalendar is a request with its mentor's calendar link, to tell whether it
+// arrived during a vacation declared in the mentor's calendar feed
+type MentorRequestCalendar struct {
+	RequestID   string
+	CreatedAt   time.Time
+	MentorID    string
+	CalendarURL string
+	Timezone    string
+}
+
+// MentorReviewText is the text of a review left to a mentor, for sentiment scoring
+type MentorReviewText struct {
+	MentorID string
+	Text     string
+}
+
+// MentorInsights is the stored aggregate of a mentor's feedback
+type MentorInsights struct {
+	MentorRequestStats
+	WindowDays      int
+	SLAHours        int
+	Reviews         int
+	ReviewsPositive int
+	ReviewsNeutral  int
+	ReviewsNegative int
+	ComputedAt      time.Time
+}
+
+// AddReview counts a review with the given sentiment
+func (i *MentorInsights) AddReview(sentiment string) {
+	i.Reviews++
+	switch sentiment {
+	case ReviewSentimentPositive:
+		i.ReviewsPositive++
+	case ReviewSentimentNegative:
+		i.ReviewsNegative++
+	default:
+		i.ReviewsNeutral++
+	}
+}
+
+// ReviewSentimentBreakdown counts reviews by sentiment
+type ReviewSentimentBreakdown struct {
+	Positive int `json:"positive"`
+	Neutral  int `json:"neutral"`
+	Negative int `json:"negative"`
+}
+
+// MentorInsightsReport is the private insights report a mentor sees
+type MentorInsightsReport struct {
+	WindowDays     int                       `json:"windowDays"`
+	Requests       int                       `json:"requests"`
+	Declined       int                       `json:"declined"`
+	DeclineReasons map[string]int            `json:"declineReasons,omitempty"`
+	Reviews        int                       `json:"reviews"`
+	Sentiment      *ReviewSentimentBreakdown `json:"sentiment,omitempty"`
+	// ResponseSLAHours is the target time to the first answer to a request
+	ResponseSLAHours    int        `json:"responseSlaHours"`
+	Responded           int        `json:"responded"`
+	WithinSLARate       *float64   `json:"withinSlaRate,omitempty"`
+	MedianResponseHours *float64   `json:"medianResponseHours,omitempty"`
+	ComputedAt          *time.Time `json:"computedAt,omitempty"`
+}
+
+// Report turns the insights into the mentor's report, leaving out breakdowns of samples
+// smaller than MentorInsightsMinSample
+func (i *MentorInsights) Report() *MentorInsightsReport {
+	report := &MentorInsightsReport{
+		WindowDays:       i.WindowDays,
+		Requests:         i.Requests,
+		Declined:         i.Declined,
+		Reviews:          i.Reviews,
+		ResponseSLAHours: i.SLAHours,
+		Responded:        i.Responded,
+	}
+	if !i.ComputedAt.IsZero() {
+		computedAt := i.ComputedAt
+		report.ComputedAt = &computedAt
+	}
+	if i.Declined >= MentorInsightsMinSample {
+		report.DeclineReasons = i.DeclineReasons
+	}
+	if i.Reviews >= MentorInsightsMinSample {
+		report.Sentiment = &ReviewSentimentBreakdown{
+			Positive: i.ReviewsPositive,
+			Neutral:  i.ReviewsNeutral,
+			Negative: i.ReviewsNegative,
+		}
+	}
+	if i.Responded > 0 {
+		rate := math.Round(float64(i.RespondedWithinSLA)/float64(i.Responded)*1000) / 1000
+		report.WithinSLARate = &rate
+	}
+	if i.MedianResponseHours != nil {
+		hours := math.Round(*i.MedianResponseHours*10) / 10
+		report.MedianResponseHours = &hours
+	}
+	return report
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NormalizeCountryCode uppercases an ISO 3166-1 alpha-2 country code and checks its shape.
+// An empty value is allowed and means the country is not set.
+func NormalizeCountryCode(value string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+	if code == "" {
+		return "", nil
+	}
+	if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		return "", fmt.Errorf("country must be a two-letter ISO 3166-1 code")
+	}
+	return code, nil
+}
+
+// Mentoring languages. "other" covers every language without a dedicated code.
+const (
+	MentorLanguageRussian = "ru"
+	MentorLanguageEnglish = "en"
+	MentorLanguageOther   = "other"
+)
+
+// MentorLanguages lists the accepted languages in display order
+var MentorLanguages = []string{MentorLanguageRussian, MentorLanguageEnglish, MentorLanguageOther}
+
+// NormalizeLanguages lowercases and deduplicates language codes, returning them in
+// MentorLanguages order. Unknown codes are rejected.
+func NormalizeLanguages(values []string) ([]string, error) {
+	seen := map[string]bool{}
+	for _, value := range values {
+		code := strings.ToLower(strings.TrimSpace(value))
+		if !isMentorLanguage(code) {
+			return nil, fmt.Errorf("unsupported language %q, expected one of %s", value, strings.Join(MentorLanguages, ", "))
+		}
+		seen[code] = true
+	}
+
+	languages := make([]string, 0, len(seen))
+	for _, code := range MentorLanguages {
+		if seen[code] {
+			languages = append(languages, code)
+		}
+	}
+	return languages, nil
+}
+
+func isMentorLanguage(code string) bool {
+	for _, language := range MentorLanguages {
+		if code == language {
+			return true
+		}
+	}
+	return false
+}
+
+func speaksAny(mentorLanguages, wanted []string) bool {
+	for _, language := range mentorLanguages {
+		for _, w := range wanted {
+			if language == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MentorSearchFilter narrows mentor lists by profile attributes. Zero values don't filter.
+type MentorSearchFilter struct {
+	Country string // ISO 3166-1 alpha-2 code
+	City    string // case-insensitive exact match
+	// RemoteOnly selects mentors who only meet online (true) or also meet in person (false)
+	RemoteOnly *bool
+	// Languages matches mentors who mentor in any of the listed languages
+	Languages []string
+}
+
+// IsEmpty reports whether the filter lets every mentor through
+func (f MentorSearchFilter) IsEmpty() bool {
+	return f.Country == "" && f.City == "" && f.RemoteOnly == nil && len(f.Languages) == 0
+}
+
+// Matches reports whether the mentor passes the filter
+func (f MentorSearchFilter) Matches(m *Mentor) bool {
+	if f.Country != "" && !strings.EqualFold(m.Country, f.Country) {
+		return false
+	}
+	if f.City != "" && !strings.EqualFold(strings.TrimSpace(m.City), strings.TrimSpace(f.City)) {
+		return false
+	}
+	if f.RemoteOnly != nil && m.RemoteOnly != *f.RemoteOnly {
+		return false
+	}
+	if len(f.Languages) > 0 && !speaksAny(m.Languages, f.Languages) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the mentors passing the filter, keeping their order
+func (f MentorSearchFilter) Apply(mentors []*Mentor) []*Mentor {
+	if f.IsEmpty() {
+		return mentors
+	}
+	result := make([]*Mentor, 0, len(mentors))
+	for _, mentor := range mentors {
+		if f.Matches(mentor) {
+			result = append(result, mentor)
+		}
+	}
+	return result
+}
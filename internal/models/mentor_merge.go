@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// MergeMentorsRequest is the payload for merging a duplicate mentor record into the primary one
+type MergeMentorsRequest struct {
+	PrimaryID   string `json:"primaryId" binding:"required,uuid"`
+	DuplicateID string `json:"duplicateId" binding:"required,uuid"`
+}
+
+// MentorMergeResult describes what a merge moved from the duplicate to the primary mentor
+type MentorMergeResult struct {
+	ID              string    `json:"id"`
+	PrimaryID       string    `json:"primaryId"`
+	PrimarySlug     string    `json:"primarySlug"`
+	DuplicateID     string    `json:"duplicateId"`
+	DuplicateSlug   string    `json:"duplicateSlug"`
+	TagsAdded       int64     `json:"tagsAdded"`
+	RequestsMoved   int64     `json:"requestsMoved"` // reviews and session stats follow their requests
+	ProgramsMoved   int64     `json:"programsMoved"`
+	TemplatesMoved  int64     `json:"templatesMoved"`
+	ReportsMoved    int64     `json:"reportsMoved"`
+	AirtableIDMoved bool      `json:"airtableIdMoved"`
+	MergedAt        time.Time `json:"mergedAt"`
+}
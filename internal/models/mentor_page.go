@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxMentorPageSize caps a page of the mentor list read from the database
+const MaxMentorPageSize = 500
+
+// MentorPageCursor continues the mentor list after the last mentor read, in (sort_order, id) order
+type MentorPageCursor struct {
+	SortOrder int
+	ID        string
+}
+
+// MentorPageCursorAfter is the cursor continuing after the given mentor
+func MentorPageCursorAfter(m *Mentor) *MentorPageCursor {
+	return &MentorPageCursor{SortOrder: m.SortOrder, ID: m.MentorID}
+}
+
+// String encodes the cursor as an opaque token for the after= query parameter
+func (c MentorPageCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(c.SortOrder) + ":" + c.ID))
+}
+
+// ParseMentorPageCursor decodes an after= token; an empty token is the start of the list
+func ParseMentorPageCursor(token string) (*MentorPageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	sortOrder, id, found := strings.Cut(string(raw), ":")
+	if !found || id == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	value, err := strconv.Atoi(sortOrder)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &MentorPageCursor{SortOrder: value, ID: id}, nil
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+const (
+	MentorQuestionStatusPending   = "pending"
+	MentorQuestionStatusPublished = "published"
+	MentorQuestionStatusRejected  = "rejected"
+)
+
+// MaxPendingQuestionsPerMentor caps a mentor's unanswered questions so a flood of
+// anonymous questions can't bury the inbox
+const MaxPendingQuestionsPerMentor = 30
+
+// MentorQuestion is an anonymous question to a mentor, as the mentor sees it
+type MentorQuestion struct {
+	ID         string     `json:"id"`
+	MentorID   string     `json:"-"`
+	Question   string     `json:"question"`
+	Answer     string     `json:"answer,omitempty"`
+	Status     string     `json:"status"`
+	AnsweredAt *time.Time `json:"answeredAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// PublicMentorQuestion is an answered question shown on the mentor's profile
+type PublicMentorQuestion struct {
+	ID         string    `json:"id"`
+	Question   string    `json:"question"`
+	Answer     string    `json:"answer"`
+	AnsweredAt time.Time `json:"answeredAt"`
+}
+
+// AskMentorQuestionRequest is the public question form. There is deliberately no
+// name or contact field: questions are anonymous.
+type AskMentorQuestionRequest struct {
+	Question       string `json:"question" binding:"required,min=10,max=500"`
+	RecaptchaToken string `json:"recaptchaToken" binding:"required,min=20"`
+}
+
+// AskMentorQuestionResponse is returned after submitting a question
+type AskMentorQuestionResponse struct {
+	Success    bool   `json:"success"`
+	QuestionID string `json:"questionId,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// AnswerMentorQuestionRequest publishes a question with the mentor's answer
+type AnswerMentorQuestionRequest struct {
+	Answer string `json:"answer" binding:"required,min=2,max=4000"`
+}
@@ -12,7 +12,7 @@ type RegisterMentorRequest struct {
 	Workplace  string   `json:"workplace" binding:"required,max=200"`
 	Experience string   `json:"experience" binding:"required,oneof=2-5 5-10 10+"`
 	Price      string   `json:"price" binding:"required,max=100"`
-	Tags       []string `json:"tags" binding:"required,min=1,max=5,dive,max=50"`
+	Tags       []string `json:"tags" binding:"required,min=1,max=20,dive,max=50"` // the moderation rules set the actual limit
 
 	// Content
 	About        string `json:"about" binding:"required,max=10000"`
@@ -20,11 +20,20 @@ type RegisterMentorRequest struct {
 	Competencies string `json:"competencies" binding:"required,max=5000"`
 	CalendarURL  string `json:"calendarUrl" binding:"omitempty,url,max=500"`
 
+	// Location
+	Country    string   `json:"country" binding:"omitempty,len=2"`
+	City       string   `json:"city" binding:"max=100"`
+	RemoteOnly bool     `json:"remoteOnly"`
+	Languages  []string `json:"languages" binding:"omitempty,max=3,dive,oneof=ru en other"`
+
 	// Image
 	ProfilePicture ProfilePictureData `json:"profilePicture" binding:"required"`
 
 	// Security
 	RecaptchaToken string `json:"recaptchaToken" binding:"required,min=20"`
+	Website        string `json:"website" binding:"max=500"`        // Honeypot field hidden from people; bots fill it in
+	FormRenderedAt string `json:"formRenderedAt" binding:"max=200"` // Signed render timestamp the frontend embeds in the form
+	ClientIP       string `json:"-"`                                // Set by the handler and checked against the blocklist
 }
 
 // ProfilePictureData represents the profile picture upload data
@@ -40,4 +49,7 @@ type RegisterMentorResponse struct {
 	Message  string `json:"message,omitempty"`
 	MentorID int    `json:"mentorId,omitempty"`
 	Error    string `json:"error,omitempty"`
+	// Returning is set when the email belongs to a former mentor who was sent a link to
+	// restore their profile instead of registering again
+	Returning bool `json:"returning,omitempty"`
 }
@@ -8,6 +8,8 @@ type MentorSession struct {
 	Name      string `json:"name"`
 	ExpiresAt int64  `json:"exp"`
 	IssuedAt  int64  `json:"iat"`
+	// SessionID is the device session behind the JWT; empty for tokens issued before sessions were tracked
+	SessionID string `json:"session_id,omitempty"`
 }
 
 // RequestLoginRequest is the payload for requesting a login token
@@ -0,0 +1,191 @@
+package models
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MentorSort is an ordering of the public mentor list
+type MentorSort string
+
+// Mentor list orderings. The default keeps the curated sort order.
+const (
+	MentorSortDefault      MentorSort = ""
+	MentorSortSessionsDesc MentorSort = "sessions_desc"
+	MentorSortNewest       MentorSort = "newest"
+	MentorSortPriceAsc     MentorSort = "price_asc"
+	// MentorSortRandomSeeded shuffles the list deterministically by a client-chosen seed,
+	// so paging through one shuffle stays stable
+	MentorSortRandomSeeded MentorSort = "random_seeded"
+	// MentorSortDailyShuffle shuffles the list with the UTC date as seed, weighted by exposure
+	// bucket so new and less booked mentors surface above the fold more often
+	MentorSortDailyShuffle MentorSort = "daily_shuffle"
+)
+
+// PresortedMentorSorts are the orderings the mentor cache keeps precomputed on every refresh
+var PresortedMentorSorts = []MentorSort{MentorSortSessionsDesc, MentorSortNewest, MentorSortPriceAsc, MentorSortDailyShuffle}
+
+// Exposure buckets of the mentor list
+const (
+	MentorBucketNew         = "new"
+	MentorBucketFewSessions = "few_sessions"
+	MentorBucketEstablished = "established"
+)
+
+// MentorFewSessionsThreshold is the session count below which a mentor is boosted by the daily shuffle
+const MentorFewSessionsThreshold = 5
+
+// MentorListFoldSize is how many leading mentors of a list count as exposed (the first screen)
+const MentorListFoldSize = 20
+
+// mentorShuffleBoosts are the daily shuffle weights per exposure bucket
+var mentorShuffleBoosts = map[string]float64{
+	MentorBucketNew:         3,
+	MentorBucketFewSessions: 2,
+	MentorBucketEstablished: 1,
+}
+
+// MentorExposureBucket groups a mentor for shuffle boosts and exposure metrics
+func MentorExposureBucket(m *Mentor) string {
+	switch {
+	case m.IsNew:
+		return MentorBucketNew
+	case m.MenteeCount < MentorFewSessionsThreshold:
+		return MentorBucketFewSessions
+	default:
+		return MentorBucketEstablished
+	}
+}
+
+// DailyShuffleSeed is the seed of the daily shuffle on the given day, in UTC
+func DailyShuffleSeed(now time.Time) string {
+	return now.UTC().Format("2006-01-02")
+}
+
+// ParseMentorSort validates a sort query parameter
+func ParseMentorSort(value string) (MentorSort, error) {
+	switch sortBy := MentorSort(strings.TrimSpace(value)); sortBy {
+	case MentorSortDefault, MentorSortSessionsDesc, MentorSortNewest, MentorSortPriceAsc, MentorSortRandomSeeded,
+		MentorSortDailyShuffle:
+		return sortBy, nil
+	default:
+		return "", fmt.Errorf("unknown sort %q, expected sessions_desc, newest, price_asc, random_seeded or daily_shuffle", value)
+	}
+}
+
+// freePriceWords mark a free mentor in the free-form price field
+var freePriceWords = []string{"бесплатно", "free"}
+
+// MentorPriceValue extracts the amount from the free-form price field ("5 000 ₽", "Бесплатно").
+// ok is false when the price has no amount, e.g. "по договоренности".
+func MentorPriceValue(price string) (value int, ok bool) {
+	lower := strings.ToLower(price)
+	for _, word := range freePriceWords {
+		if strings.Contains(lower, word) {
+			return 0, true
+		}
+	}
+
+	digits := 0
+	for _, r := range lower {
+		switch {
+		case r >= '0' && r <= '9':
+			value = value*10 + int(r-'0')
+			digits++
+		case digits > 0 && (r == ' ' || r == '\u00a0' || r == '\u202f'):
+			// Thousands separators inside the amount
+		case digits > 0:
+			return value, true
+		}
+		if digits > 9 {
+			return value, true
+		}
+	}
+	return value, digits > 0
+}
+
+// SortMentors orders mentors in place. Ties, and the default ordering, fall back to the
+// curated sort order. seed is only used by MentorSortRandomSeeded and MentorSortDailyShuffle
+// (pass DailyShuffleSeed for the latter).
+func SortMentors(mentors []*Mentor, sortBy MentorSort, seed string) {
+	curated := func(a, b *Mentor) bool {
+		if a.SortOrder != b.SortOrder {
+			return a.SortOrder < b.SortOrder
+		}
+		return a.Slug < b.Slug
+	}
+
+	var less func(a, b *Mentor) bool
+	switch sortBy {
+	case MentorSortSessionsDesc:
+		less = func(a, b *Mentor) bool {
+			if a.MenteeCount != b.MenteeCount {
+				return a.MenteeCount > b.MenteeCount
+			}
+			return curated(a, b)
+		}
+	case MentorSortNewest:
+		less = func(a, b *Mentor) bool {
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+			return curated(a, b)
+		}
+	case MentorSortPriceAsc:
+		less = func(a, b *Mentor) bool {
+			aPrice, aOK := MentorPriceValue(a.Price)
+			bPrice, bOK := MentorPriceValue(b.Price)
+			switch {
+			case aOK != bOK:
+				// Mentors without a price go last
+				return aOK
+			case aPrice != bPrice:
+				return aPrice < bPrice
+			}
+			return curated(a, b)
+		}
+	case MentorSortRandomSeeded:
+		keys := make(map[string]uint64, len(mentors))
+		for _, m := range mentors {
+			keys[m.Slug] = seededKey(seed, m.Slug)
+		}
+		less = func(a, b *Mentor) bool {
+			if keys[a.Slug] != keys[b.Slug] {
+				return keys[a.Slug] < keys[b.Slug]
+			}
+			return a.Slug < b.Slug
+		}
+	case MentorSortDailyShuffle:
+		// Weighted shuffle (Efraimidis-Spirakis): each mentor draws -ln(u)/weight and the smallest
+		// draws go first, so a mentor with twice the weight is twice as likely to lead any pair
+		keys := make(map[string]float64, len(mentors))
+		for _, m := range mentors {
+			u := (float64(seededKey(seed, m.Slug)>>11) + 0.5) / (1 << 53)
+			keys[m.Slug] = -math.Log(u) / mentorShuffleBoosts[MentorExposureBucket(m)]
+		}
+		less = func(a, b *Mentor) bool {
+			if keys[a.Slug] != keys[b.Slug] {
+				return keys[a.Slug] < keys[b.Slug]
+			}
+			return a.Slug < b.Slug
+		}
+	default:
+		less = curated
+	}
+
+	sort.SliceStable(mentors, func(i, j int) bool {
+		return less(mentors[i], mentors[j])
+	})
+}
+
+func seededKey(seed, slug string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed)) //nolint:errcheck // hash writes never fail
+	_, _ = h.Write([]byte{0})    //nolint:errcheck // hash writes never fail
+	_, _ = h.Write([]byte(slug)) //nolint:errcheck // hash writes never fail
+	return h.Sum64()
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// MentorStats are a mentor's all-time request statistics from the mentor_stats view
+type MentorStats struct {
+	MentorID            string     `json:"mentorId"`
+	Requests            int        `json:"requests"`
+	SessionsDone        int        `json:"sessionsDone"`
+	Declined            int        `json:"declined"`
+	DeclineRate         *float64   `json:"declineRate,omitempty"`
+	MedianResponseHours *float64   `json:"medianResponseHours,omitempty"`
+	LastActivityAt      *time.Time `json:"lastActivityAt,omitempty"`
+	ComputedAt          time.Time  `json:"computedAt"`
+}
+
+// SetDeclineRate computes the share of declined requests, rounded to two decimals.
+// Mentors without requests have no rate.
+func (s *MentorStats) SetDeclineRate() {
+	s.DeclineRate = nil
+	if s.Requests == 0 {
+		return
+	}
+	rate := math.Round(float64(s.Declined)/float64(s.Requests)*100) / 100
+	s.DeclineRate = &rate
+}
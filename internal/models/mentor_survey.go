@@ -0,0 +1,118 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MentorSurveyCooldown is the minimum time between two survey invitations of a mentor
+const MentorSurveyCooldown = 90 * 24 * time.Hour
+
+// MentorSurvey is a survey invitation of a mentor and, once answered, their response
+type MentorSurvey struct {
+	ID             string     `json:"id"`
+	MentorID       string     `json:"-"`
+	InvitedAt      time.Time  `json:"invitedAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RespondedAt    *time.Time `json:"respondedAt,omitempty"`
+	NPS            *int       `json:"nps,omitempty"`
+	Satisfaction   *int       `json:"satisfaction,omitempty"`
+	RequestQuality *int       `json:"requestQuality,omitempty"`
+	WouldContinue  *bool      `json:"wouldContinue,omitempty"`
+	Comment        string     `json:"comment,omitempty"`
+}
+
+// SubmitMentorSurveyRequest is a mentor's answer to their open survey
+type SubmitMentorSurveyRequest struct {
+	// NPS is how likely the mentor is to recommend mentoring on the platform, 0-10
+	NPS *int `json:"nps" binding:"required,min=0,max=10"`
+	// Satisfaction with the platform overall, 1-5
+	Satisfaction *int `json:"satisfaction" binding:"required,min=1,max=5"`
+	// RequestQuality is how well mentees' requests were prepared, 1-5
+	RequestQuality *int   `json:"requestQuality" binding:"omitempty,min=1,max=5"`
+	WouldContinue  *bool  `json:"wouldContinue"`
+	Comment        string `json:"comment" binding:"max=2000"`
+}
+
+// MentorSurveyQuarter holds the raw survey counts of a quarter
+type MentorSurveyQuarter struct {
+	Start              time.Time
+	Invited            int
+	Responded          int
+	Promoters          int
+	Passives           int
+	Detractors         int
+	AvgSatisfaction    *float64
+	AvgRequestQuality  *float64
+	WouldContinueCount int
+}
+
+// MentorSurveySummary is the survey result of a quarter for the admin analytics
+type MentorSurveySummary struct {
+	Quarter           string   `json:"quarter"`
+	Invited           int      `json:"invited"`
+	Responded         int      `json:"responded"`
+	ResponseRate      float64  `json:"responseRate"`
+	NPS               *float64 `json:"nps"`
+	Promoters         int      `json:"promoters"`
+	Passives          int      `json:"passives"`
+	Detractors        int      `json:"detractors"`
+	AvgSatisfaction   *float64 `json:"avgSatisfaction"`
+	AvgRequestQuality *float64 `json:"avgRequestQuality"`
+	WouldContinueRate *float64 `json:"wouldContinueRate"`
+}
+
+// Summary turns the counts into rates and the NPS. Rates without responses are nil.
+func (q MentorSurveyQuarter) Summary() MentorSurveySummary {
+	summary := MentorSurveySummary{
+		Quarter:           QuarterLabel(q.Start),
+		Invited:           q.Invited,
+		Responded:         q.Responded,
+		Promoters:         q.Promoters,
+		Passives:          q.Passives,
+		Detractors:        q.Detractors,
+		AvgSatisfaction:   roundRate(q.AvgSatisfaction),
+		AvgRequestQuality: roundRate(q.AvgRequestQuality),
+	}
+	if q.Invited > 0 {
+		summary.ResponseRate = math.Round(float64(q.Responded)/float64(q.Invited)*1000) / 1000
+	}
+	if q.Responded > 0 {
+		nps := NPSScore(q.Promoters, q.Detractors, q.Responded)
+		summary.NPS = &nps
+		rate := float64(q.WouldContinueCount) / float64(q.Responded)
+		summary.WouldContinueRate = roundRate(&rate)
+	}
+	return summary
+}
+
+// NPSScore is the share of promoters (9-10) minus the share of detractors (0-6),
+// from -100 to 100, rounded to one decimal
+func NPSScore(promoters, detractors, responses int) float64 {
+	if responses == 0 {
+		return 0
+	}
+	return math.Round(float64(promoters-detractors)/float64(responses)*1000) / 10
+}
+
+// QuarterStart returns the first moment of the UTC quarter containing t
+func QuarterStart(t time.Time) time.Time {
+	t = t.UTC()
+	month := time.Month((int(t.Month())-1)/3*3 + 1)
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// QuarterLabel formats the quarter containing t, like 2026-Q3
+func QuarterLabel(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())-1)/3+1)
+}
+
+func roundRate(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+	rounded := math.Round(*v*1000) / 1000
+	return &rounded
+}
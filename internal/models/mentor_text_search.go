@@ -0,0 +1,142 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Page sizes of the full-text mentor search
+const (
+	DefaultMentorSearchLimit = 20
+	MaxMentorSearchLimit     = 100
+)
+
+// Keyword weights of the full-text mentor search. Unweighted keywords count once.
+const (
+	DefaultMentorKeywordWeight = 1.0
+	MaxMentorKeywordWeight     = 10.0
+)
+
+// MentorTextSearchFilters narrows a full-text mentor search. Empty fields match everything.
+type MentorTextSearchFilters struct {
+	MentorSearchFilter
+	// Tags matches mentors with any of the tags, case-insensitively
+	Tags []string
+	// Experience and Workplace are case-insensitive substring matches
+	Experience string
+	Workplace  string
+	// ExcludeKeywords drops mentors matching any of them, like the search query does
+	ExcludeKeywords []string
+	// Keywords ranks the matches by the weighted sum of each keyword's rank instead of the
+	// rank of the whole query
+	Keywords []MentorSearchKeyword
+}
+
+// MentorSearchKeyword is a search keyword and how much a match on it counts in the ranking
+type MentorSearchKeyword struct {
+	Keyword string
+	Weight  float64
+}
+
+// MentorSearchResponse is a page of full-text mentor search results, best match first
+type MentorSearchResponse struct {
+	Mentors []PublicMentorResponse `json:"mentors"`
+	Count   int                    `json:"count"`
+	Limit   int                    `json:"limit"`
+	Offset  int                    `json:"offset"`
+}
+
+// MentorSearchQueryFromKeywords turns a comma-separated keyword list ("python, machine learning")
+// into a web search query matching any of the keywords
+func MentorSearchQueryFromKeywords(keywords string) string {
+	parts := []string{}
+	for _, keyword := range strings.Split(keywords, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			parts = append(parts, keyword)
+		}
+	}
+	return strings.Join(parts, " or ")
+}
+
+// ParseMentorSearchKeywords splits a comma-separated keyword list and weighs each keyword with
+// weights (keyed case-insensitively), DefaultMentorKeywordWeight when it has none. Weighted
+// keywords missing from the list are added to it. Weights must be in (0, MaxMentorKeywordWeight].
+func ParseMentorSearchKeywords(keywords string, weights map[string]float64) ([]MentorSearchKeyword, error) {
+	normalizedWeights := make(map[string]float64, len(weights))
+	for keyword, weight := range weights {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			continue
+		}
+		if weight <= 0 || weight > MaxMentorKeywordWeight {
+			return nil, fmt.Errorf("weight of %q must be greater than 0 and at most %g", keyword, MaxMentorKeywordWeight)
+		}
+		normalizedWeights[keyword] = weight
+	}
+
+	parsed := []MentorSearchKeyword{}
+	seen := map[string]bool{}
+	add := func(keyword string) {
+		key := strings.ToLower(keyword)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		weight, found := normalizedWeights[key]
+		if !found {
+			weight = DefaultMentorKeywordWeight
+		}
+		parsed = append(parsed, MentorSearchKeyword{Keyword: keyword, Weight: weight})
+	}
+	for _, keyword := range strings.Split(keywords, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			add(keyword)
+		}
+	}
+	extra := []string{}
+	for keyword := range normalizedWeights {
+		if !seen[keyword] {
+			extra = append(extra, keyword)
+		}
+	}
+	sort.Strings(extra)
+	for _, keyword := range extra {
+		add(keyword)
+	}
+	return parsed, nil
+}
+
+// MentorSearchQueryFromWeightedKeywords returns the web search query matching any of keywords
+func MentorSearchQueryFromWeightedKeywords(keywords []MentorSearchKeyword) string {
+	parts := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		parts[i] = keyword.Keyword
+	}
+	return strings.Join(parts, " or ")
+}
+
+// SplitMentorSearchKeywords returns the non-empty trimmed keywords of a list
+func SplitMentorSearchKeywords(keywords []string) []string {
+	parts := []string{}
+	for _, keyword := range keywords {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			parts = append(parts, keyword)
+		}
+	}
+	return parts
+}
+
+// ClampMentorSearchPage applies the default and maximum page size and rejects negative offsets
+func ClampMentorSearchPage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = DefaultMentorSearchLimit
+	}
+	if limit > MaxMentorSearchLimit {
+		limit = MaxMentorSearchLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
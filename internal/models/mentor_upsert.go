@@ -0,0 +1,33 @@
+package models
+
+import "fmt"
+
+// MentorUpsertResult counts what a bulk mentor upsert did
+type MentorUpsertResult struct {
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	// Skipped are soft-deleted mentors, which an upsert never revives
+	Skipped int `json:"skipped"`
+}
+
+// ValidateMentorUpsert checks a batch before it is upserted: every mentor needs a slug (the
+// upsert key), a name and a status, and a slug may appear only once
+func ValidateMentorUpsert(mentors []*Mentor) error {
+	seen := make(map[string]bool, len(mentors))
+	for i, m := range mentors {
+		switch {
+		case m == nil:
+			return fmt.Errorf("mentor %d is nil", i)
+		case m.Slug == "":
+			return fmt.Errorf("mentor %d has no slug", i)
+		case m.Name == "":
+			return fmt.Errorf("mentor %s has no name", m.Slug)
+		case m.Status == "":
+			return fmt.Errorf("mentor %s has no status", m.Slug)
+		case seen[m.Slug]:
+			return fmt.Errorf("mentor %s appears twice", m.Slug)
+		}
+		seen[m.Slug] = true
+	}
+	return nil
+}
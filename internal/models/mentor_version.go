@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExpectedVersionKey is the request field (and merge patch key) carrying the mentor version the client last read
+const ExpectedVersionKey = "expectedVersion"
+
+// VersionETag formats a mentor version as an entity tag, to be sent back in If-Match
+func VersionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ParseIfMatchVersion reads a mentor version from an If-Match header. The tag may be strong ("3"),
+// weak (W/"3") or unquoted (3). An empty header or "*" carries no version and returns nil.
+func ParseIfMatchVersion(header string) (*int, error) {
+	tag := strings.TrimSpace(header)
+	if tag == "" || tag == "*" {
+		return nil, nil
+	}
+	if strings.Contains(tag, ",") {
+		return nil, errors.New("expected a single version in If-Match")
+	}
+
+	tag = strings.TrimPrefix(tag, "W/")
+	if unquoted, err := strconv.Unquote(tag); err == nil {
+		tag = unquoted
+	}
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		return nil, fmt.Errorf("invalid If-Match version %q", header)
+	}
+	return &version, nil
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// MergePatchContentType is the media type of JSON Merge Patch documents (RFC 7396)
+const MergePatchContentType = "application/merge-patch+json"
+
+// MergePatch is a JSON Merge Patch document over a flat object:
+// absent keys leave the target untouched, null clears it, any other value replaces it.
+type MergePatch map[string]json.RawMessage
+
+// ProfileFieldChange is a field-level audit entry produced by a profile patch
+type ProfileFieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// PatchProfileResponse is returned by profile PATCH endpoints
+type PatchProfileResponse struct {
+	Success bool                 `json:"success"`
+	Changes []ProfileFieldChange `json:"changes"`
+}
+
+// ParseMergePatch parses a merge patch document. The document must be a JSON object.
+func ParseMergePatch(data []byte) (MergePatch, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+
+	var patch MergePatch
+	if err := json.Unmarshal(trimmed, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// Keys returns the patched keys in a stable order
+func (p MergePatch) Keys() []string {
+	keys := make([]string, 0, len(p))
+	for key := range p {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsNull reports whether key is present and set to null
+func (p MergePatch) IsNull(key string) bool {
+	raw, ok := p[key]
+	return ok && bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+// Decode unmarshals the value of key into dst
+func (p MergePatch) Decode(key string, dst interface{}) error {
+	return json.Unmarshal(p[key], dst)
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ModerationRules are the moderation thresholds admins change without a deployment
+type ModerationRules struct {
+	// MaxTags caps the tags of a registration; the request binding allows up to 20
+	MaxTags int `json:"maxTags" binding:"min=1,max=20"`
+	// BannedWords reject registrations mentioning them, matched as whole words ignoring case
+	BannedWords []string         `json:"bannedWords" binding:"max=500,dive,min=2,max=100"`
+	AutoApprove AutoApproveRules `json:"autoApprove"`
+}
+
+// AutoApproveRules decide when a returning mentor skips moderation
+type AutoApproveRules struct {
+	Enabled bool `json:"enabled"`
+	// MinCompletedSessions the mentor must have completed before they left
+	MinCompletedSessions int `json:"minCompletedSessions" binding:"min=0"`
+	// MaxInactiveDays since the mentor left; 0 allows any gap
+	MaxInactiveDays int `json:"maxInactiveDays" binding:"min=0"`
+}
+
+// DefaultModerationRules apply until an admin saves the first version
+func DefaultModerationRules() ModerationRules {
+	return ModerationRules{
+		MaxTags:     5,
+		BannedWords: []string{},
+		AutoApprove: AutoApproveRules{
+			Enabled:              false,
+			MinCompletedSessions: 1,
+			MaxInactiveDays:      365,
+		},
+	}
+}
+
+// Normalize lowercases, trims, deduplicates and sorts the banned words
+func (r *ModerationRules) Normalize() {
+	seen := make(map[string]bool, len(r.BannedWords))
+	words := make([]string, 0, len(r.BannedWords))
+	for _, word := range r.BannedWords {
+		word = strings.ToLower(strings.Join(strings.Fields(word), " "))
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	r.BannedWords = words
+}
+
+// FindBannedWord returns the first banned word found in the texts, or "" when there is none.
+// Words match whole: "spam" matches "Spam!" but not "spammer".
+func (r *ModerationRules) FindBannedWord(texts ...string) string {
+	for _, text := range texts {
+		text = strings.ToLower(text)
+		for _, word := range r.BannedWords {
+			if containsWord(text, word) {
+				return word
+			}
+		}
+	}
+	return ""
+}
+
+func containsWord(text, word string) bool {
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// ModerationSettings is one saved version of the moderation rules
+type ModerationSettings struct {
+	// Version is 0 for the built-in defaults
+	Version   int             `json:"version"`
+	Rules     ModerationRules `json:"rules"`
+	Note      string          `json:"note"`
+	CreatedBy string          `json:"createdBy"`
+	CreatedAt *time.Time      `json:"createdAt,omitempty"`
+}
+
+// UpdateModerationRulesRequest saves a new version of the moderation rules
+type UpdateModerationRulesRequest struct {
+	Rules ModerationRules `json:"rules"`
+	Note  string          `json:"note" binding:"max=500"`
+	// BaseVersion is the version the admin edited; the save fails if another version was saved since
+	BaseVersion *int `json:"baseVersion" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Mentor notification channels; each is delivered through its own trigger URL
+const (
+	NotificationChannelMentorRequestCreated = "mentor_request_created"
+	NotificationChannelMentorQuestion       = "mentor_question"
+)
+
+// Delivery modes of notifications held during quiet hours
+const (
+	// NotificationModeDigest merges the held notifications of a mentor into one morning digest
+	NotificationModeDigest = "digest"
+	// NotificationModeIndividual sends each held notification through its channel once quiet hours end
+	NotificationModeIndividual = "individual"
+)
+
+// MentorNotification is a notification to a mentor. Like a trigger call it is either a GET of the
+// channel's trigger URL with RecordID appended, or a POST of Payload when set.
+type MentorNotification struct {
+	MentorID string
+	Channel  string
+	RecordID string
+	Payload  interface{}
+}
+
+// QueuedNotification is a notification held until the mentor's quiet hours end
+type QueuedNotification struct {
+	ID           string          `json:"id"`
+	MentorID     string          `json:"mentorId"`
+	Channel      string          `json:"channel"`
+	RecordID     string          `json:"recordId,omitempty"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	DeliverAfter time.Time       `json:"deliverAfter"`
+	CreatedAt    time.Time       `json:"createdAt"`
+}
+
+// NotificationDigest is POSTed to NOTIFICATION_DIGEST_TRIGGER_URL with the notifications a mentor
+// received during their quiet hours, batched per channel
+type NotificationDigest struct {
+	Type     string              `json:"type"`
+	MentorID string              `json:"mentor_id"`
+	Batches  []NotificationBatch `json:"batches"`
+}
+
+// NotificationBatch is the held notifications of one channel, oldest first
+type NotificationBatch struct {
+	Channel string               `json:"channel"`
+	Count   int                  `json:"count"`
+	Items   []QueuedNotification `json:"items"`
+}
+
+// NotificationSettings are a mentor's notification preferences
+type NotificationSettings struct {
+	QuietHours string `json:"quietHours"`
+	// Timezone is the profile timezone quiet hours are read in; without it they don't apply
+	Timezone string `json:"timezone"`
+	// Queued is the number of notifications held until the current quiet hours end
+	Queued int `json:"queued"`
+}
+
+// SetQuietHoursRequest sets the mentor's quiet hours; an empty value turns them off
+type SetQuietHoursRequest struct {
+	QuietHours *string `json:"quietHours" binding:"required,max=11"`
+}
+
+// QuietHoursEnd returns when the quiet hours (e.g. "22:00-08:00" in timezone) that now falls into
+// end. The second return value is false outside quiet hours, and when either value is unset or invalid.
+func QuietHoursEnd(quietHours, timezone string, now time.Time) (time.Time, bool) {
+	if quietHours == "" || timezone == "" {
+		return time.Time{}, false
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	startMinute, endMinute, err := ParseQuietHours(quietHours)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	local := now.In(loc)
+	if !withinMinuteRange(local.Hour()*60+local.Minute(), startMinute, endMinute) {
+		return time.Time{}, false
+	}
+	end := time.Date(local.Year(), local.Month(), local.Day(), endMinute/60, endMinute%60, 0, 0, loc)
+	if !end.After(local) {
+		end = time.Date(local.Year(), local.Month(), local.Day()+1, endMinute/60, endMinute%60, 0, 0, loc)
+	}
+	return end, true
+}
+
+// BatchNotifications splits held notifications, oldest first, into one digest per mentor of the
+// channels for which digest reports true, batched per channel, and the ones sent individually
+func BatchNotifications(held []QueuedNotification, digest func(channel string) bool) ([]NotificationDigest, []QueuedNotification) {
+	var digests []NotificationDigest
+	var individual []QueuedNotification
+	digestByMentor := make(map[string]int)
+
+	for _, notification := range held {
+		if !digest(notification.Channel) {
+			individual = append(individual, notification)
+			continue
+		}
+
+		i, ok := digestByMentor[notification.MentorID]
+		if !ok {
+			i = len(digests)
+			digestByMentor[notification.MentorID] = i
+			digests = append(digests, NotificationDigest{Type: "notification_digest", MentorID: notification.MentorID})
+		}
+		digests[i].add(notification)
+	}
+	return digests, individual
+}
+
+func (d *NotificationDigest) add(notification QueuedNotification) {
+	for i := range d.Batches {
+		if d.Batches[i].Channel == notification.Channel {
+			d.Batches[i].Items = append(d.Batches[i].Items, notification)
+			d.Batches[i].Count++
+			return
+		}
+	}
+	d.Batches = append(d.Batches, NotificationBatch{
+		Channel: notification.Channel,
+		Count:   1,
+		Items:   []QueuedNotification{notification},
+	})
+}
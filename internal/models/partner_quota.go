@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// PartnerQuotaUsage is a partner token's consumption in the current monthly period
+type PartnerQuotaUsage struct {
+	Token     string                `json:"token"`
+	Period    string                `json:"period"` // YYYY-MM, UTC
+	Used      int64                 `json:"used"`
+	Limit     int64                 `json:"limit"` // 0 means unlimited
+	Remaining *int64                `json:"remaining,omitempty"`
+	ResetsAt  time.Time             `json:"resetsAt"`
+	Override  *PartnerQuotaOverride `json:"override,omitempty"`
+}
+
+// Exceeded reports whether the usage is over a finite limit
+func (u *PartnerQuotaUsage) Exceeded() bool {
+	return u.Limit > 0 && u.Used > u.Limit
+}
+
+// PartnerQuotaOverride replaces the default monthly limit for one partner token
+type PartnerQuotaOverride struct {
+	TokenName    string    `json:"tokenName"`
+	MonthlyLimit int64     `json:"monthlyLimit"`
+	Note         string    `json:"note,omitempty"`
+	UpdatedBy    string    `json:"updatedBy"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// SetPartnerQuotaRequest is the admin request to override a partner token's monthly limit
+type SetPartnerQuotaRequest struct {
+	MonthlyLimit *int64 `json:"monthlyLimit" binding:"required,min=0"`
+	Note         string `json:"note" binding:"max=500"`
+}
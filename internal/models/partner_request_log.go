@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// PartnerRequestLog is a sanitized summary of a request made with a partner API token.
+// Request and response bodies are never stored.
+type PartnerRequestLog struct {
+	ID            int64     `json:"id"`
+	OccurredAt    time.Time `json:"occurredAt"`
+	TokenID       string    `json:"tokenId"`
+	Method        string    `json:"method"`
+	Route         string    `json:"route"`
+	Path          string    `json:"path"`
+	Query         string    `json:"query,omitempty"`
+	Status        int       `json:"status"`
+	ResultCount   *int      `json:"resultCount,omitempty"`
+	ResponseBytes int       `json:"responseBytes"`
+	LatencyMs     int       `json:"latencyMs"`
+	TraceID       string    `json:"traceId,omitempty"`
+}
+
+// PartnerRequestLogFilter selects partner request summaries; empty fields match everything
+type PartnerRequestLogFilter struct {
+	TokenID string
+	Route   string
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+// PartnerRequestLogListResponse is the response of the partner audit query endpoint
+type PartnerRequestLogListResponse struct {
+	Entries []*PartnerRequestLog `json:"entries"`
+	Total   int                  `json:"total"`
+}
+
+// MaxPartnerRequestLogsListed caps a partner audit listing
+const MaxPartnerRequestLogsListed = 500
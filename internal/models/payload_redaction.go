@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces personal data and secrets in payloads shown to operators
+const RedactedValue = "[redacted]"
+
+// redactedPayloadKeys are payload fields (or field suffixes) holding personal data or one-time links
+var redactedPayloadKeys = []string{
+	"email", "recipient", "name", "telegram", "phone", "ip", "user_agent", "userAgent",
+	"details", "description", "comment", "text", "token", "url", "link",
+}
+
+// emailInTextPattern catches addresses in fields not covered by redactedPayloadKeys
+var emailInTextPattern = regexp.MustCompile(`[^\s@"<>]+@[^\s@"<>]+\.[^\s@"<>]+`)
+
+// RedactPayload returns a copy of a JSON payload with personal data and link tokens replaced
+// by RedactedValue, matching keys case-insensitively by exact name or "_"-separated suffix.
+// Email addresses elsewhere in string values are redacted too. Payloads that are not
+// valid JSON are returned as is.
+func RedactPayload(payload json.RawMessage) json.RawMessage {
+	if len(payload) == 0 {
+		return payload
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return payload
+	}
+	redacted, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return payload
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isRedactedKey(key) && nested != nil {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+		return v
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+		return v
+	case string:
+		return emailInTextPattern.ReplaceAllString(v, RedactedValue)
+	default:
+		return v
+	}
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range redactedPayloadKeys {
+		sensitive = strings.ToLower(sensitive)
+		if key == sensitive || strings.HasSuffix(key, "_"+sensitive) {
+			return true
+		}
+	}
+	return false
+}
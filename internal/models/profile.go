@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // SaveProfileRequest represents a mentor profile update request
 // SECURITY: Max length validation to prevent resource exhaustion attacks
 type SaveProfileRequest struct {
@@ -13,6 +15,19 @@ type SaveProfileRequest struct {
 	About        string   `json:"about" binding:"required,max=10000"`
 	Competencies string   `json:"competencies" binding:"required,max=5000"`
 	CalendarURL  string   `json:"calendarUrl" binding:"omitempty,url,max=500"`
+	// Timezone and ContactHours are optional: nil leaves the stored value unchanged, empty string clears it
+	Timezone     *string `json:"timezone,omitempty" binding:"omitempty,max=64"`
+	ContactHours *string `json:"contactHours,omitempty" binding:"omitempty,max=11"`
+	// Location fields follow the same rule: nil leaves the stored value unchanged
+	Country    *string `json:"country,omitempty" binding:"omitempty,max=2"`
+	City       *string `json:"city,omitempty" binding:"omitempty,max=100"`
+	RemoteOnly *bool   `json:"remoteOnly,omitempty"`
+	// Languages replaces the stored list when present
+	Languages []string `json:"languages,omitempty" binding:"omitempty,max=3,dive,oneof=ru en other"`
+	// ExpectedVersion is the version the client last read (also accepted as If-Match); the save fails with 409
+	// if the profile changed since. ExpectedUpdatedAt is the older equivalent. One of them is required.
+	ExpectedVersion   *int       `json:"expectedVersion,omitempty"`
+	ExpectedUpdatedAt *time.Time `json:"expectedUpdatedAt,omitempty"`
 }
 
 // SaveProfileResponse represents the response after updating a profile
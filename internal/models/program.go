@@ -0,0 +1,132 @@
+package models
+
+import "time"
+
+const (
+	ProgramStatusDraft     = "draft"
+	ProgramStatusPublished = "published"
+	ProgramStatusCancelled = "cancelled"
+)
+
+const (
+	ProgramRegistrationRegistered = "registered"
+	ProgramRegistrationWaitlisted = "waitlisted"
+	ProgramRegistrationCancelled  = "cancelled"
+)
+
+// Program is a group workshop or session run by a mentor
+type Program struct {
+	ID              string    `json:"id"`
+	MentorID        string    `json:"mentorId"`
+	MentorLegacyID  int       `json:"mentorLegacyId"`
+	MentorSlug      string    `json:"mentorSlug"`
+	MentorName      string    `json:"mentorName"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	StartsAt        time.Time `json:"startsAt"`
+	DurationMinutes int       `json:"durationMinutes"`
+	// Schedule is a free-form note for recurring programs, e.g. "Tuesdays 19:00, 4 weeks"
+	Schedule        string    `json:"schedule"`
+	Capacity        int       `json:"capacity"`
+	Price           string    `json:"price"`
+	Status          string    `json:"status"`
+	RegisteredCount int       `json:"registeredCount"`
+	WaitlistCount   int       `json:"waitlistCount"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// EndsAt returns the end of the first session of the program
+func (p *Program) EndsAt() time.Time {
+	return p.StartsAt.Add(time.Duration(p.DurationMinutes) * time.Minute)
+}
+
+// IsOpenForRegistration reports whether attendees can register at the given moment
+func (p *Program) IsOpenForRegistration(now time.Time) bool {
+	return p.Status == ProgramStatusPublished && p.StartsAt.After(now)
+}
+
+// SeatsLeft returns the number of free seats
+func (p *Program) SeatsLeft() int {
+	if p.RegisteredCount >= p.Capacity {
+		return 0
+	}
+	return p.Capacity - p.RegisteredCount
+}
+
+// FillRate returns the share of seats taken, from 0 to 1
+func (p *Program) FillRate() float64 {
+	if p.Capacity <= 0 {
+		return 0
+	}
+	return float64(p.Capacity-p.SeatsLeft()) / float64(p.Capacity)
+}
+
+// ProgramFilter narrows program listings
+type ProgramFilter struct {
+	MentorID       string
+	MentorLegacyID int
+	// PublicOnly limits results to upcoming published programs of visible mentors
+	PublicOnly bool
+}
+
+// ProgramRequest creates or replaces a program
+type ProgramRequest struct {
+	// MentorID is only read on admin creation; mentors always create programs for themselves
+	MentorID        string    `json:"mentorId,omitempty" binding:"omitempty,uuid"`
+	Title           string    `json:"title" binding:"required,max=200"`
+	Description     string    `json:"description" binding:"max=10000"`
+	StartsAt        time.Time `json:"startsAt" binding:"required"`
+	DurationMinutes int       `json:"durationMinutes" binding:"required,min=15,max=1440"`
+	Schedule        string    `json:"schedule" binding:"max=500"`
+	Capacity        int       `json:"capacity" binding:"required,min=1,max=1000"`
+	Price           string    `json:"price" binding:"max=100"`
+	Status          string    `json:"status" binding:"omitempty,oneof=draft published cancelled"`
+}
+
+// ProgramsListResponse is returned by program listing endpoints
+type ProgramsListResponse struct {
+	Programs []*Program `json:"programs"`
+	Total    int        `json:"total"`
+}
+
+// ProgramRegistration is an attendee registered for (or waitlisted on) a program
+type ProgramRegistration struct {
+	ID        string `json:"id"`
+	ProgramID string `json:"programId"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Telegram  string `json:"telegram"`
+	Comment   string `json:"comment"`
+	Status    string `json:"status"`
+	// CancelToken is the attendee's secret for cancelling and downloading the event; never listed
+	CancelToken string     `json:"-"`
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// RegisterProgramAttendeeRequest is the public attendee registration form
+type RegisterProgramAttendeeRequest struct {
+	Name             string `json:"name" binding:"required,min=2,max=100"`
+	Email            string `json:"email" binding:"required,email,max=255"`
+	TelegramUsername string `json:"telegramUsername" binding:"max=50"`
+	Comment          string `json:"comment" binding:"max=2000"`
+	RecaptchaToken   string `json:"recaptchaToken" binding:"required"`
+}
+
+// RegisterProgramAttendeeResponse is returned after an attendee registration
+type RegisterProgramAttendeeResponse struct {
+	Success        bool   `json:"success"`
+	RegistrationID string `json:"registrationId,omitempty"`
+	// Status is "registered" or "waitlisted" when the program is full
+	Status string `json:"status,omitempty"`
+	// CancelToken identifies the registration in the cancel and calendar endpoints
+	CancelToken string `json:"cancelToken,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// CancelProgramRegistrationResponse is returned after an attendee cancels
+type CancelProgramRegistrationResponse struct {
+	Success bool   `json:"success"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// PublicStats are the vanity counters shown on the landing page
+type PublicStats struct {
+	Mentors           int       `json:"mentors"`
+	SessionsCompleted int       `json:"sessionsCompleted"`
+	RequestsThisMonth int       `json:"requestsThisMonth"`
+	GeneratedAt       time.Time `json:"generatedAt"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+const (
+	QuarantineStatusQuarantined = "quarantined"
+	QuarantineStatusReleased    = "released"
+	QuarantineStatusDiscarded   = "discarded"
+)
+
+const (
+	// QuarantineVerdictRelease delivers the request to the mentor
+	QuarantineVerdictRelease = "release"
+	// QuarantineVerdictDiscard drops the request for good
+	QuarantineVerdictDiscard = "discard"
+)
+
+// QuarantinedRequest is a client request held back by a shadow antispam rule
+type QuarantinedRequest struct {
+	ID               string     `json:"id"`
+	MentorID         string     `json:"mentorId"`
+	MentorSlug       string     `json:"mentorSlug"`
+	MentorName       string     `json:"mentorName"`
+	Email            string     `json:"email"`
+	Name             string     `json:"name"`
+	Telegram         string     `json:"telegram"`
+	Details          string     `json:"details"`
+	Level            string     `json:"level"`
+	FlagReason       string     `json:"flagReason"`
+	QuarantineStatus string     `json:"quarantineStatus"`
+	ReviewedBy       string     `json:"reviewedBy,omitempty"`
+	ReviewedAt       *time.Time `json:"reviewedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+// QuarantinedRequestsResponse is returned by the quarantine queue endpoint
+type QuarantinedRequestsResponse struct {
+	Requests []*QuarantinedRequest `json:"requests"`
+	Total    int                   `json:"total"`
+}
+
+// QuarantineVerdictRequest releases or discards a quarantined request
+type QuarantineVerdictRequest struct {
+	Verdict string `json:"verdict" binding:"required,oneof=release discard"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+// MaxReplyTemplatesPerMentor limits how many saved replies a mentor can keep
+const MaxReplyTemplatesPerMentor = 20
+
+var replyTemplatePlaceholder = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// ReplyTemplate is a saved reply a mentor sends when contacting mentees.
+// The body may contain placeholders like {{name}} and {{calendar_url}}.
+type ReplyTemplate struct {
+	ID        string    `json:"id"`
+	MentorID  string    `json:"-"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ReplyTemplateRequest creates or replaces a reply template
+type ReplyTemplateRequest struct {
+	Title string `json:"title" binding:"required,max=100"`
+	Body  string `json:"body" binding:"required,max=4000"`
+}
+
+// RenderedReplyTemplate is a template filled in for a specific request
+type RenderedReplyTemplate struct {
+	TemplateID string `json:"templateId"`
+	RequestID  string `json:"requestId"`
+	Text       string `json:"text"`
+}
+
+// RenderReplyTemplate substitutes {{placeholder}} occurrences with values.
+// Unknown placeholders are kept as is so typos stay visible to the mentor.
+func RenderReplyTemplate(body string, values map[string]string) string {
+	return replyTemplatePlaceholder.ReplaceAllStringFunc(body, func(match string) string {
+		key := replyTemplatePlaceholder.FindStringSubmatch(match)[1]
+		if value, ok := values[key]; ok {
+			return value
+		}
+		return match
+	})
+}
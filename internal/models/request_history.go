@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// RequestHistoryEntry is a client request as read by event backfills
+type RequestHistoryEntry struct {
+	ID              string
+	MentorID        string
+	Level           string
+	Status          RequestStatus
+	DeclineReason   string
+	CreatedAt       time.Time
+	StatusChangedAt *time.Time
+}
+
+// HistoryCursor continues a history scan after the last entry read
+type HistoryCursor struct {
+	At time.Time
+	ID string
+}
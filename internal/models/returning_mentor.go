@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ReturningMentor is an approved mentor who left the platform and may come back
+type ReturningMentor struct {
+	MentorID          string
+	Slug              string
+	Name              string
+	CompletedSessions int
+	DeactivatedAt     *time.Time
+}
+
+// InactiveDays is the number of whole days since the mentor left, or -1 when unknown
+func (m *ReturningMentor) InactiveDays(now time.Time) int {
+	if m.DeactivatedAt == nil {
+		return -1
+	}
+	return int(now.Sub(*m.DeactivatedAt).Hours() / 24)
+}
+
+// Allows reports whether the rules let the returning mentor back without moderation
+func (r AutoApproveRules) Allows(m *ReturningMentor, now time.Time) bool {
+	if !r.Enabled || m.CompletedSessions < r.MinCompletedSessions {
+		return false
+	}
+	if r.MaxInactiveDays == 0 {
+		return true
+	}
+	days := m.InactiveDays(now)
+	return days >= 0 && days <= r.MaxInactiveDays
+}
@@ -1,7 +1,11 @@
 package models
 
+import "time"
+
 // SubmitReviewRequest represents a review form submission from a mentee
 type SubmitReviewRequest struct {
+	// Rating of the session from 1 to 5; optional for older review forms
+	Rating         *int   `json:"rating" binding:"omitempty,min=1,max=5"`
 	MentorReview   string `json:"mentorReview" binding:"required,min=10,max=5000"`
 	PlatformReview string `json:"platformReview" binding:"max=5000"`
 	Improvements   string `json:"improvements" binding:"max=5000"`
@@ -21,3 +25,55 @@ type ReviewCheckResponse struct {
 	Error      string `json:"error,omitempty"`
 	MentorName string `json:"mentorName,omitempty"`
 }
+
+// Review moderation statuses, used for both the review and the mentor's reply
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+// Review is a mentee's review as moderators see it
+type Review struct {
+	ID             string     `json:"id"`
+	RequestID      string     `json:"requestId"`
+	MentorID       string     `json:"mentorId"`
+	MentorName     string     `json:"mentorName"`
+	Rating         *int       `json:"rating,omitempty"`
+	MentorReview   string     `json:"mentorReview"`
+	PlatformReview string     `json:"platformReview,omitempty"`
+	Improvements   string     `json:"improvements,omitempty"`
+	Status         string     `json:"status"`
+	ModeratedAt    *time.Time `json:"moderatedAt,omitempty"`
+	Reply          string     `json:"reply,omitempty"`
+	ReplyStatus    string     `json:"replyStatus,omitempty"`
+	RepliedAt      *time.Time `json:"repliedAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// PublicReview is an approved review shown on the mentor's profile. The mentee stays anonymous.
+type PublicReview struct {
+	ID        string       `json:"id"`
+	Rating    *int         `json:"rating,omitempty"`
+	Review    string       `json:"review"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Reply     *ReviewReply `json:"reply,omitempty"`
+}
+
+// ReviewReply is the mentor's reply to a review. Status is only shown to the mentor.
+type ReviewReply struct {
+	Text      string    `json:"text"`
+	Status    string    `json:"status,omitempty"`
+	RepliedAt time.Time `json:"repliedAt"`
+}
+
+// ReviewReplyRequest is the mentor's one-time reply to a published review
+type ReviewReplyRequest struct {
+	Reply string `json:"reply" binding:"required,min=2,max=2000"`
+}
+
+// AdminReviewFilter selects reviews for the moderation queue. Empty fields match everything.
+type AdminReviewFilter struct {
+	Status      string
+	ReplyStatus string
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// ScheduledSession is a request with a scheduled call, as published in the mentor's calendar feed
+type ScheduledSession struct {
+	RequestID      string
+	MenteeName     string
+	MenteeTelegram string
+	Level          string
+	Status         RequestStatus
+	ScheduledAt    time.Time
+	// Sequence grows whenever the time or status of the session changes
+	Sequence  int
+	UpdatedAt time.Time
+}
+
+// SessionCalendarFeedResponse holds the subscription URL of the mentor's sessions feed
+type SessionCalendarFeedResponse struct {
+	URL string `json:"url"`
+}
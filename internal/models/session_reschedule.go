@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+const (
+	RescheduleProposalPending    = "pending"
+	RescheduleProposalConfirmed  = "confirmed"
+	RescheduleProposalSuperseded = "superseded"
+)
+
+// MaxRescheduleSlots limits how many alternative times a mentor can offer at once
+const MaxRescheduleSlots = 5
+
+// RescheduleProposal is a set of new session times offered by the mentor
+type RescheduleProposal struct {
+	ID             string        `json:"id"`
+	RequestID      string        `json:"requestId"`
+	MentorID       string        `json:"-"`
+	MentorName     string        `json:"mentorName"`
+	Slots          []time.Time   `json:"slots"`
+	Comment        string        `json:"comment,omitempty"`
+	PreviousStatus RequestStatus `json:"-"`
+	Status         string        `json:"status"`
+	ChosenSlot     *time.Time    `json:"chosenSlot,omitempty"`
+	ExpiresAt      time.Time     `json:"expiresAt"`
+	CreatedAt      time.Time     `json:"createdAt"`
+
+	// Token is the mentee's secret for confirming; never listed
+	Token string `json:"-"`
+}
+
+// IsOpen reports whether the mentee can still pick a slot
+func (p *RescheduleProposal) IsOpen(now time.Time) bool {
+	return p.Status == RescheduleProposalPending && p.ExpiresAt.After(now)
+}
+
+// HasSlot reports whether the time is one of the offered slots
+func (p *RescheduleProposal) HasSlot(slot time.Time) bool {
+	for _, offered := range p.Slots {
+		if offered.Equal(slot) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProposeRescheduleRequest is sent by the mentor to offer new session times
+type ProposeRescheduleRequest struct {
+	Slots   []time.Time `json:"slots" binding:"required,min=1,max=5"`
+	Comment string      `json:"comment" binding:"max=1000"`
+}
+
+// ProposeRescheduleResponse is returned to the mentor after proposing
+type ProposeRescheduleResponse struct {
+	Proposal *RescheduleProposal `json:"proposal"`
+	// ConfirmURL is the link sent to the mentee
+	ConfirmURL string `json:"confirmUrl"`
+}
+
+// ConfirmRescheduleRequest is sent by the mentee to pick one of the offered slots
+type ConfirmRescheduleRequest struct {
+	Slot time.Time `json:"slot" binding:"required"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+// MaxShortLinksPerMentor limits how many short links a mentor can keep
+const MaxShortLinksPerMentor = 20
+
+// Defaults for UTM parameters a short link is created without
+const (
+	DefaultShortLinkUTMSource = "getmentor_link"
+	DefaultShortLinkUTMMedium = "share"
+)
+
+// ShortLinkCodePattern is the format of custom short link codes
+var ShortLinkCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{2,31}$`)
+
+// ShortLink is a short, trackable link to a mentor profile served at /m/:code
+type ShortLink struct {
+	Code          string     `json:"code"`
+	MentorID      string     `json:"mentorId"`
+	URL           string     `json:"url"`    // the short link itself
+	Target        string     `json:"target"` // the profile URL with UTM parameters
+	Label         string     `json:"label,omitempty"`
+	UTMSource     string     `json:"utmSource"`
+	UTMMedium     string     `json:"utmMedium"`
+	UTMCampaign   string     `json:"utmCampaign,omitempty"`
+	Clicks        int64      `json:"clicks"`
+	LastClickedAt *time.Time `json:"lastClickedAt,omitempty"`
+	CreatedBy     string     `json:"createdBy"`
+	CreatedAt     time.Time  `json:"createdAt"`
+
+	MentorSlug string `json:"-"`
+	// MentorActive is false when the profile isn't published; clicks then land on the home page
+	MentorActive bool `json:"-"`
+}
+
+// ShortLinkList is a mentor's short links with their combined click count
+type ShortLinkList struct {
+	Links       []*ShortLink `json:"links"`
+	TotalClicks int64        `json:"totalClicks"`
+}
+
+// CreateShortLinkRequest creates a short link. Code is generated when empty.
+type CreateShortLinkRequest struct {
+	Code        string `json:"code" binding:"omitempty,max=32"`
+	Label       string `json:"label" binding:"max=100"`
+	UTMSource   string `json:"utmSource" binding:"max=100"`
+	UTMMedium   string `json:"utmMedium" binding:"max=100"`
+	UTMCampaign string `json:"utmCampaign" binding:"max=100"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Tag is a mentor tag with the number of mentors using it
+type Tag struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	MentorCount int       `json:"mentorCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// CreateTagRequest is the payload for POST /api/v1/internal/tags
+type CreateTagRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// TagSuggestionMinScore is the similarity a tag must reach to be suggested
+	TagSuggestionMinScore = 0.8
+	// MaxTagSuggestions caps the number of suggested tags
+	MaxTagSuggestions = 10
+	// tagExactMatchMaxLen is the length up to which tags must match exactly ("Go" is not "Git")
+	tagExactMatchMaxLen = 3
+)
+
+// TagSuggestion is an existing tag that matches free text
+type TagSuggestion struct {
+	Tag      string  `json:"tag"`
+	Score    float64 `json:"score"`    // best similarity, 1 for an exact mention
+	Mentions int     `json:"mentions"` // number of places in the text matching the tag
+}
+
+// SuggestTagsRequest is the payload for suggesting tags from free text
+type SuggestTagsRequest struct {
+	Text string `json:"text" binding:"required,max=20000"`
+	// Exclude lists tags already selected, which are not suggested again
+	Exclude []string `json:"exclude" binding:"max=50"`
+}
+
+// TagSuggestionsResponse is the response of the tag suggestion endpoints
+type TagSuggestionsResponse struct {
+	Suggestions []TagSuggestion `json:"suggestions"`
+}
+
+// SuggestTags matches the text against the known tags. Each tag is compared with every run
+// of as many words as the tag has, using normalized Levenshtein similarity so that typos and
+// suffixes ("Kubernets", "PostgreSQL" for "Postgres") still match. Sponsor tags and excluded
+// tags are never suggested.
+func SuggestTags(text string, tags, exclude []string, limit int) []TagSuggestion {
+	words := tagWords(text)
+	excluded := map[string]bool{}
+	for _, tag := range exclude {
+		excluded[strings.ToLower(tag)] = true
+	}
+
+	suggestions := []TagSuggestion{}
+	for _, tag := range tags {
+		if SponsorTags[tag] || excluded[strings.ToLower(tag)] {
+			continue
+		}
+		tagTokens := tagWords(tag)
+		if len(tagTokens) == 0 || len(tagTokens) > len(words) {
+			continue
+		}
+		phrase := strings.Join(tagTokens, " ")
+
+		suggestion := TagSuggestion{Tag: tag}
+		for i := 0; i+len(tagTokens) <= len(words); i++ {
+			score := phraseSimilarity(phrase, strings.Join(words[i:i+len(tagTokens)], " "))
+			if score < TagSuggestionMinScore {
+				continue
+			}
+			suggestion.Mentions++
+			if score > suggestion.Score {
+				suggestion.Score = score
+			}
+		}
+		if suggestion.Mentions > 0 {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Mentions != b.Mentions {
+			return a.Mentions > b.Mentions
+		}
+		return a.Tag < b.Tag
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// tagWords lowercases text and splits it into words, keeping the symbols used in
+// technology names (C++, C#, Node.js)
+func tagWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '.'
+	})
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if word := strings.Trim(field, "."); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// phraseSimilarity returns 1 - editDistance/maxLen. Short tags only match exactly,
+// and a text word may carry a suffix (an inflection) beyond the tag.
+func phraseSimilarity(tag, candidate string) float64 {
+	if tag == candidate {
+		return 1
+	}
+	tagLen := utf8.RuneCountInString(tag)
+	if tagLen <= tagExactMatchMaxLen {
+		return 0
+	}
+	if strings.HasPrefix(candidate, tag) && utf8.RuneCountInString(candidate)-tagLen <= 3 {
+		return 0.9
+	}
+
+	a, b := []rune(tag), []rune(candidate)
+	maxLen := len(a)
+	if len(b) > maxLen {
+		maxLen = len(b)
+	}
+	return 1 - float64(levenshtein(a, b))/float64(maxLen)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
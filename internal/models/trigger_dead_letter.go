@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+)
+
+const (
+	TriggerDeadLetterDead      = "dead"
+	TriggerDeadLetterDelivered = "delivered"
+)
+
+// TriggerDeadLetter is an outbound trigger delivery that failed every retry.
+// The trigger URL is never exposed: it carries the function access code.
+type TriggerDeadLetter struct {
+	ID           string            `json:"id"`
+	Destination  string            `json:"destination"`
+	TriggerURL   string            `json:"-"`
+	Method       string            `json:"method"`
+	RecordID     string            `json:"recordId,omitempty"`
+	Payload      json.RawMessage   `json:"payload,omitempty"`
+	Attempts     []trigger.Attempt `json:"attempts"`
+	AttemptCount int               `json:"attemptCount"`
+	LastError    string            `json:"lastError"`
+	Status       string            `json:"status"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+	DeliveredAt  *time.Time        `json:"deliveredAt,omitempty"`
+}
+
+// Delivery rebuilds the trigger call to re-drive it
+func (l *TriggerDeadLetter) Delivery() trigger.Delivery {
+	return trigger.Delivery{TriggerURL: l.TriggerURL, RecordID: l.RecordID, Payload: l.Payload}
+}
+
+// TriggerDeadLetterResponse wraps a single dead letter
+type TriggerDeadLetterResponse struct {
+	DeadLetter *TriggerDeadLetter `json:"deadLetter"`
+}
+
+// TriggerDeadLetterFilter selects dead letters by status
+type TriggerDeadLetterFilter string
+
+const (
+	TriggerDeadLetterFilterDead      TriggerDeadLetterFilter = "dead"
+	TriggerDeadLetterFilterDelivered TriggerDeadLetterFilter = "delivered"
+	TriggerDeadLetterFilterAll       TriggerDeadLetterFilter = "all"
+)
+
+// MaxTriggerDeadLettersListed caps a dead-letter listing
+const MaxTriggerDeadLettersListed = 200
+
+// MaxTriggerRedriveBatch caps how many dead letters one bulk re-drive may send
+const MaxTriggerRedriveBatch = 50
+
+// Statuses returns the statuses matched by the filter (nil matches all) and whether the filter is valid
+func (f TriggerDeadLetterFilter) Statuses() ([]string, bool) {
+	switch f {
+	case TriggerDeadLetterFilterDead:
+		return []string{TriggerDeadLetterDead}, true
+	case TriggerDeadLetterFilterDelivered:
+		return []string{TriggerDeadLetterDelivered}, true
+	case TriggerDeadLetterFilterAll:
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// TriggerDeadLettersListResponse is the admin dead-letter listing
+type TriggerDeadLettersListResponse struct {
+	DeadLetters []*TriggerDeadLetter `json:"deadLetters"`
+	Total       int                  `json:"total"`
+}
+
+// RedriveTriggerDeadLettersRequest re-drives several dead letters in order
+type RedriveTriggerDeadLettersRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1,max=50,dive,required"`
+}
+
+// TriggerRedriveResult is the outcome of re-driving one dead letter
+type TriggerRedriveResult struct {
+	ID        string `json:"id"`
+	Delivered bool   `json:"delivered"`
+	Error     string `json:"error,omitempty"`
+}
+
+// TriggerRedriveResponse lists the outcome per dead letter
+type TriggerRedriveResponse struct {
+	Results []TriggerRedriveResult `json:"results"`
+}
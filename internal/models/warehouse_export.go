@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// Warehouse export streams
+const (
+	WarehouseStreamRequests = "requests"
+	WarehouseStreamMentors  = "mentors"
+)
+
+// WarehouseWatermark is how far a stream has been exported: rows are read in
+// (updated_at, id) order and the watermark is the last row shipped
+type WarehouseWatermark struct {
+	Stream       string
+	Cursor       HistoryCursor
+	RowsExported int64
+	UpdatedAt    time.Time
+}
+
+// RequestActivity is a client request as read by the warehouse export, before anonymization
+type RequestActivity struct {
+	ID               string
+	MentorID         string
+	Email            string
+	Level            string
+	Status           string
+	DeclineReason    string
+	QuarantineStatus string
+	HasReview        bool
+	CreatedAt        time.Time
+	StatusChangedAt  *time.Time
+	UpdatedAt        time.Time
+}
+
+// RequestFact is the anonymized request row shipped to the warehouse. The mentee is only
+// present as a keyed hash of their email; names, contacts and free text never leave the service.
+type RequestFact struct {
+	RequestID        string     `json:"request_id"`
+	MentorID         string     `json:"mentor_id"`
+	MenteeHash       string     `json:"mentee_hash"`
+	Level            string     `json:"level"`
+	Status           string     `json:"status"`
+	DeclineReason    string     `json:"decline_reason"`
+	QuarantineStatus string     `json:"quarantine_status"`
+	HasReview        bool       `json:"has_review"`
+	CreatedAt        time.Time  `json:"created_at"`
+	StatusChangedAt  *time.Time `json:"status_changed_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	ExportedAt       time.Time  `json:"exported_at"`
+}
+
+// MentorFact is the mentor row shipped to the warehouse: public profile attributes only
+type MentorFact struct {
+	MentorID   string    `json:"mentor_id"`
+	Status     string    `json:"status"`
+	Experience string    `json:"experience"`
+	Price      string    `json:"price"`
+	Country    string    `json:"country"`
+	RemoteOnly bool      `json:"remote_only"`
+	Languages  []string  `json:"languages"`
+	Tags       []string  `json:"tags"`
+	MergedInto string    `json:"merged_into"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	ExportedAt time.Time `json:"exported_at"`
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrAbuseReportNotFound is returned when an abuse report doesn't exist
+var ErrAbuseReportNotFound = errors.New("abuse report not found")
+
+const abuseReportSelect = `
+	SELECT ar.id, ar.target_type, COALESCE(ar.mentor_id::text, ''), COALESCE(m.slug, ''), COALESCE(m.name, ''),
+		COALESCE(ar.client_request_id::text, ''), ar.category, ar.description, COALESCE(ar.reporter_email, ''),
+		ar.status, COALESCE(ar.resolution_comment, ''), COALESCE(ar.resolved_by::text, ''), ar.resolved_at, ar.created_at
+	FROM abuse_reports ar
+	LEFT JOIN mentors m ON m.id = ar.mentor_id
+`
+
+// AbuseReportRepository handles abuse report data access
+type AbuseReportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAbuseReportRepository creates a new abuse report repository
+func NewAbuseReportRepository(pool *pgxpool.Pool) *AbuseReportRepository {
+	return &AbuseReportRepository{
+		pool: pool,
+	}
+}
+
+// Create stores a new open report and returns its ID
+func (r *AbuseReportRepository) Create(ctx context.Context, report *models.AbuseReport) (string, error) {
+	query := `
+		INSERT INTO abuse_reports (target_type, mentor_id, client_request_id, category, description, reporter_email)
+		VALUES ($1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5, NULLIF($6, ''))
+		RETURNING id
+	`
+
+	var reportID string
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		report.TargetType, report.MentorID, report.ClientRequestID, report.Category, report.Description, report.ReporterEmail,
+	).Scan(&reportID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create abuse report: %w", err)
+	}
+	return reportID, nil
+}
+
+// List returns reports with the given statuses (all when empty), oldest first
+func (r *AbuseReportRepository) List(ctx context.Context, statuses []string) ([]*models.AbuseReport, error) {
+	query := abuseReportSelect
+	args := []interface{}{}
+	if len(statuses) > 0 {
+		query += " WHERE ar.status = ANY($1)"
+		args = append(args, statuses)
+	}
+	query += " ORDER BY ar.created_at"
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query abuse reports: %w", err)
+	}
+	defer rows.Close()
+
+	reports := []*models.AbuseReport{}
+	for rows.Next() {
+		report, scanErr := scanAbuseReport(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate abuse reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+// GetByID returns a single report
+func (r *AbuseReportRepository) GetByID(ctx context.Context, reportID string) (*models.AbuseReport, error) {
+	report, err := scanAbuseReport(conn(ctx, r.pool).QueryRow(ctx, abuseReportSelect+" WHERE ar.id = $1", reportID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAbuseReportNotFound
+	}
+	return report, err
+}
+
+// UpdateStatus moves a report to a new status. Closing statuses record who closed it and when.
+func (r *AbuseReportRepository) UpdateStatus(ctx context.Context, reportID, status, comment, moderatorID string) error {
+	query := `
+		UPDATE abuse_reports
+		SET status = $1,
+			resolution_comment = NULLIF($2, ''),
+			resolved_by = CASE WHEN $1 IN ('resolved', 'dismissed') THEN NULLIF($3, '')::uuid END,
+			resolved_at = CASE WHEN $1 IN ('resolved', 'dismissed') THEN NOW() END
+		WHERE id = $4
+	`
+
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, query, status, comment, moderatorID, reportID)
+	if err != nil {
+		return fmt.Errorf("failed to update abuse report: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrAbuseReportNotFound
+	}
+	return nil
+}
+
+func scanAbuseReport(row pgx.Row) (*models.AbuseReport, error) {
+	var report models.AbuseReport
+	err := row.Scan(
+		&report.ID,
+		&report.TargetType,
+		&report.MentorID,
+		&report.MentorSlug,
+		&report.MentorName,
+		&report.ClientRequestID,
+		&report.Category,
+		&report.Description,
+		&report.ReporterEmail,
+		&report.Status,
+		&report.ResolutionComment,
+		&report.ResolvedBy,
+		&report.ResolvedAt,
+		&report.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan abuse report: %w", err)
+	}
+	return &report, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/circuitbreaker"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// AirtableMentorDataSource fills the mentor cache from the legacy Airtable base for
+// DATA_SOURCE=airtable. Mentors are read from PostgreSQL, which keeps their IDs, tags and the
+// fields Airtable doesn't hold, and the profile fields of mentors migrated from Airtable (with
+// an airtable_id) are then taken from their record. Mentors without a record keep their
+// PostgreSQL values, so writes, which only go to PostgreSQL, still find every cached mentor.
+// While the Airtable circuit breaker is open every mentor keeps its PostgreSQL values.
+type AirtableMentorDataSource struct {
+	client  *airtable.Client
+	table   string
+	mentors *MentorRepository
+}
+
+// NewAirtableMentorDataSource creates a data source reading the Mentors table of the client's base
+func NewAirtableMentorDataSource(client *airtable.Client, table string, mentors *MentorRepository) *AirtableMentorDataSource {
+	return &AirtableMentorDataSource{client: client, table: table, mentors: mentors}
+}
+
+// FetchAllMentors is the cache.MentorFetcher of the Airtable data source
+func (s *AirtableMentorDataSource) FetchAllMentors(ctx context.Context) ([]*models.Mentor, error) {
+	mentors, err := s.mentors.FetchAllMentorsFromDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.client.List(ctx, s.table, models.AirtableMentorReadFields)
+	if errors.Is(err, circuitbreaker.ErrOpen) {
+		s.fallBack(err)
+		return mentors, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]map[string]interface{}, len(records))
+	for _, record := range records {
+		fields[record.ID] = record.Fields
+	}
+
+	for _, mentor := range mentors {
+		if mentor.AirtableID == nil {
+			continue
+		}
+		if recordFields, ok := fields[*mentor.AirtableID]; ok {
+			mentor.ApplyAirtableFields(recordFields)
+		}
+	}
+	return mentors, nil
+}
+
+// FetchSingleMentor is the cache.SingleMentorFetcher of the Airtable data source
+func (s *AirtableMentorDataSource) FetchSingleMentor(ctx context.Context, slug string) (*models.Mentor, error) {
+	mentor, err := s.mentors.FetchSingleMentorFromDB(ctx, slug)
+	if err != nil || mentor.AirtableID == nil {
+		return mentor, err
+	}
+
+	records, err := s.client.Get(ctx, s.table, []string{*mentor.AirtableID}, models.AirtableMentorReadFields)
+	if errors.Is(err, circuitbreaker.ErrOpen) {
+		s.fallBack(err)
+		return mentor, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 {
+		mentor.ApplyAirtableFields(records[0].Fields)
+	}
+	return mentor, nil
+}
+
+// fallBack records serving PostgreSQL values while Airtable is cut off by its breaker
+func (s *AirtableMentorDataSource) fallBack(err error) {
+	circuitbreaker.ObserveFallback(airtable.Dependency)
+	logger.Warn("Airtable unavailable; serving mentors from PostgreSQL", zap.Error(err))
+}
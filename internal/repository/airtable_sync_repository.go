@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AirtableSyncRepository reads PostgreSQL changes of records that still exist in Airtable
+type AirtableSyncRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAirtableSyncRepository creates a new Airtable sync repository
+func NewAirtableSyncRepository(pool *pgxpool.Pool) *AirtableSyncRepository {
+	return &AirtableSyncRepository{pool: pool}
+}
+
+// ListMentorChanges returns mentors with an Airtable record updated after the cursor and
+// before the cutoff, in (updated_at, id) order
+func (r *AirtableSyncRepository) ListMentorChanges(ctx context.Context, after models.HistoryCursor, before time.Time, limit int) ([]*models.AirtableMentorChange, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, airtableMentorSelect+`
+		WHERE airtable_id IS NOT NULL
+			AND (updated_at, id) > ($1::timestamptz, $2::uuid) AND updated_at < $3
+		ORDER BY updated_at, id
+		LIMIT $4
+	`, after.At, after.ID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentor changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.AirtableMentorChange{}
+	for rows.Next() {
+		m, err := scanAirtableMentorChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, m)
+	}
+	return changes, rows.Err()
+}
+
+// GetMentorChange returns the mentor as pushed to Airtable, or nil when it has no Airtable record
+func (r *AirtableSyncRepository) GetMentorChange(ctx context.Context, mentorID string) (*models.AirtableMentorChange, error) {
+	change, err := scanAirtableMentorChange(conn(ctx, r.pool).QueryRow(ctx, airtableMentorSelect+`
+		WHERE id = $1 AND airtable_id IS NOT NULL
+	`, mentorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return change, err
+}
+
+// airtableMentorSelect reads the columns scanned by scanAirtableMentorChange
+const airtableMentorSelect = `
+		SELECT id, airtable_id, slug, name, COALESCE(job_title, ''), COALESCE(workplace, ''),
+			COALESCE(experience, ''), COALESCE(price, ''), status, COALESCE(email::text, ''),
+			COALESCE(telegram, ''), COALESCE(calendar_url, ''), COALESCE(timezone, ''), COALESCE(contact_hours, ''),
+			COALESCE(country, ''), COALESCE(city, ''), remote_only, languages, updated_at
+		FROM mentors`
+
+func scanAirtableMentorChange(row pgx.Row) (*models.AirtableMentorChange, error) {
+	var m models.AirtableMentorChange
+	if err := row.Scan(&m.ID, &m.AirtableID, &m.Slug, &m.Name, &m.JobTitle, &m.Workplace, &m.Experience,
+		&m.Price, &m.Status, &m.Email, &m.Telegram, &m.CalendarURL, &m.Timezone, &m.ContactHours,
+		&m.Country, &m.City, &m.RemoteOnly, &m.Languages, &m.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan mentor change: %w", err)
+	}
+	return &m, nil
+}
+
+// ListRequestChanges returns client requests with an Airtable record updated after the
+// cursor and before the cutoff, in (updated_at, id) order
+func (r *AirtableSyncRepository) ListRequestChanges(ctx context.Context, after models.HistoryCursor, before time.Time, limit int) ([]*models.AirtableRequestChange, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, airtable_id, status, status_changed_at, scheduled_at,
+			COALESCE(decline_reason, ''), COALESCE(decline_comment, ''), updated_at
+		FROM client_requests
+		WHERE airtable_id IS NOT NULL
+			AND (updated_at, id) > ($1::timestamptz, $2::uuid) AND updated_at < $3
+		ORDER BY updated_at, id
+		LIMIT $4
+	`, after.At, after.ID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.AirtableRequestChange{}
+	for rows.Next() {
+		var c models.AirtableRequestChange
+		if err := rows.Scan(&c.ID, &c.AirtableID, &c.Status, &c.StatusChangedAt, &c.ScheduledAt,
+			&c.DeclineReason, &c.DeclineComment, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan request change: %w", err)
+		}
+		changes = append(changes, &c)
+	}
+	return changes, rows.Err()
+}
+
+// GetCutoverState returns the progress of the Airtable cutover
+func (r *AirtableSyncRepository) GetCutoverState(ctx context.Context) (*models.AirtableCutoverState, error) {
+	var state models.AirtableCutoverState
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT data_source, writes_frozen_at, finalized_at FROM airtable_cutover
+	`).Scan(&state.DataSource, &state.WritesFrozenAt, &state.FinalizedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &models.AirtableCutoverState{DataSource: models.DataSourceAirtable}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Airtable cutover state: %w", err)
+	}
+	return &state, nil
+}
+
+// SetWritesFrozen freezes or thaws the background Airtable sync of every replica
+func (r *AirtableSyncRepository) SetWritesFrozen(ctx context.Context, frozen bool) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO airtable_cutover (id, writes_frozen_at) VALUES (TRUE, CASE WHEN $1 THEN NOW() END)
+		ON CONFLICT (id) DO UPDATE
+		SET writes_frozen_at = CASE WHEN $1 THEN COALESCE(airtable_cutover.writes_frozen_at, NOW()) END,
+			updated_at = NOW()
+	`, frozen)
+	if err != nil {
+		return fmt.Errorf("failed to update Airtable write freeze: %w", err)
+	}
+	return nil
+}
+
+// FinalizeCutover makes PostgreSQL the data source for good and keeps the cutover report
+func (r *AirtableSyncRepository) FinalizeCutover(ctx context.Context, report []byte) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO airtable_cutover (id, data_source, finalized_at, report) VALUES (TRUE, $1, NOW(), $2)
+		ON CONFLICT (id) DO UPDATE
+		SET data_source = EXCLUDED.data_source, finalized_at = EXCLUDED.finalized_at,
+			report = EXCLUDED.report, updated_at = NOW()
+	`, models.DataSourcePostgres, report)
+	if err != nil {
+		return fmt.Errorf("failed to finalize Airtable cutover: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auditSnapshotQueries read a row as JSON for diffing, locking it for the rest of the transaction.
+// Sign-in secrets and updated_at are left out, so they never reach the log, and so is the derived search vector.
+var auditSnapshotQueries = map[string]string{
+	models.AuditEntityMentor: `
+		SELECT (to_jsonb(m) - 'login_token' - 'login_token_expires_at' - 'calendar_feed_token' - 'tg_secret' - 'updated_at' - 'search_vector')
+			|| jsonb_build_object('tags', COALESCE((
+				SELECT jsonb_agg(t.name ORDER BY t.name)
+				FROM mentor_tags mt
+				JOIN tags t ON t.id = mt.tag_id
+				WHERE mt.mentor_id = m.id
+			), '[]'::jsonb))
+		FROM mentors m
+		WHERE m.id = $1
+		FOR UPDATE
+	`,
+	models.AuditEntityRequest: `
+		SELECT to_jsonb(cr) - 'updated_at'
+		FROM client_requests cr
+		WHERE cr.id = $1
+		FOR UPDATE
+	`,
+}
+
+// AuditLogRepository stores the audit log of mentor and request mutations
+type AuditLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(pool *pgxpool.Pool) *AuditLogRepository {
+	return &AuditLogRepository{pool: pool}
+}
+
+// Snapshot returns the current row of the entity as a field map, nil when the row doesn't exist
+func (r *AuditLogRepository) Snapshot(ctx context.Context, entityType, entityID string) (map[string]interface{}, error) {
+	query, ok := auditSnapshotQueries[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unknown audit entity type: %s", entityType)
+	}
+
+	var raw []byte
+	err := conn(ctx, r.pool).QueryRow(ctx, query, entityID).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot %s: %w", entityType, err)
+	}
+	snapshot, err := models.ParseAuditSnapshot(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s snapshot: %w", entityType, err)
+	}
+	return snapshot, nil
+}
+
+// Insert stores an audit log entry
+func (r *AuditLogRepository) Insert(ctx context.Context, entry *models.AuditLogEntry) error {
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+	var patch []byte
+	if len(entry.Patch) > 0 {
+		patch = entry.Patch
+	}
+	_, err = conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO audit_log (actor_type, actor_id, entity_type, entity_id, operation, changes, patch, trace_id)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7, NULLIF($8, ''))
+	`, entry.ActorType, entry.ActorID, entry.EntityType, entry.EntityID, entry.Operation, changes, patch, entry.TraceID)
+	if err != nil {
+		return fmt.Errorf("failed to save audit log entry: %w", err)
+	}
+	return nil
+}
+
+// List returns entries matching the filter, newest first
+func (r *AuditLogRepository) List(ctx context.Context, filter models.AuditLogFilter) ([]*models.AuditLogEntry, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, occurred_at, actor_type, COALESCE(actor_id, ''), entity_type, entity_id, operation,
+			changes, patch, COALESCE(trace_id, '')
+		FROM audit_log
+		WHERE ($1 = '' OR entity_type = $1)
+			AND ($2 = '' OR entity_id = $2)
+			AND ($3 = '' OR actor_type = $3)
+			AND ($4 = '' OR actor_id = $4)
+			AND ($5 = '' OR operation = $5)
+			AND occurred_at >= $6 AND occurred_at < $7
+			AND ($8 = 0 OR id < $8)
+		ORDER BY id DESC
+		LIMIT $9
+	`, filter.EntityType, filter.EntityID, filter.ActorType, filter.ActorID, filter.Operation,
+		filter.From, filter.To, filter.Before, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.AuditLogEntry{}
+	for rows.Next() {
+		var e models.AuditLogEntry
+		var changes, patch []byte
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.ActorType, &e.ActorID, &e.EntityType, &e.EntityID,
+			&e.Operation, &changes, &patch, &e.TraceID); err != nil {
+			return nil, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+		if err := json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, fmt.Errorf("failed to decode audit changes: %w", err)
+		}
+		e.Patch = patch
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
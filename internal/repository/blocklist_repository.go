@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrBlocklistEntryNotFound is returned when a blocklist entry doesn't exist or was already removed
+	ErrBlocklistEntryNotFound = errors.New("blocklist entry not found")
+	// ErrBlocklistEntryExists is returned when the value is already on the blocklist
+	ErrBlocklistEntryExists = errors.New("value is already on the blocklist")
+)
+
+const blocklistSelect = `
+	SELECT b.id, b.kind, b.value, b.action, COALESCE(b.reason, ''),
+		COALESCE(b.created_by::text, ''), COALESCE(m.name, ''), b.created_at, b.expires_at,
+		COALESCE(b.removed_by::text, ''), b.removed_at
+	FROM blocklist_entries b
+	LEFT JOIN moderators m ON m.id = b.created_by
+`
+
+const blocklistActiveCondition = `b.removed_at IS NULL AND (b.expires_at IS NULL OR b.expires_at > NOW())`
+
+// BlocklistRepository handles blocklist data access
+type BlocklistRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBlocklistRepository creates a new blocklist repository
+func NewBlocklistRepository(pool *pgxpool.Pool) *BlocklistRepository {
+	return &BlocklistRepository{
+		pool: pool,
+	}
+}
+
+// List returns blocklist entries, newest first. Removed and expired entries are
+// included only when includeInactive is set.
+func (r *BlocklistRepository) List(ctx context.Context, includeInactive bool) ([]*models.BlocklistEntry, error) {
+	query := blocklistSelect
+	if !includeInactive {
+		query += " WHERE " + blocklistActiveCondition
+	}
+	query += " ORDER BY b.created_at DESC"
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blocklist: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.BlocklistEntry{}
+	for rows.Next() {
+		entry, scanErr := scanBlocklistEntry(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate blocklist: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Create adds an entry on behalf of the moderator and returns it
+func (r *BlocklistRepository) Create(ctx context.Context, entry *models.BlocklistEntry) (*models.BlocklistEntry, error) {
+	query := `
+		INSERT INTO blocklist_entries (kind, value, action, reason, created_by, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, '')::uuid, $6)
+		RETURNING id
+	`
+
+	var entryID string
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		entry.Kind, entry.Value, entry.Action, entry.Reason, entry.CreatedBy, entry.ExpiresAt,
+	).Scan(&entryID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrBlocklistEntryExists
+		}
+		return nil, fmt.Errorf("failed to create blocklist entry: %w", err)
+	}
+
+	return r.GetByID(ctx, entryID)
+}
+
+// GetByID returns a single entry, including removed ones
+func (r *BlocklistRepository) GetByID(ctx context.Context, entryID string) (*models.BlocklistEntry, error) {
+	entry, err := scanBlocklistEntry(conn(ctx, r.pool).QueryRow(ctx, blocklistSelect+" WHERE b.id = $1", entryID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBlocklistEntryNotFound
+	}
+	return entry, err
+}
+
+// Remove deactivates an entry. The row is kept so the blocklist history stays auditable.
+func (r *BlocklistRepository) Remove(ctx context.Context, entryID, moderatorID string) error {
+	query := `
+		UPDATE blocklist_entries
+		SET removed_at = NOW(), removed_by = NULLIF($2, '')::uuid
+		WHERE id = $1 AND removed_at IS NULL
+	`
+
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, query, entryID, moderatorID)
+	if err != nil {
+		return fmt.Errorf("failed to remove blocklist entry: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrBlocklistEntryNotFound
+	}
+	return nil
+}
+
+func scanBlocklistEntry(row pgx.Row) (*models.BlocklistEntry, error) {
+	var entry models.BlocklistEntry
+	err := row.Scan(
+		&entry.ID,
+		&entry.Kind,
+		&entry.Value,
+		&entry.Action,
+		&entry.Reason,
+		&entry.CreatedBy,
+		&entry.CreatedByName,
+		&entry.CreatedAt,
+		&entry.ExpiresAt,
+		&entry.RemovedBy,
+		&entry.RemovedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan blocklist entry: %w", err)
+	}
+	return &entry, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const botHeartbeatColumns = `version, last_update_at, received_at, silent_alerted_at`
+
+// BotHeartbeatRepository keeps the last heartbeat of the Telegram bot
+type BotHeartbeatRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBotHeartbeatRepository creates a new bot heartbeat repository
+func NewBotHeartbeatRepository(pool *pgxpool.Pool) *BotHeartbeatRepository {
+	return &BotHeartbeatRepository{
+		pool: pool,
+	}
+}
+
+// Get returns the last heartbeat, or nil when the bot never reported
+func (r *BotHeartbeatRepository) Get(ctx context.Context) (*models.BotHeartbeat, error) {
+	heartbeat, err := scanBotHeartbeat(conn(ctx, r.pool).QueryRow(ctx, `SELECT `+botHeartbeatColumns+` FROM bot_heartbeat`))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bot heartbeat: %w", err)
+	}
+	return heartbeat, nil
+}
+
+// Record stores a heartbeat and clears the silent alert. It returns the stored heartbeat and
+// when the alert it cleared was raised, nil when the bot wasn't reported silent.
+func (r *BotHeartbeatRepository) Record(ctx context.Context, req *models.BotHeartbeatRequest) (*models.BotHeartbeat, *time.Time, error) {
+	var heartbeat models.BotHeartbeat
+	var alertedAt *time.Time
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH previous AS (SELECT silent_alerted_at FROM bot_heartbeat)
+		INSERT INTO bot_heartbeat (id, version, last_update_at, received_at) VALUES (TRUE, $1, $2, NOW())
+		ON CONFLICT (id) DO UPDATE
+		SET version = EXCLUDED.version, last_update_at = EXCLUDED.last_update_at,
+			received_at = EXCLUDED.received_at, silent_alerted_at = NULL
+		RETURNING version, last_update_at, received_at, (SELECT silent_alerted_at FROM previous)
+	`, req.Version, req.LastUpdateAt).Scan(&heartbeat.Version, &heartbeat.LastUpdateAt, &heartbeat.ReceivedAt, &alertedAt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record bot heartbeat: %w", err)
+	}
+	return &heartbeat, alertedAt, nil
+}
+
+// MarkSilent flags the bot silent when its last heartbeat is older than silentBefore and it isn't
+// flagged yet. It returns the heartbeat when this call flagged it, so only one replica alerts.
+func (r *BotHeartbeatRepository) MarkSilent(ctx context.Context, silentBefore time.Time) (*models.BotHeartbeat, error) {
+	heartbeat, err := scanBotHeartbeat(conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE bot_heartbeat SET silent_alerted_at = NOW()
+		WHERE silent_alerted_at IS NULL AND received_at < $1
+		RETURNING `+botHeartbeatColumns, silentBefore))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark bot silent: %w", err)
+	}
+	return heartbeat, nil
+}
+
+func scanBotHeartbeat(row pgx.Row) (*models.BotHeartbeat, error) {
+	var heartbeat models.BotHeartbeat
+	if err := row.Scan(&heartbeat.Version, &heartbeat.LastUpdateAt, &heartbeat.ReceivedAt, &heartbeat.SilentAlertedAt); err != nil {
+		return nil, err
+	}
+	return &heartbeat, nil
+}
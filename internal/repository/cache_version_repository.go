@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CacheVersionRepository stores the shared cache versions and the versions replicas hold
+type CacheVersionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCacheVersionRepository creates a new cache version repository
+func NewCacheVersionRepository(pool *pgxpool.Pool) *CacheVersionRepository {
+	return &CacheVersionRepository{
+		pool: pool,
+	}
+}
+
+// Current returns the shared version of the cache
+func (r *CacheVersionRepository) Current(ctx context.Context, name string) (int64, error) {
+	var version int64
+	if err := conn(ctx, r.pool).QueryRow(ctx, `SELECT version FROM cache_versions WHERE name = $1`, name).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to get cache version: %w", err)
+	}
+	return version, nil
+}
+
+// Bump advances the shared version of the cache and returns the new one
+func (r *CacheVersionRepository) Bump(ctx context.Context, name string) (int64, error) {
+	var version int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO cache_versions (name) VALUES ($1)
+		ON CONFLICT (name) DO UPDATE SET version = cache_versions.version + 1, bumped_at = NOW()
+		RETURNING version
+	`, name).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to bump cache version: %w", err)
+	}
+	return version, nil
+}
+
+// ReportReplica records the version a replica holds
+func (r *CacheVersionRepository) ReportReplica(ctx context.Context, name string, replica models.CacheReplica) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO cache_replicas (replica, name, region, version, loaded_at, seen_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (replica, name) DO UPDATE
+		SET region = EXCLUDED.region, version = EXCLUDED.version, loaded_at = EXCLUDED.loaded_at, seen_at = NOW()
+	`, replica.Replica, name, replica.Region, replica.Version, replica.LoadedAt)
+	if err != nil {
+		return fmt.Errorf("failed to report cache replica: %w", err)
+	}
+	return nil
+}
+
+// ListReplicas returns the replicas that reported the cache since the given time, by region and name
+func (r *CacheVersionRepository) ListReplicas(ctx context.Context, name string, since time.Time) ([]models.CacheReplica, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT replica, region, version, loaded_at, seen_at
+		FROM cache_replicas
+		WHERE name = $1 AND seen_at >= $2
+		ORDER BY region, replica
+	`, name, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache replicas: %w", err)
+	}
+	defer rows.Close()
+
+	replicas := []models.CacheReplica{}
+	for rows.Next() {
+		var replica models.CacheReplica
+		if err := rows.Scan(&replica.Replica, &replica.Region, &replica.Version, &replica.LoadedAt, &replica.SeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan cache replica: %w", err)
+		}
+		replicas = append(replicas, replica)
+	}
+	return replicas, rows.Err()
+}
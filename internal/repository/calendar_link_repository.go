@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CalendarLinkRepository stores the results of mentors' calendar link checks
+type CalendarLinkRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCalendarLinkRepository creates a new calendar link repository
+func NewCalendarLinkRepository(pool *pgxpool.Pool) *CalendarLinkRepository {
+	return &CalendarLinkRepository{
+		pool: pool,
+	}
+}
+
+// ListLinks returns the calendar links of active mentors, least recently checked first
+func (r *CalendarLinkRepository) ListLinks(ctx context.Context) ([]*models.CalendarLink, error) {
+	query := `
+		SELECT id::text, slug, calendar_url, calendar_broken_at IS NOT NULL
+		FROM mentors
+		WHERE status = 'active' AND deleted_at IS NULL AND COALESCE(calendar_url, '') <> ''
+		ORDER BY calendar_checked_at NULLS FIRST, id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calendar links: %w", err)
+	}
+	defer rows.Close()
+
+	links := []*models.CalendarLink{}
+	for rows.Next() {
+		link := &models.CalendarLink{}
+		if err := rows.Scan(&link.MentorID, &link.Slug, &link.URL, &link.Broken); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar link: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate calendar links: %w", err)
+	}
+	return links, nil
+}
+
+// RecordCheck stores the outcome of a check of the link. A success clears the failures and the
+// broken mark; the failure that reaches threshold marks the link broken. The check is dropped
+// when the mentor changed the link in the meantime (nil result).
+func (r *CalendarLinkRepository) RecordCheck(ctx context.Context, link *models.CalendarLink, ok bool, threshold int) (*models.CalendarCheckResult, error) {
+	query := `
+		WITH previous AS (
+			SELECT id, calendar_broken_at IS NOT NULL AS was_broken
+			FROM mentors
+			WHERE id = $1 AND calendar_url = $2
+			FOR UPDATE
+		)
+		UPDATE mentors m SET
+			calendar_checked_at = now(),
+			calendar_check_failures = CASE WHEN $3 THEN 0 ELSE m.calendar_check_failures + 1 END,
+			calendar_broken_at = CASE
+				WHEN $3 THEN NULL
+				WHEN m.calendar_broken_at IS NULL AND m.calendar_check_failures + 1 >= $4 THEN now()
+				ELSE m.calendar_broken_at
+			END
+		FROM previous
+		WHERE m.id = previous.id
+		RETURNING m.calendar_check_failures, previous.was_broken, m.calendar_broken_at IS NOT NULL
+	`
+
+	var wasBroken, isBroken bool
+	result := &models.CalendarCheckResult{}
+	err := conn(ctx, r.pool).QueryRow(ctx, query, link.MentorID, link.URL, ok, threshold).
+		Scan(&result.Failures, &wasBroken, &isBroken)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to record calendar check: %w", err)
+	}
+	result.BecameBroken = !wasBroken && isBroken
+	result.Recovered = wasBroken && !isBroken
+	return result, nil
+}
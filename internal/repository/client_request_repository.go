@@ -2,12 +2,33 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/db"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrQuarantinedRequestNotFound is returned when a request is not waiting in quarantine
+var ErrQuarantinedRequestNotFound = errors.New("quarantined request not found")
+
+// mentorVisibleCondition hides requests held back by shadow antispam rules
+const mentorVisibleCondition = `COALESCE(cr.quarantine_status, 'released') = 'released'`
+
+// requestsByMentorQuery lists a mentor's requests for the mentor dashboard, a hot path
+var requestsByMentorQuery = db.NamedQuery("requests_by_mentor", `
+		SELECT cr.id, cr.mentor_id, cr.email, cr.name, cr.telegram, cr.description,
+			cr.level, cr.status, cr.created_at, cr.updated_at, cr.status_changed_at,
+			cr.scheduled_at, cr.decline_reason, cr.decline_comment,
+			r.mentor_review
+		FROM client_requests cr
+		LEFT JOIN reviews r ON r.client_request_id = cr.id
+		WHERE cr.mentor_id = $1 AND cr.status = ANY($2) AND `+mentorVisibleCondition+`
+		ORDER BY cr.created_at ASC
+	`)
+
 // ClientRequestRepository handles client request data access
 type ClientRequestRepository struct {
 	pool *pgxpool.Pool
@@ -24,8 +45,8 @@ func NewClientRequestRepository(pool *pgxpool.Pool) *ClientRequestRepository {
 // Returns: requestID (UUID), error
 func (r *ClientRequestRepository) Create(ctx context.Context, req *models.ClientRequest) (string, error) {
 	query := `
-		INSERT INTO client_requests (mentor_id, email, name, telegram, description, level, status)
-		VALUES ($1, $2, $3, $4, $5, $6, 'pending')
+		INSERT INTO client_requests (mentor_id, email, name, telegram, description, level, status, flag_reason, quarantine_status)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', NULLIF($7, ''), CASE WHEN $8 THEN 'quarantined' END)
 		RETURNING id
 	`
 
@@ -37,6 +58,8 @@ func (r *ClientRequestRepository) Create(ctx context.Context, req *models.Client
 		req.Telegram,
 		req.Description,
 		req.Level,
+		req.FlagReason,
+		req.Quarantined,
 	).Scan(&requestID)
 
 	if err != nil {
@@ -46,26 +69,16 @@ func (r *ClientRequestRepository) Create(ctx context.Context, req *models.Client
 	return requestID, nil
 }
 
-// GetByMentor retrieves all client requests for a mentor filtered by statuses
+// GetByMentor retrieves all client requests for a mentor filtered by statuses.
+// Quarantined and discarded requests are never shown to the mentor.
 func (r *ClientRequestRepository) GetByMentor(ctx context.Context, mentorId string, statuses []models.RequestStatus) ([]*models.MentorClientRequest, error) {
-	query := `
-		SELECT cr.id, cr.mentor_id, cr.email, cr.name, cr.telegram, cr.description,
-			cr.level, cr.status, cr.created_at, cr.updated_at, cr.status_changed_at,
-			cr.scheduled_at, cr.decline_reason, cr.decline_comment,
-			r.mentor_review
-		FROM client_requests cr
-		LEFT JOIN reviews r ON r.client_request_id = cr.id
-		WHERE cr.mentor_id = $1 AND cr.status = ANY($2)
-		ORDER BY cr.created_at ASC
-	`
-
 	// Convert statuses to strings for PostgreSQL array
 	statusStrs := make([]string, len(statuses))
 	for i, s := range statuses {
 		statusStrs[i] = string(s)
 	}
 
-	rows, err := r.pool.Query(ctx, query, mentorId, statusStrs)
+	rows, err := r.pool.Query(ctx, requestsByMentorQuery, mentorId, statusStrs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get client requests: %w", err)
 	}
@@ -73,7 +86,7 @@ func (r *ClientRequestRepository) GetByMentor(ctx context.Context, mentorId stri
 	return models.ScanClientRequests(rows)
 }
 
-// GetByID retrieves a single client request by ID
+// GetByID retrieves a single client request by ID, unless it is held in quarantine
 func (r *ClientRequestRepository) GetByID(ctx context.Context, id string) (*models.MentorClientRequest, error) {
 	query := `
 		SELECT cr.id, cr.mentor_id, cr.email, cr.name, cr.telegram, cr.description,
@@ -82,7 +95,7 @@ func (r *ClientRequestRepository) GetByID(ctx context.Context, id string) (*mode
 			r.mentor_review
 		FROM client_requests cr
 		LEFT JOIN reviews r ON r.client_request_id = cr.id
-		WHERE cr.id = $1
+		WHERE cr.id = $1 AND ` + mentorVisibleCondition + `
 	`
 
 	row := r.pool.QueryRow(ctx, query, id)
@@ -105,6 +118,22 @@ func (r *ClientRequestRepository) UpdateStatus(ctx context.Context, id string, s
 	return nil
 }
 
+// UpdateScheduledAt sets the session time of a client request
+func (r *ClientRequestRepository) UpdateScheduledAt(ctx context.Context, id string, scheduledAt time.Time) error {
+	query := `
+		UPDATE client_requests
+		SET scheduled_at = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	_, err := r.pool.Exec(ctx, query, scheduledAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled time: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateDecline updates a client request with decline info
 func (r *ClientRequestRepository) UpdateDecline(ctx context.Context, id string, reason models.DeclineReason, comment string) error {
 	query := `
@@ -121,3 +150,142 @@ func (r *ClientRequestRepository) UpdateDecline(ctx context.Context, id string,
 
 	return nil
 }
+
+// ListCreatedBetween returns requests that reached their mentor, created in [since, until),
+// oldest first and after the cursor. Used by event backfills.
+func (r *ClientRequestRepository) ListCreatedBetween(ctx context.Context, since, until time.Time, after *models.HistoryCursor, limit int) ([]*models.RequestHistoryEntry, error) {
+	return r.listHistory(ctx, "created_at", since, until, after, limit)
+}
+
+// ListStatusChangedBetween returns requests whose current status was set in [since, until),
+// oldest first and after the cursor. Only the latest change of each request is stored.
+func (r *ClientRequestRepository) ListStatusChangedBetween(ctx context.Context, since, until time.Time, after *models.HistoryCursor, limit int) ([]*models.RequestHistoryEntry, error) {
+	return r.listHistory(ctx, "status_changed_at", since, until, after, limit)
+}
+
+// listHistory pages through requests by a timestamp column and ID (keyset pagination)
+func (r *ClientRequestRepository) listHistory(ctx context.Context, column string, since, until time.Time, after *models.HistoryCursor, limit int) ([]*models.RequestHistoryEntry, error) {
+	cursor := models.HistoryCursor{At: since, ID: "00000000-0000-0000-0000-000000000000"}
+	if after != nil {
+		cursor = *after
+	}
+
+	query := `
+		SELECT cr.id, cr.mentor_id, COALESCE(cr.level, ''), cr.status, COALESCE(cr.decline_reason, ''),
+			cr.created_at, cr.status_changed_at
+		FROM client_requests cr
+		WHERE cr.mentor_id IS NOT NULL AND ` + mentorVisibleCondition + `
+			AND cr.` + column + ` >= $1 AND cr.` + column + ` < $2
+			AND (cr.` + column + `, cr.id) > ($3::timestamptz, $4::uuid)
+		ORDER BY cr.` + column + `, cr.id
+		LIMIT $5
+	`
+
+	rows, err := r.pool.Query(ctx, query, since, until, cursor.At, cursor.ID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.RequestHistoryEntry{}
+	for rows.Next() {
+		var entry models.RequestHistoryEntry
+		if err := rows.Scan(&entry.ID, &entry.MentorID, &entry.Level, &entry.Status, &entry.DeclineReason,
+			&entry.CreatedAt, &entry.StatusChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan request history: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, rows.Err()
+}
+
+// ListQuarantined returns requests waiting for a quarantine verdict, oldest first
+func (r *ClientRequestRepository) ListQuarantined(ctx context.Context) ([]*models.QuarantinedRequest, error) {
+	query := `
+		SELECT cr.id, cr.mentor_id, COALESCE(m.slug, ''), COALESCE(m.name, ''), cr.email, cr.name,
+			cr.telegram, cr.description, COALESCE(cr.level, ''), COALESCE(cr.flag_reason, ''),
+			cr.quarantine_status, COALESCE(cr.quarantine_reviewed_by::text, ''), cr.quarantine_reviewed_at, cr.created_at
+		FROM client_requests cr
+		LEFT JOIN mentors m ON m.id = cr.mentor_id
+		WHERE cr.quarantine_status = 'quarantined'
+		ORDER BY cr.created_at
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query quarantined requests: %w", err)
+	}
+	defer rows.Close()
+
+	requests := []*models.QuarantinedRequest{}
+	for rows.Next() {
+		var q models.QuarantinedRequest
+		if err := rows.Scan(
+			&q.ID, &q.MentorID, &q.MentorSlug, &q.MentorName, &q.Email, &q.Name,
+			&q.Telegram, &q.Details, &q.Level, &q.FlagReason,
+			&q.QuarantineStatus, &q.ReviewedBy, &q.ReviewedAt, &q.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan quarantined request: %w", err)
+		}
+		requests = append(requests, &q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate quarantined requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// SetQuarantineStatus records the verdict on a quarantined request
+func (r *ClientRequestRepository) SetQuarantineStatus(ctx context.Context, id, status, moderatorID string) error {
+	query := `
+		UPDATE client_requests
+		SET quarantine_status = $1, quarantine_reviewed_by = NULLIF($2, '')::uuid,
+			quarantine_reviewed_at = NOW(), updated_at = NOW()
+		WHERE id = $3 AND quarantine_status = 'quarantined'
+	`
+
+	commandTag, err := r.pool.Exec(ctx, query, status, moderatorID, id)
+	if err != nil {
+		return fmt.Errorf("failed to update quarantine status: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrQuarantinedRequestNotFound
+	}
+
+	return nil
+}
+
+// ListScheduledByMentor returns the mentor's requests with a session scheduled after since
+func (r *ClientRequestRepository) ListScheduledByMentor(ctx context.Context, mentorID string, since time.Time) ([]*models.ScheduledSession, error) {
+	query := `
+		SELECT cr.id, COALESCE(cr.name, ''), COALESCE(cr.telegram, ''), COALESCE(cr.level, ''),
+			cr.status, cr.scheduled_at, cr.schedule_sequence, cr.updated_at
+		FROM client_requests cr
+		WHERE cr.mentor_id = $1 AND cr.scheduled_at >= $2 AND ` + mentorVisibleCondition + `
+		ORDER BY cr.scheduled_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, mentorID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scheduled sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*models.ScheduledSession{}
+	for rows.Next() {
+		var session models.ScheduledSession
+		if err := rows.Scan(
+			&session.RequestID, &session.MenteeName, &session.MenteeTelegram, &session.Level,
+			&session.Status, &session.ScheduledAt, &session.Sequence, &session.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled session: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scheduled sessions: %w", err)
+	}
+
+	return sessions, nil
+}
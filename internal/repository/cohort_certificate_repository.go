@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrCertificateNotFound is returned when a certificate doesn't exist
+	ErrCertificateNotFound = errors.New("certificate not found")
+	// ErrCertificateExists is returned when the participant already has a certificate
+	ErrCertificateExists = errors.New("certificate already issued")
+)
+
+const cohortCertificateSelect = `
+	SELECT id, cohort_id, participant_id, recipient_name, cohort_title, completed_checkpoints,
+		signature, pdf_url, issued_by, issued_at
+	FROM cohort_certificates
+`
+
+// CohortCertificateRepository handles cohort completion certificate data access
+type CohortCertificateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCohortCertificateRepository creates a new cohort certificate repository
+func NewCohortCertificateRepository(pool *pgxpool.Pool) *CohortCertificateRepository {
+	return &CohortCertificateRepository{
+		pool: pool,
+	}
+}
+
+// Create stores an issued certificate
+func (r *CohortCertificateRepository) Create(ctx context.Context, cert *models.CohortCertificate) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO cohort_certificates (id, cohort_id, participant_id, recipient_name, cohort_title,
+			completed_checkpoints, signature, pdf_url, issued_by, issued_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, cert.ID, cert.CohortID, cert.ParticipantID, cert.RecipientName, cert.CohortTitle,
+		cert.CompletedCheckpoints, cert.Signature, cert.PDFURL, cert.IssuedBy, cert.IssuedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrCertificateExists
+		}
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a certificate
+func (r *CohortCertificateRepository) GetByID(ctx context.Context, id string) (*models.CohortCertificate, error) {
+	cert, err := scanCohortCertificate(conn(ctx, r.pool).QueryRow(ctx, cohortCertificateSelect+" WHERE id::text = $1", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCertificateNotFound
+	}
+	return cert, err
+}
+
+// ListByCohort returns the certificates of a cohort keyed by participant ID
+func (r *CohortCertificateRepository) ListByCohort(ctx context.Context, cohortID string) (map[string]*models.CohortCertificate, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, cohortCertificateSelect+" WHERE cohort_id = $1", cohortID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificates: %w", err)
+	}
+	defer rows.Close()
+
+	certs := map[string]*models.CohortCertificate{}
+	for rows.Next() {
+		cert, scanErr := scanCohortCertificate(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		certs[cert.ParticipantID] = cert
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate certificates: %w", err)
+	}
+	return certs, nil
+}
+
+func scanCohortCertificate(row pgx.Row) (*models.CohortCertificate, error) {
+	var c models.CohortCertificate
+	err := row.Scan(&c.ID, &c.CohortID, &c.ParticipantID, &c.RecipientName, &c.CohortTitle,
+		&c.CompletedCheckpoints, &c.Signature, &c.PDFURL, &c.IssuedBy, &c.IssuedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan certificate: %w", err)
+	}
+	return &c, nil
+}
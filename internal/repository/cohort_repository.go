@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrCohortNotFound is returned when a cohort doesn't exist
+	ErrCohortNotFound = errors.New("cohort not found")
+	// ErrCohortParticipantNotFound is returned when a participant doesn't exist in the cohort
+	ErrCohortParticipantNotFound = errors.New("cohort participant not found")
+	// ErrAlreadyEnrolled is returned when the email or mentor is already enrolled in the cohort
+	ErrAlreadyEnrolled = errors.New("already enrolled in this cohort")
+)
+
+const cohortSelect = `
+	SELECT c.id, c.title, c.description, c.starts_at, c.ends_at, c.status, c.mentee_capacity,
+		(SELECT COUNT(*) FROM cohort_participants p
+			WHERE p.cohort_id = c.id AND p.role = 'mentor' AND p.status <> 'withdrawn') AS mentor_count,
+		(SELECT COUNT(*) FROM cohort_participants p
+			WHERE p.cohort_id = c.id AND p.role = 'mentee' AND p.status <> 'withdrawn') AS mentee_count,
+		c.created_by, c.created_at, c.updated_at
+	FROM cohorts c
+`
+
+const cohortParticipantSelect = `
+	SELECT p.id, p.cohort_id, p.role, p.mentor_id, p.name, p.email, p.telegram, p.goal, p.status,
+		p.paired_with, p.created_at,
+		COALESCE((SELECT array_agg(pr.checkpoint_id::text ORDER BY pr.completed_at)
+			FROM cohort_progress pr WHERE pr.participant_id = p.id), '{}') AS completed_checkpoints
+	FROM cohort_participants p
+`
+
+// CohortRepository handles cohort, participant and progress data access
+type CohortRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCohortRepository creates a new cohort repository
+func NewCohortRepository(pool *pgxpool.Pool) *CohortRepository {
+	return &CohortRepository{
+		pool: pool,
+	}
+}
+
+// List returns cohorts with the given statuses (all when empty), newest first
+func (r *CohortRepository) List(ctx context.Context, statuses []string) ([]*models.Cohort, error) {
+	query := cohortSelect
+	args := []interface{}{}
+	if len(statuses) > 0 {
+		query += " WHERE c.status = ANY($1)"
+		args = append(args, statuses)
+	}
+	query += " ORDER BY c.starts_at DESC"
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cohorts: %w", err)
+	}
+	defer rows.Close()
+
+	cohorts := []*models.Cohort{}
+	for rows.Next() {
+		cohort, scanErr := scanCohort(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		cohorts = append(cohorts, cohort)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate cohorts: %w", err)
+	}
+
+	for _, cohort := range cohorts {
+		if cohort.Checkpoints, err = r.listCheckpoints(ctx, cohort.ID); err != nil {
+			return nil, err
+		}
+	}
+	return cohorts, nil
+}
+
+// GetByID returns a cohort with its checkpoints
+func (r *CohortRepository) GetByID(ctx context.Context, id string) (*models.Cohort, error) {
+	return r.get(ctx, cohortSelect+" WHERE c.id = $1", id)
+}
+
+// GetForEnrollment returns a cohort and locks it until the end of the transaction,
+// so concurrent enrollments can't exceed the mentee capacity
+func (r *CohortRepository) GetForEnrollment(ctx context.Context, id string) (*models.Cohort, error) {
+	return r.get(ctx, cohortSelect+" WHERE c.id = $1 FOR UPDATE OF c", id)
+}
+
+func (r *CohortRepository) get(ctx context.Context, query, id string) (*models.Cohort, error) {
+	cohort, err := scanCohort(conn(ctx, r.pool).QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCohortNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cohort.Checkpoints, err = r.listCheckpoints(ctx, cohort.ID); err != nil {
+		return nil, err
+	}
+	return cohort, nil
+}
+
+// Create inserts a cohort without checkpoints and returns its ID
+func (r *CohortRepository) Create(ctx context.Context, req *models.CohortRequest, createdBy string) (string, error) {
+	var id string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO cohorts (title, description, starts_at, ends_at, status, mentee_capacity, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, req.Title, req.Description, req.StartsAt, req.EndsAt, req.Status, req.MenteeCapacity, createdBy).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cohort: %w", err)
+	}
+	return id, nil
+}
+
+// Update replaces a cohort's fields, keeping its checkpoints
+func (r *CohortRepository) Update(ctx context.Context, id string, req *models.CohortRequest) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE cohorts
+		SET title = $2, description = $3, starts_at = $4, ends_at = $5, status = $6, mentee_capacity = $7
+		WHERE id = $1
+	`, id, req.Title, req.Description, req.StartsAt, req.EndsAt, req.Status, req.MenteeCapacity)
+	if err != nil {
+		return fmt.Errorf("failed to update cohort: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCohortNotFound
+	}
+	return nil
+}
+
+// ReplaceCheckpoints makes the cohort's checkpoints match the list, in its order.
+// Run it in a unit of work together with the cohort update.
+func (r *CohortRepository) ReplaceCheckpoints(ctx context.Context, cohortID string, checkpoints []models.CohortCheckpointRequest) error {
+	keep := []string{}
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ID != "" {
+			keep = append(keep, checkpoint.ID)
+		}
+	}
+	if _, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM cohort_checkpoints WHERE cohort_id = $1 AND NOT (id::text = ANY($2))`, cohortID, keep); err != nil {
+		return fmt.Errorf("failed to remove cohort checkpoints: %w", err)
+	}
+
+	for position, checkpoint := range checkpoints {
+		if checkpoint.ID == "" {
+			_, err := conn(ctx, r.pool).Exec(ctx, `
+				INSERT INTO cohort_checkpoints (cohort_id, title, due_at, position) VALUES ($1, $2, $3, $4)
+			`, cohortID, checkpoint.Title, checkpoint.DueAt, position)
+			if err != nil {
+				return fmt.Errorf("failed to create cohort checkpoint: %w", err)
+			}
+			continue
+		}
+
+		tag, err := conn(ctx, r.pool).Exec(ctx, `
+			UPDATE cohort_checkpoints SET title = $3, due_at = $4, position = $5
+			WHERE id::text = $1 AND cohort_id = $2
+		`, checkpoint.ID, cohortID, checkpoint.Title, checkpoint.DueAt, position)
+		if err != nil {
+			return fmt.Errorf("failed to update cohort checkpoint: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: checkpoint %s does not belong to the cohort", apperrors.ErrInvalidInput, checkpoint.ID)
+		}
+	}
+	return nil
+}
+
+// ListParticipants returns the cohort's participants, mentors first
+func (r *CohortRepository) ListParticipants(ctx context.Context, cohortID string) ([]*models.CohortParticipant, error) {
+	return r.queryParticipants(ctx,
+		cohortParticipantSelect+" WHERE p.cohort_id = $1 ORDER BY p.role DESC, p.created_at", cohortID)
+}
+
+// ListMentorParticipations returns the mentor's participant records across cohorts
+func (r *CohortRepository) ListMentorParticipations(ctx context.Context, mentorID string) ([]*models.CohortParticipant, error) {
+	return r.queryParticipants(ctx,
+		cohortParticipantSelect+" WHERE p.mentor_id = $1 ORDER BY p.created_at DESC", mentorID)
+}
+
+// GetParticipant returns a participant of the cohort
+func (r *CohortRepository) GetParticipant(ctx context.Context, cohortID, participantID string) (*models.CohortParticipant, error) {
+	participant, err := scanCohortParticipant(conn(ctx, r.pool).QueryRow(ctx,
+		cohortParticipantSelect+" WHERE p.cohort_id = $1 AND p.id::text = $2", cohortID, participantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCohortParticipantNotFound
+	}
+	return participant, err
+}
+
+// AddParticipant enrolls a mentor or mentee and returns the participant ID
+func (r *CohortRepository) AddParticipant(ctx context.Context, participant *models.CohortParticipant) (string, error) {
+	var id string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO cohort_participants (cohort_id, role, mentor_id, name, email, telegram, goal)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, participant.CohortID, participant.Role, participant.MentorID, participant.Name, participant.Email,
+		participant.Telegram, participant.Goal).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return "", ErrAlreadyEnrolled
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return "", fmt.Errorf("%w: cohort or mentor does not exist", apperrors.ErrInvalidInput)
+		}
+		return "", fmt.Errorf("failed to enroll cohort participant: %w", err)
+	}
+	return id, nil
+}
+
+// SetParticipantStatus changes a participant's status
+func (r *CohortRepository) SetParticipantStatus(ctx context.Context, cohortID, participantID, status string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx,
+		`UPDATE cohort_participants SET status = $3 WHERE cohort_id = $1 AND id::text = $2`,
+		cohortID, participantID, status)
+	if err != nil {
+		return fmt.Errorf("failed to update cohort participant: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCohortParticipantNotFound
+	}
+	return nil
+}
+
+// SetPair assigns the mentee to a mentor participant; nil unpairs the mentee
+func (r *CohortRepository) SetPair(ctx context.Context, cohortID, menteeID string, mentorParticipantID *string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE cohort_participants SET paired_with = $3
+		WHERE cohort_id = $1 AND id::text = $2 AND role = 'mentee'
+	`, cohortID, menteeID, mentorParticipantID)
+	if err != nil {
+		return fmt.Errorf("failed to pair cohort participants: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCohortParticipantNotFound
+	}
+	return nil
+}
+
+// UnpairMentees unpairs every mentee of the mentor participant
+func (r *CohortRepository) UnpairMentees(ctx context.Context, mentorParticipantID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx,
+		`UPDATE cohort_participants SET paired_with = NULL WHERE paired_with = $1`, mentorParticipantID)
+	if err != nil {
+		return fmt.Errorf("failed to unpair cohort mentees: %w", err)
+	}
+	return nil
+}
+
+// SetProgress records that the mentee reached the checkpoint. Recording it again updates the note.
+func (r *CohortRepository) SetProgress(ctx context.Context, participantID, checkpointID, note, recordedBy string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO cohort_progress (participant_id, checkpoint_id, note, recorded_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (participant_id, checkpoint_id) DO UPDATE SET note = EXCLUDED.note, recorded_by = EXCLUDED.recorded_by
+	`, participantID, checkpointID, note, recordedBy)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("%w: checkpoint %s does not exist", apperrors.ErrInvalidInput, checkpointID)
+		}
+		return fmt.Errorf("failed to record cohort progress: %w", err)
+	}
+	return nil
+}
+
+// DeleteProgress removes a reached checkpoint of the mentee
+func (r *CohortRepository) DeleteProgress(ctx context.Context, participantID, checkpointID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM cohort_progress WHERE participant_id = $1 AND checkpoint_id::text = $2`, participantID, checkpointID)
+	if err != nil {
+		return fmt.Errorf("failed to remove cohort progress: %w", err)
+	}
+	return nil
+}
+
+func (r *CohortRepository) listCheckpoints(ctx context.Context, cohortID string) ([]models.CohortCheckpoint, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx,
+		`SELECT id, title, due_at, position FROM cohort_checkpoints WHERE cohort_id = $1 ORDER BY position, due_at`, cohortID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cohort checkpoints: %w", err)
+	}
+	defer rows.Close()
+
+	checkpoints := []models.CohortCheckpoint{}
+	for rows.Next() {
+		var checkpoint models.CohortCheckpoint
+		if err := rows.Scan(&checkpoint.ID, &checkpoint.Title, &checkpoint.DueAt, &checkpoint.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan cohort checkpoint: %w", err)
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate cohort checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+func (r *CohortRepository) queryParticipants(ctx context.Context, query string, args ...interface{}) ([]*models.CohortParticipant, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cohort participants: %w", err)
+	}
+	defer rows.Close()
+
+	participants := []*models.CohortParticipant{}
+	for rows.Next() {
+		participant, scanErr := scanCohortParticipant(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		participants = append(participants, participant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate cohort participants: %w", err)
+	}
+	return participants, nil
+}
+
+func scanCohort(row pgx.Row) (*models.Cohort, error) {
+	var c models.Cohort
+	err := row.Scan(&c.ID, &c.Title, &c.Description, &c.StartsAt, &c.EndsAt, &c.Status, &c.MenteeCapacity,
+		&c.MentorCount, &c.MenteeCount, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan cohort: %w", err)
+	}
+	return &c, nil
+}
+
+func scanCohortParticipant(row pgx.Row) (*models.CohortParticipant, error) {
+	var p models.CohortParticipant
+	err := row.Scan(&p.ID, &p.CohortID, &p.Role, &p.MentorID, &p.Name, &p.Email, &p.Telegram, &p.Goal, &p.Status,
+		&p.PairedWith, &p.CreatedAt, &p.CompletedCheckpoints)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan cohort participant: %w", err)
+	}
+	return &p, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrCommunityEventNotFound is returned when the event doesn't exist
+var ErrCommunityEventNotFound = errors.New("community event not found")
+
+const communityEventSelect = `
+	SELECT id, title, description, starts_at, ends_at, location, url, status, sequence,
+		created_by, created_at, updated_at
+	FROM community_events
+`
+
+// CommunityEventRepository handles community event data access
+type CommunityEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCommunityEventRepository creates a new community event repository
+func NewCommunityEventRepository(pool *pgxpool.Pool) *CommunityEventRepository {
+	return &CommunityEventRepository{
+		pool: pool,
+	}
+}
+
+// List returns events matching the filter, soonest first
+func (r *CommunityEventRepository) List(ctx context.Context, filter models.CommunityEventFilter) ([]*models.CommunityEvent, error) {
+	var conditions []string
+	var args []interface{}
+	if len(filter.Statuses) > 0 {
+		args = append(args, filter.Statuses)
+		conditions = append(conditions, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+	if !filter.EndsAfter.IsZero() {
+		args = append(args, filter.EndsAfter)
+		conditions = append(conditions, fmt.Sprintf("ends_at > $%d", len(args)))
+	}
+
+	query := communityEventSelect
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY starts_at"
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query community events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.CommunityEvent{}
+	for rows.Next() {
+		event, scanErr := scanCommunityEvent(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate community events: %w", err)
+	}
+	return events, nil
+}
+
+// GetByID returns an event
+func (r *CommunityEventRepository) GetByID(ctx context.Context, id string) (*models.CommunityEvent, error) {
+	event, err := scanCommunityEvent(conn(ctx, r.pool).QueryRow(ctx, communityEventSelect+" WHERE id = $1", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCommunityEventNotFound
+	}
+	return event, err
+}
+
+// Create inserts an event and returns it
+func (r *CommunityEventRepository) Create(ctx context.Context, req *models.CommunityEventRequest, createdBy string) (*models.CommunityEvent, error) {
+	var id string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO community_events (title, description, starts_at, ends_at, location, url, status, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, req.Title, req.Description, req.StartsAt, req.EndsAt, req.Location, req.URL, req.Status, createdBy).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create community event: %w", err)
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Update replaces an event and bumps its sequence
+func (r *CommunityEventRepository) Update(ctx context.Context, id string, req *models.CommunityEventRequest) (*models.CommunityEvent, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE community_events
+		SET title = $2, description = $3, starts_at = $4, ends_at = $5, location = $6, url = $7, status = $8,
+			sequence = sequence + 1
+		WHERE id = $1
+	`, id, req.Title, req.Description, req.StartsAt, req.EndsAt, req.Location, req.URL, req.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update community event: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrCommunityEventNotFound
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Delete removes an event
+func (r *CommunityEventRepository) Delete(ctx context.Context, id string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM community_events WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete community event: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCommunityEventNotFound
+	}
+	return nil
+}
+
+func scanCommunityEvent(row pgx.Row) (*models.CommunityEvent, error) {
+	var e models.CommunityEvent
+	err := row.Scan(&e.ID, &e.Title, &e.Description, &e.StartsAt, &e.EndsAt, &e.Location, &e.URL, &e.Status,
+		&e.Sequence, &e.CreatedBy, &e.CreatedAt, &e.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan community event: %w", err)
+	}
+	return &e, nil
+}
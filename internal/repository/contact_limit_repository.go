@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ContactSubmissionCount is the submissions of one subject in the current and previous window
+type ContactSubmissionCount struct {
+	Kind             string
+	Subject          string
+	Current          int
+	Previous         int
+	LastSubmissionAt time.Time
+}
+
+// ContactLimitRepository keeps the contact form submission counters
+type ContactLimitRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewContactLimitRepository creates a new contact limit repository
+func NewContactLimitRepository(pool *pgxpool.Pool) *ContactLimitRepository {
+	return &ContactLimitRepository{
+		pool: pool,
+	}
+}
+
+// Record counts a submission of subject in the window starting at windowStart and returns its
+// submissions in that window, including this one, and in the window starting at previousStart
+func (r *ContactLimitRepository) Record(ctx context.Context, kind, subject string, windowStart, previousStart time.Time) (int, int, error) {
+	var current, previous int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH counted AS (
+			INSERT INTO contact_submission_counters (kind, subject, window_start, submissions)
+			VALUES ($1, $2, $3, 1)
+			ON CONFLICT (kind, subject, window_start) DO UPDATE
+			SET submissions = contact_submission_counters.submissions + 1, last_submission_at = NOW()
+			RETURNING submissions
+		)
+		SELECT (SELECT submissions FROM counted),
+			COALESCE((SELECT submissions FROM contact_submission_counters
+				WHERE kind = $1 AND subject = $2 AND window_start = $4), 0)
+	`, kind, subject, windowStart, previousStart).Scan(&current, &previous)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count contact submission: %w", err)
+	}
+	return current, previous, nil
+}
+
+// List returns the subjects with submissions since previousStart, busiest first. Empty kind or
+// subject match any.
+func (r *ContactLimitRepository) List(ctx context.Context, kind, subject string, windowStart, previousStart time.Time, limit int) ([]*ContactSubmissionCount, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT kind, subject,
+			COALESCE(SUM(submissions) FILTER (WHERE window_start = $1), 0),
+			COALESCE(SUM(submissions) FILTER (WHERE window_start = $2), 0),
+			MAX(last_submission_at)
+		FROM contact_submission_counters
+		WHERE window_start >= $2 AND ($3 = '' OR kind = $3) AND ($4 = '' OR subject = $4)
+		GROUP BY kind, subject
+		ORDER BY 3 DESC, 4 DESC, kind, subject
+		LIMIT $5
+	`, windowStart, previousStart, kind, subject, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact submission counters: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]*ContactSubmissionCount, 0)
+	for rows.Next() {
+		var count ContactSubmissionCount
+		if err := rows.Scan(&count.Kind, &count.Subject, &count.Current, &count.Previous, &count.LastSubmissionAt); err != nil {
+			return nil, fmt.Errorf("failed to scan contact submission counter: %w", err)
+		}
+		counts = append(counts, &count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list contact submission counters: %w", err)
+	}
+	return counts, nil
+}
+
+// Reset deletes the counters of subject and reports whether it had any
+func (r *ContactLimitRepository) Reset(ctx context.Context, kind, subject string) (bool, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM contact_submission_counters WHERE kind = $1 AND subject = $2`, kind, subject)
+	if err != nil {
+		return false, fmt.Errorf("failed to reset contact submission counters: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteBefore deletes the counters of windows that started before cutoff
+func (r *ContactLimitRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM contact_submission_counters WHERE window_start < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired contact submission counters: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
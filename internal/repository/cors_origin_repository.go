@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrCORSOriginNotFound is returned when an origin is not in cors_origins
+var ErrCORSOriginNotFound = errors.New("CORS origin not found")
+
+const corsOriginSelect = `
+	SELECT origin, allowed_methods, allowed_headers, allow_credentials, COALESCE(note, ''),
+		COALESCE(updated_by::text, ''), created_at, updated_at
+	FROM cors_origins
+`
+
+// CORSOriginRepository handles the admin-managed CORS origins
+type CORSOriginRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCORSOriginRepository creates a new CORS origin repository
+func NewCORSOriginRepository(pool *pgxpool.Pool) *CORSOriginRepository {
+	return &CORSOriginRepository{
+		pool: pool,
+	}
+}
+
+// List returns all origins ordered by origin
+func (r *CORSOriginRepository) List(ctx context.Context) ([]*models.CORSOrigin, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, corsOriginSelect+" ORDER BY origin")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query CORS origins: %w", err)
+	}
+	defer rows.Close()
+
+	origins := []*models.CORSOrigin{}
+	for rows.Next() {
+		origin, scanErr := scanCORSOrigin(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		origins = append(origins, origin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate CORS origins: %w", err)
+	}
+	return origins, nil
+}
+
+// Upsert adds the origin or replaces its rules and returns the stored row
+func (r *CORSOriginRepository) Upsert(ctx context.Context, origin *models.CORSOrigin) (*models.CORSOrigin, error) {
+	query := `
+		INSERT INTO cors_origins (origin, allowed_methods, allowed_headers, allow_credentials, note, updated_by)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, '')::uuid)
+		ON CONFLICT (origin) DO UPDATE
+		SET allowed_methods = EXCLUDED.allowed_methods, allowed_headers = EXCLUDED.allowed_headers,
+			allow_credentials = EXCLUDED.allow_credentials, note = EXCLUDED.note,
+			updated_by = EXCLUDED.updated_by, updated_at = NOW()
+	`
+	methods, headers := origin.AllowedMethods, origin.AllowedHeaders
+	if methods == nil {
+		methods = []string{}
+	}
+	if headers == nil {
+		headers = []string{}
+	}
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
+		origin.Origin, methods, headers, origin.AllowCredentials, origin.Note, origin.UpdatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save CORS origin: %w", err)
+	}
+
+	stored, err := scanCORSOrigin(conn(ctx, r.pool).QueryRow(ctx, corsOriginSelect+" WHERE origin = $1", origin.Origin))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCORSOriginNotFound
+	}
+	return stored, err
+}
+
+// Delete removes the origin
+func (r *CORSOriginRepository) Delete(ctx context.Context, origin string) error {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM cors_origins WHERE origin = $1`, origin)
+	if err != nil {
+		return fmt.Errorf("failed to delete CORS origin: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrCORSOriginNotFound
+	}
+	return nil
+}
+
+func scanCORSOrigin(row pgx.Row) (*models.CORSOrigin, error) {
+	var origin models.CORSOrigin
+	err := row.Scan(
+		&origin.Origin,
+		&origin.AllowedMethods,
+		&origin.AllowedHeaders,
+		&origin.AllowCredentials,
+		&origin.Note,
+		&origin.UpdatedBy,
+		&origin.CreatedAt,
+		&origin.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan CORS origin: %w", err)
+	}
+	return &origin, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// dualWriteTimeout bounds mirroring one mentor write to Airtable
+const dualWriteTimeout = 30 * time.Second
+
+// Operations mirrored by DualWriteMentorRepository, as labeled in getmentor_airtable_dual_writes_total
+const (
+	dualWriteProfile = "profile"
+	dualWriteStatus  = "status"
+	dualWritePicture = "picture"
+)
+
+// DualWriteMentorRepository writes mentors to PostgreSQL and mirrors profile saves, status
+// changes and picture updates to the legacy Airtable base during the transition. PostgreSQL
+// stays the primary store: its write runs first, in the caller's unit of work, and the mentor's
+// Airtable record is rewritten in the background once that commits. A rolled-back save never
+// reaches Airtable and a failed Airtable write doesn't undo the save; it is logged, counted and
+// caught up by the reverse sync. Nothing is mirrored once the cutover froze Airtable writes,
+// for mentors without an Airtable record, or for dry runs, which never commit.
+type DualWriteMentorRepository struct {
+	*MentorRepository
+	sync   *AirtableSyncRepository
+	client *airtable.Client
+	table  string
+}
+
+// NewDualWriteMentorRepository wraps mentors, mirroring its writes to the Mentors table of the client's base
+func NewDualWriteMentorRepository(mentors *MentorRepository, sync *AirtableSyncRepository, client *airtable.Client, table string) *DualWriteMentorRepository {
+	return &DualWriteMentorRepository{MentorRepository: mentors, sync: sync, client: client, table: table}
+}
+
+// UpdateIfUnmodified updates the mentor in PostgreSQL and mirrors the saved profile to Airtable
+func (r *DualWriteMentorRepository) UpdateIfUnmodified(ctx context.Context, mentorId string, updates map[string]interface{}, expected MentorPrecondition) error {
+	if err := r.MentorRepository.UpdateIfUnmodified(ctx, mentorId, updates, expected); err != nil {
+		return err
+	}
+	r.mirrorAfterCommit(ctx, dualWriteProfile, mentorId)
+	return nil
+}
+
+// SetMentorStatus changes the status in PostgreSQL and mirrors it to Airtable
+func (r *DualWriteMentorRepository) SetMentorStatus(ctx context.Context, mentorID, status string) error {
+	if err := r.MentorRepository.SetMentorStatus(ctx, mentorID, status); err != nil {
+		return err
+	}
+	r.mirrorAfterCommit(ctx, dualWriteStatus, mentorID)
+	return nil
+}
+
+// TouchUpdatedAt records a picture upload in PostgreSQL and rewrites the Airtable record, so
+// its last-modified time follows the new picture
+func (r *DualWriteMentorRepository) TouchUpdatedAt(ctx context.Context, mentorID string) error {
+	if err := r.MentorRepository.TouchUpdatedAt(ctx, mentorID); err != nil {
+		return err
+	}
+	r.mirrorAfterCommit(ctx, dualWritePicture, mentorID)
+	return nil
+}
+
+func (r *DualWriteMentorRepository) mirrorAfterCommit(ctx context.Context, operation, mentorID string) {
+	AfterCommit(ctx, func() {
+		go func() {
+			// The request and its transaction are over; the mirror reads the committed row
+			mirrorCtx, cancel := context.WithTimeout(context.Background(), dualWriteTimeout)
+			defer cancel()
+			r.mirror(mirrorCtx, operation, mentorID)
+		}()
+	})
+}
+
+// mirror rewrites the mentor's Airtable record with the fields the reverse sync pushes
+func (r *DualWriteMentorRepository) mirror(ctx context.Context, operation, mentorID string) {
+	outcome, err := r.push(ctx, mentorID)
+	metrics.AirtableDualWrites.WithLabelValues(operation, outcome).Inc()
+	if err != nil {
+		logger.Error("Failed to mirror mentor write to Airtable",
+			zap.Error(err),
+			zap.String("operation", operation),
+			zap.String("mentor_id", mentorID))
+	}
+}
+
+func (r *DualWriteMentorRepository) push(ctx context.Context, mentorID string) (string, error) {
+	state, err := r.sync.GetCutoverState(ctx)
+	if err != nil {
+		return "error", err
+	}
+	if state.WritesFrozen() {
+		return "skipped", nil
+	}
+
+	change, err := r.sync.GetMentorChange(ctx, mentorID)
+	if err != nil {
+		return "error", err
+	}
+	if change == nil {
+		return "skipped", nil
+	}
+	if err := r.client.Update(ctx, r.table, []airtable.Record{{ID: change.AirtableID, Fields: change.AirtableFields()}}); err != nil {
+		return "error", err
+	}
+	return "success", nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrEmailChangeNotFound is returned when no email change matches the token
+	ErrEmailChangeNotFound = errors.New("email change not found")
+	// ErrEmailChangeClosed is returned when the change was already confirmed or replaced
+	ErrEmailChangeClosed = errors.New("email change is no longer open")
+	// ErrEmailInUse is returned when another mentor already logs in with the address
+	ErrEmailInUse = errors.New("email is already used by another mentor")
+)
+
+const emailChangeSelect = `
+	SELECT id, mentor_id, COALESCE(old_email::text, ''), new_email::text, status, expires_at, created_at,
+		COALESCE(requested_by::text, ''), token
+	FROM mentor_email_changes
+`
+
+// EmailChangeRepository handles pending mentor email changes
+type EmailChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEmailChangeRepository creates a new email change repository
+func NewEmailChangeRepository(pool *pgxpool.Pool) *EmailChangeRepository {
+	return &EmailChangeRepository{pool: pool}
+}
+
+// Create stores a new pending change, superseding the mentor's open one, and records the
+// current address as old_email. Call it inside a unit of work so both writes land together.
+func (r *EmailChangeRepository) Create(ctx context.Context, change *models.EmailChange) (*models.EmailChange, error) {
+	db := conn(ctx, r.pool)
+
+	_, err := db.Exec(ctx, `
+		UPDATE mentor_email_changes
+		SET status = 'superseded', resolved_at = NOW()
+		WHERE mentor_id = $1 AND status = 'pending'
+	`, change.MentorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to supersede email change: %w", err)
+	}
+
+	var changeID string
+	err = db.QueryRow(ctx, `
+		INSERT INTO mentor_email_changes (mentor_id, old_email, new_email, token, requested_by, expires_at)
+		SELECT m.id, m.email, $2, $3, NULLIF($4, '')::uuid, $5
+		FROM mentors m
+		WHERE m.id = $1 AND m.deleted_at IS NULL
+		RETURNING id
+	`, change.MentorID, change.NewEmail, change.Token, change.RequestedBy, change.ExpiresAt).Scan(&changeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("mentor with ID %s not found", change.MentorID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email change: %w", err)
+	}
+
+	return scanEmailChange(db.QueryRow(ctx, emailChangeSelect+" WHERE id = $1", changeID))
+}
+
+// GetPendingByMentor returns the mentor's pending change, or nil if there is none
+func (r *EmailChangeRepository) GetPendingByMentor(ctx context.Context, mentorID string) (*models.EmailChange, error) {
+	change, err := scanEmailChange(conn(ctx, r.pool).QueryRow(ctx,
+		emailChangeSelect+" WHERE mentor_id = $1 AND status = 'pending'", mentorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return change, err
+}
+
+// GetByToken returns the change identified by the confirmation token
+func (r *EmailChangeRepository) GetByToken(ctx context.Context, token string) (*models.EmailChange, error) {
+	change, err := scanEmailChange(conn(ctx, r.pool).QueryRow(ctx, emailChangeSelect+" WHERE token = $1", token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEmailChangeNotFound
+	}
+	return change, err
+}
+
+// Confirm marks the change confirmed and switches the mentor to the new address. Outstanding
+// magic links were sent to the old address, so they are invalidated. Call it inside a unit of work.
+func (r *EmailChangeRepository) Confirm(ctx context.Context, change *models.EmailChange) error {
+	db := conn(ctx, r.pool)
+
+	commandTag, err := db.Exec(ctx, `
+		UPDATE mentor_email_changes
+		SET status = 'confirmed', resolved_at = NOW()
+		WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+	`, change.ID)
+	if err != nil {
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrEmailChangeClosed
+	}
+
+	_, err = db.Exec(ctx, `
+		UPDATE mentors
+		SET email = $2, login_token = NULL, login_token_expires_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1
+	`, change.MentorID, change.NewEmail)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrEmailInUse
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update mentor email: %w", err)
+	}
+	return nil
+}
+
+// IsEmailUsedByOtherMentor reports whether another mentor who can log in already has the address
+func (r *EmailChangeRepository) IsEmailUsedByOtherMentor(ctx context.Context, mentorID, email string) (bool, error) {
+	var used bool
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM mentors
+			WHERE email = $2 AND id <> $1 AND status IN ('active', 'inactive') AND merged_into IS NULL AND deleted_at IS NULL
+		)
+	`, mentorID, email).Scan(&used)
+	if err != nil {
+		return false, fmt.Errorf("failed to check email usage: %w", err)
+	}
+	return used, nil
+}
+
+func scanEmailChange(row pgx.Row) (*models.EmailChange, error) {
+	var change models.EmailChange
+	if err := row.Scan(
+		&change.ID, &change.MentorID, &change.OldEmail, &change.NewEmail, &change.Status,
+		&change.ExpiresAt, &change.CreatedAt, &change.RequestedBy, &change.Token,
+	); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
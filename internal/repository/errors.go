@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+)
+
+// VersionConflictError is returned by conditional updates when the record was changed
+// by someone else since the caller read it. It matches apperrors.ErrConflict.
+type VersionConflictError struct {
+	CurrentVersion   int
+	CurrentUpdatedAt time.Time
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("record is at version %d, modified at %s: %s",
+		e.CurrentVersion, e.CurrentUpdatedAt.Format(time.RFC3339Nano), apperrors.ErrConflict)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return apperrors.ErrConflict
+}
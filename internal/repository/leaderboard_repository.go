@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// LeaderboardRepository computes mentor rankings from completed requests and reviews
+type LeaderboardRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLeaderboardRepository creates a new leaderboard repository
+func NewLeaderboardRepository(pool *pgxpool.Pool) *LeaderboardRepository {
+	return &LeaderboardRepository{
+		pool: pool,
+	}
+}
+
+// FetchTopMentors ranks visible, opted-in mentors by requests completed since the given
+// moment (all time when since is nil), then by published reviews left on those requests.
+func (r *LeaderboardRepository) FetchTopMentors(ctx context.Context, since *time.Time, limit int) ([]*models.LeaderboardEntry, error) {
+	query := `
+		SELECT m.id, m.legacy_id, m.slug, m.name, COALESCE(m.job_title, ''), COALESCE(m.workplace, ''),
+			COUNT(cr.id) AS completed_sessions,
+			COUNT(rv.id) AS reviews
+		FROM mentors m
+		JOIN client_requests cr ON cr.mentor_id = m.id AND cr.status = 'done'
+		LEFT JOIN reviews rv ON rv.client_request_id = cr.id AND rv.status = 'approved'
+		WHERE m.leaderboard_opt_in
+			AND m.status = 'active'
+			AND m.telegram_chat_id IS NOT NULL
+			AND ($1::timestamptz IS NULL OR COALESCE(cr.status_changed_at, cr.updated_at) >= $1)
+		GROUP BY m.id
+		ORDER BY completed_sessions DESC, reviews DESC, m.sort_order, m.name
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.LeaderboardEntry{}
+	for rows.Next() {
+		var e models.LeaderboardEntry
+		if err := rows.Scan(&e.MentorID, &e.LegacyID, &e.Slug, &e.Name, &e.Job, &e.Workplace, &e.CompletedSessions, &e.Reviews); err != nil {
+			return nil, fmt.Errorf("failed to scan leaderboard entry: %w", err)
+		}
+		e.Rank = len(entries) + 1
+		entries = append(entries, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate leaderboard: %w", err)
+	}
+
+	return entries, nil
+}
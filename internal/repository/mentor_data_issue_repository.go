@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// MentorDataIssueRepository handles the mentor data issues found by cmd/validate-data
+type MentorDataIssueRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorDataIssueRepository creates a new mentor data issue repository
+func NewMentorDataIssueRepository(pool *pgxpool.Pool) *MentorDataIssueRepository {
+	return &MentorDataIssueRepository{
+		pool: pool,
+	}
+}
+
+// ListSubjects returns the profile fields the data checks need for every mentor that is not
+// deleted or declined
+func (r *MentorDataIssueRepository) ListSubjects(ctx context.Context) ([]*models.MentorDataSubject, error) {
+	query := `
+		SELECT id::text, airtable_id, slug, name, status, COALESCE(telegram, ''), COALESCE(calendar_url, ''),
+			COALESCE(competencies, '')
+		FROM mentors
+		WHERE deleted_at IS NULL AND status <> 'declined'
+		ORDER BY sort_order, id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentors for data checks: %w", err)
+	}
+	defer rows.Close()
+
+	subjects := []*models.MentorDataSubject{}
+	for rows.Next() {
+		subject := &models.MentorDataSubject{}
+		if err := rows.Scan(&subject.MentorID, &subject.AirtableID, &subject.Slug, &subject.Name, &subject.Status,
+			&subject.Telegram, &subject.CalendarURL, &subject.Competencies); err != nil {
+			return nil, fmt.Errorf("failed to scan mentor for data checks: %w", err)
+		}
+		subjects = append(subjects, subject)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mentors for data checks: %w", err)
+	}
+	return subjects, nil
+}
+
+// Sync records the issues found by a run of the given checks in one transaction: found issues are
+// opened (or reopened) and refreshed, and open issues of those checks that the run no longer found
+// are resolved. Returns the number of resolved issues.
+func (r *MentorDataIssueRepository) Sync(ctx context.Context, checks []string, issues []*models.MentorDataIssue) (int, error) {
+	tx, err := conn(ctx, r.pool).Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is safe to call even after Commit
+		_ = tx.Rollback(ctx) //nolint:errcheck
+	}()
+
+	mentorIDs := make([]string, len(issues))
+	names := make([]string, len(issues))
+	details := make([]string, len(issues))
+	for i, issue := range issues {
+		mentorIDs[i] = issue.MentorID
+		names[i] = issue.Check
+		details[i] = issue.Detail
+	}
+
+	// now() is the transaction start, so every issue seen by this run ends up with last_seen_at = now()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO mentor_data_issues (mentor_id, check_name, detail)
+		SELECT * FROM unnest($1::uuid[], $2::text[], $3::text[])
+		ON CONFLICT (mentor_id, check_name) DO UPDATE SET
+			detail = EXCLUDED.detail,
+			last_seen_at = now(),
+			first_seen_at = CASE WHEN mentor_data_issues.resolved_at IS NULL
+				THEN mentor_data_issues.first_seen_at ELSE now() END,
+			resolved_at = NULL
+	`, mentorIDs, names, details)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record mentor data issues: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE mentor_data_issues SET resolved_at = now()
+		WHERE resolved_at IS NULL AND check_name = ANY($1) AND last_seen_at < now()
+	`, checks)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve mentor data issues: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit mentor data issues: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// ListOpen returns the unresolved issues of mentors that are not deleted, oldest first
+func (r *MentorDataIssueRepository) ListOpen(ctx context.Context) ([]*models.MentorDataIssue, error) {
+	query := `
+		SELECT i.mentor_id::text, m.slug, m.name, i.check_name, i.detail, i.first_seen_at, i.last_seen_at
+		FROM mentor_data_issues i
+		JOIN mentors m ON m.id = i.mentor_id
+		WHERE i.resolved_at IS NULL AND m.deleted_at IS NULL
+		ORDER BY i.first_seen_at, m.slug, i.check_name
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentor data issues: %w", err)
+	}
+	defer rows.Close()
+
+	issues := []*models.MentorDataIssue{}
+	for rows.Next() {
+		issue := &models.MentorDataIssue{}
+		if err := rows.Scan(&issue.MentorID, &issue.Slug, &issue.Name, &issue.Check, &issue.Detail,
+			&issue.FirstSeenAt, &issue.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan mentor data issue: %w", err)
+		}
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mentor data issues: %w", err)
+	}
+	return issues, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrDeviceSessionNotFound is returned when the mentor has no active session with the ID
+var ErrDeviceSessionNotFound = errors.New("device session not found")
+
+// MentorDeviceSessionRepository stores mentor portal sessions
+type MentorDeviceSessionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorDeviceSessionRepository creates a new device session repository
+func NewMentorDeviceSessionRepository(pool *pgxpool.Pool) *MentorDeviceSessionRepository {
+	return &MentorDeviceSessionRepository{pool: pool}
+}
+
+// Create stores a new session and returns its ID, which becomes the JWT ID
+func (r *MentorDeviceSessionRepository) Create(ctx context.Context, mentorID string, client models.DeviceClient, expiresAt time.Time) (string, error) {
+	var id string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO mentor_device_sessions (mentor_id, user_agent, ip, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, mentorID, client.UserAgent, client.IP, expiresAt).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to create device session: %w", err)
+	}
+	return id, nil
+}
+
+// HasSessions reports whether the mentor has logged in before and whether one of
+// those logins came from the same User-Agent
+func (r *MentorDeviceSessionRepository) HasSessions(ctx context.Context, mentorID, userAgent string) (any, sameDevice bool, err error) {
+	err = conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COUNT(*) > 0, COALESCE(BOOL_OR(user_agent = $2), false)
+		FROM mentor_device_sessions
+		WHERE mentor_id = $1
+	`, mentorID, userAgent).Scan(&any, &sameDevice)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to check device sessions: %w", err)
+	}
+	return any, sameDevice, nil
+}
+
+// ListActive returns the mentor's sessions that are neither revoked nor expired, newest first
+func (r *MentorDeviceSessionRepository) ListActive(ctx context.Context, mentorID string) ([]models.MentorDeviceSession, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, mentor_id, user_agent, ip, created_at, last_used_at, expires_at, revoked_at
+		FROM mentor_device_sessions
+		WHERE mentor_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`, mentorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list device sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []models.MentorDeviceSession{}
+	for rows.Next() {
+		var s models.MentorDeviceSession
+		if err := rows.Scan(&s.ID, &s.MentorID, &s.UserAgent, &s.IP, &s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan device session: %w", err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list device sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Touch reports whether the session is still active and refreshes last_used_at
+// at most once per models.DeviceSessionTouchInterval
+func (r *MentorDeviceSessionRepository) Touch(ctx context.Context, mentorID, sessionID string) (bool, error) {
+	db := conn(ctx, r.pool)
+
+	var lastUsedAt time.Time
+	err := db.QueryRow(ctx, `
+		SELECT last_used_at
+		FROM mentor_device_sessions
+		WHERE id = $1 AND mentor_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`, sessionID, mentorID).Scan(&lastUsedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check device session: %w", err)
+	}
+
+	if time.Since(lastUsedAt) < models.DeviceSessionTouchInterval {
+		return true, nil
+	}
+	if _, err := db.Exec(ctx, `UPDATE mentor_device_sessions SET last_used_at = NOW() WHERE id = $1`, sessionID); err != nil {
+		return false, fmt.Errorf("failed to touch device session: %w", err)
+	}
+	return true, nil
+}
+
+// Revoke ends one of the mentor's active sessions
+func (r *MentorDeviceSessionRepository) Revoke(ctx context.Context, mentorID, sessionID string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE mentor_device_sessions
+		SET revoked_at = NOW()
+		WHERE id::text = $1 AND mentor_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`, sessionID, mentorID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke device session: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrDeviceSessionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrMentorInsightsNotFound is returned when a mentor's insights haven't been computed yet
+var ErrMentorInsightsNotFound = errors.New("insights not computed yet")
+
+// mentorInsightsRefreshLock is the advisory lock key that keeps API instances from
+// recomputing insights at the same time
+const mentorInsightsRefreshLock = 7423002
+
+// MentorInsightsRepository aggregates mentors' feedback and stores the per-mentor insights
+type MentorInsightsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorInsightsRepository creates a new mentor insights repository
+func NewMentorInsightsRepository(pool *pgxpool.Pool) *MentorInsightsRepository {
+	return &MentorInsightsRepository{
+		pool: pool,
+	}
+}
+
+// Lock takes the refresh lock. Must run inside a unit of work: the lock is held until it commits.
+func (r *MentorInsightsRepository) Lock(ctx context.Context) error {
+	if _, err := conn(ctx, r.pool).Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, mentorInsightsRefreshLock); err != nil {
+		return fmt.Errorf("failed to lock insights refresh: %w", err)
+	}
+	return nil
+}
+
+// ListRequestCalendars returns the requests created since the given moment whose mentor has a
+// calendar link, for finding the ones that arrived during a vacation
+func (r *MentorInsightsRepository) ListRequestCalendars(ctx context.Context, since time.Time) ([]models.MentorRequestCalendar, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT cr.id, cr.created_at, m.id, m.calendar_url, COALESCE(m.timezone, '')
+		FROM client_requests cr
+		JOIN mentors m ON m.id = cr.mentor_id
+		WHERE cr.created_at >= $1 AND COALESCE(m.calendar_url, '') <> ''
+			AND `+mentorVisibleCondition+`
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request calendars: %w", err)
+	}
+	defer rows.Close()
+
+	requests := []models.MentorRequestCalendar{}
+	for rows.Next() {
+		var rc models.MentorRequestCalendar
+		if err := rows.Scan(&rc.RequestID, &rc.CreatedAt, &rc.MentorID, &rc.CalendarURL, &rc.Timezone); err != nil {
+			return nil, fmt.Errorf("failed to scan request calendar: %w", err)
+		}
+		requests = append(requests, rc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate request calendars: %w", err)
+	}
+	return requests, nil
+}
+
+// ListRequestStats counts each mentor's requests created since the given moment: declines
+// by reason and how many were answered, within slaHours, and the median time to answer.
+// The time to answer of a request listed in slaStarts is counted from that moment instead of
+// its creation, and is zero for an answer given before it.
+// Quarantined requests never reached the mentor and are left out.
+func (r *MentorInsightsRepository) ListRequestStats(
+	ctx context.Context,
+	since time.Time,
+	slaHours int,
+	slaStarts map[string]time.Time,
+) ([]*models.MentorRequestStats, error) {
+	startIDs := make([]string, 0, len(slaStarts))
+	startTimes := make([]time.Time, 0, len(slaStarts))
+	for id, start := range slaStarts {
+		startIDs = append(startIDs, id)
+		startTimes = append(startTimes, start)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		WITH scoped AS (
+			SELECT cr.mentor_id, cr.status, cr.decline_reason,
+				EXTRACT(EPOCH FROM (cr.first_response_at -
+					LEAST(GREATEST(cr.created_at, st.sla_start), cr.first_response_at))) / 3600 AS response_hours
+			FROM client_requests cr
+			LEFT JOIN unnest($3::uuid[], $4::timestamptz[]) AS st(request_id, sla_start) ON st.request_id = cr.id
+			WHERE cr.mentor_id IS NOT NULL AND cr.created_at >= $1
+				AND `+mentorVisibleCondition+`
+		), reasons AS (
+			SELECT mentor_id, jsonb_object_agg(decline_reason, cnt) AS decline_reasons
+			FROM (
+				SELECT mentor_id, decline_reason, COUNT(*) AS cnt
+				FROM scoped
+				WHERE status = 'declined' AND decline_reason IS NOT NULL
+				GROUP BY mentor_id, decline_reason
+			) counted
+			GROUP BY mentor_id
+		)
+		SELECT s.mentor_id,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE s.status = 'declined'),
+			COALESCE(MAX(rs.decline_reasons::text), '{}'),
+			COUNT(s.response_hours),
+			COUNT(*) FILTER (WHERE s.response_hours <= $2),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY s.response_hours)
+		FROM scoped s
+		LEFT JOIN reasons rs ON rs.mentor_id = s.mentor_id
+		GROUP BY s.mentor_id
+	`, since, slaHours, startIDs, startTimes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []*models.MentorRequestStats{}
+	for rows.Next() {
+		var s models.MentorRequestStats
+		var reasons string
+		if err := rows.Scan(&s.MentorID, &s.Requests, &s.Declined, &reasons,
+			&s.Responded, &s.RespondedWithinSLA, &s.MedianResponseHours); err != nil {
+			return nil, fmt.Errorf("failed to scan request stats: %w", err)
+		}
+		if err := json.Unmarshal([]byte(reasons), &s.DeclineReasons); err != nil {
+			return nil, fmt.Errorf("failed to decode decline reasons: %w", err)
+		}
+		stats = append(stats, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate request stats: %w", err)
+	}
+	return stats, nil
+}
+
+// ListReviewTexts returns the texts of reviews written since the given moment.
+// Reviews rejected by moderators are left out.
+func (r *MentorInsightsRepository) ListReviewTexts(ctx context.Context, since time.Time) ([]models.MentorReviewText, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT cr.mentor_id, rv.mentor_review
+		FROM reviews rv
+		JOIN client_requests cr ON cr.id = rv.client_request_id
+		WHERE cr.mentor_id IS NOT NULL AND rv.created_at >= $1
+			AND rv.status <> 'rejected' AND COALESCE(rv.mentor_review, '') <> ''
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query review texts: %w", err)
+	}
+	defer rows.Close()
+
+	texts := []models.MentorReviewText{}
+	for rows.Next() {
+		var t models.MentorReviewText
+		if err := rows.Scan(&t.MentorID, &t.Text); err != nil {
+			return nil, fmt.Errorf("failed to scan review text: %w", err)
+		}
+		texts = append(texts, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate review texts: %w", err)
+	}
+	return texts, nil
+}
+
+// Replace stores the insights of a refresh and drops those of mentors without activity in it
+func (r *MentorInsightsRepository) Replace(ctx context.Context, insights []*models.MentorInsights, computedAt time.Time) error {
+	db := conn(ctx, r.pool)
+	for _, i := range insights {
+		reasons, err := json.Marshal(i.DeclineReasons)
+		if err != nil {
+			return fmt.Errorf("failed to encode decline reasons: %w", err)
+		}
+		_, err = db.Exec(ctx, `
+			INSERT INTO mentor_insights (mentor_id, window_days, sla_hours, requests, declined, decline_reasons,
+				reviews, reviews_positive, reviews_neutral, reviews_negative,
+				responded, responded_within_sla, median_response_hours, computed_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			ON CONFLICT (mentor_id) DO UPDATE SET
+				window_days = EXCLUDED.window_days, sla_hours = EXCLUDED.sla_hours,
+				requests = EXCLUDED.requests, declined = EXCLUDED.declined,
+				decline_reasons = EXCLUDED.decline_reasons, reviews = EXCLUDED.reviews,
+				reviews_positive = EXCLUDED.reviews_positive, reviews_neutral = EXCLUDED.reviews_neutral,
+				reviews_negative = EXCLUDED.reviews_negative, responded = EXCLUDED.responded,
+				responded_within_sla = EXCLUDED.responded_within_sla,
+				median_response_hours = EXCLUDED.median_response_hours, computed_at = EXCLUDED.computed_at
+		`, i.MentorID, i.WindowDays, i.SLAHours, i.Requests, i.Declined, reasons,
+			i.Reviews, i.ReviewsPositive, i.ReviewsNeutral, i.ReviewsNegative,
+			i.Responded, i.RespondedWithinSLA, i.MedianResponseHours, computedAt)
+		if err != nil {
+			return fmt.Errorf("failed to store insights: %w", err)
+		}
+	}
+
+	if _, err := db.Exec(ctx, `DELETE FROM mentor_insights WHERE computed_at < $1`, computedAt); err != nil {
+		return fmt.Errorf("failed to drop stale insights: %w", err)
+	}
+	return nil
+}
+
+// Get returns a mentor's stored insights
+func (r *MentorInsightsRepository) Get(ctx context.Context, mentorID string) (*models.MentorInsights, error) {
+	var i models.MentorInsights
+	var reasons []byte
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT mentor_id, window_days, sla_hours, requests, declined, decline_reasons,
+			reviews, reviews_positive, reviews_neutral, reviews_negative,
+			responded, responded_within_sla, median_response_hours, computed_at
+		FROM mentor_insights
+		WHERE mentor_id = $1
+	`, mentorID).Scan(&i.MentorID, &i.WindowDays, &i.SLAHours, &i.Requests, &i.Declined, &reasons,
+		&i.Reviews, &i.ReviewsPositive, &i.ReviewsNeutral, &i.ReviewsNegative,
+		&i.Responded, &i.RespondedWithinSLA, &i.MedianResponseHours, &i.ComputedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMentorInsightsNotFound
+		}
+		return nil, fmt.Errorf("failed to get insights: %w", err)
+	}
+	if err := json.Unmarshal(reasons, &i.DeclineReasons); err != nil {
+		return nil, fmt.Errorf("failed to decode decline reasons: %w", err)
+	}
+	return &i, nil
+}
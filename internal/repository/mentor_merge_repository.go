@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrMergeMentorNotFound is returned when the primary or the duplicate mentor doesn't exist
+	ErrMergeMentorNotFound = errors.New("mentor to merge not found")
+	// ErrMentorAlreadyMerged is returned when either mentor was already merged into another record
+	ErrMentorAlreadyMerged = errors.New("mentor already merged")
+)
+
+// MentorMergeRepository moves data between duplicate mentor records
+type MentorMergeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorMergeRepository creates a new mentor merge repository
+func NewMentorMergeRepository(pool *pgxpool.Pool) *MentorMergeRepository {
+	return &MentorMergeRepository{pool: pool}
+}
+
+type mergeMentorRow struct {
+	slug       string
+	airtableID *string
+	mergedInto *string
+}
+
+// Merge moves tags, requests (with their reviews), programs, reply templates and abuse reports
+// from the duplicate to the primary mentor, hands over the Airtable ID when the primary has none,
+// soft-deletes the duplicate and records the merge. It must run inside a unit of work.
+func (r *MentorMergeRepository) Merge(ctx context.Context, primaryID, duplicateID, moderatorID string) (*models.MentorMergeResult, error) {
+	db := conn(ctx, r.pool)
+
+	// Lock both records in a stable order so concurrent merges can't deadlock
+	rows, err := db.Query(ctx, `
+		SELECT id, slug, airtable_id, merged_into::text
+		FROM mentors
+		WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+		ORDER BY id
+		FOR UPDATE
+	`, []string{primaryID, duplicateID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock mentors: %w", err)
+	}
+	locked := map[string]mergeMentorRow{}
+	for rows.Next() {
+		var id string
+		var row mergeMentorRow
+		if err := rows.Scan(&id, &row.slug, &row.airtableID, &row.mergedInto); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan mentor: %w", err)
+		}
+		locked[id] = row
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to lock mentors: %w", err)
+	}
+
+	primary, okPrimary := locked[primaryID]
+	duplicate, okDuplicate := locked[duplicateID]
+	if !okPrimary || !okDuplicate {
+		return nil, ErrMergeMentorNotFound
+	}
+	if primary.mergedInto != nil || duplicate.mergedInto != nil {
+		return nil, ErrMentorAlreadyMerged
+	}
+
+	result := &models.MentorMergeResult{
+		PrimaryID:     primaryID,
+		PrimarySlug:   primary.slug,
+		DuplicateID:   duplicateID,
+		DuplicateSlug: duplicate.slug,
+	}
+
+	moves := []struct {
+		query  string
+		target *int64
+	}{
+		{`INSERT INTO mentor_tags (mentor_id, tag_id)
+			SELECT $1, tag_id FROM mentor_tags WHERE mentor_id = $2
+			ON CONFLICT DO NOTHING`, &result.TagsAdded},
+		{`UPDATE client_requests SET mentor_id = $1, updated_at = NOW() WHERE mentor_id = $2`, &result.RequestsMoved},
+		{`UPDATE programs SET mentor_id = $1 WHERE mentor_id = $2`, &result.ProgramsMoved},
+		{`UPDATE mentor_reply_templates SET mentor_id = $1 WHERE mentor_id = $2`, &result.TemplatesMoved},
+		{`UPDATE abuse_reports SET mentor_id = $1 WHERE mentor_id = $2`, &result.ReportsMoved},
+	}
+	for _, move := range moves {
+		tag, err := db.Exec(ctx, move.query, primaryID, duplicateID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge mentor data: %w", err)
+		}
+		*move.target = tag.RowsAffected()
+	}
+
+	// The duplicate keeps its row for history but can no longer log in, receive requests or show up
+	if _, err := db.Exec(ctx, `
+		UPDATE mentors
+		SET merged_into = $1, status = 'inactive', airtable_id = NULL, telegram_chat_id = NULL,
+			login_token = NULL, login_token_expires_at = NULL, calendar_feed_token = NULL,
+			version = version + 1, updated_at = NOW()
+		WHERE id = $2
+	`, primaryID, duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to soft-delete duplicate mentor: %w", err)
+	}
+	if _, err := db.Exec(ctx, `
+		UPDATE mentor_device_sessions SET revoked_at = NOW() WHERE mentor_id = $1 AND revoked_at IS NULL
+	`, duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to revoke duplicate mentor sessions: %w", err)
+	}
+
+	if primary.airtableID == nil && duplicate.airtableID != nil {
+		if _, err := db.Exec(ctx, `UPDATE mentors SET airtable_id = $1, version = version + 1, updated_at = NOW() WHERE id = $2`,
+			*duplicate.airtableID, primaryID); err != nil {
+			return nil, fmt.Errorf("failed to move airtable id: %w", err)
+		}
+		result.AirtableIDMoved = true
+	}
+
+	moved := map[string]interface{}{
+		"tagsAdded":       result.TagsAdded,
+		"requestsMoved":   result.RequestsMoved,
+		"programsMoved":   result.ProgramsMoved,
+		"templatesMoved":  result.TemplatesMoved,
+		"reportsMoved":    result.ReportsMoved,
+		"airtableIdMoved": result.AirtableIDMoved,
+	}
+	err = db.QueryRow(ctx, `
+		INSERT INTO mentor_merges (primary_mentor_id, duplicate_mentor_id, moderator_id, moved)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4)
+		RETURNING id, created_at
+	`, primaryID, duplicateID, moderatorID, moved).Scan(&result.ID, &result.MergedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record mentor merge: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrMentorQuestionNotFound is returned when the question doesn't exist or belongs to another mentor
+var ErrMentorQuestionNotFound = errors.New("question not found")
+
+const mentorQuestionSelect = `
+	SELECT id, mentor_id, question, answer, status, answered_at, created_at
+	FROM mentor_questions
+`
+
+// MentorQuestionRepository handles anonymous mentor question data access
+type MentorQuestionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorQuestionRepository creates a new mentor question repository
+func NewMentorQuestionRepository(pool *pgxpool.Pool) *MentorQuestionRepository {
+	return &MentorQuestionRepository{
+		pool: pool,
+	}
+}
+
+// Create stores a pending question and returns its ID
+func (r *MentorQuestionRepository) Create(ctx context.Context, mentorID, question string) (string, error) {
+	var id string
+	err := conn(ctx, r.pool).QueryRow(ctx,
+		`INSERT INTO mentor_questions (mentor_id, question) VALUES ($1, $2) RETURNING id`,
+		mentorID, question).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return "", fmt.Errorf("%w: mentor %s does not exist", apperrors.ErrInvalidInput, mentorID)
+		}
+		return "", fmt.Errorf("failed to create question: %w", err)
+	}
+	return id, nil
+}
+
+// CountPending returns the number of the mentor's unanswered questions
+func (r *MentorQuestionRepository) CountPending(ctx context.Context, mentorID string) (int, error) {
+	var count int
+	err := conn(ctx, r.pool).QueryRow(ctx,
+		`SELECT COUNT(*) FROM mentor_questions WHERE mentor_id = $1 AND status = 'pending'`,
+		mentorID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending questions: %w", err)
+	}
+	return count, nil
+}
+
+// ListByMentor returns the mentor's questions with the given status, newest first.
+// An empty status returns all of them.
+func (r *MentorQuestionRepository) ListByMentor(ctx context.Context, mentorID, status string) ([]*models.MentorQuestion, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, mentorQuestionSelect+`
+		WHERE mentor_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC
+	`, mentorID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query questions: %w", err)
+	}
+	defer rows.Close()
+
+	questions := []*models.MentorQuestion{}
+	for rows.Next() {
+		question, scanErr := scanMentorQuestion(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		questions = append(questions, question)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate questions: %w", err)
+	}
+	return questions, nil
+}
+
+// Answer publishes one of the mentor's questions with the answer. Answering again edits the answer.
+func (r *MentorQuestionRepository) Answer(ctx context.Context, mentorID, id, answer string) (*models.MentorQuestion, error) {
+	question, err := scanMentorQuestion(conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE mentor_questions
+		SET answer = $3, status = 'published', answered_at = COALESCE(answered_at, now())
+		WHERE id = $1 AND mentor_id = $2
+		RETURNING id, mentor_id, question, answer, status, answered_at, created_at
+	`, id, mentorID, answer))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMentorQuestionNotFound
+	}
+	return question, err
+}
+
+// Reject hides one of the mentor's questions for good
+func (r *MentorQuestionRepository) Reject(ctx context.Context, mentorID, id string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx,
+		`UPDATE mentor_questions SET status = 'rejected' WHERE id = $1 AND mentor_id = $2`, id, mentorID)
+	if err != nil {
+		return fmt.Errorf("failed to reject question: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrMentorQuestionNotFound
+	}
+	return nil
+}
+
+func scanMentorQuestion(row pgx.Row) (*models.MentorQuestion, error) {
+	var q models.MentorQuestion
+	err := row.Scan(&q.ID, &q.MentorID, &q.Question, &q.Answer, &q.Status, &q.AnsweredAt, &q.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan question: %w", err)
+	}
+	return &q, nil
+}
@@ -2,17 +2,28 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/getmentor/getmentor-api/internal/cache"
 	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/db"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/slug"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
 
+// ErrCalendarFeedNotFound is returned for unknown or revoked sessions feed tokens
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// ErrDeleteMentorNotFound is returned when the mentor to delete doesn't exist or was deleted before
+var ErrDeleteMentorNotFound = errors.New("mentor to delete not found")
+
 // MentorRepository handles mentor data access with PostgreSQL
 type MentorRepository struct {
 	pool               *pgxpool.Pool
@@ -31,10 +42,56 @@ func NewMentorRepository(pool *pgxpool.Pool, mentorCache *cache.MentorCache, tag
 	}
 }
 
+// mentorSelect reads the cached mentor columns with tags, done-session count and the rating
+// of approved reviews; callers add the WHERE, GROUP BY m.id and ordering
+const mentorSelect = `
+		SELECT m.id, m.airtable_id, m.legacy_id, m.slug, m.name, m.job_title, m.workplace,
+			m.about, m.details, m.competencies, m.experience, m.price, m.status,
+			COALESCE(array_to_string(array_agg(t.name), ','), '') as tags,
+			m.telegram_chat_id, m.calendar_url, m.sort_order, m.created_at, m.updated_at,
+			COALESCE(
+				(SELECT COUNT(*)
+				 FROM client_requests cr
+				 WHERE cr.mentor_id = m.id
+				 AND cr.status = 'done'),
+				0
+			) AS mentee_count,
+			m.timezone, m.contact_hours, m.leaderboard_opt_in,
+			m.country, m.city, m.remote_only, m.languages, m.calendar_broken_at IS NOT NULL AS calendar_broken,
+			(SELECT ROUND(AVG(rv.rating), 1)::float8
+			 FROM reviews rv
+			 JOIN client_requests cr ON cr.id = rv.client_request_id
+			 WHERE cr.mentor_id = m.id AND rv.status = 'approved') AS rating,
+			(SELECT COUNT(rv.rating)
+			 FROM reviews rv
+			 JOIN client_requests cr ON cr.id = rv.client_request_id
+			 WHERE cr.mentor_id = m.id AND rv.status = 'approved') AS review_count
+		FROM mentors m
+		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
+		LEFT JOIN tags t ON t.id = mt.tag_id`
+
+// Hot-path mentor reads, timed by name
+var (
+	mentorBySlugQuery = db.NamedQuery("mentor_by_slug", mentorSelect+`
+		WHERE m.slug = $1 AND m.deleted_at IS NULL
+		GROUP BY m.id
+	`)
+	mentorByIDQuery = db.NamedQuery("mentor_by_id", mentorSelect+`
+		WHERE m.id = $1 AND m.deleted_at IS NULL
+		GROUP BY m.id
+	`)
+)
+
+// db returns the connection for ctx: the unit-of-work transaction if one is open, the pool otherwise
+func (r *MentorRepository) db(ctx context.Context) DBTX {
+	return conn(ctx, r.pool)
+}
+
 // GetAll retrieves all mentors with optional filtering
 func (r *MentorRepository) GetAll(ctx context.Context, opts models.FilterOptions) ([]*models.Mentor, error) {
 	var mentors []*models.Mentor
 	var err error
+	sorted := false
 
 	// Experimental: bypass cache if disabled
 	if r.disableMentorCache {
@@ -47,11 +104,20 @@ func (r *MentorRepository) GetAll(ctx context.Context, opts models.FilterOptions
 		}
 		logger.Debug("Successfully fetched mentors from database",
 			zap.Int("count", len(mentors)))
+		mentors = filterByTagsAndExperience(mentors, opts)
 	} else {
 		// ForceRefresh triggers background refresh but returns current data
-		if opts.ForceRefresh {
+		switch {
+		case opts.ForceRefresh:
 			mentors, err = r.mentorCache.ForceRefresh()
-		} else {
+			mentors = filterByTagsAndExperience(mentors, opts)
+		case len(opts.Tags) > 0 || opts.Experience != "":
+			mentors, err = r.mentorCache.GetFiltered(opts.Sort, opts.Tags, opts.Experience)
+			sorted = isPresortedMentorSort(opts.Sort)
+		case isPresortedMentorSort(opts.Sort):
+			mentors, err = r.mentorCache.GetSorted(opts.Sort)
+			sorted = true
+		default:
 			mentors, err = r.mentorCache.Get()
 		}
 
@@ -63,9 +129,42 @@ func (r *MentorRepository) GetAll(ctx context.Context, opts models.FilterOptions
 	// Apply filters
 	filtered := r.applyFilters(mentors, opts)
 
+	// Orders the cache doesn't keep presorted are sorted per request
+	if opts.Sort != models.MentorSortDefault && !sorted {
+		seed := opts.SortSeed
+		if opts.Sort == models.MentorSortDailyShuffle {
+			seed = models.DailyShuffleSeed(time.Now())
+		}
+		models.SortMentors(filtered, opts.Sort, seed)
+	}
+
 	return filtered, nil
 }
 
+// filterByTagsAndExperience applies the tag and experience filters to mentors that were not
+// read through the cache's indexes
+func filterByTagsAndExperience(mentors []*models.Mentor, opts models.FilterOptions) []*models.Mentor {
+	if len(opts.Tags) == 0 && opts.Experience == "" {
+		return mentors
+	}
+	matching := make([]*models.Mentor, 0, len(mentors))
+	for _, mentor := range mentors {
+		if mentor.MatchesTagsAndExperience(opts.Tags, opts.Experience) {
+			matching = append(matching, mentor)
+		}
+	}
+	return matching
+}
+
+func isPresortedMentorSort(sortBy models.MentorSort) bool {
+	for _, presorted := range models.PresortedMentorSorts {
+		if sortBy == presorted {
+			return true
+		}
+	}
+	return false
+}
+
 // GetByID retrieves a mentor by legacy numeric ID
 // Note: O(n) complexity is acceptable as per requirements
 func (r *MentorRepository) GetByID(ctx context.Context, id int, opts models.FilterOptions) (*models.Mentor, error) {
@@ -144,50 +243,57 @@ func (r *MentorRepository) GetByMentorId(ctx context.Context, mentorId string, o
 
 // fetchMentorByUUIDFromDB retrieves a single mentor by UUID from PostgreSQL
 func (r *MentorRepository) fetchMentorByUUIDFromDB(ctx context.Context, mentorId string) (*models.Mentor, error) {
-	query := `
-		SELECT m.id, m.airtable_id, m.legacy_id, m.slug, m.name, m.job_title, m.workplace,
-			m.about, m.details, m.competencies, m.experience, m.price, m.status,
-			COALESCE(array_to_string(array_agg(t.name), ','), '') as tags,
-			m.telegram_chat_id, m.calendar_url, m.sort_order, m.created_at, m.updated_at,
-			COALESCE(
-				(SELECT COUNT(*)
-				 FROM client_requests cr
-				 WHERE cr.mentor_id = m.id
-				 AND cr.status = 'done'),
-				0
-			) AS mentee_count
-		FROM mentors m
-		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
-		LEFT JOIN tags t ON t.id = mt.tag_id
-		WHERE m.id = $1
-		GROUP BY m.id
-	`
-
-	row := r.pool.QueryRow(ctx, query, mentorId)
+	row := r.db(ctx).QueryRow(ctx, mentorByIDQuery, mentorId)
 	return models.ScanMentor(row)
 }
 
 // allowedUpdateColumns defines the columns that can be updated via the Update method
 var allowedUpdateColumns = map[string]bool{
-	"name":             true,
-	"email":            true,
-	"job_title":        true,
-	"workplace":        true,
-	"about":            true,
-	"details":          true,
-	"competencies":     true,
-	"experience":       true,
-	"price":            true,
-	"telegram":         true,
-	"telegram_chat_id": true,
-	"calendar_url":     true,
-	"slug":             true,
-	"status":           true,
-	"updated_at":       true,
+	"name":               true,
+	"email":              true,
+	"job_title":          true,
+	"workplace":          true,
+	"about":              true,
+	"details":            true,
+	"competencies":       true,
+	"experience":         true,
+	"price":              true,
+	"telegram":           true,
+	"telegram_chat_id":   true,
+	"calendar_url":       true,
+	"timezone":           true,
+	"contact_hours":      true,
+	"country":            true,
+	"city":               true,
+	"remote_only":        true,
+	"languages":          true,
+	"leaderboard_opt_in": true,
+	"slug":               true,
+	"status":             true,
+	"updated_at":         true,
 }
 
 // Update updates a mentor in PostgreSQL
 func (r *MentorRepository) Update(ctx context.Context, mentorId string, updates map[string]interface{}) error {
+	return r.UpdateIfUnmodified(ctx, mentorId, updates, MentorPrecondition{})
+}
+
+// MentorPrecondition is the state a conditional mentor update expects to find.
+// Unset fields are not checked.
+type MentorPrecondition struct {
+	Version   *int
+	UpdatedAt *time.Time
+}
+
+// IsSet reports whether the precondition checks anything
+func (p MentorPrecondition) IsSet() bool {
+	return p.Version != nil || p.UpdatedAt != nil
+}
+
+// UpdateIfUnmodified updates a mentor only if the row still matches expected, and bumps its version.
+// When the row has changed in the meantime, a *VersionConflictError carrying the current
+// version and updated_at is returned.
+func (r *MentorRepository) UpdateIfUnmodified(ctx context.Context, mentorId string, updates map[string]interface{}, expected MentorPrecondition) error {
 	// Validate all keys against allowlist to prevent SQL injection
 	for key := range updates {
 		if !allowedUpdateColumns[key] {
@@ -202,22 +308,44 @@ func (r *MentorRepository) Update(ctx context.Context, mentorId string, updates
 	argPos := 1
 
 	for key, value := range updates {
-		if argPos > 1 {
-			query += ", "
+		query += fmt.Sprintf("%s = $%d, ", key, argPos)
+		if key == "calendar_url" {
+			// A new calendar link starts over with a clean check record
+			query += fmt.Sprintf(`calendar_check_failures = CASE WHEN calendar_url IS DISTINCT FROM $%[1]d THEN 0 ELSE calendar_check_failures END,
+				calendar_broken_at = CASE WHEN calendar_url IS DISTINCT FROM $%[1]d THEN NULL ELSE calendar_broken_at END, `, argPos)
 		}
-		query += fmt.Sprintf("%s = $%d", key, argPos)
 		args = append(args, value)
 		argPos++
 	}
 
-	query += fmt.Sprintf(", updated_at = NOW() WHERE id = $%d", argPos)
+	query += fmt.Sprintf("version = version + 1, updated_at = NOW() WHERE id = $%d", argPos)
 	args = append(args, mentorId)
 
-	_, err := r.pool.Exec(ctx, query, args...)
+	if expected.Version != nil {
+		argPos++
+		query += fmt.Sprintf(" AND version = $%d", argPos)
+		args = append(args, *expected.Version)
+	}
+	if expected.UpdatedAt != nil {
+		argPos++
+		query += fmt.Sprintf(" AND updated_at = $%d", argPos)
+		args = append(args, *expected.UpdatedAt)
+	}
+
+	commandTag, err := r.db(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update mentor: %w", err)
 	}
 
+	if expected.IsSet() && commandTag.RowsAffected() == 0 {
+		conflict := &VersionConflictError{}
+		if err := r.db(ctx).QueryRow(ctx, `SELECT version, updated_at FROM mentors WHERE id = $1`, mentorId).
+			Scan(&conflict.CurrentVersion, &conflict.CurrentUpdatedAt); err != nil {
+			return fmt.Errorf("mentor with ID %s not found", mentorId)
+		}
+		return conflict
+	}
+
 	// Note: Cache will auto-refresh after TTL expires
 	return nil
 }
@@ -227,7 +355,7 @@ func (r *MentorRepository) Update(ctx context.Context, mentorId string, updates
 // Note: slug is generated automatically using pre-fetched legacy_id
 func (r *MentorRepository) CreateMentor(ctx context.Context, fields map[string]interface{}) (string, int, string, error) {
 	// Begin transaction to ensure atomicity
-	tx, err := r.pool.Begin(ctx)
+	tx, err := r.db(ctx).Begin(ctx)
 	if err != nil {
 		return "", 0, "", fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -252,8 +380,10 @@ func (r *MentorRepository) CreateMentor(ctx context.Context, fields map[string]i
 
 	query := `
 		INSERT INTO mentors (legacy_id, slug, name, email, job_title, workplace, about, details,
-			competencies, experience, price, status, telegram, tg_secret, calendar_url, sort_order)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			competencies, experience, price, status, telegram, tg_secret, calendar_url, sort_order,
+			country, city, remote_only, languages)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+			$17, $18, COALESCE($19, false), COALESCE($20::text[], '{ru}'))
 		RETURNING id
 	`
 
@@ -276,6 +406,10 @@ func (r *MentorRepository) CreateMentor(ctx context.Context, fields map[string]i
 		fields["tg_secret"],
 		fields["calendar_url"],
 		fields["sort_order"],
+		fields["country"],
+		fields["city"],
+		fields["remote_only"],
+		fields["languages"],
 	).Scan(&mentorId)
 
 	if err != nil {
@@ -290,6 +424,182 @@ func (r *MentorRepository) CreateMentor(ctx context.Context, fields map[string]i
 	return mentorId, nextLegacyID, mentorSlug, nil
 }
 
+// mentorUpsertColumns are copied into the staging table of UpsertMentors, in CopyFrom row order
+var mentorUpsertColumns = []string{
+	"slug", "airtable_id", "name", "job_title", "workplace", "about", "details", "competencies", "experience",
+	"price", "status", "telegram_chat_id", "calendar_url", "sort_order", "timezone", "contact_hours", "country",
+	"city", "remote_only", "languages",
+}
+
+// UpsertMentors inserts or updates mentors by slug in bulk, with their tag links, in one transaction.
+// Rows are streamed with COPY into a staging table and merged with INSERT ... ON CONFLICT, and tag
+// links are reconciled to exactly the given tags (unknown tags are created). Soft-deleted mentors are
+// left untouched. The mentor cache is not refreshed; call RefreshCache after a sync.
+func (r *MentorRepository) UpsertMentors(ctx context.Context, mentors []*models.Mentor) (*models.MentorUpsertResult, error) {
+	if err := models.ValidateMentorUpsert(mentors); err != nil {
+		return nil, fmt.Errorf("invalid mentor upsert: %w", err)
+	}
+	result := &models.MentorUpsertResult{}
+	if len(mentors) == 0 {
+		return result, nil
+	}
+
+	tx, err := r.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is safe to call even after Commit
+		_ = tx.Rollback(ctx) //nolint:errcheck
+	}()
+
+	// Staging tables live until the transaction ends
+	_, err = tx.Exec(ctx, `
+		CREATE TEMP TABLE mentor_upsert (
+			slug TEXT, airtable_id TEXT, name TEXT, job_title TEXT, workplace TEXT, about TEXT, details TEXT,
+			competencies TEXT, experience TEXT, price TEXT, status TEXT, telegram_chat_id BIGINT,
+			calendar_url TEXT, sort_order INTEGER, timezone TEXT, contact_hours TEXT, country TEXT,
+			city TEXT, remote_only BOOLEAN, languages TEXT[]
+		) ON COMMIT DROP
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mentor staging table: %w", err)
+	}
+	if _, err = tx.Exec(ctx, `CREATE TEMP TABLE mentor_tag_upsert (slug TEXT, tag TEXT) ON COMMIT DROP`); err != nil {
+		return nil, fmt.Errorf("failed to create tag staging table: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"mentor_upsert"}, mentorUpsertColumns,
+		pgx.CopyFromSlice(len(mentors), func(i int) ([]interface{}, error) {
+			m := mentors[i]
+			languages := m.Languages
+			if len(languages) == 0 {
+				languages = []string{"ru"}
+			}
+			return []interface{}{
+				m.Slug, m.AirtableID, m.Name, m.Job, m.Workplace, m.About, m.Description, m.Competencies,
+				m.Experience, m.Price, m.Status, m.TelegramChatID, nullableText(m.CalendarURL), m.SortOrder,
+				nullableText(m.Timezone), nullableText(m.ContactHours), nullableText(m.Country),
+				nullableText(m.City), m.RemoteOnly, languages,
+			}, nil
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy mentors: %w", err)
+	}
+	var tagRows [][]interface{}
+	for _, m := range mentors {
+		for _, tag := range m.Tags {
+			tagRows = append(tagRows, []interface{}{m.Slug, tag})
+		}
+	}
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"mentor_tag_upsert"}, []string{"slug", "tag"}, pgx.CopyFromRows(tagRows)); err != nil {
+		return nil, fmt.Errorf("failed to copy mentor tags: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		INSERT INTO mentors (slug, airtable_id, name, job_title, workplace, about, details, competencies,
+			experience, price, status, telegram_chat_id, calendar_url, sort_order, timezone, contact_hours,
+			country, city, remote_only, languages)
+		SELECT slug, airtable_id, name, job_title, workplace, about, details, competencies,
+			experience, price, status, telegram_chat_id, calendar_url, sort_order, timezone, contact_hours,
+			country, city, remote_only, languages
+		FROM mentor_upsert
+		ON CONFLICT (slug) DO UPDATE SET
+			airtable_id = COALESCE(EXCLUDED.airtable_id, mentors.airtable_id),
+			name = EXCLUDED.name,
+			job_title = EXCLUDED.job_title,
+			workplace = EXCLUDED.workplace,
+			about = EXCLUDED.about,
+			details = EXCLUDED.details,
+			competencies = EXCLUDED.competencies,
+			experience = EXCLUDED.experience,
+			price = EXCLUDED.price,
+			status = EXCLUDED.status,
+			telegram_chat_id = EXCLUDED.telegram_chat_id,
+			calendar_url = EXCLUDED.calendar_url,
+			sort_order = EXCLUDED.sort_order,
+			timezone = EXCLUDED.timezone,
+			contact_hours = EXCLUDED.contact_hours,
+			country = EXCLUDED.country,
+			city = EXCLUDED.city,
+			remote_only = EXCLUDED.remote_only,
+			languages = EXCLUDED.languages,
+			version = mentors.version + 1,
+			updated_at = NOW()
+		WHERE mentors.deleted_at IS NULL
+		RETURNING (xmax = 0) AS inserted
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert mentors: %w", err)
+	}
+	for rows.Next() {
+		var inserted bool
+		if err := rows.Scan(&inserted); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan upserted mentor: %w", err)
+		}
+		if inserted {
+			result.Inserted++
+		} else {
+			result.Updated++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to upsert mentors: %w", err)
+	}
+	result.Skipped = len(mentors) - result.Inserted - result.Updated
+
+	// Reconcile tag links of the upserted (not deleted) mentors to exactly the staged tags
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tags (name)
+		SELECT DISTINCT tag FROM mentor_tag_upsert
+		ON CONFLICT (name) DO NOTHING
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tags: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+		DELETE FROM mentor_tags mt
+		USING mentors m
+		WHERE mt.mentor_id = m.id
+			AND m.deleted_at IS NULL
+			AND m.slug IN (SELECT slug FROM mentor_upsert)
+			AND NOT EXISTS (
+				SELECT 1 FROM mentor_tag_upsert s
+				JOIN tags t ON t.name = s.tag
+				WHERE s.slug = m.slug AND t.id = mt.tag_id
+			)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove stale mentor tags: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO mentor_tags (mentor_id, tag_id)
+		SELECT m.id, t.id
+		FROM mentor_tag_upsert s
+		JOIN mentors m ON m.slug = s.slug AND m.deleted_at IS NULL
+		JOIN tags t ON t.name = s.tag
+		ON CONFLICT DO NOTHING
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link mentor tags: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// nullableText stores an empty optional field as NULL
+func nullableText(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 // GetTagIDByName retrieves a tag ID by name
 func (r *MentorRepository) GetTagIDByName(ctx context.Context, name string) (string, error) {
 	return r.tagsCache.GetTagIDByName(name)
@@ -297,7 +607,7 @@ func (r *MentorRepository) GetTagIDByName(ctx context.Context, name string) (str
 
 // UpdateMentorTags updates the tags for a mentor
 func (r *MentorRepository) UpdateMentorTags(ctx context.Context, mentorID string, tagIDs []string) error {
-	tx, err := r.pool.Begin(ctx)
+	tx, err := r.db(ctx).Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -340,16 +650,58 @@ func (r *MentorRepository) GetByEmail(ctx context.Context, email string) (*model
 	query := `
 		SELECT id, airtable_id, legacy_id, slug, name, job_title, workplace, about, details,
 			competencies, experience, price, status, '' as tags, telegram_chat_id, calendar_url,
-			sort_order, created_at, updated_at, 0 as mentee_count
+			sort_order, created_at, updated_at, 0 as mentee_count, timezone, contact_hours, leaderboard_opt_in,
+			country, city, remote_only, languages, calendar_broken_at IS NOT NULL AS calendar_broken,
+			NULL::float8 AS rating, 0 AS review_count
 		FROM mentors
-		WHERE email = $1 AND status IN ('active', 'inactive')
+		WHERE email = $1 AND status IN ('active', 'inactive') AND merged_into IS NULL AND deleted_at IS NULL
 		LIMIT 1
 	`
 
-	row := r.pool.QueryRow(ctx, query, email)
+	row := r.db(ctx).QueryRow(ctx, query, email)
 	return models.ScanMentor(row)
 }
 
+// IsEmailRegistered reports whether a mentor that isn't declined or merged has the address
+func (r *MentorRepository) IsEmailRegistered(ctx context.Context, email string) (bool, error) {
+	var registered bool
+	err := r.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM mentors
+			WHERE email = $1 AND status <> 'declined' AND merged_into IS NULL AND deleted_at IS NULL
+		)
+	`, email).Scan(&registered)
+	if err != nil {
+		return false, fmt.Errorf("failed to check mentor email: %w", err)
+	}
+	return registered, nil
+}
+
+// GetReturningMentor finds an inactive, previously approved mentor by email or ID with the
+// sessions they completed, or nil when there is none
+func (r *MentorRepository) GetReturningMentor(ctx context.Context, email, mentorID string) (*models.ReturningMentor, error) {
+	var m models.ReturningMentor
+	err := r.db(ctx).QueryRow(ctx, `
+		SELECT m.id, m.slug, m.name, m.deactivated_at,
+			(SELECT COUNT(*) FROM client_requests cr WHERE cr.mentor_id = m.id AND cr.status = 'done')
+		FROM mentors m
+		WHERE m.status = 'inactive' AND m.merged_into IS NULL AND m.deleted_at IS NULL
+			AND (m.email = NULLIF($1, '') OR m.id::text = NULLIF($2, ''))
+			AND EXISTS (
+				SELECT 1 FROM mentor_moderation_events e WHERE e.mentor_id = m.id AND e.action = 'approve'
+			)
+		ORDER BY m.deactivated_at DESC NULLS LAST
+		LIMIT 1
+	`, email, mentorID).Scan(&m.MentorID, &m.Slug, &m.Name, &m.DeactivatedAt, &m.CompletedSessions)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find returning mentor: %w", err)
+	}
+	return &m, nil
+}
+
 // GetByLoginToken retrieves a mentor by login token
 // GetByLoginToken finds a mentor by their login token
 // Note: Returns the token parameter for backwards compatibility, but it's not used for validation
@@ -360,11 +712,11 @@ func (r *MentorRepository) GetByLoginToken(ctx context.Context, token string) (*
 			competencies, experience, price, status, '' as tags, telegram_chat_id, calendar_url,
 			sort_order, created_at, 0 as mentee_count, login_token_expires_at
 		FROM mentors
-		WHERE login_token = $1
+		WHERE login_token = $1 AND deleted_at IS NULL
 		LIMIT 1
 	`
 
-	row := r.pool.QueryRow(ctx, query, token)
+	row := r.db(ctx).QueryRow(ctx, query, token)
 
 	var mentor models.Mentor
 	var tagsStr *string
@@ -439,51 +791,77 @@ func (r *MentorRepository) GetByLoginToken(ctx context.Context, token string) (*
 	return &mentor, *expiresAt, nil
 }
 
-// SetLoginToken sets the login token for a mentor
+// SetLoginToken sets the login token for a mentor. Logging in doesn't edit the profile, so
+// updated_at and the version are left alone, and a pending expectedUpdatedAt precondition or
+// If-Match ETag still holds.
 func (r *MentorRepository) SetLoginToken(ctx context.Context, mentorId string, token string, exp time.Time) error {
 	query := `
 		UPDATE mentors
-		SET login_token = $1, login_token_expires_at = $2, updated_at = NOW()
+		SET login_token = $1, login_token_expires_at = $2
 		WHERE id = $3
 	`
-	_, err := r.pool.Exec(ctx, query, token, exp, mentorId)
+	_, err := r.db(ctx).Exec(ctx, query, token, exp, mentorId)
 	return err
 }
 
-// ClearLoginToken clears the login token for a mentor
+// ClearLoginToken clears the login token for a mentor, leaving updated_at alone like SetLoginToken
 func (r *MentorRepository) ClearLoginToken(ctx context.Context, mentorId string) error {
 	query := `
 		UPDATE mentors
-		SET login_token = NULL, login_token_expires_at = NULL, updated_at = NOW()
+		SET login_token = NULL, login_token_expires_at = NULL
 		WHERE id = $1
 	`
-	_, err := r.pool.Exec(ctx, query, mentorId)
+	_, err := r.db(ctx).Exec(ctx, query, mentorId)
 	return err
 }
 
-// FetchAllMentorsFromDB retrieves all mentors from PostgreSQL for cache population
+// FetchAllMentorsFromDB retrieves all mentors from PostgreSQL for cache population.
+// Mentors are read page by page, so no single query holds every mentor and tag join. The pages
+// share one snapshot: a mentor whose sort order changes meanwhile is neither skipped nor
+// listed twice.
 func (r *MentorRepository) FetchAllMentorsFromDB(ctx context.Context) ([]*models.Mentor, error) {
-	query := `
-		SELECT m.id, m.airtable_id, m.legacy_id, m.slug, m.name, m.job_title, m.workplace,
-			m.about, m.details, m.competencies, m.experience, m.price, m.status,
-			COALESCE(array_to_string(array_agg(t.name), ','), '') as tags,
-			m.telegram_chat_id, m.calendar_url, m.sort_order, m.created_at, m.updated_at,
-			COALESCE(
-				(SELECT COUNT(*)
-				 FROM client_requests cr
-				 WHERE cr.mentor_id = m.id
-				 AND cr.status = 'done'),
-				0
-			) AS mentee_count
-		FROM mentors m
-		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
-		LEFT JOIN tags t ON t.id = mt.tag_id
-		WHERE m.status = 'active'
+	var mentors []*models.Mentor
+	err := readSnapshot(ctx, r.pool, func(ctx context.Context) error {
+		mentors = nil
+		var after *models.MentorPageCursor
+		for {
+			page, err := r.FetchMentorsPageFromDB(ctx, after, models.MaxMentorPageSize)
+			if err != nil {
+				return err
+			}
+			mentors = append(mentors, page...)
+			if len(page) < models.MaxMentorPageSize {
+				return nil
+			}
+			after = models.MentorPageCursorAfter(page[len(page)-1])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mentors, nil
+}
+
+// FetchMentorsPageFromDB returns up to limit active mentors after the cursor, in (sort_order, id)
+// order (keyset pagination). A nil cursor starts at the beginning of the list.
+func (r *MentorRepository) FetchMentorsPageFromDB(ctx context.Context, after *models.MentorPageCursor, limit int) ([]*models.Mentor, error) {
+	if limit <= 0 || limit > models.MaxMentorPageSize {
+		limit = models.MaxMentorPageSize
+	}
+	cursor := models.MentorPageCursor{}
+	if after != nil {
+		cursor = *after
+	}
+
+	query := mentorSelect + `
+		WHERE m.status = 'active' AND m.deleted_at IS NULL
+			AND ($1 OR (m.sort_order, m.id) > ($2::integer, $3::uuid))
 		GROUP BY m.id
-		ORDER BY m.sort_order
+		ORDER BY m.sort_order, m.id
+		LIMIT $4
 	`
 
-	rows, err := r.pool.Query(ctx, query)
+	rows, err := r.db(ctx).Query(ctx, query, after == nil, cursor.SortOrder, nullableUUID(cursor.ID), limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch mentors: %w", err)
 	}
@@ -491,28 +869,100 @@ func (r *MentorRepository) FetchAllMentorsFromDB(ctx context.Context) ([]*models
 	return models.ScanMentors(rows)
 }
 
-// FetchSingleMentorFromDB retrieves a single mentor by slug from PostgreSQL
-func (r *MentorRepository) FetchSingleMentorFromDB(ctx context.Context, mentorSlug string) (*models.Mentor, error) {
-	query := `
-		SELECT m.id, m.airtable_id, m.legacy_id, m.slug, m.name, m.job_title, m.workplace,
-			m.about, m.details, m.competencies, m.experience, m.price, m.status,
-			COALESCE(array_to_string(array_agg(t.name), ','), '') as tags,
-			m.telegram_chat_id, m.calendar_url, m.sort_order, m.created_at, m.updated_at,
-			COALESCE(
-				(SELECT COUNT(*)
-				 FROM client_requests cr
-				 WHERE cr.mentor_id = m.id
-				 AND cr.status = 'done'),
-				0
-			) AS mentee_count
-		FROM mentors m
-		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
-		LEFT JOIN tags t ON t.id = mt.tag_id
-		WHERE m.slug = $1
+// SearchMentors runs a full-text search over visible mentors' name, job, competencies, about and
+// description, best match first. query uses web search syntax: words, "quoted phrases", or, -word.
+// Weighted filters.Keywords replace the query's rank; filters.ExcludeKeywords drop mentors matching
+// any of them. The limit is capped; secure fields are cleared as for the public list.
+func (r *MentorRepository) SearchMentors(ctx context.Context, query string, filters models.MentorTextSearchFilters, limit, offset int) ([]*models.Mentor, error) {
+	limit, offset = models.ClampMentorSearchPage(limit, offset)
+
+	conditions := []string{
+		"m.status = 'active'",
+		"m.telegram_chat_id IS NOT NULL",
+		"m.deleted_at IS NULL",
+		"m.search_vector @@ websearch_to_tsquery('russian', $1)",
+	}
+	args := []interface{}{query}
+	where := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(filters.Tags) > 0 {
+		tags := make([]string, len(filters.Tags))
+		for i, tag := range filters.Tags {
+			tags[i] = strings.ToLower(strings.TrimSpace(tag))
+		}
+		where(`EXISTS (
+			SELECT 1 FROM mentor_tags ft JOIN tags ftn ON ftn.id = ft.tag_id
+			WHERE ft.mentor_id = m.id AND lower(ftn.name) = ANY($%d::text[]))`, tags)
+	}
+	if filters.Experience != "" {
+		where("strpos(lower(COALESCE(m.experience, '')), lower($%d)) > 0", filters.Experience)
+	}
+	if filters.Workplace != "" {
+		where("strpos(lower(COALESCE(m.workplace, '')), lower($%d)) > 0", filters.Workplace)
+	}
+	if filters.Country != "" {
+		where("m.country = upper($%d)", filters.Country)
+	}
+	if filters.City != "" {
+		where("lower(trim(m.city)) = lower(trim($%d))", filters.City)
+	}
+	if filters.RemoteOnly != nil {
+		where("m.remote_only = $%d", *filters.RemoteOnly)
+	}
+	if len(filters.Languages) > 0 {
+		where("m.languages && $%d::text[]", filters.Languages)
+	}
+	if len(filters.ExcludeKeywords) > 0 {
+		where(`NOT EXISTS (
+			SELECT 1 FROM unnest($%d::text[]) AS ex(keyword)
+			WHERE m.search_vector @@ websearch_to_tsquery('russian', ex.keyword))`, filters.ExcludeKeywords)
+	}
+
+	rank := "ts_rank_cd(m.search_vector, websearch_to_tsquery('russian', $1))"
+	if len(filters.Keywords) > 0 {
+		keywords := make([]string, len(filters.Keywords))
+		weights := make([]float64, len(filters.Keywords))
+		for i, keyword := range filters.Keywords {
+			keywords[i], weights[i] = keyword.Keyword, keyword.Weight
+		}
+		args = append(args, keywords, weights)
+		rank = fmt.Sprintf(`(
+			SELECT SUM(kw.weight * ts_rank_cd(m.search_vector, websearch_to_tsquery('russian', kw.keyword)))
+			FROM unnest($%d::text[], $%d::float8[]) AS kw(keyword, weight))`, len(args)-1, len(args))
+	}
+
+	args = append(args, limit, offset)
+	sql := mentorSelect + `
+		WHERE ` + strings.Join(conditions, " AND ") + `
 		GROUP BY m.id
-	`
+		ORDER BY ` + rank + ` DESC, m.sort_order, m.id
+		LIMIT $` + strconv.Itoa(len(args)-1) + ` OFFSET $` + strconv.Itoa(len(args))
 
-	row := r.pool.QueryRow(ctx, query, mentorSlug)
+	rows, err := r.db(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search mentors: %w", err)
+	}
+	mentors, err := models.ScanMentors(rows)
+	if err != nil {
+		return nil, err
+	}
+	return r.applyFilters(mentors, models.FilterOptions{OnlyVisible: true}), nil
+}
+
+// nullableUUID passes an empty ID as NULL, since an empty string doesn't cast to uuid
+func nullableUUID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// FetchSingleMentorFromDB retrieves a single mentor by slug from PostgreSQL
+func (r *MentorRepository) FetchSingleMentorFromDB(ctx context.Context, mentorSlug string) (*models.Mentor, error) {
+	row := r.db(ctx).QueryRow(ctx, mentorBySlugQuery, mentorSlug)
 	return models.ScanMentor(row)
 }
 
@@ -520,7 +970,7 @@ func (r *MentorRepository) FetchSingleMentorFromDB(ctx context.Context, mentorSl
 func (r *MentorRepository) FetchAllTagsFromDB(ctx context.Context) (map[string]string, error) {
 	query := `SELECT id, name FROM tags ORDER BY name`
 
-	rows, err := r.pool.Query(ctx, query)
+	rows, err := r.db(ctx).Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch tags: %w", err)
 	}
@@ -557,11 +1007,11 @@ func (r *MentorRepository) ListForModeration(ctx context.Context, statuses []str
 			m.status,
 			m.created_at
 		FROM mentors m
-		WHERE m.status = ANY($1)
+		WHERE m.status = ANY($1) AND m.deleted_at IS NULL
 		ORDER BY m.created_at DESC
 	`
 
-	rows, err := r.pool.Query(ctx, query, statuses)
+	rows, err := r.db(ctx).Query(ctx, query, statuses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list mentors for moderation: %w", err)
 	}
@@ -613,21 +1063,29 @@ func (r *MentorRepository) GetForModerationByID(ctx context.Context, mentorID st
 			COALESCE(m.details, ''),
 			COALESCE(m.competencies, ''),
 			COALESCE(m.calendar_url, ''),
+			COALESCE(m.timezone, ''),
+			COALESCE(m.contact_hours, ''),
+			COALESCE(m.country, ''),
+			COALESCE(m.city, ''),
+			m.remote_only,
+			m.languages,
 			m.status,
 			COALESCE(m.sort_order, 0),
 			m.telegram_chat_id,
+			m.merged_into::text,
 			m.created_at,
-			m.updated_at
+			m.updated_at,
+			m.version
 		FROM mentors m
 		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
 		LEFT JOIN tags t ON t.id = mt.tag_id
-		WHERE m.id = $1
+		WHERE m.id = $1 AND m.deleted_at IS NULL
 		GROUP BY m.id
 	`
 
 	var mentor models.AdminMentorDetails
 	var tags []string
-	if err := r.pool.QueryRow(ctx, query, mentorID).Scan(
+	if err := r.db(ctx).QueryRow(ctx, query, mentorID).Scan(
 		&mentor.MentorID,
 		&mentor.LegacyID,
 		&mentor.Slug,
@@ -643,11 +1101,19 @@ func (r *MentorRepository) GetForModerationByID(ctx context.Context, mentorID st
 		&mentor.Description,
 		&mentor.Competencies,
 		&mentor.CalendarURL,
+		&mentor.Timezone,
+		&mentor.ContactHours,
+		&mentor.Country,
+		&mentor.City,
+		&mentor.RemoteOnly,
+		&mentor.Languages,
 		&mentor.Status,
 		&mentor.SortOrder,
 		&mentor.TelegramChatID,
+		&mentor.MergedInto,
 		&mentor.CreatedAt,
 		&mentor.UpdatedAt,
+		&mentor.Version,
 	); err != nil {
 		return nil, fmt.Errorf("failed to fetch mentor for moderation: %w", err)
 	}
@@ -659,10 +1125,11 @@ func (r *MentorRepository) GetForModerationByID(ctx context.Context, mentorID st
 func (r *MentorRepository) SetMentorStatus(ctx context.Context, mentorID, status string) error {
 	query := `
 		UPDATE mentors
-		SET status = $1, updated_at = NOW()
+		SET status = $1, version = version + 1, updated_at = NOW(),
+			deactivated_at = CASE WHEN $1 = 'inactive' THEN COALESCE(deactivated_at, NOW()) END
 		WHERE id = $2
 	`
-	commandTag, err := r.pool.Exec(ctx, query, status, mentorID)
+	commandTag, err := r.db(ctx).Exec(ctx, query, status, mentorID)
 	if err != nil {
 		return fmt.Errorf("failed to update mentor status: %w", err)
 	}
@@ -672,6 +1139,149 @@ func (r *MentorRepository) SetMentorStatus(ctx context.Context, mentorID, status
 	return nil
 }
 
+// SoftDelete marks the mentor as deleted and returns their slug. The row is kept for request
+// history, but the mentor is hidden from every read path and can no longer log in or receive
+// requests. It must run inside a unit of work.
+func (r *MentorRepository) SoftDelete(ctx context.Context, mentorID, moderatorID string) (string, error) {
+	var slug string
+	err := r.db(ctx).QueryRow(ctx, `
+		UPDATE mentors
+		SET deleted_at = NOW(), deleted_by = NULLIF($2, '')::uuid, status = 'inactive',
+			deactivated_at = COALESCE(deactivated_at, NOW()), telegram_chat_id = NULL,
+			login_token = NULL, login_token_expires_at = NULL, calendar_feed_token = NULL,
+			version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING slug
+	`, mentorID, moderatorID).Scan(&slug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrDeleteMentorNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to soft-delete mentor: %w", err)
+	}
+
+	if _, err := r.db(ctx).Exec(ctx, `
+		UPDATE mentor_device_sessions SET revoked_at = NOW() WHERE mentor_id = $1 AND revoked_at IS NULL
+	`, mentorID); err != nil {
+		return "", fmt.Errorf("failed to revoke deleted mentor sessions: %w", err)
+	}
+	return slug, nil
+}
+
+// RecordModerationEvent stores an approve/decline decision. moderatorID may be empty.
+func (r *MentorRepository) RecordModerationEvent(ctx context.Context, mentorID, action, moderatorID string) error {
+	query := `
+		INSERT INTO mentor_moderation_events (mentor_id, action, moderator_id)
+		VALUES ($1, $2, NULLIF($3, '')::uuid)
+	`
+	if _, err := r.db(ctx).Exec(ctx, query, mentorID, action, moderatorID); err != nil {
+		return fmt.Errorf("failed to record moderation event: %w", err)
+	}
+	return nil
+}
+
+// ClaimChannelPost marks the mentor as announced in the Telegram channel.
+// Returns false when the mentor was announced before.
+func (r *MentorRepository) ClaimChannelPost(ctx context.Context, mentorID string) (bool, error) {
+	query := `
+		INSERT INTO mentor_channel_posts (mentor_id)
+		VALUES ($1)
+		ON CONFLICT (mentor_id) DO NOTHING
+	`
+	commandTag, err := r.db(ctx).Exec(ctx, query, mentorID)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim channel post: %w", err)
+	}
+	return commandTag.RowsAffected() == 1, nil
+}
+
+// GetCalendarFeedToken returns the mentor's sessions feed token, empty when none was issued yet
+func (r *MentorRepository) GetCalendarFeedToken(ctx context.Context, mentorID string) (string, error) {
+	var token *string
+	err := r.db(ctx).QueryRow(ctx, `SELECT calendar_feed_token FROM mentors WHERE id = $1`, mentorID).Scan(&token)
+	if err != nil {
+		return "", fmt.Errorf("failed to get calendar feed token: %w", err)
+	}
+	if token == nil {
+		return "", nil
+	}
+	return *token, nil
+}
+
+// GetTelegram returns the mentor's Telegram handle as they entered it, empty when none is set
+func (r *MentorRepository) GetTelegram(ctx context.Context, mentorID string) (string, error) {
+	var telegram string
+	err := r.db(ctx).QueryRow(ctx, `SELECT COALESCE(telegram, '') FROM mentors WHERE id = $1`, mentorID).Scan(&telegram)
+	if err != nil {
+		return "", fmt.Errorf("failed to get mentor telegram: %w", err)
+	}
+	return telegram, nil
+}
+
+// SetCalendarFeedToken replaces the mentor's sessions feed token, revoking the previous one
+func (r *MentorRepository) SetCalendarFeedToken(ctx context.Context, mentorID, token string) error {
+	commandTag, err := r.db(ctx).Exec(ctx, `UPDATE mentors SET calendar_feed_token = $1 WHERE id = $2`, token, mentorID)
+	if err != nil {
+		return fmt.Errorf("failed to set calendar feed token: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("mentor with ID %s not found", mentorID)
+	}
+	return nil
+}
+
+// GetIDByCalendarFeedToken resolves a sessions feed token to the active mentor it belongs to
+func (r *MentorRepository) GetIDByCalendarFeedToken(ctx context.Context, token string) (string, error) {
+	var mentorID string
+	err := r.db(ctx).QueryRow(ctx,
+		`SELECT id FROM mentors WHERE calendar_feed_token = $1 AND status IN ('active', 'inactive') AND deleted_at IS NULL`, token,
+	).Scan(&mentorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrCalendarFeedNotFound
+		}
+		return "", fmt.Errorf("failed to resolve calendar feed token: %w", err)
+	}
+	return mentorID, nil
+}
+
+// ListApprovedSince returns visible mentors approved after since, most recent first
+func (r *MentorRepository) ListApprovedSince(ctx context.Context, since time.Time, limit int) ([]models.MentorApproval, error) {
+	query := `
+		SELECT m.slug, MAX(e.created_at) AS approved_at
+		FROM mentor_moderation_events e
+		JOIN mentors m ON m.id = e.mentor_id
+		WHERE e.action = 'approve'
+			AND e.created_at > $1
+			AND m.status = 'active'
+			AND m.deleted_at IS NULL
+			AND m.telegram_chat_id IS NOT NULL
+		GROUP BY m.id
+		ORDER BY approved_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db(ctx).Query(ctx, query, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentor approvals: %w", err)
+	}
+	defer rows.Close()
+
+	approvals := []models.MentorApproval{}
+	for rows.Next() {
+		var approval models.MentorApproval
+		if err := rows.Scan(&approval.Slug, &approval.ApprovedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan mentor approval: %w", err)
+		}
+		approvals = append(approvals, approval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mentor approvals: %w", err)
+	}
+
+	return approvals, nil
+}
+
 // applyFilters applies filtering options to a mentor list
 func (r *MentorRepository) applyFilters(mentors []*models.Mentor, opts models.FilterOptions) []*models.Mentor {
 	result := make([]*models.Mentor, 0, len(mentors))
@@ -720,12 +1330,26 @@ func (r *MentorRepository) applySingleMentorFilters(mentor *models.Mentor, opts
 	return mentor
 }
 
-// TouchUpdatedAt sets updated_at = NOW() for the given mentor without changing any other fields
+// TouchUpdatedAt sets updated_at = NOW() and bumps the version of the given mentor without
+// changing any other fields
 func (r *MentorRepository) TouchUpdatedAt(ctx context.Context, mentorID string) error {
-	_, err := r.pool.Exec(ctx, `UPDATE mentors SET updated_at = NOW() WHERE id = $1`, mentorID)
+	_, err := r.db(ctx).Exec(ctx, `UPDATE mentors SET version = version + 1, updated_at = NOW() WHERE id = $1`, mentorID)
 	return err
 }
 
+// GetVersion returns the mentor's current row version, read from the database rather than the cache
+func (r *MentorRepository) GetVersion(ctx context.Context, mentorID string) (int, error) {
+	var version int
+	err := r.db(ctx).QueryRow(ctx, `SELECT version FROM mentors WHERE id = $1 AND deleted_at IS NULL`, mentorID).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("mentor with ID %s not found", mentorID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mentor version: %w", err)
+	}
+	return version, nil
+}
+
 // InvalidateCache forces cache invalidation
 func (r *MentorRepository) InvalidateCache() {
 	r.mentorCache.Clear()
@@ -737,6 +1361,19 @@ func (r *MentorRepository) UpdateSingleMentorCache(mentorSlug string) error {
 	return r.mentorCache.UpdateSingleMentor(mentorSlug)
 }
 
+// ReloadMentorInCache reloads one mentor into the cache from PostgreSQL, or removes it when it
+// no longer exists, and reports whether it was removed
+func (r *MentorRepository) ReloadMentorInCache(ctx context.Context, mentorSlug string) (bool, error) {
+	_, err := r.FetchSingleMentorFromDB(ctx, mentorSlug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true, r.mentorCache.RemoveMentor(mentorSlug)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read mentor: %w", err)
+	}
+	return false, r.mentorCache.UpdateSingleMentor(mentorSlug)
+}
+
 // RemoveMentorFromCache removes a mentor from cache
 // Called when a mentor is deleted
 func (r *MentorRepository) RemoveMentorFromCache(mentorSlug string) error {
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrMentorStatsNotFound is returned for mentors the mentor_stats view doesn't have yet
+var ErrMentorStatsNotFound = errors.New("mentor stats not found")
+
+// mentorStatsRefreshLock is the advisory lock key that keeps API instances from
+// refreshing the view at the same time
+const mentorStatsRefreshLock = 7423003
+
+// MentorStatsRepository refreshes and reads the mentor_stats materialized view
+type MentorStatsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorStatsRepository creates a new mentor stats repository
+func NewMentorStatsRepository(pool *pgxpool.Pool) *MentorStatsRepository {
+	return &MentorStatsRepository{
+		pool: pool,
+	}
+}
+
+// Refresh recomputes the view without blocking readers. Must run inside a unit of work:
+// the refresh lock is held until it commits.
+func (r *MentorStatsRepository) Refresh(ctx context.Context) error {
+	db := conn(ctx, r.pool)
+	if _, err := db.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, mentorStatsRefreshLock); err != nil {
+		return fmt.Errorf("failed to lock mentor stats refresh: %w", err)
+	}
+	if _, err := db.Exec(ctx, `REFRESH MATERIALIZED VIEW CONCURRENTLY mentor_stats`); err != nil {
+		return fmt.Errorf("failed to refresh mentor stats: %w", err)
+	}
+	return nil
+}
+
+// Get returns a mentor's statistics as of the last refresh
+func (r *MentorStatsRepository) Get(ctx context.Context, mentorID string) (*models.MentorStats, error) {
+	var s models.MentorStats
+	// Compared as text so malformed IDs are simply not found
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT mentor_id, requests, sessions_done, declined, median_response_hours,
+			last_activity_at, computed_at
+		FROM mentor_stats
+		WHERE mentor_id::text = $1
+	`, mentorID).Scan(&s.MentorID, &s.Requests, &s.SessionsDone, &s.Declined, &s.MedianResponseHours,
+		&s.LastActivityAt, &s.ComputedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMentorStatsNotFound
+		}
+		return nil, fmt.Errorf("failed to get mentor stats: %w", err)
+	}
+	s.SetDeclineRate()
+	return &s, nil
+}
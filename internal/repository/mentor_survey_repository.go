@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrMentorSurveyNotFound is returned when a mentor has no open survey
+var ErrMentorSurveyNotFound = errors.New("no open survey")
+
+// mentorSurveyDispatchLock is the advisory lock key that keeps API instances from
+// dispatching survey invitations at the same time
+const mentorSurveyDispatchLock = 7423001
+
+const mentorSurveySelect = `
+	SELECT id, mentor_id, invited_at, expires_at, responded_at,
+		nps, satisfaction, request_quality, would_continue, comment
+	FROM mentor_surveys
+`
+
+// MentorSurveyRepository stores mentor survey invitations and responses
+type MentorSurveyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMentorSurveyRepository creates a new mentor survey repository
+func NewMentorSurveyRepository(pool *pgxpool.Pool) *MentorSurveyRepository {
+	return &MentorSurveyRepository{
+		pool: pool,
+	}
+}
+
+// Invite creates invitations for up to limit active mentors registered before
+// registeredBefore who weren't invited within the cooldown, and returns them.
+// Must run inside a unit of work: the advisory lock is held until it commits.
+func (r *MentorSurveyRepository) Invite(
+	ctx context.Context,
+	registeredBefore time.Time,
+	expiresAt time.Time,
+	limit int,
+) ([]*models.MentorSurvey, error) {
+
+	db := conn(ctx, r.pool)
+	if _, err := db.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, mentorSurveyDispatchLock); err != nil {
+		return nil, fmt.Errorf("failed to lock survey dispatch: %w", err)
+	}
+
+	rows, err := db.Query(ctx, `
+		INSERT INTO mentor_surveys (mentor_id, expires_at)
+		SELECT m.id, $2
+		FROM mentors m
+		WHERE m.status = 'active' AND m.merged_into IS NULL AND m.created_at < $1
+			AND NOT EXISTS (
+				SELECT 1 FROM mentor_surveys s
+				WHERE s.mentor_id = m.id AND s.invited_at > now() - make_interval(secs => $4)
+			)
+		ORDER BY m.created_at
+		LIMIT $3
+		RETURNING id, mentor_id, invited_at, expires_at, responded_at,
+			nps, satisfaction, request_quality, would_continue, comment
+	`, registeredBefore, expiresAt, limit, models.MentorSurveyCooldown.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create survey invitations: %w", err)
+	}
+	defer rows.Close()
+
+	surveys := []*models.MentorSurvey{}
+	for rows.Next() {
+		survey, scanErr := scanMentorSurvey(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		surveys = append(surveys, survey)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate survey invitations: %w", err)
+	}
+	return surveys, nil
+}
+
+// GetOpen returns the mentor's unanswered, unexpired survey, or nil when there is none
+func (r *MentorSurveyRepository) GetOpen(ctx context.Context, mentorID string) (*models.MentorSurvey, error) {
+	survey, err := scanMentorSurvey(conn(ctx, r.pool).QueryRow(ctx, mentorSurveySelect+`
+		WHERE mentor_id = $1 AND responded_at IS NULL AND expires_at > now()
+		ORDER BY invited_at DESC
+		LIMIT 1
+	`, mentorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return survey, err
+}
+
+// Respond stores the answers on the mentor's open survey. It fails with
+// ErrMentorSurveyNotFound when the mentor has no open survey.
+func (r *MentorSurveyRepository) Respond(ctx context.Context, mentorID string, req *models.SubmitMentorSurveyRequest) (*models.MentorSurvey, error) {
+	survey, err := scanMentorSurvey(conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE mentor_surveys
+		SET responded_at = now(), nps = $2, satisfaction = $3, request_quality = $4,
+			would_continue = $5, comment = $6
+		WHERE id = (
+			SELECT id FROM mentor_surveys
+			WHERE mentor_id = $1 AND responded_at IS NULL AND expires_at > now()
+			ORDER BY invited_at DESC
+			LIMIT 1
+		) AND responded_at IS NULL
+		RETURNING id, mentor_id, invited_at, expires_at, responded_at,
+			nps, satisfaction, request_quality, would_continue, comment
+	`, mentorID, req.NPS, req.Satisfaction, req.RequestQuality, req.WouldContinue, req.Comment))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMentorSurveyNotFound
+	}
+	return survey, err
+}
+
+// Quarters aggregates the surveys by the quarter they were sent in, newest first,
+// starting from the quarter containing since
+func (r *MentorSurveyRepository) Quarters(ctx context.Context, since time.Time) ([]models.MentorSurveyQuarter, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT date_trunc('quarter', invited_at AT TIME ZONE 'UTC') AS quarter,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE responded_at IS NOT NULL),
+			COUNT(*) FILTER (WHERE nps >= 9),
+			COUNT(*) FILTER (WHERE nps BETWEEN 7 AND 8),
+			COUNT(*) FILTER (WHERE nps <= 6),
+			AVG(satisfaction)::float8,
+			AVG(request_quality)::float8,
+			COUNT(*) FILTER (WHERE would_continue)
+		FROM mentor_surveys
+		WHERE invited_at >= $1
+		GROUP BY quarter
+		ORDER BY quarter DESC
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate mentor surveys: %w", err)
+	}
+	defer rows.Close()
+
+	quarters := []models.MentorSurveyQuarter{}
+	for rows.Next() {
+		var q models.MentorSurveyQuarter
+		if err := rows.Scan(&q.Start, &q.Invited, &q.Responded, &q.Promoters, &q.Passives, &q.Detractors,
+			&q.AvgSatisfaction, &q.AvgRequestQuality, &q.WouldContinueCount); err != nil {
+			return nil, fmt.Errorf("failed to scan mentor survey quarter: %w", err)
+		}
+		quarters = append(quarters, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mentor survey quarters: %w", err)
+	}
+	return quarters, nil
+}
+
+func scanMentorSurvey(row pgx.Row) (*models.MentorSurvey, error) {
+	var s models.MentorSurvey
+	if err := row.Scan(&s.ID, &s.MentorID, &s.InvitedAt, &s.ExpiresAt, &s.RespondedAt,
+		&s.NPS, &s.Satisfaction, &s.RequestQuality, &s.WouldContinue, &s.Comment); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan mentor survey: %w", err)
+	}
+	return &s, nil
+}
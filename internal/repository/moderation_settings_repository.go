@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrModerationSettingsNotFound is returned when a version of the moderation rules doesn't exist
+	ErrModerationSettingsNotFound = errors.New("moderation settings version not found")
+	// ErrModerationSettingsConflict is returned when another version was saved after the base version
+	ErrModerationSettingsConflict = errors.New("moderation settings were changed by someone else")
+)
+
+const moderationSettingsSelect = `
+	SELECT version, rules, note, created_by, created_at
+	FROM moderation_settings
+`
+
+// ModerationSettingsRepository stores versions of the moderation rules
+type ModerationSettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewModerationSettingsRepository creates a new moderation settings repository
+func NewModerationSettingsRepository(pool *pgxpool.Pool) *ModerationSettingsRepository {
+	return &ModerationSettingsRepository{
+		pool: pool,
+	}
+}
+
+// GetCurrent returns the latest version, or nil when none was saved
+func (r *ModerationSettingsRepository) GetCurrent(ctx context.Context) (*models.ModerationSettings, error) {
+	settings, err := scanModerationSettings(conn(ctx, r.pool).QueryRow(ctx, moderationSettingsSelect+" ORDER BY version DESC LIMIT 1"))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return settings, err
+}
+
+// GetVersion returns a saved version
+func (r *ModerationSettingsRepository) GetVersion(ctx context.Context, version int) (*models.ModerationSettings, error) {
+	settings, err := scanModerationSettings(conn(ctx, r.pool).QueryRow(ctx, moderationSettingsSelect+" WHERE version = $1", version))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrModerationSettingsNotFound
+	}
+	return settings, err
+}
+
+// List returns the latest versions, newest first
+func (r *ModerationSettingsRepository) List(ctx context.Context, limit int) ([]*models.ModerationSettings, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, moderationSettingsSelect+" ORDER BY version DESC LIMIT $1", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query moderation settings: %w", err)
+	}
+	defer rows.Close()
+
+	versions := []*models.ModerationSettings{}
+	for rows.Next() {
+		settings, scanErr := scanModerationSettings(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		versions = append(versions, settings)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate moderation settings: %w", err)
+	}
+	return versions, nil
+}
+
+// Create saves settings as the version after baseVersion. It fails with
+// ErrModerationSettingsConflict when baseVersion is no longer the latest version.
+func (r *ModerationSettingsRepository) Create(ctx context.Context, baseVersion int, settings *models.ModerationSettings) error {
+	rules, err := json.Marshal(settings.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to encode moderation rules: %w", err)
+	}
+
+	// The primary key makes concurrent saves on the same base version fail; the
+	// NOT EXISTS check catches saves on a version that is no longer the latest.
+	err = conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO moderation_settings (version, rules, note, created_by)
+		SELECT $1 + 1, $2, $3, $4
+		WHERE NOT EXISTS (SELECT 1 FROM moderation_settings WHERE version > $1)
+		RETURNING version, created_at
+	`, baseVersion, rules, settings.Note, settings.CreatedBy).Scan(&settings.Version, &settings.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrModerationSettingsConflict
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrModerationSettingsConflict
+		}
+		return fmt.Errorf("failed to save moderation settings: %w", err)
+	}
+	return nil
+}
+
+func scanModerationSettings(row pgx.Row) (*models.ModerationSettings, error) {
+	var s models.ModerationSettings
+	var rules []byte
+	if err := row.Scan(&s.Version, &rules, &s.Note, &s.CreatedBy, &s.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan moderation settings: %w", err)
+	}
+
+	// Versions saved before a rule existed keep its default
+	s.Rules = models.DefaultModerationRules()
+	if err := json.Unmarshal(rules, &s.Rules); err != nil {
+		return nil, fmt.Errorf("failed to decode moderation rules of version %d: %w", s.Version, err)
+	}
+	return &s, nil
+}
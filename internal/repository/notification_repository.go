@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotificationRepository keeps mentors' quiet hours and the notifications held during them
+type NotificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(pool *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{
+		pool: pool,
+	}
+}
+
+// QuietHours returns the mentor's quiet hours and profile timezone, empty when unset or when
+// there is no such mentor
+func (r *NotificationRepository) QuietHours(ctx context.Context, mentorID string) (quietHours, timezone string, err error) {
+	err = conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT COALESCE(s.quiet_hours, ''), COALESCE(m.timezone, '')
+		FROM mentors m
+		LEFT JOIN mentor_notification_settings s ON s.mentor_id = m.id
+		WHERE m.id = $1
+	`, mentorID).Scan(&quietHours, &timezone)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read quiet hours: %w", err)
+	}
+	return quietHours, timezone, nil
+}
+
+// SetQuietHours stores the mentor's quiet hours; an empty value turns them off
+func (r *NotificationRepository) SetQuietHours(ctx context.Context, mentorID, quietHours string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO mentor_notification_settings (mentor_id, quiet_hours) VALUES ($1, NULLIF($2, ''))
+		ON CONFLICT (mentor_id) DO UPDATE SET quiet_hours = EXCLUDED.quiet_hours, updated_at = NOW()
+	`, mentorID, quietHours)
+	if err != nil {
+		return fmt.Errorf("failed to save quiet hours: %w", err)
+	}
+	return nil
+}
+
+// CountQueued returns how many notifications are held for the mentor
+func (r *NotificationRepository) CountQueued(ctx context.Context, mentorID string) (int, error) {
+	var count int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COUNT(*) FROM queued_notifications WHERE mentor_id = $1`, mentorID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count queued notifications: %w", err)
+	}
+	return count, nil
+}
+
+// Enqueue holds a notification until deliverAfter
+func (r *NotificationRepository) Enqueue(ctx context.Context, notification *models.MentorNotification, deliverAfter time.Time) error {
+	var payload []byte
+	if notification.Payload != nil {
+		var err error
+		if payload, err = json.Marshal(notification.Payload); err != nil {
+			return fmt.Errorf("failed to encode notification payload: %w", err)
+		}
+	}
+
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO queued_notifications (mentor_id, channel, record_id, payload, deliver_after)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5)
+	`, notification.MentorID, notification.Channel, notification.RecordID, payload, deliverAfter)
+	if err != nil {
+		return fmt.Errorf("failed to queue notification: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue removes up to limit notifications due at now and returns them, oldest first. Rows
+// locked by another replica are skipped, so each notification is claimed once.
+func (r *NotificationRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]models.QueuedNotification, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		DELETE FROM queued_notifications
+		WHERE id IN (
+			SELECT id FROM queued_notifications
+			WHERE deliver_after <= $1
+			ORDER BY created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, mentor_id, channel, COALESCE(record_id, ''), payload, deliver_after, created_at
+	`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim queued notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]models.QueuedNotification, 0)
+	for rows.Next() {
+		var n models.QueuedNotification
+		var payload []byte
+		if err := rows.Scan(&n.ID, &n.MentorID, &n.Channel, &n.RecordID, &payload, &n.DeliverAfter, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan queued notification: %w", err)
+		}
+		n.Payload = payload
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating queued notifications: %w", err)
+	}
+	// RETURNING keeps no order
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+	return notifications, nil
+}
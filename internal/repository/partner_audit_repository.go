@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PartnerAuditRepository stores summaries of partner-token requests
+type PartnerAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPartnerAuditRepository creates a new partner audit repository
+func NewPartnerAuditRepository(pool *pgxpool.Pool) *PartnerAuditRepository {
+	return &PartnerAuditRepository{pool: pool}
+}
+
+// Insert stores a request summary
+func (r *PartnerAuditRepository) Insert(ctx context.Context, entry *models.PartnerRequestLog) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO partner_request_log
+			(occurred_at, token_id, method, route, path, query, status, result_count, response_bytes, latency_ms, trace_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, entry.OccurredAt, entry.TokenID, entry.Method, entry.Route, entry.Path, entry.Query, entry.Status,
+		entry.ResultCount, entry.ResponseBytes, entry.LatencyMs, entry.TraceID)
+	if err != nil {
+		return fmt.Errorf("failed to save partner request log: %w", err)
+	}
+	return nil
+}
+
+// List returns request summaries matching the filter, newest first
+func (r *PartnerAuditRepository) List(ctx context.Context, filter models.PartnerRequestLogFilter) ([]*models.PartnerRequestLog, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, occurred_at, token_id, method, route, path, query, status, result_count,
+			response_bytes, latency_ms, trace_id
+		FROM partner_request_log
+		WHERE occurred_at >= $1 AND occurred_at < $2
+			AND ($3 = '' OR token_id = $3)
+			AND ($4 = '' OR route = $4)
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $5
+	`, filter.From, filter.To, filter.TokenID, filter.Route, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query partner request log: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.PartnerRequestLog{}
+	for rows.Next() {
+		var e models.PartnerRequestLog
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.TokenID, &e.Method, &e.Route, &e.Path, &e.Query, &e.Status,
+			&e.ResultCount, &e.ResponseBytes, &e.LatencyMs, &e.TraceID); err != nil {
+			return nil, fmt.Errorf("failed to scan partner request log: %w", err)
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// DeleteOlderThan purges summaries recorded before cutoff and returns how many were removed
+func (r *PartnerAuditRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM partner_request_log WHERE occurred_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge partner request log: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PartnerQuotaRepository tracks monthly usage of partner tokens and admin limit overrides
+type PartnerQuotaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPartnerQuotaRepository creates a new partner quota repository
+func NewPartnerQuotaRepository(pool *pgxpool.Pool) *PartnerQuotaRepository {
+	return &PartnerQuotaRepository{pool: pool}
+}
+
+// Increment counts one request of the token in the period and returns the new count
+// together with the token's override limit, if any
+func (r *PartnerQuotaRepository) Increment(ctx context.Context, tokenName string, period time.Time) (int64, *int64, error) {
+	var used int64
+	var limit *int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH usage AS (
+			INSERT INTO partner_quota_usage (token_name, period, request_count)
+			VALUES ($1, $2, 1)
+			ON CONFLICT (token_name, period) DO UPDATE
+				SET request_count = partner_quota_usage.request_count + 1, updated_at = now()
+			RETURNING request_count
+		)
+		SELECT usage.request_count, o.monthly_limit
+		FROM usage
+		LEFT JOIN partner_quota_overrides o ON o.token_name = $1
+	`, tokenName, period).Scan(&used, &limit)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count partner request: %w", err)
+	}
+	return used, limit, nil
+}
+
+// UsageByToken returns the request count of every token that made requests in the period
+func (r *PartnerQuotaRepository) UsageByToken(ctx context.Context, period time.Time) (map[string]int64, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT token_name, request_count FROM partner_quota_usage WHERE period = $1
+	`, period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query partner usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var count int64
+		if err := rows.Scan(&name, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan partner usage: %w", err)
+		}
+		usage[name] = count
+	}
+	return usage, rows.Err()
+}
+
+// GetOverride returns the token's override, or nil when it uses the default limit
+func (r *PartnerQuotaRepository) GetOverride(ctx context.Context, tokenName string) (*models.PartnerQuotaOverride, error) {
+	var o models.PartnerQuotaOverride
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT token_name, monthly_limit, note, updated_by, updated_at
+		FROM partner_quota_overrides WHERE token_name = $1
+	`, tokenName).Scan(&o.TokenName, &o.MonthlyLimit, &o.Note, &o.UpdatedBy, &o.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partner quota override: %w", err)
+	}
+	return &o, nil
+}
+
+// ListOverrides returns all overrides keyed by token name
+func (r *PartnerQuotaRepository) ListOverrides(ctx context.Context) (map[string]*models.PartnerQuotaOverride, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT token_name, monthly_limit, note, updated_by, updated_at FROM partner_quota_overrides
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query partner quota overrides: %w", err)
+	}
+	defer rows.Close()
+
+	overrides := make(map[string]*models.PartnerQuotaOverride)
+	for rows.Next() {
+		var o models.PartnerQuotaOverride
+		if err := rows.Scan(&o.TokenName, &o.MonthlyLimit, &o.Note, &o.UpdatedBy, &o.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan partner quota override: %w", err)
+		}
+		overrides[o.TokenName] = &o
+	}
+	return overrides, rows.Err()
+}
+
+// UpsertOverride creates or replaces the token's override
+func (r *PartnerQuotaRepository) UpsertOverride(ctx context.Context, override *models.PartnerQuotaOverride) error {
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO partner_quota_overrides (token_name, monthly_limit, note, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token_name) DO UPDATE
+			SET monthly_limit = EXCLUDED.monthly_limit, note = EXCLUDED.note,
+				updated_by = EXCLUDED.updated_by, updated_at = now()
+		RETURNING updated_at
+	`, override.TokenName, override.MonthlyLimit, override.Note, override.UpdatedBy).Scan(&override.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save partner quota override: %w", err)
+	}
+	return nil
+}
+
+// DeleteOverride removes the token's override and reports whether one existed
+func (r *PartnerQuotaRepository) DeleteOverride(ctx context.Context, tokenName string) (bool, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM partner_quota_overrides WHERE token_name = $1`, tokenName)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete partner quota override: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
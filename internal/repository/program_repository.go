@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrProgramNotFound is returned when a program doesn't exist (or isn't visible to the caller)
+	ErrProgramNotFound = errors.New("program not found")
+	// ErrAlreadyRegistered is returned when the email is already registered for the program
+	ErrAlreadyRegistered = errors.New("already registered for this program")
+	// ErrRegistrationNotFound is returned when no registration matches the cancel token
+	ErrRegistrationNotFound = errors.New("program registration not found")
+)
+
+const registrationSelect = `
+	SELECT id, program_id, name, email, COALESCE(telegram, ''), COALESCE(comment, ''),
+		status, cancel_token, cancelled_at, created_at
+	FROM program_registrations
+`
+
+const programSelect = `
+	SELECT p.id, p.mentor_id, m.legacy_id, m.slug, m.name, p.title, COALESCE(p.description, ''),
+		p.starts_at, p.duration_minutes, COALESCE(p.schedule, ''), p.capacity, COALESCE(p.price, ''),
+		p.status,
+		(SELECT COUNT(*) FROM program_registrations pr WHERE pr.program_id = p.id AND pr.status = 'registered') AS registered_count,
+		(SELECT COUNT(*) FROM program_registrations pr WHERE pr.program_id = p.id AND pr.status = 'waitlisted') AS waitlist_count,
+		p.created_at, p.updated_at
+	FROM programs p
+	JOIN mentors m ON m.id = p.mentor_id
+`
+
+// ProgramRepository handles program and attendee data access
+type ProgramRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewProgramRepository creates a new program repository
+func NewProgramRepository(pool *pgxpool.Pool) *ProgramRepository {
+	return &ProgramRepository{
+		pool: pool,
+	}
+}
+
+// List returns programs matching the filter, ordered by start time
+func (r *ProgramRepository) List(ctx context.Context, filter models.ProgramFilter) ([]*models.Program, error) {
+	conditions := []string{}
+	args := []interface{}{}
+
+	if filter.MentorID != "" {
+		args = append(args, filter.MentorID)
+		conditions = append(conditions, fmt.Sprintf("p.mentor_id = $%d", len(args)))
+	}
+	if filter.MentorLegacyID != 0 {
+		args = append(args, filter.MentorLegacyID)
+		conditions = append(conditions, fmt.Sprintf("m.legacy_id = $%d", len(args)))
+	}
+	if filter.PublicOnly {
+		conditions = append(conditions,
+			"p.status = 'published'",
+			"p.starts_at > NOW()",
+			"m.status = 'active'",
+			"m.telegram_chat_id IS NOT NULL")
+	}
+
+	query := programSelect
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY p.starts_at"
+
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query programs: %w", err)
+	}
+	defer rows.Close()
+
+	programs := []*models.Program{}
+	for rows.Next() {
+		program, scanErr := scanProgram(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		programs = append(programs, program)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate programs: %w", err)
+	}
+
+	return programs, nil
+}
+
+// GetByID returns a single program
+func (r *ProgramRepository) GetByID(ctx context.Context, programID string) (*models.Program, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, programSelect+" WHERE p.id = $1", programID)
+	program, err := scanProgram(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrProgramNotFound
+	}
+	return program, err
+}
+
+// GetForRegistration returns a program and locks its row until the end of the
+// surrounding unit of work, so concurrent registrations see consistent seat counts
+func (r *ProgramRepository) GetForRegistration(ctx context.Context, programID string) (*models.Program, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, programSelect+" WHERE p.id = $1 FOR UPDATE OF p", programID)
+	program, err := scanProgram(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrProgramNotFound
+	}
+	return program, err
+}
+
+// Create inserts a program and returns its ID
+func (r *ProgramRepository) Create(ctx context.Context, mentorID string, req *models.ProgramRequest) (string, error) {
+	query := `
+		INSERT INTO programs (mentor_id, title, description, starts_at, duration_minutes, schedule, capacity, price, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	var programID string
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		mentorID, req.Title, req.Description, req.StartsAt, req.DurationMinutes,
+		req.Schedule, req.Capacity, req.Price, req.Status,
+	).Scan(&programID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create program: %w", err)
+	}
+	return programID, nil
+}
+
+// Update replaces the editable fields of a program
+func (r *ProgramRepository) Update(ctx context.Context, programID string, req *models.ProgramRequest) error {
+	query := `
+		UPDATE programs
+		SET title = $1, description = $2, starts_at = $3, duration_minutes = $4,
+			schedule = $5, capacity = $6, price = $7, status = $8
+		WHERE id = $9
+	`
+
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, query,
+		req.Title, req.Description, req.StartsAt, req.DurationMinutes,
+		req.Schedule, req.Capacity, req.Price, req.Status, programID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update program: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrProgramNotFound
+	}
+	return nil
+}
+
+// Delete removes a program together with its registrations
+func (r *ProgramRepository) Delete(ctx context.Context, programID string) error {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM programs WHERE id = $1`, programID)
+	if err != nil {
+		return fmt.Errorf("failed to delete program: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrProgramNotFound
+	}
+	return nil
+}
+
+// CreateRegistration registers (or waitlists) an attendee for a program
+func (r *ProgramRepository) CreateRegistration(ctx context.Context, registration *models.ProgramRegistration) (string, error) {
+	query := `
+		INSERT INTO program_registrations (program_id, name, email, telegram, comment, status, cancel_token)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var registrationID string
+	err := conn(ctx, r.pool).QueryRow(ctx, query,
+		registration.ProgramID, registration.Name, registration.Email, registration.Telegram, registration.Comment,
+		registration.Status, registration.CancelToken,
+	).Scan(&registrationID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return "", ErrAlreadyRegistered
+		}
+		return "", fmt.Errorf("failed to create program registration: %w", err)
+	}
+	return registrationID, nil
+}
+
+// GetRegistrationByToken returns the registration identified by its cancel token
+func (r *ProgramRepository) GetRegistrationByToken(ctx context.Context, token string) (*models.ProgramRegistration, error) {
+	var reg models.ProgramRegistration
+	err := conn(ctx, r.pool).QueryRow(ctx, registrationSelect+" WHERE cancel_token = $1", token).Scan(
+		&reg.ID,
+		&reg.ProgramID,
+		&reg.Name,
+		&reg.Email,
+		&reg.Telegram,
+		&reg.Comment,
+		&reg.Status,
+		&reg.CancelToken,
+		&reg.CancelledAt,
+		&reg.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRegistrationNotFound
+		}
+		return nil, fmt.Errorf("failed to get program registration: %w", err)
+	}
+	return &reg, nil
+}
+
+// CancelRegistration marks a registration as cancelled. Cancelling twice is a no-op.
+func (r *ProgramRepository) CancelRegistration(ctx context.Context, registrationID string) error {
+	query := `
+		UPDATE program_registrations
+		SET status = 'cancelled', cancelled_at = NOW()
+		WHERE id = $1 AND status <> 'cancelled'
+	`
+
+	if _, err := conn(ctx, r.pool).Exec(ctx, query, registrationID); err != nil {
+		return fmt.Errorf("failed to cancel program registration: %w", err)
+	}
+	return nil
+}
+
+// PromoteFromWaitlist moves the earliest waitlisted attendee of the program onto a free seat.
+// It returns the promoted registration ID, or an empty string when the waitlist is empty.
+func (r *ProgramRepository) PromoteFromWaitlist(ctx context.Context, programID string) (string, error) {
+	query := `
+		UPDATE program_registrations
+		SET status = 'registered'
+		WHERE id = (
+			SELECT id FROM program_registrations
+			WHERE program_id = $1 AND status = 'waitlisted'
+			ORDER BY created_at
+			LIMIT 1
+		)
+		RETURNING id
+	`
+
+	var registrationID string
+	err := conn(ctx, r.pool).QueryRow(ctx, query, programID).Scan(&registrationID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to promote waitlisted attendee: %w", err)
+	}
+	return registrationID, nil
+}
+
+func scanProgram(row pgx.Row) (*models.Program, error) {
+	var p models.Program
+	err := row.Scan(
+		&p.ID,
+		&p.MentorID,
+		&p.MentorLegacyID,
+		&p.MentorSlug,
+		&p.MentorName,
+		&p.Title,
+		&p.Description,
+		&p.StartsAt,
+		&p.DurationMinutes,
+		&p.Schedule,
+		&p.Capacity,
+		&p.Price,
+		&p.Status,
+		&p.RegisteredCount,
+		&p.WaitlistCount,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan program: %w", err)
+	}
+	return &p, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrReplyTemplateNotFound is returned when the template doesn't exist or belongs to another mentor
+var ErrReplyTemplateNotFound = errors.New("reply template not found")
+
+const replyTemplateSelect = `
+	SELECT id, mentor_id, title, body, created_at, updated_at
+	FROM mentor_reply_templates
+`
+
+// ReplyTemplateRepository handles mentor reply template data access
+type ReplyTemplateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReplyTemplateRepository creates a new reply template repository
+func NewReplyTemplateRepository(pool *pgxpool.Pool) *ReplyTemplateRepository {
+	return &ReplyTemplateRepository{
+		pool: pool,
+	}
+}
+
+// ListByMentor returns the mentor's templates, oldest first
+func (r *ReplyTemplateRepository) ListByMentor(ctx context.Context, mentorID string) ([]*models.ReplyTemplate, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, replyTemplateSelect+" WHERE mentor_id = $1 ORDER BY created_at", mentorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reply templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*models.ReplyTemplate{}
+	for rows.Next() {
+		template, scanErr := scanReplyTemplate(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate reply templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetByID returns one of the mentor's templates
+func (r *ReplyTemplateRepository) GetByID(ctx context.Context, mentorID, templateID string) (*models.ReplyTemplate, error) {
+	template, err := scanReplyTemplate(conn(ctx, r.pool).QueryRow(ctx,
+		replyTemplateSelect+" WHERE id = $1 AND mentor_id = $2", templateID, mentorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReplyTemplateNotFound
+	}
+	return template, err
+}
+
+// Count returns the number of templates the mentor has
+func (r *ReplyTemplateRepository) Count(ctx context.Context, mentorID string) (int, error) {
+	var count int
+	err := conn(ctx, r.pool).QueryRow(ctx,
+		`SELECT COUNT(*) FROM mentor_reply_templates WHERE mentor_id = $1`, mentorID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reply templates: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a template for the mentor and returns it
+func (r *ReplyTemplateRepository) Create(ctx context.Context, mentorID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error) {
+	query := `
+		INSERT INTO mentor_reply_templates (mentor_id, title, body)
+		VALUES ($1, $2, $3)
+		RETURNING id, mentor_id, title, body, created_at, updated_at
+	`
+
+	template, err := scanReplyTemplate(conn(ctx, r.pool).QueryRow(ctx, query, mentorID, req.Title, req.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reply template: %w", err)
+	}
+	return template, nil
+}
+
+// Update replaces the title and body of one of the mentor's templates
+func (r *ReplyTemplateRepository) Update(ctx context.Context, mentorID, templateID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error) {
+	query := `
+		UPDATE mentor_reply_templates
+		SET title = $3, body = $4
+		WHERE id = $1 AND mentor_id = $2
+		RETURNING id, mentor_id, title, body, created_at, updated_at
+	`
+
+	template, err := scanReplyTemplate(conn(ctx, r.pool).QueryRow(ctx, query, templateID, mentorID, req.Title, req.Body))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReplyTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update reply template: %w", err)
+	}
+	return template, nil
+}
+
+// Delete removes one of the mentor's templates
+func (r *ReplyTemplateRepository) Delete(ctx context.Context, mentorID, templateID string) error {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM mentor_reply_templates WHERE id = $1 AND mentor_id = $2`, templateID, mentorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reply template: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrReplyTemplateNotFound
+	}
+	return nil
+}
+
+func scanReplyTemplate(row pgx.Row) (*models.ReplyTemplate, error) {
+	var template models.ReplyTemplate
+	err := row.Scan(
+		&template.ID,
+		&template.MentorID,
+		&template.Title,
+		&template.Body,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan reply template: %w", err)
+	}
+	return &template, nil
+}
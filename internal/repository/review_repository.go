@@ -5,11 +5,31 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var (
+	// ErrReviewNotFound is returned when the review doesn't exist, belongs to another mentor or can't be acted on yet
+	ErrReviewNotFound = errors.New("review not found")
+	// ErrReviewReplyExists is returned when the mentor has already replied to the review
+	ErrReviewReplyExists = errors.New("review already has a reply")
+)
+
+const reviewSelect = `
+	SELECT rv.id, rv.client_request_id, m.id, m.name, COALESCE(rv.mentor_review, ''),
+		COALESCE(rv.platform_review, ''), COALESCE(rv.improvements, ''), rv.status, rv.moderated_at,
+		COALESCE(rv.mentor_reply, ''), COALESCE(rv.reply_status, ''), rv.replied_at, rv.created_at, rv.rating
+	FROM reviews rv
+	JOIN client_requests cr ON cr.id = rv.client_request_id
+	JOIN mentors m ON m.id = cr.mentor_id
+`
+
+// maxModerationQueue caps how many reviews the moderation queue returns at once
+const maxModerationQueue = 200
+
 // ReviewRepository handles review data access
 type ReviewRepository struct {
 	pool *pgxpool.Pool
@@ -62,17 +82,17 @@ func (r *ReviewRepository) CheckCanSubmitReview(ctx context.Context, requestID s
 	return &ReviewCheckResult{CanSubmit: true, MentorName: mentorName}, nil
 }
 
-// CreateReview creates a new review for a client request.
+// CreateReview creates a new review for a client request. rating is nil when the mentee didn't rate the session.
 // Returns the review ID, or an error if the review already exists (unique constraint).
-func (r *ReviewRepository) CreateReview(ctx context.Context, requestID, mentorReview, platformReview, improvements string) (string, error) {
+func (r *ReviewRepository) CreateReview(ctx context.Context, requestID string, rating *int, mentorReview, platformReview, improvements string) (string, error) {
 	query := `
-		INSERT INTO reviews (client_request_id, mentor_review, platform_review, improvements)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO reviews (client_request_id, rating, mentor_review, platform_review, improvements)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id
 	`
 
 	var reviewID string
-	err := r.pool.QueryRow(ctx, query, requestID, mentorReview, platformReview, improvements).Scan(&reviewID)
+	err := r.pool.QueryRow(ctx, query, requestID, rating, mentorReview, platformReview, improvements).Scan(&reviewID)
 	if err != nil {
 		// Check for unique constraint violation (review already exists)
 		var pgErr *pgconn.PgError
@@ -84,3 +104,131 @@ func (r *ReviewRepository) CreateReview(ctx context.Context, requestID, mentorRe
 
 	return reviewID, nil
 }
+
+// ListForModeration returns reviews matching the filter, oldest first
+func (r *ReviewRepository) ListForModeration(ctx context.Context, filter models.AdminReviewFilter) ([]*models.Review, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, reviewSelect+`
+		WHERE ($1 = '' OR rv.status = $1) AND ($2 = '' OR rv.reply_status = $2)
+		ORDER BY COALESCE(rv.replied_at, rv.created_at), rv.id
+		LIMIT $3
+	`, filter.Status, filter.ReplyStatus, maxModerationQueue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	return collectReviews(rows)
+}
+
+// ListApprovedByMentor returns the mentor's approved reviews, newest first
+func (r *ReviewRepository) ListApprovedByMentor(ctx context.Context, mentorID string) ([]*models.Review, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, reviewSelect+`
+		WHERE cr.mentor_id = $1 AND rv.status = 'approved'
+		ORDER BY rv.created_at DESC
+	`, mentorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentor reviews: %w", err)
+	}
+	return collectReviews(rows)
+}
+
+// SetStatus records a moderator's verdict on a review and returns the reviewed mentor's slug
+func (r *ReviewRepository) SetStatus(ctx context.Context, id, status, moderatorID string) (string, error) {
+	var slug string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE reviews rv SET status = $2, moderated_by = $3, moderated_at = now()
+		FROM client_requests cr
+		JOIN mentors m ON m.id = cr.mentor_id
+		WHERE rv.id = $1 AND cr.id = rv.client_request_id
+		RETURNING m.slug
+	`, id, status, moderatorID).Scan(&slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrReviewNotFound
+		}
+		return "", fmt.Errorf("failed to update review status: %w", err)
+	}
+	return slug, nil
+}
+
+// SetReplyStatus records a moderator's verdict on the mentor's reply to a review
+func (r *ReviewRepository) SetReplyStatus(ctx context.Context, id, status, moderatorID string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE reviews SET reply_status = $2, reply_moderated_by = $3, reply_moderated_at = now()
+		WHERE id = $1 AND mentor_reply IS NOT NULL
+	`, id, status, moderatorID)
+	if err != nil {
+		return fmt.Errorf("failed to update reply status: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrReviewNotFound
+	}
+	return nil
+}
+
+// AddReply stores the mentor's reply to one of their approved reviews for moderation.
+// A review takes a single reply: once written, it can't be replaced, even when rejected.
+func (r *ReviewRepository) AddReply(ctx context.Context, mentorID, id, reply string) (*models.Review, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE reviews rv
+		SET mentor_reply = $3, reply_status = 'pending', replied_at = now()
+		FROM client_requests cr
+		WHERE rv.id = $1 AND cr.id = rv.client_request_id AND cr.mentor_id = $2
+			AND rv.status = 'approved' AND rv.mentor_reply IS NULL
+	`, id, mentorID, reply)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save reply: %w", err)
+	}
+
+	review, err := r.getByMentor(ctx, mentorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		if review.Reply != "" {
+			return nil, ErrReviewReplyExists
+		}
+		return nil, ErrReviewNotFound
+	}
+	return review, nil
+}
+
+// getByMentor returns one of the mentor's approved reviews
+func (r *ReviewRepository) getByMentor(ctx context.Context, mentorID, id string) (*models.Review, error) {
+	review, err := scanReview(conn(ctx, r.pool).QueryRow(ctx, reviewSelect+`
+		WHERE rv.id = $1 AND cr.mentor_id = $2 AND rv.status = 'approved'
+	`, id, mentorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReviewNotFound
+	}
+	return review, err
+}
+
+func collectReviews(rows pgx.Rows) ([]*models.Review, error) {
+	defer rows.Close()
+
+	reviews := []*models.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+func scanReview(row pgx.Row) (*models.Review, error) {
+	var rv models.Review
+	err := row.Scan(&rv.ID, &rv.RequestID, &rv.MentorID, &rv.MentorName, &rv.MentorReview,
+		&rv.PlatformReview, &rv.Improvements, &rv.Status, &rv.ModeratedAt,
+		&rv.Reply, &rv.ReplyStatus, &rv.RepliedAt, &rv.CreatedAt, &rv.Rating)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan review: %w", err)
+	}
+	return &rv, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrRescheduleProposalNotFound is returned when no proposal matches the token
+	ErrRescheduleProposalNotFound = errors.New("reschedule proposal not found")
+	// ErrRescheduleProposalClosed is returned when the proposal was already confirmed or replaced
+	ErrRescheduleProposalClosed = errors.New("reschedule proposal is no longer open")
+)
+
+const rescheduleProposalSelect = `
+	SELECT p.id, p.client_request_id, cr.mentor_id, COALESCE(m.name, ''), p.slots, COALESCE(p.comment, ''),
+		p.previous_status, p.status, p.chosen_slot, p.expires_at, p.created_at, p.token
+	FROM session_reschedule_proposals p
+	JOIN client_requests cr ON cr.id = p.client_request_id
+	LEFT JOIN mentors m ON m.id = cr.mentor_id
+`
+
+// SessionRescheduleRepository handles reschedule proposal data access
+type SessionRescheduleRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSessionRescheduleRepository creates a new session reschedule repository
+func NewSessionRescheduleRepository(pool *pgxpool.Pool) *SessionRescheduleRepository {
+	return &SessionRescheduleRepository{
+		pool: pool,
+	}
+}
+
+// Create stores a new proposal and supersedes the open one of the same request, if any.
+// Call it inside a unit of work so both writes land together.
+func (r *SessionRescheduleRepository) Create(ctx context.Context, proposal *models.RescheduleProposal) (*models.RescheduleProposal, error) {
+	db := conn(ctx, r.pool)
+
+	_, err := db.Exec(ctx, `
+		UPDATE session_reschedule_proposals
+		SET status = 'superseded', resolved_at = NOW()
+		WHERE client_request_id = $1 AND status = 'pending'
+	`, proposal.RequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to supersede reschedule proposal: %w", err)
+	}
+
+	var proposalID string
+	err = db.QueryRow(ctx, `
+		INSERT INTO session_reschedule_proposals (client_request_id, token, slots, comment, previous_status, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		RETURNING id
+	`, proposal.RequestID, proposal.Token, proposal.Slots, proposal.Comment, string(proposal.PreviousStatus), proposal.ExpiresAt,
+	).Scan(&proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reschedule proposal: %w", err)
+	}
+
+	return scanRescheduleProposal(db.QueryRow(ctx, rescheduleProposalSelect+" WHERE p.id = $1", proposalID))
+}
+
+// GetByToken returns the proposal identified by the mentee's token
+func (r *SessionRescheduleRepository) GetByToken(ctx context.Context, token string) (*models.RescheduleProposal, error) {
+	proposal, err := scanRescheduleProposal(conn(ctx, r.pool).QueryRow(ctx, rescheduleProposalSelect+" WHERE p.token = $1", token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRescheduleProposalNotFound
+	}
+	return proposal, err
+}
+
+// GetPendingPreviousStatus returns the status the request returns to once its open
+// proposal is confirmed, or an empty status when there is no open proposal
+func (r *SessionRescheduleRepository) GetPendingPreviousStatus(ctx context.Context, requestID string) (models.RequestStatus, error) {
+	var status string
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT previous_status FROM session_reschedule_proposals
+		WHERE client_request_id = $1 AND status = 'pending'
+	`, requestID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get open reschedule proposal: %w", err)
+	}
+	return models.RequestStatus(status), nil
+}
+
+// Confirm records the chosen slot, moves the request back to its previous status and
+// sets the new session time. Call it inside a unit of work.
+func (r *SessionRescheduleRepository) Confirm(ctx context.Context, proposal *models.RescheduleProposal, slot time.Time) error {
+	db := conn(ctx, r.pool)
+
+	commandTag, err := db.Exec(ctx, `
+		UPDATE session_reschedule_proposals
+		SET status = 'confirmed', chosen_slot = $2, resolved_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`, proposal.ID, slot)
+	if err != nil {
+		return fmt.Errorf("failed to confirm reschedule proposal: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrRescheduleProposalClosed
+	}
+
+	commandTag, err = db.Exec(ctx, `
+		UPDATE client_requests
+		SET status = $2, scheduled_at = $3, status_changed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'reschedule'
+	`, proposal.RequestID, string(proposal.PreviousStatus), slot)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule request: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		// The mentor moved the request on in the meantime
+		return ErrRescheduleProposalClosed
+	}
+	return nil
+}
+
+// MarkRequestRescheduling moves the request to the reschedule status if it is still in fromStatus
+func (r *SessionRescheduleRepository) MarkRequestRescheduling(ctx context.Context, requestID string, fromStatus models.RequestStatus) error {
+	commandTag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE client_requests
+		SET status = 'reschedule', status_changed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = $2
+	`, requestID, string(fromStatus))
+	if err != nil {
+		return fmt.Errorf("failed to update request status: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("request %s is no longer in status %s", requestID, fromStatus)
+	}
+	return nil
+}
+
+func scanRescheduleProposal(row pgx.Row) (*models.RescheduleProposal, error) {
+	var proposal models.RescheduleProposal
+	var previousStatus string
+	err := row.Scan(
+		&proposal.ID,
+		&proposal.RequestID,
+		&proposal.MentorID,
+		&proposal.MentorName,
+		&proposal.Slots,
+		&proposal.Comment,
+		&previousStatus,
+		&proposal.Status,
+		&proposal.ChosenSlot,
+		&proposal.ExpiresAt,
+		&proposal.CreatedAt,
+		&proposal.Token,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan reschedule proposal: %w", err)
+	}
+	proposal.PreviousStatus = models.RequestStatus(previousStatus)
+	return &proposal, nil
+}
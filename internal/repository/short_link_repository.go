@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrShortLinkNotFound is returned when the code doesn't exist or belongs to another mentor
+	ErrShortLinkNotFound = errors.New("short link not found")
+	// ErrShortLinkCodeTaken is returned when a link with the code already exists
+	ErrShortLinkCodeTaken = errors.New("short link code is taken")
+)
+
+const shortLinkSelect = `
+	SELECT l.code, l.mentor_id, l.label, l.utm_source, l.utm_medium, l.utm_campaign, l.clicks,
+		l.last_clicked_at, l.created_by, l.created_at, m.slug, m.status = 'active' AND m.deleted_at IS NULL
+	FROM mentor_short_links l
+	JOIN mentors m ON m.id = l.mentor_id
+`
+
+// ShortLinkRepository handles mentor short link data access
+type ShortLinkRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewShortLinkRepository creates a new short link repository
+func NewShortLinkRepository(pool *pgxpool.Pool) *ShortLinkRepository {
+	return &ShortLinkRepository{
+		pool: pool,
+	}
+}
+
+// ListByMentor returns the mentor's links, oldest first
+func (r *ShortLinkRepository) ListByMentor(ctx context.Context, mentorID string) ([]*models.ShortLink, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, shortLinkSelect+" WHERE l.mentor_id = $1 ORDER BY l.created_at", mentorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query short links: %w", err)
+	}
+	defer rows.Close()
+
+	links := []*models.ShortLink{}
+	for rows.Next() {
+		link, scanErr := scanShortLink(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate short links: %w", err)
+	}
+	return links, nil
+}
+
+// GetByCode returns a link by its code
+func (r *ShortLinkRepository) GetByCode(ctx context.Context, code string) (*models.ShortLink, error) {
+	link, err := scanShortLink(conn(ctx, r.pool).QueryRow(ctx, shortLinkSelect+" WHERE l.code = $1", code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrShortLinkNotFound
+	}
+	return link, err
+}
+
+// Count returns the number of links the mentor has
+func (r *ShortLinkRepository) Count(ctx context.Context, mentorID string) (int, error) {
+	var count int
+	err := conn(ctx, r.pool).QueryRow(ctx,
+		`SELECT COUNT(*) FROM mentor_short_links WHERE mentor_id = $1`, mentorID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count short links: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a link and returns it
+func (r *ShortLinkRepository) Create(ctx context.Context, link *models.ShortLink) (*models.ShortLink, error) {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO mentor_short_links (code, mentor_id, label, utm_source, utm_medium, utm_campaign, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, link.Code, link.MentorID, link.Label, link.UTMSource, link.UTMMedium, link.UTMCampaign, link.CreatedBy)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrShortLinkCodeTaken
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return nil, fmt.Errorf("%w: mentor %s does not exist", apperrors.ErrInvalidInput, link.MentorID)
+		}
+		return nil, fmt.Errorf("failed to create short link: %w", err)
+	}
+	return r.GetByCode(ctx, link.Code)
+}
+
+// Delete removes one of the mentor's links
+func (r *ShortLinkRepository) Delete(ctx context.Context, mentorID, code string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx,
+		`DELETE FROM mentor_short_links WHERE code = $1 AND mentor_id = $2`, code, mentorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete short link: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrShortLinkNotFound
+	}
+	return nil
+}
+
+// RecordClick counts a click on the link
+func (r *ShortLinkRepository) RecordClick(ctx context.Context, code string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx,
+		`UPDATE mentor_short_links SET clicks = clicks + 1, last_clicked_at = now() WHERE code = $1`, code)
+	if err != nil {
+		return fmt.Errorf("failed to record short link click: %w", err)
+	}
+	return nil
+}
+
+func scanShortLink(row pgx.Row) (*models.ShortLink, error) {
+	var link models.ShortLink
+	err := row.Scan(&link.Code, &link.MentorID, &link.Label, &link.UTMSource, &link.UTMMedium, &link.UTMCampaign,
+		&link.Clicks, &link.LastClickedAt, &link.CreatedBy, &link.CreatedAt, &link.MentorSlug, &link.MentorActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan short link: %w", err)
+	}
+	return &link, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StatsRepository computes aggregate platform counters
+type StatsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewStatsRepository creates a new stats repository
+func NewStatsRepository(pool *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{
+		pool: pool,
+	}
+}
+
+// FetchPublicStats counts visible mentors, completed sessions and requests created since monthStart
+func (r *StatsRepository) FetchPublicStats(ctx context.Context, monthStart time.Time) (*models.PublicStats, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM mentors WHERE status = 'active' AND telegram_chat_id IS NOT NULL),
+			(SELECT COUNT(*) FROM client_requests WHERE status = 'done'),
+			(SELECT COUNT(*) FROM client_requests WHERE created_at >= $1)
+	`
+
+	var stats models.PublicStats
+	err := conn(ctx, r.pool).QueryRow(ctx, query, monthStart).Scan(
+		&stats.Mentors, &stats.SessionsCompleted, &stats.RequestsThisMonth,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch public stats: %w", err)
+	}
+	return &stats, nil
+}
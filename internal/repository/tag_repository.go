@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrTagNotFound is returned when no tag has the given name
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagExists is returned when a tag with the same name, in any case, already exists
+	ErrTagExists = errors.New("tag already exists")
+	// ErrTagInUse is returned when deleting a tag that mentors still have
+	ErrTagInUse = errors.New("tag is used by mentors")
+)
+
+// TagRepository manages the tags mentors pick from
+type TagRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTagRepository creates a new tag repository
+func NewTagRepository(pool *pgxpool.Pool) *TagRepository {
+	return &TagRepository{
+		pool: pool,
+	}
+}
+
+// List returns every tag with the number of mentors using it, by name
+func (r *TagRepository) List(ctx context.Context) ([]models.Tag, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT t.id, t.name, COUNT(mt.mentor_id), t.created_at
+		FROM tags t
+		LEFT JOIN mentor_tags mt ON mt.tag_id = t.id
+		GROUP BY t.id
+		ORDER BY t.name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]models.Tag, 0)
+	for rows.Next() {
+		var tag models.Tag
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.MentorCount, &tag.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tags: %w", err)
+	}
+	return tags, nil
+}
+
+// Create adds a tag. Names differing only in case count as the same tag.
+func (r *TagRepository) Create(ctx context.Context, name string) (*models.Tag, error) {
+	tag := models.Tag{Name: name}
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO tags (name)
+		SELECT $1
+		WHERE NOT EXISTS (SELECT 1 FROM tags WHERE lower(name) = lower($1))
+		RETURNING id, created_at
+	`, name).Scan(&tag.ID, &tag.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTagExists
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrTagExists
+		}
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// Delete removes a tag no mentor uses
+func (r *TagRepository) Delete(ctx context.Context, name string) error {
+	var deleted, inUse bool
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH tag AS (
+			SELECT id, EXISTS (SELECT 1 FROM mentor_tags WHERE tag_id = tags.id) AS in_use
+			FROM tags WHERE name = $1
+		), deleted AS (
+			DELETE FROM tags WHERE id IN (SELECT id FROM tag WHERE NOT in_use) RETURNING id
+		)
+		SELECT EXISTS (SELECT 1 FROM deleted), COALESCE((SELECT in_use FROM tag), FALSE)
+	`, name).Scan(&deleted, &inUse)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	switch {
+	case deleted:
+		return nil
+	case inUse:
+		return ErrTagInUse
+	default:
+		return ErrTagNotFound
+	}
+}
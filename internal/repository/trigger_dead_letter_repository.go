@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrTriggerDeadLetterNotFound is returned when no dead letter matches the ID
+var ErrTriggerDeadLetterNotFound = errors.New("trigger dead letter not found")
+
+const triggerDeadLetterSelect = `
+	SELECT id, destination, trigger_url, method, record_id, payload, attempts, attempt_count,
+		last_error, status, created_at, updated_at, delivered_at
+	FROM trigger_dead_letters
+`
+
+// TriggerDeadLetterRepository stores outbound trigger deliveries that gave up
+type TriggerDeadLetterRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTriggerDeadLetterRepository creates a new trigger dead-letter repository
+func NewTriggerDeadLetterRepository(pool *pgxpool.Pool) *TriggerDeadLetterRepository {
+	return &TriggerDeadLetterRepository{pool: pool}
+}
+
+// SaveDeadLetter implements trigger.DeadLetterSink
+func (r *TriggerDeadLetterRepository) SaveDeadLetter(ctx context.Context, letter trigger.DeadLetter) error {
+	attempts, err := json.Marshal(letter.Attempts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trigger attempts: %w", err)
+	}
+	lastError := ""
+	if len(letter.Attempts) > 0 {
+		lastError = letter.Attempts[len(letter.Attempts)-1].Error
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO trigger_dead_letters
+			(destination, trigger_url, method, record_id, payload, attempts, attempt_count, last_error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, letter.Delivery.Destination(), letter.Delivery.TriggerURL, letter.Delivery.Method(), letter.Delivery.RecordID,
+		nullableJSON(letter.Delivery.Payload), attempts, len(letter.Attempts), lastError)
+	if err != nil {
+		return fmt.Errorf("failed to save trigger dead letter: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a dead letter by ID
+func (r *TriggerDeadLetterRepository) GetByID(ctx context.Context, id string) (*models.TriggerDeadLetter, error) {
+	letter, err := scanTriggerDeadLetter(conn(ctx, r.pool).QueryRow(ctx, triggerDeadLetterSelect+" WHERE id::text = $1", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTriggerDeadLetterNotFound
+	}
+	return letter, err
+}
+
+// List returns dead letters with the given statuses (nil matches all), optionally for one
+// destination, newest first
+func (r *TriggerDeadLetterRepository) List(ctx context.Context, statuses []string, destination string, limit int) ([]*models.TriggerDeadLetter, error) {
+	query := triggerDeadLetterSelect + " WHERE ($1::text[] IS NULL OR status = ANY($1)) AND ($2 = '' OR destination = $2) ORDER BY created_at DESC LIMIT $3"
+	rows, err := conn(ctx, r.pool).Query(ctx, query, statuses, destination, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trigger dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	letters := []*models.TriggerDeadLetter{}
+	for rows.Next() {
+		letter, scanErr := scanTriggerDeadLetter(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		letters = append(letters, letter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate trigger dead letters: %w", err)
+	}
+	return letters, nil
+}
+
+// MarkDelivered records a successful re-drive
+func (r *TriggerDeadLetterRepository) MarkDelivered(ctx context.Context, id string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE trigger_dead_letters
+		SET status = 'delivered', delivered_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark trigger dead letter delivered: %w", err)
+	}
+	return nil
+}
+
+// AppendAttempt records a failed re-drive in the dead letter's attempt history
+func (r *TriggerDeadLetterRepository) AppendAttempt(ctx context.Context, id string, attempt trigger.Attempt) error {
+	encoded, err := json.Marshal([]trigger.Attempt{attempt})
+	if err != nil {
+		return fmt.Errorf("failed to marshal trigger attempt: %w", err)
+	}
+	_, err = conn(ctx, r.pool).Exec(ctx, `
+		UPDATE trigger_dead_letters
+		SET attempts = attempts || $2::jsonb, attempt_count = attempt_count + 1, last_error = $3, updated_at = NOW()
+		WHERE id = $1
+	`, id, encoded, attempt.Error)
+	if err != nil {
+		return fmt.Errorf("failed to record trigger attempt: %w", err)
+	}
+	return nil
+}
+
+func scanTriggerDeadLetter(row pgx.Row) (*models.TriggerDeadLetter, error) {
+	var letter models.TriggerDeadLetter
+	var payload, attempts []byte
+	err := row.Scan(&letter.ID, &letter.Destination, &letter.TriggerURL, &letter.Method, &letter.RecordID,
+		&payload, &attempts, &letter.AttemptCount, &letter.LastError, &letter.Status,
+		&letter.CreatedAt, &letter.UpdatedAt, &letter.DeliveredAt)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		letter.Payload = payload
+	}
+	if err := json.Unmarshal(attempts, &letter.Attempts); err != nil {
+		return nil, fmt.Errorf("failed to decode trigger attempts: %w", err)
+	}
+	return &letter, nil
+}
+
+// nullableJSON stores an absent payload as SQL NULL rather than the JSON literal null
+func nullableJSON(raw json.RawMessage) interface{} {
+	if raw == nil {
+		return nil
+	}
+	return []byte(raw)
+}
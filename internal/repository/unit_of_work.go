@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// DBTX is the subset of pgx used by repositories. Both *pgxpool.Pool and pgx.Tx satisfy it,
+// so the same query code runs inside and outside a unit of work.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type unitOfWorkKey struct{}
+
+// unitOfWork holds the transaction and the hooks registered while it is open
+type unitOfWork struct {
+	tx          pgx.Tx
+	compensate  []func(context.Context) error
+	afterCommit []func()
+}
+
+// UnitOfWork runs multi-step writes in a single PostgreSQL transaction.
+// Repositories pick the transaction up from the context, so service code keeps
+// calling the usual repository methods inside Do.
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork creates a new unit of work factory over the connection pool
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{pool: pool}
+}
+
+// Do runs fn in a transaction. The transaction is committed when fn returns nil and rolled back otherwise.
+// On rollback, compensations registered with OnRollback run in reverse order to undo
+// non-transactional side effects (e.g. uploaded files). After a successful commit,
+// hooks registered with AfterCommit run in registration order.
+// Nested calls join the outer unit of work.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		return fn(ctx)
+	}
+
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	work := &unitOfWork{tx: tx}
+	if err := fn(context.WithValue(ctx, unitOfWorkKey{}, work)); err != nil {
+		work.rollback(ctx)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		work.rollback(ctx)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, hook := range work.afterCommit {
+		hook()
+	}
+	return nil
+}
+
+// Rehearse runs fn like Do but always rolls the transaction back: fn's writes are visible to its
+// own reads and then discarded, compensations run and AfterCommit hooks are dropped. Dry runs use
+// it to go through the same writes as the real call. Inside another unit of work only the writes
+// made by fn are rolled back, to a savepoint.
+func (u *UnitOfWork) Rehearse(ctx context.Context, fn func(ctx context.Context) error) error {
+	var tx pgx.Tx
+	var err error
+	if outer, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		tx, err = outer.tx.Begin(ctx)
+	} else {
+		tx, err = u.pool.Begin(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	work := &unitOfWork{tx: tx}
+	defer work.rollback(ctx)
+	return fn(context.WithValue(ctx, unitOfWorkKey{}, work))
+}
+
+// ReadSnapshot runs fn in a read-only REPEATABLE READ transaction, so all of fn's reads see the
+// database as of its first query, even when they span several statements. Inside a unit of
+// work fn joins its transaction instead.
+func (u *UnitOfWork) ReadSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return readSnapshot(ctx, u.pool, fn)
+}
+
+func readSnapshot(ctx context.Context, pool *pgxpool.Pool, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		return fn(ctx)
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Nothing to commit; AfterCommit hooks of a read-only snapshot are dropped
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	return fn(context.WithValue(ctx, unitOfWorkKey{}, &unitOfWork{tx: tx}))
+}
+
+func (w *unitOfWork) rollback(ctx context.Context) {
+	// Rollback is safe to call after a failed Commit
+	_ = w.tx.Rollback(ctx) //nolint:errcheck
+
+	for i := len(w.compensate) - 1; i >= 0; i-- {
+		if err := w.compensate[i](ctx); err != nil {
+			logger.Error("Unit of work compensation failed", zap.Error(err))
+		}
+	}
+}
+
+// OnRollback registers a compensation for a non-transactional step taken inside the current unit of work.
+// Outside a unit of work the compensation is dropped, as there is nothing to roll back.
+func OnRollback(ctx context.Context, compensate func(ctx context.Context) error) {
+	if work, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		work.compensate = append(work.compensate, compensate)
+	}
+}
+
+// AfterCommit defers a side effect (trigger, async upload) until the current unit of work commits.
+// Outside a unit of work the hook runs immediately.
+func AfterCommit(ctx context.Context, hook func()) {
+	if work, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		work.afterCommit = append(work.afterCommit, hook)
+		return
+	}
+	hook()
+}
+
+// conn returns the transaction of the current unit of work, or the pool when there is none
+func conn(ctx context.Context, pool *pgxpool.Pool) DBTX {
+	if work, ok := ctx.Value(unitOfWorkKey{}).(*unitOfWork); ok {
+		return work.tx
+	}
+	return pool
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WarehouseExportRepository reads activity for the analytics warehouse and tracks export progress
+type WarehouseExportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewWarehouseExportRepository creates a new warehouse export repository
+func NewWarehouseExportRepository(pool *pgxpool.Pool) *WarehouseExportRepository {
+	return &WarehouseExportRepository{pool: pool}
+}
+
+// LockWatermark returns the stream's watermark, locked until the surrounding unit of work ends.
+// It returns nil when another instance holds the lock.
+func (r *WarehouseExportRepository) LockWatermark(ctx context.Context, stream string) (*models.WarehouseWatermark, error) {
+	db := conn(ctx, r.pool)
+	if _, err := db.Exec(ctx, `
+		INSERT INTO warehouse_export_watermarks (stream) VALUES ($1) ON CONFLICT (stream) DO NOTHING
+	`, stream); err != nil {
+		return nil, fmt.Errorf("failed to create warehouse watermark: %w", err)
+	}
+
+	var watermark models.WarehouseWatermark
+	err := db.QueryRow(ctx, `
+		SELECT stream, watermark_at, watermark_id::text, rows_exported, updated_at
+		FROM warehouse_export_watermarks
+		WHERE stream = $1
+		FOR UPDATE SKIP LOCKED
+	`, stream).Scan(&watermark.Stream, &watermark.Cursor.At, &watermark.Cursor.ID, &watermark.RowsExported, &watermark.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock warehouse watermark: %w", err)
+	}
+	return &watermark, nil
+}
+
+// GetWatermark returns the stream's watermark without locking it, or nil when the stream never ran
+func (r *WarehouseExportRepository) GetWatermark(ctx context.Context, stream string) (*models.WarehouseWatermark, error) {
+	var watermark models.WarehouseWatermark
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT stream, watermark_at, watermark_id::text, rows_exported, updated_at
+		FROM warehouse_export_watermarks
+		WHERE stream = $1
+	`, stream).Scan(&watermark.Stream, &watermark.Cursor.At, &watermark.Cursor.ID, &watermark.RowsExported, &watermark.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read warehouse watermark: %w", err)
+	}
+	return &watermark, nil
+}
+
+// AdvanceWatermark moves the stream's watermark to the last exported row
+func (r *WarehouseExportRepository) AdvanceWatermark(ctx context.Context, stream string, cursor models.HistoryCursor, rows int) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE warehouse_export_watermarks
+		SET watermark_at = $2, watermark_id = $3::uuid, rows_exported = rows_exported + $4, updated_at = NOW()
+		WHERE stream = $1
+	`, stream, cursor.At, cursor.ID, rows)
+	if err != nil {
+		return fmt.Errorf("failed to advance warehouse watermark: %w", err)
+	}
+	return nil
+}
+
+// ListRequestActivity returns requests updated after the cursor and before the cutoff, in (updated_at, id) order
+func (r *WarehouseExportRepository) ListRequestActivity(ctx context.Context, after models.HistoryCursor, before time.Time, limit int) ([]*models.RequestActivity, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT cr.id, COALESCE(cr.mentor_id::text, ''), COALESCE(cr.email, ''), COALESCE(cr.level, ''), cr.status,
+			COALESCE(cr.decline_reason, ''), COALESCE(cr.quarantine_status, ''), r.id IS NOT NULL,
+			cr.created_at, cr.status_changed_at, cr.updated_at
+		FROM client_requests cr
+		LEFT JOIN reviews r ON r.client_request_id = cr.id
+		WHERE (cr.updated_at, cr.id) > ($1::timestamptz, $2::uuid) AND cr.updated_at < $3
+		ORDER BY cr.updated_at, cr.id
+		LIMIT $4
+	`, after.At, after.ID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request activity: %w", err)
+	}
+	defer rows.Close()
+
+	activity := []*models.RequestActivity{}
+	for rows.Next() {
+		var a models.RequestActivity
+		if err := rows.Scan(&a.ID, &a.MentorID, &a.Email, &a.Level, &a.Status, &a.DeclineReason, &a.QuarantineStatus,
+			&a.HasReview, &a.CreatedAt, &a.StatusChangedAt, &a.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan request activity: %w", err)
+		}
+		activity = append(activity, &a)
+	}
+	return activity, rows.Err()
+}
+
+// ListMentorFacts returns mentors updated after the cursor and before the cutoff, in (updated_at, id) order
+func (r *WarehouseExportRepository) ListMentorFacts(ctx context.Context, after models.HistoryCursor, before time.Time, limit int) ([]*models.MentorFact, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT m.id, m.status, COALESCE(m.experience, ''), COALESCE(m.price, ''), COALESCE(m.country, ''),
+			m.remote_only, m.languages, COALESCE(array_to_string(array_agg(t.name ORDER BY t.name), ','), ''),
+			COALESCE(m.merged_into::text, ''), m.created_at, m.updated_at
+		FROM mentors m
+		LEFT JOIN mentor_tags mt ON mt.mentor_id = m.id
+		LEFT JOIN tags t ON t.id = mt.tag_id
+		WHERE (m.updated_at, m.id) > ($1::timestamptz, $2::uuid) AND m.updated_at < $3
+		GROUP BY m.id
+		ORDER BY m.updated_at, m.id
+		LIMIT $4
+	`, after.At, after.ID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mentor facts: %w", err)
+	}
+	defer rows.Close()
+
+	facts := []*models.MentorFact{}
+	for rows.Next() {
+		var f models.MentorFact
+		var tags string
+		if err := rows.Scan(&f.MentorID, &f.Status, &f.Experience, &f.Price, &f.Country, &f.RemoteOnly, &f.Languages,
+			&tags, &f.MergedInto, &f.CreatedAt, &f.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan mentor facts: %w", err)
+		}
+		f.Tags = []string{}
+		if tags != "" {
+			f.Tags = strings.Split(tags, ",")
+		}
+		if f.Languages == nil {
+			f.Languages = []string{}
+		}
+		facts = append(facts, &f)
+	}
+	return facts, rows.Err()
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/recaptcha"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// ErrAbuseTargetNotFound is returned when the reported mentor or request doesn't exist
+var ErrAbuseTargetNotFound = errors.New("reported mentor or request not found")
+
+// AbuseReportService accepts public abuse reports and serves the admin moderation queue
+type AbuseReportService struct {
+	reportRepo        *repository.AbuseReportRepository
+	mentorRepo        *repository.MentorRepository
+	clientRequestRepo *repository.ClientRequestRepository
+	config            *config.Config
+	httpClient        httpclient.Client
+	recaptchaVerifier *recaptcha.Verifier
+	tracker           analytics.Tracker
+}
+
+// NewAbuseReportService creates a new abuse report service
+func NewAbuseReportService(
+	reportRepo *repository.AbuseReportRepository,
+	mentorRepo *repository.MentorRepository,
+	clientRequestRepo *repository.ClientRequestRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+) *AbuseReportService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	return &AbuseReportService{
+		reportRepo:        reportRepo,
+		mentorRepo:        mentorRepo,
+		clientRequestRepo: clientRequestRepo,
+		config:            cfg,
+		httpClient:        httpClient,
+		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+		tracker:           tracker,
+	}
+}
+
+// SubmitReport stores an abuse report about a mentor profile or a request and notifies admins
+func (s *AbuseReportService) SubmitReport(ctx context.Context, req *models.AbuseReportRequest) (*models.AbuseReportResponse, error) {
+	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
+		metrics.AbuseReports.WithLabelValues("captcha_failed").Inc()
+		s.trackSubmitted(ctx, req, "", "captcha_failed")
+		logger.Warn("ReCAPTCHA verification failed", zap.Error(err))
+		return &models.AbuseReportResponse{
+			Success: false,
+			Error:   "Captcha verification failed",
+		}, fmt.Errorf("captcha verification failed: %w", err)
+	}
+
+	report := &models.AbuseReport{
+		TargetType:    req.TargetType,
+		Category:      req.Category,
+		Description:   strings.TrimSpace(req.Description),
+		ReporterEmail: req.Email,
+	}
+	if err := s.resolveTarget(ctx, req, report); err != nil {
+		metrics.AbuseReports.WithLabelValues("target_not_found").Inc()
+		s.trackSubmitted(ctx, req, "", "target_not_found")
+		return nil, err
+	}
+
+	reportID, err := s.reportRepo.Create(ctx, report)
+	if err != nil {
+		metrics.AbuseReports.WithLabelValues("error").Inc()
+		s.trackSubmitted(ctx, req, "", "db_error")
+		logger.Error("Failed to create abuse report", zap.Error(err))
+		return &models.AbuseReportResponse{
+			Success: false,
+			Error:   "Failed to save report",
+		}, fmt.Errorf("failed to create abuse report: %w", err)
+	}
+
+	// Notify admins (non-blocking)
+	trigger.CallAsync(s.config.EventTriggers.AbuseReportTriggerURL, reportID, s.httpClient)
+
+	metrics.AbuseReports.WithLabelValues("success").Inc()
+	s.trackSubmitted(ctx, req, reportID, "success")
+
+	return &models.AbuseReportResponse{
+		Success:  true,
+		ReportID: reportID,
+	}, nil
+}
+
+// ListReports returns the moderation queue. Available to moderators and admins.
+func (s *AbuseReportService) ListReports(ctx context.Context, session *models.AdminSession, filter models.AbuseReportFilter) ([]*models.AbuseReport, error) {
+	statuses, ok := filter.Statuses()
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported filter %q", apperrors.ErrInvalidInput, filter)
+	}
+	return s.reportRepo.List(ctx, statuses)
+}
+
+// ResolveReport moves a report to in_review, resolved or dismissed
+func (s *AbuseReportService) ResolveReport(
+	ctx context.Context,
+	session *models.AdminSession,
+	reportID string,
+	req *models.ResolveAbuseReportRequest,
+) (*models.AbuseReport, error) {
+
+	err := s.reportRepo.UpdateStatus(ctx, reportID, req.Status, strings.TrimSpace(req.Comment), session.ModeratorID)
+	outcome := "success"
+	if err != nil {
+		outcome = "update_failed"
+	}
+	s.tracker.Track(ctx, analytics.EventAdminAbuseReportResolved, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"report_id":      reportID,
+		"status":         req.Status,
+		"outcome":        outcome,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.AbuseReportResolutions.WithLabelValues(req.Status).Inc()
+	return s.reportRepo.GetByID(ctx, reportID)
+}
+
+// resolveTarget fills the mentor or request the report is about
+func (s *AbuseReportService) resolveTarget(ctx context.Context, req *models.AbuseReportRequest, report *models.AbuseReport) error {
+	target := strings.TrimSpace(req.Target)
+
+	switch req.TargetType {
+	case models.AbuseTargetMentor:
+		mentor, err := s.mentorRepo.GetBySlug(ctx, target, models.FilterOptions{})
+		if err != nil {
+			return ErrAbuseTargetNotFound
+		}
+		report.MentorID = mentor.MentorID
+	case models.AbuseTargetRequest:
+		request, err := s.clientRequestRepo.GetByID(ctx, target)
+		if err != nil {
+			return ErrAbuseTargetNotFound
+		}
+		report.ClientRequestID = request.ID
+		report.MentorID = request.MentorID
+	default:
+		return ErrAbuseTargetNotFound
+	}
+
+	return nil
+}
+
+func (s *AbuseReportService) trackSubmitted(ctx context.Context, req *models.AbuseReportRequest, reportID, outcome string) {
+	s.tracker.Track(ctx, analytics.EventAbuseReportSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+		"report_id":   reportID,
+		"target_type": req.TargetType,
+		"category":    req.Category,
+		"outcome":     outcome,
+	})
+}
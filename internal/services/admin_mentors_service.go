@@ -2,17 +2,24 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
 )
 
 const (
@@ -29,32 +36,62 @@ var (
 	ErrAdminForbiddenAction = errors.New("forbidden action for current role")
 )
 
+// AdminMentorStore is the mentor repository AdminMentorsService reads and saves through;
+// *repository.MentorRepository implements it, and *repository.DualWriteMentorRepository
+// also mirrors the saves and status changes to Airtable
+type AdminMentorStore interface {
+	ListForModeration(ctx context.Context, statuses []string) ([]models.AdminMentorListItem, error)
+	GetForModerationByID(ctx context.Context, mentorID string) (*models.AdminMentorDetails, error)
+	GetTagIDByName(ctx context.Context, name string) (string, error)
+	UpdateIfUnmodified(ctx context.Context, mentorId string, updates map[string]interface{}, expected repository.MentorPrecondition) error
+	UpdateMentorTags(ctx context.Context, mentorID string, tagIDs []string) error
+	SetMentorStatus(ctx context.Context, mentorID, status string) error
+	RecordModerationEvent(ctx context.Context, mentorID, action, moderatorID string) error
+	SoftDelete(ctx context.Context, mentorID, moderatorID string) (string, error)
+	RemoveMentorFromCache(mentorSlug string) error
+}
+
 type AdminMentorsService struct {
-	mentorRepo     *repository.MentorRepository
+	mentorRepo     AdminMentorStore
+	uow            *repository.UnitOfWork
 	profileService ProfileServiceInterface
+	announcements  *MentorAnnouncementService
 	config         *config.Config
 	httpClient     httpclient.Client
 	tracker        analytics.Tracker
+	publisher      eventbus.Publisher
+	audit          *AuditLogger
 }
 
 func NewAdminMentorsService(
-	mentorRepo *repository.MentorRepository,
+	mentorRepo AdminMentorStore,
+	uow *repository.UnitOfWork,
 	profileService ProfileServiceInterface,
+	announcements *MentorAnnouncementService,
 	cfg *config.Config,
 	httpClient httpclient.Client,
 	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
+	audit *AuditLogger,
 ) *AdminMentorsService {
 
 	if tracker == nil {
 		tracker = analytics.NoopTracker{}
 	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
 
 	return &AdminMentorsService{
 		mentorRepo:     mentorRepo,
+		uow:            uow,
 		profileService: profileService,
+		announcements:  announcements,
 		config:         cfg,
 		httpClient:     httpClient,
 		tracker:        tracker,
+		publisher:      publisher,
+		audit:          audit,
 	}
 }
 
@@ -123,20 +160,218 @@ func (s *AdminMentorsService) UpdateMentorProfile(
 		s.trackAdminProfileUpdate(ctx, session, mentorID, "invalid_payload", nil)
 		return nil, err
 	}
+	expected := repository.MentorPrecondition{Version: req.ExpectedVersion, UpdatedAt: req.ExpectedUpdatedAt}
+	if !expected.IsSet() {
+		s.trackAdminProfileUpdate(ctx, session, mentorID, "precondition_required", nil)
+		return nil, apperrors.ErrPreconditionRequired
+	}
+	newEmail, emailChanged := takeEmailChange(mentor, updates)
 
-	if err := s.mentorRepo.Update(ctx, mentorID, updates); err != nil {
-		s.trackAdminProfileUpdate(ctx, session, mentorID, "update_failed", nil)
-		return nil, err
+	outcome := "update_failed"
+	updated, err := s.inTransaction(ctx, mentorID, AuditOperationUpdateProfile, func(ctx context.Context) error {
+		if err := s.mentorRepo.UpdateIfUnmodified(ctx, mentorID, updates, expected); err != nil {
+			return err
+		}
+		if emailChanged {
+			outcome = "email_change_failed"
+			if _, err := s.profileService.RequestEmailChange(ctx, mentorID, newEmail, session.ModeratorID); err != nil {
+				return err
+			}
+		}
+		outcome = "tags_update_failed"
+		return s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs)
+	})
+	var conflictErr *repository.VersionConflictError
+	if errors.As(err, &conflictErr) {
+		outcome = "version_conflict"
 	}
-	if err := s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs); err != nil {
-		s.trackAdminProfileUpdate(ctx, session, mentorID, "tags_update_failed", nil)
+	if err != nil {
+		s.trackAdminProfileUpdate(ctx, session, mentorID, outcome, nil)
 		return nil, err
 	}
 
 	s.trackAdminProfileUpdate(ctx, session, mentorID, "success", map[string]interface{}{
 		"tags_count": len(tagIDs),
 	})
-	return s.mentorRepo.GetForModerationByID(ctx, mentorID)
+	s.publishUpdated(ctx, updated, nil)
+	return updated, nil
+}
+
+// PatchMentorProfile applies a JSON Merge Patch to a mentor profile. Only the fields present
+// in the patch are validated and written; the returned changes are the field-level audit entries.
+func (s *AdminMentorsService) PatchMentorProfile(
+	ctx context.Context,
+	session *models.AdminSession,
+	mentorID string,
+	patch models.MergePatch,
+) (*models.AdminMentorDetails, []models.ProfileFieldChange, error) {
+
+	mentor, err := s.GetMentor(ctx, session, mentorID)
+	if err != nil {
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, "mentor_not_found_or_forbidden", nil)
+		return nil, nil, err
+	}
+	return s.applyMentorPatch(ctx, session, mentorID, mentor, patch)
+}
+
+// JSONPatchMentorProfile applies an RFC 6902 JSON Patch to a mentor profile. The operations run
+// against the current profile and are written as the equivalent merge patch, so they are validated
+// per role and applied atomically like PatchMentorProfile. A failing "test" operation is a conflict;
+// a "test" of version or updatedAt is also enforced when writing. The patch is recorded in the audit log.
+func (s *AdminMentorsService) JSONPatchMentorProfile(
+	ctx context.Context,
+	session *models.AdminSession,
+	mentorID string,
+	patch models.JSONPatch,
+) (*models.AdminMentorDetails, []models.ProfileFieldChange, error) {
+
+	mentor, err := s.GetMentor(ctx, session, mentorID)
+	if err != nil {
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, "mentor_not_found_or_forbidden", nil)
+		return nil, nil, err
+	}
+
+	merge, err := jsonPatchToMergePatch(patch, mentor)
+	if err != nil {
+		outcome := "invalid_payload"
+		var conflictErr *repository.VersionConflictError
+		if errors.Is(err, models.ErrJSONPatchTestFailed) || errors.As(err, &conflictErr) {
+			outcome = "version_conflict"
+		}
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, outcome, nil)
+		return nil, nil, err
+	}
+
+	recorded, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode json patch: %w", err)
+	}
+	return s.applyMentorPatch(models.WithAuditPatch(ctx, recorded), session, mentorID, mentor, merge)
+}
+
+// jsonPatchToMergePatch runs the operations against the mentor. Tests of version and updatedAt are
+// checked here and carried over as expectedVersion and expectedUpdatedAt, so a change made before
+// the write conflicts too.
+func jsonPatchToMergePatch(patch models.JSONPatch, mentor *models.AdminMentorDetails) (models.MergePatch, error) {
+	doc, err := jsonFieldValues(mentor)
+	if err != nil {
+		return nil, err
+	}
+
+	preconditions := models.MergePatch{}
+	ops := make(models.JSONPatch, 0, len(patch))
+	for _, op := range patch {
+		switch field := op.Field(); {
+		case field == patchKeyExpectedUpdatedAt || field == patchKeyExpectedVersion:
+			return nil, apperrors.InvalidInputError(op.Path, "unknown or read-only field")
+		case field == "updatedAt" && op.Op == models.JSONPatchTest:
+			var expected time.Time
+			if err := json.Unmarshal(op.Value, &expected); err != nil {
+				return nil, apperrors.InvalidInputError(op.Path, "must be an RFC 3339 timestamp")
+			}
+			if !expected.Equal(mentor.UpdatedAt) {
+				return nil, &repository.VersionConflictError{CurrentVersion: mentor.Version, CurrentUpdatedAt: mentor.UpdatedAt}
+			}
+			preconditions[patchKeyExpectedUpdatedAt] = op.Value
+		case field == "version" && op.Op == models.JSONPatchTest:
+			var expected int
+			if err := json.Unmarshal(op.Value, &expected); err != nil {
+				return nil, apperrors.InvalidInputError(op.Path, "must be an integer")
+			}
+			if expected != mentor.Version {
+				return nil, &repository.VersionConflictError{CurrentVersion: mentor.Version, CurrentUpdatedAt: mentor.UpdatedAt}
+			}
+			preconditions[patchKeyExpectedVersion] = op.Value
+		default:
+			ops = append(ops, op)
+		}
+	}
+
+	merge, err := ops.ToMergePatch(doc)
+	if err != nil {
+		if errors.Is(err, models.ErrJSONPatchTestFailed) || errors.Is(err, models.ErrJSONPatchPathNotFound) {
+			return nil, err
+		}
+		return nil, apperrors.InvalidInputError("patch", err.Error())
+	}
+	for key, value := range preconditions {
+		merge[key] = value
+	}
+	return merge, nil
+}
+
+// applyMentorPatch validates and writes a merge patch to the mentor it was read from
+func (s *AdminMentorsService) applyMentorPatch(
+	ctx context.Context,
+	session *models.AdminSession,
+	mentorID string,
+	mentor *models.AdminMentorDetails,
+	patch models.MergePatch,
+) (*models.AdminMentorDetails, []models.ProfileFieldChange, error) {
+
+	patched, err := buildProfilePatch(patch, adminPatchFields, mentor, session.Role == models.ModeratorRoleAdmin)
+	if err != nil {
+		outcome := "invalid_payload"
+		if errors.Is(err, ErrAdminForbiddenAction) {
+			outcome = "forbidden"
+		}
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, outcome, nil)
+		return nil, nil, err
+	}
+
+	var tagIDs []string
+	if patched.hasTags {
+		tagIDs = s.resolveTagIDs(ctx, patched.tags)
+		if len(tagIDs) == 0 {
+			s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, "invalid_tags", nil)
+			return nil, nil, fmt.Errorf("at least one valid tag is required")
+		}
+		patched.addChange(patchKeyTags, mentor.Tags, patched.tags)
+	}
+	if !patched.expected.IsSet() {
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, "precondition_required", nil)
+		return nil, nil, apperrors.ErrPreconditionRequired
+	}
+	newEmail, emailChanged := takeEmailChange(mentor, patched.updates)
+	if emailChanged {
+		// The email is only written once the mentor confirms it, so it is not a field change yet
+		patched.dropChange("email")
+	}
+
+	outcome := "update_failed"
+	updated, err := s.inTransaction(ctx, mentorID, AuditOperationPatchProfile, func(ctx context.Context) error {
+		if err := s.mentorRepo.UpdateIfUnmodified(ctx, mentorID, patched.updates, patched.expected); err != nil {
+			return err
+		}
+		if emailChanged {
+			outcome = "email_change_failed"
+			if _, err := s.profileService.RequestEmailChange(ctx, mentorID, newEmail, session.ModeratorID); err != nil {
+				return err
+			}
+		}
+		if !patched.hasTags {
+			return nil
+		}
+		outcome = "tags_update_failed"
+		return s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs)
+	})
+	var conflictErr *repository.VersionConflictError
+	if errors.As(err, &conflictErr) {
+		outcome = "version_conflict"
+	}
+	if err != nil {
+		s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, outcome, nil)
+		return nil, nil, err
+	}
+
+	if !IsDryRun(ctx) {
+		logProfileAuditEntries(mentorID, analytics.ModeratorDistinctID(session.ModeratorID), patched.changes)
+	}
+	s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfilePatched, session, mentorID, "success", map[string]interface{}{
+		"changed_fields": patched.changedFields(),
+	})
+	s.publishUpdated(ctx, updated, patched.changedFields())
+	return updated, patched.changes, nil
 }
 
 func (s *AdminMentorsService) ApproveMentor(
@@ -165,7 +400,7 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 ) (*models.AdminMentorDetails, error) {
 
 	if session.Role != models.ModeratorRoleAdmin {
-		s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -175,7 +410,7 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 		return nil, ErrAdminForbiddenAction
 	}
 	if status != mentorStatusActive && status != mentorStatusInactive {
-		s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -187,7 +422,7 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 
 	mentor, err := s.mentorRepo.GetForModerationByID(ctx, mentorID)
 	if err != nil {
-		s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -197,7 +432,7 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 		return nil, err
 	}
 	if mentor.Status != mentorStatusActive && mentor.Status != mentorStatusInactive {
-		s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -207,9 +442,15 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 		})
 		return nil, fmt.Errorf("status toggle is available only for approved mentors")
 	}
+	if mentor.MergedInto != nil {
+		return nil, fmt.Errorf("status toggle is available only for mentors that were not merged")
+	}
 
-	if err := s.mentorRepo.SetMentorStatus(ctx, mentorID, status); err != nil {
-		s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+	updated, err := s.inTransaction(ctx, mentorID, AuditOperationSetStatus, func(ctx context.Context) error {
+		return s.mentorRepo.SetMentorStatus(ctx, mentorID, status)
+	})
+	if err != nil {
+		s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -219,7 +460,7 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 		})
 		return nil, err
 	}
-	s.tracker.Track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+	s.track(ctx, analytics.EventAdminMentorStatusUpdated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 		"moderator_id":     session.ModeratorID,
 		"moderator_role":   string(session.Role),
 		"target_mentor_id": mentorID,
@@ -227,7 +468,11 @@ func (s *AdminMentorsService) UpdateMentorStatus(
 		"requested_status": status,
 		"outcome":          "success",
 	})
-	return s.mentorRepo.GetForModerationByID(ctx, mentorID)
+	if status == mentorStatusActive {
+		s.announceMentor(ctx, mentorID)
+	}
+	s.publishUpdated(ctx, updated, []string{"status"})
+	return updated, nil
 }
 
 func (s *AdminMentorsService) UploadMentorPicture(
@@ -238,7 +483,7 @@ func (s *AdminMentorsService) UploadMentorPicture(
 ) (string, error) {
 
 	if session.Role != models.ModeratorRoleAdmin {
-		s.tracker.Track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -249,7 +494,7 @@ func (s *AdminMentorsService) UploadMentorPicture(
 
 	mentor, err := s.mentorRepo.GetForModerationByID(ctx, mentorID)
 	if err != nil {
-		s.tracker.Track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -257,9 +502,9 @@ func (s *AdminMentorsService) UploadMentorPicture(
 		})
 		return "", err
 	}
-	uploadURL, err := s.profileService.UploadPictureByMentorId(ctx, mentorID, mentor.Slug, req)
+	uploadURL, err := s.profileService.UploadPictureByMentorId(ctx, mentorID, mentor.Slug, events.ActorAdmin, req)
 	if err != nil {
-		s.tracker.Track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		s.track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 			"moderator_id":     session.ModeratorID,
 			"moderator_role":   string(session.Role),
 			"target_mentor_id": mentorID,
@@ -267,7 +512,7 @@ func (s *AdminMentorsService) UploadMentorPicture(
 		})
 		return "", err
 	}
-	s.tracker.Track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+	s.track(ctx, analytics.EventAdminMentorPictureUploaded, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 		"moderator_id":     session.ModeratorID,
 		"moderator_role":   string(session.Role),
 		"target_mentor_id": mentorID,
@@ -278,6 +523,65 @@ func (s *AdminMentorsService) UploadMentorPicture(
 	return uploadURL, nil
 }
 
+// DeleteMentor soft-deletes the mentor and drops them from the cache once the deletion is committed
+func (s *AdminMentorsService) DeleteMentor(ctx context.Context, session *models.AdminSession, mentorID string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.trackDelete(ctx, session, mentorID, "forbidden")
+		return ErrAdminForbiddenAction
+	}
+
+	var slug string
+	err := s.audited(ctx, mentorID, AuditOperationDelete, func(txCtx context.Context) error {
+		deleted, err := s.mentorRepo.SoftDelete(txCtx, mentorID, session.ModeratorID)
+		if err != nil {
+			return err
+		}
+		slug = deleted
+
+		repository.AfterCommit(txCtx, func() {
+			if err := s.mentorRepo.RemoveMentorFromCache(deleted); err != nil {
+				logger.Warn("Failed to remove deleted mentor from cache", zap.Error(err), zap.String("mentor_slug", deleted))
+			}
+		})
+		return nil
+	})
+	if errors.Is(err, repository.ErrDeleteMentorNotFound) {
+		s.trackDelete(ctx, session, mentorID, "mentor_not_found")
+		return err
+	}
+	if err != nil {
+		s.trackDelete(ctx, session, mentorID, "delete_failed")
+		logger.Error("Failed to delete mentor", zap.Error(err), zap.String("mentor_id", mentorID))
+		return err
+	}
+
+	s.trackDelete(ctx, session, mentorID, "success")
+	if IsDryRun(ctx) {
+		return nil
+	}
+	events.Publish(ctx, s.publisher, events.MentorUpdated{
+		MentorID:      mentorID,
+		Slug:          slug,
+		Status:        mentorStatusInactive,
+		ChangedFields: []string{"status", "deleted_at"},
+		Actor:         events.ActorAdmin,
+	})
+	logger.Info("Mentor deleted",
+		zap.String("actor", analytics.ModeratorDistinctID(session.ModeratorID)),
+		zap.String("mentor_id", mentorID),
+		zap.String("mentor_slug", slug))
+	return nil
+}
+
+func (s *AdminMentorsService) trackDelete(ctx context.Context, session *models.AdminSession, mentorID, outcome string) {
+	s.track(ctx, analytics.EventAdminMentorDeleted, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":     session.ModeratorID,
+		"moderator_role":   string(session.Role),
+		"target_mentor_id": mentorID,
+		"outcome":          outcome,
+	})
+}
+
 func (s *AdminMentorsService) setModerationStatus(
 	ctx context.Context,
 	session *models.AdminSession,
@@ -296,14 +600,23 @@ func (s *AdminMentorsService) setModerationStatus(
 		return nil, ErrAdminForbiddenAction
 	}
 
-	if err := s.mentorRepo.SetMentorStatus(ctx, mentorID, targetStatus); err != nil {
+	updated, err := s.inTransaction(ctx, mentorID, action, func(ctx context.Context) error {
+		if err := s.mentorRepo.SetMentorStatus(ctx, mentorID, targetStatus); err != nil {
+			return err
+		}
+		return s.mentorRepo.RecordModerationEvent(ctx, mentorID, action, session.ModeratorID)
+	})
+	if err != nil {
 		s.trackModerationAction(ctx, session, mentorID, action, "update_failed")
 		return nil, err
 	}
 	s.trackModerationAction(ctx, session, mentorID, action, "success")
-	s.triggerModerationAction(action, session, mentorID)
-
-	return s.mentorRepo.GetForModerationByID(ctx, mentorID)
+	s.triggerModerationAction(ctx, action, session, mentorID)
+	if targetStatus == mentorStatusActive {
+		s.announceMentor(ctx, mentorID)
+	}
+	s.publishUpdated(ctx, updated, []string{"status"})
+	return updated, nil
 }
 
 func validateProfileUpdatePermissions(
@@ -322,11 +635,7 @@ func validateProfileUpdatePermissions(
 }
 
 func normalizeTelegramHandle(input string) string {
-	telegram := strings.TrimSpace(input)
-	telegram = strings.TrimPrefix(telegram, "@")
-	telegram = strings.TrimPrefix(telegram, "https://t.me/")
-	telegram = strings.TrimPrefix(telegram, "t.me/")
-	return telegram
+	return models.NormalizeTelegramHandle(input)
 }
 
 func (s *AdminMentorsService) resolveTagIDs(ctx context.Context, tags []string) []string {
@@ -359,6 +668,15 @@ func buildProfileUpdates(
 		"competencies": req.Competencies,
 		"calendar_url": req.CalendarURL,
 	}
+	if err := applyAvailabilityUpdates(updates, req.Timezone, req.ContactHours); err != nil {
+		return nil, err
+	}
+	if err := applyLocationUpdates(updates, req.Country, req.City, req.RemoteOnly); err != nil {
+		return nil, err
+	}
+	if err := applyLanguageUpdates(updates, req.Languages); err != nil {
+		return nil, err
+	}
 	if session.Role != models.ModeratorRoleAdmin {
 		return updates, nil
 	}
@@ -388,7 +706,30 @@ func buildProfileUpdates(
 	return updates, nil
 }
 
-func (s *AdminMentorsService) triggerModerationAction(action string, session *models.AdminSession, mentorID string) {
+// takeEmailChange removes a changed email of an approved mentor from updates: the mentor logs in
+// with that address, so it has to be confirmed from the new mailbox first. Pending mentors
+// have never logged in and keep the direct edit.
+func takeEmailChange(mentor *models.AdminMentorDetails, updates map[string]interface{}) (string, bool) {
+	if mentor.Status != mentorStatusActive && mentor.Status != mentorStatusInactive {
+		return "", false
+	}
+	raw, ok := updates["email"]
+	if !ok {
+		return "", false
+	}
+	delete(updates, "email")
+
+	email, _ := raw.(string)
+	if models.NormalizeEmail(email) == models.NormalizeEmail(mentor.Email) {
+		return "", false
+	}
+	return email, true
+}
+
+func (s *AdminMentorsService) triggerModerationAction(ctx context.Context, action string, session *models.AdminSession, mentorID string) {
+	if IsDryRun(ctx) {
+		return
+	}
 	payload := models.AdminModerationTriggerPayload{
 		Type:        "mentor_moderation",
 		MentorID:    mentorID,
@@ -399,6 +740,59 @@ func (s *AdminMentorsService) triggerModerationAction(action string, session *mo
 	trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorModerationTriggerURL, payload, s.httpClient)
 }
 
+// announceMentor posts a newly activated mentor to the Telegram channel, once per mentor
+func (s *AdminMentorsService) announceMentor(ctx context.Context, mentorID string) {
+	if IsDryRun(ctx) {
+		return
+	}
+	s.announcements.AnnounceMentor(ctx, mentorID)
+}
+
+// publishUpdated emits mentor.updated for a saved mentor; dry runs saved nothing
+func (s *AdminMentorsService) publishUpdated(ctx context.Context, mentor *models.AdminMentorDetails, changedFields []string) {
+	if IsDryRun(ctx) {
+		return
+	}
+	events.Publish(ctx, s.publisher, events.MentorUpdated{
+		MentorID:      mentor.MentorID,
+		Slug:          mentor.Slug,
+		Status:        mentor.Status,
+		ChangedFields: changedFields,
+		Actor:         events.ActorAdmin,
+	})
+}
+
+// inTransaction runs fn like audited and returns the mentor as fn left it, read in the same
+// transaction: in dry-run mode that is the mentor the call would have saved
+func (s *AdminMentorsService) inTransaction(ctx context.Context, mentorID, operation string, fn func(ctx context.Context) error) (*models.AdminMentorDetails, error) {
+	var mentor *models.AdminMentorDetails
+	err := s.audited(ctx, mentorID, operation, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		var err error
+		mentor, err = s.mentorRepo.GetForModerationByID(ctx, mentorID)
+		return err
+	})
+	return mentor, err
+}
+
+// audited runs fn in a unit of work and records the changes it makes to the mentor in the
+// audit log under operation. In dry-run mode the unit of work is rolled back.
+func (s *AdminMentorsService) audited(ctx context.Context, mentorID, operation string, fn func(ctx context.Context) error) error {
+	return runUnitOfWork(ctx, s.uow, func(ctx context.Context) error {
+		return s.audit.Track(ctx, models.AuditEntityMentor, mentorID, operation, fn)
+	})
+}
+
+// track sends an analytics event unless the call runs in dry-run mode
+func (s *AdminMentorsService) track(ctx context.Context, event, distinctID string, properties map[string]interface{}) {
+	if IsDryRun(ctx) {
+		return
+	}
+	s.tracker.Track(ctx, event, distinctID, properties)
+}
+
 func (s *AdminMentorsService) trackModerationAction(
 	ctx context.Context,
 	session *models.AdminSession,
@@ -407,7 +801,7 @@ func (s *AdminMentorsService) trackModerationAction(
 	outcome string,
 ) {
 
-	s.tracker.Track(ctx, analytics.EventAdminMentorModerationAction, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+	s.track(ctx, analytics.EventAdminMentorModerationAction, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
 		"moderator_id":     session.ModeratorID,
 		"moderator_role":   string(session.Role),
 		"target_mentor_id": mentorID,
@@ -424,6 +818,18 @@ func (s *AdminMentorsService) trackAdminProfileUpdate(
 	extra map[string]interface{},
 ) {
 
+	s.trackAdminProfileEvent(ctx, analytics.EventAdminMentorProfileUpdated, session, mentorID, outcome, extra)
+}
+
+func (s *AdminMentorsService) trackAdminProfileEvent(
+	ctx context.Context,
+	event string,
+	session *models.AdminSession,
+	mentorID string,
+	outcome string,
+	extra map[string]interface{},
+) {
+
 	properties := map[string]interface{}{
 		"moderator_id":     session.ModeratorID,
 		"moderator_role":   string(session.Role),
@@ -433,7 +839,7 @@ func (s *AdminMentorsService) trackAdminProfileUpdate(
 	for key, value := range extra {
 		properties[key] = value
 	}
-	s.tracker.Track(ctx, analytics.EventAdminMentorProfileUpdated, analytics.ModeratorDistinctID(session.ModeratorID), properties)
+	s.track(ctx, event, analytics.ModeratorDistinctID(session.ModeratorID), properties)
 }
 
 func resolveStatuses(filter models.MentorModerationFilter, role models.ModeratorRole) ([]string, error) {
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const webhookTestTimeout = 10 * time.Second
+
+var (
+	ErrUnknownTrigger = errors.New("unknown trigger")
+)
+
+// triggerDefinition describes how an outbound trigger is called
+type triggerDefinition struct {
+	url         string
+	withPayload bool // POST JSON payload (CallAsyncWithPayload) vs GET url+recordID (CallAsync)
+	// fields are the keys of the real payload; a test payload may not set them
+	fields []string
+}
+
+// AdminWebhooksService lets admins exercise outbound event triggers with synthetic events
+type AdminWebhooksService struct {
+	config     *config.Config
+	httpClient httpclient.Client
+	tracker    analytics.Tracker
+}
+
+func NewAdminWebhooksService(
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+) *AdminWebhooksService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	return &AdminWebhooksService{
+		config:     cfg,
+		httpClient: httpClient,
+		tracker:    tracker,
+	}
+}
+
+func (s *AdminWebhooksService) triggerDefinitions() map[string]triggerDefinition {
+	t := s.config.EventTriggers
+	return map[string]triggerDefinition{
+		"mentor_created":           {url: t.MentorCreatedTriggerURL},
+		"mentor_updated":           {url: t.MentorUpdatedTriggerURL},
+		"mentor_request_created":   {url: t.MentorRequestCreatedTriggerURL},
+		"request_process_finished": {url: t.RequestProcessFinishedTriggerURL},
+		"review_created":           {url: t.ReviewCreatedTriggerURL},
+		"program_registration":     {url: t.ProgramRegistrationTriggerURL},
+		"abuse_report_created":     {url: t.AbuseReportTriggerURL},
+		"mentor_login_email": {url: t.MentorLoginEmailTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "login_url", "name", "slug", "completed_sessions", "inactive_days"}},
+		"moderator_login_email": {url: t.ModeratorLoginEmailTriggerURL, withPayload: true,
+			fields: []string{"moderator_id", "moderator_name", "moderator_email", "login_url"}},
+		"mentor_moderation": {url: t.MentorModerationTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "moderator_id", "role"}},
+		"mentor_channel_post": {url: t.MentorChannelPostTriggerURL, withPayload: true,
+			fields: []string{"mentorId", "link", "photoUrl", "text"}},
+		"session_reschedule": {url: t.SessionRescheduleTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "request_id", "proposal_id", "slots", "expires_at"}},
+		"mentor_email_change": {url: t.MentorEmailChangeTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "email_change_id", "new_email", "recipient", "by_moderator"}},
+		"mentor_new_device_login": {url: t.MentorNewDeviceLoginTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "session_id", "ip", "user_agent", "logged_in"}},
+		"community_event": {url: t.CommunityEventTriggerURL, withPayload: true,
+			fields: []string{"event", "pageUrl"}},
+		"mentor_question": {url: t.MentorQuestionTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "question_id"}},
+		"cohort_enrollment": {url: t.CohortEnrollmentTriggerURL, withPayload: true,
+			fields: []string{"cohort_id", "participant_id", "role", "email", "telegram"}},
+		"cohort_certificate": {url: t.CohortCertificateTriggerURL, withPayload: true,
+			fields: []string{"cohort_id", "participant_id", "certificate_id", "email", "telegram", "pdf_url", "verify_url"}},
+		"mentor_auto_approved": {url: t.MentorAutoApprovedTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "slug"}},
+		"mentor_survey": {url: t.MentorSurveyTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "survey_id", "survey_url"}},
+		"bot_silent": {url: t.BotSilentTriggerURL, withPayload: true},
+		"notification_digest": {url: t.NotificationDigestTriggerURL, withPayload: true,
+			fields: []string{"mentor_id", "batches"}},
+	}
+}
+
+// TriggerNames returns the names accepted by TestTrigger
+func (s *AdminWebhooksService) TriggerNames() []string {
+	names := make([]string, 0, len(s.triggerDefinitions()))
+	for name := range s.triggerDefinitions() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestTrigger builds the request a trigger would receive for a synthetic event and,
+// unless dry-run is requested, delivers it synchronously to the webhook test sandbox and
+// reports the outcome. Production trigger URLs are never called, and payload keys the real
+// trigger payload carries (recipients, IDs, links) are dropped.
+func (s *AdminWebhooksService) TestTrigger(
+	ctx context.Context,
+	session *models.AdminSession,
+	req *models.AdminWebhookTestRequest,
+) (*models.AdminWebhookTestResponse, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		s.trackWebhookTest(ctx, session, req, "forbidden")
+		return nil, ErrAdminForbiddenAction
+	}
+
+	def, ok := s.triggerDefinitions()[req.Trigger]
+	if !ok {
+		s.trackWebhookTest(ctx, session, req, "unknown_trigger")
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTrigger, req.Trigger)
+	}
+
+	resp := &models.AdminWebhookTestResponse{
+		Trigger:    req.Trigger,
+		Configured: def.url != "",
+		DryRun:     req.IsDryRun(),
+		Trace:      []string{fmt.Sprintf("resolved trigger %q", req.Trigger)},
+	}
+	if !resp.Configured {
+		resp.Trace = append(resp.Trace, "trigger URL is not configured, production calls are skipped silently")
+		s.trackWebhookTest(ctx, session, req, "not_configured")
+		return resp, nil
+	}
+
+	recordID := req.RecordID
+	if recordID == "" {
+		recordID = "webhook-test"
+	}
+
+	var payload map[string]interface{}
+	if def.withPayload {
+		payload = map[string]interface{}{"type": "webhook_test"}
+		reserved := make(map[string]bool, len(def.fields))
+		for _, field := range def.fields {
+			reserved[field] = true
+		}
+		for key, value := range req.Payload {
+			if reserved[key] {
+				resp.DroppedKeys = append(resp.DroppedKeys, key)
+				continue
+			}
+			payload[key] = value
+		}
+		payload["trigger"] = req.Trigger
+		payload["test"] = true
+		if len(resp.DroppedKeys) > 0 {
+			sort.Strings(resp.DroppedKeys)
+			resp.Trace = append(resp.Trace, fmt.Sprintf("dropped payload keys of the real trigger: %v", resp.DroppedKeys))
+		}
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize test payload: %w", err)
+		}
+		resp.Method = http.MethodPost
+		resp.URL = def.url
+		resp.Body = body
+		resp.Trace = append(resp.Trace, "built JSON payload request")
+	} else {
+		resp.Method = http.MethodGet
+		resp.URL = def.url + recordID
+		resp.Trace = append(resp.Trace, fmt.Sprintf("built record request for record %q", recordID))
+	}
+
+	if resp.DryRun {
+		resp.Trace = append(resp.Trace, "dry run: request not sent")
+		s.trackWebhookTest(ctx, session, req, "dry_run")
+		return resp, nil
+	}
+
+	sandboxURL := s.config.EventTriggers.WebhookTestSandboxURL
+	if sandboxURL == "" {
+		resp.Trace = append(resp.Trace, "WEBHOOK_TEST_SANDBOX_URL is not configured: request not sent")
+		s.trackWebhookTest(ctx, session, req, "no_sandbox")
+		return resp, nil
+	}
+	if def.withPayload {
+		resp.URL = sandboxURL
+	} else {
+		resp.URL = sandboxURL + recordID
+	}
+	resp.Trace = append(resp.Trace, "sending to the webhook test sandbox instead of the trigger URL")
+
+	callCtx, cancel := context.WithTimeout(ctx, webhookTestTimeout)
+	defer cancel()
+
+	start := time.Now()
+	var statusCode int
+	var err error
+	if def.withPayload {
+		statusCode, err = trigger.CallWithPayload(callCtx, sandboxURL, payload, s.httpClient)
+	} else {
+		statusCode, err = trigger.Call(callCtx, sandboxURL, recordID, s.httpClient)
+	}
+	resp.DurationMs = time.Since(start).Milliseconds()
+	resp.StatusCode = statusCode
+
+	if err != nil {
+		resp.Error = err.Error()
+		resp.Trace = append(resp.Trace, "delivery failed")
+		logger.Warn("Webhook test delivery failed",
+			zap.Error(err),
+			zap.String("trigger", req.Trigger),
+			zap.String("moderator_id", session.ModeratorID))
+		s.trackWebhookTest(ctx, session, req, "delivery_failed")
+		return resp, nil
+	}
+
+	resp.Delivered = true
+	resp.Trace = append(resp.Trace, fmt.Sprintf("delivered with status %d", statusCode))
+	s.trackWebhookTest(ctx, session, req, "success")
+	return resp, nil
+}
+
+func (s *AdminWebhooksService) trackWebhookTest(
+	ctx context.Context,
+	session *models.AdminSession,
+	req *models.AdminWebhookTestRequest,
+	outcome string,
+) {
+
+	s.tracker.Track(ctx, analytics.EventAdminWebhookTested, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"trigger":        req.Trigger,
+		"dry_run":        req.IsDryRun(),
+		"outcome":        outcome,
+	})
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// zeroUUID is the cursor ID before every row
+const zeroUUID = "00000000-0000-0000-0000-000000000000"
+
+// CutoverState returns the progress of the Airtable cutover
+func (s *AirtableSyncService) CutoverState(ctx context.Context) (*models.AirtableCutoverState, error) {
+	return s.repo.GetCutoverState(ctx)
+}
+
+// FreezeWrites stops the background sync of every replica; their next run skips
+func (s *AirtableSyncService) FreezeWrites(ctx context.Context) error {
+	return s.repo.SetWritesFrozen(ctx, true)
+}
+
+// ThawWrites resumes the background sync after a cutover that did not complete
+func (s *AirtableSyncService) ThawWrites(ctx context.Context) error {
+	return s.repo.SetWritesFrozen(ctx, false)
+}
+
+// FinalizeCutover retires the Airtable base: PostgreSQL becomes the only data source and
+// the sync never writes to Airtable again. report is kept with the cutover state.
+func (s *AirtableSyncService) FinalizeCutover(ctx context.Context, report []byte) error {
+	return s.repo.FinalizeCutover(ctx, report)
+}
+
+// FinalSync pushes every change up to now, without the lag of the periodic sync, and
+// returns the time it synced up to. It runs while writes are frozen and waits for a replica
+// that was still syncing a stream when they were.
+func (s *AirtableSyncService) FinalSync(ctx context.Context) (time.Time, []models.AirtableSyncResult, error) {
+	cutoff := time.Now()
+	results := []models.AirtableSyncResult{}
+	for _, stream := range airtableStreams {
+		var result *models.AirtableSyncResult
+		for attempt := 1; ; attempt++ {
+			var err error
+			result, err = s.syncStream(ctx, stream, cutoff)
+			if err != nil {
+				return cutoff, results, err
+			}
+			if !result.Skipped || attempt == airtableFinalSyncAttempts {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return cutoff, results, ctx.Err()
+			case <-time.After(airtableFinalSyncWait):
+			}
+		}
+		if result.Skipped {
+			return cutoff, results, fmt.Errorf("stream %s is still being synced by another instance", stream)
+		}
+		results = append(results, *result)
+	}
+	return cutoff, results, nil
+}
+
+// SyncedUntil returns how far every stream has been pushed to Airtable: the oldest watermark,
+// or the zero time when a stream never synced
+func (s *AirtableSyncService) SyncedUntil(ctx context.Context) (time.Time, error) {
+	var syncedUntil time.Time
+	for i, stream := range airtableStreams {
+		watermark, err := s.watermarks.GetWatermark(ctx, stream)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if watermark == nil {
+			return time.Time{}, nil
+		}
+		if i == 0 || watermark.Cursor.At.Before(syncedUntil) {
+			syncedUntil = watermark.Cursor.At
+		}
+	}
+	return syncedUntil, nil
+}
+
+// Verify compares the count and the field hashes of every row linked to Airtable with the
+// records of its table. Rows updated after syncedUntil are counted but not compared.
+func (s *AirtableSyncService) Verify(ctx context.Context, syncedUntil time.Time) ([]models.AirtableStreamCheck, error) {
+	checks := []models.AirtableStreamCheck{}
+	for _, stream := range airtableStreams {
+		check, err := s.verifyStream(ctx, stream, syncedUntil)
+		if err != nil {
+			return checks, fmt.Errorf("failed to verify %s: %w", stream, err)
+		}
+		checks = append(checks, *check)
+	}
+	return checks, nil
+}
+
+func (s *AirtableSyncService) verifyStream(ctx context.Context, stream string, syncedUntil time.Time) (*models.AirtableStreamCheck, error) {
+	table := s.table(stream)
+	rows := map[string]airtableChange{}
+	after := models.HistoryCursor{ID: zeroUUID}
+	readUntil := time.Now().Add(time.Minute)
+	for {
+		changes, last, err := s.readBatch(ctx, stream, after, readUntil)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			rows[change.recordID] = change
+		}
+		if len(changes) < airtableSyncBatchSize {
+			break
+		}
+		after = last
+	}
+
+	fields := airtableFieldNames(stream)
+	records, err := s.client.List(ctx, table, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &models.AirtableStreamCheck{
+		Stream:        stream,
+		Table:         table,
+		PostgresCount: len(rows),
+		AirtableCount: len(records),
+	}
+	samples := []string{}
+	seen := make(map[string]bool, len(records))
+	for _, record := range records {
+		row, found := rows[record.ID]
+		if !found {
+			check.Extra++
+			samples = append(samples, "extra "+record.ID)
+			continue
+		}
+		seen[record.ID] = true
+		if row.updatedAt.After(syncedUntil) {
+			check.ChangedAfterSync++
+			continue
+		}
+		if models.AirtableFieldsHash(row.fields, fields) != models.AirtableFieldsHash(record.Fields, fields) {
+			check.Mismatched++
+			samples = append(samples, "mismatched "+record.ID)
+		}
+	}
+	for recordID := range rows {
+		if !seen[recordID] {
+			check.Missing++
+			samples = append(samples, "missing "+recordID)
+		}
+	}
+
+	sort.Strings(samples)
+	if len(samples) > airtableVerifySamples {
+		samples = samples[:airtableVerifySamples]
+	}
+	if len(samples) > 0 {
+		check.Samples = samples
+	}
+	check.OK = check.Missing == 0 && check.Extra == 0 && check.Mismatched == 0
+	logger.Info("Airtable stream verified",
+		zap.String("stream", stream),
+		zap.Int("postgres_count", check.PostgresCount),
+		zap.Int("airtable_count", check.AirtableCount),
+		zap.Int("missing", check.Missing),
+		zap.Int("extra", check.Extra),
+		zap.Int("mismatched", check.Mismatched))
+	return check, nil
+}
+
+// airtableFieldNames returns the sorted Airtable fields a stream writes
+func airtableFieldNames(stream string) []string {
+	var fields map[string]interface{}
+	if stream == models.AirtableStreamRequests {
+		fields = (&models.AirtableRequestChange{}).AirtableFields()
+	} else {
+		fields = (&models.AirtableMentorChange{}).AirtableFields()
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// airtableSyncBatchSize is how many changed rows are read and pushed under one watermark lock
+	airtableSyncBatchSize = 100
+	// airtableSyncLag keeps the sync behind the newest rows, like the warehouse export
+	airtableSyncLag = time.Minute
+	// airtableFinalSyncAttempts and airtableFinalSyncWait bound how long the final sync waits
+	// for a replica that was syncing when writes were frozen
+	airtableFinalSyncAttempts = 12
+	airtableFinalSyncWait     = 5 * time.Second
+	// airtableVerifySamples caps the record IDs listed per verified stream
+	airtableVerifySamples = 20
+)
+
+// ErrAirtableWritesFrozen is returned when the Airtable cutover froze or retired the sync
+var ErrAirtableWritesFrozen = errors.New("airtable writes are frozen by the cutover")
+
+// airtableStreams are synced and verified in this order
+var airtableStreams = []string{models.AirtableStreamMentors, models.AirtableStreamRequests}
+
+// airtableChange is a PostgreSQL change ready to be written to an Airtable record
+type airtableChange struct {
+	recordID  string
+	fields    map[string]interface{}
+	updatedAt time.Time
+}
+
+// AirtableSyncService mirrors mentor and request changes from PostgreSQL back to the legacy
+// Airtable base, for the operations views that still live there. Only rows migrated from
+// Airtable (with an airtable_id) are synced; nothing is created or deleted in Airtable.
+//
+// Conflicts are detected with the table's last-modified field: when an Airtable record was
+// edited after the PostgreSQL row's updated_at, the Airtable edit is newer and the row is
+// skipped and counted as a conflict instead of being overwritten.
+type AirtableSyncService struct {
+	repo       *repository.AirtableSyncRepository
+	watermarks *repository.WarehouseExportRepository
+	uow        *repository.UnitOfWork
+	client     *airtable.Client
+	config     config.AirtableSyncConfig
+}
+
+// NewAirtableSyncService creates a new Airtable sync service
+func NewAirtableSyncService(
+	repo *repository.AirtableSyncRepository,
+	watermarks *repository.WarehouseExportRepository,
+	uow *repository.UnitOfWork,
+	client *airtable.Client,
+	cfg config.AirtableSyncConfig,
+) *AirtableSyncService {
+
+	return &AirtableSyncService{
+		repo:       repo,
+		watermarks: watermarks,
+		uow:        uow,
+		client:     client,
+		config:     cfg,
+	}
+}
+
+// Start runs a sync in the background now and then every configured interval
+func (s *AirtableSyncService) Start() {
+	go func() {
+		s.syncAndLog()
+
+		ticker := time.NewTicker(time.Duration(s.config.IntervalMinutes) * time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.syncAndLog()
+		}
+	}()
+}
+
+func (s *AirtableSyncService) syncAndLog() {
+	_, err := s.SyncAll(context.Background())
+	if errors.Is(err, ErrAirtableWritesFrozen) {
+		logger.Info("Airtable writes are frozen by the cutover; skipping sync")
+		return
+	}
+	if err != nil {
+		logger.Error("Airtable sync failed", zap.Error(err))
+		// The watermark only moves after a pushed batch - the next run resumes from it
+	}
+}
+
+// SyncAll pushes the pending changes of every stream. It is not safe for concurrent use
+// within a process; other instances skip a stream while its watermark is locked. It returns
+// ErrAirtableWritesFrozen once the cutover has started.
+func (s *AirtableSyncService) SyncAll(ctx context.Context) ([]models.AirtableSyncResult, error) {
+	state, err := s.repo.GetCutoverState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state.WritesFrozen() {
+		return nil, ErrAirtableWritesFrozen
+	}
+
+	results := []models.AirtableSyncResult{}
+	for _, stream := range airtableStreams {
+		result, err := s.syncStream(ctx, stream, time.Now().Add(-airtableSyncLag))
+		if err != nil {
+			metrics.AirtableSyncRecords.WithLabelValues(stream, "error").Inc()
+			return results, fmt.Errorf("failed to sync %s: %w", stream, err)
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// syncStream pushes batches until the stream catches up with the cutoff
+func (s *AirtableSyncService) syncStream(ctx context.Context, stream string, cutoff time.Time) (*models.AirtableSyncResult, error) {
+	start := time.Now()
+	result := &models.AirtableSyncResult{Stream: stream}
+
+	for {
+		read := 0
+		err := s.uow.Do(ctx, func(ctx context.Context) error {
+			watermark, err := s.watermarks.LockWatermark(ctx, stream)
+			if err != nil {
+				return err
+			}
+			if watermark == nil {
+				result.Skipped = true
+				return nil
+			}
+
+			changes, last, err := s.readBatch(ctx, stream, watermark.Cursor, cutoff)
+			if err != nil || len(changes) == 0 {
+				return err
+			}
+			if err := s.push(ctx, stream, changes, result); err != nil {
+				return err
+			}
+			read = len(changes)
+			return s.watermarks.AdvanceWatermark(ctx, stream, last, read)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.Skipped {
+			logger.Info("Airtable sync of stream is running elsewhere; skipping", zap.String("stream", stream))
+			return result, nil
+		}
+		if read < airtableSyncBatchSize {
+			break
+		}
+	}
+
+	metrics.AirtableSyncRecords.WithLabelValues(stream, "synced").Add(float64(result.Synced))
+	metrics.AirtableSyncRecords.WithLabelValues(stream, "conflict").Add(float64(result.Conflicts))
+	metrics.AirtableSyncRecords.WithLabelValues(stream, "missing").Add(float64(result.Missing))
+	logger.Info("Airtable sync completed",
+		zap.String("stream", stream),
+		zap.Int("synced", result.Synced),
+		zap.Int("conflicts", result.Conflicts),
+		zap.Int("missing", result.Missing),
+		zap.Duration("duration", time.Since(start)))
+	return result, nil
+}
+
+// readBatch reads the next changed rows of a stream, returning them and the cursor of the last one
+func (s *AirtableSyncService) readBatch(ctx context.Context, stream string, after models.HistoryCursor, cutoff time.Time) ([]airtableChange, models.HistoryCursor, error) {
+	changes := []airtableChange{}
+	last := after
+
+	switch stream {
+	case models.AirtableStreamMentors:
+		mentors, err := s.repo.ListMentorChanges(ctx, after, cutoff, airtableSyncBatchSize)
+		if err != nil {
+			return nil, last, err
+		}
+		for _, m := range mentors {
+			changes = append(changes, airtableChange{recordID: m.AirtableID, fields: m.AirtableFields(), updatedAt: m.UpdatedAt})
+			last = models.HistoryCursor{At: m.UpdatedAt, ID: m.ID}
+		}
+	case models.AirtableStreamRequests:
+		requests, err := s.repo.ListRequestChanges(ctx, after, cutoff, airtableSyncBatchSize)
+		if err != nil {
+			return nil, last, err
+		}
+		for _, r := range requests {
+			changes = append(changes, airtableChange{recordID: r.AirtableID, fields: r.AirtableFields(), updatedAt: r.UpdatedAt})
+			last = models.HistoryCursor{At: r.UpdatedAt, ID: r.ID}
+		}
+	default:
+		return nil, last, fmt.Errorf("unknown Airtable stream %q", stream)
+	}
+	return changes, last, nil
+}
+
+// push writes the changes in Airtable-sized chunks, skipping records that are gone or
+// were edited in Airtable after the PostgreSQL change
+func (s *AirtableSyncService) push(ctx context.Context, stream string, changes []airtableChange, result *models.AirtableSyncResult) error {
+	table := s.table(stream)
+	for startIdx := 0; startIdx < len(changes); startIdx += airtable.MaxBatch {
+		chunk := changes[startIdx:min(startIdx+airtable.MaxBatch, len(changes))]
+		ids := make([]string, len(chunk))
+		for i, change := range chunk {
+			ids[i] = change.recordID
+		}
+
+		existing, err := s.client.Get(ctx, table, ids, []string{s.config.ModifiedField})
+		if err != nil {
+			return err
+		}
+		modifiedAt := make(map[string]*time.Time, len(existing))
+		for _, record := range existing {
+			modifiedAt[record.ID] = parseAirtableTime(record.Fields[s.config.ModifiedField])
+		}
+
+		updates := []airtable.Record{}
+		for _, change := range chunk {
+			modified, found := modifiedAt[change.recordID]
+			switch {
+			case !found:
+				result.Missing++
+			case modified != nil && modified.After(change.updatedAt):
+				result.Conflicts++
+				logger.Warn("Airtable record changed after PostgreSQL, not overwriting",
+					zap.String("stream", stream),
+					zap.String("record_id", change.recordID),
+					zap.Time("airtable_modified_at", *modified),
+					zap.Time("updated_at", change.updatedAt))
+			default:
+				updates = append(updates, airtable.Record{ID: change.recordID, Fields: change.fields})
+			}
+		}
+		if err := s.client.Update(ctx, table, updates); err != nil {
+			return err
+		}
+		result.Synced += len(updates)
+	}
+	return nil
+}
+
+func (s *AirtableSyncService) table(stream string) string {
+	if stream == models.AirtableStreamRequests {
+		return s.config.RequestsTable
+	}
+	return s.config.MentorsTable
+}
+
+// parseAirtableTime reads a last-modified field value, or nil when it is empty
+func parseAirtableTime(value interface{}) *time.Time {
+	str, ok := value.(string)
+	if !ok || str == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Audited operations
+const (
+	AuditOperationUpdateProfile  = "update_profile"
+	AuditOperationPatchProfile   = "patch_profile"
+	AuditOperationDecline        = "decline"
+	AuditOperationSetStatus      = "set_status"
+	AuditOperationSchedule       = "schedule"
+	AuditOperationReactivate     = "reactivate"
+	AuditOperationDelete         = "delete"
+	AuditOperationConfirmEmail   = "confirm_email"
+	AuditOperationLeaderboardOpt = "leaderboard_opt_in"
+)
+
+// AuditLogger records who changed a mentor or request, what changed and when.
+// A nil *AuditLogger runs the changes without recording them.
+type AuditLogger struct {
+	repo *repository.AuditLogRepository
+	uow  *repository.UnitOfWork
+}
+
+// NewAuditLogger creates a new AuditLogger
+func NewAuditLogger(repo *repository.AuditLogRepository, uow *repository.UnitOfWork) *AuditLogger {
+	return &AuditLogger{repo: repo, uow: uow}
+}
+
+// Track runs fn and records the fields it changed on the entity, all in one transaction, so a
+// change is never saved without its entry. The actor is taken from ctx (see models.WithAuditActor).
+// Changes that leave the row as it was are not recorded. Dry runs are not recorded either.
+func (l *AuditLogger) Track(ctx context.Context, entityType, entityID, operation string, fn func(ctx context.Context) error) error {
+	if l == nil || IsDryRun(ctx) {
+		return fn(ctx)
+	}
+
+	return l.uow.Do(ctx, func(txCtx context.Context) error {
+		before, err := l.repo.Snapshot(txCtx, entityType, entityID)
+		if err != nil {
+			return err
+		}
+		if err := fn(txCtx); err != nil {
+			return err
+		}
+		after, err := l.repo.Snapshot(txCtx, entityType, entityID)
+		if err != nil {
+			return err
+		}
+
+		changes := models.DiffAuditSnapshots(before, after)
+		if len(changes) == 0 {
+			metrics.AuditLogEntries.WithLabelValues(entityType, "unchanged").Inc()
+			return nil
+		}
+
+		actor := models.AuditActorFromContext(ctx)
+		entry := &models.AuditLogEntry{
+			ActorType:  actor.Type,
+			ActorID:    actor.ID,
+			EntityType: entityType,
+			EntityID:   entityID,
+			Operation:  operation,
+			Changes:    changes,
+			Patch:      models.AuditPatchFromContext(ctx),
+		}
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+			entry.TraceID = spanContext.TraceID().String()
+		}
+		if err := l.repo.Insert(txCtx, entry); err != nil {
+			return err
+		}
+		metrics.AuditLogEntries.WithLabelValues(entityType, "recorded").Inc()
+		return nil
+	})
+}
+
+// List returns a page of the audit log, newest first. Admin only. The limit is capped.
+func (l *AuditLogger) List(ctx context.Context, session *models.AdminSession, filter models.AuditLogFilter) (*models.AuditLogListResponse, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	if filter.To.IsZero() {
+		filter.To = time.Now()
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", apperrors.ErrInvalidInput)
+	}
+	if filter.Before < 0 {
+		return nil, fmt.Errorf("%w: before must be a positive entry ID", apperrors.ErrInvalidInput)
+	}
+	if filter.Limit <= 0 || filter.Limit > models.MaxAuditLogEntriesListed {
+		filter.Limit = models.MaxAuditLogEntriesListed
+	}
+
+	entries, err := l.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.AuditLogListResponse{Entries: entries}
+	if len(entries) == filter.Limit {
+		next := entries[len(entries)-1].ID
+		resp.NextBefore = &next
+	}
+	return resp, nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+)
+
+const (
+	calendarFeedMaxBytes     = 2 * 1024 * 1024
+	calendarFeedFetchTimeout = 10 * time.Second
+	availabilityHorizon      = 30 * 24 * time.Hour
+	// Busy periods of at least this length are treated as a declared vacation
+	vacationMinDuration = 24 * time.Hour
+
+	availabilitySourceICal = "ical"
+	availabilitySourceNone = "none"
+)
+
+// calendarFeedEntry is a parsed feed stored in cache
+type calendarFeedEntry struct {
+	events    []ical.Event
+	fetchedAt time.Time
+}
+
+// AvailabilityService computes mentor availability from profile settings and their iCal feed
+type AvailabilityService struct {
+	mentorRepo *repository.MentorRepository
+	// httpClient refuses feeds resolving to internal addresses: the URLs come from mentors
+	httpClient httpclient.Client
+	feedTTL    time.Duration
+	// fresh holds feeds for feedTTL; lastGood keeps the last successful parse to survive feed outages
+	fresh    *gocache.Cache
+	lastGood *gocache.Cache
+}
+
+// NewAvailabilityService creates a new availability service
+func NewAvailabilityService(
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+) *AvailabilityService {
+
+	feedTTL := time.Duration(cfg.Cache.CalendarFeedTTLSeconds) * time.Second
+	if feedTTL <= 0 {
+		feedTTL = 30 * time.Minute
+	}
+
+	return &AvailabilityService{
+		mentorRepo: mentorRepo,
+		httpClient: ical.NewGuardedClient(calendarFeedFetchTimeout),
+		feedTTL:    feedTTL,
+		fresh:      gocache.New(feedTTL, 10*time.Minute),
+		lastGood:   gocache.New(7*24*time.Hour, time.Hour),
+	}
+}
+
+// GetAvailability returns availability for a visible mentor identified by legacy ID
+func (s *AvailabilityService) GetAvailability(ctx context.Context, legacyID int) (*models.MentorAvailability, error) {
+	mentor, err := s.mentorRepo.GetByID(ctx, legacyID, models.FilterOptions{OnlyVisible: true, ShowHidden: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.BuildAvailability(ctx, mentor, time.Now()), nil
+}
+
+// BuildAvailability computes availability for the given mentor at the given moment.
+// Calendar feed errors never fail the call: the last known good data is served and marked stale.
+func (s *AvailabilityService) BuildAvailability(ctx context.Context, mentor *models.Mentor, now time.Time) *models.MentorAvailability {
+	availability := &models.MentorAvailability{
+		Timezone:     mentor.Timezone,
+		ContactHours: mentor.ContactHours,
+		Source:       availabilitySourceNone,
+		BusyPeriods:  []models.BusyPeriod{},
+	}
+	if within, known := mentor.IsWithinContactHours(now); known {
+		availability.WithinContactHours = &within
+	}
+
+	if !ical.IsFeedURL(mentor.CalendarURL) {
+		return availability
+	}
+	availability.Source = availabilitySourceICal
+
+	events, stale := s.getFeed(ctx, mentor)
+	availability.Stale = stale
+	fillBusyPeriods(availability, events, now)
+
+	return availability
+}
+
+// getFeed returns cached feed events, refetching when the cache entry has expired
+func (s *AvailabilityService) getFeed(ctx context.Context, mentor *models.Mentor) ([]ical.Event, bool) {
+	key := mentor.MentorID + "|" + mentor.CalendarURL
+
+	if cached, found := s.fresh.Get(key); found {
+		if entry, ok := cached.(*calendarFeedEntry); ok {
+			metrics.CalendarFeedFetches.WithLabelValues("cache_hit").Inc()
+			return entry.events, false
+		}
+	}
+
+	events, err := s.fetchFeed(ctx, mentor)
+	if err == nil {
+		entry := &calendarFeedEntry{events: events, fetchedAt: time.Now()}
+		s.fresh.Set(key, entry, s.feedTTL)
+		s.lastGood.SetDefault(key, entry)
+		metrics.CalendarFeedFetches.WithLabelValues("success").Inc()
+		return events, false
+	}
+
+	metrics.CalendarFeedFetches.WithLabelValues("error").Inc()
+	logger.Warn("Failed to fetch mentor calendar feed",
+		zap.Error(err),
+		zap.String("mentor_id", mentor.MentorID))
+
+	if cached, found := s.lastGood.Get(key); found {
+		if entry, ok := cached.(*calendarFeedEntry); ok {
+			return entry.events, true
+		}
+	}
+
+	return nil, true
+}
+
+func (s *AvailabilityService) fetchFeed(ctx context.Context, mentor *models.Mentor) ([]ical.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, calendarFeedFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ical.FetchURL(mentor.CalendarURL), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build calendar request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch calendar: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar feed returned status %d", resp.StatusCode)
+	}
+
+	loc := time.UTC
+	if mentor.Timezone != "" {
+		if l, locErr := time.LoadLocation(mentor.Timezone); locErr == nil {
+			loc = l
+		}
+	}
+
+	return ical.Parse(io.LimitReader(resp.Body, calendarFeedMaxBytes), loc)
+}
+
+// fillBusyPeriods keeps events and occurrences of recurring ones overlapping [now, now+horizon]
+// and detects vacations covering now
+func fillBusyPeriods(availability *models.MentorAvailability, events []ical.Event, now time.Time) {
+	horizonEnd := now.Add(availabilityHorizon)
+
+	for _, event := range ical.Expand(events, now, horizonEnd) {
+		if !event.End.After(now) {
+			continue
+		}
+
+		availability.BusyPeriods = append(availability.BusyPeriods, models.BusyPeriod{
+			Start:  event.Start,
+			End:    event.End,
+			AllDay: event.AllDay,
+		})
+
+		if event.Duration() >= vacationMinDuration && !event.Start.After(now) {
+			end := event.End
+			if availability.PausedUntil == nil || end.After(*availability.PausedUntil) {
+				availability.PausedUntil = &end
+			}
+		}
+	}
+
+	sort.Slice(availability.BusyPeriods, func(i, j int) bool {
+		return availability.BusyPeriods[i].Start.Before(availability.BusyPeriods[j].Start)
+	})
+	availability.OnVacation = availability.IsPausedAt(now)
+}
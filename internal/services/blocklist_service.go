@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// ErrSubmissionBlocked is returned when a submission matches a rejecting blocklist entry
+var ErrSubmissionBlocked = errors.New("submission rejected by blocklist")
+
+// BlocklistService manages the mentee blocklist and checks public submissions against it
+type BlocklistService struct {
+	repo    *repository.BlocklistRepository
+	tracker analytics.Tracker
+}
+
+// NewBlocklistService creates a new blocklist service
+func NewBlocklistService(repo *repository.BlocklistRepository, tracker analytics.Tracker) *BlocklistService {
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	return &BlocklistService{
+		repo:    repo,
+		tracker: tracker,
+	}
+}
+
+// Check returns the active entry matching the submission, or nil. When several entries
+// match, a rejecting one wins over a flagging one. source labels the checked form in metrics.
+func (s *BlocklistService) Check(ctx context.Context, source string, subject models.BlocklistSubject) (*models.BlocklistEntry, error) {
+	entries, err := s.repo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *models.BlocklistEntry
+	for _, entry := range entries {
+		if !entry.Matches(subject) {
+			continue
+		}
+		if match == nil || entry.Action == models.BlocklistActionReject {
+			match = entry
+		}
+		if match.Action == models.BlocklistActionReject {
+			break
+		}
+	}
+
+	if match != nil {
+		metrics.BlocklistMatches.WithLabelValues(source, match.Kind, match.Action).Inc()
+		logger.Warn("Submission matched blocklist",
+			zap.String("source", source),
+			zap.String("entry_id", match.ID),
+			zap.String("kind", match.Kind),
+			zap.String("action", match.Action))
+	}
+	return match, nil
+}
+
+// ListEntries returns the blocklist. Available to moderators and admins.
+func (s *BlocklistService) ListEntries(ctx context.Context, session *models.AdminSession, includeInactive bool) ([]*models.BlocklistEntry, error) {
+	return s.repo.List(ctx, includeInactive)
+}
+
+// AddEntry puts a value on the blocklist. Admin only.
+func (s *BlocklistService) AddEntry(ctx context.Context, session *models.AdminSession, req *models.CreateBlocklistEntryRequest) (*models.BlocklistEntry, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.track(ctx, session, "added", req.Kind, "", "forbidden")
+		return nil, ErrAdminForbiddenAction
+	}
+
+	value, err := models.NormalizeBlocklistValue(req.Kind, req.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: expiresAt must be in the future", apperrors.ErrInvalidInput)
+	}
+
+	action := req.Action
+	if action == "" {
+		action = models.BlocklistActionReject
+	}
+
+	entry, err := s.repo.Create(ctx, &models.BlocklistEntry{
+		Kind:      req.Kind,
+		Value:     value,
+		Action:    action,
+		Reason:    strings.TrimSpace(req.Reason),
+		CreatedBy: session.ModeratorID,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		s.track(ctx, session, "added", req.Kind, "", "create_failed")
+		return nil, err
+	}
+
+	logger.Info("Blocklist entry added",
+		zap.String("entry_id", entry.ID),
+		zap.String("kind", entry.Kind),
+		zap.String("action", entry.Action),
+		zap.String("moderator_id", session.ModeratorID))
+	s.track(ctx, session, "added", entry.Kind, entry.ID, "success")
+	return entry, nil
+}
+
+// RemoveEntry deactivates a blocklist entry. Admin only.
+func (s *BlocklistService) RemoveEntry(ctx context.Context, session *models.AdminSession, entryID string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.track(ctx, session, "removed", "", entryID, "forbidden")
+		return ErrAdminForbiddenAction
+	}
+
+	if err := s.repo.Remove(ctx, entryID, session.ModeratorID); err != nil {
+		s.track(ctx, session, "removed", "", entryID, "remove_failed")
+		return err
+	}
+
+	logger.Info("Blocklist entry removed",
+		zap.String("entry_id", entryID),
+		zap.String("moderator_id", session.ModeratorID))
+	s.track(ctx, session, "removed", "", entryID, "success")
+	return nil
+}
+
+func (s *BlocklistService) track(ctx context.Context, session *models.AdminSession, operation, kind, entryID, outcome string) {
+	s.tracker.Track(ctx, analytics.EventAdminBlocklistChanged, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"operation":      operation,
+		"kind":           kind,
+		"entry_id":       entryID,
+		"outcome":        outcome,
+	})
+}
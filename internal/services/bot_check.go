@@ -0,0 +1,32 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/pkg/botcheck"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// newBotChecker creates the honeypot and fill time checker of the public forms
+func newBotChecker(cfg *config.Config) *botcheck.Checker {
+	return botcheck.NewChecker(
+		cfg.BotCheck.TimestampSecret,
+		time.Duration(cfg.BotCheck.MinFillSeconds)*time.Second,
+		time.Duration(cfg.BotCheck.TimestampMaxHours)*time.Hour,
+	)
+}
+
+// checkBotSignals rejects a submission of form that looks automated and counts why
+func checkBotSignals(checker *botcheck.Checker, form, honeypot, renderToken string) error {
+	err := checker.Check(form, honeypot, renderToken, time.Now())
+	var rejection *botcheck.Rejection
+	if errors.As(err, &rejection) {
+		metrics.FormBotRejections.WithLabelValues(form, rejection.Reason).Inc()
+		logger.Warn("Rejected automated form submission", zap.String("form", form), zap.String("reason", rejection.Reason))
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const (
+	// botSilenceCheckInterval is how often replicas look for a silent bot
+	botSilenceCheckInterval = time.Minute
+	botSilenceCheckTimeout  = 10 * time.Second
+)
+
+// BotHeartbeatService records the Telegram bot's heartbeats and raises the bot-silent alert
+// through BOT_SILENT_TRIGGER_URL when they stop for BOT_HEARTBEAT_SILENT_MINUTES. The next
+// heartbeat sends a recovery event through the same trigger.
+type BotHeartbeatService struct {
+	repo        *repository.BotHeartbeatRepository
+	config      *config.Config
+	httpClient  httpclient.Client
+	silentAfter time.Duration
+}
+
+// NewBotHeartbeatService creates a new bot heartbeat service
+func NewBotHeartbeatService(
+	repo *repository.BotHeartbeatRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *BotHeartbeatService {
+
+	return &BotHeartbeatService{
+		repo:        repo,
+		config:      cfg,
+		httpClient:  httpClient,
+		silentAfter: time.Duration(cfg.BotHeartbeat.SilentMinutes) * time.Minute,
+	}
+}
+
+// Start looks for a silent bot in the background every minute
+func (s *BotHeartbeatService) Start() {
+	go func() {
+		ticker := time.NewTicker(botSilenceCheckInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ctx, cancel := context.WithTimeout(context.Background(), botSilenceCheckTimeout)
+			if err := s.CheckSilence(ctx); err != nil {
+				logger.Error("Bot silence check failed", zap.Error(err))
+			}
+			cancel()
+		}
+	}()
+}
+
+// RecordHeartbeat stores a heartbeat and returns the bot status
+func (s *BotHeartbeatService) RecordHeartbeat(ctx context.Context, req *models.BotHeartbeatRequest) (*models.BotStatus, error) {
+	heartbeat, alertedAt, err := s.repo.Record(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	metrics.BotHeartbeatAge.Set(0)
+
+	if alertedAt != nil {
+		metrics.BotSilenceAlerts.WithLabelValues("recovered").Inc()
+		logger.Info("Telegram bot is sending heartbeats again",
+			zap.String("version", heartbeat.Version),
+			zap.Time("silent_alerted_at", *alertedAt))
+		s.notify("bot_recovered", heartbeat)
+	}
+	return models.NewBotStatus(heartbeat, s.silentAfter, heartbeat.ReceivedAt), nil
+}
+
+// Status returns whether the bot is alive
+func (s *BotHeartbeatService) Status(ctx context.Context) (*models.BotStatus, error) {
+	heartbeat, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewBotStatus(heartbeat, s.silentAfter, time.Now()), nil
+}
+
+// CheckSilence updates the heartbeat age gauge and raises the alert when the bot went silent.
+// Replicas all check; the one that flags the bot first sends the alert.
+func (s *BotHeartbeatService) CheckSilence(ctx context.Context) error {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if status.Heartbeat == nil {
+		return nil
+	}
+	metrics.BotHeartbeatAge.Set(float64(status.SecondsSinceHeartbeat))
+	if !status.Silent || status.Heartbeat.SilentAlertedAt != nil {
+		return nil
+	}
+
+	heartbeat, err := s.repo.MarkSilent(ctx, time.Now().Add(-s.silentAfter))
+	if err != nil || heartbeat == nil {
+		return err
+	}
+	metrics.BotSilenceAlerts.WithLabelValues("silent").Inc()
+	logger.Error("Telegram bot stopped sending heartbeats",
+		zap.String("version", heartbeat.Version),
+		zap.Time("last_heartbeat_at", heartbeat.ReceivedAt),
+		zap.Int("silent_after_minutes", s.config.BotHeartbeat.SilentMinutes))
+	s.notify("bot_silent", heartbeat)
+	return nil
+}
+
+func (s *BotHeartbeatService) notify(eventType string, heartbeat *models.BotHeartbeat) {
+	triggerURL := s.config.EventTriggers.BotSilentTriggerURL
+	if triggerURL == "" {
+		return
+	}
+	trigger.CallAsyncWithPayload(triggerURL, map[string]interface{}{
+		"type":                 eventType,
+		"version":              heartbeat.Version,
+		"last_heartbeat_at":    heartbeat.ReceivedAt,
+		"last_update_at":       heartbeat.LastUpdateAt,
+		"silent_after_minutes": s.config.BotHeartbeat.SilentMinutes,
+	}, s.httpClient)
+}
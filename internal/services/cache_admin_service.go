@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// MentorCacheAdmin is the mentor cache as operated through CacheAdminService; *cache.MentorCache implements it
+type MentorCacheAdmin interface {
+	Status() *models.MentorCacheStatus
+	Reload() error
+}
+
+// CacheAdminService lets operators inspect, refresh and invalidate the mentor cache of a replica
+// without restarting it. With shared cache versions on, refreshes and invalidations also bump
+// the shared version so every other replica reloads within a poll interval.
+type CacheAdminService struct {
+	cache    MentorCacheAdmin
+	mentors  *repository.MentorRepository
+	versions *CacheVersionService
+	backend  string
+}
+
+// NewCacheAdminService creates the service; versions is nil when shared cache versions are off
+func NewCacheAdminService(
+	mentorCache MentorCacheAdmin,
+	mentors *repository.MentorRepository,
+	versions *CacheVersionService,
+	backend string,
+) *CacheAdminService {
+
+	return &CacheAdminService{
+		cache:    mentorCache,
+		mentors:  mentors,
+		versions: versions,
+		backend:  backend,
+	}
+}
+
+// Status describes this replica's mentor cache
+func (s *CacheAdminService) Status(ctx context.Context) *models.MentorCacheStatus {
+	status := s.cache.Status()
+	status.Backend = s.backend
+	if s.versions != nil {
+		version := s.versions.Version()
+		status.SharedVersion = &version
+	}
+	return status
+}
+
+// Refresh reloads every mentor into the cache before returning its status
+func (s *CacheAdminService) Refresh(ctx context.Context) (*models.MentorCacheStatus, error) {
+	if err := s.cache.Reload(); err != nil {
+		return nil, fmt.Errorf("failed to reload mentor cache: %w", err)
+	}
+	logger.Info("Mentor cache refreshed by an operator")
+	s.bumpShared(ctx)
+	return s.Status(ctx), nil
+}
+
+// Invalidate reloads one mentor into the cache, dropping it when it no longer exists
+func (s *CacheAdminService) Invalidate(ctx context.Context, slug string) (*models.CacheInvalidateResponse, error) {
+	slug = strings.TrimSpace(slug)
+	if slug == "" {
+		return nil, fmt.Errorf("%w: slug is required", apperrors.ErrInvalidInput)
+	}
+
+	removed, err := s.mentors.ReloadMentorInCache(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Mentor cache entry invalidated by an operator", zap.String("slug", slug), zap.Bool("removed", removed))
+	s.bumpShared(ctx)
+	return &models.CacheInvalidateResponse{Slug: slug, Removed: removed}, nil
+}
+
+// bumpShared makes the other replicas reload; failing only delays them until the next change
+func (s *CacheAdminService) bumpShared(ctx context.Context) {
+	if s.versions == nil {
+		return
+	}
+	if _, err := s.versions.Bump(ctx); err != nil {
+		logger.Warn("Failed to bump the shared mentor cache version", zap.Error(err))
+	}
+}
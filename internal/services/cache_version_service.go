@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// cacheVersionTimeout bounds a single read or write of the shared version
+	cacheVersionTimeout = 5 * time.Second
+	// cacheReplicaWindow is how many poll intervals a replica may miss before it is left out of reports
+	cacheReplicaWindow = 4
+	// cacheReloadMaxDeferrals is how many polls a reload may wait for a burst of changes to settle
+	cacheReloadMaxDeferrals = 4
+)
+
+// CacheReloader reloads a cache synchronously; *cache.MentorCache implements it
+type CacheReloader interface {
+	Reload() error
+}
+
+// VersionedMentorCache is the mentor cache CacheVersionService keeps in step: reloaded in full
+// when another replica changed mentors, one mentor at a time for changes made here.
+// *cache.MentorCache implements it.
+type VersionedMentorCache interface {
+	CacheReloader
+	UpdateSingleMentor(slug string) error
+}
+
+// CacheVersionService keeps the mentor caches of all replicas, in every region, in step. Mentor
+// changes bump a version shared through Postgres; each replica polls it and reloads its cache when
+// the shared version is newer than the one it holds. While the shared version keeps moving from one
+// poll to the next, the reload waits for it to settle, at most cacheReloadMaxDeferrals polls, so a
+// burst of changes costs one reload; replicas converge within two poll intervals plus a reload after
+// the last change. Each poll also records the version the replica holds, for comparing replicas.
+type CacheVersionService struct {
+	repo     *repository.CacheVersionRepository
+	cache    VersionedMentorCache
+	replica  string
+	region   string
+	interval time.Duration
+
+	mu       sync.RWMutex
+	version  int64
+	loadedAt time.Time
+
+	// seen is the shared version read by the last poll and deferred the polls the pending
+	// reload has waited; only the poll loop uses them
+	seen     int64
+	deferred int
+}
+
+// NewCacheVersionService creates the version service for the mentor cache. The replica is named
+// by SERVICE_INSTANCE_ID, or the hostname when it is not set.
+func NewCacheVersionService(
+	repo *repository.CacheVersionRepository,
+	mentorCache VersionedMentorCache,
+	cfg *config.Config,
+) *CacheVersionService {
+
+	replica := cfg.Observability.ServiceInstanceID
+	if replica == "" {
+		replica, _ = os.Hostname()
+	}
+	return &CacheVersionService{
+		repo:     repo,
+		cache:    mentorCache,
+		replica:  replica,
+		region:   cfg.Server.Region,
+		interval: time.Duration(cfg.Cache.VersionPollSeconds) * time.Second,
+	}
+}
+
+// Start adopts the shared version for the freshly loaded cache and polls it in the background
+func (s *CacheVersionService) Start() {
+	ctx, cancel := context.WithTimeout(context.Background(), cacheVersionTimeout)
+	current, err := s.repo.Current(ctx, models.CacheVersionMentors)
+	cancel()
+	if err != nil {
+		logger.Warn("Failed to read the shared mentor cache version", zap.Error(err))
+	}
+	s.setVersion(current)
+	s.seen = current
+	s.report()
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			s.poll()
+		}
+	}()
+}
+
+// Version returns the shared mentor cache version this replica holds
+func (s *CacheVersionService) Version() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.version
+}
+
+// VersionHeader returns Version formatted for the X-Cache-Version header
+func (s *CacheVersionService) VersionHeader() string {
+	return strconv.FormatInt(s.Version(), 10)
+}
+
+// Report compares the versions recently reported by every replica with the shared one
+func (s *CacheVersionService) Report(ctx context.Context) (*models.CacheVersionsReport, error) {
+	current, err := s.repo.Current(ctx, models.CacheVersionMentors)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().Add(-cacheReplicaWindow * s.interval)
+	replicas, err := s.repo.ListReplicas(ctx, models.CacheVersionMentors, since)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewCacheVersionsReport(models.CacheVersionMentors, current, replicas), nil
+}
+
+// Publisher wraps next so that every mentor.updated event published through it bumps the
+// shared version, whichever service made the change
+func (s *CacheVersionService) Publisher(next eventbus.Publisher) eventbus.Publisher {
+	return &cacheVersionPublisher{next: next, service: s}
+}
+
+// poll reloads the cache when the shared version moved past the held one and stopped moving,
+// or the reload waited for it long enough
+func (s *CacheVersionService) poll() {
+	ctx, cancel := context.WithTimeout(context.Background(), cacheVersionTimeout)
+	current, err := s.repo.Current(ctx, models.CacheVersionMentors)
+	cancel()
+	if err != nil {
+		logger.Warn("Failed to read the shared mentor cache version", zap.Error(err))
+		return
+	}
+
+	if held := s.Version(); current > held {
+		if current != s.seen && s.deferred < cacheReloadMaxDeferrals {
+			s.seen = current
+			s.deferred++
+			metrics.CacheVersionReloads.WithLabelValues(models.CacheVersionMentors, "deferred").Inc()
+			s.report()
+			return
+		}
+		if err := s.cache.Reload(); err != nil {
+			metrics.CacheVersionReloads.WithLabelValues(models.CacheVersionMentors, "failure").Inc()
+			logger.Error("Failed to reload mentor cache for a newer version",
+				zap.Int64("held_version", held),
+				zap.Int64("shared_version", current),
+				zap.Error(err))
+			return
+		}
+		metrics.CacheVersionReloads.WithLabelValues(models.CacheVersionMentors, "success").Inc()
+		logger.Info("Reloaded mentor cache for a newer version",
+			zap.Int64("held_version", held),
+			zap.Int64("shared_version", current))
+		s.deferred = 0
+		s.setVersion(current)
+	}
+	s.seen = current
+	s.report()
+}
+
+func (s *CacheVersionService) setVersion(version int64) {
+	s.mu.Lock()
+	s.version, s.loadedAt = version, time.Now()
+	s.mu.Unlock()
+	metrics.CacheVersion.WithLabelValues(models.CacheVersionMentors).Set(float64(version))
+}
+
+// report records the held version; failures only cost the replica its place in reports
+func (s *CacheVersionService) report() {
+	s.mu.RLock()
+	replica := models.CacheReplica{Replica: s.replica, Region: s.region, Version: s.version, LoadedAt: s.loadedAt}
+	s.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheVersionTimeout)
+	defer cancel()
+	if err := s.repo.ReportReplica(ctx, models.CacheVersionMentors, replica); err != nil {
+		logger.Warn("Failed to report mentor cache version", zap.Error(err))
+	}
+}
+
+// Bump advances the shared version after this replica already applied a change, so the other
+// replicas reload. This one adopts the new version without reloading again unless another
+// change bumped the version in between.
+func (s *CacheVersionService) Bump(ctx context.Context) (int64, error) {
+	version, err := s.repo.Bump(ctx, models.CacheVersionMentors)
+	if err != nil {
+		return 0, err
+	}
+	if version == s.Version()+1 {
+		s.setVersion(version)
+	}
+	return version, nil
+}
+
+// bump advances the shared version for a change of the mentor made here, without holding up the
+// publisher. The mentor is updated in this replica's cache first, so that it can adopt the new
+// version like Bump does; when that fails, its next poll reloads the whole cache instead.
+func (s *CacheVersionService) bump(slug string) {
+	go func() {
+		applied := false
+		if slug != "" {
+			if err := s.cache.UpdateSingleMentor(slug); err != nil {
+				logger.Warn("Failed to update changed mentor in cache, reloading on the next poll",
+					zap.String("slug", slug),
+					zap.Error(err))
+			} else {
+				applied = true
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), cacheVersionTimeout)
+		defer cancel()
+		var err error
+		if applied {
+			_, err = s.Bump(ctx)
+		} else {
+			_, err = s.repo.Bump(ctx, models.CacheVersionMentors)
+		}
+		if err != nil {
+			logger.Error("Failed to bump the shared mentor cache version", zap.Error(err))
+		}
+	}()
+}
+
+// cacheVersionPublisher passes events on and bumps the shared version on mentor.updated events
+type cacheVersionPublisher struct {
+	next    eventbus.Publisher
+	service *CacheVersionService
+}
+
+func (p *cacheVersionPublisher) Publish(ctx context.Context, event eventbus.Event) {
+	p.next.Publish(ctx, event)
+	if event.Type != events.TypeMentorUpdated {
+		return
+	}
+
+	// An event that doesn't decode still bumps the version, and this replica reloads like the others
+	var payload events.MentorUpdated
+	if raw, ok := event.Data.(json.RawMessage); ok {
+		_ = json.Unmarshal(raw, &payload) //nolint:errcheck // Handled by the empty slug
+	}
+	p.service.bump(payload.Slug)
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// calendarCheckWorkers bounds the calendar links requested at once
+const calendarCheckWorkers = 8
+
+// CalendarLinkCheckService periodically checks that mentors' calendar links respond. A link that
+// fails the configured number of checks in a row is marked broken: the mentor is notified through
+// the calendar-broken trigger and mentees who contact them get the mentor's Telegram instead.
+// The mark is lifted by the first successful check or when the mentor changes the link.
+type CalendarLinkCheckService struct {
+	repo       *repository.CalendarLinkRepository
+	mentorRepo *repository.MentorRepository
+	config     *config.Config
+	httpClient httpclient.Client
+	// linkClient refuses links resolving to internal addresses: the URLs come from mentors
+	linkClient httpclient.Client
+}
+
+// NewCalendarLinkCheckService creates a new calendar link check service
+func NewCalendarLinkCheckService(
+	repo *repository.CalendarLinkRepository,
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *CalendarLinkCheckService {
+
+	timeout := time.Duration(cfg.CalendarCheck.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = calendarCheckTimeout
+	}
+
+	return &CalendarLinkCheckService{
+		repo:       repo,
+		mentorRepo: mentorRepo,
+		config:     cfg,
+		httpClient: httpClient,
+		linkClient: ical.NewGuardedClient(timeout),
+	}
+}
+
+// Start checks the links in the background now and then every configured interval
+func (s *CalendarLinkCheckService) Start() {
+	go func() {
+		s.checkAndLog()
+
+		ticker := time.NewTicker(time.Duration(s.config.CalendarCheck.IntervalMinutes) * time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.checkAndLog()
+		}
+	}()
+}
+
+func (s *CalendarLinkCheckService) checkAndLog() {
+	checked, err := s.CheckAll(context.Background())
+	if err != nil {
+		metrics.CalendarLinkChecks.WithLabelValues("error").Inc()
+		logger.Error("Calendar link check failed", zap.Error(err))
+		return
+	}
+	logger.Info("Calendar link check completed", zap.Int("checked", checked))
+}
+
+// CheckAll checks the calendar link of every active mentor and returns how many were checked
+func (s *CalendarLinkCheckService) CheckAll(ctx context.Context) (int, error) {
+	links, err := s.repo.ListLinks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queue := make(chan *models.CalendarLink)
+	var wg sync.WaitGroup
+	for i := 0; i < calendarCheckWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for link := range queue {
+				s.check(ctx, link)
+			}
+		}()
+	}
+	for _, link := range links {
+		queue <- link
+	}
+	close(queue)
+	wg.Wait()
+	return len(links), nil
+}
+
+// check requests one link and records the outcome
+func (s *CalendarLinkCheckService) check(ctx context.Context, link *models.CalendarLink) {
+	timeout := time.Duration(s.config.CalendarCheck.TimeoutSeconds) * time.Second
+	problem := probeCalendarURL(ctx, s.linkClient, link.URL, timeout)
+
+	result, err := s.repo.RecordCheck(ctx, link, problem == "", s.config.CalendarCheck.FailureThreshold)
+	if err != nil {
+		metrics.CalendarLinkChecks.WithLabelValues("error").Inc()
+		logger.Error("Failed to record calendar link check", zap.String("mentor_id", link.MentorID), zap.Error(err))
+		return
+	}
+	if result == nil {
+		// The mentor changed the link while it was being checked
+		return
+	}
+
+	switch {
+	case result.BecameBroken:
+		metrics.CalendarLinkChecks.WithLabelValues("broken").Inc()
+		logger.Warn("Calendar link marked broken",
+			zap.String("mentor_id", link.MentorID),
+			zap.String("calendar_url", link.URL),
+			zap.Int("failures", result.Failures),
+			zap.String("problem", problem))
+		s.refreshMentor(link)
+		if triggerURL := s.config.EventTriggers.MentorCalendarBrokenTriggerURL; triggerURL != "" {
+			trigger.CallAsyncWithPayload(triggerURL, map[string]interface{}{
+				"type":         "calendar_broken",
+				"mentor_id":    link.MentorID,
+				"calendar_url": link.URL,
+				"failures":     result.Failures,
+				"problem":      problem,
+				"profile_url":  s.config.Server.BaseURL + "/mentor/profile",
+			}, s.httpClient)
+		}
+	case result.Recovered:
+		metrics.CalendarLinkChecks.WithLabelValues("recovered").Inc()
+		logger.Info("Calendar link recovered", zap.String("mentor_id", link.MentorID))
+		s.refreshMentor(link)
+	case problem != "":
+		metrics.CalendarLinkChecks.WithLabelValues("failed").Inc()
+	default:
+		metrics.CalendarLinkChecks.WithLabelValues("ok").Inc()
+	}
+}
+
+// refreshMentor reloads the mentor into the cache so the calendar type follows the new state
+func (s *CalendarLinkCheckService) refreshMentor(link *models.CalendarLink) {
+	if err := s.mentorRepo.UpdateSingleMentorCache(link.Slug); err != nil {
+		logger.Error("Failed to refresh mentor cache after calendar check", zap.String("slug", link.Slug), zap.Error(err))
+	}
+}
+
+// probeCalendarURL requests a calendar link and describes why it is broken, or returns "" when it
+// works. It sends HEAD and falls back to GET for servers that don't support HEAD. Links answering
+// 401, 403 or 429 count as working: the page exists but won't show itself to a bot.
+func probeCalendarURL(ctx context.Context, client httpclient.Client, link string, timeout time.Duration) string {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status, err := requestCalendarURL(ctx, client, http.MethodHead, link)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, err = requestCalendarURL(ctx, client, http.MethodGet, link)
+	}
+	if err != nil {
+		return fmt.Sprintf("request failed: %v", err)
+	}
+
+	switch {
+	case status == http.StatusUnauthorized, status == http.StatusForbidden, status == http.StatusTooManyRequests:
+		return ""
+	case status >= 400:
+		return fmt.Sprintf("HTTP %d", status)
+	}
+	return ""
+}
+
+func requestCalendarURL(ctx context.Context, client httpclient.Client, method, link string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, ical.FetchURL(link), http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck // drain for connection reuse
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/cdn"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const (
+	// cdnPurgeQueueSize bounds the mentors waiting for a purge; changes beyond it are dropped
+	cdnPurgeQueueSize = 256
+	// cdnPurgeTimeout bounds a single purge request
+	cdnPurgeTimeout = 15 * time.Second
+)
+
+// CDNPurgeService keeps the CDN in front of the API in step with mentor changes. After a mentor
+// changes it refreshes the mentor in the in-process cache, then purges the cached list routes
+// and the mentor's detail routes from the CDN, retrying failed purges with backoff. Work is
+// queued and done by a single background worker, so callers never wait for the CDN.
+type CDNPurgeService struct {
+	purger       cdn.Purger
+	provider     string
+	mentorRepo   *repository.MentorRepository
+	baseURL      string
+	listRoutes   []string
+	detailRoutes []string
+	retry        trigger.RetryPolicy
+	queue        chan string
+}
+
+// NewCDNPurgeService creates a purge service for the configured CDN. The purged routes are the
+// ones the response caching policy lets the CDN cache.
+func NewCDNPurgeService(
+	purger cdn.Purger,
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+) *CDNPurgeService {
+
+	return &CDNPurgeService{
+		purger:       purger,
+		provider:     cfg.CDN.Provider,
+		mentorRepo:   mentorRepo,
+		baseURL:      cfg.CDN.PurgeBaseURL,
+		listRoutes:   cfg.HTTPCache.ListRoutes,
+		detailRoutes: cfg.HTTPCache.DetailRoutes,
+		retry: trigger.RetryPolicy{
+			MaxAttempts: cfg.CDN.PurgeMaxAttempts,
+			BaseDelay:   time.Second,
+			MaxDelay:    30 * time.Second,
+		},
+		queue: make(chan string, cdnPurgeQueueSize),
+	}
+}
+
+// Start runs the purge worker in the background
+func (s *CDNPurgeService) Start() {
+	go func() {
+		for slug := range s.queue {
+			s.purgeMentor(context.Background(), slug)
+		}
+	}()
+}
+
+// PurgeMentor queues a purge of the mentor with the given slug without waiting for it
+func (s *CDNPurgeService) PurgeMentor(slug string) {
+	select {
+	case s.queue <- slug:
+	default:
+		metrics.CDNPurges.WithLabelValues(s.provider, "dropped").Inc()
+		logger.Warn("CDN purge queue is full, dropping purge", zap.String("slug", slug))
+	}
+}
+
+// Publisher wraps next so that every mentor.updated event published through it also purges
+// the mentor, whichever service made the change
+func (s *CDNPurgeService) Publisher(next eventbus.Publisher) eventbus.Publisher {
+	return &cdnPurgePublisher{next: next, service: s}
+}
+
+func (s *CDNPurgeService) purgeMentor(ctx context.Context, slug string) {
+	if err := s.mentorRepo.UpdateSingleMentorCache(slug); err != nil {
+		logger.Warn("Failed to refresh mentor cache before CDN purge", zap.String("slug", slug), zap.Error(err))
+	}
+
+	params := map[string]string{"slug": slug}
+	mentor, err := s.mentorRepo.GetBySlug(ctx, slug, models.FilterOptions{ShowHidden: true})
+	if err == nil {
+		params["id"] = strconv.Itoa(mentor.LegacyID)
+	}
+	urls := cdn.RouteURLs(s.baseURL, s.listRoutes, nil)
+	urls = append(urls, cdn.RouteURLs(s.baseURL, s.detailRoutes, params)...)
+	if len(urls) == 0 {
+		return
+	}
+
+	for attempt := 1; ; attempt++ {
+		purgeCtx, cancel := context.WithTimeout(ctx, cdnPurgeTimeout)
+		err := s.purger.Purge(purgeCtx, urls)
+		cancel()
+		if err == nil {
+			metrics.CDNPurges.WithLabelValues(s.provider, "success").Inc()
+			logger.Debug("Purged mentor from CDN", zap.String("slug", slug), zap.Int("urls", len(urls)))
+			return
+		}
+
+		if attempt >= s.retry.MaxAttempts || !isRetryablePurgeError(err) {
+			metrics.CDNPurges.WithLabelValues(s.provider, "failure").Inc()
+			logger.Error("CDN purge failed",
+				zap.String("slug", slug),
+				zap.Int("attempts", attempt),
+				zap.Error(err))
+			return
+		}
+		metrics.CDNPurges.WithLabelValues(s.provider, "retry").Inc()
+		time.Sleep(s.retry.Backoff(attempt))
+	}
+}
+
+// isRetryablePurgeError retries network errors, rate limiting and CDN server errors. Failures the
+// CDN reports in a successful response are not retried.
+func isRetryablePurgeError(err error) bool {
+	var statusErr *cdn.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode != 0 && trigger.IsRetryable(statusErr.StatusCode)
+	}
+	return true
+}
+
+// cdnPurgePublisher passes events on and purges the mentors of mentor.updated events
+type cdnPurgePublisher struct {
+	next    eventbus.Publisher
+	service *CDNPurgeService
+}
+
+func (p *cdnPurgePublisher) Publish(ctx context.Context, event eventbus.Event) {
+	p.next.Publish(ctx, event)
+	if event.Type != events.TypeMentorUpdated {
+		return
+	}
+
+	raw, ok := event.Data.(json.RawMessage)
+	if !ok {
+		return
+	}
+	var payload events.MentorUpdated
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.Slug == "" {
+		logger.Warn("Skipping CDN purge of undecodable mentor.updated event", zap.String("event_id", event.ID))
+		return
+	}
+	p.service.PurgeMentor(payload.Slug)
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/certificate"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxCertificatesPerIssue bounds a bulk issue so the request finishes in time;
+	// rendering and uploading a certificate takes a few hundred milliseconds
+	maxCertificatesPerIssue = 25
+	// certificateCacheControl lets the CDN keep PDFs; a certificate is never re-rendered
+	certificateCacheControl = "public, max-age=31536000, immutable"
+)
+
+// ErrCertificatesDisabled is returned when issuing without a signing secret or object storage
+var ErrCertificatesDisabled = errors.New("certificates require CERTIFICATE_SIGNING_SECRET and object storage")
+
+// CohortCertificateService builds cohort completion reports and issues signed
+// certificate PDFs to mentees who reached every checkpoint
+type CohortCertificateService struct {
+	cohortRepo *repository.CohortRepository
+	repo       *repository.CohortCertificateRepository
+	storage    *yandex.StorageClient // nil disables issuing
+	config     *config.Config
+	httpClient httpclient.Client
+}
+
+// NewCohortCertificateService creates a new cohort certificate service
+func NewCohortCertificateService(
+	cohortRepo *repository.CohortRepository,
+	repo *repository.CohortCertificateRepository,
+	storage *yandex.StorageClient,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *CohortCertificateService {
+
+	return &CohortCertificateService{
+		cohortRepo: cohortRepo,
+		repo:       repo,
+		storage:    storage,
+		config:     cfg,
+		httpClient: httpClient,
+	}
+}
+
+// VerifyCertificate returns the public view of a certificate and whether its signature holds
+func (s *CohortCertificateService) VerifyCertificate(ctx context.Context, certificateID string) (*models.CertificateVerification, error) {
+	cert, err := s.repo.GetByID(ctx, certificateID)
+	if err != nil {
+		return nil, err
+	}
+	secret := s.config.Certificates.SigningSecret
+	return &models.CertificateVerification{
+		Valid:                secret != "" && certificate.Verify([]byte(secret), certificateStatements(cert), cert.Signature),
+		CertificateID:        cert.ID,
+		RecipientName:        cert.RecipientName,
+		CohortTitle:          cert.CohortTitle,
+		CompletedCheckpoints: cert.CompletedCheckpoints,
+		IssuedAt:             cert.IssuedAt,
+		PDFURL:               cert.PDFURL,
+	}, nil
+}
+
+// GetReport returns the checkpoint completion of every mentee with their certificates. Admin only.
+func (s *CohortCertificateService) GetReport(ctx context.Context, session *models.AdminSession, cohortID string) (*models.CohortReport, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	cohort, participants, certs, err := s.load(ctx, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	return buildCohortReport(cohort, participants, certs), nil
+}
+
+// IssueCertificates issues certificates to eligible mentees, up to maxCertificatesPerIssue
+// per call. Mentees that already have one are skipped, so the call can be repeated. Admin only.
+func (s *CohortCertificateService) IssueCertificates(
+	ctx context.Context,
+	session *models.AdminSession,
+	cohortID string,
+	req *models.IssueCohortCertificatesRequest,
+) (*models.IssueCohortCertificatesResponse, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if s.config.Certificates.SigningSecret == "" || s.storage == nil {
+		return nil, ErrCertificatesDisabled
+	}
+
+	cohort, participants, certs, err := s.load(ctx, cohortID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &models.IssueCohortCertificatesResponse{
+		Issued:  []*models.CohortCertificate{},
+		Skipped: []models.CohortCertificateSkip{},
+	}
+	skip := func(participantID, reason string) {
+		metrics.CohortCertificates.WithLabelValues(reason).Inc()
+		resp.Skipped = append(resp.Skipped, models.CohortCertificateSkip{ParticipantID: participantID, Reason: reason})
+	}
+
+	byID := make(map[string]*models.CohortParticipant, len(participants))
+	for _, participant := range participants {
+		byID[participant.ID] = participant
+	}
+	var candidates []*models.CohortParticipant
+	if len(req.ParticipantIDs) == 0 {
+		for _, participant := range participants {
+			if participant.EligibleForCertificate(cohort.Checkpoints) && certs[participant.ID] == nil {
+				candidates = append(candidates, participant)
+			}
+		}
+	} else {
+		for _, participantID := range req.ParticipantIDs {
+			participant, ok := byID[participantID]
+			switch {
+			case !ok:
+				skip(participantID, "not_found")
+			case certs[participantID] != nil:
+				skip(participantID, "already_issued")
+			case !participant.EligibleForCertificate(cohort.Checkpoints):
+				skip(participantID, "not_eligible")
+			default:
+				candidates = append(candidates, participant)
+			}
+		}
+	}
+
+	if len(candidates) > maxCertificatesPerIssue {
+		resp.Remaining = len(candidates) - maxCertificatesPerIssue
+		candidates = candidates[:maxCertificatesPerIssue]
+	}
+	for _, participant := range candidates {
+		cert, err := s.issue(ctx, cohort, participant, session.Email)
+		switch {
+		case errors.Is(err, repository.ErrCertificateExists):
+			skip(participant.ID, "already_issued")
+		case err != nil:
+			logger.Error("Failed to issue cohort certificate",
+				zap.Error(err),
+				zap.String("cohort_id", cohortID),
+				zap.String("participant_id", participant.ID))
+			skip(participant.ID, "failed")
+		default:
+			metrics.CohortCertificates.WithLabelValues("issued").Inc()
+			resp.Issued = append(resp.Issued, cert)
+		}
+	}
+
+	logger.Info("Cohort certificates issued",
+		zap.String("cohort_id", cohortID),
+		zap.Int("issued", len(resp.Issued)),
+		zap.Int("skipped", len(resp.Skipped)),
+		zap.Int("remaining", resp.Remaining),
+		zap.String("moderator_id", session.ModeratorID))
+	return resp, nil
+}
+
+// issue renders, uploads and stores the certificate of one mentee and notifies them
+func (s *CohortCertificateService) issue(
+	ctx context.Context,
+	cohort *models.Cohort,
+	participant *models.CohortParticipant,
+	issuedBy string,
+) (*models.CohortCertificate, error) {
+
+	id, err := certificate.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate ID: %w", err)
+	}
+	cert := &models.CohortCertificate{
+		ID:                   id,
+		CohortID:             cohort.ID,
+		ParticipantID:        participant.ID,
+		RecipientName:        participant.Name,
+		CohortTitle:          cohort.Title,
+		CompletedCheckpoints: len(participant.CompletedCheckpoints),
+		IssuedBy:             issuedBy,
+		IssuedAt:             time.Now().UTC().Truncate(time.Second),
+	}
+
+	statements := certificateStatements(cert)
+	statements.VerifyURL = s.verifyURL(cert.ID)
+	cert.Signature = certificate.Sign([]byte(s.config.Certificates.SigningSecret), statements)
+
+	pdf, err := certificate.RenderPDF(statements, cert.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render certificate: %w", err)
+	}
+	if cert.PDFURL, err = s.storage.UploadObject(ctx, "certificates/"+cert.ID+".pdf", "application/pdf", certificateCacheControl, pdf); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, cert); err != nil {
+		return nil, err
+	}
+
+	if s.config.EventTriggers.CohortCertificateTriggerURL != "" {
+		payload := map[string]interface{}{
+			"type":           "cohort_certificate",
+			"certificate_id": cert.ID,
+			"cohort_id":      cohort.ID,
+			"cohort_title":   cohort.Title,
+			"participant_id": participant.ID,
+			"name":           participant.Name,
+			"email":          participant.Email,
+			"telegram":       participant.Telegram,
+			"pdf_url":        cert.PDFURL,
+			"verify_url":     statements.VerifyURL,
+		}
+		trigger.CallAsyncWithPayload(s.config.EventTriggers.CohortCertificateTriggerURL, payload, s.httpClient)
+	}
+	return cert, nil
+}
+
+func (s *CohortCertificateService) load(
+	ctx context.Context,
+	cohortID string,
+) (*models.Cohort, []*models.CohortParticipant, map[string]*models.CohortCertificate, error) {
+
+	cohort, err := s.cohortRepo.GetByID(ctx, cohortID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	participants, err := s.cohortRepo.ListParticipants(ctx, cohortID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	certs, err := s.repo.ListByCohort(ctx, cohortID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return cohort, participants, certs, nil
+}
+
+func (s *CohortCertificateService) verifyURL(certificateID string) string {
+	return s.config.Server.BaseURL + "/api/v1/certificates/" + certificateID
+}
+
+// certificateStatements is the signed part of a stored certificate
+func certificateStatements(cert *models.CohortCertificate) certificate.Certificate {
+	return certificate.Certificate{
+		ID:                   cert.ID,
+		RecipientName:        cert.RecipientName,
+		CohortTitle:          cert.CohortTitle,
+		CompletedCheckpoints: cert.CompletedCheckpoints,
+		IssuedAt:             cert.IssuedAt,
+	}
+}
+
+// buildCohortReport lists the cohort's mentees with their checkpoint completion and certificates
+func buildCohortReport(
+	cohort *models.Cohort,
+	participants []*models.CohortParticipant,
+	certs map[string]*models.CohortCertificate,
+) *models.CohortReport {
+
+	names := map[string]string{}
+	for _, participant := range participants {
+		if participant.Role == models.CohortRoleMentor {
+			names[participant.ID] = participant.Name
+		}
+	}
+
+	report := &models.CohortReport{Cohort: cohort, Mentees: []models.CohortReportRow{}}
+	for _, participant := range participants {
+		if participant.Role != models.CohortRoleMentee {
+			continue
+		}
+		row := models.CohortReportRow{
+			ParticipantID:        participant.ID,
+			Name:                 participant.Name,
+			Email:                participant.Email,
+			Status:               participant.Status,
+			CompletedCheckpoints: len(participant.CompletedCheckpoints),
+			Eligible:             participant.EligibleForCertificate(cohort.Checkpoints),
+			Certificate:          certs[participant.ID],
+		}
+		if participant.PairedWith != nil {
+			row.MentorName = names[*participant.PairedWith]
+		}
+		if len(cohort.Checkpoints) > 0 {
+			row.Rate = float64(row.CompletedCheckpoints) / float64(len(cohort.Checkpoints))
+		}
+		if row.Eligible {
+			report.Eligible++
+		}
+		if row.Certificate != nil {
+			report.CertificatesIssued++
+		}
+		report.Mentees = append(report.Mentees, row)
+	}
+	return report
+}
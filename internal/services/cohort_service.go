@@ -0,0 +1,520 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/recaptcha"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrCohortClosed is returned when enrolling in a cohort that isn't enrolling
+	ErrCohortClosed = errors.New("cohort is not open for enrollment")
+	// ErrCohortFull is returned when every mentee seat of the cohort is taken
+	ErrCohortFull = errors.New("cohort has no mentee seats left")
+	// ErrNotCohortMentor is returned when a mentor acts on a cohort or mentee that isn't theirs
+	ErrNotCohortMentor = errors.New("not a mentor of this mentee in the cohort")
+)
+
+// CohortService manages cohorts: enrollment of mentors and mentees, pairing and
+// checkpoint progress
+type CohortService struct {
+	repo              *repository.CohortRepository
+	mentorRepo        *repository.MentorRepository
+	uow               *repository.UnitOfWork
+	config            *config.Config
+	httpClient        httpclient.Client
+	recaptchaVerifier *recaptcha.Verifier
+}
+
+// NewCohortService creates a new cohort service
+func NewCohortService(
+	repo *repository.CohortRepository,
+	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *CohortService {
+
+	return &CohortService{
+		repo:              repo,
+		mentorRepo:        mentorRepo,
+		uow:               uow,
+		config:            cfg,
+		httpClient:        httpClient,
+		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+	}
+}
+
+// ListOpenCohorts returns cohorts open for enrollment
+func (s *CohortService) ListOpenCohorts(ctx context.Context) ([]*models.Cohort, error) {
+	cohorts, err := s.repo.List(ctx, []string{models.CohortStatusEnrolling})
+	if err != nil {
+		return nil, err
+	}
+	for _, cohort := range cohorts {
+		cohort.CreatedBy = "" // moderator emails are not public
+	}
+	return cohorts, nil
+}
+
+// EnrollMentee enrolls a mentee from the public form while the cohort has seats left
+func (s *CohortService) EnrollMentee(ctx context.Context, cohortID string, req *models.CohortEnrollRequest) (*models.CohortEnrollResponse, error) {
+	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
+		metrics.CohortEnrollments.WithLabelValues(models.CohortRoleMentee, "captcha_failed").Inc()
+		logger.Warn("ReCAPTCHA verification failed", zap.Error(err))
+		return &models.CohortEnrollResponse{
+			Success: false,
+			Error:   "Captcha verification failed",
+		}, fmt.Errorf("captcha verification failed: %w", err)
+	}
+
+	participant := &models.CohortParticipant{
+		CohortID: cohortID,
+		Role:     models.CohortRoleMentee,
+		Name:     strings.TrimSpace(req.Name),
+		Email:    req.Email,
+		Telegram: normalizeTelegramHandle(req.TelegramUsername),
+		Goal:     strings.TrimSpace(req.Goal),
+	}
+	participantID, err := s.enroll(ctx, participant, true)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CohortEnrollResponse{
+		Success:       true,
+		ParticipantID: participantID,
+	}, nil
+}
+
+// ListMentorCohorts returns the cohorts the mentor takes part in, with their mentees
+func (s *CohortService) ListMentorCohorts(ctx context.Context, mentorID string) ([]*models.MentorCohort, error) {
+	participations, err := s.repo.ListMentorParticipations(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.MentorCohort, 0, len(participations))
+	for _, participation := range participations {
+		if participation.Status == models.CohortParticipantWithdrawn {
+			continue
+		}
+		cohort, err := s.repo.GetByID(ctx, participation.CohortID)
+		if err != nil {
+			return nil, err
+		}
+		cohort.CreatedBy = ""
+
+		participants, err := s.repo.ListParticipants(ctx, participation.CohortID)
+		if err != nil {
+			return nil, err
+		}
+		mentees := []*models.CohortParticipant{}
+		for _, participant := range participants {
+			if participant.PairedWith != nil && *participant.PairedWith == participation.ID {
+				mentees = append(mentees, participant)
+			}
+		}
+
+		result = append(result, &models.MentorCohort{
+			Cohort:        cohort,
+			ParticipantID: participation.ID,
+			Mentees:       mentees,
+		})
+	}
+	return result, nil
+}
+
+// EnrollMentor enrolls the logged-in mentor in a cohort open for enrollment
+func (s *CohortService) EnrollMentor(ctx context.Context, session *models.MentorSession, cohortID string) (*models.CohortParticipant, error) {
+	mentorID := session.MentorID
+	participant := &models.CohortParticipant{
+		CohortID: cohortID,
+		Role:     models.CohortRoleMentor,
+		MentorID: &mentorID,
+		Name:     session.Name,
+		Email:    session.Email,
+	}
+	participantID, err := s.enroll(ctx, participant, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetParticipant(ctx, cohortID, participantID)
+}
+
+// RecordMenteeProgress lets a mentor mark checkpoints of their own mentee
+func (s *CohortService) RecordMenteeProgress(
+	ctx context.Context,
+	mentorID, cohortID, menteeID string,
+	req *models.CohortProgressRequest,
+) (*models.CohortParticipant, error) {
+
+	mentee, err := s.repo.GetParticipant(ctx, cohortID, menteeID)
+	if err != nil {
+		return nil, err
+	}
+	if mentee.PairedWith == nil {
+		return nil, ErrNotCohortMentor
+	}
+	mentor, err := s.repo.GetParticipant(ctx, cohortID, *mentee.PairedWith)
+	if err != nil || mentor.MentorID == nil || *mentor.MentorID != mentorID {
+		return nil, ErrNotCohortMentor
+	}
+	return s.recordProgress(ctx, mentee, req, "mentor")
+}
+
+// ListCohorts returns all cohorts. Admin only.
+func (s *CohortService) ListCohorts(ctx context.Context, session *models.AdminSession) ([]*models.Cohort, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	return s.repo.List(ctx, nil)
+}
+
+// GetDashboard returns a cohort with its participants, pairing and checkpoint completion. Admin only.
+func (s *CohortService) GetDashboard(ctx context.Context, session *models.AdminSession, cohortID string) (*models.CohortDashboard, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	cohort, err := s.repo.GetByID(ctx, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := s.repo.ListParticipants(ctx, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	return buildCohortDashboard(cohort, participants), nil
+}
+
+// CreateCohort creates a cohort with its checkpoints, a draft unless the request says otherwise. Admin only.
+func (s *CohortService) CreateCohort(ctx context.Context, session *models.AdminSession, req *models.CohortRequest) (*models.Cohort, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if err := normalizeCohortRequest(req); err != nil {
+		return nil, err
+	}
+	for i := range req.Checkpoints {
+		req.Checkpoints[i].ID = "" // all checkpoints of a new cohort are new
+	}
+
+	var cohortID string
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		if cohortID, err = s.repo.Create(txCtx, req, session.Email); err != nil {
+			return err
+		}
+		return s.repo.ReplaceCheckpoints(txCtx, cohortID, req.Checkpoints)
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Cohort created", zap.String("cohort_id", cohortID), zap.String("moderator_id", session.ModeratorID))
+	return s.repo.GetByID(ctx, cohortID)
+}
+
+// UpdateCohort replaces a cohort and its checkpoints. Admin only.
+func (s *CohortService) UpdateCohort(ctx context.Context, session *models.AdminSession, cohortID string, req *models.CohortRequest) (*models.Cohort, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if err := normalizeCohortRequest(req); err != nil {
+		return nil, err
+	}
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		if err := s.repo.Update(txCtx, cohortID, req); err != nil {
+			return err
+		}
+		return s.repo.ReplaceCheckpoints(txCtx, cohortID, req.Checkpoints)
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Cohort updated", zap.String("cohort_id", cohortID), zap.String("moderator_id", session.ModeratorID))
+	return s.repo.GetByID(ctx, cohortID)
+}
+
+// AddParticipant enrolls a mentor or mentee regardless of the cohort status and capacity. Admin only.
+func (s *CohortService) AddParticipant(
+	ctx context.Context,
+	session *models.AdminSession,
+	cohortID string,
+	req *models.AddCohortParticipantRequest,
+) (*models.CohortParticipant, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	participant := &models.CohortParticipant{
+		CohortID: cohortID,
+		Role:     req.Role,
+		Name:     strings.TrimSpace(req.Name),
+		Email:    req.Email,
+		Telegram: normalizeTelegramHandle(req.Telegram),
+		Goal:     strings.TrimSpace(req.Goal),
+	}
+	if req.Role == models.CohortRoleMentor {
+		mentor, err := s.mentorRepo.GetForModerationByID(ctx, req.MentorID)
+		if err != nil {
+			return nil, apperrors.InvalidInputError("mentorId", "mentor not found")
+		}
+		mentorID := mentor.MentorID
+		participant.MentorID = &mentorID
+		participant.Name = mentor.Name
+		participant.Email = mentor.Email
+		participant.Telegram = mentor.Telegram
+	}
+
+	participantID, err := s.enroll(ctx, participant, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetParticipant(ctx, cohortID, participantID)
+}
+
+// UpdateParticipant changes a participant's status. Withdrawing a mentor unpairs their mentees. Admin only.
+func (s *CohortService) UpdateParticipant(
+	ctx context.Context,
+	session *models.AdminSession,
+	cohortID, participantID string,
+	req *models.UpdateCohortParticipantRequest,
+) (*models.CohortParticipant, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		participant, err := s.repo.GetParticipant(txCtx, cohortID, participantID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.SetParticipantStatus(txCtx, cohortID, participantID, req.Status); err != nil {
+			return err
+		}
+		if req.Status == models.CohortParticipantWithdrawn {
+			if participant.Role == models.CohortRoleMentor {
+				return s.repo.UnpairMentees(txCtx, participantID)
+			}
+			return s.repo.SetPair(txCtx, cohortID, participantID, nil)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetParticipant(ctx, cohortID, participantID)
+}
+
+// PairParticipants assigns a mentee to a mentor of the same cohort, or unpairs the mentee. Admin only.
+func (s *CohortService) PairParticipants(
+	ctx context.Context,
+	session *models.AdminSession,
+	cohortID string,
+	req *models.CohortPairRequest,
+) (*models.CohortParticipant, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	mentee, err := s.repo.GetParticipant(ctx, cohortID, req.MenteeParticipantID)
+	if err != nil {
+		return nil, err
+	}
+	if mentee.Role != models.CohortRoleMentee || mentee.Status == models.CohortParticipantWithdrawn {
+		return nil, apperrors.InvalidInputError("menteeParticipantId", "must be an active mentee of the cohort")
+	}
+
+	var mentorParticipantID *string
+	if req.MentorParticipantID != "" {
+		mentor, err := s.repo.GetParticipant(ctx, cohortID, req.MentorParticipantID)
+		if err != nil {
+			return nil, err
+		}
+		if mentor.Role != models.CohortRoleMentor || mentor.Status == models.CohortParticipantWithdrawn {
+			return nil, apperrors.InvalidInputError("mentorParticipantId", "must be an active mentor of the cohort")
+		}
+		mentorParticipantID = &mentor.ID
+	}
+
+	if err := s.repo.SetPair(ctx, cohortID, mentee.ID, mentorParticipantID); err != nil {
+		return nil, err
+	}
+	logger.Info("Cohort pairing changed",
+		zap.String("cohort_id", cohortID),
+		zap.String("mentee_participant_id", mentee.ID),
+		zap.String("mentor_participant_id", req.MentorParticipantID),
+		zap.String("moderator_id", session.ModeratorID))
+	return s.repo.GetParticipant(ctx, cohortID, mentee.ID)
+}
+
+// RecordProgress marks checkpoints of any mentee of the cohort. Admin only.
+func (s *CohortService) RecordProgress(
+	ctx context.Context,
+	session *models.AdminSession,
+	cohortID, menteeID string,
+	req *models.CohortProgressRequest,
+) (*models.CohortParticipant, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	mentee, err := s.repo.GetParticipant(ctx, cohortID, menteeID)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordProgress(ctx, mentee, req, session.Email)
+}
+
+// enroll adds a participant. With checkOpen, the cohort must be enrolling and, for
+// mentees, have seats left; the cohort row is locked so seats can't be oversold.
+func (s *CohortService) enroll(ctx context.Context, participant *models.CohortParticipant, checkOpen bool) (string, error) {
+	var participantID string
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		cohort, err := s.repo.GetForEnrollment(txCtx, participant.CohortID)
+		if err != nil {
+			return err
+		}
+		if checkOpen && !cohort.IsOpenForEnrollment() {
+			return ErrCohortClosed
+		}
+		if checkOpen && participant.Role == models.CohortRoleMentee && cohort.MenteeSeatsLeft() == 0 {
+			return ErrCohortFull
+		}
+		participantID, err = s.repo.AddParticipant(txCtx, participant)
+		return err
+	})
+
+	switch {
+	case errors.Is(err, repository.ErrCohortNotFound):
+		metrics.CohortEnrollments.WithLabelValues(participant.Role, "not_found").Inc()
+		return "", err
+	case errors.Is(err, ErrCohortClosed):
+		metrics.CohortEnrollments.WithLabelValues(participant.Role, "closed").Inc()
+		return "", err
+	case errors.Is(err, ErrCohortFull):
+		metrics.CohortEnrollments.WithLabelValues(participant.Role, "full").Inc()
+		return "", err
+	case errors.Is(err, repository.ErrAlreadyEnrolled):
+		metrics.CohortEnrollments.WithLabelValues(participant.Role, "duplicate").Inc()
+		return "", err
+	case err != nil:
+		metrics.CohortEnrollments.WithLabelValues(participant.Role, "error").Inc()
+		logger.Error("Failed to enroll cohort participant", zap.Error(err), zap.String("cohort_id", participant.CohortID))
+		return "", err
+	}
+
+	metrics.CohortEnrollments.WithLabelValues(participant.Role, "enrolled").Inc()
+	if s.config.EventTriggers.CohortEnrollmentTriggerURL != "" {
+		payload := map[string]interface{}{
+			"type":           "cohort_enrollment",
+			"cohort_id":      participant.CohortID,
+			"participant_id": participantID,
+			"role":           participant.Role,
+			"name":           participant.Name,
+			"email":          participant.Email,
+			"telegram":       participant.Telegram,
+		}
+		trigger.CallAsyncWithPayload(s.config.EventTriggers.CohortEnrollmentTriggerURL, payload, s.httpClient)
+	}
+	return participantID, nil
+}
+
+func (s *CohortService) recordProgress(
+	ctx context.Context,
+	mentee *models.CohortParticipant,
+	req *models.CohortProgressRequest,
+	recordedBy string,
+) (*models.CohortParticipant, error) {
+
+	if mentee.Role != models.CohortRoleMentee {
+		return nil, apperrors.InvalidInputError("participantId", "progress is tracked for mentees only")
+	}
+
+	var err error
+	if req.Completed {
+		err = s.repo.SetProgress(ctx, mentee.ID, req.CheckpointID, strings.TrimSpace(req.Note), recordedBy)
+	} else {
+		err = s.repo.DeleteProgress(ctx, mentee.ID, req.CheckpointID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetParticipant(ctx, mentee.CohortID, mentee.ID)
+}
+
+// buildCohortDashboard counts unpaired mentees and checkpoint completion among active mentees
+func buildCohortDashboard(cohort *models.Cohort, participants []*models.CohortParticipant) *models.CohortDashboard {
+	dashboard := &models.CohortDashboard{
+		Cohort:       cohort,
+		Participants: participants,
+		Checkpoints:  make([]models.CohortCheckpointStats, 0, len(cohort.Checkpoints)),
+	}
+
+	completed := map[string]int{}
+	activeMentees := 0
+	for _, participant := range participants {
+		if participant.Role != models.CohortRoleMentee || participant.Status == models.CohortParticipantWithdrawn {
+			continue
+		}
+		activeMentees++
+		if participant.PairedWith == nil {
+			dashboard.UnpairedMentees++
+		}
+		for _, checkpointID := range participant.CompletedCheckpoints {
+			completed[checkpointID]++
+		}
+	}
+
+	for _, checkpoint := range cohort.Checkpoints {
+		stats := models.CohortCheckpointStats{
+			CheckpointID: checkpoint.ID,
+			Title:        checkpoint.Title,
+			DueAt:        checkpoint.DueAt,
+			Completed:    completed[checkpoint.ID],
+		}
+		if activeMentees > 0 {
+			stats.Rate = float64(stats.Completed) / float64(activeMentees)
+		}
+		dashboard.Checkpoints = append(dashboard.Checkpoints, stats)
+	}
+	return dashboard
+}
+
+func normalizeCohortRequest(req *models.CohortRequest) error {
+	req.Title = strings.TrimSpace(req.Title)
+	req.Description = strings.TrimSpace(req.Description)
+	if req.Title == "" {
+		return apperrors.InvalidInputError("title", "is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return apperrors.InvalidInputError("endsAt", "must be after startsAt")
+	}
+	if req.Status == "" {
+		req.Status = models.CohortStatusDraft
+	}
+	for i := range req.Checkpoints {
+		req.Checkpoints[i].Title = strings.TrimSpace(req.Checkpoints[i].Title)
+		if req.Checkpoints[i].Title == "" {
+			return apperrors.InvalidInputError("checkpoints", "title is required")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// communityEventCalendarHistory keeps recently finished and cancelled events in the
+// calendar feed, so subscribers see cancellations instead of events silently vanishing
+const communityEventCalendarHistory = 30 * 24 * time.Hour
+
+// CommunityEventService manages community event announcements, the single source for
+// the site, the calendar feed and the bot
+type CommunityEventService struct {
+	repo       *repository.CommunityEventRepository
+	config     *config.Config
+	httpClient httpclient.Client
+}
+
+// NewCommunityEventService creates a new community event service
+func NewCommunityEventService(
+	repo *repository.CommunityEventRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *CommunityEventService {
+
+	return &CommunityEventService{
+		repo:       repo,
+		config:     cfg,
+		httpClient: httpClient,
+	}
+}
+
+// ListUpcoming returns published events that haven't ended yet
+func (s *CommunityEventService) ListUpcoming(ctx context.Context) ([]*models.CommunityEvent, error) {
+	events, err := s.repo.List(ctx, models.CommunityEventFilter{
+		Statuses:  []string{models.CommunityEventStatusPublished},
+		EndsAfter: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		event.CreatedBy = "" // moderator emails are not public
+	}
+	return events, nil
+}
+
+// GetCalendar renders published and cancelled events as an iCalendar feed
+func (s *CommunityEventService) GetCalendar(ctx context.Context) ([]byte, error) {
+	events, err := s.repo.List(ctx, models.CommunityEventFilter{
+		Statuses:  []string{models.CommunityEventStatusPublished, models.CommunityEventStatusCancelled},
+		EndsAfter: time.Now().Add(-communityEventCalendarHistory),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invites := make([]ical.Invite, 0, len(events))
+	for _, event := range events {
+		invite := ical.Invite{
+			UID:         event.ID + "@getmentor.dev",
+			Summary:     event.Title,
+			Description: event.Description,
+			URL:         event.URL,
+			Location:    event.Location,
+			Start:       event.StartsAt,
+			End:         event.EndsAt,
+			Stamp:       now,
+			Status:      "CONFIRMED",
+			Sequence:    event.Sequence,
+		}
+		if event.Status == models.CommunityEventStatusCancelled {
+			invite.Status = "CANCELLED"
+		}
+		invites = append(invites, invite)
+	}
+	return ical.Encode("-//GetMentor//Community Events//RU", invites...), nil
+}
+
+// ListEvents returns all events, including drafts and past ones. Admin only.
+func (s *CommunityEventService) ListEvents(ctx context.Context, session *models.AdminSession) ([]*models.CommunityEvent, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	return s.repo.List(ctx, models.CommunityEventFilter{})
+}
+
+// CreateEvent creates an event, a draft unless the request says otherwise. Admin only.
+func (s *CommunityEventService) CreateEvent(ctx context.Context, session *models.AdminSession, req *models.CommunityEventRequest) (*models.CommunityEvent, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if err := normalizeCommunityEventRequest(req); err != nil {
+		return nil, err
+	}
+	if !req.StartsAt.After(time.Now()) {
+		return nil, apperrors.InvalidInputError("startsAt", "must be in the future")
+	}
+
+	event, err := s.repo.Create(ctx, req, session.Email)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Community event created",
+		zap.String("event_id", event.ID),
+		zap.String("status", event.Status),
+		zap.String("moderator_id", session.ModeratorID))
+
+	if event.Status == models.CommunityEventStatusPublished {
+		s.crossPost(models.CommunityEventActionPublished, event)
+	}
+	return event, nil
+}
+
+// UpdateEvent replaces an event. Admin only.
+func (s *CommunityEventService) UpdateEvent(ctx context.Context, session *models.AdminSession, id string, req *models.CommunityEventRequest) (*models.CommunityEvent, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if err := normalizeCommunityEventRequest(req); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.repo.Update(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Community event updated",
+		zap.String("event_id", event.ID),
+		zap.String("status", event.Status),
+		zap.String("moderator_id", session.ModeratorID))
+
+	if action := communityEventAction(previous.Status, event.Status); action != "" {
+		s.crossPost(action, event)
+	}
+	return event, nil
+}
+
+// DeleteEvent removes an event. Deleting a published event announces its cancellation. Admin only.
+func (s *CommunityEventService) DeleteEvent(ctx context.Context, session *models.AdminSession, id string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		return ErrAdminForbiddenAction
+	}
+
+	event, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	logger.Info("Community event deleted", zap.String("event_id", id), zap.String("moderator_id", session.ModeratorID))
+
+	if event.Status == models.CommunityEventStatusPublished {
+		event.Status = models.CommunityEventStatusCancelled
+		s.crossPost(models.CommunityEventActionCancelled, event)
+	}
+	return nil
+}
+
+// crossPost sends the event to the cross-posting trigger (the bot and channel)
+func (s *CommunityEventService) crossPost(action string, event *models.CommunityEvent) {
+	triggerURL := s.config.EventTriggers.CommunityEventTriggerURL
+	if triggerURL == "" {
+		return
+	}
+	trigger.CallAsyncWithPayload(triggerURL, models.CommunityEventAnnouncement{
+		Action:  action,
+		Event:   event,
+		PageURL: s.config.Server.BaseURL + "/events",
+	}, s.httpClient)
+}
+
+// communityEventAction returns the cross-posting action of a status change, if any.
+// Drafts are never posted; changes to a published event are posted as updates.
+func communityEventAction(from, to string) string {
+	switch {
+	case to == models.CommunityEventStatusPublished && from != models.CommunityEventStatusPublished:
+		return models.CommunityEventActionPublished
+	case to == models.CommunityEventStatusPublished:
+		return models.CommunityEventActionUpdated
+	case to == models.CommunityEventStatusCancelled && from == models.CommunityEventStatusPublished:
+		return models.CommunityEventActionCancelled
+	default:
+		return ""
+	}
+}
+
+func normalizeCommunityEventRequest(req *models.CommunityEventRequest) error {
+	req.Title = strings.TrimSpace(req.Title)
+	req.Description = strings.TrimSpace(req.Description)
+	req.Location = strings.TrimSpace(req.Location)
+	req.URL = strings.TrimSpace(req.URL)
+	if req.Title == "" {
+		return apperrors.InvalidInputError("title", "is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return apperrors.InvalidInputError("endsAt", "must be after startsAt")
+	}
+	if req.Status == "" {
+		req.Status = models.CommunityEventStatusDraft
+	}
+	return nil
+}
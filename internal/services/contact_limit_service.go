@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// contactLimitCleanupInterval is how often counters that no longer count are deleted
+	contactLimitCleanupInterval = time.Hour
+	contactLimitCleanupTimeout  = 30 * time.Second
+	// contactLimitListSize caps the counters listed to admins
+	contactLimitListSize = 100
+)
+
+var (
+	// ErrContactRateLimited is returned when an email or client IP sent too many contact forms
+	ErrContactRateLimited = errors.New("too many contact submissions")
+	// ErrContactLimitNotFound is returned when a reset subject has no counters
+	ErrContactLimitNotFound = errors.New("no contact submissions counted for subject")
+)
+
+// ContactLimitService limits contact form submissions per email and per client IP over a
+// sliding window. The counters live in PostgreSQL, so a deploy doesn't reset them the way it
+// resets the HTTP rate limiters.
+type ContactLimitService struct {
+	repo   *repository.ContactLimitRepository
+	window time.Duration
+	limits map[string]int
+}
+
+// NewContactLimitService creates a new contact limit service
+func NewContactLimitService(repo *repository.ContactLimitRepository, cfg *config.Config) *ContactLimitService {
+	return &ContactLimitService{
+		repo:   repo,
+		window: time.Duration(cfg.ContactLimits.WindowMinutes) * time.Minute,
+		limits: map[string]int{
+			models.ContactLimitKindEmail: cfg.ContactLimits.EmailLimit,
+			models.ContactLimitKindIP:    cfg.ContactLimits.IPLimit,
+		},
+	}
+}
+
+// Enabled reports whether any limit is set
+func (s *ContactLimitService) Enabled() bool {
+	return s.limits[models.ContactLimitKindEmail] > 0 || s.limits[models.ContactLimitKindIP] > 0
+}
+
+// Start deletes the counters older than the sliding window in the background every hour
+func (s *ContactLimitService) Start() {
+	if !s.Enabled() {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(contactLimitCleanupInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ctx, cancel := context.WithTimeout(context.Background(), contactLimitCleanupTimeout)
+			deleted, err := s.repo.DeleteBefore(ctx, s.previousStart(time.Now()))
+			cancel()
+			if err != nil {
+				logger.Error("Failed to delete expired contact submission counters", zap.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				logger.Debug("Deleted expired contact submission counters", zap.Int64("count", deleted))
+			}
+		}
+	}()
+}
+
+// Check counts a contact form submission from email and ip and returns ErrContactRateLimited
+// when either went over its limit. Rejected submissions count too, so a sender that keeps
+// retrying stays limited until it slows down.
+func (s *ContactLimitService) Check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	subjects := map[string]string{
+		models.ContactLimitKindEmail: email,
+		models.ContactLimitKindIP:    ip,
+	}
+
+	var limited error
+	for _, kind := range []string{models.ContactLimitKindEmail, models.ContactLimitKindIP} {
+		subject := models.NormalizeContactLimitSubject(subjects[kind])
+		limit := s.limits[kind]
+		if limit <= 0 || subject == "" {
+			continue
+		}
+
+		current, previous, err := s.repo.Record(ctx, kind, subject, s.windowStart(now), s.previousStart(now))
+		if err != nil {
+			return err
+		}
+		if models.SlidingWindowCount(current, previous, s.windowStart(now), now, s.window) > float64(limit) && limited == nil {
+			metrics.ContactLimitRejections.WithLabelValues(kind).Inc()
+			limited = fmt.Errorf("%w: %s over %d per %s", ErrContactRateLimited, kind, limit, s.window)
+		}
+	}
+	return limited
+}
+
+// ListCounters returns the busiest subjects of the sliding window, optionally of one kind or
+// subject. Available to moderators and admins.
+func (s *ContactLimitService) ListCounters(ctx context.Context, session *models.AdminSession, kind, subject string) (*models.ContactLimitsResponse, error) {
+	if kind != "" && !s.knownKind(kind) {
+		return nil, fmt.Errorf("%w: kind must be email or ip", apperrors.ErrInvalidInput)
+	}
+
+	now := time.Now()
+	counts, err := s.repo.List(ctx, kind, models.NormalizeContactLimitSubject(subject),
+		s.windowStart(now), s.previousStart(now), contactLimitListSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counters := make([]*models.ContactLimitCounter, 0, len(counts))
+	for _, count := range counts {
+		submissions := models.SlidingWindowCount(count.Current, count.Previous, s.windowStart(now), now, s.window)
+		limit := s.limits[count.Kind]
+		counters = append(counters, &models.ContactLimitCounter{
+			Kind:             count.Kind,
+			Subject:          count.Subject,
+			Submissions:      submissions,
+			Limit:            limit,
+			Limited:          limit > 0 && submissions > float64(limit),
+			LastSubmissionAt: count.LastSubmissionAt,
+		})
+	}
+	return &models.ContactLimitsResponse{
+		Counters:      counters,
+		Total:         len(counters),
+		WindowMinutes: int(s.window / time.Minute),
+	}, nil
+}
+
+// ResetCounter forgets the submissions of a subject, lifting its limit. Admin only.
+func (s *ContactLimitService) ResetCounter(ctx context.Context, session *models.AdminSession, kind, subject string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		return ErrAdminForbiddenAction
+	}
+	if !s.knownKind(kind) {
+		return fmt.Errorf("%w: kind must be email or ip", apperrors.ErrInvalidInput)
+	}
+	subject = models.NormalizeContactLimitSubject(subject)
+	if subject == "" {
+		return fmt.Errorf("%w: subject is required", apperrors.ErrInvalidInput)
+	}
+
+	found, err := s.repo.Reset(ctx, kind, subject)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrContactLimitNotFound
+	}
+	logger.Info("Contact submission counters reset",
+		zap.String("kind", kind),
+		zap.String("moderator_id", session.ModeratorID))
+	return nil
+}
+
+func (s *ContactLimitService) knownKind(kind string) bool {
+	return kind == models.ContactLimitKindEmail || kind == models.ContactLimitKindIP
+}
+
+// windowStart is the start of the fixed window now falls in
+func (s *ContactLimitService) windowStart(now time.Time) time.Time {
+	if s.window <= 0 {
+		return now
+	}
+	return now.Truncate(s.window)
+}
+
+// previousStart is the start of the fixed window before the one now falls in
+func (s *ContactLimitService) previousStart(now time.Time) time.Time {
+	return s.windowStart(now).Add(-s.window)
+}
@@ -2,18 +2,22 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/botcheck"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
 	"github.com/getmentor/getmentor-api/pkg/recaptcha"
-	"github.com/getmentor/getmentor-api/pkg/trigger"
 	"go.uber.org/zap"
 )
 
@@ -21,32 +25,49 @@ import (
 type ContactService struct {
 	clientRequestRepo *repository.ClientRequestRepository
 	mentorRepo        *repository.MentorRepository
+	blocklist         *BlocklistService
+	limits            *ContactLimitService
 	config            *config.Config
 	httpClient        httpclient.Client
+	notifications     *NotificationDispatcher
 	recaptchaVerifier *recaptcha.Verifier
+	botChecker        *botcheck.Checker
 	tracker           analytics.Tracker
+	publisher         eventbus.Publisher
 }
 
 // NewContactService creates a new contact service instance
 func NewContactService(
 	clientRequestRepo *repository.ClientRequestRepository,
 	mentorRepo *repository.MentorRepository,
+	blocklist *BlocklistService,
+	limits *ContactLimitService,
 	cfg *config.Config,
 	httpClient httpclient.Client,
+	notifications *NotificationDispatcher,
 	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
 ) *ContactService {
 
 	if tracker == nil {
 		tracker = analytics.NoopTracker{}
 	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
 
 	return &ContactService{
 		clientRequestRepo: clientRequestRepo,
 		mentorRepo:        mentorRepo,
+		blocklist:         blocklist,
+		limits:            limits,
 		config:            cfg,
 		httpClient:        httpClient,
+		notifications:     notifications,
 		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+		botChecker:        newBotChecker(cfg),
 		tracker:           tracker,
+		publisher:         publisher,
 	}
 }
 
@@ -58,6 +79,22 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 		"calendar_url_requested": true,
 	}
 
+	// Reject obvious bots before spending a ReCAPTCHA verification on them
+	if err := checkBotSignals(s.botChecker, "contact", req.Website, req.FormRenderedAt); err != nil {
+		metrics.ContactFormSubmissions.WithLabelValues("bot_detected").Inc()
+		s.tracker.Track(ctx, analytics.EventMenteeContactSubmitted, analytics.MentorDistinctID(req.MentorID), map[string]interface{}{
+			"mentor_id":              req.MentorID,
+			"experience":             req.Experience,
+			"has_telegram_username":  strings.TrimSpace(req.TelegramUsername) != "",
+			"calendar_url_requested": true,
+			"outcome":                "bot_detected",
+		})
+		return &models.ContactMentorResponse{
+			Success: false,
+			Error:   "Submission rejected",
+		}, err
+	}
+
 	// Verify ReCAPTCHA
 	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
 		metrics.ContactFormSubmissions.WithLabelValues("captcha_failed").Inc()
@@ -75,6 +112,58 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 		}, fmt.Errorf("captcha verification failed: %w", err)
 	}
 
+	// Check the per-email and per-IP limits. A failed check doesn't stop legitimate mentees.
+	if err := s.limits.Check(ctx, req.Email, req.ClientIP); err != nil {
+		if !errors.Is(err, ErrContactRateLimited) {
+			logger.Error("Contact limit check failed", zap.Error(err))
+		} else {
+			metrics.ContactFormSubmissions.WithLabelValues("rate_limited").Inc()
+			s.tracker.Track(ctx, analytics.EventMenteeContactSubmitted, analytics.MentorDistinctID(req.MentorID), map[string]interface{}{
+				"mentor_id":              req.MentorID,
+				"experience":             req.Experience,
+				"has_telegram_username":  strings.TrimSpace(req.TelegramUsername) != "",
+				"calendar_url_requested": true,
+				"outcome":                "rate_limited",
+			})
+			logger.Warn("Contact submission over the limit", zap.String("mentor_id", req.MentorID), zap.Error(err))
+			return &models.ContactMentorResponse{
+				Success: false,
+				Error:   "Too many requests, try again later",
+			}, err
+		}
+	}
+
+	// Check the blocklist. A failed lookup doesn't stop legitimate mentees.
+	// Shadow entries keep up the appearance of success but hold the request in quarantine.
+	var flagReason string
+	var quarantined bool
+	entry, err := s.blocklist.Check(ctx, "contact", models.BlocklistSubject{
+		Email:    req.Email,
+		Telegram: req.TelegramUsername,
+		IP:       req.ClientIP,
+	})
+	if err != nil {
+		logger.Error("Blocklist check failed", zap.Error(err))
+	}
+	if entry != nil {
+		if entry.Action == models.BlocklistActionReject {
+			metrics.ContactFormSubmissions.WithLabelValues("blocked").Inc()
+			s.tracker.Track(ctx, analytics.EventMenteeContactSubmitted, analytics.MentorDistinctID(req.MentorID), map[string]interface{}{
+				"mentor_id":              req.MentorID,
+				"experience":             req.Experience,
+				"has_telegram_username":  strings.TrimSpace(req.TelegramUsername) != "",
+				"calendar_url_requested": true,
+				"outcome":                "blocked",
+			})
+			return &models.ContactMentorResponse{
+				Success: false,
+				Error:   "Submission rejected",
+			}, fmt.Errorf("%w: entry %s", ErrSubmissionBlocked, entry.ID)
+		}
+		flagReason = "blocklist:" + entry.ID
+		quarantined = entry.Action == models.BlocklistActionShadow
+	}
+
 	// Create client request in PostgreSQL
 	clientReq := &models.ClientRequest{
 		Email:       req.Email,
@@ -83,6 +172,8 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 		MentorID:    req.MentorID,
 		Description: req.Intro,
 		Telegram:    req.TelegramUsername,
+		FlagReason:  flagReason,
+		Quarantined: quarantined,
 	}
 
 	requestID, err := s.clientRequestRepo.Create(ctx, clientReq)
@@ -102,15 +193,30 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 		}, fmt.Errorf("failed to create client request: %w", err)
 	}
 
-	// Trigger contact created webhook (non-blocking)
-	trigger.CallAsync(s.config.EventTriggers.MentorRequestCreatedTriggerURL, requestID, s.httpClient)
+	// Notify the mentor (non-blocking, held during their quiet hours). Quarantined requests
+	// reach the mentor only once an admin releases them.
+	outcome := "success"
+	if quarantined {
+		outcome = "quarantined"
+	} else {
+		s.notifications.Notify(ctx, &models.MentorNotification{
+			MentorID: req.MentorID,
+			Channel:  models.NotificationChannelMentorRequestCreated,
+			RecordID: requestID,
+		})
+		events.Publish(ctx, s.publisher, events.RequestCreated{
+			RequestID: requestID,
+			MentorID:  req.MentorID,
+			Level:     req.Experience,
+		})
+	}
 
 	// Get mentor to retrieve calendar URL
 	mentor, err := s.mentorRepo.GetByMentorId(ctx, req.MentorID, models.FilterOptions{ShowHidden: true})
 	if err != nil {
 		logger.Error("Failed to get mentor for calendar URL", zap.Error(err))
 		// Still return success as the request was saved
-		metrics.ContactFormSubmissions.WithLabelValues("success").Inc()
+		metrics.ContactFormSubmissions.WithLabelValues(outcome).Inc()
 		s.tracker.Track(ctx, analytics.EventMenteeContactSubmitted, analytics.RequestDistinctID(requestID), map[string]interface{}{
 			"mentor_id":              req.MentorID,
 			"request_id":             requestID,
@@ -118,7 +224,7 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 			"has_telegram_username":  strings.TrimSpace(req.TelegramUsername) != "",
 			"calendar_url_requested": true,
 			"calendar_url_available": false,
-			"outcome":                "success",
+			"outcome":                outcome,
 		})
 		return &models.ContactMentorResponse{
 			Success:   true,
@@ -126,18 +232,48 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *models.Cont
 		}, nil
 	}
 
-	metrics.ContactFormSubmissions.WithLabelValues("success").Inc()
-	successProperties := make(map[string]interface{}, len(baseProperties)+4)
+	calendarBroken := mentor.CalendarType == models.CalendarTypeBroken
+
+	metrics.ContactFormSubmissions.WithLabelValues(outcome).Inc()
+	successProperties := make(map[string]interface{}, len(baseProperties)+5)
 	for key, value := range baseProperties {
 		successProperties[key] = value
 	}
 	successProperties["request_id"] = requestID
-	successProperties["calendar_url_available"] = strings.TrimSpace(mentor.CalendarURL) != ""
-	successProperties["outcome"] = "success"
+	successProperties["calendar_url_available"] = strings.TrimSpace(mentor.CalendarURL) != "" && !calendarBroken
+	successProperties["calendar_broken"] = calendarBroken
+	successProperties["outcome"] = outcome
 	s.tracker.Track(ctx, analytics.EventMenteeContactSubmitted, analytics.RequestDistinctID(requestID), successProperties)
-	return &models.ContactMentorResponse{
-		Success:     true,
-		RequestID:   requestID,
-		CalendarURL: mentor.CalendarURL,
-	}, nil
+
+	resp := &models.ContactMentorResponse{
+		Success:        true,
+		RequestID:      requestID,
+		CalendarURL:    mentor.CalendarURL,
+		MentorTimezone: mentor.Timezone,
+		ContactHours:   mentor.ContactHours,
+	}
+	if calendarBroken {
+		// The calendar link stopped responding: point the mentee to the mentor's Telegram instead
+		resp.CalendarURL = ""
+		resp.CalendarUnavailable = true
+		resp.TelegramURL = s.telegramURL(ctx, mentor.MentorID)
+	}
+	if within, known := mentor.IsWithinContactHours(time.Now()); known {
+		resp.WithinContactHours = &within
+	}
+	return resp, nil
+}
+
+// telegramURL returns a t.me link to the mentor's Telegram, empty when the handle is missing or invalid
+func (s *ContactService) telegramURL(ctx context.Context, mentorID string) string {
+	telegram, err := s.mentorRepo.GetTelegram(ctx, mentorID)
+	if err != nil {
+		logger.Error("Failed to get mentor telegram for contact fallback", zap.String("mentor_id", mentorID), zap.Error(err))
+		return ""
+	}
+	handle := models.NormalizeTelegramHandle(telegram)
+	if !models.ValidTelegramHandle(handle) {
+		return ""
+	}
+	return "https://t.me/" + handle
 }
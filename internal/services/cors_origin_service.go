@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// corsReloadTimeout bounds one reload of the origins
+const corsReloadTimeout = 5 * time.Second
+
+// ErrStaticCORSOrigin is returned when an admin tries to change an origin from ALLOWED_CORS_ORIGINS
+var ErrStaticCORSOrigin = errors.New("origin is configured by ALLOWED_CORS_ORIGINS")
+
+// CORSOriginService keeps the CORS policy of this replica: the ALLOWED_CORS_ORIGINS (plus localhost in
+// development) with every capability, and the origins admins keep in cors_origins. The table is
+// reloaded periodically, so changes made through any replica apply everywhere without a deploy.
+type CORSOriginService struct {
+	repo     *repository.CORSOriginRepository
+	static   []*models.CORSOrigin
+	interval time.Duration
+	policy   atomic.Pointer[models.CORSPolicy]
+}
+
+// NewCORSOriginService creates the service and applies the static origins until the first reload
+func NewCORSOriginService(repo *repository.CORSOriginRepository, cfg *config.Config) *CORSOriginService {
+	origins := append([]string{}, cfg.Server.AllowedOrigins...)
+	if cfg.IsDevelopment() {
+		origins = append(origins, "http://localhost:3000", "http://127.0.0.1:3000")
+	}
+
+	static := make([]*models.CORSOrigin, 0, len(origins))
+	for _, origin := range origins {
+		static = append(static, &models.CORSOrigin{
+			Origin:           strings.TrimSuffix(strings.TrimSpace(origin), "/"),
+			AllowCredentials: true, // Required for mentor session cookies
+			Static:           true,
+		})
+	}
+
+	s := &CORSOriginService{
+		repo:     repo,
+		static:   static,
+		interval: time.Duration(cfg.Server.CORSRefreshSeconds) * time.Second,
+	}
+	s.apply(nil)
+	return s
+}
+
+// Start loads the admin-managed origins and reloads them in the background.
+// A zero refresh interval loads them once.
+func (s *CORSOriginService) Start() {
+	s.reloadLogged()
+	if s.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			s.reloadLogged()
+		}
+	}()
+}
+
+// Policy returns the CORS policy currently applied
+func (s *CORSOriginService) Policy() *models.CORSPolicy {
+	return s.policy.Load()
+}
+
+// ListOrigins returns the static and the admin-managed origins. Available to moderators and admins.
+func (s *CORSOriginService) ListOrigins(ctx context.Context, session *models.AdminSession) ([]*models.CORSOrigin, error) {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]*models.CORSOrigin{}, s.static...), stored...), nil
+}
+
+// UpsertOrigin adds an origin or replaces its rules and applies it on this replica at once;
+// other replicas pick it up on their next reload. Admin only.
+func (s *CORSOriginService) UpsertOrigin(ctx context.Context, session *models.AdminSession, req *models.UpsertCORSOriginRequest) (*models.CORSOrigin, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	origin, err := models.NormalizeCORSOrigin(req.Origin)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+	}
+	if s.isStatic(origin) {
+		return nil, ErrStaticCORSOrigin
+	}
+
+	methods := make([]string, 0, len(req.AllowedMethods))
+	for _, method := range req.AllowedMethods {
+		methods = append(methods, strings.ToUpper(method))
+	}
+	if len(methods) > 0 && !containsString(methods, http.MethodOptions) {
+		methods = append(methods, http.MethodOptions)
+	}
+	headers := make([]string, 0, len(req.AllowedHeaders))
+	for _, header := range req.AllowedHeaders {
+		if header = strings.TrimSpace(header); header != "" {
+			headers = append(headers, header)
+		}
+	}
+
+	stored, err := s.repo.Upsert(ctx, &models.CORSOrigin{
+		Origin:           origin,
+		AllowedMethods:   methods,
+		AllowedHeaders:   headers,
+		AllowCredentials: req.AllowCredentials,
+		Note:             strings.TrimSpace(req.Note),
+		UpdatedBy:        session.ModeratorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("CORS origin saved",
+		zap.String("origin", origin),
+		zap.Strings("methods", methods),
+		zap.String("moderator_id", session.ModeratorID))
+	s.reloadLogged()
+	return stored, nil
+}
+
+// DeleteOrigin removes an admin-managed origin. Admin only.
+func (s *CORSOriginService) DeleteOrigin(ctx context.Context, session *models.AdminSession, origin string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		return ErrAdminForbiddenAction
+	}
+
+	normalized, err := models.NormalizeCORSOrigin(origin)
+	if err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+	}
+	if s.isStatic(normalized) {
+		return ErrStaticCORSOrigin
+	}
+	if err := s.repo.Delete(ctx, normalized); err != nil {
+		return err
+	}
+
+	logger.Info("CORS origin removed",
+		zap.String("origin", normalized),
+		zap.String("moderator_id", session.ModeratorID))
+	s.reloadLogged()
+	return nil
+}
+
+// Reload reads the admin-managed origins and applies them with the static ones
+func (s *CORSOriginService) Reload(ctx context.Context) error {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	s.apply(stored)
+	return nil
+}
+
+// reloadLogged reloads and keeps the current policy when the database is unreachable
+func (s *CORSOriginService) reloadLogged() {
+	ctx, cancel := context.WithTimeout(context.Background(), corsReloadTimeout)
+	defer cancel()
+	if err := s.Reload(ctx); err != nil {
+		logger.Warn("Failed to reload CORS origins, keeping the current policy", zap.Error(err))
+	}
+}
+
+// apply swaps in the policy made of the static origins and stored, static ones winning
+func (s *CORSOriginService) apply(stored []*models.CORSOrigin) {
+	origins := make([]*models.CORSOrigin, 0, len(stored)+len(s.static))
+	origins = append(origins, stored...)
+	origins = append(origins, s.static...)
+	policy := models.NewCORSPolicy(origins)
+	s.policy.Store(policy)
+	metrics.CORSOrigins.Set(float64(policy.Len()))
+}
+
+func (s *CORSOriginService) isStatic(origin string) bool {
+	for _, static := range s.static {
+		if strings.EqualFold(static.Origin, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
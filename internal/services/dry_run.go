@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+)
+
+type dryRunKey struct{}
+
+// WithDryRun marks ctx as dry-run. Services validate input and run the same code path, with
+// their unit of work rolled back at the end (see runUnitOfWork), and skip the side effects
+// outside the database (triggers, events, analytics).
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx is in dry-run mode
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool) //nolint:errcheck // type assertion, false on mismatch
+	return dryRun
+}
+
+// runUnitOfWork runs fn in a unit of work. In dry-run mode the transaction is rolled back once
+// fn returns, so the writes are checked by the database and visible to fn's reads but not kept.
+func runUnitOfWork(ctx context.Context, uow *repository.UnitOfWork, fn func(ctx context.Context) error) error {
+	if IsDryRun(ctx) {
+		return uow.Rehearse(ctx, fn)
+	}
+	return uow.Do(ctx, fn)
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/pkg/jwt"
@@ -18,12 +19,24 @@ type MentorServiceInterface interface {
 	GetMentorByID(ctx context.Context, id int, opts models.FilterOptions) (*models.Mentor, error)
 	GetMentorBySlug(ctx context.Context, slug string, opts models.FilterOptions) (*models.Mentor, error)
 	GetMentorByMentorId(ctx context.Context, mentorId string, opts models.FilterOptions) (*models.Mentor, error)
+	GetNewMentors(ctx context.Context, since time.Time, limit int) ([]models.NewMentor, error)
+	SearchMentors(ctx context.Context, query string, filters models.MentorTextSearchFilters, limit, offset int) ([]*models.Mentor, error)
+}
+
+// AvailabilityServiceInterface defines the interface for mentor availability lookups
+type AvailabilityServiceInterface interface {
+	GetAvailability(ctx context.Context, legacyID int) (*models.MentorAvailability, error)
 }
 
 // ProfileServiceInterface defines the interface for profile service operations
 type ProfileServiceInterface interface {
+	GetProfileVersion(ctx context.Context, mentorId string) (int, error)
 	SaveProfileByMentorId(ctx context.Context, mentorId string, req *models.SaveProfileRequest) error
-	UploadPictureByMentorId(ctx context.Context, mentorId string, mentorSlug string, req *models.UploadProfilePictureRequest) (string, error)
+	PatchProfileByMentorId(ctx context.Context, mentorId string, patch models.MergePatch) ([]models.ProfileFieldChange, error)
+	UploadPictureByMentorId(ctx context.Context, mentorId string, mentorSlug string, actor string, req *models.UploadProfilePictureRequest) (string, error)
+	RequestEmailChange(ctx context.Context, mentorID, newEmail, moderatorID string) (*models.EmailChange, error)
+	GetPendingEmailChange(ctx context.Context, mentorID string) (*models.EmailChange, error)
+	ConfirmEmailChange(ctx context.Context, token string) (*models.EmailChange, error)
 }
 
 // RegistrationServiceInterface defines the interface for registration service operations
@@ -34,7 +47,8 @@ type RegistrationServiceInterface interface {
 // MentorAuthServiceInterface defines the interface for mentor authentication
 type MentorAuthServiceInterface interface {
 	RequestLogin(ctx context.Context, email string) (*models.RequestLoginResponse, error)
-	VerifyLogin(ctx context.Context, token string) (*models.MentorSession, string, error)
+	VerifyLogin(ctx context.Context, token string, client models.DeviceClient) (*models.MentorSession, string, error)
+	Logout(ctx context.Context, jwtToken string) error
 	GetSessionTTL() int
 	GetCookieDomain() string
 	GetCookieSecure() bool
@@ -57,27 +71,292 @@ type MentorRequestsServiceInterface interface {
 	GetRequestByID(ctx context.Context, mentorId string, requestID string) (*models.MentorClientRequest, error)
 	UpdateStatus(ctx context.Context, mentorId string, requestID string, newStatus models.RequestStatus) (*models.MentorClientRequest, error)
 	DeclineRequest(ctx context.Context, mentorId string, requestID string, payload *models.DeclineRequestPayload) (*models.MentorClientRequest, error)
+	ScheduleRequest(ctx context.Context, mentorId string, requestID string, scheduledAt time.Time) (*models.MentorClientRequest, error)
+	ScheduleRequestFromBot(ctx context.Context, requestID string, scheduledAt time.Time) (*models.MentorClientRequest, error)
 }
 
 // ReviewServiceInterface defines the interface for review service operations
 type ReviewServiceInterface interface {
 	CheckReview(ctx context.Context, requestID string) (*models.ReviewCheckResponse, error)
 	SubmitReview(ctx context.Context, requestID string, req *models.SubmitReviewRequest) (*models.SubmitReviewResponse, error)
+	ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicReview, error)
+	ListMentorReviews(ctx context.Context, mentorID string) ([]models.PublicReview, error)
+	ReplyToReview(ctx context.Context, mentorID, reviewID string, req *models.ReviewReplyRequest) (*models.PublicReview, error)
+	ListForModeration(ctx context.Context, session *models.AdminSession, filter models.AdminReviewFilter) ([]*models.Review, error)
+	ModerateReview(ctx context.Context, session *models.AdminSession, reviewID, status string) error
+	ModerateReply(ctx context.Context, session *models.AdminSession, reviewID, status string) error
 }
 
 type AdminMentorsServiceInterface interface {
 	ListMentors(ctx context.Context, session *models.AdminSession, filter models.MentorModerationFilter) ([]models.AdminMentorListItem, error)
 	GetMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error)
 	UpdateMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, req *models.AdminMentorProfileUpdateRequest) (*models.AdminMentorDetails, error)
+	PatchMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, patch models.MergePatch) (*models.AdminMentorDetails, []models.ProfileFieldChange, error)
+	JSONPatchMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, patch models.JSONPatch) (*models.AdminMentorDetails, []models.ProfileFieldChange, error)
 	ApproveMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error)
 	DeclineMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error)
 	UpdateMentorStatus(ctx context.Context, session *models.AdminSession, mentorID string, status string) (*models.AdminMentorDetails, error)
 	UploadMentorPicture(ctx context.Context, session *models.AdminSession, mentorID string, req *models.UploadProfilePictureRequest) (string, error)
+	DeleteMentor(ctx context.Context, session *models.AdminSession, mentorID string) error
+}
+
+type AdminWebhooksServiceInterface interface {
+	TriggerNames() []string
+	TestTrigger(ctx context.Context, session *models.AdminSession, req *models.AdminWebhookTestRequest) (*models.AdminWebhookTestResponse, error)
+}
+
+type ProgramServiceInterface interface {
+	ListPublicPrograms(ctx context.Context) ([]*models.Program, error)
+	ListMentorPublicPrograms(ctx context.Context, legacyID int) ([]*models.Program, error)
+	ListMentorPrograms(ctx context.Context, mentorID string) ([]*models.Program, error)
+	CreateMentorProgram(ctx context.Context, mentorID string, req *models.ProgramRequest) (*models.Program, error)
+	UpdateMentorProgram(ctx context.Context, mentorID, programID string, req *models.ProgramRequest) (*models.Program, error)
+	DeleteMentorProgram(ctx context.Context, mentorID, programID string) error
+	ListPrograms(ctx context.Context, session *models.AdminSession, mentorID string) ([]*models.Program, error)
+	CreateProgram(ctx context.Context, session *models.AdminSession, req *models.ProgramRequest) (*models.Program, error)
+	UpdateProgram(ctx context.Context, session *models.AdminSession, programID string, req *models.ProgramRequest) (*models.Program, error)
+	DeleteProgram(ctx context.Context, session *models.AdminSession, programID string) error
+	RegisterAttendee(ctx context.Context, programID string, req *models.RegisterProgramAttendeeRequest) (*models.RegisterProgramAttendeeResponse, error)
+	CancelRegistration(ctx context.Context, cancelToken string) (*models.CancelProgramRegistrationResponse, error)
+	GetRegistrationCalendar(ctx context.Context, cancelToken string) ([]byte, error)
+}
+
+type LeaderboardServiceInterface interface {
+	GetLeaderboard(ctx context.Context, period string) (*models.Leaderboard, error)
+	SetMentorOptIn(ctx context.Context, mentorID string, optIn bool) error
+}
+
+type AbuseReportServiceInterface interface {
+	SubmitReport(ctx context.Context, req *models.AbuseReportRequest) (*models.AbuseReportResponse, error)
+	ListReports(ctx context.Context, session *models.AdminSession, filter models.AbuseReportFilter) ([]*models.AbuseReport, error)
+	ResolveReport(ctx context.Context, session *models.AdminSession, reportID string, req *models.ResolveAbuseReportRequest) (*models.AbuseReport, error)
+}
+
+type BlocklistServiceInterface interface {
+	ListEntries(ctx context.Context, session *models.AdminSession, includeInactive bool) ([]*models.BlocklistEntry, error)
+	AddEntry(ctx context.Context, session *models.AdminSession, req *models.CreateBlocklistEntryRequest) (*models.BlocklistEntry, error)
+	RemoveEntry(ctx context.Context, session *models.AdminSession, entryID string) error
+}
+
+// ContactLimitServiceInterface inspects and resets the persistent contact form limits
+type ContactLimitServiceInterface interface {
+	ListCounters(ctx context.Context, session *models.AdminSession, kind, subject string) (*models.ContactLimitsResponse, error)
+	ResetCounter(ctx context.Context, session *models.AdminSession, kind, subject string) error
+}
+
+// CORSOriginServiceInterface manages the admin-managed CORS origins
+type CORSOriginServiceInterface interface {
+	ListOrigins(ctx context.Context, session *models.AdminSession) ([]*models.CORSOrigin, error)
+	UpsertOrigin(ctx context.Context, session *models.AdminSession, req *models.UpsertCORSOriginRequest) (*models.CORSOrigin, error)
+	DeleteOrigin(ctx context.Context, session *models.AdminSession, origin string) error
+}
+
+type QuarantineServiceInterface interface {
+	ListQuarantined(ctx context.Context, session *models.AdminSession) ([]*models.QuarantinedRequest, error)
+	SetVerdict(ctx context.Context, session *models.AdminSession, requestID, verdict string) error
+}
+
+type MentorDataValidationServiceInterface interface {
+	ListOpenIssues(ctx context.Context, session *models.AdminSession) ([]*models.MentorDataIssue, error)
+}
+
+type SessionCalendarServiceInterface interface {
+	GetMentorFeed(ctx context.Context, mentorID string) ([]byte, error)
+	GetFeedByToken(ctx context.Context, token string) ([]byte, error)
+	GetFeedURL(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error)
+	RotateFeedToken(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error)
+}
+
+type SessionRescheduleServiceInterface interface {
+	ProposeReschedule(ctx context.Context, mentorID, requestID string, req *models.ProposeRescheduleRequest) (*models.ProposeRescheduleResponse, error)
+	GetProposal(ctx context.Context, token string) (*models.RescheduleProposal, error)
+	ConfirmReschedule(ctx context.Context, token string, req *models.ConfirmRescheduleRequest) (*models.RescheduleProposal, error)
+}
+
+type ReplyTemplateServiceInterface interface {
+	ListTemplates(ctx context.Context, mentorID string) ([]*models.ReplyTemplate, error)
+	CreateTemplate(ctx context.Context, mentorID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error)
+	UpdateTemplate(ctx context.Context, mentorID, templateID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error)
+	DeleteTemplate(ctx context.Context, mentorID, templateID string) error
+	RenderTemplate(ctx context.Context, mentorID, templateID, requestID string) (*models.RenderedReplyTemplate, error)
+}
+
+type PublicStatsServiceInterface interface {
+	GetStats(ctx context.Context) (*models.PublicStats, error)
+}
+
+// TagSuggestionServiceInterface defines the interface for tag suggestions
+type TagSuggestionServiceInterface interface {
+	SuggestForText(ctx context.Context, text string, exclude []string) ([]models.TagSuggestion, error)
+	SuggestForMentor(ctx context.Context, session *models.AdminSession, mentorID string) ([]models.TagSuggestion, error)
+}
+
+// MentorMergeServiceInterface defines the interface for merging duplicate mentors
+type MentorMergeServiceInterface interface {
+	MergeMentors(ctx context.Context, session *models.AdminSession, req *models.MergeMentorsRequest) (*models.MentorMergeResult, error)
+}
+
+// MentorDeviceSessionServiceInterface lists and revokes mentor portal sessions per device
+type MentorDeviceSessionServiceInterface interface {
+	ListSessions(ctx context.Context, mentorID, currentSessionID string) ([]models.MentorDeviceSession, error)
+	RevokeSession(ctx context.Context, mentorID, sessionID string) error
+	IsSessionActive(ctx context.Context, mentorID, sessionID string) (bool, error)
+}
+
+// AuditLogServiceInterface queries the audit log of mentor and request mutations
+type AuditLogServiceInterface interface {
+	List(ctx context.Context, session *models.AdminSession, filter models.AuditLogFilter) (*models.AuditLogListResponse, error)
+}
+
+// PartnerAuditServiceInterface queries the audit log of partner-token requests
+type PartnerAuditServiceInterface interface {
+	List(ctx context.Context, session *models.AdminSession, filter models.PartnerRequestLogFilter) ([]*models.PartnerRequestLog, error)
+}
+
+// PartnerQuotaServiceInterface reports and manages monthly quotas of partner tokens
+type PartnerQuotaServiceInterface interface {
+	GetUsage(ctx context.Context, tokenID string) (*models.PartnerQuotaUsage, error)
+	ListUsage(ctx context.Context, session *models.AdminSession) ([]*models.PartnerQuotaUsage, error)
+	SetOverride(ctx context.Context, session *models.AdminSession, tokenName string, req *models.SetPartnerQuotaRequest) (*models.PartnerQuotaOverride, error)
+	DeleteOverride(ctx context.Context, session *models.AdminSession, tokenName string) error
+}
+
+// OGImageServiceInterface renders mentor share cards
+type OGImageServiceInterface interface {
+	GetCard(ctx context.Context, slug string) (*OGImage, error)
+}
+
+// ShortLinkServiceInterface manages and resolves short links to mentor profiles
+type ShortLinkServiceInterface interface {
+	ListLinks(ctx context.Context, mentorID string) (*models.ShortLinkList, error)
+	CreateLink(ctx context.Context, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error)
+	DeleteLink(ctx context.Context, mentorID, code string) error
+	AdminListLinks(ctx context.Context, session *models.AdminSession, mentorID string) (*models.ShortLinkList, error)
+	AdminCreateLink(ctx context.Context, session *models.AdminSession, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error)
+	Resolve(ctx context.Context, code, userAgent string) (string, error)
+}
+
+// CommunityEventServiceInterface serves community events publicly and manages them for admins
+type CommunityEventServiceInterface interface {
+	ListUpcoming(ctx context.Context) ([]*models.CommunityEvent, error)
+	GetCalendar(ctx context.Context) ([]byte, error)
+	ListEvents(ctx context.Context, session *models.AdminSession) ([]*models.CommunityEvent, error)
+	CreateEvent(ctx context.Context, session *models.AdminSession, req *models.CommunityEventRequest) (*models.CommunityEvent, error)
+	UpdateEvent(ctx context.Context, session *models.AdminSession, id string, req *models.CommunityEventRequest) (*models.CommunityEvent, error)
+	DeleteEvent(ctx context.Context, session *models.AdminSession, id string) error
+}
+
+// MentorQuestionServiceInterface handles anonymous questions to mentors
+type MentorQuestionServiceInterface interface {
+	AskQuestion(ctx context.Context, mentorLegacyID int, req *models.AskMentorQuestionRequest) (*models.AskMentorQuestionResponse, error)
+	ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicMentorQuestion, error)
+	ListQuestions(ctx context.Context, mentorID, status string) ([]*models.MentorQuestion, error)
+	AnswerQuestion(ctx context.Context, mentorID, questionID string, req *models.AnswerMentorQuestionRequest) (*models.MentorQuestion, error)
+	RejectQuestion(ctx context.Context, mentorID, questionID string) error
+}
+
+// CohortServiceInterface manages cohorts, their enrollment, pairing and progress
+type CohortServiceInterface interface {
+	ListOpenCohorts(ctx context.Context) ([]*models.Cohort, error)
+	EnrollMentee(ctx context.Context, cohortID string, req *models.CohortEnrollRequest) (*models.CohortEnrollResponse, error)
+	ListMentorCohorts(ctx context.Context, mentorID string) ([]*models.MentorCohort, error)
+	EnrollMentor(ctx context.Context, session *models.MentorSession, cohortID string) (*models.CohortParticipant, error)
+	RecordMenteeProgress(ctx context.Context, mentorID, cohortID, menteeID string, req *models.CohortProgressRequest) (*models.CohortParticipant, error)
+	ListCohorts(ctx context.Context, session *models.AdminSession) ([]*models.Cohort, error)
+	GetDashboard(ctx context.Context, session *models.AdminSession, cohortID string) (*models.CohortDashboard, error)
+	CreateCohort(ctx context.Context, session *models.AdminSession, req *models.CohortRequest) (*models.Cohort, error)
+	UpdateCohort(ctx context.Context, session *models.AdminSession, cohortID string, req *models.CohortRequest) (*models.Cohort, error)
+	AddParticipant(ctx context.Context, session *models.AdminSession, cohortID string, req *models.AddCohortParticipantRequest) (*models.CohortParticipant, error)
+	UpdateParticipant(ctx context.Context, session *models.AdminSession, cohortID, participantID string, req *models.UpdateCohortParticipantRequest) (*models.CohortParticipant, error)
+	PairParticipants(ctx context.Context, session *models.AdminSession, cohortID string, req *models.CohortPairRequest) (*models.CohortParticipant, error)
+	RecordProgress(ctx context.Context, session *models.AdminSession, cohortID, menteeID string, req *models.CohortProgressRequest) (*models.CohortParticipant, error)
+}
+
+// CohortCertificateServiceInterface issues and verifies cohort completion certificates
+type CohortCertificateServiceInterface interface {
+	VerifyCertificate(ctx context.Context, certificateID string) (*models.CertificateVerification, error)
+	GetReport(ctx context.Context, session *models.AdminSession, cohortID string) (*models.CohortReport, error)
+	IssueCertificates(ctx context.Context, session *models.AdminSession, cohortID string, req *models.IssueCohortCertificatesRequest) (*models.IssueCohortCertificatesResponse, error)
+}
+
+// MentorImportServiceInterface creates pending mentors from import files
+type MentorImportServiceInterface interface {
+	Import(ctx context.Context, rows []models.MentorImportRow) *models.MentorImportReport
+}
+
+// ModerationRulesServiceInterface reads and versions the moderation rules
+type ModerationRulesServiceInterface interface {
+	GetCurrent(ctx context.Context) (*models.ModerationSettings, error)
+	ListVersions(ctx context.Context) ([]*models.ModerationSettings, error)
+	Update(ctx context.Context, session *models.AdminSession, req *models.UpdateModerationRulesRequest) (*models.ModerationSettings, error)
+	Restore(ctx context.Context, session *models.AdminSession, version int) (*models.ModerationSettings, error)
+}
+
+// ReturningMentorServiceInterface restores the profiles of returning mentors
+type ReturningMentorServiceInterface interface {
+	Reactivate(ctx context.Context, mentorID string) error
+}
+
+// MentorSurveyServiceInterface collects mentor surveys and aggregates their results
+type MentorSurveyServiceInterface interface {
+	GetOpen(ctx context.Context, mentorID string) (*models.MentorSurvey, error)
+	Submit(ctx context.Context, mentorID string, req *models.SubmitMentorSurveyRequest) (*models.MentorSurvey, error)
+	Summary(ctx context.Context, quarters int) ([]models.MentorSurveySummary, error)
+}
+
+// MentorInsightsServiceInterface serves mentors their private feedback insights
+type MentorInsightsServiceInterface interface {
+	GetReport(ctx context.Context, mentorID string) (*models.MentorInsightsReport, error)
+}
+
+// MentorStatsServiceInterface serves per-mentor statistics to the admin UI
+type MentorStatsServiceInterface interface {
+	GetStats(ctx context.Context, mentorID string) (*models.MentorStats, error)
+}
+
+// CacheVersionServiceInterface compares the mentor cache versions held by the API replicas
+type CacheVersionServiceInterface interface {
+	Report(ctx context.Context) (*models.CacheVersionsReport, error)
+}
+
+// CacheAdminServiceInterface inspects, refreshes and invalidates the mentor cache of a replica
+type CacheAdminServiceInterface interface {
+	Status(ctx context.Context) *models.MentorCacheStatus
+	Refresh(ctx context.Context) (*models.MentorCacheStatus, error)
+	Invalidate(ctx context.Context, slug string) (*models.CacheInvalidateResponse, error)
+}
+
+// TagAdminServiceInterface lists, adds and removes the tags mentors pick from
+type TagAdminServiceInterface interface {
+	List(ctx context.Context) ([]models.Tag, error)
+	Create(ctx context.Context, req *models.CreateTagRequest) (*models.Tag, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// NotificationServiceInterface manages the quiet hours of a mentor's notifications
+type NotificationServiceInterface interface {
+	GetSettings(ctx context.Context, mentorID string) (*models.NotificationSettings, error)
+	SetQuietHours(ctx context.Context, mentorID, quietHours string) (*models.NotificationSettings, error)
+}
+
+// BotHeartbeatServiceInterface records the Telegram bot's heartbeats and reports its liveness
+type BotHeartbeatServiceInterface interface {
+	RecordHeartbeat(ctx context.Context, req *models.BotHeartbeatRequest) (*models.BotStatus, error)
+	Status(ctx context.Context) (*models.BotStatus, error)
+}
+
+// TriggerDeadLetterServiceInterface inspects and re-drives failed outbound trigger deliveries
+type TriggerDeadLetterServiceInterface interface {
+	Redrive(ctx context.Context, session *models.AdminSession, id string) (*models.TriggerDeadLetter, error)
+	RedriveMany(ctx context.Context, session *models.AdminSession, ids []string) ([]models.TriggerRedriveResult, error)
+	ListDeadLetters(ctx context.Context, session *models.AdminSession, filter models.TriggerDeadLetterFilter, destination string) ([]*models.TriggerDeadLetter, error)
+	GetDeadLetter(ctx context.Context, session *models.AdminSession, id string) (*models.TriggerDeadLetter, error)
 }
 
 // Ensure services implement their interfaces
 var _ ContactServiceInterface = (*ContactService)(nil)
 var _ MentorServiceInterface = (*MentorService)(nil)
+var _ AvailabilityServiceInterface = (*AvailabilityService)(nil)
 var _ ProfileServiceInterface = (*ProfileService)(nil)
 var _ RegistrationServiceInterface = (*RegistrationService)(nil)
 var _ MentorAuthServiceInterface = (*MentorAuthService)(nil)
@@ -85,3 +364,40 @@ var _ AdminAuthServiceInterface = (*AdminAuthService)(nil)
 var _ MentorRequestsServiceInterface = (*MentorRequestsService)(nil)
 var _ ReviewServiceInterface = (*ReviewService)(nil)
 var _ AdminMentorsServiceInterface = (*AdminMentorsService)(nil)
+var _ AdminWebhooksServiceInterface = (*AdminWebhooksService)(nil)
+var _ ProgramServiceInterface = (*ProgramService)(nil)
+var _ LeaderboardServiceInterface = (*LeaderboardService)(nil)
+var _ AbuseReportServiceInterface = (*AbuseReportService)(nil)
+var _ BlocklistServiceInterface = (*BlocklistService)(nil)
+var _ ContactLimitServiceInterface = (*ContactLimitService)(nil)
+var _ CORSOriginServiceInterface = (*CORSOriginService)(nil)
+var _ QuarantineServiceInterface = (*QuarantineService)(nil)
+var _ MentorDataValidationServiceInterface = (*MentorDataValidationService)(nil)
+var _ SessionCalendarServiceInterface = (*SessionCalendarService)(nil)
+var _ SessionRescheduleServiceInterface = (*SessionRescheduleService)(nil)
+var _ ReplyTemplateServiceInterface = (*ReplyTemplateService)(nil)
+var _ PublicStatsServiceInterface = (*PublicStatsService)(nil)
+var _ TagSuggestionServiceInterface = (*TagSuggestionService)(nil)
+var _ MentorMergeServiceInterface = (*MentorMergeService)(nil)
+var _ MentorDeviceSessionServiceInterface = (*MentorDeviceSessionService)(nil)
+var _ TriggerDeadLetterServiceInterface = (*TriggerDeadLetterService)(nil)
+var _ AuditLogServiceInterface = (*AuditLogger)(nil)
+var _ PartnerAuditServiceInterface = (*PartnerAuditService)(nil)
+var _ PartnerQuotaServiceInterface = (*PartnerQuotaService)(nil)
+var _ OGImageServiceInterface = (*OGImageService)(nil)
+var _ ShortLinkServiceInterface = (*ShortLinkService)(nil)
+var _ CommunityEventServiceInterface = (*CommunityEventService)(nil)
+var _ MentorQuestionServiceInterface = (*MentorQuestionService)(nil)
+var _ CohortServiceInterface = (*CohortService)(nil)
+var _ CohortCertificateServiceInterface = (*CohortCertificateService)(nil)
+var _ MentorImportServiceInterface = (*MentorImportService)(nil)
+var _ ModerationRulesServiceInterface = (*ModerationRulesService)(nil)
+var _ ReturningMentorServiceInterface = (*ReturningMentorService)(nil)
+var _ MentorSurveyServiceInterface = (*MentorSurveyService)(nil)
+var _ MentorInsightsServiceInterface = (*MentorInsightsService)(nil)
+var _ MentorStatsServiceInterface = (*MentorStatsService)(nil)
+var _ CacheVersionServiceInterface = (*CacheVersionService)(nil)
+var _ CacheAdminServiceInterface = (*CacheAdminService)(nil)
+var _ TagAdminServiceInterface = (*TagAdminService)(nil)
+var _ BotHeartbeatServiceInterface = (*BotHeartbeatService)(nil)
+var _ NotificationServiceInterface = (*NotificationDispatcher)(nil)
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLeaderboardLimit          = 20
+	defaultLeaderboardRefreshMinutes = 60
+)
+
+var (
+	// ErrUnknownLeaderboardPeriod is returned for periods that are not configured
+	ErrUnknownLeaderboardPeriod = errors.New("unknown leaderboard period")
+	// ErrLeaderboardNotReady is returned until the first computation has finished
+	ErrLeaderboardNotReady = errors.New("leaderboard is not computed yet")
+)
+
+// LeaderboardService serves the opt-in mentor leaderboard. Leaderboards are
+// precomputed on a schedule and kept in memory; every response is checked against
+// the mentors' current opt-in flag and visibility.
+type LeaderboardService struct {
+	leaderboardRepo *repository.LeaderboardRepository
+	mentorRepo      *repository.MentorRepository
+	cache           *cache.LeaderboardCache
+	config          *config.Config
+	periods         []string
+	limit           int
+	tracker         analytics.Tracker
+	audit           *AuditLogger
+}
+
+// NewLeaderboardService creates a new leaderboard service. Call Start to begin computing leaderboards.
+func NewLeaderboardService(
+	leaderboardRepo *repository.LeaderboardRepository,
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+	tracker analytics.Tracker,
+	audit *AuditLogger,
+) *LeaderboardService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	s := &LeaderboardService{
+		leaderboardRepo: leaderboardRepo,
+		mentorRepo:      mentorRepo,
+		config:          cfg,
+		periods:         cfg.Leaderboard.Periods,
+		limit:           cfg.Leaderboard.Limit,
+		tracker:         tracker,
+		audit:           audit,
+	}
+	if len(s.periods) == 0 {
+		s.periods = []string{models.LeaderboardPeriodAll}
+	}
+	if s.limit <= 0 {
+		s.limit = defaultLeaderboardLimit
+	}
+
+	refreshMinutes := cfg.Leaderboard.RefreshMinutes
+	if refreshMinutes <= 0 {
+		refreshMinutes = defaultLeaderboardRefreshMinutes
+	}
+	s.cache = cache.NewLeaderboardCache(s.computeLeaderboards, time.Duration(refreshMinutes)*time.Minute)
+
+	return s
+}
+
+// Start schedules leaderboard computation in the background
+func (s *LeaderboardService) Start() {
+	s.cache.Start()
+}
+
+// GetLeaderboard returns the leaderboard for the period; an empty period selects the default one
+func (s *LeaderboardService) GetLeaderboard(ctx context.Context, period string) (*models.Leaderboard, error) {
+	if period == "" {
+		period = s.periods[0]
+	}
+	if !s.isConfiguredPeriod(period) {
+		return nil, ErrUnknownLeaderboardPeriod
+	}
+
+	leaderboard, err := s.cache.Get(period)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLeaderboardNotReady, err)
+	}
+
+	// Mentors may have opted out or been hidden since the leaderboard was computed
+	result := *leaderboard
+	result.Entries = make([]*models.LeaderboardEntry, 0, len(leaderboard.Entries))
+	for _, entry := range leaderboard.Entries {
+		mentor, err := s.mentorRepo.GetBySlug(ctx, entry.Slug, models.FilterOptions{OnlyVisible: true})
+		if err != nil || !mentor.LeaderboardOptIn {
+			continue
+		}
+		visible := *entry
+		visible.Rank = len(result.Entries) + 1
+		result.Entries = append(result.Entries, &visible)
+	}
+
+	return &result, nil
+}
+
+// SetMentorOptIn shows or hides the mentor on the leaderboard. Opting out takes effect immediately.
+func (s *LeaderboardService) SetMentorOptIn(ctx context.Context, mentorID string, optIn bool) error {
+	mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true})
+	if err != nil {
+		return err
+	}
+
+	err = s.audit.Track(ctx, models.AuditEntityMentor, mentorID, AuditOperationLeaderboardOpt, func(ctx context.Context) error {
+		return s.mentorRepo.Update(ctx, mentorID, map[string]interface{}{"leaderboard_opt_in": optIn})
+	})
+	if err != nil {
+		logger.Error("Failed to update leaderboard opt-in", zap.Error(err), zap.String("mentor_id", mentorID))
+		return fmt.Errorf("failed to update leaderboard opt-in: %w", err)
+	}
+
+	if !optIn {
+		s.cache.RemoveMentor(mentorID)
+	}
+	if err := s.mentorRepo.UpdateSingleMentorCache(mentor.Slug); err != nil {
+		logger.Warn("Failed to refresh mentor cache after leaderboard opt-in change",
+			zap.Error(err), zap.String("mentor_id", mentorID))
+	}
+
+	s.tracker.Track(ctx, analytics.EventMentorLeaderboardOptInChanged, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+		"mentor_id": mentorID,
+		"opt_in":    optIn,
+	})
+	return nil
+}
+
+// computeLeaderboards builds the leaderboards of all configured periods
+func (s *LeaderboardService) computeLeaderboards(ctx context.Context) (map[string]*models.Leaderboard, error) {
+	now := time.Now()
+	leaderboards := make(map[string]*models.Leaderboard, len(s.periods))
+
+	for _, period := range s.periods {
+		days, err := models.ParseLeaderboardPeriod(period)
+		if err != nil {
+			return nil, err
+		}
+
+		var since *time.Time
+		if days > 0 {
+			start := now.AddDate(0, 0, -days)
+			since = &start
+		}
+
+		entries, err := s.leaderboardRepo.FetchTopMentors(ctx, since, s.limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			entry.Link = s.config.Server.BaseURL + "/mentor/" + entry.Slug
+		}
+
+		leaderboards[period] = &models.Leaderboard{
+			Period:      period,
+			Since:       since,
+			GeneratedAt: now,
+			Entries:     entries,
+		}
+	}
+
+	return leaderboards, nil
+}
+
+func (s *LeaderboardService) isConfiguredPeriod(period string) bool {
+	for _, p := range s.periods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
@@ -13,22 +13,37 @@ import (
 	"go.uber.org/zap"
 )
 
+// Tools whose results are paginated with cursors
+const (
+	mcpToolListMentors   = "list_mentors"
+	mcpToolSearchMentors = "search_mentors"
+)
+
 // MCPService handles MCP (Model Context Protocol) operations for mentor search
 type MCPService struct {
-	repo    *repository.MentorRepository
-	baseURL string
+	repo         *repository.MentorRepository
+	baseURL      string
+	cacheVersion func() string
 }
 
-// NewMCPService creates a new MCP service instance
-func NewMCPService(repo *repository.MentorRepository, baseURL string) *MCPService {
+// NewMCPService creates a new MCP service instance. cacheVersion returns the mentor cache
+// version stamped on pagination cursors; nil stamps none.
+func NewMCPService(repo *repository.MentorRepository, baseURL string, cacheVersion func() string) *MCPService {
 	return &MCPService{
-		repo:    repo,
-		baseURL: baseURL,
+		repo:         repo,
+		baseURL:      baseURL,
+		cacheVersion: cacheVersion,
 	}
 }
 
-// ListMentors returns all active mentors with optional filtering
+// ListMentors returns a page of active mentors with optional filtering, continuing after
+// params.Cursor when set
 func (s *MCPService) ListMentors(ctx context.Context, params *models.ListMentorsParams) (*models.ListMentorsResult, error) {
+	cursor, err := models.DecodeMCPCursor(params.Cursor, mcpToolListMentors)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor parameter: %w", err)
+	}
+
 	// Set default limit
 	if params.Limit <= 0 {
 		params.Limit = 50
@@ -37,6 +52,12 @@ func (s *MCPService) ListMentors(ctx context.Context, params *models.ListMentors
 		params.Limit = 200
 	}
 
+	version := s.version()
+	location, err := mcpLocationFilter(params.Country, params.City, params.RemoteOnly, params.Languages)
+	if err != nil {
+		return nil, err
+	}
+
 	// Fetch all visible mentors
 	opts := models.FilterOptions{
 		OnlyVisible:    true,
@@ -53,22 +74,27 @@ func (s *MCPService) ListMentors(ctx context.Context, params *models.ListMentors
 
 	// Apply filters
 	filtered := s.filterMentors(mentors, params.Tags, params.Experience, params.MinPrice, params.MaxPrice, params.Workplace)
+	filtered = location.Apply(filtered)
 
-	// Apply limit
-	if len(filtered) > params.Limit {
-		filtered = filtered[:params.Limit]
-	}
+	// Apply the cursor and the limit
+	start := mcpPageStart(cursor, version, filtered, 0)
+	end := min(start+params.Limit, len(filtered))
+	page := filtered[start:end]
 
 	// Convert to MCP basic response
-	result := make([]models.MCPMentorBasic, 0, len(filtered))
-	for _, mentor := range filtered {
+	result := make([]models.MCPMentorBasic, 0, len(page))
+	for _, mentor := range page {
 		result = append(result, mentor.ToMCPBasic(s.baseURL))
 	}
 
-	return &models.ListMentorsResult{
+	response := &models.ListMentorsResult{
 		Mentors: result,
 		Count:   len(result),
-	}, nil
+	}
+	if end < len(filtered) {
+		response.NextCursor = mcpNextCursor(mcpToolListMentors, end, version, page)
+	}
+	return response, nil
 }
 
 // GetMentor returns extended information for a specific mentor
@@ -109,11 +135,21 @@ func (s *MCPService) GetMentor(ctx context.Context, params *models.GetMentorPara
 	return &models.GetMentorResult{Mentor: &extended}, nil
 }
 
-// SearchMentors performs keyword search with optional filtering
+// SearchMentors runs a full-text search for any of the comma-separated keywords, best match first
+// by the keywords' weights, leaving out mentors matching an excluded keyword, with optional filtering
 func (s *MCPService) SearchMentors(ctx context.Context, params *models.SearchMentorsParams) (*models.SearchMentorsResult, error) {
-	if params.Query == "" {
+	if models.MentorSearchQueryFromKeywords(params.Query) == "" {
 		return nil, fmt.Errorf("query parameter is required")
 	}
+	cursor, err := models.DecodeMCPCursor(params.Cursor, mcpToolSearchMentors)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor parameter: %w", err)
+	}
+	keywords, err := models.ParseMentorSearchKeywords(params.Query, params.KeywordWeights)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keywordWeights parameter: %w", err)
+	}
+	query := models.MentorSearchQueryFromWeightedKeywords(keywords)
 
 	// Set default limit
 	if params.Limit <= 0 {
@@ -123,42 +159,69 @@ func (s *MCPService) SearchMentors(ctx context.Context, params *models.SearchMen
 		params.Limit = 100
 	}
 
-	// Fetch all visible mentors with full info
-	opts := models.FilterOptions{
-		OnlyVisible:    true,
-		ShowHidden:     false,
-		DropLongFields: false, // Need full info for search
-		ForceRefresh:   false,
+	location, err := mcpLocationFilter(params.Country, params.City, params.RemoteOnly, params.Languages)
+	if err != nil {
+		return nil, err
+	}
+	filters := models.MentorTextSearchFilters{
+		MentorSearchFilter: location,
+		Tags:               params.Tags,
+		Experience:         params.Experience,
+		Workplace:          params.Workplace,
+		ExcludeKeywords:    models.SplitMentorSearchKeywords(params.ExcludeKeywords),
+		Keywords:           keywords,
 	}
 
-	mentors, err := s.repo.GetAll(ctx, opts)
+	// Cursor offsets count search rows. Prices are free-form text and filtered here, so a price
+	// range reads the longest page. When the cache version changed since the cursor was issued,
+	// the page is read from one limit earlier to find the last mentor returned.
+	version := s.version()
+	offset, windowStart := 0, 0
+	if cursor != nil {
+		offset, windowStart = cursor.Offset, cursor.Offset
+	}
+	fetchLimit := params.Limit
+	if params.MinPrice != "" || params.MaxPrice != "" {
+		fetchLimit = models.MaxMentorSearchLimit
+	}
+	if cursor != nil && cursor.Version != version && cursor.LastSlug != "" {
+		windowStart = max(0, offset-params.Limit)
+		fetchLimit = models.MaxMentorSearchLimit
+	}
+	searched, err := s.repo.SearchMentors(ctx, query, filters, fetchLimit, windowStart)
 	if err != nil {
-		logger.Error("Failed to fetch mentors for MCP search", zap.Error(err))
+		logger.Error("Failed to search mentors for MCP search", zap.Error(err))
 		return nil, err
 	}
 
-	// Apply filters first
-	filtered := s.filterMentors(mentors, params.Tags, params.Experience, params.MinPrice, params.MaxPrice, params.Workplace)
-
-	// Apply keyword search
-	keywords := s.parseKeywords(params.Query)
-	searched := s.searchMentors(filtered, keywords)
-
-	// Apply limit
-	if len(searched) > params.Limit {
-		searched = searched[:params.Limit]
+	// Take the page, skipping mentors outside the price range
+	page := []*models.Mentor{}
+	next := mcpPageStart(cursor, version, searched, windowStart)
+	for ; next < len(searched) && len(page) < params.Limit; next++ {
+		mentor := searched[next]
+		if params.MinPrice != "" && !s.priceInRange(mentor.Price, params.MinPrice, true) {
+			continue
+		}
+		if params.MaxPrice != "" && !s.priceInRange(mentor.Price, params.MaxPrice, false) {
+			continue
+		}
+		page = append(page, mentor)
 	}
 
 	// Convert to MCP extended response
-	result := make([]models.MCPMentorExtended, 0, len(searched))
-	for _, mentor := range searched {
+	result := make([]models.MCPMentorExtended, 0, len(page))
+	for _, mentor := range page {
 		result = append(result, mentor.ToMCPExtended(s.baseURL))
 	}
 
-	return &models.SearchMentorsResult{
+	response := &models.SearchMentorsResult{
 		Mentors: result,
 		Count:   len(result),
-	}, nil
+	}
+	if next < len(searched) || len(searched) == fetchLimit {
+		response.NextCursor = mcpNextCursor(mcpToolSearchMentors, windowStart+next, version, page)
+	}
+	return response, nil
 }
 
 // GetAvailableTools returns the MCP tool definitions
@@ -166,7 +229,7 @@ func (s *MCPService) GetAvailableTools() []models.MCPTool {
 	return []models.MCPTool{
 		{
 			Name:        "list_mentors",
-			Description: "List all active mentors with optional filtering by tags, experience, price range, and workplace. Returns basic mentor information.",
+			Description: "List all active mentors with optional filtering by tags, experience, price range, and workplace. Also filters by country, city, online-only mentors and mentoring languages. Returns basic mentor information and a nextCursor when more mentors match.",
 			InputSchema: map[string]interface{}{
 				"type": "object",
 				"properties": map[string]interface{}{
@@ -191,6 +254,27 @@ func (s *MCPService) GetAvailableTools() []models.MCPTool {
 						"type":        "string",
 						"description": "Filter by workplace/company name",
 					},
+					"country": map[string]interface{}{
+						"type":        "string",
+						"description": "Filter by ISO 3166-1 alpha-2 country code (e.g., 'DE')",
+					},
+					"city": map[string]interface{}{
+						"type":        "string",
+						"description": "Filter by city",
+					},
+					"remoteOnly": map[string]interface{}{
+						"type":        "boolean",
+						"description": "true for mentors who only meet online, false for mentors who also meet in person",
+					},
+					"languages": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string", "enum": models.MentorLanguages},
+						"description": "Mentoring languages; mentors speaking any of them match (ru, en, other)",
+					},
+					"cursor": map[string]interface{}{
+						"type":        "string",
+						"description": "nextCursor from the previous page, to continue the listing with the same filters",
+					},
 					"limit": map[string]interface{}{
 						"type":        "integer",
 						"description": "Maximum number of results (default: 50, max: 200)",
@@ -224,13 +308,23 @@ func (s *MCPService) GetAvailableTools() []models.MCPTool {
 		},
 		{
 			Name:        "search_mentors",
-			Description: "Search for mentors by keywords in their competencies, description, and about sections. Supports additional filtering by tags, experience, price, and workplace. Returns extended mentor information.",
+			Description: "Full-text search for mentors by keywords in their name, job title, competencies, description, and about sections, best match first. Words match in any grammatical form. Keywords can be weighted to rank must-have terms above nice-to-have ones, and excluded keywords drop matching mentors. Supports additional filtering by tags, experience, price, workplace, location and mentoring languages. Returns extended mentor information and a nextCursor when more mentors may match.",
 			InputSchema: map[string]interface{}{
 				"type": "object",
 				"properties": map[string]interface{}{
 					"query": map[string]interface{}{
 						"type":        "string",
-						"description": "Search keywords (comma-separated). Searches in competencies, description, and about fields.",
+						"description": "Search keywords (comma-separated); mentors matching any keyword are returned. A keyword of several words matches them all.",
+					},
+					"keywordWeights": map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": map[string]interface{}{"type": "number", "exclusiveMinimum": 0, "maximum": models.MaxMentorKeywordWeight},
+						"description":          "Ranking weight per keyword (default 1, max 10), e.g. {'python': 3, 'mentoring': 1} ranks Python matches first. Weighted keywords missing from query are searched too.",
+					},
+					"excludeKeywords": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]string{"type": "string"},
+						"description": "Mentors matching any of these keywords are left out (e.g., ['management'])",
 					},
 					"tags": map[string]interface{}{
 						"type":        "array",
@@ -253,6 +347,27 @@ func (s *MCPService) GetAvailableTools() []models.MCPTool {
 						"type":        "string",
 						"description": "Filter by workplace/company name",
 					},
+					"country": map[string]interface{}{
+						"type":        "string",
+						"description": "Filter by ISO 3166-1 alpha-2 country code (e.g., 'DE')",
+					},
+					"city": map[string]interface{}{
+						"type":        "string",
+						"description": "Filter by city",
+					},
+					"remoteOnly": map[string]interface{}{
+						"type":        "boolean",
+						"description": "true for mentors who only meet online, false for mentors who also meet in person",
+					},
+					"languages": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string", "enum": models.MentorLanguages},
+						"description": "Mentoring languages; mentors speaking any of them match (ru, en, other)",
+					},
+					"cursor": map[string]interface{}{
+						"type":        "string",
+						"description": "nextCursor from the previous page, to continue the search with the same query and filters",
+					},
 					"limit": map[string]interface{}{
 						"type":        "integer",
 						"description": "Maximum number of results (default: 20, max: 100)",
@@ -267,6 +382,59 @@ func (s *MCPService) GetAvailableTools() []models.MCPTool {
 	}
 }
 
+// version returns the mentor cache version stamped on cursors
+func (s *MCPService) version() string {
+	if s.cacheVersion == nil {
+		return ""
+	}
+	return s.cacheVersion()
+}
+
+// mcpPageStart returns the index in mentors, which start at the windowStart-th result, where
+// the page after cursor begins. When the cache version changed since the cursor was issued,
+// mentors may have moved, so the page resumes after the last mentor returned while it is
+// still there, and at the cursor's offset otherwise.
+func mcpPageStart(cursor *models.MCPCursor, version string, mentors []*models.Mentor, windowStart int) int {
+	if cursor == nil {
+		return 0
+	}
+	if cursor.Version != version && cursor.LastSlug != "" {
+		for i, mentor := range mentors {
+			if mentor.Slug == cursor.LastSlug {
+				return i + 1
+			}
+		}
+	}
+	return min(max(cursor.Offset-windowStart, 0), len(mentors))
+}
+
+// mcpNextCursor encodes the cursor of the page starting at offset, after page
+func mcpNextCursor(tool string, offset int, version string, page []*models.Mentor) string {
+	cursor := &models.MCPCursor{Tool: tool, Offset: offset, Version: version}
+	if len(page) > 0 {
+		cursor.LastSlug = page[len(page)-1].Slug
+	}
+	return cursor.Encode()
+}
+
+// mcpLocationFilter builds the location and language filter shared by list_mentors and search_mentors
+func mcpLocationFilter(country, city string, remoteOnly *bool, languages []string) (models.MentorSearchFilter, error) {
+	code, err := models.NormalizeCountryCode(country)
+	if err != nil {
+		return models.MentorSearchFilter{}, fmt.Errorf("invalid country parameter: %w", err)
+	}
+	normalizedLanguages, err := models.NormalizeLanguages(languages)
+	if err != nil {
+		return models.MentorSearchFilter{}, fmt.Errorf("invalid languages parameter: %w", err)
+	}
+	return models.MentorSearchFilter{
+		Country:    code,
+		City:       strings.TrimSpace(city),
+		RemoteOnly: remoteOnly,
+		Languages:  normalizedLanguages,
+	}, nil
+}
+
 // filterMentors applies filters to a list of mentors
 func (s *MCPService) filterMentors(mentors []*models.Mentor, tags []string, experience, minPrice, maxPrice, workplace string) []*models.Mentor {
 	filtered := make([]*models.Mentor, 0, len(mentors))
@@ -332,54 +500,6 @@ func (s *MCPService) priceInRange(mentorPrice, comparePrice string, isMin bool)
 	return mp <= cp
 }
 
-// parseKeywords splits query into keywords
-func (s *MCPService) parseKeywords(query string) []string {
-	keywords := strings.Split(strings.ToLower(query), ",")
-	// Remove duplicates
-	seen := make(map[string]bool)
-	unique := make([]string, 0, len(keywords))
-	for _, keyword := range keywords {
-		if !seen[keyword] && keyword != "" {
-			seen[keyword] = true
-			unique = append(unique, keyword)
-		}
-	}
-	return unique
-}
-
-// searchMentors performs keyword search in competencies, description, and about fields
-func (s *MCPService) searchMentors(mentors []*models.Mentor, keywords []string) []*models.Mentor {
-	if len(keywords) == 0 {
-		return mentors
-	}
-
-	result := make([]*models.Mentor, 0, len(mentors))
-
-	for _, mentor := range mentors {
-		// Create searchable text (lowercase)
-		searchText := strings.ToLower(
-			mentor.Competencies + " " +
-				mentor.Description + " " +
-				mentor.About,
-		)
-
-		// Check if any keyword matches
-		matched := false
-		for _, keyword := range keywords {
-			if strings.Contains(searchText, keyword) {
-				matched = true
-				break
-			}
-		}
-
-		if matched {
-			result = append(result, mentor)
-		}
-	}
-
-	return result
-}
-
 // ParseParams safely parses params from map to struct
 func ParseParams(params map[string]interface{}, target interface{}) error {
 	// Convert map to JSON
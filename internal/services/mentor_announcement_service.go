@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	"go.uber.org/zap"
+)
+
+// MentorAnnouncementService posts newly activated mentors to the Telegram channel
+// through the channel-posting webhook
+type MentorAnnouncementService struct {
+	mentorRepo   *repository.MentorRepository
+	yandexClient *yandex.StorageClient
+	config       *config.Config
+	httpClient   httpclient.Client
+}
+
+// NewMentorAnnouncementService creates a new mentor announcement service
+func NewMentorAnnouncementService(
+	mentorRepo *repository.MentorRepository,
+	yandexClient *yandex.StorageClient,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *MentorAnnouncementService {
+
+	return &MentorAnnouncementService{
+		mentorRepo:   mentorRepo,
+		yandexClient: yandexClient,
+		config:       cfg,
+		httpClient:   httpClient,
+	}
+}
+
+// AnnounceMentor posts the mentor once they are active. Every mentor is announced
+// at most once, so re-approvals and status toggles don't post again.
+// Failures are logged and never affect the moderation action that caused the call.
+func (s *MentorAnnouncementService) AnnounceMentor(ctx context.Context, mentorID string) {
+	triggerURL := s.config.EventTriggers.MentorChannelPostTriggerURL
+	if triggerURL == "" {
+		return
+	}
+
+	mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true})
+	if err != nil {
+		logger.Error("Failed to load mentor for channel announcement", zap.Error(err), zap.String("mentor_id", mentorID))
+		metrics.MentorChannelPosts.WithLabelValues("error").Inc()
+		return
+	}
+	if mentor.Status != mentorStatusActive {
+		return
+	}
+
+	claimed, err := s.mentorRepo.ClaimChannelPost(ctx, mentorID)
+	if err != nil {
+		logger.Error("Failed to claim channel announcement", zap.Error(err), zap.String("mentor_id", mentorID))
+		metrics.MentorChannelPosts.WithLabelValues("error").Inc()
+		return
+	}
+	if !claimed {
+		metrics.MentorChannelPosts.WithLabelValues("duplicate").Inc()
+		return
+	}
+
+	var photoURL string
+	if s.yandexClient != nil {
+		photoURL = s.yandexClient.PublicURL(mentor.Slug + "/full")
+	}
+	announcement := models.NewMentorAnnouncement(mentor, s.config.Server.BaseURL+"/mentor/"+mentor.Slug, photoURL)
+
+	trigger.CallAsyncWithPayload(triggerURL, announcement, s.httpClient)
+	metrics.MentorChannelPosts.WithLabelValues("posted").Inc()
+	logger.Info("Mentor channel announcement sent", zap.String("mentor_id", mentorID))
+}
@@ -30,16 +30,18 @@ var (
 
 // MentorAuthService handles mentor authentication
 type MentorAuthService struct {
-	mentorRepo   *repository.MentorRepository
-	config       *config.Config
-	tokenManager *jwt.TokenManager
-	httpClient   httpclient.Client
-	tracker      analytics.Tracker
+	mentorRepo     *repository.MentorRepository
+	deviceSessions *MentorDeviceSessionService
+	config         *config.Config
+	tokenManager   *jwt.TokenManager
+	httpClient     httpclient.Client
+	tracker        analytics.Tracker
 }
 
 // NewMentorAuthService creates a new MentorAuthService
 func NewMentorAuthService(
 	mentorRepo *repository.MentorRepository,
+	deviceSessions *MentorDeviceSessionService,
 	cfg *config.Config,
 	httpClient httpclient.Client,
 	tracker analytics.Tracker,
@@ -59,11 +61,12 @@ func NewMentorAuthService(
 	}
 
 	return &MentorAuthService{
-		mentorRepo:   mentorRepo,
-		config:       cfg,
-		tokenManager: tokenManager,
-		httpClient:   httpClient,
-		tracker:      tracker,
+		mentorRepo:     mentorRepo,
+		deviceSessions: deviceSessions,
+		config:         cfg,
+		tokenManager:   tokenManager,
+		httpClient:     httpClient,
+		tracker:        tracker,
 	}
 }
 
@@ -167,8 +170,8 @@ func (s *MentorAuthService) RequestLogin(ctx context.Context, email string) (*mo
 	}, nil
 }
 
-// VerifyLogin verifies a login token and creates a session
-func (s *MentorAuthService) VerifyLogin(ctx context.Context, token string) (*models.MentorSession, string, error) {
+// VerifyLogin verifies a login token and creates a session bound to the client's device
+func (s *MentorAuthService) VerifyLogin(ctx context.Context, token string, client models.DeviceClient) (*models.MentorSession, string, error) {
 	start := time.Now()
 
 	if s.tokenManager == nil {
@@ -228,8 +231,25 @@ func (s *MentorAuthService) VerifyLogin(ctx context.Context, token string) (*mod
 		// Continue with login even if clearing fails
 	}
 
+	now := time.Now()
+	expiresAt := now.Add(s.tokenManager.GetExpirationTime())
+
+	// Track the device session; its ID becomes the JWT ID so it can be revoked
+	sessionID, err := s.deviceSessions.StartSession(ctx, mentor.MentorID, client, expiresAt)
+	if err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorAuthLoginVerified, analytics.MentorDistinctID(mentor.MentorID), map[string]interface{}{
+			"mentor_id": mentor.MentorID,
+			"outcome":   "session_failed",
+		})
+		logger.Error("Failed to start device session",
+			zap.String("mentor_id", mentor.MentorID),
+			zap.Error(err))
+		metrics.MentorAuthVerifyRequests.WithLabelValues("session_failed").Inc()
+		return nil, "", fmt.Errorf("failed to start session: %w", err)
+	}
+
 	// Generate JWT session token
-	jwtToken, err := s.tokenManager.GenerateToken(mentor.MentorID, mentor.LegacyID, "", mentor.Name)
+	jwtToken, err := s.tokenManager.GenerateSessionToken(sessionID, mentor.MentorID, mentor.LegacyID, "", mentor.Name)
 	if err != nil {
 		s.tracker.Track(ctx, analytics.EventMentorAuthLoginVerified, analytics.MentorDistinctID(mentor.MentorID), map[string]interface{}{
 			"mentor_id": mentor.MentorID,
@@ -242,14 +262,14 @@ func (s *MentorAuthService) VerifyLogin(ctx context.Context, token string) (*mod
 		return nil, "", fmt.Errorf("failed to generate session: %w", err)
 	}
 
-	now := time.Now()
 	session := &models.MentorSession{
 		LegacyID:  mentor.LegacyID,
 		MentorID:  mentor.MentorID,
 		Email:     "",
 		Name:      mentor.Name,
-		ExpiresAt: now.Add(s.tokenManager.GetExpirationTime()).Unix(),
+		ExpiresAt: expiresAt.Unix(),
 		IssuedAt:  now.Unix(),
+		SessionID: sessionID,
 	}
 
 	duration := metrics.MeasureDuration(start)
@@ -270,6 +290,24 @@ func (s *MentorAuthService) VerifyLogin(ctx context.Context, token string) (*mod
 	return session, jwtToken, nil
 }
 
+// Logout revokes the device session behind the JWT. Invalid, expired and untracked
+// tokens have nothing to revoke; the caller clears the cookie either way.
+func (s *MentorAuthService) Logout(ctx context.Context, jwtToken string) error {
+	if s.tokenManager == nil || jwtToken == "" {
+		return nil
+	}
+	claims, err := s.tokenManager.ValidateToken(jwtToken)
+	if err != nil || claims.ID == "" {
+		return nil
+	}
+
+	err = s.deviceSessions.RevokeSession(ctx, claims.MentorUUID, claims.ID)
+	if errors.Is(err, repository.ErrDeviceSessionNotFound) {
+		return nil
+	}
+	return err
+}
+
 // GetSessionTTL returns the session TTL in seconds
 func (s *MentorAuthService) GetSessionTTL() int {
 	return s.config.MentorSession.SessionTTLHours * 3600
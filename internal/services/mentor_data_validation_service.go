@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// mentorDataCheckWorkers bounds the calendar and photo checks running at once
+	mentorDataCheckWorkers = 8
+	// calendarCheckTimeout bounds a single calendar link request
+	calendarCheckTimeout = 10 * time.Second
+)
+
+// PhotoStorage reports whether a profile picture is stored
+type PhotoStorage interface {
+	ObjectExists(ctx context.Context, key string) (bool, error)
+}
+
+// MentorDataValidationService scans mentor profiles for data quality problems: broken calendar
+// links, missing photos, empty competencies and invalid Telegram handles. Found issues can be
+// flagged in PostgreSQL, where they make up the admin moderation queue, and in Airtable.
+type MentorDataValidationService struct {
+	repo    *repository.MentorDataIssueRepository
+	storage PhotoStorage // nil skips the photo check
+	// httpClient refuses links resolving to internal addresses: the URLs come from mentors
+	httpClient httpclient.Client
+}
+
+// NewMentorDataValidationService creates a new MentorDataValidationService
+func NewMentorDataValidationService(
+	repo *repository.MentorDataIssueRepository,
+	storage PhotoStorage,
+) *MentorDataValidationService {
+
+	return &MentorDataValidationService{
+		repo:       repo,
+		storage:    storage,
+		httpClient: ical.NewGuardedClient(calendarCheckTimeout),
+	}
+}
+
+// Validate checks every mentor that is not deleted or declined. With checkLinks false the
+// calendar links are only checked to be well-formed, not requested.
+func (s *MentorDataValidationService) Validate(ctx context.Context, checkLinks bool) (*models.MentorDataReport, []*models.MentorDataSubject, error) {
+	subjects, err := s.repo.ListSubjects(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &models.MentorDataReport{
+		Scanned: len(subjects),
+		Checks:  []string{models.MentorDataCheckCalendarURL, models.MentorDataCheckCompetencies, models.MentorDataCheckTelegram},
+		Counts:  map[string]int{},
+		Issues:  []*models.MentorDataIssue{},
+	}
+	if s.storage != nil {
+		report.Checks = append(report.Checks, models.MentorDataCheckPhoto)
+	} else {
+		logger.Warn("Object storage not configured, skipping the photo check")
+	}
+
+	var mu sync.Mutex
+	add := func(issues ...*models.MentorDataIssue) {
+		mu.Lock()
+		defer mu.Unlock()
+		report.Issues = append(report.Issues, issues...)
+	}
+
+	queue := make(chan *models.MentorDataSubject)
+	var wg sync.WaitGroup
+	for i := 0; i < mentorDataCheckWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for subject := range queue {
+				add(s.checkSubject(ctx, subject, checkLinks)...)
+			}
+		}()
+	}
+	for _, subject := range subjects {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- subject
+	}
+	close(queue)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(report.Issues, func(i, j int) bool {
+		a, b := report.Issues[i], report.Issues[j]
+		if a.Slug != b.Slug {
+			return a.Slug < b.Slug
+		}
+		return a.Check < b.Check
+	})
+	for _, issue := range report.Issues {
+		report.Counts[issue.Check]++
+	}
+	return report, subjects, nil
+}
+
+// checkSubject runs all checks on one mentor
+func (s *MentorDataValidationService) checkSubject(ctx context.Context, subject *models.MentorDataSubject, checkLinks bool) []*models.MentorDataIssue {
+	issues := models.CheckMentorData(subject)
+	issue := func(check, detail string) *models.MentorDataIssue {
+		return &models.MentorDataIssue{MentorID: subject.MentorID, Slug: subject.Slug, Name: subject.Name, Check: check, Detail: detail}
+	}
+
+	if checkLinks && models.ValidCalendarURL(subject.CalendarURL) {
+		if detail := probeCalendarURL(ctx, s.httpClient, subject.CalendarURL, calendarCheckTimeout); detail != "" {
+			issues = append(issues, issue(models.MentorDataCheckCalendarURL, detail))
+		}
+	}
+
+	if s.storage != nil {
+		exists, err := s.storage.ObjectExists(ctx, subject.Slug+"/full")
+		switch {
+		case err != nil:
+			// An unreachable bucket says nothing about the mentor
+			logger.Warn("Failed to check mentor photo", zap.String("slug", subject.Slug), zap.Error(err))
+		case !exists:
+			issues = append(issues, issue(models.MentorDataCheckPhoto, ""))
+		}
+	}
+	return issues
+}
+
+// Flag records the report in PostgreSQL: found issues join the admin queue and issues of the
+// checks that ran but were not found again are resolved
+func (s *MentorDataValidationService) Flag(ctx context.Context, report *models.MentorDataReport) error {
+	resolved, err := s.repo.Sync(ctx, report.Checks, report.Issues)
+	if err != nil {
+		return err
+	}
+	report.Resolved = resolved
+	return nil
+}
+
+// FlagAirtable writes the names of each mentor's failed checks, comma separated, into a field of
+// the legacy Airtable mentors table, and clears it for mentors without issues. Mentors that were
+// never in Airtable are skipped. Returns the number of updated records.
+func (s *MentorDataValidationService) FlagAirtable(
+	ctx context.Context,
+	client *airtable.Client,
+	table, field string,
+	subjects []*models.MentorDataSubject,
+	report *models.MentorDataReport,
+) (int, error) {
+
+	checks := map[string][]string{}
+	for _, issue := range report.Issues {
+		checks[issue.MentorID] = append(checks[issue.MentorID], issue.Check)
+	}
+
+	records := []airtable.Record{}
+	for _, subject := range subjects {
+		if subject.AirtableID == nil || *subject.AirtableID == "" {
+			continue
+		}
+		records = append(records, airtable.Record{
+			ID:     *subject.AirtableID,
+			Fields: map[string]interface{}{field: strings.Join(checks[subject.MentorID], ", ")},
+		})
+	}
+
+	for startIdx := 0; startIdx < len(records); startIdx += airtable.MaxBatch {
+		chunk := records[startIdx:min(startIdx+airtable.MaxBatch, len(records))]
+		if err := client.Update(ctx, table, chunk); err != nil {
+			return startIdx, err
+		}
+	}
+	return len(records), nil
+}
+
+// ListOpenIssues returns the open data issues queue. Available to moderators and admins.
+func (s *MentorDataValidationService) ListOpenIssues(ctx context.Context, session *models.AdminSession) ([]*models.MentorDataIssue, error) {
+	return s.repo.ListOpen(ctx)
+}
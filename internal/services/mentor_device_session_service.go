@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// MentorDeviceSessionService tracks mentor portal logins per device
+type MentorDeviceSessionService struct {
+	repo       *repository.MentorDeviceSessionRepository
+	config     *config.Config
+	httpClient httpclient.Client
+	tracker    analytics.Tracker
+}
+
+// NewMentorDeviceSessionService creates a new device session service
+func NewMentorDeviceSessionService(
+	repo *repository.MentorDeviceSessionRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+) *MentorDeviceSessionService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	return &MentorDeviceSessionService{
+		repo:       repo,
+		config:     cfg,
+		httpClient: httpClient,
+		tracker:    tracker,
+	}
+}
+
+// StartSession records a login and returns the session ID to embed in the JWT.
+// A login from a User-Agent the mentor never used before triggers a new device notice;
+// the very first tracked login does not, as there is nothing to compare it with.
+func (s *MentorDeviceSessionService) StartSession(ctx context.Context, mentorID string, client models.DeviceClient, expiresAt time.Time) (string, error) {
+	client.UserAgent = models.NormalizeUserAgent(client.UserAgent)
+
+	known, sameDevice, err := s.repo.HasSessions(ctx, mentorID, client.UserAgent)
+	if err != nil {
+		return "", err
+	}
+
+	sessionID, err := s.repo.Create(ctx, mentorID, client, expiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	if known && !sameDevice {
+		s.notifyNewDevice(mentorID, sessionID, client)
+		s.tracker.Track(ctx, analytics.EventMentorNewDeviceLogin, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+		})
+		logger.Info("Mentor logged in from a new device",
+			zap.String("mentor_id", mentorID),
+			zap.String("session_id", sessionID))
+	}
+
+	return sessionID, nil
+}
+
+// ListSessions returns the mentor's active sessions, marking the one with currentSessionID
+func (s *MentorDeviceSessionService) ListSessions(ctx context.Context, mentorID, currentSessionID string) ([]models.MentorDeviceSession, error) {
+	sessions, err := s.repo.ListActive(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sessions {
+		sessions[i].Current = currentSessionID != "" && sessions[i].ID == currentSessionID
+	}
+	return sessions, nil
+}
+
+// RevokeSession logs the mentor out on one device
+func (s *MentorDeviceSessionService) RevokeSession(ctx context.Context, mentorID, sessionID string) error {
+	err := s.repo.Revoke(ctx, mentorID, sessionID)
+	outcome := "success"
+	if errors.Is(err, repository.ErrDeviceSessionNotFound) {
+		outcome = "not_found"
+	} else if err != nil {
+		outcome = "error"
+	}
+	s.tracker.Track(ctx, analytics.EventMentorSessionRevoked, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+		"mentor_id": mentorID,
+		"outcome":   outcome,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Mentor device session revoked",
+		zap.String("mentor_id", mentorID),
+		zap.String("session_id", sessionID))
+	return nil
+}
+
+// IsSessionActive reports whether the session was neither revoked nor expired
+func (s *MentorDeviceSessionService) IsSessionActive(ctx context.Context, mentorID, sessionID string) (bool, error) {
+	active, err := s.repo.Touch(ctx, mentorID, sessionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check device session: %w", err)
+	}
+	return active, nil
+}
+
+func (s *MentorDeviceSessionService) notifyNewDevice(mentorID, sessionID string, client models.DeviceClient) {
+	if s.config.EventTriggers.MentorNewDeviceLoginTriggerURL == "" {
+		return
+	}
+	payload := map[string]interface{}{
+		"type":       "mentor_new_device_login",
+		"mentor_id":  mentorID,
+		"session_id": sessionID,
+		"user_agent": client.UserAgent,
+		"ip":         client.IP,
+		"logged_in":  time.Now().UTC(),
+	}
+	trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorNewDeviceLoginTriggerURL, payload, s.httpClient)
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// importFieldColumns maps MentorImportRow fields to their CSV columns for error messages
+var importFieldColumns = map[string]string{
+	"Name": "name", "Email": "email", "Telegram": "telegram", "Job": "job", "Workplace": "workplace",
+	"Experience": "experience", "Price": "price", "Tags": "tags", "About": "about",
+	"Description": "description", "Competencies": "competencies", "CalendarURL": "calendar_url",
+	"Country": "country", "City": "city", "RemoteOnly": "remote_only", "Languages": "languages",
+}
+
+// MentorImportService creates pending mentors in bulk, e.g. after partner events.
+// Every row goes through the registration rules and the blocklist; rows are saved
+// one by one, so an invalid row doesn't stop the others.
+type MentorImportService struct {
+	mentorRepo *repository.MentorRepository
+	uow        *repository.UnitOfWork
+	blocklist  *BlocklistService
+	rules      *ModerationRulesService
+	config     *config.Config
+	httpClient httpclient.Client
+}
+
+// NewMentorImportService creates a new mentor import service
+func NewMentorImportService(
+	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	blocklist *BlocklistService,
+	rules *ModerationRulesService,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *MentorImportService {
+
+	return &MentorImportService{
+		mentorRepo: mentorRepo,
+		uow:        uow,
+		blocklist:  blocklist,
+		rules:      rules,
+		config:     cfg,
+		httpClient: httpClient,
+	}
+}
+
+// ParseMentorImportCSV reads mentors from a CSV file with a header of
+// models.MentorImportColumns in any order. Missing optional columns are allowed.
+func ParseMentorImportCSV(r io.Reader) ([]models.MentorImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, apperrors.InvalidInputError("file", "is empty")
+	}
+	if err != nil {
+		return nil, apperrors.InvalidInputError("file", err.Error())
+	}
+
+	known := map[string]bool{}
+	for _, column := range models.MentorImportColumns {
+		known[column] = true
+	}
+	index := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !known[column] {
+			return nil, apperrors.InvalidInputError("file", fmt.Sprintf("unknown column %q", column))
+		}
+		index[column] = i
+	}
+	for _, column := range []string{"name", "email"} {
+		if _, ok := index[column]; !ok {
+			return nil, apperrors.InvalidInputError("file", fmt.Sprintf("column %q is required", column))
+		}
+	}
+
+	var rows []models.MentorImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, apperrors.InvalidInputError("file", err.Error())
+		}
+		if len(rows) == models.MaxMentorImportRows {
+			return nil, apperrors.InvalidInputError("file", fmt.Sprintf("has more than %d rows", models.MaxMentorImportRows))
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := index[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		rows = append(rows, models.MentorImportRow{
+			Line:         line,
+			Name:         value("name"),
+			Email:        strings.ToLower(value("email")),
+			Telegram:     value("telegram"),
+			Job:          value("job"),
+			Workplace:    value("workplace"),
+			Experience:   value("experience"),
+			Price:        value("price"),
+			Tags:         splitImportList(value("tags")),
+			About:        value("about"),
+			Description:  value("description"),
+			Competencies: value("competencies"),
+			CalendarURL:  value("calendar_url"),
+			Country:      value("country"),
+			City:         value("city"),
+			RemoteOnly:   parseImportBool(value("remote_only")),
+			Languages:    splitImportList(value("languages")),
+		})
+	}
+	if len(rows) == 0 {
+		return nil, apperrors.InvalidInputError("file", "has no rows")
+	}
+	return rows, nil
+}
+
+// Import validates every row and creates the valid ones as pending mentors. In dry-run
+// mode each row is created and rolled back, and the rows that saved are reported as valid.
+func (s *MentorImportService) Import(ctx context.Context, rows []models.MentorImportRow) *models.MentorImportReport {
+	report := &models.MentorImportReport{
+		DryRun: IsDryRun(ctx),
+		Total:  len(rows),
+		Rows:   make([]models.MentorImportRowResult, 0, len(rows)),
+	}
+
+	rules := s.rules.Rules(ctx)
+	seen := map[string]int{}
+	for i := range rows {
+		row := &rows[i]
+		result := models.MentorImportRowResult{Line: row.Line, Email: row.Email}
+
+		fields, tagIDs, errs := s.validateRow(ctx, &rules, row)
+		if first, ok := seen[row.Email]; ok && row.Email != "" {
+			errs = append(errs, fmt.Sprintf("email: duplicates line %d", first))
+		} else {
+			seen[row.Email] = row.Line
+		}
+
+		switch {
+		case len(errs) > 0:
+			result.Status = models.MentorImportInvalid
+			result.Errors = errs
+			report.Invalid++
+		default:
+			mentorID, slug, err := s.create(ctx, fields, tagIDs)
+			switch {
+			case err != nil:
+				logger.Error("Failed to import mentor", zap.Error(err), zap.Int("line", row.Line), zap.Bool("dry_run", report.DryRun))
+				result.Status = models.MentorImportFailed
+				result.Errors = []string{"failed to save the mentor"}
+				report.Failed++
+			case report.DryRun:
+				result.Status = models.MentorImportValid
+				report.Valid++
+			default:
+				result.Status = models.MentorImportCreated
+				result.MentorID = mentorID
+				result.Slug = slug
+				report.Created++
+			}
+		}
+		metrics.MentorImportRows.WithLabelValues(result.Status).Inc()
+		report.Rows = append(report.Rows, result)
+	}
+
+	logger.Info("Mentor import finished",
+		zap.Bool("dry_run", report.DryRun),
+		zap.Int("total", report.Total),
+		zap.Int("created", report.Created),
+		zap.Int("valid", report.Valid),
+		zap.Int("invalid", report.Invalid),
+		zap.Int("failed", report.Failed))
+	return report
+}
+
+// validateRow applies the registration rules and returns the mentor fields and tag IDs
+// of a valid row, or the reasons it is invalid
+func (s *MentorImportService) validateRow(
+	ctx context.Context,
+	rules *models.ModerationRules,
+	row *models.MentorImportRow,
+) (map[string]interface{}, []string, []string) {
+
+	var errs []string
+	if err := binding.Validator.ValidateStruct(row); err != nil {
+		var fieldErrors validator.ValidationErrors
+		if !errors.As(err, &fieldErrors) {
+			return nil, nil, []string{err.Error()}
+		}
+		for _, fe := range fieldErrors {
+			column := importFieldColumns[fe.StructField()]
+			if column == "" {
+				column = fe.Field()
+			}
+			if fe.Param() != "" {
+				errs = append(errs, fmt.Sprintf("%s: fails %s=%s", column, fe.Tag(), fe.Param()))
+			} else {
+				errs = append(errs, fmt.Sprintf("%s: fails %s", column, fe.Tag()))
+			}
+		}
+		return nil, nil, errs
+	}
+
+	if len(row.Tags) > rules.MaxTags {
+		errs = append(errs, fmt.Sprintf("tags: must contain at most %d items", rules.MaxTags))
+	}
+	if word := rules.FindBannedWord(row.Name, row.Job, row.Workplace, row.About, row.Description, row.Competencies); word != "" {
+		errs = append(errs, fmt.Sprintf("contains the banned word %q", word))
+	}
+
+	fields := map[string]interface{}{
+		"name":         row.Name,
+		"email":        row.Email,
+		"telegram":     normalizeTelegramHandle(row.Telegram),
+		"job_title":    row.Job,
+		"workplace":    row.Workplace,
+		"experience":   row.Experience,
+		"price":        row.Price,
+		"about":        row.About,
+		"details":      row.Description,
+		"competencies": row.Competencies,
+		"status":       registrationStatusPending,
+		"remote_only":  row.RemoteOnly,
+	}
+	if row.CalendarURL != "" {
+		fields["calendar_url"] = row.CalendarURL
+	}
+	if row.City != "" {
+		fields["city"] = row.City
+	}
+	if country, err := models.NormalizeCountryCode(row.Country); err != nil {
+		errs = append(errs, "country: "+err.Error())
+	} else if country != "" {
+		fields["country"] = country
+	}
+	if languages, err := models.NormalizeLanguages(row.Languages); err != nil {
+		errs = append(errs, "languages: "+err.Error())
+	} else if len(languages) > 0 {
+		fields["languages"] = languages
+	}
+
+	// Registration skips unknown tags; an import reports them so typos get fixed
+	tagIDs := make([]string, 0, len(row.Tags))
+	for _, tagName := range row.Tags {
+		tagID, err := s.mentorRepo.GetTagIDByName(ctx, tagName)
+		if err != nil || tagID == "" {
+			errs = append(errs, fmt.Sprintf("tags: unknown tag %q", tagName))
+			continue
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	if registered, err := s.mentorRepo.IsEmailRegistered(ctx, row.Email); err != nil {
+		errs = append(errs, "email: "+err.Error())
+	} else if registered {
+		errs = append(errs, "email: a mentor with this email already exists")
+	}
+
+	entry, err := s.blocklist.Check(ctx, "import", models.BlocklistSubject{Email: row.Email, Telegram: row.Telegram})
+	if err != nil {
+		logger.Error("Blocklist check failed", zap.Error(err))
+	}
+	if entry != nil && entry.Action == models.BlocklistActionReject {
+		errs = append(errs, "blocked by the blocklist")
+	}
+
+	return fields, tagIDs, errs
+}
+
+// create saves a mentor with its tags like a registration does and announces it after commit.
+// Each row has its own unit of work, so a dry run rolls back one row without aborting the others.
+func (s *MentorImportService) create(ctx context.Context, fields map[string]interface{}, tagIDs []string) (string, string, error) {
+	var mentorID, slug string
+	err := runUnitOfWork(ctx, s.uow, func(txCtx context.Context) error {
+		var err error
+		if mentorID, _, slug, err = s.mentorRepo.CreateMentor(txCtx, fields); err != nil {
+			return err
+		}
+		if len(tagIDs) > 0 {
+			if err := s.mentorRepo.UpdateMentorTags(txCtx, mentorID, tagIDs); err != nil {
+				return fmt.Errorf("failed to set mentor tags: %w", err)
+			}
+		}
+		repository.AfterCommit(txCtx, func() {
+			trigger.CallAsync(s.config.EventTriggers.MentorCreatedTriggerURL, mentorID, s.httpClient)
+		})
+		return nil
+	})
+	return mentorID, slug, err
+}
+
+// splitImportList splits a ";"-separated cell, dropping empty items
+func splitImportList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseImportBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "yes", "1", "да":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// MentorInsightsService periodically aggregates each mentor's decline reasons, review
+// sentiment and response times into a private insights report. Only counts are kept,
+// and breakdowns of small samples are hidden so no single mentee can be singled out.
+// The response time SLA is paused while the mentor is on a vacation from their calendar feed.
+type MentorInsightsService struct {
+	repo         *repository.MentorInsightsRepository
+	uow          *repository.UnitOfWork
+	availability *AvailabilityService
+	config       *config.Config
+}
+
+// NewMentorInsightsService creates a new mentor insights service
+func NewMentorInsightsService(
+	repo *repository.MentorInsightsRepository,
+	uow *repository.UnitOfWork,
+	availability *AvailabilityService,
+	cfg *config.Config,
+) *MentorInsightsService {
+
+	return &MentorInsightsService{
+		repo:         repo,
+		uow:          uow,
+		availability: availability,
+		config:       cfg,
+	}
+}
+
+// Start recomputes insights in the background now and then every configured interval
+func (s *MentorInsightsService) Start() {
+	go func() {
+		s.refreshAndLog()
+
+		ticker := time.NewTicker(time.Duration(s.config.MentorInsights.RefreshHours) * time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.refreshAndLog()
+		}
+	}()
+}
+
+func (s *MentorInsightsService) refreshAndLog() {
+	start := time.Now()
+	mentors, err := s.Refresh(context.Background())
+	if err != nil {
+		metrics.MentorInsightsRefreshes.WithLabelValues("error").Inc()
+		logger.Error("Mentor insights refresh failed", zap.Error(err))
+		return
+	}
+	metrics.MentorInsightsRefreshes.WithLabelValues("success").Inc()
+	logger.Info("Mentor insights refresh completed",
+		zap.Int("mentors", mentors),
+		zap.Duration("duration", time.Since(start)))
+}
+
+// Refresh recomputes the insights of every mentor with requests or reviews in the window
+// and returns how many mentors have insights
+func (s *MentorInsightsService) Refresh(ctx context.Context) (int, error) {
+	cfg := s.config.MentorInsights
+	now := time.Now()
+	since := now.AddDate(0, 0, -cfg.WindowDays)
+
+	// Calendar feeds are read before the refresh lock is taken, not to hold it over the fetches
+	slaStarts, err := s.slaStarts(ctx, since)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Lock(ctx); err != nil {
+			return err
+		}
+
+		stats, err := s.repo.ListRequestStats(ctx, since, cfg.ResponseSLAHours, slaStarts)
+		if err != nil {
+			return err
+		}
+		reviews, err := s.repo.ListReviewTexts(ctx, since)
+		if err != nil {
+			return err
+		}
+
+		byMentor := make(map[string]*models.MentorInsights, len(stats))
+		insights := make([]*models.MentorInsights, 0, len(stats))
+		add := func(mentorID string) *models.MentorInsights {
+			i := &models.MentorInsights{
+				MentorRequestStats: models.MentorRequestStats{MentorID: mentorID, DeclineReasons: map[string]int{}},
+				WindowDays:         cfg.WindowDays,
+				SLAHours:           cfg.ResponseSLAHours,
+			}
+			byMentor[mentorID] = i
+			insights = append(insights, i)
+			return i
+		}
+
+		for _, st := range stats {
+			add(st.MentorID).MentorRequestStats = *st
+		}
+		for _, review := range reviews {
+			i, ok := byMentor[review.MentorID]
+			if !ok {
+				i = add(review.MentorID)
+			}
+			i.AddReview(models.ScoreReviewSentiment(review.Text))
+		}
+
+		count = len(insights)
+		return s.repo.Replace(ctx, insights, now)
+	})
+	return count, err
+}
+
+// slaStarts returns when the SLA timer starts for the requests that arrived while their mentor
+// was paused: the end of the vacation
+func (s *MentorInsightsService) slaStarts(ctx context.Context, since time.Time) (map[string]time.Time, error) {
+	requests, err := s.repo.ListRequestCalendars(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	starts := map[string]time.Time{}
+	for _, request := range requests {
+		mentor := &models.Mentor{MentorID: request.MentorID, CalendarURL: request.CalendarURL, Timezone: request.Timezone}
+		availability := s.availability.BuildAvailability(ctx, mentor, request.CreatedAt)
+		if availability.IsPausedAt(request.CreatedAt) {
+			starts[request.RequestID] = *availability.PausedUntil
+		}
+	}
+	return starts, nil
+}
+
+// GetReport returns the mentor's insights report. Mentors without requests or reviews in
+// the window get an empty report.
+func (s *MentorInsightsService) GetReport(ctx context.Context, mentorID string) (*models.MentorInsightsReport, error) {
+	insights, err := s.repo.Get(ctx, mentorID)
+	if errors.Is(err, repository.ErrMentorInsightsNotFound) {
+		insights = &models.MentorInsights{
+			WindowDays: s.config.MentorInsights.WindowDays,
+			SLAHours:   s.config.MentorInsights.ResponseSLAHours,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	return insights.Report(), nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// MentorMergeService merges duplicate mentor records (the same person registered twice)
+type MentorMergeService struct {
+	mergeRepo  *repository.MentorMergeRepository
+	mentorRepo *repository.MentorRepository
+	uow        *repository.UnitOfWork
+	tracker    analytics.Tracker
+}
+
+// NewMentorMergeService creates a new MentorMergeService
+func NewMentorMergeService(
+	mergeRepo *repository.MentorMergeRepository,
+	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	tracker analytics.Tracker,
+) *MentorMergeService {
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+	return &MentorMergeService{
+		mergeRepo:  mergeRepo,
+		mentorRepo: mentorRepo,
+		uow:        uow,
+		tracker:    tracker,
+	}
+}
+
+// MergeMentors moves everything of the duplicate mentor to the primary one and soft-deletes
+// the duplicate. Admin only; the merge is recorded in mentor_merges and in the log. In dry-run
+// mode the merge is rolled back and the result reports what it would have moved.
+func (s *MentorMergeService) MergeMentors(ctx context.Context, session *models.AdminSession, req *models.MergeMentorsRequest) (*models.MentorMergeResult, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.trackMerge(ctx, session, req, "forbidden")
+		return nil, ErrAdminForbiddenAction
+	}
+	if req.PrimaryID == req.DuplicateID {
+		s.trackMerge(ctx, session, req, "same_mentor")
+		return nil, fmt.Errorf("%w: a mentor can't be merged into itself", apperrors.ErrInvalidInput)
+	}
+
+	var result *models.MentorMergeResult
+	err := runUnitOfWork(ctx, s.uow, func(txCtx context.Context) error {
+		merged, err := s.mergeRepo.Merge(txCtx, req.PrimaryID, req.DuplicateID, session.ModeratorID)
+		if err != nil {
+			return err
+		}
+		result = merged
+
+		repository.AfterCommit(txCtx, func() {
+			if err := s.mentorRepo.RemoveMentorFromCache(merged.DuplicateSlug); err != nil {
+				logger.Warn("Failed to remove merged mentor from cache", zap.Error(err), zap.String("mentor_slug", merged.DuplicateSlug))
+			}
+			if err := s.mentorRepo.UpdateSingleMentorCache(merged.PrimarySlug); err != nil {
+				logger.Warn("Failed to refresh merged mentor in cache", zap.Error(err), zap.String("mentor_slug", merged.PrimarySlug))
+			}
+		})
+		return nil
+	})
+	switch {
+	case errors.Is(err, repository.ErrMergeMentorNotFound):
+		s.trackMerge(ctx, session, req, "mentor_not_found")
+		return nil, err
+	case errors.Is(err, repository.ErrMentorAlreadyMerged):
+		s.trackMerge(ctx, session, req, "already_merged")
+		return nil, err
+	case err != nil:
+		s.trackMerge(ctx, session, req, "merge_failed")
+		logger.Error("Failed to merge mentors", zap.Error(err),
+			zap.String("primary_mentor_id", req.PrimaryID),
+			zap.String("duplicate_mentor_id", req.DuplicateID))
+		return nil, err
+	}
+
+	s.trackMerge(ctx, session, req, "success")
+	if IsDryRun(ctx) {
+		return result, nil
+	}
+	logger.Info("Mentors merged",
+		zap.String("merge_id", result.ID),
+		zap.String("actor", analytics.ModeratorDistinctID(session.ModeratorID)),
+		zap.String("primary_mentor_id", result.PrimaryID),
+		zap.String("duplicate_mentor_id", result.DuplicateID),
+		zap.Int64("tags_added", result.TagsAdded),
+		zap.Int64("requests_moved", result.RequestsMoved),
+		zap.Int64("programs_moved", result.ProgramsMoved),
+		zap.Int64("templates_moved", result.TemplatesMoved),
+		zap.Int64("reports_moved", result.ReportsMoved),
+		zap.Bool("airtable_id_moved", result.AirtableIDMoved))
+
+	return result, nil
+}
+
+// trackMerge sends an analytics event unless the call runs in dry-run mode
+func (s *MentorMergeService) trackMerge(ctx context.Context, session *models.AdminSession, req *models.MergeMentorsRequest, outcome string) {
+	if IsDryRun(ctx) {
+		return
+	}
+	s.tracker.Track(ctx, analytics.EventAdminMentorsMerged, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":        session.ModeratorID,
+		"moderator_role":      string(session.Role),
+		"primary_mentor_id":   req.PrimaryID,
+		"duplicate_mentor_id": req.DuplicateID,
+		"outcome":             outcome,
+	})
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/recaptcha"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrQuestionMentorNotFound is returned when the mentor doesn't exist or isn't published
+	ErrQuestionMentorNotFound = errors.New("mentor not found")
+	// ErrQuestionInboxFull is returned when the mentor has too many unanswered questions
+	ErrQuestionInboxFull = errors.New("mentor has too many unanswered questions")
+)
+
+// MentorQuestionService accepts anonymous questions to mentors and lets mentors answer
+// or reject them. Only answered questions are public.
+type MentorQuestionService struct {
+	repo              *repository.MentorQuestionRepository
+	mentorRepo        *repository.MentorRepository
+	notifications     *NotificationDispatcher
+	recaptchaVerifier *recaptcha.Verifier
+}
+
+// NewMentorQuestionService creates a new mentor question service
+func NewMentorQuestionService(
+	repo *repository.MentorQuestionRepository,
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	notifications *NotificationDispatcher,
+) *MentorQuestionService {
+
+	return &MentorQuestionService{
+		repo:              repo,
+		mentorRepo:        mentorRepo,
+		notifications:     notifications,
+		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+	}
+}
+
+// AskQuestion queues an anonymous question for the mentor with the given legacy ID
+func (s *MentorQuestionService) AskQuestion(ctx context.Context, mentorLegacyID int, req *models.AskMentorQuestionRequest) (*models.AskMentorQuestionResponse, error) {
+	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
+		metrics.MentorQuestions.WithLabelValues("captcha_failed").Inc()
+		logger.Warn("ReCAPTCHA verification failed", zap.Error(err))
+		return &models.AskMentorQuestionResponse{
+			Success: false,
+			Error:   "Captcha verification failed",
+		}, fmt.Errorf("captcha verification failed: %w", err)
+	}
+
+	mentor, err := s.mentorRepo.GetByID(ctx, mentorLegacyID, models.FilterOptions{OnlyVisible: true})
+	if err != nil {
+		return nil, ErrQuestionMentorNotFound
+	}
+
+	pending, err := s.repo.CountPending(ctx, mentor.MentorID)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= models.MaxPendingQuestionsPerMentor {
+		metrics.MentorQuestions.WithLabelValues("inbox_full").Inc()
+		return nil, ErrQuestionInboxFull
+	}
+
+	questionID, err := s.repo.Create(ctx, mentor.MentorID, strings.TrimSpace(req.Question))
+	if err != nil {
+		logger.Error("Failed to create mentor question", zap.Error(err), zap.String("mentor_id", mentor.MentorID))
+		return nil, err
+	}
+	metrics.MentorQuestions.WithLabelValues("submitted").Inc()
+
+	s.notifications.Notify(ctx, &models.MentorNotification{
+		MentorID: mentor.MentorID,
+		Channel:  models.NotificationChannelMentorQuestion,
+		Payload: map[string]interface{}{
+			"type":        "mentor_question",
+			"mentor_id":   mentor.MentorID,
+			"question_id": questionID,
+			"question":    strings.TrimSpace(req.Question),
+			"created":     time.Now().UTC(),
+		},
+	})
+
+	return &models.AskMentorQuestionResponse{
+		Success:    true,
+		QuestionID: questionID,
+	}, nil
+}
+
+// ListPublished returns the answered questions of the mentor with the given legacy ID
+func (s *MentorQuestionService) ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicMentorQuestion, error) {
+	mentor, err := s.mentorRepo.GetByID(ctx, mentorLegacyID, models.FilterOptions{OnlyVisible: true})
+	if err != nil {
+		return nil, ErrQuestionMentorNotFound
+	}
+
+	questions, err := s.repo.ListByMentor(ctx, mentor.MentorID, models.MentorQuestionStatusPublished)
+	if err != nil {
+		return nil, err
+	}
+
+	public := make([]models.PublicMentorQuestion, 0, len(questions))
+	for _, q := range questions {
+		if q.AnsweredAt == nil {
+			continue
+		}
+		public = append(public, models.PublicMentorQuestion{
+			ID:         q.ID,
+			Question:   q.Question,
+			Answer:     q.Answer,
+			AnsweredAt: *q.AnsweredAt,
+		})
+	}
+	return public, nil
+}
+
+// ListQuestions returns the mentor's own questions, optionally filtered by status
+func (s *MentorQuestionService) ListQuestions(ctx context.Context, mentorID, status string) ([]*models.MentorQuestion, error) {
+	switch status {
+	case "", models.MentorQuestionStatusPending, models.MentorQuestionStatusPublished, models.MentorQuestionStatusRejected:
+	default:
+		return nil, apperrors.InvalidInputError("status", "must be pending, published or rejected")
+	}
+	return s.repo.ListByMentor(ctx, mentorID, status)
+}
+
+// AnswerQuestion publishes one of the mentor's questions with the answer
+func (s *MentorQuestionService) AnswerQuestion(ctx context.Context, mentorID, questionID string, req *models.AnswerMentorQuestionRequest) (*models.MentorQuestion, error) {
+	answer := strings.TrimSpace(req.Answer)
+	if answer == "" {
+		return nil, apperrors.InvalidInputError("answer", "is required")
+	}
+
+	question, err := s.repo.Answer(ctx, mentorID, questionID, answer)
+	if err != nil {
+		return nil, err
+	}
+	metrics.MentorQuestions.WithLabelValues("answered").Inc()
+	return question, nil
+}
+
+// RejectQuestion hides one of the mentor's questions
+func (s *MentorQuestionService) RejectQuestion(ctx context.Context, mentorID, questionID string) error {
+	if err := s.repo.Reject(ctx, mentorID, questionID); err != nil {
+		return err
+	}
+	metrics.MentorQuestions.WithLabelValues("rejected").Inc()
+	return nil
+}
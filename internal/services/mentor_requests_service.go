@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
@@ -22,6 +25,7 @@ var (
 	ErrAccessDenied            = errors.New("access denied")
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
 	ErrCannotDeclineRequest    = errors.New("cannot decline request")
+	ErrCannotScheduleRequest   = errors.New("cannot schedule request")
 	ErrInvalidRequestGroup     = errors.New("invalid request group")
 )
 
@@ -31,6 +35,8 @@ type MentorRequestsService struct {
 	config      *config.Config
 	httpClient  httpclient.Client
 	tracker     analytics.Tracker
+	publisher   eventbus.Publisher
+	audit       *AuditLogger
 }
 
 // NewMentorRequestsService creates a new MentorRequestsService
@@ -39,17 +45,24 @@ func NewMentorRequestsService(
 	cfg *config.Config,
 	httpClient httpclient.Client,
 	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
+	audit *AuditLogger,
 ) *MentorRequestsService {
 
 	if tracker == nil {
 		tracker = analytics.NoopTracker{}
 	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
 
 	return &MentorRequestsService{
 		requestRepo: requestRepo,
 		config:      cfg,
 		httpClient:  httpClient,
 		tracker:     tracker,
+		publisher:   publisher,
+		audit:       audit,
 	}
 }
 
@@ -146,7 +159,10 @@ func (s *MentorRequestsService) UpdateStatus(ctx context.Context, mentorId strin
 	oldStatus := request.Status
 
 	// Update in repository
-	if err := s.requestRepo.UpdateStatus(ctx, requestID, newStatus); err != nil {
+	err = s.audit.Track(ctx, models.AuditEntityRequest, requestID, AuditOperationSetStatus, func(ctx context.Context) error {
+		return s.requestRepo.UpdateStatus(ctx, requestID, newStatus)
+	})
+	if err != nil {
 		s.tracker.Track(ctx, analytics.EventMentorRequestStatusUpdated, analytics.RequestDistinctID(requestID), map[string]interface{}{
 			"request_id":  requestID,
 			"mentor_id":   mentorId,
@@ -165,6 +181,13 @@ func (s *MentorRequestsService) UpdateStatus(ctx context.Context, mentorId strin
 		trigger.CallAsync(s.config.EventTriggers.RequestProcessFinishedTriggerURL, requestID, s.httpClient)
 	}
 
+	events.Publish(ctx, s.publisher, events.RequestStatusChanged{
+		RequestID:  requestID,
+		MentorID:   mentorId,
+		FromStatus: string(oldStatus),
+		ToStatus:   string(newStatus),
+	})
+
 	// Record metrics
 	metrics.MentorRequestsStatusUpdates.WithLabelValues(string(oldStatus), string(newStatus)).Inc()
 	s.tracker.Track(ctx, analytics.EventMentorRequestStatusUpdated, analytics.RequestDistinctID(requestID), map[string]interface{}{
@@ -220,7 +243,10 @@ func (s *MentorRequestsService) DeclineRequest(ctx context.Context, mentorId str
 	}
 
 	// Update in repository
-	if err := s.requestRepo.UpdateDecline(ctx, requestID, payload.Reason, payload.Comment); err != nil {
+	err = s.audit.Track(ctx, models.AuditEntityRequest, requestID, AuditOperationDecline, func(ctx context.Context) error {
+		return s.requestRepo.UpdateDecline(ctx, requestID, payload.Reason, payload.Comment)
+	})
+	if err != nil {
 		s.tracker.Track(ctx, analytics.EventMentorRequestDeclined, analytics.RequestDistinctID(requestID), map[string]interface{}{
 			"request_id": requestID,
 			"mentor_id":  mentorId,
@@ -238,6 +264,14 @@ func (s *MentorRequestsService) DeclineRequest(ctx context.Context, mentorId str
 		trigger.CallAsync(s.config.EventTriggers.RequestProcessFinishedTriggerURL, requestID, s.httpClient)
 	}
 
+	events.Publish(ctx, s.publisher, events.RequestStatusChanged{
+		RequestID:     requestID,
+		MentorID:      mentorId,
+		FromStatus:    string(request.Status),
+		ToStatus:      string(models.StatusDeclined),
+		DeclineReason: string(payload.Reason),
+	})
+
 	// Record metrics
 	metrics.MentorRequestsDeclines.WithLabelValues(string(payload.Reason)).Inc()
 	s.tracker.Track(ctx, analytics.EventMentorRequestDeclined, analytics.RequestDistinctID(requestID), map[string]interface{}{
@@ -254,3 +288,74 @@ func (s *MentorRequestsService) DeclineRequest(ctx context.Context, mentorId str
 	// Fetch updated request
 	return s.requestRepo.GetByID(ctx, requestID)
 }
+
+// ScheduleRequest sets the session time of one of the mentor's requests
+func (s *MentorRequestsService) ScheduleRequest(ctx context.Context, mentorId string, requestID string, scheduledAt time.Time) (*models.MentorClientRequest, error) {
+	request, err := s.GetRequestByID(ctx, mentorId, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return s.schedule(ctx, request, scheduledAt, "mentor")
+}
+
+// ScheduleRequestFromBot sets the session time of a request on behalf of its mentor.
+// The bot is trusted with any request, so ownership is not checked.
+func (s *MentorRequestsService) ScheduleRequestFromBot(ctx context.Context, requestID string, scheduledAt time.Time) (*models.MentorClientRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, requestID)
+	if err != nil {
+		logger.Warn("Request not found",
+			zap.String("request_id", requestID),
+			zap.Error(err))
+		return nil, ErrRequestNotFound
+	}
+	return s.schedule(ctx, request, scheduledAt, "bot")
+}
+
+// schedule validates and stores the session time, then notifies the scheduling trigger.
+// Only requests the mentor has answered and not finished can be scheduled.
+func (s *MentorRequestsService) schedule(ctx context.Context, request *models.MentorClientRequest, scheduledAt time.Time, source string) (*models.MentorClientRequest, error) {
+	scheduledAt = scheduledAt.UTC().Truncate(time.Minute)
+	if !scheduledAt.After(time.Now()) {
+		metrics.MentorRequestsSchedules.WithLabelValues(source, "in_past").Inc()
+		return nil, fmt.Errorf("%w: scheduled time %s is in the past", apperrors.ErrInvalidInput, scheduledAt.Format(time.RFC3339))
+	}
+
+	if !request.Status.CanSchedule() {
+		metrics.MentorRequestsSchedules.WithLabelValues(source, "invalid_state").Inc()
+		logger.Warn("Cannot schedule request",
+			zap.String("request_id", request.ID),
+			zap.String("status", string(request.Status)))
+		return nil, fmt.Errorf("%w: request with status '%s' cannot be scheduled", ErrCannotScheduleRequest, request.Status)
+	}
+
+	err := s.audit.Track(ctx, models.AuditEntityRequest, request.ID, AuditOperationSchedule, func(ctx context.Context) error {
+		return s.requestRepo.UpdateScheduledAt(ctx, request.ID, scheduledAt)
+	})
+	if err != nil {
+		metrics.MentorRequestsSchedules.WithLabelValues(source, "db_error").Inc()
+		logger.Error("Failed to schedule request",
+			zap.String("request_id", request.ID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to schedule request: %w", err)
+	}
+
+	if s.config.EventTriggers.RequestScheduledTriggerURL != "" {
+		trigger.CallAsyncWithPayload(s.config.EventTriggers.RequestScheduledTriggerURL, map[string]interface{}{
+			"type":                  "request_scheduled",
+			"request_id":            request.ID,
+			"mentor_id":             request.MentorID,
+			"status":                request.Status,
+			"scheduled_at":          scheduledAt,
+			"previous_scheduled_at": request.ScheduledAt,
+			"source":                source,
+		}, s.httpClient)
+	}
+
+	metrics.MentorRequestsSchedules.WithLabelValues(source, "success").Inc()
+	logger.Info("Request scheduled",
+		zap.String("request_id", request.ID),
+		zap.String("source", source),
+		zap.Time("scheduled_at", scheduledAt))
+
+	return s.requestRepo.GetByID(ctx, request.ID)
+}
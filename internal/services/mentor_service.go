@@ -2,10 +2,14 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/getmentor/getmentor-api/config"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 )
 
 type MentorService struct {
@@ -35,3 +39,34 @@ func (s *MentorService) GetMentorBySlug(ctx context.Context, slug string, opts m
 func (s *MentorService) GetMentorByMentorId(ctx context.Context, mentorId string, opts models.FilterOptions) (*models.Mentor, error) {
 	return s.repo.GetByMentorId(ctx, mentorId, opts)
 }
+
+// SearchMentors runs a full-text search over visible mentors, best match first
+func (s *MentorService) SearchMentors(ctx context.Context, query string, filters models.MentorTextSearchFilters, limit, offset int) ([]*models.Mentor, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: q is required", apperrors.ErrInvalidInput)
+	}
+	return s.repo.SearchMentors(ctx, query, filters, limit, offset)
+}
+
+// GetNewMentors returns visible mentors approved after since, most recent first.
+// It relies on recorded approval events rather than the IsNew flag, which only
+// reflects when a profile was created.
+func (s *MentorService) GetNewMentors(ctx context.Context, since time.Time, limit int) ([]models.NewMentor, error) {
+	approvals, err := s.repo.ListApprovedSince(ctx, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	mentors := make([]models.NewMentor, 0, len(approvals))
+	for _, approval := range approvals {
+		mentor, err := s.repo.GetBySlug(ctx, approval.Slug, models.FilterOptions{OnlyVisible: true})
+		if err != nil {
+			// Not in the cache yet (or hidden since); it'll show up on the next request
+			continue
+		}
+		mentors = append(mentors, models.NewMentor{Mentor: mentor, ApprovedAt: approval.ApprovedAt})
+	}
+
+	return mentors, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// MentorStatsService keeps the mentor_stats view fresh and serves it to the admin UI:
+// sessions done, decline rate, median response time and last activity per mentor
+type MentorStatsService struct {
+	repo   *repository.MentorStatsRepository
+	uow    *repository.UnitOfWork
+	config *config.Config
+}
+
+// NewMentorStatsService creates a new mentor stats service
+func NewMentorStatsService(
+	repo *repository.MentorStatsRepository,
+	uow *repository.UnitOfWork,
+	cfg *config.Config,
+) *MentorStatsService {
+
+	return &MentorStatsService{
+		repo:   repo,
+		uow:    uow,
+		config: cfg,
+	}
+}
+
+// Start refreshes the view in the background now and then every configured interval
+func (s *MentorStatsService) Start() {
+	go func() {
+		s.refreshAndLog()
+
+		ticker := time.NewTicker(time.Duration(s.config.MentorStats.RefreshMinutes) * time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.refreshAndLog()
+		}
+	}()
+}
+
+func (s *MentorStatsService) refreshAndLog() {
+	start := time.Now()
+	if err := s.Refresh(context.Background()); err != nil {
+		metrics.MentorStatsRefreshes.WithLabelValues("error").Inc()
+		logger.Error("Mentor stats refresh failed", zap.Error(err))
+		return
+	}
+	metrics.MentorStatsRefreshes.WithLabelValues("success").Inc()
+	logger.Info("Mentor stats refresh completed", zap.Duration("duration", time.Since(start)))
+}
+
+// Refresh recomputes the statistics of every mentor
+func (s *MentorStatsService) Refresh(ctx context.Context) error {
+	return s.uow.Do(ctx, s.repo.Refresh)
+}
+
+// GetStats returns a mentor's statistics as of the last refresh. Mentors added since then
+// are not found.
+func (s *MentorStatsService) GetStats(ctx context.Context, mentorID string) (*models.MentorStats, error) {
+	return s.repo.Get(ctx, mentorID)
+}
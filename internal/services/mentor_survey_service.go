@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const (
+	// mentorSurveyDefaultQuarters is how many quarters the analytics show by default
+	mentorSurveyDefaultQuarters = 4
+	// mentorSurveyMaxQuarters caps the quarters the analytics show
+	mentorSurveyMaxQuarters = 20
+)
+
+// MentorSurveyService periodically invites mentors to a short NPS / satisfaction survey,
+// stores their answers and aggregates them per quarter. A mentor is invited at most once
+// per models.MentorSurveyCooldown; the invitation email is sent by the survey trigger.
+type MentorSurveyService struct {
+	repo       *repository.MentorSurveyRepository
+	uow        *repository.UnitOfWork
+	config     *config.Config
+	httpClient httpclient.Client
+}
+
+// NewMentorSurveyService creates a new mentor survey service
+func NewMentorSurveyService(
+	repo *repository.MentorSurveyRepository,
+	uow *repository.UnitOfWork,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *MentorSurveyService {
+
+	return &MentorSurveyService{
+		repo:       repo,
+		uow:        uow,
+		config:     cfg,
+		httpClient: httpClient,
+	}
+}
+
+// Start dispatches invitations in the background now and then every configured interval
+func (s *MentorSurveyService) Start() {
+	go func() {
+		s.dispatchAndLog()
+
+		ticker := time.NewTicker(time.Duration(s.config.MentorSurvey.DispatchIntervalHours) * time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.dispatchAndLog()
+		}
+	}()
+}
+
+func (s *MentorSurveyService) dispatchAndLog() {
+	invited, err := s.Dispatch(context.Background())
+	if err != nil {
+		metrics.MentorSurveys.WithLabelValues("error").Inc()
+		logger.Error("Mentor survey dispatch failed", zap.Error(err))
+		return
+	}
+	logger.Info("Mentor survey dispatch completed", zap.Int("invited", invited))
+}
+
+// Dispatch invites the next batch of eligible mentors and returns how many were invited
+func (s *MentorSurveyService) Dispatch(ctx context.Context) (int, error) {
+	cfg := s.config.MentorSurvey
+	now := time.Now()
+	registeredBefore := now.AddDate(0, 0, -cfg.MinTenureDays)
+	expiresAt := now.AddDate(0, 0, cfg.ResponseWindowDays)
+
+	var surveys []*models.MentorSurvey
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		surveys, err = s.repo.Invite(txCtx, registeredBefore, expiresAt, cfg.BatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	surveyURL := s.config.Server.BaseURL + "/mentor/survey"
+	for _, survey := range surveys {
+		if s.config.EventTriggers.MentorSurveyTriggerURL != "" {
+			payload := map[string]interface{}{
+				"type":       "mentor_survey",
+				"mentor_id":  survey.MentorID,
+				"survey_id":  survey.ID,
+				"survey_url": surveyURL,
+				"expires_at": survey.ExpiresAt,
+			}
+			trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorSurveyTriggerURL, payload, s.httpClient)
+		}
+	}
+	metrics.MentorSurveys.WithLabelValues("invited").Add(float64(len(surveys)))
+	return len(surveys), nil
+}
+
+// GetOpen returns the mentor's open survey, or ErrMentorSurveyNotFound
+func (s *MentorSurveyService) GetOpen(ctx context.Context, mentorID string) (*models.MentorSurvey, error) {
+	survey, err := s.repo.GetOpen(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if survey == nil {
+		return nil, repository.ErrMentorSurveyNotFound
+	}
+	return survey, nil
+}
+
+// Submit stores the mentor's answers on their open survey
+func (s *MentorSurveyService) Submit(ctx context.Context, mentorID string, req *models.SubmitMentorSurveyRequest) (*models.MentorSurvey, error) {
+	survey, err := s.repo.Respond(ctx, mentorID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.MentorSurveys.WithLabelValues("responded").Inc()
+	logger.Info("Mentor survey answered",
+		zap.String("mentor_id", mentorID),
+		zap.String("survey_id", survey.ID),
+		zap.Int("nps", *req.NPS))
+	return survey, nil
+}
+
+// Summary returns the survey results of the last quarters, newest first. Quarters
+// without invitations are left out.
+func (s *MentorSurveyService) Summary(ctx context.Context, quarters int) ([]models.MentorSurveySummary, error) {
+	if quarters <= 0 {
+		quarters = mentorSurveyDefaultQuarters
+	}
+	if quarters > mentorSurveyMaxQuarters {
+		quarters = mentorSurveyMaxQuarters
+	}
+
+	since := models.QuarterStart(time.Now()).AddDate(0, -3*(quarters-1), 0)
+	rows, err := s.repo.Quarters(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]models.MentorSurveySummary, 0, len(rows))
+	for _, q := range rows {
+		summaries = append(summaries, q.Summary())
+	}
+	return summaries, nil
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// moderationRulesCacheTTL is how long an instance serves the rules before re-reading them,
+	// which is also how long other instances take to pick up a change
+	moderationRulesCacheTTL = time.Minute
+	// moderationRulesHistoryLimit caps the listed versions
+	moderationRulesHistoryLimit = 50
+)
+
+// ErrBannedWord is returned when a submission mentions a banned word
+var ErrBannedWord = errors.New("submission contains a banned word")
+
+// ModerationRulesService serves the versioned moderation rules from an in-memory cache
+// and lets admins save new versions
+type ModerationRulesService struct {
+	repo *repository.ModerationSettingsRepository
+
+	mu       sync.RWMutex
+	current  *models.ModerationSettings
+	loadedAt time.Time
+}
+
+// NewModerationRulesService creates a new moderation rules service
+func NewModerationRulesService(repo *repository.ModerationSettingsRepository) *ModerationRulesService {
+	return &ModerationRulesService{repo: repo}
+}
+
+// Rules returns the rules in effect. When the database can't be read, the last loaded
+// rules are used, or the defaults if nothing was loaded yet.
+func (s *ModerationRulesService) Rules(ctx context.Context) models.ModerationRules {
+	settings, err := s.load(ctx)
+	if err != nil {
+		logger.Error("Failed to load moderation rules, using the last known ones", zap.Error(err))
+	}
+	return settings.Rules
+}
+
+// GetCurrent returns the version in effect
+func (s *ModerationRulesService) GetCurrent(ctx context.Context) (*models.ModerationSettings, error) {
+	return s.load(ctx)
+}
+
+// ListVersions returns the saved versions, newest first
+func (s *ModerationRulesService) ListVersions(ctx context.Context) ([]*models.ModerationSettings, error) {
+	return s.repo.List(ctx, moderationRulesHistoryLimit)
+}
+
+// Update saves the rules as a new version. Admin only.
+func (s *ModerationRulesService) Update(
+	ctx context.Context,
+	session *models.AdminSession,
+	req *models.UpdateModerationRulesRequest,
+) (*models.ModerationSettings, error) {
+
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	baseVersion, err := s.baseVersion(ctx, req.BaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	return s.save(ctx, session, baseVersion, req.Rules, req.Note)
+}
+
+// Restore saves the rules of an earlier version as a new version. Admin only.
+func (s *ModerationRulesService) Restore(ctx context.Context, session *models.AdminSession, version int) (*models.ModerationSettings, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	restored, err := s.repo.GetVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	baseVersion, err := s.baseVersion(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.save(ctx, session, baseVersion, restored.Rules, fmt.Sprintf("Restored version %d", version))
+}
+
+func (s *ModerationRulesService) save(
+	ctx context.Context,
+	session *models.AdminSession,
+	baseVersion int,
+	rules models.ModerationRules,
+	note string,
+) (*models.ModerationSettings, error) {
+
+	rules.Normalize()
+	settings := &models.ModerationSettings{
+		Rules:     rules,
+		Note:      note,
+		CreatedBy: session.Email,
+	}
+	if err := s.repo.Create(ctx, baseVersion, settings); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.current = settings
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+
+	logger.Info("Moderation rules updated",
+		zap.Int("version", settings.Version),
+		zap.Int("max_tags", rules.MaxTags),
+		zap.Int("banned_words", len(rules.BannedWords)),
+		zap.Bool("auto_approve", rules.AutoApprove.Enabled),
+		zap.String("moderator_id", session.ModeratorID))
+	return settings, nil
+}
+
+// baseVersion returns the version a save builds on: the one the admin edited, or the latest
+func (s *ModerationRulesService) baseVersion(ctx context.Context, requested *int) (int, error) {
+	if requested != nil {
+		return *requested, nil
+	}
+	current, err := s.repo.GetCurrent(ctx)
+	if err != nil || current == nil {
+		return 0, err
+	}
+	return current.Version, nil
+}
+
+// load returns the cached settings, re-reading them once the cache expires. On error it
+// returns the stale settings (or the defaults) together with the error.
+func (s *ModerationRulesService) load(ctx context.Context) (*models.ModerationSettings, error) {
+	s.mu.RLock()
+	current, loadedAt := s.current, s.loadedAt
+	s.mu.RUnlock()
+	if current != nil && time.Since(loadedAt) < moderationRulesCacheTTL {
+		return current, nil
+	}
+
+	settings, err := s.repo.GetCurrent(ctx)
+	if err != nil {
+		if current == nil {
+			current = &models.ModerationSettings{Rules: models.DefaultModerationRules()}
+		}
+		return current, err
+	}
+	if settings == nil {
+		settings = &models.ModerationSettings{Rules: models.DefaultModerationRules(), CreatedBy: "default"}
+	}
+
+	s.mu.Lock()
+	s.current = settings
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+	return settings, nil
+}
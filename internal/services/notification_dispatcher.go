@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+const (
+	// notificationDeliveryBatch caps the held notifications delivered per run
+	notificationDeliveryBatch   = 1000
+	notificationDeliveryTimeout = 30 * time.Second
+)
+
+// NotificationDispatcher sends mentor notifications through their channel's trigger URL. During a
+// mentor's quiet hours, read in their profile timezone, notifications of the channels listed in
+// NOTIFICATION_QUIET_HOURS_CHANNELS are queued in Postgres instead, and delivered once quiet hours
+// end: merged into one digest per mentor through NOTIFICATION_DIGEST_TRIGGER_URL, or one by one.
+// A delivered notification is handed to the trigger package, which retries it and dead-letters it.
+type NotificationDispatcher struct {
+	repo        *repository.NotificationRepository
+	config      *config.Config
+	httpClient  httpclient.Client
+	triggerURLs map[string]string
+}
+
+// NewNotificationDispatcher creates a new notification dispatcher
+func NewNotificationDispatcher(
+	repo *repository.NotificationRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+) *NotificationDispatcher {
+
+	return &NotificationDispatcher{
+		repo:       repo,
+		config:     cfg,
+		httpClient: httpClient,
+		triggerURLs: map[string]string{
+			models.NotificationChannelMentorRequestCreated: cfg.EventTriggers.MentorRequestCreatedTriggerURL,
+			models.NotificationChannelMentorQuestion:       cfg.EventTriggers.MentorQuestionTriggerURL,
+		},
+	}
+}
+
+// Start delivers the held notifications that are due in the background
+func (d *NotificationDispatcher) Start() {
+	if len(d.config.Notifications.QuietHoursChannels) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(d.config.Notifications.DeliveryIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			ctx, cancel := context.WithTimeout(context.Background(), notificationDeliveryTimeout)
+			if _, err := d.DeliverDue(ctx); err != nil {
+				logger.Error("Held notification delivery failed", zap.Error(err))
+			}
+			cancel()
+		}
+	}()
+}
+
+// Notify sends a notification to a mentor, or queues it until their quiet hours end. Failing to
+// read the quiet hours or to queue the notification sends it at once.
+func (d *NotificationDispatcher) Notify(ctx context.Context, notification *models.MentorNotification) {
+	if d.triggerURLs[notification.Channel] == "" {
+		// No trigger URL configured, skip silently
+		return
+	}
+
+	if _, held := d.config.Notifications.QuietHoursChannels[notification.Channel]; held {
+		queued, err := d.holdDuringQuietHours(ctx, notification)
+		if err != nil {
+			metrics.MentorNotifications.WithLabelValues(notification.Channel, "queue_failed").Inc()
+			logger.Warn("Failed to hold notification during quiet hours, sending it now",
+				zap.String("mentor_id", notification.MentorID),
+				zap.String("channel", notification.Channel),
+				zap.Error(err))
+		}
+		if queued {
+			metrics.MentorNotifications.WithLabelValues(notification.Channel, "queued").Inc()
+			return
+		}
+	}
+
+	d.send(notification.Channel, notification.RecordID, notification.Payload)
+	metrics.MentorNotifications.WithLabelValues(notification.Channel, "sent").Inc()
+}
+
+func (d *NotificationDispatcher) holdDuringQuietHours(ctx context.Context, notification *models.MentorNotification) (bool, error) {
+	quietHours, timezone, err := d.repo.QuietHours(ctx, notification.MentorID)
+	if err != nil {
+		return false, err
+	}
+	end, quiet := models.QuietHoursEnd(quietHours, timezone, time.Now())
+	if !quiet {
+		return false, nil
+	}
+	if err := d.repo.Enqueue(ctx, notification, end); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeliverDue delivers the held notifications whose quiet hours ended and returns how many it sent
+func (d *NotificationDispatcher) DeliverDue(ctx context.Context) (int, error) {
+	held, err := d.repo.ClaimDue(ctx, time.Now(), notificationDeliveryBatch)
+	if err != nil {
+		return 0, err
+	}
+	if len(held) == 0 {
+		return 0, nil
+	}
+
+	digests, individual := models.BatchNotifications(held, d.digested)
+	for _, digest := range digests {
+		trigger.CallAsyncWithPayload(d.config.EventTriggers.NotificationDigestTriggerURL, digest, d.httpClient)
+		for _, batch := range digest.Batches {
+			metrics.MentorNotifications.WithLabelValues(batch.Channel, "delivered_digest").Add(float64(batch.Count))
+		}
+	}
+	for _, notification := range individual {
+		var payload interface{}
+		if notification.Payload != nil {
+			payload = notification.Payload
+		}
+		d.send(notification.Channel, notification.RecordID, payload)
+		metrics.MentorNotifications.WithLabelValues(notification.Channel, "delivered_individual").Inc()
+	}
+
+	logger.Info("Delivered notifications held during quiet hours",
+		zap.Int("notifications", len(held)),
+		zap.Int("digests", len(digests)))
+	return len(held), nil
+}
+
+// digested reports whether the held notifications of a channel go out in the morning digest;
+// without a digest trigger URL they are sent individually
+func (d *NotificationDispatcher) digested(channel string) bool {
+	return d.config.Notifications.QuietHoursChannels[channel] == models.NotificationModeDigest &&
+		d.config.EventTriggers.NotificationDigestTriggerURL != ""
+}
+
+func (d *NotificationDispatcher) send(channel, recordID string, payload interface{}) {
+	if payload != nil {
+		trigger.CallAsyncWithPayload(d.triggerURLs[channel], payload, d.httpClient)
+		return
+	}
+	trigger.CallAsync(d.triggerURLs[channel], recordID, d.httpClient)
+}
+
+// GetSettings returns the mentor's quiet hours and how many notifications they hold
+func (d *NotificationDispatcher) GetSettings(ctx context.Context, mentorID string) (*models.NotificationSettings, error) {
+	quietHours, timezone, err := d.repo.QuietHours(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	queued, err := d.repo.CountQueued(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.NotificationSettings{QuietHours: quietHours, Timezone: timezone, Queued: queued}, nil
+}
+
+// SetQuietHours validates and stores the mentor's quiet hours; an empty value turns them off.
+// Notifications already held keep their delivery time.
+func (d *NotificationDispatcher) SetQuietHours(ctx context.Context, mentorID, quietHours string) (*models.NotificationSettings, error) {
+	quietHours = strings.TrimSpace(quietHours)
+	if quietHours != "" {
+		if _, _, err := models.ParseQuietHours(quietHours); err != nil {
+			return nil, apperrors.InvalidInputError("quietHours", err.Error())
+		}
+	}
+
+	if err := d.repo.SetQuietHours(ctx, mentorID, quietHours); err != nil {
+		return nil, err
+	}
+	logger.Info("Mentor quiet hours updated", zap.String("mentor_id", mentorID), zap.String("quiet_hours", quietHours))
+	return d.GetSettings(ctx, mentorID)
+}
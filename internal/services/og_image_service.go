@@ -0,0 +1,176 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // decoders for profile pictures
+	_ "image/png"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/ogimage"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// ogImageCacheControl lets crawlers and the CDN keep a card; its key changes with the profile
+	ogImageCacheControl = "public, max-age=604800, immutable"
+	// ogImageKnownTTL is how long a stored card is remembered without checking the bucket
+	ogImageKnownTTL = 24 * time.Hour
+)
+
+// OGImage is a rendered share card: either the public URL of the stored card or, when
+// object storage isn't configured, the PNG itself
+type OGImage struct {
+	URL string
+	PNG []byte
+}
+
+// OGImageService renders mentor share cards and caches them in object storage.
+// Cards are keyed by a hash of their content, so profile edits produce a new card
+// and stale ones are never served.
+type OGImageService struct {
+	mentorRepo *repository.MentorRepository
+	storage    *yandex.StorageClient // nil renders on every request
+	footer     string
+	known      *gocache.Cache // card key -> public URL
+}
+
+// NewOGImageService creates a new OGImageService. baseURL is shown on the card.
+func NewOGImageService(mentorRepo *repository.MentorRepository, storage *yandex.StorageClient, baseURL string) *OGImageService {
+	footer := baseURL
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		footer = u.Host
+	}
+	return &OGImageService{
+		mentorRepo: mentorRepo,
+		storage:    storage,
+		footer:     footer,
+		known:      gocache.New(ogImageKnownTTL, time.Hour),
+	}
+}
+
+// GetCard returns the share card of a visible mentor, rendering and storing it on first request
+func (s *OGImageService) GetCard(ctx context.Context, slug string) (*OGImage, error) {
+	mentor, err := s.mentorRepo.GetBySlug(ctx, slug, models.FilterOptions{OnlyVisible: true})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", apperrors.ErrNotFound, err)
+	}
+
+	key := ogImageKey(mentor)
+	if s.storage == nil {
+		png, err := s.render(ctx, mentor)
+		if err != nil {
+			return nil, err
+		}
+		metrics.OGImageRequests.WithLabelValues("rendered_inline").Inc()
+		return &OGImage{PNG: png}, nil
+	}
+
+	if cached, ok := s.known.Get(key); ok {
+		metrics.OGImageRequests.WithLabelValues("memory_hit").Inc()
+		return &OGImage{URL: cached.(string)}, nil
+	}
+	exists, err := s.storage.ObjectExists(ctx, key)
+	if err != nil {
+		logger.Warn("Failed to check stored share card; rendering", zap.Error(err), zap.String("key", key))
+	}
+	if exists {
+		publicURL := s.storage.PublicURL(key)
+		s.known.SetDefault(key, publicURL)
+		metrics.OGImageRequests.WithLabelValues("storage_hit").Inc()
+		return &OGImage{URL: publicURL}, nil
+	}
+
+	png, err := s.render(ctx, mentor)
+	if err != nil {
+		return nil, err
+	}
+	publicURL, err := s.storage.UploadObject(ctx, key, "image/png", ogImageCacheControl, png)
+	if err != nil {
+		// The card is still good; serve it and try storing it next time
+		logger.Error("Failed to store share card", zap.Error(err), zap.String("key", key))
+		metrics.OGImageRequests.WithLabelValues("rendered_inline").Inc()
+		return &OGImage{PNG: png}, nil
+	}
+	s.known.SetDefault(key, publicURL)
+	metrics.OGImageRequests.WithLabelValues("rendered").Inc()
+	return &OGImage{URL: publicURL}, nil
+}
+
+func (s *OGImageService) render(ctx context.Context, mentor *models.Mentor) ([]byte, error) {
+	start := time.Now()
+	png, err := ogimage.Render(ogimage.Card{
+		Name:   mentor.Name,
+		Title:  mentorTitle(mentor),
+		Tags:   mentor.Tags,
+		Photo:  s.loadPhoto(ctx, mentor.Slug),
+		Footer: s.footer,
+	})
+	if err != nil {
+		metrics.OGImageRequests.WithLabelValues("error").Inc()
+		return nil, fmt.Errorf("failed to render share card for %s: %w", mentor.Slug, err)
+	}
+	logger.Info("Rendered mentor share card",
+		zap.String("slug", mentor.Slug),
+		zap.Duration("duration", time.Since(start)),
+		zap.Int("size_bytes", len(png)))
+	return png, nil
+}
+
+// loadPhoto returns the mentor's profile picture, or nil to draw initials instead
+func (s *OGImageService) loadPhoto(ctx context.Context, slug string) image.Image {
+	if s.storage == nil {
+		return nil
+	}
+	data, err := s.storage.GetObject(ctx, slug+"/large")
+	if err != nil {
+		if !errors.Is(err, yandex.ErrObjectNotFound) {
+			logger.Warn("Failed to load profile picture for share card", zap.Error(err), zap.String("slug", slug))
+		}
+		return nil
+	}
+	photo, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		logger.Warn("Failed to decode profile picture for share card", zap.Error(err), zap.String("slug", slug))
+		return nil
+	}
+	return photo
+}
+
+func mentorTitle(mentor *models.Mentor) string {
+	switch {
+	case mentor.Job != "" && mentor.Workplace != "":
+		return mentor.Job + " @ " + mentor.Workplace
+	case mentor.Job != "":
+		return mentor.Job
+	default:
+		return mentor.Workplace
+	}
+}
+
+// ogImageKey is the storage key of a card. It covers every field drawn on the card;
+// UpdatedAt changes with the profile picture.
+func ogImageKey(mentor *models.Mentor) string {
+	h := sha256.New()
+	for _, part := range []string{mentor.Name, mentor.Job, mentor.Workplace, strings.Join(mentor.Tags, ","),
+		mentor.UpdatedAt.UTC().Format(time.RFC3339Nano)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("og/%s/%s.png", mentor.Slug, hex.EncodeToString(h.Sum(nil))[:16])
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// partnerAuditQueueSize bounds the summaries waiting to be written; more are dropped
+	partnerAuditQueueSize = 1000
+	// partnerAuditWriteTimeout bounds a single insert
+	partnerAuditWriteTimeout = 5 * time.Second
+	// partnerAuditPurgeInterval is how often expired summaries are deleted
+	partnerAuditPurgeInterval = 6 * time.Hour
+	// partnerAuditDefaultWindow is the period listed when the query has no from
+	partnerAuditDefaultWindow = 7 * 24 * time.Hour
+)
+
+// PartnerAuditService persists summaries of partner-token requests off the request path
+// and purges them after the retention period
+type PartnerAuditService struct {
+	repo      *repository.PartnerAuditRepository
+	retention time.Duration
+	queue     chan *models.PartnerRequestLog
+}
+
+// NewPartnerAuditService creates a new PartnerAuditService
+func NewPartnerAuditService(repo *repository.PartnerAuditRepository, retentionDays int) *PartnerAuditService {
+	return &PartnerAuditService{
+		repo:      repo,
+		retention: time.Duration(retentionDays) * 24 * time.Hour,
+		queue:     make(chan *models.PartnerRequestLog, partnerAuditQueueSize),
+	}
+}
+
+// Record queues a summary for writing. It never blocks: when the queue is full the
+// summary is dropped and counted.
+func (s *PartnerAuditService) Record(entry *models.PartnerRequestLog) {
+	select {
+	case s.queue <- entry:
+	default:
+		metrics.PartnerAuditEntries.WithLabelValues("dropped").Inc()
+	}
+}
+
+// Start runs the writer and the retention purge in the background
+func (s *PartnerAuditService) Start() {
+	go func() {
+		for entry := range s.queue {
+			ctx, cancel := context.WithTimeout(context.Background(), partnerAuditWriteTimeout)
+			err := s.repo.Insert(ctx, entry)
+			cancel()
+			if err != nil {
+				metrics.PartnerAuditEntries.WithLabelValues("error").Inc()
+				logger.Error("Failed to write partner request log", zap.Error(err), zap.String("token_id", entry.TokenID))
+				continue
+			}
+			metrics.PartnerAuditEntries.WithLabelValues("recorded").Inc()
+		}
+	}()
+
+	go func() {
+		s.purge()
+
+		ticker := time.NewTicker(partnerAuditPurgeInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.purge()
+		}
+	}()
+}
+
+func (s *PartnerAuditService) purge() {
+	deleted, err := s.repo.DeleteOlderThan(context.Background(), time.Now().Add(-s.retention))
+	if err != nil {
+		logger.Error("Failed to purge partner request log", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		logger.Info("Purged expired partner request log entries", zap.Int64("deleted", deleted))
+	}
+}
+
+// List returns request summaries matching the filter, newest first. Admin only.
+// Without From the last week is listed; the limit is capped.
+func (s *PartnerAuditService) List(ctx context.Context, session *models.AdminSession, filter models.PartnerRequestLogFilter) ([]*models.PartnerRequestLog, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	if filter.To.IsZero() {
+		filter.To = time.Now()
+	}
+	if filter.From.IsZero() {
+		filter.From = filter.To.Add(-partnerAuditDefaultWindow)
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", apperrors.ErrInvalidInput)
+	}
+	if filter.Limit <= 0 || filter.Limit > models.MaxPartnerRequestLogsListed {
+		filter.Limit = models.MaxPartnerRequestLogsListed
+	}
+
+	return s.repo.List(ctx, filter)
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// PartnerQuotaService enforces monthly request quotas of partner tokens. Tokens are
+// tracked by their configured name; the default limit can be overridden per token by admins.
+type PartnerQuotaService struct {
+	repo         *repository.PartnerQuotaRepository
+	defaultLimit int64
+	tokenNames   map[string]string // PartnerTokenID -> name
+}
+
+// NewPartnerQuotaService creates a new PartnerQuotaService. defaultLimit of 0 means
+// unlimited unless an override says otherwise.
+func NewPartnerQuotaService(repo *repository.PartnerQuotaRepository, defaultLimit int64, tokenNames map[string]string) *PartnerQuotaService {
+	return &PartnerQuotaService{
+		repo:         repo,
+		defaultLimit: defaultLimit,
+		tokenNames:   tokenNames,
+	}
+}
+
+// Consume counts one request of the token and returns the resulting usage. Tokens that
+// aren't configured partner tokens are not tracked and return nil.
+func (s *PartnerQuotaService) Consume(ctx context.Context, tokenID string) (*models.PartnerQuotaUsage, error) {
+	name, ok := s.tokenNames[tokenID]
+	if !ok {
+		return nil, nil
+	}
+
+	period := quotaPeriod(time.Now())
+	used, override, err := s.repo.Increment(ctx, name, period)
+	if err != nil {
+		metrics.PartnerQuotaRequests.WithLabelValues(name, "error").Inc()
+		return nil, err
+	}
+
+	limit := s.defaultLimit
+	if override != nil {
+		limit = *override
+	}
+	usage := newQuotaUsage(name, period, used, limit)
+	if usage.Exceeded() {
+		metrics.PartnerQuotaRequests.WithLabelValues(name, "exceeded").Inc()
+	} else {
+		metrics.PartnerQuotaRequests.WithLabelValues(name, "allowed").Inc()
+	}
+	return usage, nil
+}
+
+// GetUsage returns the current usage of a partner token without counting a request
+func (s *PartnerQuotaService) GetUsage(ctx context.Context, tokenID string) (*models.PartnerQuotaUsage, error) {
+	name, ok := s.tokenNames[tokenID]
+	if !ok {
+		return nil, fmt.Errorf("%w: partner token is not tracked", apperrors.ErrNotFound)
+	}
+
+	period := quotaPeriod(time.Now())
+	usage, err := s.repo.UsageByToken(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	override, err := s.repo.GetOverride(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.usageFor(name, period, usage[name], override), nil
+}
+
+// ListUsage returns the current usage and overrides of every configured partner token. Admin only.
+func (s *PartnerQuotaService) ListUsage(ctx context.Context, session *models.AdminSession) ([]*models.PartnerQuotaUsage, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	period := quotaPeriod(time.Now())
+	usage, err := s.repo.UsageByToken(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.repo.ListOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := s.names()
+	result := make([]*models.PartnerQuotaUsage, 0, len(names))
+	for _, name := range names {
+		result = append(result, s.usageFor(name, period, usage[name], overrides[name]))
+	}
+	return result, nil
+}
+
+// SetOverride replaces the monthly limit of a partner token. Admin only.
+func (s *PartnerQuotaService) SetOverride(ctx context.Context, session *models.AdminSession, tokenName string, req *models.SetPartnerQuotaRequest) (*models.PartnerQuotaOverride, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if !s.isKnownName(tokenName) {
+		return nil, fmt.Errorf("%w: unknown partner token %q", apperrors.ErrInvalidInput, tokenName)
+	}
+
+	override := &models.PartnerQuotaOverride{
+		TokenName:    tokenName,
+		MonthlyLimit: *req.MonthlyLimit,
+		Note:         req.Note,
+		UpdatedBy:    session.Email,
+	}
+	if err := s.repo.UpsertOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Partner quota overridden",
+		zap.String("token", tokenName),
+		zap.Int64("monthly_limit", override.MonthlyLimit),
+		zap.String("moderator_id", session.ModeratorID))
+	return override, nil
+}
+
+// DeleteOverride restores the default monthly limit of a partner token. Admin only.
+func (s *PartnerQuotaService) DeleteOverride(ctx context.Context, session *models.AdminSession, tokenName string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		return ErrAdminForbiddenAction
+	}
+
+	deleted, err := s.repo.DeleteOverride(ctx, tokenName)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("%w: no quota override for %q", apperrors.ErrNotFound, tokenName)
+	}
+
+	logger.Info("Partner quota override removed",
+		zap.String("token", tokenName),
+		zap.String("moderator_id", session.ModeratorID))
+	return nil
+}
+
+func (s *PartnerQuotaService) usageFor(name string, period time.Time, used int64, override *models.PartnerQuotaOverride) *models.PartnerQuotaUsage {
+	limit := s.defaultLimit
+	if override != nil {
+		limit = override.MonthlyLimit
+	}
+	usage := newQuotaUsage(name, period, used, limit)
+	usage.Override = override
+	return usage
+}
+
+func (s *PartnerQuotaService) names() []string {
+	names := make([]string, 0, len(s.tokenNames))
+	for _, name := range s.tokenNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *PartnerQuotaService) isKnownName(tokenName string) bool {
+	for _, name := range s.tokenNames {
+		if name == tokenName {
+			return true
+		}
+	}
+	return false
+}
+
+// quotaPeriod returns the first day of the UTC month containing t
+func quotaPeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func newQuotaUsage(name string, period time.Time, used, limit int64) *models.PartnerQuotaUsage {
+	usage := &models.PartnerQuotaUsage{
+		Token:    name,
+		Period:   period.Format("2006-01"),
+		Used:     used,
+		Limit:    limit,
+		ResetsAt: period.AddDate(0, 1, 0),
+	}
+	if limit > 0 {
+		remaining := max(limit-used, 0)
+		usage.Remaining = &remaining
+	}
+	return usage
+}
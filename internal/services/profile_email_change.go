@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// RequestEmailChange starts the two-step email change: the new address gets a confirmation
+// link and the old one a notice. The email only changes once the link is followed, so magic
+// link login keeps working with the old address until then. moderatorID is empty when the
+// mentor changes their own email. Inside a unit of work the change joins the caller's transaction.
+func (s *ProfileService) RequestEmailChange(ctx context.Context, mentorID, newEmail, moderatorID string) (*models.EmailChange, error) {
+	email := models.NormalizeEmail(newEmail)
+	if email == "" {
+		return nil, apperrors.InvalidInputError("email", "is required")
+	}
+
+	used, err := s.emailChangeRepo.IsEmailUsedByOtherMentor(ctx, mentorID, email)
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		s.trackEmailChange(ctx, analytics.EventMentorEmailChangeRequested, mentorID, "email_in_use")
+		return nil, repository.ErrEmailInUse
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate email change token: %w", err)
+	}
+
+	var change *models.EmailChange
+	err = s.uow.Do(ctx, func(txCtx context.Context) error {
+		var createErr error
+		change, createErr = s.emailChangeRepo.Create(txCtx, &models.EmailChange{
+			MentorID:    mentorID,
+			NewEmail:    email,
+			Token:       token,
+			RequestedBy: moderatorID,
+			ExpiresAt:   time.Now().Add(models.EmailChangeTTL),
+		})
+		if createErr != nil {
+			return createErr
+		}
+		if change.OldEmail != "" && models.NormalizeEmail(change.OldEmail) == email {
+			return apperrors.InvalidInputError("email", "is the current email")
+		}
+
+		repository.AfterCommit(txCtx, func() {
+			s.notifyEmailChange("mentor_email_change_confirm", change, change.NewEmail, map[string]interface{}{
+				"confirm_url": s.emailChangeConfirmURL(change.Token),
+				"expires_at":  change.ExpiresAt,
+			})
+			if change.OldEmail != "" {
+				s.notifyEmailChange("mentor_email_change_requested", change, change.OldEmail, nil)
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrInvalidInput) {
+			s.trackEmailChange(ctx, analytics.EventMentorEmailChangeRequested, mentorID, "same_email")
+			return nil, err
+		}
+		s.trackEmailChange(ctx, analytics.EventMentorEmailChangeRequested, mentorID, "error")
+		logger.Error("Failed to request email change", zap.String("mentor_id", mentorID), zap.Error(err))
+		return nil, fmt.Errorf("failed to request email change: %w", err)
+	}
+
+	s.trackEmailChange(ctx, analytics.EventMentorEmailChangeRequested, mentorID, "success")
+	logger.Info("Mentor email change requested",
+		zap.String("mentor_id", mentorID),
+		zap.String("email_change_id", change.ID),
+		zap.Bool("by_moderator", moderatorID != ""))
+
+	return change, nil
+}
+
+// GetPendingEmailChange returns the mentor's unconfirmed email change, or nil
+func (s *ProfileService) GetPendingEmailChange(ctx context.Context, mentorID string) (*models.EmailChange, error) {
+	change, err := s.emailChangeRepo.GetPendingByMentor(ctx, mentorID)
+	if err != nil || change == nil || !change.IsOpen(time.Now()) {
+		return nil, err
+	}
+	return change, nil
+}
+
+// ConfirmEmailChange applies the change behind the confirmation link and tells the old address
+func (s *ProfileService) ConfirmEmailChange(ctx context.Context, token string) (*models.EmailChange, error) {
+	change, err := s.emailChangeRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !change.IsOpen(time.Now()) {
+		s.trackEmailChange(ctx, analytics.EventMentorEmailChangeConfirmed, change.MentorID, "closed")
+		return nil, repository.ErrEmailChangeClosed
+	}
+
+	// Following the link proves the mentor owns the new address, so the change is theirs
+	auditCtx := models.WithAuditActor(ctx, models.AuditActor{Type: models.AuditActorMentor, ID: change.MentorID})
+	err = s.audit.Track(auditCtx, models.AuditEntityMentor, change.MentorID, AuditOperationConfirmEmail, func(txCtx context.Context) error {
+		return s.uow.Do(txCtx, func(txCtx context.Context) error {
+			return s.emailChangeRepo.Confirm(txCtx, change)
+		})
+	})
+	if err != nil {
+		outcome := "error"
+		switch {
+		case errors.Is(err, repository.ErrEmailChangeClosed):
+			outcome = "closed"
+		case errors.Is(err, repository.ErrEmailInUse):
+			outcome = "email_in_use"
+		default:
+			logger.Error("Failed to confirm email change", zap.String("email_change_id", change.ID), zap.Error(err))
+		}
+		s.trackEmailChange(ctx, analytics.EventMentorEmailChangeConfirmed, change.MentorID, outcome)
+		return nil, err
+	}
+	change.Status = models.EmailChangeConfirmed
+
+	if change.OldEmail != "" {
+		s.notifyEmailChange("mentor_email_changed", change, change.OldEmail, nil)
+	}
+	s.trackEmailChange(ctx, analytics.EventMentorEmailChangeConfirmed, change.MentorID, "success")
+	logger.Info("Mentor email changed",
+		zap.String("mentor_id", change.MentorID),
+		zap.String("email_change_id", change.ID))
+
+	return change, nil
+}
+
+// notifyEmailChange sends one email change event; recipient is the address the email goes to
+func (s *ProfileService) notifyEmailChange(eventType string, change *models.EmailChange, recipient string, extra map[string]interface{}) {
+	if s.config.EventTriggers.MentorEmailChangeTriggerURL == "" {
+		if s.config.IsDevelopment() && eventType == "mentor_email_change_confirm" {
+			logger.Info("=== DEVELOPMENT EMAIL CHANGE URL ===",
+				zap.String("new_email", change.NewEmail),
+				zap.String("confirm_url", s.emailChangeConfirmURL(change.Token)))
+		}
+		return
+	}
+
+	payload := map[string]interface{}{
+		"type":            eventType,
+		"mentor_id":       change.MentorID,
+		"email_change_id": change.ID,
+		"recipient":       recipient,
+		"new_email":       change.NewEmail,
+		"by_moderator":    change.RequestedBy != "",
+	}
+	for key, value := range extra {
+		payload[key] = value
+	}
+	trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorEmailChangeTriggerURL, payload, s.httpClient)
+}
+
+func (s *ProfileService) emailChangeConfirmURL(token string) string {
+	return s.config.Server.BaseURL + "/mentor/email/confirm?token=" + token
+}
+
+func (s *ProfileService) trackEmailChange(ctx context.Context, event, mentorID, outcome string) {
+	if IsDryRun(ctx) {
+		return
+	}
+	s.tracker.Track(ctx, event, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+		"mentor_id": mentorID,
+		"outcome":   outcome,
+	})
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	patchKeyTags              = "tags"
+	patchKeyLanguages         = "languages"
+	patchKeyExpectedUpdatedAt = "expectedUpdatedAt"
+	patchKeyExpectedVersion   = models.ExpectedVersionKey
+	patchMaxTags              = 20
+	patchMaxTagLength         = 50
+)
+
+// patchField describes how a merge patch key maps onto a mentors column.
+// Limits mirror the binding rules of the full-update request models.
+type patchField struct {
+	column    string
+	maxLen    int
+	nullable  bool // null (or empty string) clears the column
+	adminOnly bool
+	boolean   bool // the value is a JSON boolean rather than a string
+	normalize func(value string) (interface{}, error)
+}
+
+// mentorPatchFields are the fields a mentor can patch on their own profile
+var mentorPatchFields = map[string]patchField{
+	"name":         {column: "name", maxLen: 100},
+	"job":          {column: "job_title", maxLen: 200},
+	"workplace":    {column: "workplace", maxLen: 200},
+	"experience":   {column: "experience", maxLen: 50},
+	"price":        {column: "price", maxLen: 100},
+	"description":  {column: "details", maxLen: 5000},
+	"about":        {column: "about", maxLen: 10000},
+	"competencies": {column: "competencies", maxLen: 5000},
+	"calendarUrl":  {column: "calendar_url", maxLen: 500, nullable: true, normalize: normalizePatchURL},
+	"timezone":     {column: "timezone", maxLen: 64, nullable: true, normalize: normalizePatchTimezone},
+	"contactHours": {column: "contact_hours", maxLen: 11, nullable: true, normalize: normalizePatchContactHours},
+	"country":      {column: "country", maxLen: 2, nullable: true, normalize: normalizePatchCountry},
+	"city":         {column: "city", maxLen: 100, nullable: true},
+	"remoteOnly":   {column: "remote_only", boolean: true},
+}
+
+// adminPatchFields extend mentorPatchFields with contact and admin-only fields
+var adminPatchFields = func() map[string]patchField {
+	fields := map[string]patchField{
+		"email":          {column: "email", maxLen: 255, normalize: normalizePatchEmail},
+		"telegram":       {column: "telegram", maxLen: 50, normalize: normalizePatchTelegram},
+		"slug":           {column: "slug", maxLen: 200, adminOnly: true},
+		"telegramChatId": {column: "telegram_chat_id", maxLen: 30, nullable: true, adminOnly: true, normalize: normalizePatchChatID},
+	}
+	for key, field := range mentorPatchFields {
+		fields[key] = field
+	}
+	return fields
+}()
+
+// profilePatch is a validated merge patch ready to be written
+type profilePatch struct {
+	updates  map[string]interface{}
+	tags     []string
+	hasTags  bool
+	expected repository.MentorPrecondition
+	changes  []models.ProfileFieldChange
+}
+
+// buildProfilePatch validates only the keys present in patch and diffs them against current,
+// whose JSON representation uses the same field names as the patch.
+func buildProfilePatch(patch models.MergePatch, fields map[string]patchField, current interface{}, isAdmin bool) (*profilePatch, error) {
+	currentValues, err := jsonFieldValues(current)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &profilePatch{updates: map[string]interface{}{}}
+	for _, key := range patch.Keys() {
+		switch key {
+		case patchKeyExpectedUpdatedAt:
+			if patch.IsNull(key) {
+				continue
+			}
+			var expected time.Time
+			if err := patch.Decode(key, &expected); err != nil {
+				return nil, apperrors.InvalidInputError(key, "must be an RFC 3339 timestamp")
+			}
+			result.expected.UpdatedAt = &expected
+			continue
+		case patchKeyExpectedVersion:
+			if patch.IsNull(key) {
+				continue
+			}
+			var expected int
+			if err := patch.Decode(key, &expected); err != nil {
+				return nil, apperrors.InvalidInputError(key, "must be an integer")
+			}
+			result.expected.Version = &expected
+			continue
+		case patchKeyTags:
+			tags, err := decodePatchTags(patch)
+			if err != nil {
+				return nil, err
+			}
+			// The tags change is recorded by the caller once sponsor tags are merged in
+			result.tags = tags
+			result.hasTags = true
+			continue
+		case patchKeyLanguages:
+			var languages []string
+			if patch.IsNull(key) || patch.Decode(key, &languages) != nil {
+				return nil, apperrors.InvalidInputError(key, "must be an array of strings")
+			}
+			normalized, err := models.NormalizeLanguages(languages)
+			if err != nil {
+				return nil, apperrors.InvalidInputError(key, err.Error())
+			}
+			if len(normalized) == 0 {
+				return nil, apperrors.InvalidInputError(key, "at least one language is required")
+			}
+			result.updates["languages"] = normalized
+			result.addChange(key, currentValues[key], normalized)
+			continue
+		}
+
+		field, ok := fields[key]
+		if !ok {
+			return nil, apperrors.InvalidInputError(key, "unknown or read-only field")
+		}
+		if field.adminOnly && !isAdmin {
+			return nil, ErrAdminForbiddenAction
+		}
+
+		if field.boolean {
+			var flag bool
+			if patch.IsNull(key) || patch.Decode(key, &flag) != nil {
+				return nil, apperrors.InvalidInputError(key, "must be a boolean")
+			}
+			result.updates[field.column] = flag
+			result.addChange(key, currentValues[key], flag)
+			continue
+		}
+
+		value, err := decodePatchString(patch, key, field)
+		if err != nil {
+			return nil, err
+		}
+
+		var columnValue interface{} = value
+		switch {
+		case value == "" && field.nullable:
+			columnValue = nil
+		case field.normalize != nil:
+			if columnValue, err = field.normalize(value); err != nil {
+				return nil, apperrors.InvalidInputError(key, err.Error())
+			}
+		}
+
+		result.updates[field.column] = columnValue
+		result.addChange(key, currentValues[key], columnValue)
+	}
+
+	return result, nil
+}
+
+func (p *profilePatch) addChange(field string, oldValue, newValue interface{}) {
+	if fmt.Sprint(normalizeAuditValue(oldValue)) == fmt.Sprint(normalizeAuditValue(newValue)) {
+		return
+	}
+	p.changes = append(p.changes, models.ProfileFieldChange{Field: field, Old: oldValue, New: newValue})
+}
+
+// dropChange removes the audit entry of field, if any
+func (p *profilePatch) dropChange(field string) {
+	changes := p.changes[:0]
+	for _, change := range p.changes {
+		if change.Field != field {
+			changes = append(changes, change)
+		}
+	}
+	p.changes = changes
+}
+
+// changedFields lists the names of the fields that actually changed
+func (p *profilePatch) changedFields() []string {
+	fields := make([]string, 0, len(p.changes))
+	for _, change := range p.changes {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
+
+// logProfileAuditEntries writes one log entry per changed field
+func logProfileAuditEntries(mentorID, actor string, changes []models.ProfileFieldChange) {
+	for _, change := range changes {
+		logger.Info("Mentor profile field changed",
+			zap.String("mentor_id", mentorID),
+			zap.String("actor", actor),
+			zap.String("field", change.Field))
+	}
+}
+
+func decodePatchString(patch models.MergePatch, key string, field patchField) (string, error) {
+	if patch.IsNull(key) {
+		if !field.nullable {
+			return "", apperrors.InvalidInputError(key, "cannot be null")
+		}
+		return "", nil
+	}
+
+	var value string
+	if err := patch.Decode(key, &value); err != nil {
+		return "", apperrors.InvalidInputError(key, "must be a string")
+	}
+	if utf8.RuneCountInString(value) > field.maxLen {
+		return "", apperrors.InvalidInputError(key, fmt.Sprintf("must be at most %d characters", field.maxLen))
+	}
+	if !field.nullable && strings.TrimSpace(value) == "" {
+		return "", apperrors.InvalidInputError(key, "cannot be empty")
+	}
+	return value, nil
+}
+
+func decodePatchTags(patch models.MergePatch) ([]string, error) {
+	if patch.IsNull(patchKeyTags) {
+		return []string{}, nil
+	}
+
+	var tags []string
+	if err := patch.Decode(patchKeyTags, &tags); err != nil {
+		return nil, apperrors.InvalidInputError(patchKeyTags, "must be an array of strings")
+	}
+	if len(tags) > patchMaxTags {
+		return nil, apperrors.InvalidInputError(patchKeyTags, fmt.Sprintf("must contain at most %d items", patchMaxTags))
+	}
+	for _, tag := range tags {
+		if utf8.RuneCountInString(tag) > patchMaxTagLength {
+			return nil, apperrors.InvalidInputError(patchKeyTags, fmt.Sprintf("items must be at most %d characters", patchMaxTagLength))
+		}
+	}
+	return tags, nil
+}
+
+// jsonFieldValues returns the JSON fields of v keyed by their JSON names
+func jsonFieldValues(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode current profile: %w", err)
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode current profile: %w", err)
+	}
+	return values, nil
+}
+
+// normalizeAuditValue makes values decoded from JSON comparable with column values
+func normalizeAuditValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, fmt.Sprint(item))
+		}
+		return items
+	default:
+		return v
+	}
+}
+
+func normalizePatchURL(value string) (interface{}, error) {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("must be a valid URL")
+	}
+	return value, nil
+}
+
+func normalizePatchTimezone(value string) (interface{}, error) {
+	tz := strings.TrimSpace(value)
+	if err := models.ValidateTimezone(tz); err != nil {
+		return nil, err
+	}
+	return nullIfEmpty(tz), nil
+}
+
+func normalizePatchCountry(value string) (interface{}, error) {
+	code, err := models.NormalizeCountryCode(value)
+	if err != nil {
+		return nil, err
+	}
+	return nullIfEmpty(code), nil
+}
+
+func normalizePatchContactHours(value string) (interface{}, error) {
+	hours := strings.TrimSpace(value)
+	if _, _, err := models.ParseContactHours(hours); err != nil {
+		return nil, err
+	}
+	return hours, nil
+}
+
+func normalizePatchEmail(value string) (interface{}, error) {
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value {
+		return nil, fmt.Errorf("must be a valid email")
+	}
+	return value, nil
+}
+
+func normalizePatchTelegram(value string) (interface{}, error) {
+	telegram := normalizeTelegramHandle(value)
+	if telegram == "" {
+		return nil, fmt.Errorf("cannot be empty")
+	}
+	return telegram, nil
+}
+
+func normalizePatchChatID(value string) (interface{}, error) {
+	chatID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("must be an integer")
+	}
+	return chatID, nil
+}
@@ -2,14 +2,17 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
 	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
@@ -17,37 +20,78 @@ import (
 	"go.uber.org/zap"
 )
 
+// ProfileMentorStore is the mentor repository ProfileService reads and saves through;
+// *repository.MentorRepository implements it, and *repository.DualWriteMentorRepository
+// also mirrors the saves to Airtable
+type ProfileMentorStore interface {
+	GetByMentorId(ctx context.Context, mentorId string, opts models.FilterOptions) (*models.Mentor, error)
+	GetTagIDByName(ctx context.Context, name string) (string, error)
+	GetVersion(ctx context.Context, mentorID string) (int, error)
+	UpdateIfUnmodified(ctx context.Context, mentorId string, updates map[string]interface{}, expected repository.MentorPrecondition) error
+	UpdateMentorTags(ctx context.Context, mentorID string, tagIDs []string) error
+	TouchUpdatedAt(ctx context.Context, mentorID string) error
+}
+
 type ProfileService struct {
-	mentorRepo   *repository.MentorRepository
-	yandexClient *yandex.StorageClient
-	config       *config.Config
-	httpClient   httpclient.Client
-	tracker      analytics.Tracker
+	mentorRepo      ProfileMentorStore
+	emailChangeRepo *repository.EmailChangeRepository
+	uow             *repository.UnitOfWork
+	yandexClient    *yandex.StorageClient
+	config          *config.Config
+	httpClient      httpclient.Client
+	tracker         analytics.Tracker
+	publisher       eventbus.Publisher
+	audit           *AuditLogger
 }
 
 func NewProfileService(
-	mentorRepo *repository.MentorRepository,
+	mentorRepo ProfileMentorStore,
+	emailChangeRepo *repository.EmailChangeRepository,
+	uow *repository.UnitOfWork,
 	yandexClient *yandex.StorageClient,
 	cfg *config.Config,
 	httpClient httpclient.Client,
 	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
+	audit *AuditLogger,
 ) *ProfileService {
 
 	if tracker == nil {
 		tracker = analytics.NoopTracker{}
 	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
 
 	return &ProfileService{
-		mentorRepo:   mentorRepo,
-		yandexClient: yandexClient,
-		config:       cfg,
-		httpClient:   httpClient,
-		tracker:      tracker,
+		mentorRepo:      mentorRepo,
+		emailChangeRepo: emailChangeRepo,
+		uow:             uow,
+		yandexClient:    yandexClient,
+		config:          cfg,
+		httpClient:      httpClient,
+		tracker:         tracker,
+		publisher:       publisher,
+		audit:           audit,
 	}
 }
 
+// GetProfileVersion returns the current version of the mentor's profile, which saves must send back
+func (s *ProfileService) GetProfileVersion(ctx context.Context, mentorID string) (int, error) {
+	return s.mentorRepo.GetVersion(ctx, mentorID)
+}
+
 // SaveProfileByMentorId updates a mentor's profile using Mentor ID (UUID) for session-based auth
 func (s *ProfileService) SaveProfileByMentorId(ctx context.Context, mentorID string, req *models.SaveProfileRequest) error {
+	expected := repository.MentorPrecondition{Version: req.ExpectedVersion, UpdatedAt: req.ExpectedUpdatedAt}
+	if !expected.IsSet() {
+		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "precondition_required",
+		})
+		return apperrors.ErrPreconditionRequired
+	}
+
 	// Get mentor to get current tags (for sponsor preservation)
 	mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true})
 	if err != nil {
@@ -58,34 +102,8 @@ func (s *ProfileService) SaveProfileByMentorId(ctx context.Context, mentorID str
 		return apperrors.NotFoundError("mentor")
 	}
 
-	// Get sponsor tags to preserve them
-	sponsorTags := models.SponsorTags
-	preservedSponsors := []string{}
-	for _, tag := range mentor.Tags {
-		if sponsorTags[tag] {
-			preservedSponsors = append(preservedSponsors, tag)
-		}
-	}
-
-	// Filter out sponsor tags from user input (they shouldn't be able to modify these)
-	userTags := []string{}
-	for _, tag := range req.Tags {
-		if !sponsorTags[tag] {
-			userTags = append(userTags, tag)
-		}
-	}
-
-	// Merge user tags with preserved sponsor tags
-	userTags = append(userTags, preservedSponsors...)
-
-	// Get tag IDs
-	tagIDs := []string{}
-	for _, tagName := range userTags {
-		tagID, tagErr := s.mentorRepo.GetTagIDByName(ctx, tagName)
-		if tagErr == nil && tagID != "" {
-			tagIDs = append(tagIDs, tagID)
-		}
-	}
+	userTags, preservedSponsors := mergeSponsorTags(mentor.Tags, req.Tags)
+	tagIDs := s.resolveTagIDs(ctx, userTags)
 
 	// Prepare updates with PostgreSQL column names
 	updates := map[string]interface{}{
@@ -103,8 +121,47 @@ func (s *ProfileService) SaveProfileByMentorId(ctx context.Context, mentorID str
 		updates["calendar_url"] = req.CalendarURL
 	}
 
-	// Update in database
-	if err := s.mentorRepo.Update(ctx, mentorID, updates); err != nil {
+	if err := applyAvailabilityUpdates(updates, req.Timezone, req.ContactHours); err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "invalid_availability",
+		})
+		return err
+	}
+	if err := applyLocationUpdates(updates, req.Country, req.City, req.RemoteOnly); err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "invalid_location",
+		})
+		return err
+	}
+	if err := applyLanguageUpdates(updates, req.Languages); err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "invalid_languages",
+		})
+		return err
+	}
+
+	// Update profile fields and tags atomically
+	err = s.audit.Track(ctx, models.AuditEntityMentor, mentorID, AuditOperationUpdateProfile, func(ctx context.Context) error {
+		return s.uow.Do(ctx, func(ctx context.Context) error {
+			if err := s.mentorRepo.UpdateIfUnmodified(ctx, mentorID, updates, expected); err != nil {
+				return err
+			}
+			return s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs)
+		})
+	})
+	var conflictErr *repository.VersionConflictError
+	if errors.As(err, &conflictErr) {
+		metrics.ProfileUpdates.WithLabelValues("conflict").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "version_conflict",
+		})
+		return err
+	}
+	if err != nil {
 		metrics.ProfileUpdates.WithLabelValues("error").Inc()
 		s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
 			"mentor_id":  mentorID,
@@ -117,30 +174,115 @@ func (s *ProfileService) SaveProfileByMentorId(ctx context.Context, mentorID str
 		return fmt.Errorf("failed to update profile")
 	}
 
-	// Update tags in mentor_tags table
-	if err := s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs); err != nil {
-		logger.Error("Failed to update mentor tags",
-			zap.Error(err),
-			zap.String("mentor_id", mentorID))
-		// Don't fail the whole update if tags fail - log and continue
-	}
-
 	metrics.ProfileUpdates.WithLabelValues("success").Inc()
 	s.tracker.Track(ctx, analytics.EventMentorProfileUpdated, analytics.MentorDistinctID(mentorID), map[string]interface{}{
 		"mentor_id":          mentorID,
 		"tags_count":         len(tagIDs),
 		"has_calendar_url":   strings.TrimSpace(req.CalendarURL) != "",
-		"preserved_sponsors": len(preservedSponsors),
+		"preserved_sponsors": preservedSponsors,
 		"outcome":            "success",
 	})
 	logger.Info("Mentor profile updated via session",
 		zap.String("mentor_id", mentorID))
+	s.publishMentorUpdated(ctx, mentor, nil)
 
 	return nil
 }
 
-// UploadPictureByMentorId uploads a profile picture using Mentor ID (UUID) for session-based auth
-func (s *ProfileService) UploadPictureByMentorId(ctx context.Context, mentorID string, mentorSlug string, req *models.UploadProfilePictureRequest) (string, error) {
+// PatchProfileByMentorId applies a JSON Merge Patch to the mentor's profile. Only the fields
+// present in the patch are validated and written; the returned changes are the field-level audit entries.
+func (s *ProfileService) PatchProfileByMentorId(ctx context.Context, mentorID string, patch models.MergePatch) ([]models.ProfileFieldChange, error) {
+	mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true})
+	if err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "mentor_not_found",
+		})
+		return nil, apperrors.NotFoundError("mentor")
+	}
+
+	patched, err := buildProfilePatch(patch, mentorPatchFields, mentor, false)
+	if err != nil {
+		s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "invalid_payload",
+		})
+		return nil, err
+	}
+	if !patched.expected.IsSet() {
+		s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "precondition_required",
+		})
+		return nil, apperrors.ErrPreconditionRequired
+	}
+
+	var tagIDs []string
+	if patched.hasTags {
+		// Sponsor tags are preserved exactly like in full profile saves
+		userTags, _ := mergeSponsorTags(mentor.Tags, patched.tags)
+		tagIDs = s.resolveTagIDs(ctx, userTags)
+		patched.addChange(patchKeyTags, mentor.Tags, userTags)
+	}
+
+	err = s.audit.Track(ctx, models.AuditEntityMentor, mentorID, AuditOperationPatchProfile, func(ctx context.Context) error {
+		return s.uow.Do(ctx, func(ctx context.Context) error {
+			if err := s.mentorRepo.UpdateIfUnmodified(ctx, mentorID, patched.updates, patched.expected); err != nil {
+				return err
+			}
+			if !patched.hasTags {
+				return nil
+			}
+			return s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs)
+		})
+	})
+	var conflictErr *repository.VersionConflictError
+	if errors.As(err, &conflictErr) {
+		metrics.ProfileUpdates.WithLabelValues("conflict").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "version_conflict",
+		})
+		return nil, err
+	}
+	if err != nil {
+		metrics.ProfileUpdates.WithLabelValues("error").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+			"mentor_id": mentorID,
+			"outcome":   "update_failed",
+		})
+		logger.Error("Failed to patch mentor profile",
+			zap.Error(err),
+			zap.String("mentor_id", mentorID))
+		return nil, fmt.Errorf("failed to update profile")
+	}
+
+	logProfileAuditEntries(mentorID, analytics.MentorDistinctID(mentorID), patched.changes)
+	metrics.ProfileUpdates.WithLabelValues("success").Inc()
+	s.tracker.Track(ctx, analytics.EventMentorProfilePatched, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+		"mentor_id":      mentorID,
+		"changed_fields": patched.changedFields(),
+		"outcome":        "success",
+	})
+	s.publishMentorUpdated(ctx, mentor, patched.changedFields())
+
+	return patched.changes, nil
+}
+
+// publishMentorUpdated emits mentor.updated after the mentor saved their profile
+func (s *ProfileService) publishMentorUpdated(ctx context.Context, mentor *models.Mentor, changedFields []string) {
+	events.Publish(ctx, s.publisher, events.MentorUpdated{
+		MentorID:      mentor.MentorID,
+		Slug:          mentor.Slug,
+		Status:        mentor.Status,
+		ChangedFields: changedFields,
+		Actor:         events.ActorMentor,
+	})
+}
+
+// UploadPictureByMentorId uploads a profile picture using Mentor ID (UUID) for session-based auth.
+// actor is events.ActorMentor or events.ActorAdmin and ends up in mentor.updated.
+func (s *ProfileService) UploadPictureByMentorId(ctx context.Context, mentorID string, mentorSlug string, actor string, req *models.UploadProfilePictureRequest) (string, error) {
 	// Upload to Yandex Object Storage in 3 sizes: full, large, small (synchronous)
 	// Validation (type and size) is handled automatically by UploadImageAllSizes
 	fullImageURL, err := s.yandexClient.UploadImageAllSizes(ctx, req.Image, mentorSlug, req.ContentType)
@@ -172,6 +314,20 @@ func (s *ProfileService) UploadPictureByMentorId(ctx context.Context, mentorID s
 			zap.Error(err),
 			zap.String("mentor_id", mentorID))
 	}
+	// Picture URLs are derived from the slug, so subscribers only learn that the images changed
+	if mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true}); err != nil {
+		logger.Error("Failed to reload mentor after picture upload",
+			zap.Error(err),
+			zap.String("mentor_id", mentorID))
+	} else {
+		events.Publish(ctx, s.publisher, events.MentorUpdated{
+			MentorID:      mentor.MentorID,
+			Slug:          mentor.Slug,
+			Status:        mentor.Status,
+			ChangedFields: []string{"picture"},
+			Actor:         actor,
+		})
+	}
 
 	metrics.ProfilePictureUploads.WithLabelValues("success").Inc()
 	s.tracker.Track(ctx, analytics.EventMentorProfilePictureUploaded, analytics.MentorDistinctID(mentorID), map[string]interface{}{
@@ -186,3 +342,102 @@ func (s *ProfileService) UploadPictureByMentorId(ctx context.Context, mentorID s
 
 	return fullImageURL, nil
 }
+
+// applyAvailabilityUpdates validates timezone/contact hours and adds them to updates.
+// A nil value leaves the stored column unchanged, an empty string clears it.
+func applyAvailabilityUpdates(updates map[string]interface{}, timezone, contactHours *string) error {
+	if timezone != nil {
+		tz := strings.TrimSpace(*timezone)
+		if err := models.ValidateTimezone(tz); err != nil {
+			return apperrors.InvalidInputError("timezone", err.Error())
+		}
+		updates["timezone"] = nullIfEmpty(tz)
+	}
+
+	if contactHours != nil {
+		hours := strings.TrimSpace(*contactHours)
+		if hours != "" {
+			if _, _, err := models.ParseContactHours(hours); err != nil {
+				return apperrors.InvalidInputError("contactHours", err.Error())
+			}
+		}
+		updates["contact_hours"] = nullIfEmpty(hours)
+	}
+
+	return nil
+}
+
+// applyLocationUpdates validates country/city/remote-only and adds them to updates.
+// Nil values are left unchanged; an empty country or city clears the column.
+func applyLocationUpdates(updates map[string]interface{}, country, city *string, remoteOnly *bool) error {
+	if country != nil {
+		code, err := models.NormalizeCountryCode(*country)
+		if err != nil {
+			return apperrors.InvalidInputError("country", err.Error())
+		}
+		updates["country"] = nullIfEmpty(code)
+	}
+
+	if city != nil {
+		updates["city"] = nullIfEmpty(strings.TrimSpace(*city))
+	}
+
+	if remoteOnly != nil {
+		updates["remote_only"] = *remoteOnly
+	}
+
+	return nil
+}
+
+// applyLanguageUpdates replaces the mentoring languages when the request carries them.
+// A mentor always keeps at least one language.
+func applyLanguageUpdates(updates map[string]interface{}, languages []string) error {
+	if languages == nil {
+		return nil
+	}
+	normalized, err := models.NormalizeLanguages(languages)
+	if err != nil {
+		return apperrors.InvalidInputError("languages", err.Error())
+	}
+	if len(normalized) == 0 {
+		return apperrors.InvalidInputError("languages", "at least one language is required")
+	}
+	updates["languages"] = normalized
+	return nil
+}
+
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// mergeSponsorTags drops sponsor tags from the requested tags (mentors can't set them)
+// and re-adds the sponsor tags the mentor currently has
+func mergeSponsorTags(currentTags, requested []string) (tags []string, preservedSponsors int) {
+	tags = []string{}
+	for _, tag := range requested {
+		if !models.SponsorTags[tag] {
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range currentTags {
+		if models.SponsorTags[tag] {
+			tags = append(tags, tag)
+			preservedSponsors++
+		}
+	}
+	return tags, preservedSponsors
+}
+
+func (s *ProfileService) resolveTagIDs(ctx context.Context, tags []string) []string {
+	tagIDs := []string{}
+	for _, tagName := range tags {
+		tagID, err := s.mentorRepo.GetTagIDByName(ctx, tagName)
+		if err == nil && tagID != "" {
+			tagIDs = append(tagIDs, tagID)
+		}
+	}
+	return tagIDs
+}
@@ -0,0 +1,474 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/recaptcha"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrProgramClosed is returned when registering for a program that is not published or already started
+	ErrProgramClosed = errors.New("program is not open for registration")
+)
+
+// ProgramService manages mentor programs (group workshops) and attendee registration
+type ProgramService struct {
+	programRepo       *repository.ProgramRepository
+	mentorRepo        *repository.MentorRepository
+	uow               *repository.UnitOfWork
+	config            *config.Config
+	httpClient        httpclient.Client
+	recaptchaVerifier *recaptcha.Verifier
+	tracker           analytics.Tracker
+}
+
+// NewProgramService creates a new program service
+func NewProgramService(
+	programRepo *repository.ProgramRepository,
+	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+) *ProgramService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+
+	return &ProgramService{
+		programRepo:       programRepo,
+		mentorRepo:        mentorRepo,
+		uow:               uow,
+		config:            cfg,
+		httpClient:        httpClient,
+		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+		tracker:           tracker,
+	}
+}
+
+// ListPublicPrograms returns upcoming published programs of visible mentors
+func (s *ProgramService) ListPublicPrograms(ctx context.Context) ([]*models.Program, error) {
+	return s.programRepo.List(ctx, models.ProgramFilter{PublicOnly: true})
+}
+
+// ListMentorPublicPrograms returns upcoming published programs of a visible mentor identified by legacy ID
+func (s *ProgramService) ListMentorPublicPrograms(ctx context.Context, legacyID int) ([]*models.Program, error) {
+	if _, err := s.mentorRepo.GetByID(ctx, legacyID, models.FilterOptions{OnlyVisible: true}); err != nil {
+		return nil, err
+	}
+	return s.programRepo.List(ctx, models.ProgramFilter{MentorLegacyID: legacyID, PublicOnly: true})
+}
+
+// ListMentorPrograms returns all programs of the mentor, drafts included
+func (s *ProgramService) ListMentorPrograms(ctx context.Context, mentorID string) ([]*models.Program, error) {
+	return s.programRepo.List(ctx, models.ProgramFilter{MentorID: mentorID})
+}
+
+// CreateMentorProgram creates a program owned by the mentor
+func (s *ProgramService) CreateMentorProgram(ctx context.Context, mentorID string, req *models.ProgramRequest) (*models.Program, error) {
+	return s.createProgram(ctx, mentorID, req, analytics.MentorDistinctID(mentorID))
+}
+
+// UpdateMentorProgram replaces a program owned by the mentor
+func (s *ProgramService) UpdateMentorProgram(ctx context.Context, mentorID, programID string, req *models.ProgramRequest) (*models.Program, error) {
+	if _, err := s.getOwnedProgram(ctx, mentorID, programID); err != nil {
+		return nil, err
+	}
+	return s.updateProgram(ctx, programID, req, analytics.MentorDistinctID(mentorID))
+}
+
+// DeleteMentorProgram deletes a program owned by the mentor
+func (s *ProgramService) DeleteMentorProgram(ctx context.Context, mentorID, programID string) error {
+	if _, err := s.getOwnedProgram(ctx, mentorID, programID); err != nil {
+		return err
+	}
+	return s.deleteProgram(ctx, programID, analytics.MentorDistinctID(mentorID))
+}
+
+// ListPrograms returns all programs, optionally for a single mentor. Admin only.
+func (s *ProgramService) ListPrograms(ctx context.Context, session *models.AdminSession, mentorID string) ([]*models.Program, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	return s.programRepo.List(ctx, models.ProgramFilter{MentorID: mentorID})
+}
+
+// CreateProgram creates a program for the mentor given in the request. Admin only.
+func (s *ProgramService) CreateProgram(ctx context.Context, session *models.AdminSession, req *models.ProgramRequest) (*models.Program, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	if req.MentorID == "" {
+		return nil, apperrors.InvalidInputError("mentorId", "is required")
+	}
+	if _, err := s.mentorRepo.GetByMentorId(ctx, req.MentorID, models.FilterOptions{ShowHidden: true}); err != nil {
+		return nil, apperrors.NotFoundError("mentor")
+	}
+	return s.createProgram(ctx, req.MentorID, req, analytics.ModeratorDistinctID(session.ModeratorID))
+}
+
+// UpdateProgram replaces any program. Admin only.
+func (s *ProgramService) UpdateProgram(ctx context.Context, session *models.AdminSession, programID string, req *models.ProgramRequest) (*models.Program, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	return s.updateProgram(ctx, programID, req, analytics.ModeratorDistinctID(session.ModeratorID))
+}
+
+// DeleteProgram deletes any program. Admin only.
+func (s *ProgramService) DeleteProgram(ctx context.Context, session *models.AdminSession, programID string) error {
+	if session.Role != models.ModeratorRoleAdmin {
+		return ErrAdminForbiddenAction
+	}
+	return s.deleteProgram(ctx, programID, analytics.ModeratorDistinctID(session.ModeratorID))
+}
+
+// RegisterAttendee registers an attendee for a published upcoming program.
+// It follows the contact form pipeline: captcha, persist, trigger, metrics and analytics.
+// Once the program is full, attendees are put on the waitlist instead.
+func (s *ProgramService) RegisterAttendee(
+	ctx context.Context,
+	programID string,
+	req *models.RegisterProgramAttendeeRequest,
+) (*models.RegisterProgramAttendeeResponse, error) {
+
+	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
+		metrics.ProgramRegistrations.WithLabelValues("captcha_failed").Inc()
+		s.trackRegistration(ctx, programID, "", "captcha_failed")
+		logger.Warn("ReCAPTCHA verification failed", zap.Error(err))
+		return &models.RegisterProgramAttendeeResponse{
+			Success: false,
+			Error:   "Captcha verification failed",
+		}, fmt.Errorf("captcha verification failed: %w", err)
+	}
+
+	cancelToken, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cancel token: %w", err)
+	}
+
+	registration := &models.ProgramRegistration{
+		ProgramID:   programID,
+		Name:        strings.TrimSpace(req.Name),
+		Email:       req.Email,
+		Telegram:    normalizeTelegramHandle(req.TelegramUsername),
+		Comment:     req.Comment,
+		CancelToken: cancelToken,
+	}
+
+	var program *models.Program
+	var registrationID string
+	err = s.uow.Do(ctx, func(txCtx context.Context) error {
+		var lockErr error
+		program, lockErr = s.programRepo.GetForRegistration(txCtx, programID)
+		if lockErr != nil {
+			return lockErr
+		}
+		if !program.IsOpenForRegistration(time.Now()) {
+			return ErrProgramClosed
+		}
+
+		registration.Status = models.ProgramRegistrationRegistered
+		if program.SeatsLeft() == 0 {
+			registration.Status = models.ProgramRegistrationWaitlisted
+		}
+
+		var createErr error
+		registrationID, createErr = s.programRepo.CreateRegistration(txCtx, registration)
+		return createErr
+	})
+
+	switch {
+	case errors.Is(err, repository.ErrProgramNotFound):
+		metrics.ProgramRegistrations.WithLabelValues("not_found").Inc()
+		s.trackRegistration(ctx, programID, "", "program_not_found")
+		return nil, err
+	case errors.Is(err, ErrProgramClosed):
+		metrics.ProgramRegistrations.WithLabelValues("closed").Inc()
+		s.trackRegistration(ctx, programID, "", "program_closed")
+		return nil, err
+	case errors.Is(err, repository.ErrAlreadyRegistered):
+		metrics.ProgramRegistrations.WithLabelValues("duplicate").Inc()
+		s.trackRegistration(ctx, programID, "", "already_registered")
+		return nil, err
+	case err != nil:
+		metrics.ProgramRegistrations.WithLabelValues("error").Inc()
+		s.trackRegistration(ctx, programID, "", "db_error")
+		logger.Error("Failed to create program registration", zap.Error(err), zap.String("program_id", programID))
+		return &models.RegisterProgramAttendeeResponse{
+			Success: false,
+			Error:   "Failed to save registration",
+		}, fmt.Errorf("failed to create program registration: %w", err)
+	}
+
+	// Trigger program registration webhook (non-blocking)
+	trigger.CallAsync(s.config.EventTriggers.ProgramRegistrationTriggerURL, registrationID, s.httpClient)
+
+	if registration.Status == models.ProgramRegistrationRegistered {
+		program.RegisteredCount++
+	}
+	recordProgramFillRatio(program)
+	metrics.ProgramRegistrations.WithLabelValues(registration.Status).Inc()
+	s.trackRegistration(ctx, programID, registrationID, registration.Status)
+
+	return &models.RegisterProgramAttendeeResponse{
+		Success:        true,
+		RegistrationID: registrationID,
+		Status:         registration.Status,
+		CancelToken:    cancelToken,
+	}, nil
+}
+
+// CancelRegistration cancels the registration identified by its cancel token.
+// A freed seat goes to the earliest waitlisted attendee while the program hasn't started.
+func (s *ProgramService) CancelRegistration(ctx context.Context, cancelToken string) (*models.CancelProgramRegistrationResponse, error) {
+	var program *models.Program
+	var promotedID string
+	alreadyCancelled := false
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		registration, err := s.programRepo.GetRegistrationByToken(txCtx, cancelToken)
+		if err != nil {
+			return err
+		}
+		// Lock the program first so cancellation and promotion don't race with new registrations
+		program, err = s.programRepo.GetForRegistration(txCtx, registration.ProgramID)
+		if err != nil {
+			return err
+		}
+		if registration.Status == models.ProgramRegistrationCancelled {
+			alreadyCancelled = true
+			return nil
+		}
+
+		if err := s.programRepo.CancelRegistration(txCtx, registration.ID); err != nil {
+			return err
+		}
+		if registration.Status != models.ProgramRegistrationRegistered {
+			program.WaitlistCount--
+			return nil
+		}
+
+		program.RegisteredCount--
+		if !program.IsOpenForRegistration(time.Now()) {
+			return nil
+		}
+		promotedID, err = s.programRepo.PromoteFromWaitlist(txCtx, program.ID)
+		if err == nil && promotedID != "" {
+			program.RegisteredCount++
+			program.WaitlistCount--
+		}
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrRegistrationNotFound) {
+			metrics.ProgramRegistrations.WithLabelValues("cancel_error").Inc()
+			logger.Error("Failed to cancel program registration", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	if alreadyCancelled {
+		return &models.CancelProgramRegistrationResponse{Success: true, Status: models.ProgramRegistrationCancelled}, nil
+	}
+
+	recordProgramFillRatio(program)
+	metrics.ProgramRegistrations.WithLabelValues("cancelled").Inc()
+	s.tracker.Track(ctx, analytics.EventProgramRegistrationCancelled, analytics.SystemDistinctID("api"), map[string]interface{}{
+		"program_id": program.ID,
+		"promoted":   promotedID != "",
+	})
+
+	if promotedID != "" {
+		// The promoted attendee is notified through the same webhook as a new registration
+		trigger.CallAsync(s.config.EventTriggers.ProgramRegistrationTriggerURL, promotedID, s.httpClient)
+		metrics.ProgramRegistrations.WithLabelValues("promoted").Inc()
+	}
+
+	return &models.CancelProgramRegistrationResponse{Success: true, Status: models.ProgramRegistrationCancelled}, nil
+}
+
+// GetRegistrationCalendar renders the program as an iCalendar file for the attendee
+// identified by the cancel token. Waitlisted attendees get a tentative event and
+// cancelled registrations or programs a cancelled one, so calendar clients drop it.
+func (s *ProgramService) GetRegistrationCalendar(ctx context.Context, cancelToken string) ([]byte, error) {
+	registration, err := s.programRepo.GetRegistrationByToken(ctx, cancelToken)
+	if err != nil {
+		return nil, err
+	}
+	program, err := s.programRepo.GetByID(ctx, registration.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite := ical.Invite{
+		UID:         program.ID + "@getmentor.dev",
+		Summary:     program.Title,
+		Description: programInviteDescription(program),
+		Start:       program.StartsAt,
+		End:         program.EndsAt(),
+		Stamp:       time.Now(),
+		Status:      "CONFIRMED",
+	}
+	if s.config.Server.BaseURL != "" {
+		invite.URL = s.config.Server.BaseURL + "/mentor/" + program.MentorSlug
+	}
+
+	switch {
+	case registration.Status == models.ProgramRegistrationCancelled || program.Status == models.ProgramStatusCancelled:
+		invite.Status = "CANCELLED"
+		invite.Sequence = 1
+	case registration.Status == models.ProgramRegistrationWaitlisted:
+		invite.Status = "TENTATIVE"
+	}
+
+	return ical.Encode("-//GetMentor//Programs//RU", invite), nil
+}
+
+func (s *ProgramService) getOwnedProgram(ctx context.Context, mentorID, programID string) (*models.Program, error) {
+	program, err := s.programRepo.GetByID(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+	// Other mentors' programs are reported as missing rather than forbidden
+	if program.MentorID != mentorID {
+		return nil, repository.ErrProgramNotFound
+	}
+	return program, nil
+}
+
+func (s *ProgramService) createProgram(ctx context.Context, mentorID string, req *models.ProgramRequest, actor string) (*models.Program, error) {
+	if !req.StartsAt.After(time.Now()) {
+		return nil, apperrors.InvalidInputError("startsAt", "must be in the future")
+	}
+	if req.Status == "" {
+		req.Status = models.ProgramStatusPublished
+	}
+
+	programID, err := s.programRepo.Create(ctx, mentorID, req)
+	if err != nil {
+		s.trackProgramSaved(ctx, actor, "", "create", "db_error")
+		return nil, err
+	}
+
+	s.trackProgramSaved(ctx, actor, programID, "create", "success")
+	program, err := s.programRepo.GetByID(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+	recordProgramFillRatio(program)
+	return program, nil
+}
+
+func (s *ProgramService) updateProgram(ctx context.Context, programID string, req *models.ProgramRequest, actor string) (*models.Program, error) {
+	if req.Status == "" {
+		req.Status = models.ProgramStatusPublished
+	}
+
+	var program *models.Program
+	var promotedIDs []string
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		if err := s.programRepo.Update(txCtx, programID, req); err != nil {
+			return err
+		}
+
+		var err error
+		program, err = s.programRepo.GetForRegistration(txCtx, programID)
+		if err != nil {
+			return err
+		}
+		// A raised capacity is filled from the waitlist right away
+		for program.SeatsLeft() > 0 && program.WaitlistCount > 0 && program.IsOpenForRegistration(time.Now()) {
+			promotedID, err := s.programRepo.PromoteFromWaitlist(txCtx, programID)
+			if err != nil || promotedID == "" {
+				return err
+			}
+			promotedIDs = append(promotedIDs, promotedID)
+			program.RegisteredCount++
+			program.WaitlistCount--
+		}
+		return nil
+	})
+	if err != nil {
+		s.trackProgramSaved(ctx, actor, programID, "update", "update_failed")
+		return nil, err
+	}
+
+	for _, promotedID := range promotedIDs {
+		trigger.CallAsync(s.config.EventTriggers.ProgramRegistrationTriggerURL, promotedID, s.httpClient)
+		metrics.ProgramRegistrations.WithLabelValues("promoted").Inc()
+	}
+	recordProgramFillRatio(program)
+	s.trackProgramSaved(ctx, actor, programID, "update", "success")
+	return program, nil
+}
+
+func (s *ProgramService) deleteProgram(ctx context.Context, programID string, actor string) error {
+	if err := s.programRepo.Delete(ctx, programID); err != nil {
+		s.trackProgramSaved(ctx, actor, programID, "delete", "delete_failed")
+		return err
+	}
+	metrics.ProgramFillRatio.DeleteLabelValues(programID)
+	s.trackProgramSaved(ctx, actor, programID, "delete", "success")
+	return nil
+}
+
+// recordProgramFillRatio publishes the share of taken seats of the program
+func recordProgramFillRatio(program *models.Program) {
+	metrics.ProgramFillRatio.WithLabelValues(program.ID).Set(program.FillRate())
+}
+
+func programInviteDescription(program *models.Program) string {
+	parts := []string{}
+	if program.Description != "" {
+		parts = append(parts, program.Description)
+	}
+	if program.Schedule != "" {
+		parts = append(parts, program.Schedule)
+	}
+	parts = append(parts, "Ментор: "+program.MentorName)
+	return strings.Join(parts, "\n\n")
+}
+
+// generateSecureToken creates a random token for capability URLs (registration cancel links, calendar feeds)
+func generateSecureToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+func (s *ProgramService) trackProgramSaved(ctx context.Context, actor, programID, action, outcome string) {
+	s.tracker.Track(ctx, analytics.EventProgramSaved, actor, map[string]interface{}{
+		"program_id": programID,
+		"action":     action,
+		"outcome":    outcome,
+	})
+}
+
+func (s *ProgramService) trackRegistration(ctx context.Context, programID, registrationID, outcome string) {
+	s.tracker.Track(ctx, analytics.EventProgramRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+		"program_id":      programID,
+		"registration_id": registrationID,
+		"outcome":         outcome,
+	})
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+)
+
+// PublicStatsTTL is how long computed counters are served before recomputing
+const PublicStatsTTL = 5 * time.Minute
+
+// PublicStatsService serves the landing page counters. They are computed with a single
+// query and kept in memory, so the endpoint stays cheap under any traffic.
+type PublicStatsService struct {
+	statsRepo *repository.StatsRepository
+
+	mu    sync.Mutex
+	stats *models.PublicStats
+}
+
+// NewPublicStatsService creates a new public stats service
+func NewPublicStatsService(statsRepo *repository.StatsRepository) *PublicStatsService {
+	return &PublicStatsService{
+		statsRepo: statsRepo,
+	}
+}
+
+// GetStats returns the cached counters, recomputing them once they are older than PublicStatsTTL.
+// If recomputation fails, the previous counters are served until the next attempt.
+func (s *PublicStatsService) GetStats(ctx context.Context) (*models.PublicStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	if s.stats != nil && now.Sub(s.stats.GeneratedAt) < PublicStatsTTL {
+		metrics.CacheHits.WithLabelValues("public_stats").Inc()
+		return s.stats, nil
+	}
+	metrics.CacheMisses.WithLabelValues("public_stats").Inc()
+
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	stats, err := s.statsRepo.FetchPublicStats(ctx, monthStart)
+	if err != nil {
+		if s.stats != nil {
+			return s.stats, nil
+		}
+		return nil, err
+	}
+
+	stats.GeneratedAt = now
+	s.stats = stats
+	return stats, nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// QuarantineService serves the admin queue of requests held back by shadow antispam rules
+type QuarantineService struct {
+	clientRequestRepo *repository.ClientRequestRepository
+	config            *config.Config
+	httpClient        httpclient.Client
+	notifications     *NotificationDispatcher
+	tracker           analytics.Tracker
+	publisher         eventbus.Publisher
+}
+
+// NewQuarantineService creates a new quarantine service
+func NewQuarantineService(
+	clientRequestRepo *repository.ClientRequestRepository,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	notifications *NotificationDispatcher,
+	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
+) *QuarantineService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
+
+	return &QuarantineService{
+		clientRequestRepo: clientRequestRepo,
+		config:            cfg,
+		httpClient:        httpClient,
+		notifications:     notifications,
+		tracker:           tracker,
+		publisher:         publisher,
+	}
+}
+
+// ListQuarantined returns requests waiting for a verdict. Available to moderators and admins.
+func (s *QuarantineService) ListQuarantined(ctx context.Context, session *models.AdminSession) ([]*models.QuarantinedRequest, error) {
+	return s.clientRequestRepo.ListQuarantined(ctx)
+}
+
+// SetVerdict releases a quarantined request to the mentor or discards it
+func (s *QuarantineService) SetVerdict(ctx context.Context, session *models.AdminSession, requestID, verdict string) error {
+	status := models.QuarantineStatusDiscarded
+	if verdict == models.QuarantineVerdictRelease {
+		status = models.QuarantineStatusReleased
+	}
+
+	err := s.clientRequestRepo.SetQuarantineStatus(ctx, requestID, status, session.ModeratorID)
+	outcome := "success"
+	if err != nil {
+		outcome = "update_failed"
+	}
+	s.tracker.Track(ctx, analytics.EventAdminQuarantineVerdict, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"request_id":     requestID,
+		"verdict":        verdict,
+		"outcome":        outcome,
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.QuarantineVerdicts.WithLabelValues(verdict).Inc()
+	logger.Info("Quarantine verdict recorded",
+		zap.String("request_id", requestID),
+		zap.String("verdict", verdict),
+		zap.String("moderator_id", session.ModeratorID))
+
+	// The mentor hears about a released request just like about a fresh one
+	if status == models.QuarantineStatusReleased {
+		request, getErr := s.clientRequestRepo.GetByID(ctx, requestID)
+		if getErr != nil {
+			// Without the mentor, quiet hours can't be checked; the mentor still hears about it
+			logger.Warn("Failed to load released request", zap.String("request_id", requestID), zap.Error(getErr))
+			trigger.CallAsync(s.config.EventTriggers.MentorRequestCreatedTriggerURL, requestID, s.httpClient)
+			return nil
+		}
+		s.notifications.Notify(ctx, &models.MentorNotification{
+			MentorID: request.MentorID,
+			Channel:  models.NotificationChannelMentorRequestCreated,
+			RecordID: requestID,
+		})
+		events.Publish(ctx, s.publisher, events.RequestCreated{
+			RequestID: request.ID,
+			MentorID:  request.MentorID,
+			Level:     request.Level,
+		})
+	}
+	return nil
+}
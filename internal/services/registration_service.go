@@ -9,6 +9,8 @@ import (
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	"github.com/getmentor/getmentor-api/pkg/botcheck"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
@@ -26,16 +28,25 @@ const (
 // RegistrationService handles mentor registration
 type RegistrationService struct {
 	mentorRepo        *repository.MentorRepository
+	uow               *repository.UnitOfWork
+	blocklist         *BlocklistService
+	moderationRules   *ModerationRulesService
+	returning         *ReturningMentorService
 	yandexClient      *yandex.StorageClient
 	config            *config.Config
 	httpClient        httpclient.Client
 	recaptchaVerifier *recaptcha.Verifier
+	botChecker        *botcheck.Checker
 	tracker           analytics.Tracker
 }
 
 // NewRegistrationService creates a new registration service instance
 func NewRegistrationService(
 	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	blocklist *BlocklistService,
+	moderationRules *ModerationRulesService,
+	returning *ReturningMentorService,
 	yandexClient *yandex.StorageClient,
 	cfg *config.Config,
 	httpClient httpclient.Client,
@@ -48,10 +59,15 @@ func NewRegistrationService(
 
 	return &RegistrationService{
 		mentorRepo:        mentorRepo,
+		uow:               uow,
+		blocklist:         blocklist,
+		moderationRules:   moderationRules,
+		returning:         returning,
 		yandexClient:      yandexClient,
 		config:            cfg,
 		httpClient:        httpClient,
 		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
+		botChecker:        newBotChecker(cfg),
 		tracker:           tracker,
 	}
 }
@@ -64,7 +80,21 @@ func (s *RegistrationService) RegisterMentor(ctx context.Context, req *models.Re
 		"has_profile_picture": req.ProfilePicture.Image != "",
 	}
 
-	// 1. Verify ReCAPTCHA
+	// 1. Reject obvious bots before spending a ReCAPTCHA verification on them, then verify ReCAPTCHA
+	if err := checkBotSignals(s.botChecker, "registration", req.Website, req.FormRenderedAt); err != nil {
+		metrics.MentorRegistrations.WithLabelValues("bot_detected").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+			"tags_count":          len(req.Tags),
+			"has_calendar_url":    strings.TrimSpace(req.CalendarURL) != "",
+			"has_profile_picture": req.ProfilePicture.Image != "",
+			"outcome":             "bot_detected",
+		})
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   "Registration rejected",
+		}, err
+	}
+
 	if err := s.recaptchaVerifier.Verify(req.RecaptchaToken); err != nil {
 		metrics.MentorRegistrations.WithLabelValues("captcha_failed").Inc()
 		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
@@ -80,13 +110,77 @@ func (s *RegistrationService) RegisterMentor(ctx context.Context, req *models.Re
 		}, fmt.Errorf("captcha verification failed: %w", err)
 	}
 
-	// 2. Clean telegram handle (remove @ and t.me/ prefix)
+	// 2. Check the blocklist. Flag and shadow entries let registrations through: every new mentor is moderated anyway.
+	entry, err := s.blocklist.Check(ctx, "registration", models.BlocklistSubject{
+		Email:    req.Email,
+		Telegram: req.Telegram,
+		IP:       req.ClientIP,
+	})
+	if err != nil {
+		logger.Error("Blocklist check failed", zap.Error(err))
+	}
+	if entry != nil && entry.Action == models.BlocklistActionReject {
+		metrics.MentorRegistrations.WithLabelValues("blocked").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+			"tags_count":          len(req.Tags),
+			"has_calendar_url":    strings.TrimSpace(req.CalendarURL) != "",
+			"has_profile_picture": req.ProfilePicture.Image != "",
+			"outcome":             "blocked",
+		})
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   "Registration rejected",
+		}, fmt.Errorf("%w: entry %s", ErrSubmissionBlocked, entry.ID)
+	}
+
+	// 3. Apply the moderation rules admins maintain in the database
+	rules := s.moderationRules.Rules(ctx)
+	if len(req.Tags) > rules.MaxTags {
+		metrics.MentorRegistrations.WithLabelValues("invalid_input").Inc()
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   fmt.Sprintf("Select at most %d tags", rules.MaxTags),
+		}, apperrors.InvalidInputError("tags", fmt.Sprintf("must contain at most %d items", rules.MaxTags))
+	}
+	if word := rules.FindBannedWord(req.Name, req.Job, req.Workplace, req.About, req.Description, req.Competencies); word != "" {
+		metrics.MentorRegistrations.WithLabelValues("banned_words").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+			"tags_count":          len(req.Tags),
+			"has_calendar_url":    strings.TrimSpace(req.CalendarURL) != "",
+			"has_profile_picture": req.ProfilePicture.Image != "",
+			"outcome":             "banned_words",
+		})
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   "Registration rejected",
+		}, fmt.Errorf("%w: %q", ErrBannedWord, word)
+	}
+
+	// 4. Former mentors get a link to restore their profile instead of a second one
+	if offered, err := s.returning.OfferReactivation(ctx, req.Email, rules.AutoApprove); err != nil {
+		logger.Error("Failed to offer reactivation to a returning mentor", zap.Error(err))
+	} else if offered {
+		metrics.MentorRegistrations.WithLabelValues("returning").Inc()
+		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
+			"tags_count":          len(req.Tags),
+			"has_calendar_url":    strings.TrimSpace(req.CalendarURL) != "",
+			"has_profile_picture": req.ProfilePicture.Image != "",
+			"outcome":             "returning",
+		})
+		return &models.RegisterMentorResponse{
+			Success:   true,
+			Message:   "Welcome back! We've sent you an email with a link to restore your profile.",
+			Returning: true,
+		}, nil
+	}
+
+	// 5. Clean telegram handle (remove @ and t.me/ prefix)
 	telegram := strings.TrimSpace(req.Telegram)
 	telegram = strings.TrimPrefix(telegram, "@")
 	telegram = strings.TrimPrefix(telegram, "https://t.me/")
 	telegram = strings.TrimPrefix(telegram, "t.me/")
 
-	// 3. Get tag IDs for selected tags
+	// 6. Get tag IDs for selected tags
 	var tagIDs []string
 	for _, tagName := range req.Tags {
 		tagID, err := s.mentorRepo.GetTagIDByName(ctx, tagName)
@@ -97,7 +191,7 @@ func (s *RegistrationService) RegisterMentor(ctx context.Context, req *models.Re
 		}
 	}
 
-	// 4. Create mentor record in PostgreSQL
+	// 7. Create mentor record in PostgreSQL
 	fields := map[string]interface{}{
 		"name":         strings.TrimSpace(req.Name),
 		"email":        req.Email,
@@ -115,11 +209,58 @@ func (s *RegistrationService) RegisterMentor(ctx context.Context, req *models.Re
 	if req.CalendarURL != "" {
 		fields["calendar_url"] = req.CalendarURL
 	}
+	if country, err := models.NormalizeCountryCode(req.Country); err != nil {
+		metrics.MentorRegistrations.WithLabelValues("invalid_input").Inc()
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   "Invalid country",
+		}, apperrors.InvalidInputError("country", err.Error())
+	} else if country != "" {
+		fields["country"] = country
+	}
+	if city := strings.TrimSpace(req.City); city != "" {
+		fields["city"] = city
+	}
+	fields["remote_only"] = req.RemoteOnly
+	if languages, err := models.NormalizeLanguages(req.Languages); err != nil {
+		metrics.MentorRegistrations.WithLabelValues("invalid_input").Inc()
+		return &models.RegisterMentorResponse{
+			Success: false,
+			Error:   "Invalid languages",
+		}, apperrors.InvalidInputError("languages", err.Error())
+	} else if len(languages) > 0 {
+		fields["languages"] = languages
+	}
+
+	// Mentor record and tags are written in one transaction. The picture upload and
+	// the mentor created trigger run only after commit, so a failed registration
+	// leaves neither a half-created mentor nor orphaned side effects behind.
+	var mentorID, mentorSlug string
+	var legacyID int
+	err = s.uow.Do(ctx, func(txCtx context.Context) error {
+		var createErr error
+		mentorID, legacyID, mentorSlug, createErr = s.mentorRepo.CreateMentor(txCtx, fields)
+		if createErr != nil {
+			return createErr
+		}
+
+		if len(tagIDs) > 0 {
+			if tagsErr := s.mentorRepo.UpdateMentorTags(txCtx, mentorID, tagIDs); tagsErr != nil {
+				return fmt.Errorf("failed to set mentor tags: %w", tagsErr)
+			}
+		}
 
-	// Note: Tags will be inserted separately into mentor_tags table
-	// This is handled by the repository CreateMentor method
+		// 8. Upload profile picture (non-blocking on failure)
+		repository.AfterCommit(txCtx, func() {
+			s.yandexClient.UploadImageAllSizesAsync(ctx, req.ProfilePicture.Image, mentorSlug, req.ProfilePicture.ContentType, mentorID)
+		})
 
-	mentorID, legacyID, mentorSlug, err := s.mentorRepo.CreateMentor(ctx, fields)
+		// 9. Trigger mentor created webhook (non-blocking)
+		repository.AfterCommit(txCtx, func() {
+			trigger.CallAsync(s.config.EventTriggers.MentorCreatedTriggerURL, mentorID, s.httpClient)
+		})
+		return nil
+	})
 	if err != nil {
 		metrics.MentorRegistrations.WithLabelValues("db_error").Inc()
 		s.tracker.Track(ctx, analytics.EventMentorRegistrationSubmitted, analytics.SystemDistinctID("api"), map[string]interface{}{
@@ -140,20 +281,6 @@ func (s *RegistrationService) RegisterMentor(ctx context.Context, req *models.Re
 		zap.Int("legacy_id", legacyID),
 		zap.String("email", req.Email))
 
-	// Set mentor tags if any were provided
-	if len(tagIDs) > 0 {
-		if err := s.mentorRepo.UpdateMentorTags(ctx, mentorID, tagIDs); err != nil {
-			logger.Error("Failed to set mentor tags", zap.Error(err))
-			// Don't fail registration if tags fail - continue
-		}
-	}
-
-	// 5. Upload profile picture (non-blocking on failure)
-	s.yandexClient.UploadImageAllSizesAsync(ctx, req.ProfilePicture.Image, mentorSlug, req.ProfilePicture.ContentType, mentorID)
-
-	// 6. Trigger mentor created webhook (non-blocking)
-	trigger.CallAsync(s.config.EventTriggers.MentorCreatedTriggerURL, mentorID, s.httpClient)
-
 	metrics.MentorRegistrations.WithLabelValues("success").Inc()
 	successProperties := make(map[string]interface{}, len(baseProperties)+4)
 	for key, value := range baseProperties {
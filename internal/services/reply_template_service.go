@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ReplyTemplateService manages mentors' saved replies and fills them in for requests
+type ReplyTemplateService struct {
+	templateRepo *repository.ReplyTemplateRepository
+	requestRepo  *repository.ClientRequestRepository
+	mentorRepo   *repository.MentorRepository
+}
+
+// NewReplyTemplateService creates a new reply template service
+func NewReplyTemplateService(
+	templateRepo *repository.ReplyTemplateRepository,
+	requestRepo *repository.ClientRequestRepository,
+	mentorRepo *repository.MentorRepository,
+) *ReplyTemplateService {
+
+	return &ReplyTemplateService{
+		templateRepo: templateRepo,
+		requestRepo:  requestRepo,
+		mentorRepo:   mentorRepo,
+	}
+}
+
+// ListTemplates returns the mentor's templates
+func (s *ReplyTemplateService) ListTemplates(ctx context.Context, mentorID string) ([]*models.ReplyTemplate, error) {
+	return s.templateRepo.ListByMentor(ctx, mentorID)
+}
+
+// CreateTemplate saves a new template, up to MaxReplyTemplatesPerMentor per mentor
+func (s *ReplyTemplateService) CreateTemplate(ctx context.Context, mentorID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error) {
+	normalized, err := normalizeReplyTemplateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.templateRepo.Count(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= models.MaxReplyTemplatesPerMentor {
+		return nil, fmt.Errorf("%w: at most %d templates allowed", apperrors.ErrInvalidInput, models.MaxReplyTemplatesPerMentor)
+	}
+
+	template, err := s.templateRepo.Create(ctx, mentorID, normalized)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Reply template created", zap.String("mentor_id", mentorID), zap.String("template_id", template.ID))
+	return template, nil
+}
+
+// UpdateTemplate replaces one of the mentor's templates
+func (s *ReplyTemplateService) UpdateTemplate(ctx context.Context, mentorID, templateID string, req *models.ReplyTemplateRequest) (*models.ReplyTemplate, error) {
+	normalized, err := normalizeReplyTemplateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.templateRepo.Update(ctx, mentorID, templateID, normalized)
+}
+
+// DeleteTemplate removes one of the mentor's templates
+func (s *ReplyTemplateService) DeleteTemplate(ctx context.Context, mentorID, templateID string) error {
+	return s.templateRepo.Delete(ctx, mentorID, templateID)
+}
+
+// RenderTemplate fills in the template with the mentee's and mentor's details for the request
+func (s *ReplyTemplateService) RenderTemplate(ctx context.Context, mentorID, templateID, requestID string) (*models.RenderedReplyTemplate, error) {
+	template, err := s.templateRepo.GetByID(ctx, mentorID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.requestRepo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, ErrRequestNotFound
+	}
+	if request.MentorID != mentorID {
+		return nil, ErrAccessDenied
+	}
+
+	mentor, err := s.mentorRepo.GetByMentorId(ctx, mentorID, models.FilterOptions{ShowHidden: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mentor: %w", err)
+	}
+
+	text := models.RenderReplyTemplate(template.Body, map[string]string{
+		"name":         request.Name,
+		"telegram":     request.Telegram,
+		"mentor_name":  mentor.Name,
+		"calendar_url": mentor.CalendarURL,
+	})
+
+	return &models.RenderedReplyTemplate{
+		TemplateID: template.ID,
+		RequestID:  request.ID,
+		Text:       text,
+	}, nil
+}
+
+func normalizeReplyTemplateRequest(req *models.ReplyTemplateRequest) (*models.ReplyTemplateRequest, error) {
+	normalized := &models.ReplyTemplateRequest{
+		Title: strings.TrimSpace(req.Title),
+		Body:  strings.TrimSpace(req.Body),
+	}
+	if normalized.Title == "" || normalized.Body == "" {
+		return nil, fmt.Errorf("%w: title and body must not be blank", apperrors.ErrInvalidInput)
+	}
+	return normalized, nil
+}
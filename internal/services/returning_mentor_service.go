@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// ErrReactivationNotAllowed is returned when a mentor can't restore their profile without moderation
+var ErrReactivationNotAllowed = errors.New("profile can't be restored without moderation")
+
+// ReturningMentorService lets former mentors come back without a new moderation round.
+// A registration with the email of an eligible former mentor sends them a sign-in link
+// instead of creating a second profile; once signed in, which proves they own the
+// email, they restore their old profile and tags, and admins are notified.
+type ReturningMentorService struct {
+	mentorRepo *repository.MentorRepository
+	uow        *repository.UnitOfWork
+	rules      *ModerationRulesService
+	config     *config.Config
+	httpClient httpclient.Client
+	publisher  eventbus.Publisher
+	audit      *AuditLogger
+}
+
+// NewReturningMentorService creates a new returning mentor service
+func NewReturningMentorService(
+	mentorRepo *repository.MentorRepository,
+	uow *repository.UnitOfWork,
+	rules *ModerationRulesService,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	publisher eventbus.Publisher,
+	audit *AuditLogger,
+) *ReturningMentorService {
+
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
+
+	return &ReturningMentorService{
+		mentorRepo: mentorRepo,
+		uow:        uow,
+		rules:      rules,
+		config:     cfg,
+		httpClient: httpClient,
+		publisher:  publisher,
+		audit:      audit,
+	}
+}
+
+// OfferReactivation emails a former mentor a link to restore their profile when the
+// auto-approval rules allow it, and reports whether it did. Otherwise the registration
+// goes to moderation as usual.
+func (s *ReturningMentorService) OfferReactivation(ctx context.Context, email string, rules models.AutoApproveRules) (bool, error) {
+	if !rules.Enabled {
+		return false, nil
+	}
+
+	mentor, err := s.mentorRepo.GetReturningMentor(ctx, email, "")
+	if err != nil || mentor == nil {
+		return false, err
+	}
+	if !rules.Allows(mentor, time.Now()) {
+		metrics.MentorReactivations.WithLabelValues("not_eligible").Inc()
+		return false, nil
+	}
+
+	token, err := generateLoginToken()
+	if err != nil {
+		return false, ErrTokenGenerationFail
+	}
+	expiration := time.Now().Add(time.Duration(s.config.MentorSession.LoginTokenTTLMinutes) * time.Minute)
+	if err := s.mentorRepo.SetLoginToken(ctx, mentor.MentorID, token, expiration); err != nil {
+		return false, fmt.Errorf("failed to store login token: %w", err)
+	}
+
+	// The frontend calls POST /api/v1/mentor/reactivate after signing in with next=reactivate
+	loginURL := fmt.Sprintf("%s/mentor/auth/callback?token=%s&next=reactivate", s.config.Server.BaseURL, token)
+	if s.config.EventTriggers.MentorLoginEmailTriggerURL != "" {
+		payload := map[string]interface{}{
+			"type":      "mentor_reactivation",
+			"mentor_id": mentor.MentorID,
+			"login_url": loginURL,
+		}
+		trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorLoginEmailTriggerURL, payload, s.httpClient)
+	} else if s.config.IsDevelopment() {
+		logger.Info("=== DEVELOPMENT REACTIVATION URL ===",
+			zap.String("mentor_id", mentor.MentorID),
+			zap.String("login_url", loginURL))
+	}
+
+	metrics.MentorReactivations.WithLabelValues("offered").Inc()
+	logger.Info("Returning mentor offered reactivation",
+		zap.String("mentor_id", mentor.MentorID),
+		zap.Int("completed_sessions", mentor.CompletedSessions))
+	return true, nil
+}
+
+// Reactivate restores the profile of a signed-in former mentor if the auto-approval
+// rules still allow it, and notifies admins
+func (s *ReturningMentorService) Reactivate(ctx context.Context, mentorID string) error {
+	rules := s.rules.Rules(ctx).AutoApprove
+	mentor, err := s.mentorRepo.GetReturningMentor(ctx, "", mentorID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if mentor == nil || !rules.Allows(mentor, now) {
+		metrics.MentorReactivations.WithLabelValues("not_eligible").Inc()
+		return ErrReactivationNotAllowed
+	}
+
+	err = s.audit.Track(ctx, models.AuditEntityMentor, mentorID, AuditOperationReactivate, func(ctx context.Context) error {
+		return s.uow.Do(ctx, func(txCtx context.Context) error {
+			if err := s.mentorRepo.SetMentorStatus(txCtx, mentorID, mentorStatusActive); err != nil {
+				return err
+			}
+			// Recorded without a moderator: the moderation rules approved the mentor
+			return s.mentorRepo.RecordModerationEvent(txCtx, mentorID, moderationActionApprove, "")
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	events.Publish(ctx, s.publisher, events.MentorUpdated{
+		MentorID:      mentor.MentorID,
+		Slug:          mentor.Slug,
+		Status:        mentorStatusActive,
+		ChangedFields: []string{"status"},
+		Actor:         events.ActorMentor,
+	})
+	if s.config.EventTriggers.MentorAutoApprovedTriggerURL != "" {
+		payload := map[string]interface{}{
+			"type":               "mentor_auto_approved",
+			"mentor_id":          mentor.MentorID,
+			"slug":               mentor.Slug,
+			"name":               mentor.Name,
+			"completed_sessions": mentor.CompletedSessions,
+			"inactive_days":      mentor.InactiveDays(now),
+		}
+		trigger.CallAsyncWithPayload(s.config.EventTriggers.MentorAutoApprovedTriggerURL, payload, s.httpClient)
+	}
+
+	metrics.MentorReactivations.WithLabelValues("reactivated").Inc()
+	logger.Info("Returning mentor reactivated without moderation",
+		zap.String("mentor_id", mentor.MentorID),
+		zap.Int("completed_sessions", mentor.CompletedSessions),
+		zap.Int("inactive_days", mentor.InactiveDays(now)))
+	return nil
+}
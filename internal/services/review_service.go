@@ -11,6 +11,7 @@ import (
 	"github.com/getmentor/getmentor-api/internal/models"
 	"github.com/getmentor/getmentor-api/internal/repository"
 	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
@@ -24,11 +25,14 @@ var (
 	ErrReviewRequestNotDone  = errors.New("request is not in done status")
 	ErrReviewAlreadyExists   = errors.New("review already exists for this request")
 	ErrReviewCaptchaFailed   = errors.New("captcha verification failed")
+	ErrReviewMentorNotFound  = errors.New("mentor not found")
 )
 
-// ReviewService handles review submissions
+// ReviewService handles review submissions, their moderation and the mentor's replies.
+// Only approved reviews are public, and a reply shows once it is approved too.
 type ReviewService struct {
 	reviewRepo        *repository.ReviewRepository
+	mentorRepo        *repository.MentorRepository
 	config            *config.Config
 	httpClient        httpclient.Client
 	recaptchaVerifier *recaptcha.Verifier
@@ -38,6 +42,7 @@ type ReviewService struct {
 // NewReviewService creates a new review service instance
 func NewReviewService(
 	reviewRepo *repository.ReviewRepository,
+	mentorRepo *repository.MentorRepository,
 	cfg *config.Config,
 	httpClient httpclient.Client,
 	tracker analytics.Tracker,
@@ -49,6 +54,7 @@ func NewReviewService(
 
 	return &ReviewService{
 		reviewRepo:        reviewRepo,
+		mentorRepo:        mentorRepo,
 		config:            cfg,
 		httpClient:        httpClient,
 		recaptchaVerifier: recaptcha.NewVerifier(cfg.ReCAPTCHA.SecretKey, httpClient),
@@ -179,7 +185,7 @@ func (s *ReviewService) SubmitReview(ctx context.Context, requestID string, req
 	}
 
 	// Create review
-	reviewID, err := s.reviewRepo.CreateReview(ctx, requestID, req.MentorReview, req.PlatformReview, req.Improvements)
+	reviewID, err := s.reviewRepo.CreateReview(ctx, requestID, req.Rating, req.MentorReview, req.PlatformReview, req.Improvements)
 	if err != nil {
 		metrics.ReviewSubmissions.WithLabelValues("db_error").Inc()
 		trackSubmissionOutcome("db_error")
@@ -217,12 +223,165 @@ func (s *ReviewService) SubmitReview(ctx context.Context, requestID string, req
 	}, nil
 }
 
+// ListPublished returns the approved reviews of the mentor with the given legacy ID
+// with their approved replies
+func (s *ReviewService) ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicReview, error) {
+	mentor, err := s.mentorRepo.GetByID(ctx, mentorLegacyID, models.FilterOptions{OnlyVisible: true})
+	if err != nil {
+		return nil, ErrReviewMentorNotFound
+	}
+
+	reviews, err := s.reviewRepo.ListApprovedByMentor(ctx, mentor.MentorID)
+	if err != nil {
+		return nil, err
+	}
+
+	public := make([]models.PublicReview, 0, len(reviews))
+	for _, rv := range reviews {
+		item := models.PublicReview{ID: rv.ID, Rating: rv.Rating, Review: rv.MentorReview, CreatedAt: rv.CreatedAt}
+		if rv.ReplyStatus == models.ReviewStatusApproved && rv.RepliedAt != nil {
+			item.Reply = &models.ReviewReply{Text: rv.Reply, RepliedAt: *rv.RepliedAt}
+		}
+		public = append(public, item)
+	}
+	return public, nil
+}
+
+// ListMentorReviews returns the mentor's own published reviews with their replies in any status
+func (s *ReviewService) ListMentorReviews(ctx context.Context, mentorID string) ([]models.PublicReview, error) {
+	reviews, err := s.reviewRepo.ListApprovedByMentor(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.PublicReview, 0, len(reviews))
+	for _, rv := range reviews {
+		items = append(items, mentorReviewItem(rv))
+	}
+	return items, nil
+}
+
+// ReplyToReview stores the mentor's only reply to one of their published reviews.
+// The reply is public once a moderator approves it.
+func (s *ReviewService) ReplyToReview(ctx context.Context, mentorID, reviewID string, req *models.ReviewReplyRequest) (*models.PublicReview, error) {
+	reply := strings.TrimSpace(req.Reply)
+	if reply == "" {
+		return nil, apperrors.InvalidInputError("reply", "is required")
+	}
+
+	review, err := s.reviewRepo.AddReply(ctx, mentorID, reviewID, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReviewModeration.WithLabelValues("reply", "submitted").Inc()
+	s.tracker.Track(ctx, analytics.EventMentorReviewReplied, analytics.MentorDistinctID(mentorID), map[string]interface{}{
+		"mentor_id":    mentorID,
+		"review_id":    reviewID,
+		"reply_length": len(reply),
+	})
+	logger.Info("Mentor replied to review",
+		zap.String("mentor_id", mentorID),
+		zap.String("review_id", reviewID))
+
+	item := mentorReviewItem(review)
+	return &item, nil
+}
+
+// ListForModeration returns reviews for the moderation queue. Available to moderators and admins.
+func (s *ReviewService) ListForModeration(ctx context.Context, session *models.AdminSession, filter models.AdminReviewFilter) ([]*models.Review, error) {
+	if !isReviewStatus(filter.Status, true) {
+		return nil, apperrors.InvalidInputError("status", "must be pending, approved or rejected")
+	}
+	if !isReviewStatus(filter.ReplyStatus, true) {
+		return nil, apperrors.InvalidInputError("replyStatus", "must be pending, approved or rejected")
+	}
+	return s.reviewRepo.ListForModeration(ctx, filter)
+}
+
+// ModerateReview approves or rejects a review. Moderators can change their verdict later.
+func (s *ReviewService) ModerateReview(ctx context.Context, session *models.AdminSession, reviewID, status string) error {
+	if !isReviewStatus(status, false) || status == models.ReviewStatusPending {
+		return apperrors.InvalidInputError("status", "must be approved or rejected")
+	}
+
+	slug, err := s.reviewRepo.SetStatus(ctx, reviewID, status, session.ModeratorID)
+	s.trackModeration(ctx, session, reviewID, "review", status, err)
+	if err != nil {
+		return err
+	}
+	// The mentor's average rating counts approved reviews only
+	if err := s.mentorRepo.UpdateSingleMentorCache(slug); err != nil {
+		logger.Error("Failed to refresh mentor cache after review moderation", zap.String("slug", slug), zap.Error(err))
+	}
+	metrics.ReviewModeration.WithLabelValues("review", status).Inc()
+	logger.Info("Review moderated",
+		zap.String("review_id", reviewID),
+		zap.String("status", status),
+		zap.String("moderator_id", session.ModeratorID))
+	return nil
+}
+
+// ModerateReply approves or rejects the mentor's reply to a review
+func (s *ReviewService) ModerateReply(ctx context.Context, session *models.AdminSession, reviewID, status string) error {
+	if !isReviewStatus(status, false) || status == models.ReviewStatusPending {
+		return apperrors.InvalidInputError("status", "must be approved or rejected")
+	}
+
+	err := s.reviewRepo.SetReplyStatus(ctx, reviewID, status, session.ModeratorID)
+	s.trackModeration(ctx, session, reviewID, "reply", status, err)
+	if err != nil {
+		return err
+	}
+	metrics.ReviewModeration.WithLabelValues("reply", status).Inc()
+	logger.Info("Review reply moderated",
+		zap.String("review_id", reviewID),
+		zap.String("status", status),
+		zap.String("moderator_id", session.ModeratorID))
+	return nil
+}
+
+func (s *ReviewService) trackModeration(ctx context.Context, session *models.AdminSession, reviewID, target, status string, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "update_failed"
+	}
+	s.tracker.Track(ctx, analytics.EventAdminReviewModerated, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"review_id":      reviewID,
+		"target":         target,
+		"status":         status,
+		"outcome":        outcome,
+	})
+}
+
+// mentorReviewItem shows a review to its mentor, with the reply and its moderation status
+func mentorReviewItem(rv *models.Review) models.PublicReview {
+	item := models.PublicReview{ID: rv.ID, Rating: rv.Rating, Review: rv.MentorReview, CreatedAt: rv.CreatedAt}
+	if rv.Reply != "" && rv.RepliedAt != nil {
+		item.Reply = &models.ReviewReply{Text: rv.Reply, Status: rv.ReplyStatus, RepliedAt: *rv.RepliedAt}
+	}
+	return item
+}
+
+func isReviewStatus(status string, allowEmpty bool) bool {
+	switch status {
+	case models.ReviewStatusPending, models.ReviewStatusApproved, models.ReviewStatusRejected:
+		return true
+	case "":
+		return allowEmpty
+	}
+	return false
+}
+
 func reviewSubmissionProperties(requestID string, req *models.SubmitReviewRequest) map[string]interface{} {
 	return map[string]interface{}{
 		"request_id":           requestID,
 		"has_platform_review":  strings.TrimSpace(req.PlatformReview) != "",
 		"has_improvements":     strings.TrimSpace(req.Improvements) != "",
 		"has_mentor_review":    strings.TrimSpace(req.MentorReview) != "",
+		"has_rating":           req.Rating != nil,
 		"review_payload_size":  len(req.MentorReview) + len(req.PlatformReview) + len(req.Improvements),
 		"captcha_token_length": len(req.RecaptchaToken),
 	}
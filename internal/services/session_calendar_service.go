@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+)
+
+const (
+	// sessionDuration is the calendar length of a session; requests don't store one
+	sessionDuration = time.Hour
+	// sessionFeedHistory is how far back the feed keeps past sessions
+	sessionFeedHistory = 90 * 24 * time.Hour
+)
+
+// SessionCalendarService publishes a mentor's scheduled sessions as a subscribable iCal feed
+type SessionCalendarService struct {
+	clientRequestRepo *repository.ClientRequestRepository
+	mentorRepo        *repository.MentorRepository
+	config            *config.Config
+}
+
+// NewSessionCalendarService creates a new session calendar service
+func NewSessionCalendarService(
+	clientRequestRepo *repository.ClientRequestRepository,
+	mentorRepo *repository.MentorRepository,
+	cfg *config.Config,
+) *SessionCalendarService {
+
+	return &SessionCalendarService{
+		clientRequestRepo: clientRequestRepo,
+		mentorRepo:        mentorRepo,
+		config:            cfg,
+	}
+}
+
+// GetMentorFeed renders the feed of the signed-in mentor
+func (s *SessionCalendarService) GetMentorFeed(ctx context.Context, mentorID string) ([]byte, error) {
+	feed, err := s.renderFeed(ctx, mentorID)
+	if err != nil {
+		metrics.SessionCalendarFetches.WithLabelValues("error").Inc()
+		return nil, err
+	}
+	metrics.SessionCalendarFetches.WithLabelValues("session").Inc()
+	return feed, nil
+}
+
+// GetFeedByToken renders the feed for a subscription URL token
+func (s *SessionCalendarService) GetFeedByToken(ctx context.Context, token string) ([]byte, error) {
+	mentorID, err := s.mentorRepo.GetIDByCalendarFeedToken(ctx, token)
+	if err != nil {
+		metrics.SessionCalendarFetches.WithLabelValues("invalid_token").Inc()
+		return nil, err
+	}
+
+	feed, err := s.renderFeed(ctx, mentorID)
+	if err != nil {
+		metrics.SessionCalendarFetches.WithLabelValues("error").Inc()
+		return nil, err
+	}
+	metrics.SessionCalendarFetches.WithLabelValues("token").Inc()
+	return feed, nil
+}
+
+// GetFeedURL returns the subscription URL of the mentor's feed, issuing a token on first use
+func (s *SessionCalendarService) GetFeedURL(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error) {
+	token, err := s.mentorRepo.GetCalendarFeedToken(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return s.RotateFeedToken(ctx, mentorID)
+	}
+	return &models.SessionCalendarFeedResponse{URL: s.feedURL(token)}, nil
+}
+
+// RotateFeedToken issues a new subscription URL; the previous one stops working
+func (s *SessionCalendarService) RotateFeedToken(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error) {
+	token, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	if err := s.mentorRepo.SetCalendarFeedToken(ctx, mentorID, token); err != nil {
+		return nil, err
+	}
+	return &models.SessionCalendarFeedResponse{URL: s.feedURL(token)}, nil
+}
+
+func (s *SessionCalendarService) renderFeed(ctx context.Context, mentorID string) ([]byte, error) {
+	sessions, err := s.clientRequestRepo.ListScheduledByMentor(ctx, mentorID, time.Now().Add(-sessionFeedHistory))
+	if err != nil {
+		return nil, err
+	}
+
+	invites := make([]ical.Invite, 0, len(sessions))
+	for _, session := range sessions {
+		invites = append(invites, sessionInvite(session))
+	}
+	return ical.Encode("-//GetMentor//Sessions//RU", invites...), nil
+}
+
+// sessionInvite maps a scheduled request to a calendar event. Closed requests
+// without a completed session are published as cancelled so clients drop them;
+// sessions waiting for the mentee to confirm a new time are tentative.
+func sessionInvite(session *models.ScheduledSession) ical.Invite {
+	invite := ical.Invite{
+		UID:         session.RequestID + "@sessions.getmentor.dev",
+		Summary:     "GetMentor: " + session.MenteeName,
+		Description: sessionInviteDescription(session),
+		Start:       session.ScheduledAt,
+		End:         session.ScheduledAt.Add(sessionDuration),
+		Stamp:       session.UpdatedAt,
+		Status:      "CONFIRMED",
+		Sequence:    session.Sequence,
+	}
+	switch session.Status {
+	case models.StatusDeclined, models.StatusUnavailable:
+		invite.Status = "CANCELLED"
+	case models.StatusReschedule:
+		invite.Status = "TENTATIVE"
+	}
+	return invite
+}
+
+func sessionInviteDescription(session *models.ScheduledSession) string {
+	parts := []string{}
+	if session.MenteeTelegram != "" {
+		parts = append(parts, "Telegram: @"+strings.TrimPrefix(session.MenteeTelegram, "@"))
+	}
+	if session.Level != "" {
+		parts = append(parts, "Уровень: "+session.Level)
+	}
+	return strings.Join(parts, "\n")
+}
+
+func (s *SessionCalendarService) feedURL(token string) string {
+	return s.config.Server.BaseURL + "/api/v1/session-feeds/" + token + "/sessions.ics"
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrRescheduleNotAllowed is returned when the request has no session that can be moved
+	ErrRescheduleNotAllowed = errors.New("request cannot be rescheduled")
+	// ErrRescheduleSlotNotOffered is returned when the mentee picks a time the mentor didn't offer
+	ErrRescheduleSlotNotOffered = errors.New("slot was not offered")
+)
+
+// SessionRescheduleService lets a mentor offer new session times and the mentee confirm one
+type SessionRescheduleService struct {
+	requestRepo    *repository.ClientRequestRepository
+	rescheduleRepo *repository.SessionRescheduleRepository
+	uow            *repository.UnitOfWork
+	config         *config.Config
+	httpClient     httpclient.Client
+	tracker        analytics.Tracker
+	publisher      eventbus.Publisher
+}
+
+// NewSessionRescheduleService creates a new session reschedule service
+func NewSessionRescheduleService(
+	requestRepo *repository.ClientRequestRepository,
+	rescheduleRepo *repository.SessionRescheduleRepository,
+	uow *repository.UnitOfWork,
+	cfg *config.Config,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+	publisher eventbus.Publisher,
+) *SessionRescheduleService {
+
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+	if publisher == nil {
+		publisher = eventbus.NoopPublisher{}
+	}
+
+	return &SessionRescheduleService{
+		requestRepo:    requestRepo,
+		rescheduleRepo: rescheduleRepo,
+		uow:            uow,
+		config:         cfg,
+		httpClient:     httpClient,
+		tracker:        tracker,
+		publisher:      publisher,
+	}
+}
+
+// ProposeReschedule offers new session times for the mentor's request and moves it to
+// the reschedule status. A newer proposal replaces the open one; the request keeps
+// the status it had before the first proposal.
+func (s *SessionRescheduleService) ProposeReschedule(ctx context.Context, mentorID, requestID string, req *models.ProposeRescheduleRequest) (*models.ProposeRescheduleResponse, error) {
+	slots, err := normalizeRescheduleSlots(req.Slots, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.requestRepo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, ErrRequestNotFound
+	}
+	if request.MentorID != mentorID {
+		return nil, ErrAccessDenied
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate reschedule token: %w", err)
+	}
+
+	var proposal *models.RescheduleProposal
+	err = s.uow.Do(ctx, func(txCtx context.Context) error {
+		previousStatus := request.Status
+		switch {
+		case request.Status == models.StatusReschedule:
+			// Keep returning to the status from before the first proposal
+			open, getErr := s.rescheduleRepo.GetPendingPreviousStatus(txCtx, request.ID)
+			if getErr != nil {
+				return getErr
+			}
+			previousStatus = open
+			if previousStatus == "" {
+				previousStatus = models.StatusWorking
+			}
+		case request.Status.CanTransitionTo(models.StatusReschedule):
+			if err := s.rescheduleRepo.MarkRequestRescheduling(txCtx, request.ID, request.Status); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: request has status '%s'", ErrRescheduleNotAllowed, request.Status)
+		}
+
+		var createErr error
+		proposal, createErr = s.rescheduleRepo.Create(txCtx, &models.RescheduleProposal{
+			RequestID:      request.ID,
+			Slots:          slots,
+			Comment:        strings.TrimSpace(req.Comment),
+			PreviousStatus: previousStatus,
+			ExpiresAt:      slots[len(slots)-1],
+			Token:          token,
+		})
+		return createErr
+	})
+	if err != nil {
+		if errors.Is(err, ErrRescheduleNotAllowed) {
+			metrics.SessionReschedules.WithLabelValues("proposed", "not_allowed").Inc()
+			return nil, err
+		}
+		metrics.SessionReschedules.WithLabelValues("proposed", "error").Inc()
+		logger.Error("Failed to propose reschedule", zap.String("request_id", requestID), zap.Error(err))
+		return nil, fmt.Errorf("failed to propose reschedule: %w", err)
+	}
+
+	if request.Status != models.StatusReschedule {
+		events.Publish(ctx, s.publisher, events.RequestStatusChanged{
+			RequestID:  request.ID,
+			MentorID:   mentorID,
+			FromStatus: string(request.Status),
+			ToStatus:   string(models.StatusReschedule),
+		})
+	}
+
+	confirmURL := s.confirmURL(proposal.Token)
+	s.notify("session_reschedule_proposed", proposal, map[string]interface{}{
+		"confirm_url": confirmURL,
+	})
+
+	metrics.SessionReschedules.WithLabelValues("proposed", "success").Inc()
+	s.tracker.Track(ctx, analytics.EventMentorRescheduleProposed, analytics.RequestDistinctID(requestID), map[string]interface{}{
+		"request_id":  requestID,
+		"mentor_id":   mentorID,
+		"slots_count": len(slots),
+		"from_status": string(request.Status),
+	})
+
+	logger.Info("Reschedule proposed",
+		zap.String("request_id", requestID),
+		zap.String("proposal_id", proposal.ID),
+		zap.Int("slots", len(slots)))
+
+	return &models.ProposeRescheduleResponse{
+		Proposal:   proposal,
+		ConfirmURL: confirmURL,
+	}, nil
+}
+
+// GetProposal returns the proposal behind the mentee's link
+func (s *SessionRescheduleService) GetProposal(ctx context.Context, token string) (*models.RescheduleProposal, error) {
+	return s.rescheduleRepo.GetByToken(ctx, token)
+}
+
+// ConfirmReschedule books the slot picked by the mentee and returns the request to its previous status
+func (s *SessionRescheduleService) ConfirmReschedule(ctx context.Context, token string, req *models.ConfirmRescheduleRequest) (*models.RescheduleProposal, error) {
+	now := time.Now()
+	slot := req.Slot.UTC().Truncate(time.Minute)
+
+	var proposal *models.RescheduleProposal
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		proposal, err = s.rescheduleRepo.GetByToken(txCtx, token)
+		if err != nil {
+			return err
+		}
+		if !proposal.IsOpen(now) {
+			return repository.ErrRescheduleProposalClosed
+		}
+		if !proposal.HasSlot(slot) || !slot.After(now) {
+			return ErrRescheduleSlotNotOffered
+		}
+		return s.rescheduleRepo.Confirm(txCtx, proposal, slot)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRescheduleProposalNotFound):
+			metrics.SessionReschedules.WithLabelValues("confirmed", "not_found").Inc()
+		case errors.Is(err, repository.ErrRescheduleProposalClosed):
+			metrics.SessionReschedules.WithLabelValues("confirmed", "closed").Inc()
+		case errors.Is(err, ErrRescheduleSlotNotOffered):
+			metrics.SessionReschedules.WithLabelValues("confirmed", "invalid_slot").Inc()
+		default:
+			metrics.SessionReschedules.WithLabelValues("confirmed", "error").Inc()
+			logger.Error("Failed to confirm reschedule", zap.Error(err))
+			return nil, fmt.Errorf("failed to confirm reschedule: %w", err)
+		}
+		return nil, err
+	}
+
+	proposal.Status = models.RescheduleProposalConfirmed
+	proposal.ChosenSlot = &slot
+
+	events.Publish(ctx, s.publisher, events.RequestStatusChanged{
+		RequestID:  proposal.RequestID,
+		MentorID:   proposal.MentorID,
+		FromStatus: string(models.StatusReschedule),
+		ToStatus:   string(proposal.PreviousStatus),
+	})
+
+	s.notify("session_reschedule_confirmed", proposal, map[string]interface{}{
+		"scheduled_at": slot,
+	})
+
+	metrics.SessionReschedules.WithLabelValues("confirmed", "success").Inc()
+	s.tracker.Track(ctx, analytics.EventMenteeRescheduleConfirmed, analytics.RequestDistinctID(proposal.RequestID), map[string]interface{}{
+		"request_id": proposal.RequestID,
+		"mentor_id":  proposal.MentorID,
+		"to_status":  string(proposal.PreviousStatus),
+	})
+
+	logger.Info("Reschedule confirmed",
+		zap.String("request_id", proposal.RequestID),
+		zap.String("proposal_id", proposal.ID),
+		zap.Time("scheduled_at", slot))
+
+	return proposal, nil
+}
+
+// notify tells the notification workflow about the proposal; the mentee is reached
+// on the proposal and the mentor on the confirmation
+func (s *SessionRescheduleService) notify(eventType string, proposal *models.RescheduleProposal, extra map[string]interface{}) {
+	if s.config.EventTriggers.SessionRescheduleTriggerURL == "" {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"type":        eventType,
+		"request_id":  proposal.RequestID,
+		"proposal_id": proposal.ID,
+		"mentor_id":   proposal.MentorID,
+		"slots":       proposal.Slots,
+		"comment":     proposal.Comment,
+		"expires_at":  proposal.ExpiresAt,
+	}
+	for key, value := range extra {
+		payload[key] = value
+	}
+	trigger.CallAsyncWithPayload(s.config.EventTriggers.SessionRescheduleTriggerURL, payload, s.httpClient)
+}
+
+func (s *SessionRescheduleService) confirmURL(token string) string {
+	return s.config.Server.BaseURL + "/sessions/reschedule/" + token
+}
+
+// normalizeRescheduleSlots validates offered times and returns them in UTC,
+// minute precision, sorted and without duplicates
+func normalizeRescheduleSlots(slots []time.Time, now time.Time) ([]time.Time, error) {
+	if len(slots) == 0 || len(slots) > models.MaxRescheduleSlots {
+		return nil, fmt.Errorf("%w: between 1 and %d slots required", apperrors.ErrInvalidInput, models.MaxRescheduleSlots)
+	}
+
+	normalized := make([]time.Time, 0, len(slots))
+	for _, slot := range slots {
+		slot = slot.UTC().Truncate(time.Minute)
+		if !slot.After(now) {
+			return nil, fmt.Errorf("%w: slot %s is in the past", apperrors.ErrInvalidInput, slot.Format(time.RFC3339))
+		}
+		normalized = append(normalized, slot)
+	}
+
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Before(normalized[j]) })
+	for i := 1; i < len(normalized); i++ {
+		if normalized[i].Equal(normalized[i-1]) {
+			return nil, fmt.Errorf("%w: duplicate slot %s", apperrors.ErrInvalidInput, normalized[i].Format(time.RFC3339))
+		}
+	}
+	return normalized, nil
+}
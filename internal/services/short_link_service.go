@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	shortLinkCodeLength   = 7
+	shortLinkCodeAlphabet = "abcdefghijkmnpqrstuvwxyz23456789" // no look-alikes (l/1, o/0)
+	shortLinkCodeAttempts = 3
+	shortLinkCreatorSelf  = "mentor"
+)
+
+// previewAgentMarkers identify link-preview crawlers and bots, whose hits aren't counted as clicks
+var previewAgentMarkers = []string{"bot", "crawler", "spider", "facebookexternalhit", "preview", "slurp", "whatsapp"}
+
+// ShortLinkService manages mentors' short profile links and resolves them
+type ShortLinkService struct {
+	linkRepo  *repository.ShortLinkRepository
+	baseURL   string // public site, where profiles live
+	shortBase string // where /m/:code is served
+}
+
+// NewShortLinkService creates a new short link service. shortBaseURL defaults to baseURL.
+func NewShortLinkService(linkRepo *repository.ShortLinkRepository, baseURL, shortBaseURL string) *ShortLinkService {
+	if shortBaseURL == "" {
+		shortBaseURL = baseURL
+	}
+	return &ShortLinkService{
+		linkRepo:  linkRepo,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		shortBase: strings.TrimRight(shortBaseURL, "/"),
+	}
+}
+
+// ListLinks returns the mentor's links and their combined clicks
+func (s *ShortLinkService) ListLinks(ctx context.Context, mentorID string) (*models.ShortLinkList, error) {
+	links, err := s.linkRepo.ListByMentor(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &models.ShortLinkList{Links: links}
+	for _, link := range links {
+		s.decorate(link)
+		list.TotalClicks += link.Clicks
+	}
+	return list, nil
+}
+
+// CreateLink creates a link for the mentor, up to MaxShortLinksPerMentor
+func (s *ShortLinkService) CreateLink(ctx context.Context, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error) {
+	return s.create(ctx, mentorID, shortLinkCreatorSelf, req)
+}
+
+// DeleteLink removes one of the mentor's links
+func (s *ShortLinkService) DeleteLink(ctx context.Context, mentorID, code string) error {
+	return s.linkRepo.Delete(ctx, mentorID, strings.ToLower(code))
+}
+
+// AdminListLinks returns a mentor's links for moderators
+func (s *ShortLinkService) AdminListLinks(ctx context.Context, session *models.AdminSession, mentorID string) (*models.ShortLinkList, error) {
+	return s.ListLinks(ctx, mentorID)
+}
+
+// AdminCreateLink creates a link on a mentor's behalf, e.g. for a partner campaign
+func (s *ShortLinkService) AdminCreateLink(ctx context.Context, session *models.AdminSession, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error) {
+	return s.create(ctx, mentorID, session.Email, req)
+}
+
+// Resolve returns where a short link leads and counts the click. Links of unpublished
+// profiles lead to the home page and aren't counted.
+func (s *ShortLinkService) Resolve(ctx context.Context, code, userAgent string) (string, error) {
+	link, err := s.linkRepo.GetByCode(ctx, strings.ToLower(code))
+	if err != nil {
+		return "", err
+	}
+	if !link.MentorActive {
+		metrics.ShortLinkClicks.WithLabelValues("inactive").Inc()
+		return s.baseURL + "/", nil
+	}
+
+	if isPreviewAgent(userAgent) {
+		metrics.ShortLinkClicks.WithLabelValues("preview").Inc()
+	} else if err := s.linkRepo.RecordClick(ctx, link.Code); err != nil {
+		// Losing a click is better than failing the redirect
+		logger.Warn("Failed to count short link click", zap.Error(err), zap.String("code", link.Code))
+	} else {
+		metrics.ShortLinkClicks.WithLabelValues("counted").Inc()
+	}
+
+	return s.target(link), nil
+}
+
+func (s *ShortLinkService) create(ctx context.Context, mentorID, createdBy string, req *models.CreateShortLinkRequest) (*models.ShortLink, error) {
+	count, err := s.linkRepo.Count(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= models.MaxShortLinksPerMentor {
+		return nil, fmt.Errorf("%w: at most %d short links allowed", apperrors.ErrInvalidInput, models.MaxShortLinksPerMentor)
+	}
+
+	link := &models.ShortLink{
+		MentorID:    mentorID,
+		Label:       strings.TrimSpace(req.Label),
+		UTMSource:   defaultString(strings.TrimSpace(req.UTMSource), models.DefaultShortLinkUTMSource),
+		UTMMedium:   defaultString(strings.TrimSpace(req.UTMMedium), models.DefaultShortLinkUTMMedium),
+		UTMCampaign: strings.TrimSpace(req.UTMCampaign),
+		CreatedBy:   createdBy,
+	}
+
+	if code := strings.ToLower(strings.TrimSpace(req.Code)); code != "" {
+		if !models.ShortLinkCodePattern.MatchString(code) {
+			return nil, fmt.Errorf("%w: code must be 3-32 lowercase letters, digits or dashes", apperrors.ErrInvalidInput)
+		}
+		link.Code = code
+		created, err := s.linkRepo.Create(ctx, link)
+		if err != nil {
+			return nil, err
+		}
+		return s.logCreated(created), nil
+	}
+
+	// Generated codes rarely collide; retry a few times when they do
+	for attempt := 1; ; attempt++ {
+		link.Code = generateShortLinkCode()
+		created, err := s.linkRepo.Create(ctx, link)
+		if errors.Is(err, repository.ErrShortLinkCodeTaken) && attempt < shortLinkCodeAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.logCreated(created), nil
+	}
+}
+
+func (s *ShortLinkService) logCreated(link *models.ShortLink) *models.ShortLink {
+	s.decorate(link)
+	logger.Info("Short link created",
+		zap.String("mentor_id", link.MentorID),
+		zap.String("code", link.Code),
+		zap.String("created_by", link.CreatedBy))
+	return link
+}
+
+func (s *ShortLinkService) decorate(link *models.ShortLink) {
+	link.URL = s.shortBase + "/m/" + link.Code
+	link.Target = s.target(link)
+}
+
+// target is the profile URL with the link's UTM parameters
+func (s *ShortLinkService) target(link *models.ShortLink) string {
+	query := url.Values{}
+	query.Set("utm_source", link.UTMSource)
+	query.Set("utm_medium", link.UTMMedium)
+	if link.UTMCampaign != "" {
+		query.Set("utm_campaign", link.UTMCampaign)
+	}
+	// utm_content tells links of the same mentor and campaign apart
+	query.Set("utm_content", link.Code)
+	return s.baseURL + "/mentor/" + url.PathEscape(link.MentorSlug) + "?" + query.Encode()
+}
+
+func generateShortLinkCode() string {
+	buf := make([]byte, shortLinkCodeLength)
+	_, _ = rand.Read(buf) //nolint:errcheck // crypto/rand.Read never fails
+	for i, b := range buf {
+		buf[i] = shortLinkCodeAlphabet[int(b)%len(shortLinkCodeAlphabet)]
+	}
+	return string(buf)
+}
+
+func isPreviewAgent(userAgent string) bool {
+	ua := strings.ToLower(userAgent)
+	for _, marker := range previewAgentMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
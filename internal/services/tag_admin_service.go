@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// TagAdminService lets operators list, add and remove the tags mentors pick from. Tags are
+// stored in PostgreSQL; a change reloads this replica's tags cache at once, the other replicas
+// pick it up within TAGS_CACHE_TTL.
+type TagAdminService struct {
+	repo *repository.TagRepository
+	tags CacheReloader
+}
+
+// NewTagAdminService creates the service; tags is the replica's tags cache
+func NewTagAdminService(repo *repository.TagRepository, tags CacheReloader) *TagAdminService {
+	return &TagAdminService{
+		repo: repo,
+		tags: tags,
+	}
+}
+
+// List returns every tag with the number of mentors using it
+func (s *TagAdminService) List(ctx context.Context) ([]models.Tag, error) {
+	return s.repo.List(ctx)
+}
+
+// Create adds a tag
+func (s *TagAdminService) Create(ctx context.Context, req *models.CreateTagRequest) (*models.Tag, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", apperrors.ErrInvalidInput)
+	}
+
+	tag, err := s.repo.Create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Tag created by an operator", zap.String("tag", name))
+	s.reload()
+	return tag, nil
+}
+
+// Delete removes a tag that no mentor uses
+func (s *TagAdminService) Delete(ctx context.Context, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", apperrors.ErrInvalidInput)
+	}
+
+	if err := s.repo.Delete(ctx, name); err != nil {
+		return err
+	}
+	logger.Info("Tag deleted by an operator", zap.String("tag", name))
+	s.reload()
+	return nil
+}
+
+// reload refreshes the tags cache; failing only delays the change until the next scheduled reload
+func (s *TagAdminService) reload() {
+	if err := s.tags.Reload(); err != nil {
+		logger.Warn("Failed to reload the tags cache", zap.Error(err))
+	}
+}
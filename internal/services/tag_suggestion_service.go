@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+)
+
+// TagSuggestionService suggests existing tags for free-form profile text, so that
+// mentors and moderators pick standard tags instead of inventing near-duplicates.
+type TagSuggestionService struct {
+	mentorRepo *repository.MentorRepository
+}
+
+// NewTagSuggestionService creates a new TagSuggestionService
+func NewTagSuggestionService(mentorRepo *repository.MentorRepository) *TagSuggestionService {
+	return &TagSuggestionService{mentorRepo: mentorRepo}
+}
+
+// SuggestForText suggests tags mentioned in text, skipping the excluded ones
+func (s *TagSuggestionService) SuggestForText(ctx context.Context, text string, exclude []string) ([]models.TagSuggestion, error) {
+	tags, err := s.tagNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return models.SuggestTags(text, tags, exclude, models.MaxTagSuggestions), nil
+}
+
+// SuggestForMentor suggests tags for a mentor under moderation from their job title,
+// competencies, description and about text. Tags the mentor already has are skipped.
+func (s *TagSuggestionService) SuggestForMentor(ctx context.Context, session *models.AdminSession, mentorID string) ([]models.TagSuggestion, error) {
+	mentor, err := s.mentorRepo.GetForModerationByID(ctx, mentorID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Role == models.ModeratorRoleModerator && mentor.Status != mentorStatusPending {
+		return nil, ErrAdminForbiddenAction
+	}
+
+	text := strings.Join([]string{mentor.Job, mentor.Competencies, mentor.Description, mentor.About}, "\n")
+	return s.SuggestForText(ctx, text, mentor.Tags)
+}
+
+func (s *TagSuggestionService) tagNames(ctx context.Context) ([]string, error) {
+	tagsByName, err := s.mentorRepo.GetAllTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tags: %w", err)
+	}
+	names := make([]string, 0, len(tagsByName))
+	for name := range tagsByName {
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/analytics"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"go.uber.org/zap"
+)
+
+// redriveTimeout bounds a single re-drive attempt
+const redriveTimeout = 30 * time.Second
+
+// TriggerDeadLetterService re-drives outbound trigger deliveries that failed every retry
+type TriggerDeadLetterService struct {
+	repo       *repository.TriggerDeadLetterRepository
+	httpClient httpclient.Client
+	tracker    analytics.Tracker
+}
+
+// NewTriggerDeadLetterService creates a new TriggerDeadLetterService
+func NewTriggerDeadLetterService(
+	repo *repository.TriggerDeadLetterRepository,
+	httpClient httpclient.Client,
+	tracker analytics.Tracker,
+) *TriggerDeadLetterService {
+	if tracker == nil {
+		tracker = analytics.NoopTracker{}
+	}
+	return &TriggerDeadLetterService{
+		repo:       repo,
+		httpClient: httpClient,
+		tracker:    tracker,
+	}
+}
+
+// Redrive sends a dead letter once more, synchronously. Admin only. On success the letter
+// is marked delivered; on failure the attempt is added to its history and the error returned.
+func (s *TriggerDeadLetterService) Redrive(ctx context.Context, session *models.AdminSession, id string) (*models.TriggerDeadLetter, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.trackRedrive(ctx, session, id, "", "forbidden")
+		return nil, ErrAdminForbiddenAction
+	}
+
+	letter, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.trackRedrive(ctx, session, id, "", "not_found")
+		return nil, err
+	}
+	if letter.Status == models.TriggerDeadLetterDelivered {
+		s.trackRedrive(ctx, session, id, letter.Destination, "already_delivered")
+		return nil, fmt.Errorf("%w: the event was already delivered", apperrors.ErrInvalidInput)
+	}
+
+	if err := s.redrive(ctx, session, letter); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.GetByID(ctx, letter.ID)
+	if err != nil {
+		return nil, err
+	}
+	return redactDeadLetter(updated), nil
+}
+
+// RedriveMany re-drives the given dead letters one by one and reports the outcome of each.
+// Admin only. Letters that are missing or already delivered are reported, not retried.
+func (s *TriggerDeadLetterService) RedriveMany(ctx context.Context, session *models.AdminSession, ids []string) ([]models.TriggerRedriveResult, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		s.trackRedrive(ctx, session, "", "", "forbidden")
+		return nil, ErrAdminForbiddenAction
+	}
+	if len(ids) > models.MaxTriggerRedriveBatch {
+		return nil, fmt.Errorf("%w: at most %d dead letters can be re-driven at once", apperrors.ErrInvalidInput, models.MaxTriggerRedriveBatch)
+	}
+
+	results := make([]models.TriggerRedriveResult, 0, len(ids))
+	for _, id := range ids {
+		result := models.TriggerRedriveResult{ID: id}
+		letter, err := s.repo.GetByID(ctx, id)
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+		case letter.Status == models.TriggerDeadLetterDelivered:
+			result.Error = "already delivered"
+		default:
+			if err := s.redrive(ctx, session, letter); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Delivered = true
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ListDeadLetters returns dead letters with payloads redacted. Admin only.
+func (s *TriggerDeadLetterService) ListDeadLetters(
+	ctx context.Context,
+	session *models.AdminSession,
+	filter models.TriggerDeadLetterFilter,
+	destination string,
+) ([]*models.TriggerDeadLetter, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	statuses, ok := filter.Statuses()
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported filter %q", apperrors.ErrInvalidInput, filter)
+	}
+
+	letters, err := s.repo.List(ctx, statuses, destination, models.MaxTriggerDeadLettersListed)
+	if err != nil {
+		return nil, err
+	}
+	for i, letter := range letters {
+		letters[i] = redactDeadLetter(letter)
+	}
+	return letters, nil
+}
+
+// GetDeadLetter returns one dead letter with its attempt history and redacted payload. Admin only.
+func (s *TriggerDeadLetterService) GetDeadLetter(ctx context.Context, session *models.AdminSession, id string) (*models.TriggerDeadLetter, error) {
+	if session.Role != models.ModeratorRoleAdmin {
+		return nil, ErrAdminForbiddenAction
+	}
+	letter, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return redactDeadLetter(letter), nil
+}
+
+// redrive makes one delivery attempt and records its outcome on the dead letter
+func (s *TriggerDeadLetterService) redrive(ctx context.Context, session *models.AdminSession, letter *models.TriggerDeadLetter) error {
+	deliverCtx, cancel := context.WithTimeout(ctx, redriveTimeout)
+	statusCode, deliverErr := trigger.Deliver(deliverCtx, letter.Delivery(), s.httpClient)
+	cancel()
+
+	if deliverErr != nil {
+		attempt := trigger.Attempt{At: time.Now().UTC(), StatusCode: statusCode, Error: deliverErr.Error()}
+		if err := s.repo.AppendAttempt(ctx, letter.ID, attempt); err != nil {
+			logger.Error("Failed to record re-drive attempt", zap.Error(err), zap.String("dead_letter_id", letter.ID))
+		}
+		s.trackRedrive(ctx, session, letter.ID, letter.Destination, "delivery_failed")
+		return fmt.Errorf("re-drive failed: %w", deliverErr)
+	}
+
+	if err := s.repo.MarkDelivered(ctx, letter.ID); err != nil {
+		return err
+	}
+	s.trackRedrive(ctx, session, letter.ID, letter.Destination, "success")
+	logger.Info("Trigger dead letter re-driven",
+		zap.String("dead_letter_id", letter.ID),
+		zap.String("destination", letter.Destination),
+		zap.String("actor", analytics.ModeratorDistinctID(session.ModeratorID)))
+	return nil
+}
+
+// redactDeadLetter hides personal data and one-time links in the payload before it is shown
+func redactDeadLetter(letter *models.TriggerDeadLetter) *models.TriggerDeadLetter {
+	letter.Payload = models.RedactPayload(letter.Payload)
+	return letter
+}
+
+func (s *TriggerDeadLetterService) trackRedrive(ctx context.Context, session *models.AdminSession, id, destination, outcome string) {
+	s.tracker.Track(ctx, analytics.EventAdminTriggerRedriven, analytics.ModeratorDistinctID(session.ModeratorID), map[string]interface{}{
+		"moderator_id":   session.ModeratorID,
+		"moderator_role": string(session.Role),
+		"dead_letter_id": id,
+		"destination":    destination,
+		"outcome":        outcome,
+	})
+}
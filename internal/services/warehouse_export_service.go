@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/warehouse"
+	"go.uber.org/zap"
+)
+
+const (
+	warehouseRequestsTable = "request_facts"
+	warehouseMentorsTable  = "mentor_facts"
+
+	// warehouseExportLag keeps the export behind the newest rows, so rows written by
+	// transactions still in flight don't land below the watermark once they commit
+	warehouseExportLag = time.Minute
+)
+
+// warehouseSchema is applied in order before the first export of the process. Statements must stay idempotent:
+// add columns with ALTER TABLE ... ADD COLUMN IF NOT EXISTS instead of editing a CREATE TABLE.
+// ReplacingMergeTree keeps the latest version of a row, so re-exported rows replace old ones.
+var warehouseSchema = []string{
+	`CREATE TABLE IF NOT EXISTS request_facts (
+		request_id UUID,
+		mentor_id String,
+		mentee_hash String,
+		level LowCardinality(String),
+		status LowCardinality(String),
+		decline_reason LowCardinality(String),
+		quarantine_status LowCardinality(String),
+		has_review Bool,
+		created_at DateTime64(3, 'UTC'),
+		status_changed_at Nullable(DateTime64(3, 'UTC')),
+		updated_at DateTime64(3, 'UTC'),
+		exported_at DateTime64(3, 'UTC')
+	) ENGINE = ReplacingMergeTree(updated_at) ORDER BY request_id`,
+	`CREATE TABLE IF NOT EXISTS mentor_facts (
+		mentor_id UUID,
+		status LowCardinality(String),
+		experience LowCardinality(String),
+		price LowCardinality(String),
+		country LowCardinality(String),
+		remote_only Bool,
+		languages Array(LowCardinality(String)),
+		tags Array(LowCardinality(String)),
+		merged_into String,
+		created_at DateTime64(3, 'UTC'),
+		updated_at DateTime64(3, 'UTC'),
+		exported_at DateTime64(3, 'UTC')
+	) ENGINE = ReplacingMergeTree(updated_at) ORDER BY mentor_id`,
+}
+
+// WarehouseExportService periodically ships anonymized request and mentor activity to the
+// analytics warehouse. Each stream resumes from its watermark in PostgreSQL; the watermark
+// row is locked while a batch is shipped, so several API instances don't export twice.
+type WarehouseExportService struct {
+	repo      *repository.WarehouseExportRepository
+	uow       *repository.UnitOfWork
+	warehouse warehouse.Warehouse
+	hasher    *warehouse.Hasher
+	interval  time.Duration
+	batchSize int
+
+	schemaReady bool
+}
+
+// NewWarehouseExportService creates a new warehouse export service
+func NewWarehouseExportService(
+	repo *repository.WarehouseExportRepository,
+	uow *repository.UnitOfWork,
+	wh warehouse.Warehouse,
+	hasher *warehouse.Hasher,
+	interval time.Duration,
+	batchSize int,
+) *WarehouseExportService {
+
+	return &WarehouseExportService{
+		repo:      repo,
+		uow:       uow,
+		warehouse: wh,
+		hasher:    hasher,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Start runs an export in the background now and then every interval
+func (s *WarehouseExportService) Start() {
+	go func() {
+		s.exportAndLog()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.exportAndLog()
+		}
+	}()
+}
+
+func (s *WarehouseExportService) exportAndLog() {
+	if err := s.ExportAll(context.Background()); err != nil {
+		logger.Error("Warehouse export failed", zap.Error(err))
+		// The watermark only moves after a successful batch - the next run resumes from it
+	}
+}
+
+// ExportAll makes sure the warehouse schema exists and exports every stream up to now.
+// It is not safe for concurrent use; Start calls it from a single goroutine.
+func (s *WarehouseExportService) ExportAll(ctx context.Context) error {
+	if !s.schemaReady {
+		for _, statement := range warehouseSchema {
+			if err := s.warehouse.Exec(ctx, statement); err != nil {
+				return fmt.Errorf("failed to apply warehouse schema: %w", err)
+			}
+		}
+		s.schemaReady = true
+	}
+
+	for _, stream := range []string{models.WarehouseStreamRequests, models.WarehouseStreamMentors} {
+		if err := s.exportStream(ctx, stream); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportStream ships batches until the stream catches up with the cutoff
+func (s *WarehouseExportService) exportStream(ctx context.Context, stream string) error {
+	start := time.Now()
+	cutoff := start.Add(-warehouseExportLag)
+	total := 0
+
+	for {
+		shipped := 0
+		locked := true
+		err := s.uow.Do(ctx, func(ctx context.Context) error {
+			watermark, err := s.repo.LockWatermark(ctx, stream)
+			if err != nil {
+				return err
+			}
+			if watermark == nil {
+				locked = false
+				return nil
+			}
+
+			rows, last, err := s.readBatch(ctx, stream, watermark.Cursor, cutoff)
+			if err != nil || len(rows) == 0 {
+				return err
+			}
+			if err := s.warehouse.Insert(ctx, streamTable(stream), rows); err != nil {
+				return err
+			}
+			shipped = len(rows)
+			return s.repo.AdvanceWatermark(ctx, stream, last, shipped)
+		})
+		if err != nil {
+			metrics.WarehouseExportRows.WithLabelValues(stream, "error").Inc()
+			return fmt.Errorf("failed to export %s: %w", stream, err)
+		}
+		if !locked {
+			logger.Info("Warehouse export of stream is running elsewhere; skipping", zap.String("stream", stream))
+			return nil
+		}
+
+		total += shipped
+		metrics.WarehouseExportRows.WithLabelValues(stream, "success").Add(float64(shipped))
+		if shipped < s.batchSize {
+			break
+		}
+	}
+
+	logger.Info("Warehouse export completed",
+		zap.String("stream", stream),
+		zap.Int("rows", total),
+		zap.Duration("duration", time.Since(start)))
+	return nil
+}
+
+// readBatch reads the next batch of a stream and anonymizes it, returning the rows
+// and the cursor of the last one
+func (s *WarehouseExportService) readBatch(ctx context.Context, stream string, after models.HistoryCursor, cutoff time.Time) ([]interface{}, models.HistoryCursor, error) {
+	exportedAt := time.Now().UTC()
+	rows := []interface{}{}
+	last := after
+
+	switch stream {
+	case models.WarehouseStreamRequests:
+		activity, err := s.repo.ListRequestActivity(ctx, after, cutoff, s.batchSize)
+		if err != nil {
+			return nil, last, err
+		}
+		for _, a := range activity {
+			rows = append(rows, s.requestFact(a, exportedAt))
+			last = models.HistoryCursor{At: a.UpdatedAt, ID: a.ID}
+		}
+	case models.WarehouseStreamMentors:
+		facts, err := s.repo.ListMentorFacts(ctx, after, cutoff, s.batchSize)
+		if err != nil {
+			return nil, last, err
+		}
+		for _, f := range facts {
+			f.ExportedAt = exportedAt
+			rows = append(rows, f)
+			last = models.HistoryCursor{At: f.UpdatedAt, ID: f.MentorID}
+		}
+	default:
+		return nil, last, fmt.Errorf("unknown warehouse stream %q", stream)
+	}
+	return rows, last, nil
+}
+
+func (s *WarehouseExportService) requestFact(a *models.RequestActivity, exportedAt time.Time) *models.RequestFact {
+	return &models.RequestFact{
+		RequestID:        a.ID,
+		MentorID:         a.MentorID,
+		MenteeHash:       s.hasher.Hash(a.Email),
+		Level:            a.Level,
+		Status:           a.Status,
+		DeclineReason:    a.DeclineReason,
+		QuarantineStatus: a.QuarantineStatus,
+		HasReview:        a.HasReview,
+		CreatedAt:        a.CreatedAt,
+		StatusChangedAt:  a.StatusChangedAt,
+		UpdatedAt:        a.UpdatedAt,
+		ExportedAt:       exportedAt,
+	}
+}
+
+func streamTable(stream string) string {
+	if stream == models.WarehouseStreamMentors {
+		return warehouseMentorsTable
+	}
+	return warehouseRequestsTable
+}
@@ -0,0 +1,3 @@
+ALTER TABLE mentors
+  DROP COLUMN IF EXISTS contact_hours,
+  DROP COLUMN IF EXISTS timezone;
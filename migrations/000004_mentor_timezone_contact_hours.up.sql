@@ -0,0 +1,6 @@
+-- Mentor timezone (IANA name, e.g. Europe/Moscow) and preferred contact hours
+-- in the mentor's local time, formatted as HH:MM-HH:MM
+
+ALTER TABLE mentors
+  ADD COLUMN IF NOT EXISTS timezone TEXT,
+  ADD COLUMN IF NOT EXISTS contact_hours TEXT;
@@ -0,0 +1,42 @@
+-- Programs: group workshops / sessions run by a mentor, and the attendees registered for them
+
+CREATE TABLE IF NOT EXISTS programs (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  title TEXT NOT NULL,
+  description TEXT,
+  starts_at TIMESTAMPTZ NOT NULL,
+  duration_minutes INTEGER NOT NULL,
+  schedule TEXT,
+  capacity INTEGER NOT NULL,
+  price TEXT,
+  status TEXT NOT NULL DEFAULT 'published',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT programs_status_chk CHECK (status IN ('draft', 'published', 'cancelled')),
+  CONSTRAINT programs_capacity_chk CHECK (capacity > 0),
+  CONSTRAINT programs_duration_chk CHECK (duration_minutes > 0)
+);
+
+CREATE INDEX IF NOT EXISTS programs_mentor_id_idx ON programs (mentor_id);
+CREATE INDEX IF NOT EXISTS programs_status_starts_at_idx ON programs (status, starts_at);
+
+CREATE TABLE IF NOT EXISTS program_registrations (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  program_id UUID NOT NULL REFERENCES programs(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  email CITEXT NOT NULL,
+  telegram TEXT,
+  comment TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT program_registrations_program_email_uniq UNIQUE (program_id, email)
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_programs_updated_at') THEN
+    CREATE TRIGGER trg_programs_updated_at
+    BEFORE UPDATE ON programs
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
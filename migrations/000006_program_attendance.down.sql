@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS program_registrations_waitlist_idx;
+DROP INDEX IF EXISTS program_registrations_cancel_token_uniq;
+DROP INDEX IF EXISTS program_registrations_program_email_active_uniq;
+
+DELETE FROM program_registrations WHERE status = 'cancelled';
+
+ALTER TABLE program_registrations
+  ADD CONSTRAINT program_registrations_program_email_uniq UNIQUE (program_id, email);
+
+ALTER TABLE program_registrations
+  DROP CONSTRAINT IF EXISTS program_registrations_status_chk,
+  DROP COLUMN IF EXISTS cancelled_at,
+  DROP COLUMN IF EXISTS cancel_token,
+  DROP COLUMN IF EXISTS status;
@@ -0,0 +1,32 @@
+-- Program attendance: registrations beyond capacity go to a waitlist, and every
+-- registration carries a secret token the attendee uses to cancel or download the event
+
+ALTER TABLE program_registrations
+  ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'registered',
+  ADD COLUMN IF NOT EXISTS cancel_token TEXT,
+  ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+
+UPDATE program_registrations
+SET cancel_token = encode(gen_random_bytes(32), 'hex')
+WHERE cancel_token IS NULL;
+
+ALTER TABLE program_registrations
+  ALTER COLUMN cancel_token SET NOT NULL;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'program_registrations_status_chk') THEN
+    ALTER TABLE program_registrations
+      ADD CONSTRAINT program_registrations_status_chk CHECK (status IN ('registered', 'waitlisted', 'cancelled'));
+  END IF;
+END $$;
+
+-- Cancelled attendees may register again
+ALTER TABLE program_registrations
+  DROP CONSTRAINT IF EXISTS program_registrations_program_email_uniq;
+CREATE UNIQUE INDEX IF NOT EXISTS program_registrations_program_email_active_uniq
+  ON program_registrations (program_id, email) WHERE status <> 'cancelled';
+
+CREATE UNIQUE INDEX IF NOT EXISTS program_registrations_cancel_token_uniq ON program_registrations (cancel_token);
+CREATE INDEX IF NOT EXISTS program_registrations_waitlist_idx
+  ON program_registrations (program_id, created_at) WHERE status = 'waitlisted';
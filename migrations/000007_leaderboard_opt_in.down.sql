@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS client_requests_done_status_changed_at_idx;
+
+ALTER TABLE mentors
+  DROP COLUMN IF EXISTS leaderboard_opt_in;
@@ -0,0 +1,7 @@
+-- Mentors appear on the public leaderboard only after opting in
+
+ALTER TABLE mentors
+  ADD COLUMN IF NOT EXISTS leaderboard_opt_in BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS client_requests_done_status_changed_at_idx
+  ON client_requests (status_changed_at) WHERE status = 'done';
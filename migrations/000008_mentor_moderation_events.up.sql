@@ -0,0 +1,22 @@
+-- Moderation history of mentor profiles: one row per approve/decline decision
+
+CREATE TABLE IF NOT EXISTS mentor_moderation_events (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  action TEXT NOT NULL,
+  moderator_id UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT mentor_moderation_events_action_chk CHECK (action IN ('approve', 'decline'))
+);
+
+CREATE INDEX IF NOT EXISTS mentor_moderation_events_action_created_at_idx
+  ON mentor_moderation_events (action, created_at);
+CREATE INDEX IF NOT EXISTS mentor_moderation_events_mentor_id_idx
+  ON mentor_moderation_events (mentor_id);
+
+-- Mentors approved before events were recorded count as approved when they joined
+INSERT INTO mentor_moderation_events (mentor_id, action, created_at)
+SELECT m.id, 'approve', m.created_at
+FROM mentors m
+WHERE m.status IN ('active', 'inactive')
+  AND NOT EXISTS (SELECT 1 FROM mentor_moderation_events e WHERE e.mentor_id = m.id);
@@ -0,0 +1,32 @@
+-- Abuse reports about mentor profiles and client requests, handled in the admin moderation queue
+
+CREATE TABLE IF NOT EXISTS abuse_reports (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  target_type TEXT NOT NULL,
+  mentor_id UUID REFERENCES mentors(id) ON DELETE SET NULL,
+  client_request_id UUID REFERENCES client_requests(id) ON DELETE SET NULL,
+  category TEXT NOT NULL,
+  description TEXT NOT NULL,
+  reporter_email CITEXT,
+  status TEXT NOT NULL DEFAULT 'open',
+  resolution_comment TEXT,
+  resolved_by UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  resolved_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT abuse_reports_target_type_chk CHECK (target_type IN ('mentor', 'request')),
+  CONSTRAINT abuse_reports_category_chk CHECK (category IN ('spam', 'fraud', 'harassment', 'inappropriate', 'other')),
+  CONSTRAINT abuse_reports_status_chk CHECK (status IN ('open', 'in_review', 'resolved', 'dismissed'))
+);
+
+CREATE INDEX IF NOT EXISTS abuse_reports_status_created_at_idx ON abuse_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS abuse_reports_mentor_id_idx ON abuse_reports (mentor_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_abuse_reports_updated_at') THEN
+    CREATE TRIGGER trg_abuse_reports_updated_at
+    BEFORE UPDATE ON abuse_reports
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
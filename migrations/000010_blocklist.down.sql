@@ -0,0 +1,2 @@
+ALTER TABLE client_requests DROP COLUMN IF EXISTS flag_reason;
+DROP TABLE IF EXISTS blocklist_entries;
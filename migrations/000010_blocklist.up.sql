@@ -0,0 +1,22 @@
+-- Admin-managed blocklist of mentee emails, Telegram handles and IP ranges
+
+CREATE TABLE IF NOT EXISTS blocklist_entries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  kind TEXT NOT NULL,
+  value TEXT NOT NULL,
+  action TEXT NOT NULL DEFAULT 'reject',
+  reason TEXT,
+  created_by UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ,
+  removed_by UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  removed_at TIMESTAMPTZ,
+  CONSTRAINT blocklist_entries_kind_chk CHECK (kind IN ('email', 'telegram', 'ip')),
+  CONSTRAINT blocklist_entries_action_chk CHECK (action IN ('reject', 'flag'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS blocklist_entries_active_value_uidx
+  ON blocklist_entries (kind, value) WHERE removed_at IS NULL;
+
+-- Submissions that matched a "flag" entry are accepted but marked for review
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS flag_reason TEXT;
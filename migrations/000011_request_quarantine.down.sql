@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS client_requests_quarantined_idx;
+ALTER TABLE client_requests DROP CONSTRAINT IF EXISTS client_requests_quarantine_status_chk;
+ALTER TABLE client_requests DROP COLUMN IF EXISTS quarantine_reviewed_at;
+ALTER TABLE client_requests DROP COLUMN IF EXISTS quarantine_reviewed_by;
+ALTER TABLE client_requests DROP COLUMN IF EXISTS quarantine_status;
+
+DELETE FROM blocklist_entries WHERE action = 'shadow';
+ALTER TABLE blocklist_entries DROP CONSTRAINT IF EXISTS blocklist_entries_action_chk;
+ALTER TABLE blocklist_entries
+  ADD CONSTRAINT blocklist_entries_action_chk CHECK (action IN ('reject', 'flag'));
@@ -0,0 +1,22 @@
+-- Shadow mode for antispam rules: matching requests look accepted to the mentee
+-- but are held in a quarantine queue for admin review and never reach the mentor
+
+ALTER TABLE blocklist_entries DROP CONSTRAINT IF EXISTS blocklist_entries_action_chk;
+ALTER TABLE blocklist_entries
+  ADD CONSTRAINT blocklist_entries_action_chk CHECK (action IN ('reject', 'flag', 'shadow'));
+
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS quarantine_status TEXT;
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS quarantine_reviewed_by UUID REFERENCES moderators(id) ON DELETE SET NULL;
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS quarantine_reviewed_at TIMESTAMPTZ;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'client_requests_quarantine_status_chk') THEN
+    ALTER TABLE client_requests
+      ADD CONSTRAINT client_requests_quarantine_status_chk
+      CHECK (quarantine_status IS NULL OR quarantine_status IN ('quarantined', 'released', 'discarded'));
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS client_requests_quarantined_idx
+  ON client_requests (created_at) WHERE quarantine_status = 'quarantined';
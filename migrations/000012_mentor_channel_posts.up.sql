@@ -0,0 +1,7 @@
+-- Announcements of newly activated mentors posted to the Telegram channel.
+-- One row per mentor: re-approvals and status toggles never post twice.
+
+CREATE TABLE IF NOT EXISTS mentor_channel_posts (
+  mentor_id UUID PRIMARY KEY REFERENCES mentors(id) ON DELETE CASCADE,
+  posted_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS client_requests_mentor_scheduled_at_idx;
+DROP TRIGGER IF EXISTS trg_client_requests_schedule_sequence ON client_requests;
+DROP FUNCTION IF EXISTS bump_schedule_sequence();
+ALTER TABLE client_requests DROP COLUMN IF EXISTS schedule_sequence;
+
+DROP INDEX IF EXISTS mentors_calendar_feed_token_uidx;
+ALTER TABLE mentors DROP COLUMN IF EXISTS calendar_feed_token;
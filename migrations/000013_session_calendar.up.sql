@@ -0,0 +1,34 @@
+-- iCal feed of mentors' scheduled sessions
+
+-- Secret token in the subscription URL; calendar apps can't send session cookies
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS calendar_feed_token TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS mentors_calendar_feed_token_uidx
+  ON mentors (calendar_feed_token) WHERE calendar_feed_token IS NOT NULL;
+
+-- iCal SEQUENCE of the session: grows whenever the time or status changes,
+-- so subscribed calendars replace their copy after a reschedule
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS schedule_sequence INTEGER NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION bump_schedule_sequence()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  IF NEW.scheduled_at IS DISTINCT FROM OLD.scheduled_at OR NEW.status IS DISTINCT FROM OLD.status THEN
+    NEW.schedule_sequence = OLD.schedule_sequence + 1;
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_client_requests_schedule_sequence') THEN
+    CREATE TRIGGER trg_client_requests_schedule_sequence
+    BEFORE UPDATE ON client_requests
+    FOR EACH ROW EXECUTE FUNCTION bump_schedule_sequence();
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS client_requests_mentor_scheduled_at_idx
+  ON client_requests (mentor_id, scheduled_at) WHERE scheduled_at IS NOT NULL;
@@ -0,0 +1,21 @@
+-- Reschedule proposals: the mentor offers new slots, the mentee confirms one through a tokenized link
+CREATE TABLE IF NOT EXISTS session_reschedule_proposals (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  client_request_id UUID NOT NULL REFERENCES client_requests(id) ON DELETE CASCADE,
+  token TEXT NOT NULL UNIQUE,
+  slots TIMESTAMPTZ[] NOT NULL,
+  comment TEXT,
+  -- Request status to return to once the mentee confirms a slot
+  previous_status TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending',
+  chosen_slot TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  resolved_at TIMESTAMPTZ,
+  CONSTRAINT session_reschedule_proposals_status_chk CHECK (status IN ('pending', 'confirmed', 'superseded')),
+  CONSTRAINT session_reschedule_proposals_previous_status_chk CHECK (previous_status IN ('contacted', 'working'))
+);
+
+-- At most one open proposal per request
+CREATE UNIQUE INDEX IF NOT EXISTS session_reschedule_proposals_pending_uidx
+  ON session_reschedule_proposals (client_request_id) WHERE status = 'pending';
@@ -0,0 +1,21 @@
+-- Saved replies mentors use when contacting mentees, shared by the bot and the mentor portal
+
+CREATE TABLE IF NOT EXISTS mentor_reply_templates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  title TEXT NOT NULL,
+  body TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS mentor_reply_templates_mentor_id_idx ON mentor_reply_templates (mentor_id, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_mentor_reply_templates_updated_at') THEN
+    CREATE TRIGGER trg_mentor_reply_templates_updated_at
+    BEFORE UPDATE ON mentor_reply_templates
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
@@ -0,0 +1,6 @@
+ALTER TABLE mentors DROP CONSTRAINT IF EXISTS mentors_country_chk;
+
+ALTER TABLE mentors
+  DROP COLUMN IF EXISTS remote_only,
+  DROP COLUMN IF EXISTS city,
+  DROP COLUMN IF EXISTS country;
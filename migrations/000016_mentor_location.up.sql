@@ -0,0 +1,14 @@
+-- Mentor location: ISO 3166-1 alpha-2 country code, free-form city and
+-- whether the mentor only meets online
+
+ALTER TABLE mentors
+  ADD COLUMN IF NOT EXISTS country TEXT,
+  ADD COLUMN IF NOT EXISTS city TEXT,
+  ADD COLUMN IF NOT EXISTS remote_only BOOLEAN NOT NULL DEFAULT false;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'mentors_country_chk') THEN
+    ALTER TABLE mentors ADD CONSTRAINT mentors_country_chk CHECK (country IS NULL OR country ~ '^[A-Z]{2}$');
+  END IF;
+END $$;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_mentors_languages;
+
+ALTER TABLE mentors DROP CONSTRAINT IF EXISTS mentors_languages_chk;
+
+ALTER TABLE mentors DROP COLUMN IF EXISTS languages;
@@ -0,0 +1,15 @@
+-- Languages a mentor mentors in. Existing mentors default to Russian,
+-- the language every profile was written in until now.
+
+ALTER TABLE mentors
+  ADD COLUMN IF NOT EXISTS languages TEXT[] NOT NULL DEFAULT '{ru}';
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'mentors_languages_chk') THEN
+    ALTER TABLE mentors ADD CONSTRAINT mentors_languages_chk
+      CHECK (languages <@ ARRAY['ru', 'en', 'other']::TEXT[]);
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_mentors_languages ON mentors USING GIN (languages);
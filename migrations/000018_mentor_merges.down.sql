@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS mentor_merges;
+
+ALTER TABLE mentors DROP COLUMN IF EXISTS merged_into;
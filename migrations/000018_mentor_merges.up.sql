@@ -0,0 +1,16 @@
+-- Merging duplicate mentor records: the duplicate is kept (soft-deleted) and
+-- points at the record it was merged into; every merge is recorded for audit.
+
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS merged_into UUID REFERENCES mentors(id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS mentor_merges (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  primary_mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  duplicate_mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  moderator_id UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  moved JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS mentor_merges_primary_mentor_id_idx ON mentor_merges (primary_mentor_id);
+CREATE UNIQUE INDEX IF NOT EXISTS mentor_merges_duplicate_mentor_id_uidx ON mentor_merges (duplicate_mentor_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS mentor_email_changes;
@@ -0,0 +1,20 @@
+-- Pending mentor email changes. The new address only replaces the current one after
+-- the mentor follows the confirmation link sent to it.
+
+CREATE TABLE IF NOT EXISTS mentor_email_changes (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  old_email CITEXT,
+  new_email CITEXT NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  requested_by UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  status TEXT NOT NULL DEFAULT 'pending',
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  resolved_at TIMESTAMPTZ,
+  CONSTRAINT mentor_email_changes_status_chk CHECK (status IN ('pending', 'confirmed', 'superseded'))
+);
+
+-- At most one pending change per mentor
+CREATE UNIQUE INDEX IF NOT EXISTS mentor_email_changes_pending_uidx
+  ON mentor_email_changes (mentor_id) WHERE status = 'pending';
@@ -0,0 +1,16 @@
+-- Mentor portal sessions, one row per issued JWT (the token's jti is the row id).
+-- Lets mentors see where they are logged in and revoke individual devices.
+
+CREATE TABLE IF NOT EXISTS mentor_device_sessions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  user_agent TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_used_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS mentor_device_sessions_mentor_idx
+  ON mentor_device_sessions (mentor_id, created_at DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS trigger_dead_letters;
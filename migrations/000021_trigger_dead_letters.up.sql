@@ -0,0 +1,22 @@
+-- Outbound trigger deliveries that failed every retry. Admins can re-drive them once
+-- the receiving function is healthy again.
+
+CREATE TABLE IF NOT EXISTS trigger_dead_letters (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  destination TEXT NOT NULL,
+  trigger_url TEXT NOT NULL,
+  method TEXT NOT NULL,
+  record_id TEXT NOT NULL DEFAULT '',
+  payload JSONB,
+  attempts JSONB NOT NULL DEFAULT '[]',
+  attempt_count INT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'dead',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  delivered_at TIMESTAMPTZ,
+  CONSTRAINT trigger_dead_letters_status_chk CHECK (status IN ('dead', 'delivered'))
+);
+
+CREATE INDEX IF NOT EXISTS trigger_dead_letters_status_idx
+  ON trigger_dead_letters (status, created_at DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS mentors_updated_at_id_idx;
+DROP INDEX IF EXISTS client_requests_updated_at_id_idx;
+DROP TABLE IF EXISTS warehouse_export_watermarks;
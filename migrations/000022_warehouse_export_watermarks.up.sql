@@ -0,0 +1,13 @@
+-- Progress of the analytics warehouse export, one row per stream. The row is locked
+-- while a batch is shipped, so only one API instance exports a stream at a time.
+
+CREATE TABLE IF NOT EXISTS warehouse_export_watermarks (
+  stream TEXT PRIMARY KEY,
+  watermark_at TIMESTAMPTZ NOT NULL DEFAULT '1970-01-01 00:00:00+00',
+  watermark_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+  rows_exported BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS client_requests_updated_at_id_idx ON client_requests (updated_at, id);
+CREATE INDEX IF NOT EXISTS mentors_updated_at_id_idx ON mentors (updated_at, id);
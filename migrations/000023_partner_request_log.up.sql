@@ -0,0 +1,23 @@
+-- Sanitized summaries of requests made with partner API tokens, kept for a limited time
+-- to answer partner disputes. Rows older than the retention window are purged by the API.
+
+CREATE TABLE IF NOT EXISTS partner_request_log (
+  id BIGSERIAL PRIMARY KEY,
+  occurred_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  token_id TEXT NOT NULL,
+  method TEXT NOT NULL,
+  route TEXT NOT NULL,
+  path TEXT NOT NULL,
+  query TEXT NOT NULL DEFAULT '',
+  status INT NOT NULL,
+  result_count INT,
+  response_bytes INT NOT NULL DEFAULT 0,
+  latency_ms INT NOT NULL DEFAULT 0,
+  trace_id TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS partner_request_log_token_idx
+  ON partner_request_log (token_id, occurred_at DESC);
+
+CREATE INDEX IF NOT EXISTS partner_request_log_occurred_at_idx
+  ON partner_request_log (occurred_at);
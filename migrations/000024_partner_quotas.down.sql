@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS partner_quota_overrides;
+DROP TABLE IF EXISTS partner_quota_usage;
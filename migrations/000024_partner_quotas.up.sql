@@ -0,0 +1,19 @@
+-- Monthly request quotas for partner API tokens. Tokens are identified by their configured
+-- name (mentors_api, inno, aikb), never by the secret.
+
+CREATE TABLE IF NOT EXISTS partner_quota_usage (
+  token_name TEXT NOT NULL,
+  period DATE NOT NULL, -- first day of the month (UTC)
+  request_count BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (token_name, period)
+);
+
+-- Per-token overrides of the default monthly limit. A limit of 0 means unlimited.
+CREATE TABLE IF NOT EXISTS partner_quota_overrides (
+  token_name TEXT PRIMARY KEY,
+  monthly_limit BIGINT NOT NULL CHECK (monthly_limit >= 0),
+  note TEXT NOT NULL DEFAULT '',
+  updated_by TEXT NOT NULL DEFAULT '',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,16 @@
+-- Short links to mentor profiles (/m/:code) with UTM parameters and click counts
+
+CREATE TABLE IF NOT EXISTS mentor_short_links (
+  code TEXT PRIMARY KEY,
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  label TEXT NOT NULL DEFAULT '',
+  utm_source TEXT NOT NULL,
+  utm_medium TEXT NOT NULL,
+  utm_campaign TEXT NOT NULL DEFAULT '',
+  clicks BIGINT NOT NULL DEFAULT 0,
+  last_clicked_at TIMESTAMPTZ,
+  created_by TEXT NOT NULL, -- 'mentor' or the moderator's email
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS mentor_short_links_mentor_id_idx ON mentor_short_links (mentor_id, created_at);
@@ -0,0 +1,29 @@
+-- Community events (meetups, webinars, AMAs) announced on the site and by the bot
+
+CREATE TABLE IF NOT EXISTS community_events (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  location TEXT NOT NULL DEFAULT '',
+  url TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'cancelled')),
+  -- Grows with every change so calendar clients replace their copy of the event
+  sequence INT NOT NULL DEFAULT 0,
+  created_by TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS community_events_status_starts_at_idx ON community_events (status, starts_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_community_events_updated_at') THEN
+    CREATE TRIGGER trg_community_events_updated_at
+    BEFORE UPDATE ON community_events
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
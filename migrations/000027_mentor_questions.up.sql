@@ -0,0 +1,24 @@
+-- Anonymous questions to mentors. Mentors approve a question by answering it; only
+-- answered questions are public.
+
+CREATE TABLE IF NOT EXISTS mentor_questions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  question TEXT NOT NULL,
+  answer TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'published', 'rejected')),
+  answered_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS mentor_questions_mentor_status_idx ON mentor_questions (mentor_id, status, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_mentor_questions_updated_at') THEN
+    CREATE TRIGGER trg_mentor_questions_updated_at
+    BEFORE UPDATE ON mentor_questions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
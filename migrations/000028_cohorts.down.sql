@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS cohort_progress;
+DROP TABLE IF EXISTS cohort_participants;
+DROP TABLE IF EXISTS cohort_checkpoints;
+DROP TABLE IF EXISTS cohorts;
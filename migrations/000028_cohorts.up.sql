@@ -0,0 +1,76 @@
+-- Cohorts: structured mentorship runs with enrolled mentors and mentees, mentor-mentee
+-- pairs and progress checkpoints
+
+CREATE TABLE IF NOT EXISTS cohorts (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'enrolling', 'active', 'completed')),
+  mentee_capacity INTEGER NOT NULL CHECK (mentee_capacity > 0),
+  created_by TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS cohorts_status_starts_at_idx ON cohorts (status, starts_at);
+
+CREATE TABLE IF NOT EXISTS cohort_checkpoints (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  cohort_id UUID NOT NULL REFERENCES cohorts(id) ON DELETE CASCADE,
+  title TEXT NOT NULL,
+  due_at TIMESTAMPTZ NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS cohort_checkpoints_cohort_id_idx ON cohort_checkpoints (cohort_id, position);
+
+CREATE TABLE IF NOT EXISTS cohort_participants (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  cohort_id UUID NOT NULL REFERENCES cohorts(id) ON DELETE CASCADE,
+  role TEXT NOT NULL CHECK (role IN ('mentor', 'mentee')),
+  -- Set for mentors; mentees are not registered on the platform
+  mentor_id UUID REFERENCES mentors(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  email CITEXT NOT NULL,
+  telegram TEXT NOT NULL DEFAULT '',
+  goal TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'enrolled' CHECK (status IN ('enrolled', 'withdrawn', 'completed')),
+  -- The mentee's mentor in the cohort; NULL until paired and always NULL for mentors
+  paired_with UUID REFERENCES cohort_participants(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT cohort_participants_cohort_email_uniq UNIQUE (cohort_id, email),
+  CHECK ((role = 'mentor') = (mentor_id IS NOT NULL)),
+  CHECK (role = 'mentee' OR paired_with IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS cohort_participants_cohort_id_idx ON cohort_participants (cohort_id, role);
+CREATE INDEX IF NOT EXISTS cohort_participants_mentor_id_idx ON cohort_participants (mentor_id) WHERE mentor_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS cohort_participants_paired_with_idx ON cohort_participants (paired_with) WHERE paired_with IS NOT NULL;
+
+-- Checkpoints a mentee has reached, recorded by their mentor or an admin
+CREATE TABLE IF NOT EXISTS cohort_progress (
+  participant_id UUID NOT NULL REFERENCES cohort_participants(id) ON DELETE CASCADE,
+  checkpoint_id UUID NOT NULL REFERENCES cohort_checkpoints(id) ON DELETE CASCADE,
+  note TEXT NOT NULL DEFAULT '',
+  recorded_by TEXT NOT NULL,
+  completed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (participant_id, checkpoint_id)
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_cohorts_updated_at') THEN
+    CREATE TRIGGER trg_cohorts_updated_at
+    BEFORE UPDATE ON cohorts
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_cohort_participants_updated_at') THEN
+    CREATE TRIGGER trg_cohort_participants_updated_at
+    BEFORE UPDATE ON cohort_participants
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END $$;
@@ -0,0 +1,19 @@
+-- Completion certificates of cohort mentees. The recipient name and cohort title are
+-- copied at issue time so later edits don't change what a signed certificate states.
+
+CREATE TABLE IF NOT EXISTS cohort_certificates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  cohort_id UUID NOT NULL REFERENCES cohorts(id) ON DELETE CASCADE,
+  participant_id UUID NOT NULL REFERENCES cohort_participants(id) ON DELETE CASCADE,
+  recipient_name TEXT NOT NULL,
+  cohort_title TEXT NOT NULL,
+  completed_checkpoints INTEGER NOT NULL DEFAULT 0,
+  -- HMAC-SHA256 of the certificate statements, hex encoded
+  signature TEXT NOT NULL,
+  pdf_url TEXT NOT NULL DEFAULT '',
+  issued_by TEXT NOT NULL,
+  issued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT cohort_certificates_participant_uniq UNIQUE (participant_id)
+);
+
+CREATE INDEX IF NOT EXISTS cohort_certificates_cohort_id_idx ON cohort_certificates (cohort_id);
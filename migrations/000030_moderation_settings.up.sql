@@ -0,0 +1,11 @@
+-- Versioned moderation rules (tag limit, banned words, auto-approval of returning mentors).
+-- Every save adds a version; the highest one is in effect. Without rows the built-in
+-- defaults apply.
+
+CREATE TABLE IF NOT EXISTS moderation_settings (
+  version INT PRIMARY KEY CHECK (version > 0),
+  rules JSONB NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  created_by TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
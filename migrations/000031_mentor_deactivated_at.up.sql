@@ -0,0 +1,7 @@
+-- When an approved mentor left the platform, for auto-approving returning mentors.
+-- Mentors that are inactive already count from their last update.
+
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMPTZ;
+
+UPDATE mentors SET deactivated_at = updated_at
+WHERE status = 'inactive' AND deactivated_at IS NULL;
@@ -0,0 +1,18 @@
+-- NPS / satisfaction surveys of mentors. A row is an invitation; the answers are filled in
+-- when the mentor responds. Invitations are sent at most once per mentor per 90 days.
+
+CREATE TABLE IF NOT EXISTS mentor_surveys (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  invited_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  responded_at TIMESTAMPTZ,
+  nps SMALLINT CHECK (nps BETWEEN 0 AND 10),
+  satisfaction SMALLINT CHECK (satisfaction BETWEEN 1 AND 5),
+  request_quality SMALLINT CHECK (request_quality BETWEEN 1 AND 5),
+  would_continue BOOLEAN,
+  comment TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_mentor_surveys_mentor ON mentor_surveys(mentor_id, invited_at DESC);
+CREATE INDEX IF NOT EXISTS idx_mentor_surveys_invited_at ON mentor_surveys(invited_at);
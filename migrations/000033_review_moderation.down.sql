@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS reviews_pending_reply_idx;
+DROP INDEX IF EXISTS reviews_pending_idx;
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_reply_status_chk;
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_status_chk;
+ALTER TABLE reviews DROP COLUMN IF EXISTS reply_moderated_at;
+ALTER TABLE reviews DROP COLUMN IF EXISTS reply_moderated_by;
+ALTER TABLE reviews DROP COLUMN IF EXISTS replied_at;
+ALTER TABLE reviews DROP COLUMN IF EXISTS reply_status;
+ALTER TABLE reviews DROP COLUMN IF EXISTS mentor_reply;
+ALTER TABLE reviews DROP COLUMN IF EXISTS moderated_at;
+ALTER TABLE reviews DROP COLUMN IF EXISTS moderated_by;
+ALTER TABLE reviews DROP COLUMN IF EXISTS status;
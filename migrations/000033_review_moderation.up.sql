@@ -0,0 +1,29 @@
+-- Reviews are published only after a moderator approves them. The mentor can reply
+-- once to a published review; the reply is moderated the same way before it shows.
+-- Reviews written before moderation existed start pending too.
+
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'pending';
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS moderated_by UUID REFERENCES moderators(id) ON DELETE SET NULL;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS mentor_reply TEXT;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS reply_status TEXT;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS replied_at TIMESTAMPTZ;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS reply_moderated_by UUID REFERENCES moderators(id) ON DELETE SET NULL;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS reply_moderated_at TIMESTAMPTZ;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'reviews_status_chk') THEN
+    ALTER TABLE reviews
+      ADD CONSTRAINT reviews_status_chk CHECK (status IN ('pending', 'approved', 'rejected'));
+  END IF;
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'reviews_reply_status_chk') THEN
+    ALTER TABLE reviews
+      ADD CONSTRAINT reviews_reply_status_chk
+      CHECK ((mentor_reply IS NULL AND reply_status IS NULL)
+        OR (mentor_reply IS NOT NULL AND reply_status IN ('pending', 'approved', 'rejected')));
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS reviews_pending_idx ON reviews (created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS reviews_pending_reply_idx ON reviews (replied_at) WHERE reply_status = 'pending';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS mentor_insights;
+DROP TRIGGER IF EXISTS trg_client_requests_first_response_at ON client_requests;
+DROP FUNCTION IF EXISTS set_first_response_at();
+ALTER TABLE client_requests DROP COLUMN IF EXISTS first_response_at;
@@ -0,0 +1,47 @@
+-- Private per-mentor insights: aggregated decline reasons, review sentiment and
+-- response times, recomputed periodically. Only counts are stored, never mentees.
+
+-- When the mentor first moved a request out of 'pending', for the response SLA.
+-- Requests answered before this column existed count from their last status change.
+ALTER TABLE client_requests ADD COLUMN IF NOT EXISTS first_response_at TIMESTAMPTZ;
+
+UPDATE client_requests SET first_response_at = COALESCE(status_changed_at, updated_at)
+WHERE status <> 'pending' AND first_response_at IS NULL;
+
+CREATE OR REPLACE FUNCTION set_first_response_at()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  IF OLD.status = 'pending' AND NEW.status <> 'pending' AND NEW.first_response_at IS NULL THEN
+    NEW.first_response_at = now();
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'trg_client_requests_first_response_at') THEN
+    CREATE TRIGGER trg_client_requests_first_response_at
+    BEFORE UPDATE ON client_requests
+    FOR EACH ROW EXECUTE FUNCTION set_first_response_at();
+  END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS mentor_insights (
+  mentor_id UUID PRIMARY KEY REFERENCES mentors(id) ON DELETE CASCADE,
+  window_days INTEGER NOT NULL,
+  sla_hours INTEGER NOT NULL,
+  requests INTEGER NOT NULL DEFAULT 0,
+  declined INTEGER NOT NULL DEFAULT 0,
+  decline_reasons JSONB NOT NULL DEFAULT '{}',
+  reviews INTEGER NOT NULL DEFAULT 0,
+  reviews_positive INTEGER NOT NULL DEFAULT 0,
+  reviews_neutral INTEGER NOT NULL DEFAULT 0,
+  reviews_negative INTEGER NOT NULL DEFAULT 0,
+  responded INTEGER NOT NULL DEFAULT 0,
+  responded_within_sla INTEGER NOT NULL DEFAULT 0,
+  median_response_hours DOUBLE PRECISION,
+  computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS mentors_not_deleted_status_idx;
+ALTER TABLE mentors DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE mentors DROP COLUMN IF EXISTS deleted_at;
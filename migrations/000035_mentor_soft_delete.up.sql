@@ -0,0 +1,7 @@
+-- Soft deletion of mentors: the row is kept for request history and audit, but a
+-- deleted mentor is hidden from every read path and can no longer log in.
+
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES moderators(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS mentors_not_deleted_status_idx ON mentors (status) WHERE deleted_at IS NULL;
@@ -0,0 +1,19 @@
+-- Audit log of mentor and request mutations: who made the change (actor), what changed
+-- (operation and the old and new values of the changed fields) and when.
+
+CREATE TABLE IF NOT EXISTS audit_log (
+  id BIGSERIAL PRIMARY KEY,
+  occurred_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  actor_type TEXT NOT NULL,
+  actor_id TEXT,
+  entity_type TEXT NOT NULL,
+  entity_id TEXT NOT NULL,
+  operation TEXT NOT NULL,
+  changes JSONB NOT NULL DEFAULT '{}'::jsonb,
+  trace_id TEXT,
+  CONSTRAINT audit_log_actor_type_chk CHECK (actor_type IN ('admin', 'mentor', 'token', 'internal', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id, id DESC);
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor_type, actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS audit_log_occurred_at_idx ON audit_log (occurred_at);
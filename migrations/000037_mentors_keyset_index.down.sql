@@ -0,0 +1 @@
+DROP INDEX IF EXISTS mentors_active_sort_order_id_idx;
@@ -0,0 +1,4 @@
+-- Keyset pagination of the active mentor list on (sort_order, id)
+
+CREATE INDEX IF NOT EXISTS mentors_active_sort_order_id_idx ON mentors (sort_order, id)
+  WHERE status = 'active' AND deleted_at IS NULL;
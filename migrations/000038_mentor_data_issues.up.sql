@@ -0,0 +1,16 @@
+-- Data quality issues found on mentor profiles by cmd/validate-data. One row per mentor and
+-- check; a run that no longer finds the issue sets resolved_at, and a later recurrence reopens it.
+
+CREATE TABLE IF NOT EXISTS mentor_data_issues (
+  mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+  check_name TEXT NOT NULL,
+  detail TEXT NOT NULL DEFAULT '',
+  first_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  resolved_at TIMESTAMPTZ,
+  PRIMARY KEY (mentor_id, check_name),
+  CONSTRAINT mentor_data_issues_check_name_chk
+    CHECK (check_name IN ('broken_calendar_url', 'missing_photo', 'empty_competencies', 'invalid_telegram'))
+);
+
+CREATE INDEX IF NOT EXISTS mentor_data_issues_open_idx ON mentor_data_issues (first_seen_at) WHERE resolved_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS mentors_search_vector_idx;
+ALTER TABLE mentors DROP COLUMN IF EXISTS search_vector;
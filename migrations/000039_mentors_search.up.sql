@@ -0,0 +1,13 @@
+-- Full-text search over mentor profiles. The 'russian' configuration stems Cyrillic words with the
+-- Russian stemmer and Latin ones with the English stemmer, which fits the mixed-language profiles.
+-- Name and job title rank above competencies, which rank above the about and description texts.
+
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+  setweight(to_tsvector('russian', COALESCE(job_title, '')), 'A') ||
+  setweight(to_tsvector('russian', COALESCE(competencies, '')), 'B') ||
+  setweight(to_tsvector('russian', COALESCE(about, '')), 'C') ||
+  setweight(to_tsvector('russian', COALESCE(details, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS mentors_search_vector_idx ON mentors USING GIN (search_vector);
@@ -0,0 +1,4 @@
+ALTER TABLE mentors
+  DROP COLUMN IF EXISTS calendar_broken_at,
+  DROP COLUMN IF EXISTS calendar_checked_at,
+  DROP COLUMN IF EXISTS calendar_check_failures;
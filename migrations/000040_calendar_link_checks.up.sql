@@ -0,0 +1,8 @@
+-- Calendar link checks: consecutive failed checks of the mentor's calendar_url and when the link
+-- was marked broken after too many of them. A broken link is hidden from mentees until a check
+-- succeeds again or the mentor changes the link.
+
+ALTER TABLE mentors
+  ADD COLUMN IF NOT EXISTS calendar_check_failures INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS calendar_checked_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS calendar_broken_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_rating_chk;
+ALTER TABLE reviews DROP COLUMN IF EXISTS rating;
@@ -0,0 +1,11 @@
+-- Mentees rate the session from 1 to 5 together with the review. The mentor's average rating
+-- counts approved reviews only. Reviews written before ratings existed stay unrated.
+
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS rating SMALLINT;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'reviews_rating_chk') THEN
+    ALTER TABLE reviews ADD CONSTRAINT reviews_rating_chk CHECK (rating BETWEEN 1 AND 5);
+  END IF;
+END $$;
@@ -0,0 +1,23 @@
+-- All-time per-mentor statistics for the admin UI, refreshed periodically by the API
+-- (REFRESH MATERIALIZED VIEW CONCURRENTLY, which needs the unique index).
+-- Quarantined requests never reached the mentor and are left out.
+CREATE MATERIALIZED VIEW IF NOT EXISTS mentor_stats AS
+SELECT m.id AS mentor_id,
+  COUNT(cr.id)::int AS requests,
+  COUNT(cr.id) FILTER (WHERE cr.status = 'done')::int AS sessions_done,
+  COUNT(cr.id) FILTER (WHERE cr.status = 'declined')::int AS declined,
+  percentile_cont(0.5) WITHIN GROUP (
+    ORDER BY EXTRACT(EPOCH FROM (cr.first_response_at - cr.created_at)) / 3600
+  ) AS median_response_hours,
+  GREATEST(
+    MAX(cr.first_response_at),
+    MAX(cr.status_changed_at) FILTER (WHERE cr.status <> 'pending'),
+    (SELECT MAX(ds.last_used_at) FROM mentor_device_sessions ds WHERE ds.mentor_id = m.id)
+  ) AS last_activity_at,
+  now() AS computed_at
+FROM mentors m
+LEFT JOIN client_requests cr
+  ON cr.mentor_id = m.id AND COALESCE(cr.quarantine_status, 'released') = 'released'
+GROUP BY m.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS mentor_stats_mentor_id_idx ON mentor_stats (mentor_id);
@@ -0,0 +1,2 @@
+-- The JSON Patch document behind a change, for edits made with one (admin UI undo)
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS patch JSONB;
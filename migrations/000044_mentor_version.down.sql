@@ -0,0 +1 @@
+ALTER TABLE mentors DROP COLUMN IF EXISTS version;
@@ -0,0 +1,3 @@
+-- Row version for optimistic locking of mentor edits. It is bumped by every write of profile
+-- data; bookkeeping writes (login tokens, calendar checks) leave it alone.
+ALTER TABLE mentors ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
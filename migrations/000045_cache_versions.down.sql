@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cache_replicas;
+DROP TABLE IF EXISTS cache_versions;
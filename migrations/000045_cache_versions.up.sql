@@ -0,0 +1,20 @@
+-- Shared version of each in-process cache. A change bumps it; replicas poll it and reload when
+-- it is newer than what they hold, so caches in every region converge within the poll interval.
+CREATE TABLE IF NOT EXISTS cache_versions (
+    name TEXT PRIMARY KEY,
+    version BIGINT NOT NULL DEFAULT 1,
+    bumped_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO cache_versions (name) VALUES ('mentors') ON CONFLICT (name) DO NOTHING;
+
+-- The version each replica holds, reported on every poll, to compare replicas across regions
+CREATE TABLE IF NOT EXISTS cache_replicas (
+    replica TEXT NOT NULL,
+    name TEXT NOT NULL REFERENCES cache_versions (name) ON DELETE CASCADE,
+    region TEXT NOT NULL DEFAULT '',
+    version BIGINT NOT NULL,
+    loaded_at TIMESTAMPTZ NOT NULL,
+    seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (replica, name)
+);
@@ -0,0 +1,12 @@
+-- Origins allowed to call the API from browsers, on top of ALLOWED_CORS_ORIGINS. Replicas reload them
+-- periodically. Empty method/header lists mean the full default set; partner widgets get narrower ones.
+CREATE TABLE IF NOT EXISTS cors_origins (
+  origin TEXT PRIMARY KEY,
+  allowed_methods TEXT[] NOT NULL DEFAULT '{}',
+  allowed_headers TEXT[] NOT NULL DEFAULT '{}',
+  allow_credentials BOOLEAN NOT NULL DEFAULT FALSE,
+  note TEXT,
+  updated_by UUID REFERENCES moderators(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,13 @@
+-- State of the Airtable cutover (cmd/migrate --finalize). While writes are frozen, replicas skip
+-- their background Airtable sync; once data_source is 'postgres' the Airtable base is retired and
+-- nothing writes to it again.
+CREATE TABLE IF NOT EXISTS airtable_cutover (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    data_source TEXT NOT NULL DEFAULT 'airtable' CHECK (data_source IN ('airtable', 'postgres')),
+    writes_frozen_at TIMESTAMPTZ,
+    finalized_at TIMESTAMPTZ,
+    report JSONB,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO airtable_cutover (id) VALUES (TRUE) ON CONFLICT (id) DO NOTHING;
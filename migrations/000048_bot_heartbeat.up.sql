@@ -0,0 +1,9 @@
+-- Last heartbeat of the Telegram bot (POST /api/v1/bot/heartbeat). silent_alerted_at is set by the
+-- replica that raised the bot-silent alert, so replicas raise it once, and cleared by the next heartbeat.
+CREATE TABLE IF NOT EXISTS bot_heartbeat (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    version TEXT NOT NULL,
+    last_update_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    silent_alerted_at TIMESTAMPTZ
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS queued_notifications;
+DROP TABLE IF EXISTS mentor_notification_settings;
@@ -0,0 +1,22 @@
+-- Quiet hours of a mentor ("HH:MM-HH:MM" in the mentor's timezone). Mentor notifications of the
+-- channels held during quiet hours are queued instead of sent, and delivered once they end.
+CREATE TABLE IF NOT EXISTS mentor_notification_settings (
+    mentor_id UUID PRIMARY KEY REFERENCES mentors(id) ON DELETE CASCADE,
+    quiet_hours TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Notifications held until deliver_after. A delivery deletes the rows it sends, so every replica
+-- may deliver and none sends a notification twice.
+CREATE TABLE IF NOT EXISTS queued_notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mentor_id UUID NOT NULL REFERENCES mentors(id) ON DELETE CASCADE,
+    channel TEXT NOT NULL,
+    record_id TEXT,
+    payload JSONB,
+    deliver_after TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS queued_notifications_deliver_after_idx ON queued_notifications (deliver_after);
+CREATE INDEX IF NOT EXISTS queued_notifications_mentor_id_idx ON queued_notifications (mentor_id);
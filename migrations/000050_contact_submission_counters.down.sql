@@ -0,0 +1 @@
+DROP TABLE IF EXISTS contact_submission_counters;
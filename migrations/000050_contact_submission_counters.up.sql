@@ -0,0 +1,13 @@
+-- Contact form submissions per email and per client IP, one row per fixed window. The limiter
+-- weighs the previous window by how much of it still overlaps the sliding window ending now, so
+-- rows older than two windows are no longer read and are deleted.
+CREATE TABLE IF NOT EXISTS contact_submission_counters (
+    kind TEXT NOT NULL CHECK (kind IN ('email', 'ip')),
+    subject TEXT NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    submissions INTEGER NOT NULL DEFAULT 0,
+    last_submission_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (kind, subject, window_start)
+);
+
+CREATE INDEX IF NOT EXISTS contact_submission_counters_window_start_idx ON contact_submission_counters (window_start);
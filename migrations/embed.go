@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL schema migrations, so the API and migrate binaries
+// can apply them without the migrations directory next to them.
+package migrations
+
+import "embed"
+
+// FS holds the NNNNNN_description.{up,down}.sql files
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,308 @@
+// Package airtable is a minimal client for the Airtable REST API, used to mirror
+// PostgreSQL changes back to the base that operations still work in.
+package airtable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/circuitbreaker"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultAPIURL is the public Airtable API
+	DefaultAPIURL = "https://api.airtable.com/v0"
+	// MaxBatch is the most records Airtable reads by ID or updates in one request
+	MaxBatch = 10
+	// listPageSize is the most records Airtable returns per page of a listing
+	listPageSize = 100
+
+	// Dependency labels the Airtable circuit breaker in logs and metrics
+	Dependency = "airtable"
+
+	// requestInterval keeps the client under Airtable's limit of 5 requests per second per base
+	requestInterval = 250 * time.Millisecond
+	maxErrorBody    = 1024
+
+	// breakerFailureThreshold consecutive failed requests open the circuit breaker for breakerOpenTimeout
+	breakerFailureThreshold = 5
+	breakerOpenTimeout      = 30 * time.Second
+)
+
+// StatusError is returned when Airtable answers with a status other than 200
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("airtable returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// Record is an Airtable record: its ID (rec...) and the fields to read or write. CreatedTime
+// is set by Airtable on records it returns and must be left empty when writing.
+type Record struct {
+	ID          string                 `json:"id"`
+	Fields      map[string]interface{} `json:"fields"`
+	CreatedTime string                 `json:"createdTime,omitempty"`
+}
+
+// Client talks to one Airtable base. Concurrent identical reads share one request, so the
+// records returned by Get and List may be shared between callers and must not be modified.
+// After breakerFailureThreshold consecutive failures (network errors, 429 and 5xx responses)
+// its circuit breaker opens and requests fail with circuitbreaker.ErrOpen without calling
+// Airtable until a probe request succeeds.
+type Client struct {
+	apiURL     string
+	baseID     string
+	token      string
+	httpClient httpclient.Client
+	reads      singleflight.Group
+	breaker    *circuitbreaker.Breaker
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewClient creates a client for the base, authenticating with a personal access token
+func NewClient(apiURL, baseID, token string, httpClient httpclient.Client) (*Client, error) {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	u, err := url.Parse(apiURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid Airtable API URL %q", apiURL)
+	}
+	if baseID == "" || token == "" {
+		return nil, errors.New("airtable base ID and token are required")
+	}
+	return &Client{
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		baseID:     baseID,
+		token:      token,
+		httpClient: httpClient,
+		breaker: circuitbreaker.New(circuitbreaker.Settings{
+			Dependency:       Dependency,
+			FailureThreshold: breakerFailureThreshold,
+			OpenTimeout:      breakerOpenTimeout,
+			IsFailure:        isOutage,
+		}),
+	}, nil
+}
+
+// isOutage reports whether err means Airtable is unavailable, as opposed to a rejected request
+func isOutage(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	return !errors.Is(err, context.Canceled)
+}
+
+// Get returns the records of table with the given IDs (at most MaxBatch), reading only
+// fields. Records that don't exist are left out.
+func (c *Client) Get(ctx context.Context, table string, ids []string, fields []string) ([]Record, error) {
+	if len(ids) == 0 {
+		return []Record{}, nil
+	}
+	if len(ids) > MaxBatch {
+		return nil, fmt.Errorf("airtable: at most %d records per request, got %d", MaxBatch, len(ids))
+	}
+
+	conditions := make([]string, len(ids))
+	for i, id := range ids {
+		conditions[i] = fmt.Sprintf("RECORD_ID()='%s'", strings.ReplaceAll(id, "'", ""))
+	}
+	query := url.Values{}
+	query.Set("filterByFormula", "OR("+strings.Join(conditions, ",")+")")
+	for _, field := range fields {
+		query.Add("fields[]", field)
+	}
+
+	target := c.tableURL(table) + "?" + query.Encode()
+	return c.sharedRead(ctx, "get", target, func(ctx context.Context) ([]Record, error) {
+		var resp struct {
+			Records []Record `json:"records"`
+		}
+		if err := observe("get", c.do(ctx, http.MethodGet, target, nil, &resp)); err != nil {
+			return nil, err
+		}
+		return resp.Records, nil
+	})
+}
+
+// List returns every record of table, reading only fields, following Airtable's
+// pagination offset until the last page
+func (c *Client) List(ctx context.Context, table string, fields []string) ([]Record, error) {
+	key := table + "\x00" + strings.Join(fields, "\x00")
+	return c.sharedRead(ctx, "list", key, func(ctx context.Context) ([]Record, error) {
+		records, err := c.list(ctx, table, fields)
+		return records, observe("list", err)
+	})
+}
+
+func (c *Client) list(ctx context.Context, table string, fields []string) ([]Record, error) {
+	records := []Record{}
+	offset := ""
+	for {
+		query := url.Values{}
+		query.Set("pageSize", fmt.Sprint(listPageSize))
+		for _, field := range fields {
+			query.Add("fields[]", field)
+		}
+		if offset != "" {
+			query.Set("offset", offset)
+		}
+
+		var resp struct {
+			Records []Record `json:"records"`
+			Offset  string   `json:"offset"`
+		}
+		if err := c.do(ctx, http.MethodGet, c.tableURL(table)+"?"+query.Encode(), nil, &resp); err != nil {
+			return nil, err
+		}
+		records = append(records, resp.Records...)
+		if resp.Offset == "" {
+			return records, nil
+		}
+		offset = resp.Offset
+	}
+}
+
+// Update writes the fields of up to MaxBatch records, leaving other fields untouched.
+// Values are typecast, so select options are created when missing.
+func (c *Client) Update(ctx context.Context, table string, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if len(records) > MaxBatch {
+		return fmt.Errorf("airtable: at most %d records per request, got %d", MaxBatch, len(records))
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"records": records, "typecast": true})
+	if err != nil {
+		return fmt.Errorf("failed to encode Airtable records: %w", err)
+	}
+	return observe("update", c.do(ctx, http.MethodPatch, c.tableURL(table), body, nil))
+}
+
+// observe counts an operation by outcome in getmentor_airtable_requests_total and returns err
+func observe(operation string, err error) error {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	metrics.AirtableRequests.WithLabelValues(operation, outcome).Inc()
+	return err
+}
+
+// sharedRead runs read once for concurrent callers with the same operation and key. The read
+// outlives a caller that gives up, since others may be waiting for it; each caller still
+// returns when its own context is done.
+func (c *Client) sharedRead(ctx context.Context, operation, key string, read func(context.Context) ([]Record, error)) ([]Record, error) {
+	leader := false
+	results := c.reads.DoChan(operation+"\x00"+key, func() (interface{}, error) {
+		leader = true
+		return read(context.WithoutCancel(ctx))
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if !leader {
+			metrics.AirtableCoalescedReads.WithLabelValues(operation).Inc()
+		}
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		records, _ := result.Val.([]Record) //nolint:errcheck // type assertion, reads return records
+		return records, nil
+	}
+}
+
+func (c *Client) tableURL(table string) string {
+	return c.apiURL + "/" + url.PathEscape(c.baseID) + "/" + url.PathEscape(table)
+}
+
+func (c *Client) do(ctx context.Context, method, target string, body []byte, out interface{}) error {
+	return c.breaker.Execute(func() error {
+		return c.send(ctx, method, target, body, out)
+	})
+}
+
+func (c *Client) send(ctx context.Context, method, target string, body []byte, out interface{}) error {
+	if err := c.throttle(ctx); err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build Airtable request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("airtable request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck // best effort error detail
+		return &StatusError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body) //nolint:errcheck // drain for connection reuse
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode Airtable response: %w", err)
+	}
+	return nil
+}
+
+// throttle spaces requests requestInterval apart
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	wait := time.Until(c.lastRequest.Add(requestInterval))
+	if wait < 0 {
+		wait = 0
+	}
+	c.lastRequest = time.Now().Add(wait)
+	c.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package airtable
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// ClientRequestFields are the Client Requests table fields GetAllClientRequests reads
+var ClientRequestFields = []string{
+	"Mentor", "Email", "Name", "Telegram", "Description", "Level", "Status",
+	"Last Status Change", "Scheduled At", "Decline Reason", "Decline Comment",
+}
+
+// clientRequestStatuses are the request statuses of the client_requests table. Airtable holds
+// the same values, though edits in its UI may change their case.
+var clientRequestStatuses = map[string]bool{
+	"pending":     true,
+	"contacted":   true,
+	"working":     true,
+	"done":        true,
+	"reschedule":  true,
+	"declined":    true,
+	"unavailable": true,
+}
+
+// declineReasons are the decline reasons of the client_requests table
+var declineReasons = map[string]bool{
+	"no_time":        true,
+	"topic_mismatch": true,
+	"helping_others": true,
+	"on_break":       true,
+	"other":          true,
+}
+
+// ClientRequest is a record of the Airtable Client Requests table, mapped to the values of
+// the client_requests table
+type ClientRequest struct {
+	AirtableID string
+	// MentorAirtableID is the Mentors record the request links to, empty when it links to none
+	MentorAirtableID string
+	Email            string
+	Name             string
+	Telegram         string
+	Description      string
+	Level            string
+	// Status is empty when the record's status is not a known request status; RawStatus keeps it
+	Status          string
+	RawStatus       string
+	CreatedAt       time.Time
+	StatusChangedAt *time.Time
+	ScheduledAt     *time.Time
+	DeclineReason   string
+	DeclineComment  string
+}
+
+// GetAllClientRequests lists every record of the Client Requests table, following Airtable's
+// pagination, and maps it with mapAirtableStatus
+func (c *Client) GetAllClientRequests(ctx context.Context, table string) ([]ClientRequest, error) {
+	records, err := c.List(ctx, table, ClientRequestFields)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]ClientRequest, 0, len(records))
+	for _, record := range records {
+		rawStatus := fieldString(record.Fields["Status"])
+		request := ClientRequest{
+			AirtableID:      record.ID,
+			Email:           fieldString(record.Fields["Email"]),
+			Name:            fieldString(record.Fields["Name"]),
+			Telegram:        fieldString(record.Fields["Telegram"]),
+			Description:     fieldString(record.Fields["Description"]),
+			Level:           fieldString(record.Fields["Level"]),
+			Status:          mapAirtableStatus(rawStatus),
+			RawStatus:       rawStatus,
+			StatusChangedAt: fieldTime(record.Fields["Last Status Change"]),
+			ScheduledAt:     fieldTime(record.Fields["Scheduled At"]),
+			DeclineComment:  fieldString(record.Fields["Decline Comment"]),
+		}
+		if links, ok := record.Fields["Mentor"].([]interface{}); ok && len(links) > 0 {
+			request.MentorAirtableID = fieldString(links[0])
+		}
+		if reason := normalize(fieldString(record.Fields["Decline Reason"])); reason != "" {
+			// Reasons typed in Airtable that PostgreSQL doesn't know are kept as other
+			request.DeclineReason = "other"
+			if declineReasons[reason] {
+				request.DeclineReason = reason
+			}
+		}
+		if created := fieldTime(record.CreatedTime); created != nil {
+			request.CreatedAt = *created
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// mapAirtableStatus maps an Airtable request status to its client_requests value. Records
+// without a status were never picked up and are pending; unknown statuses map to "".
+func mapAirtableStatus(status string) string {
+	status = normalize(status)
+	if status == "" {
+		return "pending"
+	}
+	if clientRequestStatuses[status] {
+		return status
+	}
+	return ""
+}
+
+// normalize lowercases a select option and joins its words with underscores
+func normalize(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), "_")
+}
+
+func fieldString(value interface{}) string {
+	s, _ := value.(string) //nolint:errcheck // type assertion, other types are empty
+	return strings.TrimSpace(s)
+}
+
+// fieldTime parses an Airtable date or date-time value, returning nil when it is empty or invalid
+func fieldTime(value interface{}) *time.Time {
+	s := fieldString(value)
+	if s == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
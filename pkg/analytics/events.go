@@ -5,19 +5,42 @@ const (
 	EventMentorRegistrationSubmitted = "mentor_registration_submitted"
 	EventReviewEligibilityChecked    = "review_eligibility_checked"
 	EventReviewSubmitted             = "review_submitted"
+	EventAbuseReportSubmitted        = "abuse_report_submitted"
 
 	EventMentorAuthLoginRequested = "mentor_auth_login_requested"
 	EventMentorAuthLoginVerified  = "mentor_auth_login_verified"
 	EventAdminAuthLoginRequested  = "admin_auth_login_requested"
 	EventAdminAuthLoginVerified   = "admin_auth_login_verified"
+	EventMentorNewDeviceLogin     = "mentor_new_device_login"
+	EventMentorSessionRevoked     = "mentor_session_revoked"
 
-	EventMentorProfileUpdated         = "mentor_profile_updated"
-	EventMentorProfilePictureUploaded = "mentor_profile_picture_uploaded"
-	EventMentorRequestStatusUpdated   = "mentor_request_status_updated"
-	EventMentorRequestDeclined        = "mentor_request_declined"
+	EventMentorProfileUpdated          = "mentor_profile_updated"
+	EventMentorProfilePatched          = "mentor_profile_patched"
+	EventMentorProfilePictureUploaded  = "mentor_profile_picture_uploaded"
+	EventMentorEmailChangeRequested    = "mentor_email_change_requested"
+	EventMentorEmailChangeConfirmed    = "mentor_email_change_confirmed"
+	EventMentorLeaderboardOptInChanged = "mentor_leaderboard_opt_in_changed"
+	EventMentorRequestStatusUpdated    = "mentor_request_status_updated"
+	EventMentorRequestDeclined         = "mentor_request_declined"
+	EventMentorRescheduleProposed      = "mentor_reschedule_proposed"
+	EventMenteeRescheduleConfirmed     = "mentee_reschedule_confirmed"
+	EventMentorReviewReplied           = "mentor_review_replied"
 
 	EventAdminMentorModerationAction = "admin_mentor_moderation_action"
 	EventAdminMentorStatusUpdated    = "admin_mentor_status_updated"
 	EventAdminMentorProfileUpdated   = "admin_mentor_profile_updated"
+	EventAdminMentorProfilePatched   = "admin_mentor_profile_patched"
 	EventAdminMentorPictureUploaded  = "admin_mentor_picture_uploaded"
+	EventAdminMentorDeleted          = "admin_mentor_deleted"
+	EventAdminWebhookTested          = "admin_webhook_tested"
+	EventAdminAbuseReportResolved    = "admin_abuse_report_resolved"
+	EventAdminBlocklistChanged       = "admin_blocklist_changed"
+	EventAdminQuarantineVerdict      = "admin_quarantine_verdict"
+	EventAdminReviewModerated        = "admin_review_moderated"
+	EventAdminMentorsMerged          = "admin_mentors_merged"
+	EventAdminTriggerRedriven        = "admin_trigger_redriven"
+
+	EventProgramSaved                 = "program_saved"
+	EventProgramRegistrationSubmitted = "program_registration_submitted"
+	EventProgramRegistrationCancelled = "program_registration_cancelled"
 )
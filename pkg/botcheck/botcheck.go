@@ -0,0 +1,99 @@
+// Package botcheck rejects obviously automated submissions of public forms before they cost a
+// reCAPTCHA verification: a filled-in honeypot field, or a form sent faster than a person could
+// fill it in according to the signed render timestamp the frontend embeds in it.
+package botcheck
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rejection reasons, used as metric labels
+const (
+	ReasonHoneypot         = "honeypot"
+	ReasonMissingTimestamp = "missing_timestamp"
+	ReasonInvalidTimestamp = "invalid_timestamp"
+	ReasonTooFast          = "too_fast"
+	ReasonExpired          = "expired"
+)
+
+// ErrRejected matches every Rejection
+var ErrRejected = errors.New("submission looks automated")
+
+// Rejection is returned for a submission that looks automated
+type Rejection struct {
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	return ErrRejected.Error() + ": " + r.Reason
+}
+
+// Is makes errors.Is(err, ErrRejected) match
+func (r *Rejection) Is(target error) bool {
+	return target == ErrRejected
+}
+
+// Checker checks the honeypot and render timestamp of form submissions
+type Checker struct {
+	secret      []byte
+	minFillTime time.Duration
+	maxAge      time.Duration
+}
+
+// NewChecker creates a checker. Without a secret only the honeypot is checked; a zero maxAge
+// accepts render timestamps of any age.
+func NewChecker(secret string, minFillTime, maxAge time.Duration) *Checker {
+	return &Checker{
+		secret:      []byte(secret),
+		minFillTime: minFillTime,
+		maxAge:      maxAge,
+	}
+}
+
+// Sign returns the render timestamp of form rendered at renderedAt: the Unix time in
+// milliseconds and its HMAC-SHA256 under the secret, as "<millis>.<hex mac>"
+func (c *Checker) Sign(form string, renderedAt time.Time) string {
+	millis := strconv.FormatInt(renderedAt.UnixMilli(), 10)
+	return millis + "." + c.mac(form, millis)
+}
+
+// Check returns a *Rejection when honeypot is filled in or, with a secret, renderToken isn't a
+// valid render timestamp of form at least the minimum fill time and at most the max age old
+func (c *Checker) Check(form, honeypot, renderToken string, now time.Time) error {
+	if strings.TrimSpace(honeypot) != "" {
+		return &Rejection{Reason: ReasonHoneypot}
+	}
+	if len(c.secret) == 0 {
+		return nil
+	}
+	if renderToken == "" {
+		return &Rejection{Reason: ReasonMissingTimestamp}
+	}
+
+	millis, mac, found := strings.Cut(renderToken, ".")
+	renderedAt, err := strconv.ParseInt(millis, 10, 64)
+	if !found || err != nil || !hmac.Equal([]byte(mac), []byte(c.mac(form, millis))) {
+		return &Rejection{Reason: ReasonInvalidTimestamp}
+	}
+
+	elapsed := now.Sub(time.UnixMilli(renderedAt))
+	if elapsed < c.minFillTime {
+		return &Rejection{Reason: ReasonTooFast}
+	}
+	if c.maxAge > 0 && elapsed > c.maxAge {
+		return &Rejection{Reason: ReasonExpired}
+	}
+	return nil
+}
+
+func (c *Checker) mac(form, millis string) string {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(form + "." + millis))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,188 @@
+// Package cdn purges cached API responses from the CDN in front of the API. Cloudflare
+// and Yandex Cloud CDN are supported.
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+)
+
+const (
+	ProviderNone       = "none"
+	ProviderCloudflare = "cloudflare"
+	ProviderYandex     = "yandex"
+
+	// DefaultCloudflareAPIURL and DefaultYandexAPIURL are the public purge APIs
+	DefaultCloudflareAPIURL = "https://api.cloudflare.com/client/v4"
+	DefaultYandexAPIURL     = "https://cdn.api.cloud.yandex.net/cdn/v1"
+
+	// cloudflareMaxFiles is the most URLs Cloudflare purges in one request
+	cloudflareMaxFiles = 30
+	maxErrorBody       = 1024
+)
+
+// Purger removes URLs from the CDN cache
+type Purger interface {
+	Purge(ctx context.Context, urls []string) error
+}
+
+// StatusError is a purge request the CDN answered with an error status. StatusCode is 0
+// when the CDN reported the failure in a successful response.
+type StatusError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s purge returned status %d: %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// Config selects the CDN provider and its credentials
+type Config struct {
+	Provider string
+	APIURL   string // overrides the provider's default API URL
+
+	CloudflareZoneID   string
+	CloudflareAPIToken string // API token with the Cache Purge permission
+
+	YandexResourceID string
+	YandexAPIKey     string // API key of a service account with the cdn.editor role
+}
+
+// New creates the purger of the configured provider; nil when purging is disabled
+func New(cfg Config, httpClient httpclient.Client) (Purger, error) {
+	switch cfg.Provider {
+	case "", ProviderNone:
+		return nil, nil
+	case ProviderCloudflare:
+		if cfg.CloudflareZoneID == "" || cfg.CloudflareAPIToken == "" {
+			return nil, errors.New("cloudflare zone ID and API token are required")
+		}
+		return &cloudflarePurger{
+			apiURL:     apiURL(cfg.APIURL, DefaultCloudflareAPIURL),
+			zoneID:     cfg.CloudflareZoneID,
+			token:      cfg.CloudflareAPIToken,
+			httpClient: httpClient,
+		}, nil
+	case ProviderYandex:
+		if cfg.YandexResourceID == "" || cfg.YandexAPIKey == "" {
+			return nil, errors.New("yandex CDN resource ID and API key are required")
+		}
+		return &yandexPurger{
+			apiURL:     apiURL(cfg.APIURL, DefaultYandexAPIURL),
+			resourceID: cfg.YandexResourceID,
+			apiKey:     cfg.YandexAPIKey,
+			httpClient: httpClient,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown CDN provider %q", cfg.Provider)
+}
+
+func apiURL(configured, fallback string) string {
+	if configured == "" {
+		return fallback
+	}
+	return strings.TrimRight(configured, "/")
+}
+
+// cloudflarePurger purges single files of a Cloudflare zone
+type cloudflarePurger struct {
+	apiURL     string
+	zoneID     string
+	token      string
+	httpClient httpclient.Client
+}
+
+func (p *cloudflarePurger) Purge(ctx context.Context, urls []string) error {
+	for start := 0; start < len(urls); start += cloudflareMaxFiles {
+		end := start + cloudflareMaxFiles
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		var resp struct {
+			Success bool `json:"success"`
+			Errors  []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		target := p.apiURL + "/zones/" + url.PathEscape(p.zoneID) + "/purge_cache"
+		err := post(ctx, p.httpClient, ProviderCloudflare, target, "Bearer "+p.token, map[string]interface{}{"files": urls[start:end]}, &resp)
+		if err != nil {
+			return err
+		}
+		if !resp.Success {
+			messages := make([]string, 0, len(resp.Errors))
+			for _, e := range resp.Errors {
+				messages = append(messages, e.Message)
+			}
+			return &StatusError{Provider: ProviderCloudflare, Message: strings.Join(messages, "; ")}
+		}
+	}
+	return nil
+}
+
+// yandexPurger purges paths of a Yandex Cloud CDN resource
+type yandexPurger struct {
+	apiURL     string
+	resourceID string
+	apiKey     string
+	httpClient httpclient.Client
+}
+
+func (p *yandexPurger) Purge(ctx context.Context, urls []string) error {
+	// Yandex purges paths of the resource rather than full URLs
+	paths := make([]string, 0, len(urls))
+	for _, raw := range urls {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid URL to purge %q: %w", raw, err)
+		}
+		paths = append(paths, u.RequestURI())
+	}
+
+	target := p.apiURL + "/cache/" + url.PathEscape(p.resourceID) + ":purge"
+	return post(ctx, p.httpClient, ProviderYandex, target, "Api-Key "+p.apiKey, map[string]interface{}{"paths": paths}, nil)
+}
+
+func post(ctx context.Context, client httpclient.Client, provider, target, authorization string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s purge request: %w", provider, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build %s purge request: %w", provider, err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s purge request failed: %w", provider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck // best effort error detail
+		return &StatusError{Provider: provider, StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body) //nolint:errcheck // drain for connection reuse
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s purge response: %w", provider, err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package cdn
+
+import (
+	"strings"
+)
+
+// RouteURLs expands Gin route templates into the URLs to purge under baseURL. Parameters
+// (":id") are filled from params; routes with a parameter missing from params are skipped.
+func RouteURLs(baseURL string, routes []string, params map[string]string) []string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	urls := make([]string, 0, len(routes))
+	for _, route := range routes {
+		segments := strings.Split(route, "/")
+		complete := true
+		for i, segment := range segments {
+			if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
+				continue
+			}
+			value, ok := params[segment[1:]]
+			if !ok {
+				complete = false
+				break
+			}
+			segments[i] = value
+		}
+		if complete {
+			urls = append(urls, baseURL+strings.Join(segments, "/"))
+		}
+	}
+	return urls
+}
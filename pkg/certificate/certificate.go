@@ -0,0 +1,72 @@
+// Package certificate signs and renders cohort completion certificates
+package certificate
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Certificate is what a completion certificate states
+type Certificate struct {
+	ID                   string
+	RecipientName        string
+	CohortTitle          string
+	CompletedCheckpoints int
+	IssuedAt             time.Time
+	VerifyURL            string // printed on the certificate; not signed
+}
+
+// NewID returns a random UUID (version 4). Certificates need their ID before they are
+// stored because it is signed and printed.
+func NewID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// Sign returns the hex HMAC-SHA256 of the certificate's statements
+func Sign(secret []byte, c Certificate) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(canonical(c)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature was produced by Sign for the same certificate and secret
+func Verify(secret []byte, c Certificate, signature string) bool {
+	expected := Sign(secret, c)
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// Code is the short form of a signature printed on the certificate
+func Code(signature string) string {
+	if len(signature) < 16 {
+		return strings.ToUpper(signature)
+	}
+	s := strings.ToUpper(signature[:16])
+	return s[:4] + "-" + s[4:8] + "-" + s[8:12] + "-" + s[12:]
+}
+
+// canonical joins the signed fields with a separator that can't appear in them unescaped
+func canonical(c Certificate) string {
+	fields := []string{
+		c.ID,
+		c.RecipientName,
+		c.CohortTitle,
+		strconv.Itoa(c.CompletedCheckpoints),
+		c.IssuedAt.UTC().Format(time.RFC3339),
+	}
+	for i, field := range fields {
+		fields[i] = strings.ReplaceAll(strings.ReplaceAll(field, `\`, `\\`), "\n", `\n`)
+	}
+	return strings.Join(fields, "\n")
+}
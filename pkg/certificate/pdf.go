@@ -0,0 +1,93 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+)
+
+// A4 landscape in PDF points
+const (
+	pageWidthPt  = 842
+	pageHeightPt = 595
+)
+
+// imagePDF wraps an image into a single-page PDF that it fills entirely. The image is
+// stored as a Flate-compressed RGB XObject, so no fonts have to be embedded.
+func imagePDF(img *image.RGBA, title string) ([]byte, error) {
+	bounds := img.Bounds()
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := img.Pix[img.PixOffset(bounds.Min.X, y):img.PixOffset(bounds.Max.X, y)]
+		for i := 0; i < len(row); i += 4 {
+			rgb = append(rgb, row[i], row[i+1], row[i+2])
+		}
+	}
+
+	var pixels bytes.Buffer
+	zw, err := zlib.NewWriterLevel(&pixels, zlib.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compressor: %w", err)
+	}
+	if _, err := zw.Write(rgb); err != nil {
+		return nil, fmt.Errorf("failed to compress certificate image: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress certificate image: %w", err)
+	}
+
+	content := fmt.Sprintf("q %d 0 0 %d 0 0 cm /Im1 Do Q", pageWidthPt, pageHeightPt)
+	objects := [][]byte{
+		[]byte("<< /Type /Catalog /Pages 2 0 R >>"),
+		[]byte("<< /Type /Pages /Kids [3 0 R] /Count 1 >>"),
+		[]byte(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /XObject << /Im1 5 0 R >> >> /Contents 4 0 R >>", pageWidthPt, pageHeightPt)),
+		stream("", []byte(content)),
+		stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB "+
+			"/BitsPerComponent 8 /Filter /FlateDecode ", bounds.Dx(), bounds.Dy()), pixels.Bytes()),
+		[]byte(fmt.Sprintf("<< /Title %s /Producer (getmentor-api) >>", pdfString(title))),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n", i+1)
+		out.Write(object)
+		out.WriteString("\nendobj\n")
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, len(objects), xref)
+	return out.Bytes(), nil
+}
+
+func stream(dict string, data []byte) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<< %s/Length %d >>\nstream\n", dict, len(data))
+	b.Write(data)
+	b.WriteString("\nendstream")
+	return b.Bytes()
+}
+
+// pdfString encodes text as a UTF-16BE string with BOM, which PDF readers accept for
+// document metadata in any script
+func pdfString(text string) string {
+	var b bytes.Buffer
+	b.WriteString("<FEFF")
+	for _, r := range text {
+		if r > 0xffff {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteString(">")
+	return b.String()
+}
@@ -0,0 +1,183 @@
+package certificate
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+	"sync"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// The page is rendered at twice the PDF point size, about 144 DPI
+const (
+	width  = pageWidthPt * 2
+	height = pageHeightPt * 2
+	margin = 64
+	frame  = 12
+)
+
+var (
+	colorBackground = color.RGBA{R: 0xfa, G: 0xf8, B: 0xf5, A: 0xff}
+	colorAccent     = color.RGBA{R: 0xff, G: 0x6b, B: 0x35, A: 0xff}
+	colorText       = color.RGBA{R: 0x1f, G: 0x23, B: 0x28, A: 0xff}
+	colorMuted      = color.RGBA{R: 0x5f, G: 0x66, B: 0x6d, A: 0xff}
+)
+
+type faces struct {
+	heading, name, title, body, small font.Face
+}
+
+var (
+	loadFacesOnce sync.Once
+	loadedFaces   *faces
+	loadFacesErr  error
+)
+
+// loadFaces parses the embedded Go fonts once. They cover Latin and Cyrillic.
+func loadFaces() (*faces, error) {
+	loadFacesOnce.Do(func() {
+		bold, err := opentype.Parse(gobold.TTF)
+		if err != nil {
+			loadFacesErr = fmt.Errorf("failed to parse bold font: %w", err)
+			return
+		}
+		regular, err := opentype.Parse(goregular.TTF)
+		if err != nil {
+			loadFacesErr = fmt.Errorf("failed to parse regular font: %w", err)
+			return
+		}
+
+		face := func(f *opentype.Font, size float64) font.Face {
+			if loadFacesErr != nil {
+				return nil
+			}
+			var ff font.Face
+			ff, loadFacesErr = opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+			return ff
+		}
+		loadedFaces = &faces{
+			heading: face(bold, 72),
+			name:    face(bold, 88),
+			title:   face(bold, 52),
+			body:    face(regular, 36),
+			small:   face(regular, 24),
+		}
+	})
+	return loadedFaces, loadFacesErr
+}
+
+// RenderPDF draws the certificate as a one-page A4 landscape PDF. signature is
+// printed in its short form next to the verification link.
+func RenderPDF(c Certificate, signature string) ([]byte, error) {
+	img, err := render(c, signature)
+	if err != nil {
+		return nil, err
+	}
+	return imagePDF(img, "Сертификат — "+c.CohortTitle)
+}
+
+func render(c Certificate, signature string) (*image.RGBA, error) {
+	f, err := loadFaces()
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	fill(img, img.Bounds(), colorAccent)
+	fill(img, img.Bounds().Inset(frame), colorBackground)
+
+	y := 260
+	drawCentered(img, "СЕРТИФИКАТ", f.heading, y, colorAccent)
+	y += 90
+	drawCentered(img, "подтверждает, что", f.body, y, colorMuted)
+	y += 130
+	drawCentered(img, ellipsize(c.RecipientName, f.name, width-4*margin), f.name, y, colorText)
+	y += 100
+	drawCentered(img, "успешно завершил(а) программу", f.body, y, colorMuted)
+	for _, line := range wrap(c.CohortTitle, f.title, width-4*margin, 2) {
+		y += 80
+		drawCentered(img, line, f.title, y, colorText)
+	}
+	if c.CompletedCheckpoints > 0 {
+		y += 80
+		drawCentered(img, fmt.Sprintf("Пройдено этапов: %d", c.CompletedCheckpoints), f.body, y, colorMuted)
+	}
+
+	bottom := height - margin - frame
+	drawText(img, "Дата выдачи: "+c.IssuedAt.UTC().Format("02.01.2006"), f.small, 2*margin, bottom-40, colorMuted)
+	drawText(img, "Код проверки: "+Code(signature), f.small, 2*margin, bottom, colorMuted)
+	if c.VerifyURL != "" {
+		drawRight(img, "Проверить подлинность:", f.small, bottom-40, colorMuted)
+		drawRight(img, ellipsize(c.VerifyURL, f.small, width-4*margin-520), f.small, bottom, colorMuted)
+	}
+
+	return img, nil
+}
+
+func drawCentered(dst *image.RGBA, text string, face font.Face, baseline int, c color.Color) {
+	drawText(dst, text, face, (width-font.MeasureString(face, text).Ceil())/2, baseline, c)
+}
+
+func drawRight(dst *image.RGBA, text string, face font.Face, baseline int, c color.Color) {
+	drawText(dst, text, face, width-2*margin-font.MeasureString(face, text).Ceil(), baseline, c)
+}
+
+func drawText(dst *image.RGBA, text string, face font.Face, x, baseline int, c color.Color) {
+	d := font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, baseline),
+	}
+	d.DrawString(text)
+}
+
+func fill(dst *image.RGBA, rect image.Rectangle, c color.Color) {
+	xdraw.Draw(dst, rect, image.NewUniform(c), image.Point{}, xdraw.Src)
+}
+
+// wrap splits text into at most maxLines lines of at most width pixels, ending with an
+// ellipsis when it doesn't fit
+func wrap(text string, face font.Face, width, maxLines int) []string {
+	words := strings.Fields(text)
+	var lines []string
+	var line string
+	for i, word := range words {
+		candidate := strings.TrimSpace(line + " " + word)
+		if font.MeasureString(face, candidate).Ceil() <= width || line == "" {
+			line = candidate
+			continue
+		}
+		if len(lines) == maxLines-1 {
+			return append(lines, ellipsize(strings.Join(append([]string{line}, words[i:]...), " "), face, width))
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, ellipsize(line, face, width))
+	}
+	return lines
+}
+
+func ellipsize(text string, face font.Face, width int) string {
+	if font.MeasureString(face, text).Ceil() <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "…"
+		if font.MeasureString(face, candidate).Ceil() <= width {
+			return candidate
+		}
+	}
+	return "…"
+}
@@ -0,0 +1,213 @@
+// Package circuitbreaker stops calling a failing dependency for a while, so requests fail fast
+// instead of waiting on timeouts, and probes it again before letting traffic through.
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// ErrOpen is returned without calling the dependency while its breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a breaker
+type State int
+
+const (
+	// StateClosed lets every call through
+	StateClosed State = iota
+	// StateHalfOpen lets one probe call through after the open timeout
+	StateHalfOpen
+	// StateOpen fails every call with ErrOpen
+	StateOpen
+)
+
+var states = []State{StateClosed, StateHalfOpen, StateOpen}
+
+func (s State) String() string {
+	switch s {
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// Settings configures a breaker
+type Settings struct {
+	// Dependency names the protected dependency in logs and metric labels
+	Dependency string
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before a probe call is let through
+	OpenTimeout time.Duration
+	// IsFailure reports whether an error counts against the dependency; nil counts every
+	// error except a cancelled context
+	IsFailure func(error) bool
+	// OnStateChange is called after every transition, in addition to ObserveStateChange
+	OnStateChange func(dependency string, from, to State)
+}
+
+// Breaker guards the calls to one dependency. It is safe for concurrent use.
+type Breaker struct {
+	settings Settings
+	now      func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// New creates a closed breaker
+func New(settings Settings) *Breaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = 1
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = func(err error) bool { return !errors.Is(err, context.Canceled) }
+	}
+	b := &Breaker{settings: settings, now: time.Now}
+	setStateGauge(settings.Dependency, StateClosed)
+	return b
+}
+
+// NewWithClock creates a breaker reading the time from now, for tests
+func NewWithClock(settings Settings, now func() time.Time) *Breaker {
+	b := New(settings)
+	b.now = now
+	return b
+}
+
+// Dependency returns the name of the protected dependency
+func (b *Breaker) Dependency() string {
+	return b.settings.Dependency
+}
+
+// State returns the breaker's current state; an open breaker past its timeout reports half-open
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == StateOpen && !b.now().Before(b.openedAt.Add(b.settings.OpenTimeout)) {
+		return StateHalfOpen
+	}
+	return b.state
+}
+
+// Execute calls fn unless the breaker is open, and records its outcome
+func (b *Breaker) Execute(fn func() error) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := fn()
+	b.record(err)
+	return err
+}
+
+// allow lets a call through, moving an open breaker past its timeout to half-open. While
+// half-open only the probe call passes.
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen {
+		if b.now().Before(b.openedAt.Add(b.settings.OpenTimeout)) {
+			return ErrOpen
+		}
+		b.transition(StateHalfOpen)
+	}
+	if b.state == StateHalfOpen {
+		if b.probing {
+			return ErrOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failed := err != nil && b.settings.IsFailure(err)
+	if b.state == StateHalfOpen {
+		b.probing = false
+		if failed {
+			b.open()
+		} else if err == nil {
+			b.failures = 0
+			b.transition(StateClosed)
+		}
+		return
+	}
+
+	if !failed {
+		if err == nil {
+			b.failures = 0
+		}
+		return
+	}
+	b.failures++
+	if b.state == StateClosed && b.failures >= b.settings.FailureThreshold {
+		b.open()
+	}
+}
+
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.transition(StateOpen)
+}
+
+// transition moves to state and runs the hooks; the caller holds the lock
+func (b *Breaker) transition(to State) {
+	from := b.state
+	if from == to {
+		return
+	}
+	b.state = to
+	ObserveStateChange(b.settings.Dependency, from, to)
+	if b.settings.OnStateChange != nil {
+		b.settings.OnStateChange(b.settings.Dependency, from, to)
+	}
+}
+
+// ObserveStateChange is the state-change hook every breaker runs: it logs the transition,
+// moves getmentor_circuit_breaker_state to the new state and counts trips
+func ObserveStateChange(dependency string, from, to State) {
+	setStateGauge(dependency, to)
+	if to == StateOpen {
+		metrics.CircuitBreakerTrips.WithLabelValues(dependency).Inc()
+		logger.Warn("Circuit breaker opened",
+			zap.String("dependency", dependency),
+			zap.String("from", from.String()))
+		return
+	}
+	logger.Info("Circuit breaker state changed",
+		zap.String("dependency", dependency),
+		zap.String("from", from.String()),
+		zap.String("to", to.String()))
+}
+
+// ObserveFallback counts a call served by a fallback because the dependency's breaker was open
+func ObserveFallback(dependency string) {
+	metrics.CircuitBreakerFallbacks.WithLabelValues(dependency).Inc()
+}
+
+func setStateGauge(dependency string, current State) {
+	for _, state := range states {
+		value := 0.0
+		if state == current {
+			value = 1
+		}
+		metrics.CircuitBreakerState.WithLabelValues(dependency, state.String()).Set(value)
+	}
+}
@@ -1,33 +1,109 @@
 package db
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 
+	"github.com/getmentor/getmentor-api/migrations"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file" // Register file source driver
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
 )
 
-// RunMigrations executes database migrations from the specified path
-// Parameters:
-//   - databaseURL: PostgreSQL connection string
-//   - migrationsPath: Path to migration files (e.g., "file://./migrations")
-//
+// RunMigrations applies all pending migrations embedded in the binary.
 // Returns error if migrations fail, ignores ErrNoChange (already up to date)
-func RunMigrations(databaseURL, migrationsPath string) error {
+func RunMigrations(databaseURL string) error {
+	return withMigrate(databaseURL, func(m *migrate.Migrate) error {
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+		return nil
+	})
+}
+
+// RollbackMigrations runs the down migrations of the last steps applied versions
+func RollbackMigrations(databaseURL string, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("rollback steps must be positive, got %d", steps)
+	}
+	return withMigrate(databaseURL, func(m *migrate.Migrate) error {
+		if err := m.Steps(-steps); err != nil {
+			return fmt.Errorf("failed to roll back migrations: %w", err)
+		}
+		return nil
+	})
+}
+
+// MigrateTo moves the schema up or down to the given version
+func MigrateTo(databaseURL string, version uint) error {
+	return withMigrate(databaseURL, func(m *migrate.Migrate) error {
+		if err := m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("failed to migrate to version %d: %w", version, err)
+		}
+		return nil
+	})
+}
+
+// MigrationVersion returns the applied schema version; dirty means a migration failed halfway
+// and the schema needs a manual fix. Version 0 means no migration was applied yet.
+func MigrationVersion(databaseURL string) (version uint, dirty bool, err error) {
+	err = withMigrate(databaseURL, func(m *migrate.Migrate) error {
+		version, dirty, err = m.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read schema version: %w", err)
+		}
+		return nil
+	})
+	return version, dirty, err
+}
+
+// withMigrate opens a migrate instance over the embedded migrations for fn.
+// golang-migrate holds a PostgreSQL advisory lock while migrating, so instances
+// starting at the same time apply each migration once.
+func withMigrate(databaseURL string, fn func(m *migrate.Migrate) error) error {
+	db, err := openMigrationDB(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// Create postgres driver instance for migrations
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to create migration driver: %w", err)
+	}
+
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	if err != nil {
+		return fmt.Errorf("failed to create migrate instance: %w", err)
+	}
+
+	return fn(m)
+}
+
+// openMigrationDB opens a database/sql connection for golang-migrate
+func openMigrationDB(databaseURL string) (*sql.DB, error) {
 	// Parse connection config from URL
 	connConfig, err := pgx.ParseConfig(databaseURL)
 	if err != nil {
-		return fmt.Errorf("failed to parse database URL: %w", err)
+		return nil, fmt.Errorf("failed to parse database URL: %w", err)
 	}
 
 	// Configure TLS using the same CA cert as the main connection pool
 	tlsConfig, err := configureTLS(databaseURL)
 	if err != nil {
-		return fmt.Errorf("failed to configure TLS: %w", err)
+		return nil, fmt.Errorf("failed to configure TLS: %w", err)
 	}
 	if tlsConfig != nil {
 		connConfig.TLSConfig = tlsConfig
@@ -35,33 +111,11 @@ func RunMigrations(databaseURL, migrationsPath string) error {
 
 	// Open database connection via pgx stdlib adapter
 	db := stdlib.OpenDB(*connConfig)
-	defer db.Close()
 
 	// Ping database to verify connection
 	if pingErr := db.Ping(); pingErr != nil {
-		return fmt.Errorf("failed to ping database: %w", pingErr)
+		db.Close() //nolint:errcheck,gosec // Closing after a failed ping
+		return nil, fmt.Errorf("failed to ping database: %w", pingErr)
 	}
-
-	// Create postgres driver instance for migrations
-	driver, err := postgres.WithInstance(db, &postgres.Config{})
-	if err != nil {
-		return fmt.Errorf("failed to create migration driver: %w", err)
-	}
-
-	// Create migrate instance with file source and postgres driver
-	m, err := migrate.NewWithDatabaseInstance(
-		migrationsPath,
-		"postgres",
-		driver,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create migrate instance: %w", err)
-	}
-
-	// Run all pending migrations
-	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return fmt.Errorf("failed to run migrations: %w", err)
-	}
-
-	return nil
+	return db, nil
 }
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	namedQueriesMu sync.RWMutex
+	// namedQueryNames maps the SQL of the hot-path queries to their names
+	namedQueryNames = map[string]string{}
+	// namedQuerySQL maps the names back to the SQL, to refuse a name registered twice
+	namedQuerySQL = map[string]string{}
+)
+
+// NamedQuery registers a hot query under name and returns the SQL to run: pass it to Query,
+// QueryRow or Exec. The query is not prepared by name; like any other query it is prepared on
+// first use on each connection by pgx's statement cache, so it works on any pool, including one
+// made with pgxpool.New. The name only labels its timings (db_client_query_duration_seconds).
+func NamedQuery(name, sql string) string {
+	namedQueriesMu.Lock()
+	defer namedQueriesMu.Unlock()
+	if existing, ok := namedQuerySQL[name]; ok && existing != sql {
+		panic(fmt.Sprintf("named query %q registered twice with different SQL", name))
+	}
+	if existing, ok := namedQueryNames[sql]; ok && existing != name {
+		panic(fmt.Sprintf("query SQL registered as both %q and %q", existing, name))
+	}
+	namedQuerySQL[name] = sql
+	namedQueryNames[sql] = name
+	return sql
+}
+
+// namedQueryName returns the name a hot query's SQL was registered under
+func namedQueryName(sql string) (string, bool) {
+	namedQueriesMu.RLock()
+	defer namedQueriesMu.RUnlock()
+	name, ok := namedQueryNames[sql]
+	return name, ok
+}
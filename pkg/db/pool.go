@@ -75,6 +75,7 @@ func containsSSLMode(url string) bool {
 //   - HealthCheckPeriod: 30s (how often to check connection health)
 //   - MaxConnLifetime: 1h (maximum lifetime of a connection)
 //   - MaxConnIdleTime: 30m (maximum idle time before closing)
+//   - Tracer: records db_client_operation_* metrics for every query
 //
 // TLS configuration:
 //   - Automatically enabled if DATABASE_URL contains sslmode=verify-full or sslmode=require
@@ -82,6 +83,23 @@ func containsSSLMode(url string) bool {
 //   - DATABASE_TLS_SERVER_NAME is optional (only needed if cert name differs from hostname)
 //   - Local development (localhost without sslmode) connects without TLS
 func NewPool(ctx context.Context, dbCfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	pool, err := OpenPool(ctx, dbCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify connection by pinging database
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return pool, nil
+}
+
+// OpenPool creates the pool like NewPool without checking the database answers; connections
+// are opened on first use. It lets the API start from a mentor cache snapshot during an outage.
+func OpenPool(ctx context.Context, dbCfg config.DatabaseConfig) (*pgxpool.Pool, error) {
 	// Parse connection string and configure pool
 	poolConfig, err := pgxpool.ParseConfig(dbCfg.URL)
 	if err != nil {
@@ -104,18 +122,14 @@ func NewPool(ctx context.Context, dbCfg config.DatabaseConfig) (*pgxpool.Pool, e
 	poolConfig.MaxConnLifetime = 1 * time.Hour
 	poolConfig.MaxConnIdleTime = 30 * time.Minute
 
+	// Record per-query duration metrics with trace exemplars
+	poolConfig.ConnConfig.Tracer = queryMetricsTracer{}
+
 	// Create pool with config
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection pool: %w", err)
 	}
-
-	// Verify connection by pinging database
-	if err := pool.Ping(ctx); err != nil {
-		pool.Close()
-		return nil, fmt.Errorf("failed to ping database: %w", err)
-	}
-
 	return pool, nil
 }
 
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RecordPoolMetrics publishes connection pool statistics every 15 seconds:
+// acquired and idle connections, and how often and how long callers waited for a free one.
+func RecordPoolMetrics(pool *pgxpool.Pool) {
+	if metrics.DBPoolConnections == nil {
+		return
+	}
+	ticker := time.NewTicker(15 * time.Second)
+	go func() {
+		var lastWaits int64
+		var lastWait time.Duration
+		for range ticker.C {
+			stat := pool.Stat()
+			metrics.DBPoolConnections.WithLabelValues("acquired").Set(float64(stat.AcquiredConns()))
+			metrics.DBPoolConnections.WithLabelValues("idle").Set(float64(stat.IdleConns()))
+			metrics.DBPoolConnections.WithLabelValues("constructing").Set(float64(stat.ConstructingConns()))
+			metrics.DBPoolConnections.WithLabelValues("max").Set(float64(stat.MaxConns()))
+
+			// Stat reports running totals; the counters advance by the change since the last tick
+			waits, wait := stat.EmptyAcquireCount(), stat.EmptyAcquireWaitTime()
+			metrics.DBPoolEmptyAcquires.Add(float64(waits - lastWaits))
+			metrics.DBPoolAcquireWaitSeconds.Add((wait - lastWait).Seconds())
+			lastWaits, lastWait = waits, wait
+		}
+	}()
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/jackc/pgx/v5"
+)
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	at        time.Time
+	operation string
+	// query is the name of a query registered with NamedQuery, empty for other SQL
+	query string
+}
+
+// queryMetricsTracer records db_client_operation_* metrics for every query run through
+// the pool, and db_client_query_duration_seconds for the queries registered with NamedQuery.
+// The duration histograms carry the caller's trace ID as an exemplar.
+type queryMetricsTracer struct{}
+
+func (queryMetricsTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	start := queryStart{at: time.Now(), operation: queryOperation(data.SQL)}
+	if name, ok := namedQueryName(data.SQL); ok {
+		start.query = name
+	}
+	return context.WithValue(ctx, queryStartKey{}, start)
+}
+
+func (queryMetricsTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	// Metrics are only registered by the API server; CLI tools share the pool without them
+	if metrics.DBRequestDuration == nil {
+		return
+	}
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+
+	status := "success"
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		status = "error"
+	}
+
+	duration := metrics.MeasureDuration(start.at)
+	metrics.ObserveWithTrace(ctx, metrics.DBRequestDuration.WithLabelValues(start.operation, status), duration)
+	metrics.DBRequestTotal.WithLabelValues(start.operation, status).Inc()
+	if start.query != "" {
+		metrics.ObserveWithTrace(ctx, metrics.DBQueryDuration.WithLabelValues(start.query, status), duration)
+	}
+}
+
+// queryOperation derives a low-cardinality operation label from the leading SQL
+// keyword. CTEs and anything else are reported as "other".
+func queryOperation(sql string) string {
+	verb := strings.TrimLeftFunc(sql, unicode.IsSpace)
+	if end := strings.IndexFunc(verb, unicode.IsSpace); end >= 0 {
+		verb = verb[:end]
+	}
+	switch verb = strings.ToLower(verb); verb {
+	case "select", "insert", "update", "delete":
+		return verb
+	default:
+		return "other"
+	}
+}
@@ -23,6 +23,9 @@ var (
 	// ErrConflict indicates a conflict with existing data
 	ErrConflict = errors.New("conflict")
 
+	// ErrPreconditionRequired indicates a conditional write was sent without the version it expects
+	ErrPreconditionRequired = errors.New("precondition required")
+
 	// ErrInternal indicates an internal server error
 	ErrInternal = errors.New("internal error")
 )
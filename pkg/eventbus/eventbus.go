@@ -0,0 +1,223 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	ProviderNone = "none"
+	ProviderLog  = "log"
+	ProviderNATS = "nats"
+
+	DefaultSubjectPrefix = "getmentor"
+	defaultSource        = "getmentor-api"
+	defaultQueueSize     = 512
+	defaultSendTimeout   = 5 * time.Second
+)
+
+// Event is the envelope of every domain event put on the bus. Field names follow
+// the snake_case used by the HTTP trigger payloads.
+type Event struct {
+	ID            string      `json:"id"`
+	Type          string      `json:"type"`
+	SchemaVersion int         `json:"schema_version"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	Source        string      `json:"source"`
+	Replayed      bool        `json:"replayed,omitempty"` // set by backfills re-sending past events
+	Data          interface{} `json:"data"`
+}
+
+// NewEvent wraps data in an envelope with a fresh ID, occurring now
+func NewEvent(eventType string, schemaVersion int, data interface{}) (Event, error) {
+	id, err := newEventID()
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to generate event ID: %w", err)
+	}
+	return Event{
+		ID:            id,
+		Type:          eventType,
+		SchemaVersion: schemaVersion,
+		OccurredAt:    time.Now().UTC(),
+		Source:        defaultSource,
+		Data:          data,
+	}, nil
+}
+
+// Publisher emits domain events. Publishing never blocks the caller and never fails it:
+// delivery problems are logged and counted.
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// NoopPublisher drops every event; used when no bus is configured
+type NoopPublisher struct{}
+
+func (NoopPublisher) Publish(context.Context, Event) {}
+
+// Sink delivers an encoded event to a subject (topic) of a message broker
+type Sink interface {
+	Send(ctx context.Context, subject string, payload []byte) error
+}
+
+// Config selects the bus provider
+type Config struct {
+	Provider      string
+	NATSURL       string
+	SubjectPrefix string
+	QueueSize     int
+}
+
+// Bus queues events and sends them to its sink from a single worker, keeping their order
+type Bus struct {
+	sink          Sink
+	provider      string
+	subjectPrefix string
+	queue         chan Event
+}
+
+// New creates the publisher for cfg. An empty or "none" provider returns NoopPublisher.
+func New(cfg Config) (Publisher, error) {
+	bus, err := NewConfiguredBus(cfg)
+	if err != nil || bus == nil {
+		return NoopPublisher{}, err
+	}
+	return bus, nil
+}
+
+// NewConfiguredBus creates the bus for cfg, or returns nil when no provider is configured
+func NewConfiguredBus(cfg Config) (*Bus, error) {
+	provider := strings.ToLower(strings.TrimSpace(cfg.Provider))
+
+	var sink Sink
+	switch provider {
+	case "", ProviderNone:
+		return nil, nil
+	case ProviderLog:
+		sink = LogSink{}
+	case ProviderNATS:
+		natsSink, err := NewNATSSink(cfg.NATSURL, defaultSource)
+		if err != nil {
+			return nil, err
+		}
+		sink = natsSink
+	default:
+		return nil, fmt.Errorf("unsupported event bus provider %q", cfg.Provider)
+	}
+
+	return NewBus(provider, sink, cfg.SubjectPrefix, cfg.QueueSize), nil
+}
+
+// NewBus creates a bus over an arbitrary sink and starts its worker
+func NewBus(provider string, sink Sink, subjectPrefix string, queueSize int) *Bus {
+	if subjectPrefix = strings.Trim(strings.TrimSpace(subjectPrefix), "."); subjectPrefix == "" {
+		subjectPrefix = DefaultSubjectPrefix
+	}
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+
+	bus := &Bus{
+		sink:          sink,
+		provider:      provider,
+		subjectPrefix: subjectPrefix,
+		queue:         make(chan Event, queueSize),
+	}
+	go bus.runWorker()
+	return bus
+}
+
+// Publish queues the event for the worker
+func (b *Bus) Publish(_ context.Context, event Event) {
+	select {
+	case b.queue <- event:
+	default:
+		recordPublish(event.Type, "dropped")
+		logger.Warn("event bus queue is full; dropping event",
+			zap.String("provider", b.provider),
+			zap.String("event_type", event.Type),
+			zap.Int("queue_capacity", cap(b.queue)))
+	}
+}
+
+// Send delivers the event right away, bypassing the queue. Used by backfills,
+// which need to know whether each event made it.
+func (b *Bus) Send(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		recordPublish(event.Type, "error")
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if err := b.sink.Send(ctx, b.Subject(event.Type), payload); err != nil {
+		recordPublish(event.Type, "error")
+		return err
+	}
+	recordPublish(event.Type, "success")
+	return nil
+}
+
+// Ping checks the broker connection when the sink supports it (NATS); other sinks
+// are always ready
+func (b *Bus) Ping(ctx context.Context) error {
+	if pinger, ok := b.sink.(interface{ Ping(context.Context) error }); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// Subject returns the broker subject of an event type, e.g. getmentor.mentor.updated
+func (b *Bus) Subject(eventType string) string {
+	return b.subjectPrefix + "." + eventType
+}
+
+func (b *Bus) runWorker() {
+	for event := range b.queue {
+		b.send(event)
+	}
+}
+
+func (b *Bus) send(event Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultSendTimeout)
+	defer cancel()
+	if err := b.Send(ctx, event); err != nil {
+		logger.Warn("Failed to publish event",
+			zap.String("provider", b.provider),
+			zap.String("event_type", event.Type),
+			zap.String("event_id", event.ID),
+			zap.Error(err))
+	}
+}
+
+// LogSink writes events to the application log; useful in development
+type LogSink struct{}
+
+func (LogSink) Send(_ context.Context, subject string, payload []byte) error {
+	logger.Info("Event published", zap.String("subject", subject), zap.ByteString("payload", payload))
+	return nil
+}
+
+func recordPublish(eventType, outcome string) {
+	if metrics.EventBusPublished == nil {
+		return
+	}
+	metrics.EventBusPublished.WithLabelValues(eventType, outcome).Inc()
+}
+
+// newEventID returns a random UUID (version 4)
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,122 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	natsDialTimeout   = 5 * time.Second
+	natsReconnectWait = 2 * time.Second
+)
+
+// NATSSink publishes to a NATS server with the nats.go client (core PUB, without JetStream).
+// TLS is used for tls:// URLs and whenever the server requires it. The connection is opened
+// lazily; once open, the client reconnects on its own and buffers messages published meanwhile.
+type NATSSink struct {
+	url  string
+	name string
+
+	mu   sync.Mutex
+	conn *nats.Conn
+}
+
+// NewNATSSink parses a nats:// or tls:// URL, [user:pass@|token@]host[:port]; several servers
+// may be listed separated by commas
+func NewNATSSink(rawURL, clientName string) (*NATSSink, error) {
+	if strings.TrimSpace(rawURL) == "" {
+		return nil, errors.New("NATS URL is required for the nats event bus provider")
+	}
+
+	for _, server := range strings.Split(rawURL, ",") {
+		u, err := url.Parse(strings.TrimSpace(server))
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS URL: %w", err)
+		}
+		if u.Scheme != "nats" && u.Scheme != "tls" {
+			return nil, fmt.Errorf("unsupported NATS URL scheme %q (nats:// or tls://)", u.Scheme)
+		}
+		if u.Hostname() == "" {
+			return nil, errors.New("NATS URL must include a host")
+		}
+	}
+
+	return &NATSSink{url: rawURL, name: clientName}, nil
+}
+
+// Send publishes payload to subject. While the client is reconnecting the message is buffered
+// and sent once the connection is back.
+func (s *NATSSink) Send(_ context.Context, subject string, payload []byte) error {
+	conn, err := s.connection()
+	if err != nil {
+		return err
+	}
+	if err := conn.Publish(subject, payload); err != nil {
+		return fmt.Errorf("NATS publish failed: %w", err)
+	}
+	return nil
+}
+
+// Ping connects to the server if not connected yet and checks it answers a PING
+func (s *NATSSink) Ping(ctx context.Context) error {
+	conn, err := s.connection()
+	if err != nil {
+		return err
+	}
+	if err := conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("NATS ping failed: %w", err)
+	}
+	return nil
+}
+
+// Close flushes the messages still buffered and closes the connection to the server
+func (s *NATSSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// connection returns the open connection, connecting first when there is none. A client that
+// gave up reconnecting is closed and replaced.
+func (s *NATSSink) connection() (*nats.Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil && !s.conn.IsClosed() {
+		return s.conn, nil
+	}
+	conn, err := nats.Connect(s.url,
+		nats.Name(s.name),
+		nats.Timeout(natsDialTimeout),
+		nats.ReconnectWait(natsReconnectWait),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				logger.Warn("NATS connection lost, reconnecting", zap.Error(err))
+			}
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			logger.Info("NATS connection restored", zap.String("server", conn.ConnectedAddr()))
+		}),
+		nats.ErrorHandler(func(_ *nats.Conn, _ *nats.Subscription, err error) {
+			logger.Warn("NATS server reported an error", zap.Error(err))
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("NATS connect failed: %w", err)
+	}
+	s.conn = conn
+	return conn, nil
+}
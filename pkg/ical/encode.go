@@ -0,0 +1,98 @@
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Invite is an event published to attendees as an iCalendar file
+type Invite struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	// Stamp is the DTSTAMP of the event, usually the moment the file is generated
+	Stamp time.Time
+	// Status is one of CONFIRMED, TENTATIVE or CANCELLED; empty omits the property
+	Status string
+	// Sequence must grow whenever the event changes so clients replace their copy
+	Sequence int
+}
+
+// maxLineOctets is the RFC 5545 limit for a content line, excluding CRLF
+const maxLineOctets = 75
+
+// Encode renders invites as an iCalendar (RFC 5545) document.
+// Times are written in UTC; text values are escaped and long lines folded.
+func Encode(prodID string, invites ...Invite) []byte {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+escapeText(prodID))
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+
+	for _, invite := range invites {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+escapeText(invite.UID))
+		writeLine(&b, "DTSTAMP:"+formatUTC(invite.Stamp))
+		writeLine(&b, "DTSTART:"+formatUTC(invite.Start))
+		writeLine(&b, "DTEND:"+formatUTC(invite.End))
+		writeLine(&b, "SUMMARY:"+escapeText(invite.Summary))
+		if invite.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(invite.Description))
+		}
+		if invite.URL != "" {
+			writeLine(&b, "URL:"+invite.URL)
+		}
+		if invite.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(invite.Location))
+		}
+		if invite.Status != "" {
+			writeLine(&b, "STATUS:"+invite.Status)
+		}
+		if invite.Sequence > 0 {
+			writeLine(&b, "SEQUENCE:"+strconv.Itoa(invite.Sequence))
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// writeLine writes a content line, folding it at 75 octets without splitting UTF-8 sequences
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
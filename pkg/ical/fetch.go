@@ -0,0 +1,143 @@
+package ical
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// maxFetchRedirects bounds the redirects followed for one feed
+const maxFetchRedirects = 5
+
+var (
+	// ErrBlockedAddress is returned when a calendar URL resolves to an internal address
+	ErrBlockedAddress = errors.New("calendar URL resolves to a blocked address")
+	// ErrUnsupportedScheme is returned for calendar URLs other than http, https and webcal
+	ErrUnsupportedScheme = errors.New("calendar URL scheme is not allowed")
+)
+
+// blockedPrefixes are the ranges IsBlockedAddress refuses on top of the private, loopback,
+// link-local (which covers the 169.254.169.254 metadata endpoint), multicast and unspecified ones
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),         // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),     // carrier-grade NAT, used for internal cloud networks
+	netip.MustParsePrefix("192.0.0.0/24"),      // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),     // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),       // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),      // NAT64, which reaches IPv4 addresses
+	netip.MustParsePrefix("fd00:ec2::254/128"), // EC2 metadata over IPv6
+}
+
+// IsBlockedAddress reports whether a calendar feed must not be fetched from addr: any address
+// that isn't a public unicast one
+func IsBlockedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GuardedClient fetches user-supplied calendar URLs without reaching internal services. Only
+// http and https are requested (webcal:// is rewritten to https://), every connection is checked
+// after DNS resolution, so a hostname resolving to a private address is refused, and the check
+// repeats for each redirect. It implements httpclient.Client.
+type GuardedClient struct {
+	client *http.Client
+}
+
+// NewGuardedClient creates a client refusing the addresses IsBlockedAddress reports
+func NewGuardedClient(timeout time.Duration) *GuardedClient {
+	return NewGuardedClientWithFilter(timeout, IsBlockedAddress)
+}
+
+// NewGuardedClientWithFilter creates a client refusing the addresses blocked reports
+func NewGuardedClientWithFilter(timeout time.Duration, blocked func(netip.Addr) bool) *GuardedClient {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Control runs for the resolved address of every connection, redirects included
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+			}
+			if blocked(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := &http.Transport{
+		// No proxy: the dialer must see the feed's own address
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       90 * time.Second,
+	}
+
+	return &GuardedClient{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxFetchRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxFetchRedirects)
+				}
+				return checkScheme(req.URL)
+			},
+		},
+	}
+}
+
+// Do sends req after checking its scheme
+func (c *GuardedClient) Do(req *http.Request) (*http.Response, error) {
+	if err := checkScheme(req.URL); err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
+// Get requests a calendar URL; webcal:// URLs are fetched over https
+func (c *GuardedClient) Get(rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, FetchURL(rawURL), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+// Post sends body to a calendar URL
+func (c *GuardedClient) Post(rawURL, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, FetchURL(rawURL), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.Do(req)
+}
+
+func checkScheme(u *url.URL) error {
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedScheme, u.Scheme)
+	}
+}
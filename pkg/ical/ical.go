@@ -0,0 +1,255 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// Event is a single VEVENT extracted from an iCalendar feed.
+// Only the fields needed to compute busy periods are parsed.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	AllDay  bool
+	// Recurrence is the event's RRULE, nil for single events and rules Expand can't follow
+	Recurrence *Recurrence
+	// ExDates are the starts of occurrences removed from the recurrence
+	ExDates []time.Time
+	// RecurrenceID is set on an event that overrides one occurrence of the recurring event with
+	// the same UID: the start that occurrence originally had
+	RecurrenceID time.Time
+}
+
+// Duration returns the length of the event
+func (e Event) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// IsFeedURL reports whether a calendar URL points to an iCalendar feed (.ics or webcal://)
+func IsFeedURL(rawURL string) bool {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return false
+	}
+	if strings.EqualFold(u.Scheme, "webcal") {
+		return true
+	}
+	return strings.HasSuffix(strings.ToLower(u.Path), ".ics")
+}
+
+// FetchURL returns the HTTP(S) URL to download a feed from (webcal:// is served over https)
+func FetchURL(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if strings.HasPrefix(strings.ToLower(rawURL), "webcal://") {
+		return "https://" + rawURL[len("webcal://"):]
+	}
+	return rawURL
+}
+
+// Parse reads VEVENT components from an iCalendar stream; cancelled events are skipped.
+// Recurring events are returned once, with their rule: Expand turns them into occurrences.
+// An RRULE Expand can't follow leaves the first occurrence only.
+// Floating times (no TZID and no UTC suffix) are interpreted in defaultLoc.
+// An event with a date that doesn't parse is logged, counted and skipped; the rest of the feed is kept.
+func Parse(r io.Reader, defaultLoc *time.Location) ([]Event, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	var current *Event
+	var cancelled bool
+	var hasEnd bool
+	var rrule string
+	// broken is the first date of the current event that didn't parse
+	var broken *invalidDate
+	markBroken := func(property, value string, err error) {
+		if broken == nil {
+			broken = &invalidDate{property: property, value: value, err: err}
+		}
+	}
+
+	for _, line := range lines {
+		name, params, value := splitProperty(line)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &Event{}
+			cancelled = false
+			hasEnd = false
+			rrule = ""
+			broken = nil
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current != nil && broken != nil {
+				skipEvent(current.UID, broken)
+			} else if current != nil && !cancelled && !current.Start.IsZero() {
+				if !hasEnd {
+					current.End = defaultEnd(current)
+				}
+				if rrule != "" {
+					// Rules resolve floating UNTIL values in the event's own location
+					if rule, ruleErr := parseRecurrence(rrule, current.Start.Location()); ruleErr == nil {
+						current.Recurrence = rule
+					}
+				}
+				events = append(events, *current)
+			}
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescapeText(value)
+		case name == "STATUS":
+			cancelled = strings.EqualFold(value, "CANCELLED")
+		case name == "DTSTART":
+			t, allDay, parseErr := parseDateTime(value, params, defaultLoc)
+			if parseErr != nil {
+				markBroken("DTSTART", value, parseErr)
+				continue
+			}
+			current.Start = t
+			current.AllDay = allDay
+		case name == "DTEND":
+			t, _, parseErr := parseDateTime(value, params, defaultLoc)
+			if parseErr != nil {
+				markBroken("DTEND", value, parseErr)
+				continue
+			}
+			current.End = t
+			hasEnd = true
+		case name == "RRULE":
+			rrule = value
+		case name == "EXDATE":
+			for _, exValue := range strings.Split(value, ",") {
+				t, _, parseErr := parseDateTime(exValue, params, defaultLoc)
+				if parseErr != nil {
+					markBroken("EXDATE", exValue, parseErr)
+					continue
+				}
+				current.ExDates = append(current.ExDates, t)
+			}
+		case name == "RECURRENCE-ID":
+			t, _, parseErr := parseDateTime(value, params, defaultLoc)
+			if parseErr != nil {
+				markBroken("RECURRENCE-ID", value, parseErr)
+				continue
+			}
+			current.RecurrenceID = t
+		}
+	}
+
+	return events, nil
+}
+
+// invalidDate is a date property of an event that doesn't parse
+type invalidDate struct {
+	property string
+	value    string
+	err      error
+}
+
+// skipEvent logs and counts an event left out for an unparseable date
+func skipEvent(uid string, broken *invalidDate) {
+	metrics.CalendarEventsSkipped.WithLabelValues(broken.property).Inc()
+	logger.Warn("Skipping calendar event with an invalid date",
+		zap.String("uid", uid),
+		zap.String("property", broken.property),
+		zap.String("value", broken.value),
+		zap.Error(broken.err))
+}
+
+// defaultEnd applies RFC 5545 rules for events without DTEND:
+// all-day events last one day, timed events are instantaneous.
+func defaultEnd(e *Event) time.Time {
+	if e.AllDay {
+		return e.Start.AddDate(0, 0, 1)
+	}
+	return e.Start
+}
+
+// unfoldLines joins folded content lines (continuations start with a space or tab)
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+
+	return lines, nil
+}
+
+// splitProperty splits "NAME;PARAM=X:VALUE" into its parts
+func splitProperty(line string) (name string, params map[string]string, value string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+	name = strings.ToUpper(parts[0])
+
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+
+	return name, params, value
+}
+
+func parseDateTime(value string, params map[string]string, defaultLoc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, defaultLoc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	loc := defaultLoc
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,203 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrencePeriods bounds the periods (days, weeks, months or years) walked for one
+// recurring event, so an unbounded rule can't stall Expand
+const maxRecurrencePeriods = 20000
+
+// Recurrence is the part of an RRULE needed to compute busy periods
+type Recurrence struct {
+	// Freq is DAILY, WEEKLY, MONTHLY or YEARLY
+	Freq     string
+	Interval int
+	// Count limits the occurrences, the first one included; 0 means no limit
+	Count int
+	// Until is the last moment an occurrence may start; zero means no limit
+	Until time.Time
+	// ByDay lists the weekdays of a WEEKLY rule; empty repeats on the weekday of DTSTART
+	ByDay []time.Weekday
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// parseRecurrence parses an RRULE value. Rules this package can't expand (other frequencies,
+// BYDAY with ordinals, BYMONTH and the like) return an error.
+func parseRecurrence(value string, loc *time.Location) (*Recurrence, error) {
+	rule := &Recurrence{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", val)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", val)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, _, err := parseDateTime(val, nil, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid UNTIL %q: %w", val, err)
+			}
+			rule.Until = until
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("unsupported BYDAY %q", day)
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "WKST", "":
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+	}
+
+	switch rule.Freq {
+	case "DAILY", "MONTHLY", "YEARLY":
+		if len(rule.ByDay) > 0 {
+			return nil, fmt.Errorf("unsupported BYDAY with FREQ=%s", rule.Freq)
+		}
+	case "WEEKLY":
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %q", rule.Freq)
+	}
+	return rule, nil
+}
+
+// Expand returns the events and the occurrences of recurring events that overlap [from, to),
+// sorted by start. Excluded dates (EXDATE) are skipped and occurrences rescheduled through
+// RECURRENCE-ID are replaced by their override. The returned events carry no recurrence.
+func Expand(events []Event, from, to time.Time) []Event {
+	overridden := make(map[string]bool)
+	for _, event := range events {
+		if !event.RecurrenceID.IsZero() {
+			overridden[occurrenceKey(event.UID, event.RecurrenceID)] = true
+		}
+	}
+
+	expanded := []Event{}
+	for _, event := range events {
+		if event.Recurrence == nil || !event.RecurrenceID.IsZero() {
+			if overlaps(event, from, to) {
+				event.Recurrence = nil
+				expanded = append(expanded, event)
+			}
+			continue
+		}
+		for _, occurrence := range event.occurrences(from, to) {
+			if !overridden[occurrenceKey(event.UID, occurrence.Start)] {
+				expanded = append(expanded, occurrence)
+			}
+		}
+	}
+
+	sort.SliceStable(expanded, func(i, j int) bool {
+		return expanded[i].Start.Before(expanded[j].Start)
+	})
+	return expanded
+}
+
+// occurrences returns the occurrences of a recurring event overlapping [from, to)
+func (e Event) occurrences(from, to time.Time) []Event {
+	rule := e.Recurrence
+	duration := e.Duration()
+	excluded := make(map[int64]bool, len(e.ExDates))
+	for _, exDate := range e.ExDates {
+		excluded[exDate.Unix()] = true
+	}
+
+	var occurrences []Event
+	counted := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, start := range rule.periodStarts(e.Start, period) {
+			if start.Before(e.Start) {
+				continue
+			}
+			if (!rule.Until.IsZero() && start.After(rule.Until)) || !start.Before(to) {
+				return occurrences
+			}
+			counted++
+			if rule.Count > 0 && counted > rule.Count {
+				return occurrences
+			}
+
+			occurrence := e
+			occurrence.Start, occurrence.End = start, start.Add(duration)
+			occurrence.Recurrence, occurrence.ExDates = nil, nil
+			if !excluded[start.Unix()] && overlaps(occurrence, from, to) {
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+	}
+	return occurrences
+}
+
+// periodStarts returns the candidate starts of the period-th period after DTSTART, in order.
+// Starts keep the wall-clock time of DTSTART in its location, across DST changes. Dates that
+// don't exist (February 30th) are skipped.
+func (r *Recurrence) periodStarts(start time.Time, period int) []time.Time {
+	n := period * r.Interval
+	year, month, day := start.Date()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	}
+
+	switch r.Freq {
+	case "DAILY":
+		return []time.Time{at(year, month, day+n)}
+	case "WEEKLY":
+		if len(r.ByDay) == 0 {
+			return []time.Time{at(year, month, day+7*n)}
+		}
+		// Weeks start on Monday (the default WKST)
+		monday := day - (int(start.Weekday())+6)%7 + 7*n
+		starts := make([]time.Time, 0, len(r.ByDay))
+		for _, weekday := range r.ByDay {
+			starts = append(starts, at(year, month, monday+(int(weekday)+6)%7))
+		}
+		sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+		return starts
+	case "MONTHLY":
+		first := time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, start.Location())
+		if t := at(first.Year(), first.Month(), day); t.Day() == day {
+			return []time.Time{t}
+		}
+	case "YEARLY":
+		if t := at(year+n, month, day); t.Day() == day {
+			return []time.Time{t}
+		}
+	}
+	return nil
+}
+
+func overlaps(event Event, from, to time.Time) bool {
+	end := event.End
+	if !end.After(event.Start) {
+		// Instantaneous events occupy their start
+		end = event.Start.Add(time.Nanosecond)
+	}
+	return end.After(from) && event.Start.Before(to)
+}
+
+func occurrenceKey(uid string, start time.Time) string {
+	return uid + "|" + strconv.FormatInt(start.Unix(), 10)
+}
@@ -43,15 +43,20 @@ func NewTokenManager(secret string, issuer string, ttlHours int) *TokenManager {
 
 // GenerateToken creates a new JWT token for a mentor
 func (tm *TokenManager) GenerateToken(mentorUUID string, legacyID int, email, name string) (string, error) {
-	return tm.generateToken(mentorUUID, legacyID, email, name, "")
+	return tm.generateToken("", mentorUUID, legacyID, email, name, "")
+}
+
+// GenerateSessionToken creates a JWT token for a mentor whose jti is the tracked device session ID
+func (tm *TokenManager) GenerateSessionToken(sessionID, mentorUUID string, legacyID int, email, name string) (string, error) {
+	return tm.generateToken(sessionID, mentorUUID, legacyID, email, name, "")
 }
 
 // GenerateTokenWithRole creates a JWT token with an explicit role claim.
 func (tm *TokenManager) GenerateTokenWithRole(subjectID string, legacyID int, email, name, role string) (string, error) {
-	return tm.generateToken(subjectID, legacyID, email, name, role)
+	return tm.generateToken("", subjectID, legacyID, email, name, role)
 }
 
-func (tm *TokenManager) generateToken(subjectID string, legacyID int, email, name, role string) (string, error) {
+func (tm *TokenManager) generateToken(tokenID, subjectID string, legacyID int, email, name, role string) (string, error) {
 	now := time.Now()
 	expiresAt := now.Add(tm.ttl)
 
@@ -67,6 +72,7 @@ func (tm *TokenManager) generateToken(subjectID string, legacyID int, email, nam
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    tm.issuer,
 			Subject:   subjectID, // UUID as subject
+			ID:        tokenID,
 		},
 	}
 
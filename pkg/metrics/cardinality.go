@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// OtherLabelValue is reported instead of label values a guard refuses to track
+const OtherLabelValue = "other"
+
+// UnmatchedRoute is the route label for requests that did not match any route
+const UnmatchedRoute = "unmatched"
+
+// Guards for labels whose values come from request input
+var (
+	HTTPRouteLabels  = NewLabelGuard("http_server_request_duration_seconds", "http_route", 200)
+	CORSOriginLabels = NewLabelGuard("cors_rejected_requests_total", "origin", 50)
+)
+
+// standardMethods are the HTTP methods reported as-is; anything else is "other"
+var standardMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true, http.MethodPut: true,
+	http.MethodPatch: true, http.MethodDelete: true, http.MethodConnect: true,
+	http.MethodOptions: true, http.MethodTrace: true,
+}
+
+// LabelGuard caps the number of distinct values a label can take. The first limit
+// values are passed through, later ones are folded into OtherLabelValue.
+type LabelGuard struct {
+	metric string
+	label  string
+	limit  int
+
+	mu     sync.Mutex
+	seen   map[string]struct{}
+	capped bool
+}
+
+// NewLabelGuard creates a guard for the label of the metric allowing up to limit values
+func NewLabelGuard(metric, label string, limit int) *LabelGuard {
+	return &LabelGuard{
+		metric: metric,
+		label:  label,
+		limit:  limit,
+		seen:   make(map[string]struct{}),
+	}
+}
+
+// Value returns value if it is already tracked or there is room for it, and
+// OtherLabelValue otherwise. The first overflow is logged.
+func (g *LabelGuard) Value(value string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.seen[value]; ok {
+		return value
+	}
+	if len(g.seen) < g.limit {
+		g.seen[value] = struct{}{}
+		return value
+	}
+
+	if !g.capped {
+		g.capped = true
+		if logger.Log != nil {
+			logger.Warn("Metric label hit its cardinality cap; new values are reported as \"other\"",
+				zap.String("metric", g.metric),
+				zap.String("label", g.label),
+				zap.Int("limit", g.limit),
+				zap.String("first_dropped_value", value))
+		}
+	}
+	if LabelOverflows != nil {
+		LabelOverflows.WithLabelValues(g.metric, g.label).Inc()
+	}
+	return OtherLabelValue
+}
+
+// KnownLabels is a closed set of label values. Unlike a LabelGuard it doesn't let the first
+// callers claim the series: values outside the set are always OtherLabelValue.
+type KnownLabels map[string]struct{}
+
+// NewKnownLabels creates the set of values a label may take
+func NewKnownLabels(values ...string) KnownLabels {
+	known := make(KnownLabels, len(values))
+	for _, value := range values {
+		known[value] = struct{}{}
+	}
+	return known
+}
+
+// Value returns value if it is known and OtherLabelValue otherwise
+func (k KnownLabels) Value(value string) string {
+	if _, ok := k[value]; ok {
+		return value
+	}
+	return OtherLabelValue
+}
+
+// RouteLabel normalizes a Gin route template (c.FullPath()) into the http_route label.
+// Unmatched requests share a single label so raw paths never reach Prometheus.
+func RouteLabel(fullPath string) string {
+	if fullPath == "" {
+		return UnmatchedRoute
+	}
+	return HTTPRouteLabels.Value(fullPath)
+}
+
+// MethodLabel normalizes the HTTP method label; non-standard methods are "other"
+func MethodLabel(method string) string {
+	if standardMethods[method] {
+		return method
+	}
+	return OtherLabelValue
+}
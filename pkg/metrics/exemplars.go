@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// exemplarTraceIDLabel is the exemplar label Grafana uses to link a sample to Tempo
+const exemplarTraceIDLabel = "trace_id"
+
+// ObserveWithTrace records a histogram observation and, when ctx carries a sampled
+// span, attaches its trace ID as an exemplar. Exemplars are only exposed when the
+// metrics endpoint serves the OpenMetrics format.
+func ObserveWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsSampled() {
+		observer.Observe(value)
+		return
+	}
+
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !ok {
+		observer.Observe(value)
+		return
+	}
+
+	exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{
+		exemplarTraceIDLabel: spanContext.TraceID().String(),
+	})
+}
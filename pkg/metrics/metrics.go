@@ -16,26 +16,98 @@ var (
 	HTTPRequestDuration *prometheus.HistogramVec
 	HTTPRequestTotal    *prometheus.CounterVec
 	ActiveRequests      *prometheus.GaugeVec
+	// CORSRejectedRequests counts cross-origin requests refused by the CORS policy; CORSOrigins is its size
+	CORSRejectedRequests *prometheus.CounterVec
+	CORSOrigins          prometheus.Gauge
 
 	// Database Client Metrics (PostgreSQL)
 	DBRequestDuration *prometheus.HistogramVec
 	DBRequestTotal    *prometheus.CounterVec
+	// DBQueryDuration times the hot-path prepared statements by name
+	DBQueryDuration          *prometheus.HistogramVec
+	DBPoolConnections        *prometheus.GaugeVec
+	DBPoolEmptyAcquires      prometheus.Counter
+	DBPoolAcquireWaitSeconds prometheus.Counter
+	// DBReady is 1 while the database supervisor's pings succeed
+	DBReady      prometheus.Gauge
+	DBPoolResets prometheus.Counter
 
 	// Cache Metrics
 	CacheHits   *prometheus.CounterVec
 	CacheMisses *prometheus.CounterVec
 	CacheSize   *prometheus.GaugeVec
+	// CacheCoalescedFetches counts fetches that waited for an identical one in flight instead of hitting the data source
+	CacheCoalescedFetches *prometheus.CounterVec
+	// CacheVersion is the shared cache version this replica holds; CacheVersionReloads counts reloads it triggered
+	CacheVersion        *prometheus.GaugeVec
+	CacheVersionReloads *prometheus.CounterVec
+	// CacheStaleReads counts reads served from the last known data after the cached list expired
+	CacheStaleReads *prometheus.CounterVec
+	// CacheStoreErrors counts failed operations of shared cache stores (Redis), which read as misses
+	CacheStoreErrors *prometheus.CounterVec
+	// CacheIndexRebuilds counts mentor cache indexes rebuilt after another replica changed the shared store
+	CacheIndexRebuilds prometheus.Counter
+	// CacheSnapshots counts saves and startup loads of the mentor cache snapshot file
+	CacheSnapshots *prometheus.CounterVec
+	// CacheRefreshPolicy exports the refresh jitter, max backoff and disabled switch; CacheRefreshDelay
+	// and CacheRefreshFailures are each cache's wait for its next scheduled refresh and failed refreshes in a row
+	CacheRefreshPolicy   *prometheus.GaugeVec
+	CacheRefreshDelay    *prometheus.GaugeVec
+	CacheRefreshFailures *prometheus.GaugeVec
 
 	// Storage Client Metrics (Yandex Object Storage)
 	YandexStorageRequestDuration *prometheus.HistogramVec
 	YandexStorageRequestTotal    *prometheus.CounterVec
 
 	// Business Metrics
-	MentorProfileViews     *prometheus.CounterVec
-	ContactFormSubmissions *prometheus.CounterVec
-	ProfileUpdates         *prometheus.CounterVec
-	ProfilePictureUploads  *prometheus.CounterVec
-	MentorRegistrations    *prometheus.CounterVec
+	MentorProfileViews      *prometheus.CounterVec
+	ContactFormSubmissions  *prometheus.CounterVec
+	ProfileUpdates          *prometheus.CounterVec
+	ProfilePictureUploads   *prometheus.CounterVec
+	MentorRegistrations     *prometheus.CounterVec
+	CalendarFeedFetches     *prometheus.CounterVec
+	CalendarEventsSkipped   *prometheus.CounterVec
+	FrontendLogEntries      *prometheus.CounterVec
+	ProgramRegistrations    *prometheus.CounterVec
+	ProgramFillRatio        *prometheus.GaugeVec
+	AbuseReports            *prometheus.CounterVec
+	AbuseReportResolutions  *prometheus.CounterVec
+	BlocklistMatches        *prometheus.CounterVec
+	ContactLimitRejections  *prometheus.CounterVec
+	FormBotRejections       *prometheus.CounterVec
+	QuarantineVerdicts      *prometheus.CounterVec
+	MentorChannelPosts      *prometheus.CounterVec
+	SessionCalendarFetches  *prometheus.CounterVec
+	SessionReschedules      *prometheus.CounterVec
+	TriggerDeliveries       *prometheus.CounterVec
+	EventBusPublished       *prometheus.CounterVec
+	WarehouseExportRows     *prometheus.CounterVec
+	PartnerAuditEntries     *prometheus.CounterVec
+	PartnerQuotaRequests    *prometheus.CounterVec
+	OGImageRequests         *prometheus.CounterVec
+	ShortLinkClicks         *prometheus.CounterVec
+	MentorQuestions         *prometheus.CounterVec
+	CohortEnrollments       *prometheus.CounterVec
+	CohortCertificates      *prometheus.CounterVec
+	MentorImportRows        *prometheus.CounterVec
+	MentorReactivations     *prometheus.CounterVec
+	MentorSurveys           *prometheus.CounterVec
+	MentorInsightsRefreshes *prometheus.CounterVec
+	MentorStatsRefreshes    *prometheus.CounterVec
+	AirtableSyncRecords     *prometheus.CounterVec
+	AirtableCoalescedReads  *prometheus.CounterVec
+	AirtableRequests        *prometheus.CounterVec
+	AirtableDualWrites      *prometheus.CounterVec
+	CircuitBreakerState     *prometheus.GaugeVec
+	CircuitBreakerTrips     *prometheus.CounterVec
+	CircuitBreakerFallbacks *prometheus.CounterVec
+	AuditLogEntries         *prometheus.CounterVec
+	MentorListExposures     *prometheus.CounterVec
+	CalendarLinkChecks      *prometheus.CounterVec
+	CDNPurges               *prometheus.CounterVec
+	BotHeartbeatAge         prometheus.Gauge
+	BotSilenceAlerts        *prometheus.CounterVec
+	MentorNotifications     *prometheus.CounterVec
 
 	// Mentor Auth Metrics
 	MentorAuthLoginRequests     *prometheus.CounterVec
@@ -46,11 +118,13 @@ var (
 	MentorRequestsListDuration  prometheus.Histogram
 	MentorRequestsStatusUpdates *prometheus.CounterVec
 	MentorRequestsDeclines      *prometheus.CounterVec
+	MentorRequestsSchedules     *prometheus.CounterVec
 
 	// Review Metrics
 	ReviewSubmissions *prometheus.CounterVec
 	ReviewChecks      *prometheus.CounterVec
 	ReviewDuration    prometheus.Histogram
+	ReviewModeration  *prometheus.CounterVec
 
 	// MCP Metrics
 	MCPRequestTotal    *prometheus.CounterVec
@@ -60,8 +134,11 @@ var (
 	MCPResultsReturned *prometheus.HistogramVec
 
 	// Infrastructure Metrics
-	GoRoutines prometheus.Gauge
-	HeapAlloc  prometheus.Gauge
+	GoRoutines       prometheus.Gauge
+	HeapAlloc        prometheus.Gauge
+	ResidentMemory   prometheus.Gauge
+	LabelOverflows   *prometheus.CounterVec
+	WatchdogCaptures *prometheus.CounterVec
 )
 
 // Init initializes the metrics registry with service_name label from config
@@ -107,6 +184,21 @@ func Init(serviceName string) {
 		[]string{"http_request_method"},
 	)
 
+	CORSRejectedRequests = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cors_rejected_requests_total",
+			Help: "Cross-origin requests refused by the CORS policy, by origin and reason",
+		},
+		[]string{"origin", "reason"},
+	)
+
+	CORSOrigins = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "cors_allowed_origins",
+			Help: "Origins in the CORS policy currently applied",
+		},
+	)
+
 	// Database Client Metrics (PostgreSQL)
 	DBRequestDuration = factory.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -125,6 +217,51 @@ func Init(serviceName string) {
 		[]string{"operation", "status"},
 	)
 
+	DBQueryDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "db_client_query_duration_seconds",
+			Help:    "Duration of the hot-path prepared statements in seconds, by statement name",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"query", "status"},
+	)
+
+	DBPoolConnections = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "db_client_connection_pool_connections",
+			Help: "Connections of the PostgreSQL pool by state (acquired, idle, constructing, max)",
+		},
+		[]string{"state"},
+	)
+
+	DBPoolEmptyAcquires = factory.NewCounter(
+		prometheus.CounterOpts{
+			Name: "db_client_connection_pool_empty_acquires_total",
+			Help: "Connection acquires that had to wait because the pool had no idle connection",
+		},
+	)
+
+	DBPoolAcquireWaitSeconds = factory.NewCounter(
+		prometheus.CounterOpts{
+			Name: "db_client_connection_pool_wait_seconds_total",
+			Help: "Total time spent waiting for a free pool connection in seconds",
+		},
+	)
+
+	DBReady = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "db_client_ready",
+			Help: "Whether the database answers the supervisor's pings (1) or not (0)",
+		},
+	)
+
+	DBPoolResets = factory.NewCounter(
+		prometheus.CounterOpts{
+			Name: "db_client_connection_pool_resets_total",
+			Help: "Connection pool resets done by the supervisor after persistent ping failures",
+		},
+	)
+
 	// Cache Metrics
 	CacheHits = factory.NewCounterVec(
 		prometheus.CounterOpts{
@@ -150,6 +287,85 @@ func Init(serviceName string) {
 		[]string{"cache_name"},
 	)
 
+	CacheCoalescedFetches = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_coalesced_fetches_total",
+			Help: "Data source fetches served by an identical fetch already in flight",
+		},
+		[]string{"operation"},
+	)
+
+	CacheVersion = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cache_version",
+			Help: "Shared cache version this replica holds",
+		},
+		[]string{"cache_name"},
+	)
+
+	CacheVersionReloads = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_version_reloads_total",
+			Help: "Cache reloads triggered by a newer shared cache version, by outcome",
+		},
+		[]string{"cache_name", "outcome"},
+	)
+
+	CacheStaleReads = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_stale_reads_total",
+			Help: "Cache reads served from the last known data while revalidating",
+		},
+		[]string{"cache_name"},
+	)
+
+	CacheStoreErrors = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_store_errors_total",
+			Help: "Failed cache store operations by backend and operation",
+		},
+		[]string{"backend", "operation"},
+	)
+
+	CacheIndexRebuilds = factory.NewCounter(
+		prometheus.CounterOpts{
+			Name: "cache_index_rebuilds_total",
+			Help: "Mentor cache indexes rebuilt from the shared store after another replica changed it",
+		},
+	)
+
+	CacheSnapshots = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_snapshots_total",
+			Help: "Mentor cache snapshot operations (save, load) by outcome (success, error)",
+		},
+		[]string{"operation", "outcome"},
+	)
+
+	CacheRefreshPolicy = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cache_refresh_policy",
+			Help: "Scheduled cache refresh settings (jitter_ratio, max_backoff_seconds, disabled)",
+		},
+		[]string{"setting"},
+	)
+
+	CacheRefreshDelay = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cache_refresh_next_delay_seconds",
+			Help: "Wait before the next scheduled refresh of each cache, with jitter and backoff",
+		},
+		[]string{"cache"},
+	)
+
+	CacheRefreshFailures = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cache_refresh_consecutive_failures",
+			Help: "Scheduled refreshes of each cache that failed in a row",
+		},
+		[]string{"cache"},
+	)
+
 	// Storage Client Metrics (Yandex Object Storage)
 	YandexStorageRequestDuration = factory.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -209,6 +425,349 @@ func Init(serviceName string) {
 		[]string{"status"},
 	)
 
+	CalendarFeedFetches = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_calendar_feed_fetches_total",
+			Help: "Total mentor iCal feed fetches",
+		},
+		[]string{"status"},
+	)
+
+	CalendarEventsSkipped = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_calendar_events_skipped_total",
+			Help: "iCal feed events skipped for an unparseable date, by property",
+		},
+		[]string{"property"}, // DTSTART, DTEND, EXDATE, RECURRENCE-ID
+	)
+
+	FrontendLogEntries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_frontend_log_entries_total",
+			Help: "Total frontend log entries received, by level",
+		},
+		[]string{"level", "status"}, // status: "accepted", "rejected"
+	)
+
+	ProgramRegistrations = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_program_registrations_total",
+			Help: "Total program attendee registration attempts",
+		},
+		[]string{"status"}, // status: "registered", "waitlisted", "cancelled", "promoted", ...
+	)
+
+	ProgramFillRatio = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "getmentor_program_fill_ratio",
+			Help: "Share of seats taken per program, from 0 to 1",
+		},
+		[]string{"program_id"},
+	)
+
+	AbuseReports = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_abuse_reports_total",
+			Help: "Total number of abuse report submissions",
+		},
+		[]string{"status"},
+	)
+
+	AbuseReportResolutions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_abuse_report_resolutions_total",
+			Help: "Total number of abuse report status changes by moderators",
+		},
+		[]string{"status"},
+	)
+
+	BlocklistMatches = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_blocklist_matches_total",
+			Help: "Total number of submissions matching a blocklist entry",
+		},
+		[]string{"source", "kind", "action"},
+	)
+
+	ContactLimitRejections = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_contact_limit_rejections_total",
+			Help: "Contact form submissions rejected by the persistent per-email and per-IP limits, by kind",
+		},
+		[]string{"kind"},
+	)
+
+	FormBotRejections = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_form_bot_rejections_total",
+			Help: "Public form submissions rejected as automated before the reCAPTCHA check, by form and reason",
+		},
+		[]string{"form", "reason"},
+	)
+
+	QuarantineVerdicts = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_quarantine_verdicts_total",
+			Help: "Total number of admin verdicts on quarantined requests",
+		},
+		[]string{"verdict"},
+	)
+
+	MentorChannelPosts = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_channel_posts_total",
+			Help: "Total number of mentor channel announcements by outcome",
+		},
+		[]string{"outcome"},
+	)
+
+	SessionCalendarFetches = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_session_calendar_fetches_total",
+			Help: "Total number of mentor sessions calendar feed fetches by auth method or failure",
+		},
+		[]string{"result"},
+	)
+
+	SessionReschedules = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_session_reschedules_total",
+			Help: "Total number of session reschedule proposals and confirmations by outcome",
+		},
+		[]string{"action", "outcome"},
+	)
+
+	TriggerDeliveries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_trigger_deliveries_total",
+			Help: "Total number of outbound trigger delivery attempts by destination and outcome (success, retry, failure)",
+		},
+		[]string{"destination", "outcome"},
+	)
+
+	EventBusPublished = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_event_bus_events_total",
+			Help: "Total number of domain events handed to the event bus by type and outcome (success, error, dropped)",
+		},
+		[]string{"event_type", "outcome"},
+	)
+
+	WarehouseExportRows = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_warehouse_export_rows_total",
+			Help: "Rows shipped to the analytics warehouse by stream (outcome success), and failed export batches (outcome error)",
+		},
+		[]string{"stream", "outcome"},
+	)
+
+	PartnerAuditEntries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_partner_audit_entries_total",
+			Help: "Partner-token request summaries by outcome (recorded, sampled_out, dropped, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	PartnerQuotaRequests = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_partner_quota_requests_total",
+			Help: "Partner-token requests checked against monthly quotas by token and outcome (allowed, exceeded, error)",
+		},
+		[]string{"token", "outcome"},
+	)
+
+	OGImageRequests = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_og_image_requests_total",
+			Help: "Mentor share card requests by result (memory_hit, storage_hit, rendered, rendered_inline, error)",
+		},
+		[]string{"result"},
+	)
+
+	ShortLinkClicks = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_short_link_clicks_total",
+			Help: "Short link redirects by result (counted, preview, inactive)",
+		},
+		[]string{"result"},
+	)
+
+	MentorQuestions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_questions_total",
+			Help: "Anonymous mentor question events by action (submitted, captcha_failed, inbox_full, answered, rejected)",
+		},
+		[]string{"action"},
+	)
+
+	CohortEnrollments = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_cohort_enrollments_total",
+			Help: "Cohort enrollment attempts by role and outcome",
+		},
+		[]string{"role", "outcome"},
+	)
+
+	CohortCertificates = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_cohort_certificates_total",
+			Help: "Cohort certificate issue attempts by outcome (issued, not_found, not_eligible, already_issued, failed)",
+		},
+		[]string{"outcome"},
+	)
+
+	MentorImportRows = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_import_rows_total",
+			Help: "Rows of mentor CSV imports by status (created, valid, invalid, failed)",
+		},
+		[]string{"status"},
+	)
+
+	MentorReactivations = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_reactivations_total",
+			Help: "Returning mentors by outcome (offered, not_eligible, reactivated)",
+		},
+		[]string{"outcome"},
+	)
+
+	MentorSurveys = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_surveys_total",
+			Help: "Mentor surveys by outcome (invited, responded, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	CalendarLinkChecks = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_calendar_link_checks_total",
+			Help: "Calendar link checks by outcome (ok, failed, broken, recovered, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	CDNPurges = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_cdn_purges_total",
+			Help: "CDN purge attempts by provider and outcome (success, retry, failure, dropped)",
+		},
+		[]string{"provider", "outcome"},
+	)
+
+	BotHeartbeatAge = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "getmentor_bot_heartbeat_age_seconds",
+			Help: "Seconds since the Telegram bot last sent a heartbeat, as of the last silence check",
+		},
+	)
+
+	BotSilenceAlerts = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_bot_silence_alerts_total",
+			Help: "Telegram bot liveness alerts by event (silent, recovered)",
+		},
+		[]string{"event"},
+	)
+
+	MentorNotifications = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_notifications_total",
+			Help: "Mentor notifications by channel and outcome (sent, queued, queue_failed, delivered_digest, delivered_individual)",
+		},
+		[]string{"channel", "outcome"},
+	)
+
+	MentorInsightsRefreshes = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_insights_refreshes_total",
+			Help: "Mentor insights refreshes by outcome (success, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	MentorStatsRefreshes = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_stats_refreshes_total",
+			Help: "Mentor stats view refreshes by outcome (success, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	AirtableCoalescedReads = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_airtable_coalesced_reads_total",
+			Help: "Airtable reads (get, list) that shared an identical read in flight instead of calling Airtable",
+		},
+		[]string{"operation"},
+	)
+
+	AirtableRequests = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_airtable_requests_total",
+			Help: "Airtable API requests by operation (get, list, update) and outcome (success, error)",
+		},
+		[]string{"operation", "outcome"},
+	)
+
+	AirtableDualWrites = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_airtable_dual_writes_total",
+			Help: "Mentor writes mirrored to Airtable by operation (profile, status, picture) and outcome (success, error, skipped)",
+		},
+		[]string{"operation", "outcome"},
+	)
+
+	CircuitBreakerState = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "getmentor_circuit_breaker_state",
+			Help: "Circuit breaker state by dependency: 1 for the current state (closed, open, half-open), 0 for the others",
+		},
+		[]string{"dependency", "state"},
+	)
+
+	CircuitBreakerTrips = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_circuit_breaker_trips_total",
+			Help: "Times a dependency's circuit breaker opened",
+		},
+		[]string{"dependency"},
+	)
+
+	CircuitBreakerFallbacks = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_circuit_breaker_fallbacks_total",
+			Help: "Calls served by a fallback because a dependency's circuit breaker was open",
+		},
+		[]string{"dependency"},
+	)
+
+	AirtableSyncRecords = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_airtable_sync_records_total",
+			Help: "Records pushed back to Airtable by stream and outcome (synced, conflict, missing, error)",
+		},
+		[]string{"stream", "outcome"},
+	)
+
+	AuditLogEntries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_audit_log_entries_total",
+			Help: "Audited mentor and request mutations by entity type and outcome (recorded, unchanged)",
+		},
+		[]string{"entity_type", "outcome"},
+	)
+
+	MentorListExposures = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_list_exposures_total",
+			Help: "Mentors served within the first screen of the public mentor list, by sort and exposure bucket (new, few_sessions, established)",
+		},
+		[]string{"sort", "bucket"},
+	)
+
 	// Mentor Auth Metrics
 	MentorAuthLoginRequests = factory.NewCounterVec(
 		prometheus.CounterOpts{
@@ -274,6 +833,14 @@ func Init(serviceName string) {
 		[]string{"reason"},
 	)
 
+	MentorRequestsSchedules = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_mentor_requests_schedules_total",
+			Help: "Total session times set on mentor requests",
+		},
+		[]string{"source", "outcome"},
+	)
+
 	// Review Metrics
 	ReviewSubmissions = factory.NewCounterVec(
 		prometheus.CounterOpts{
@@ -299,6 +866,14 @@ func Init(serviceName string) {
 		},
 	)
 
+	ReviewModeration = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_review_moderation_total",
+			Help: "Review and mentor reply moderation by target (review, reply) and outcome (submitted, approved, rejected)",
+		},
+		[]string{"target", "outcome"},
+	)
+
 	// MCP Metrics
 	MCPRequestTotal = factory.NewCounterVec(
 		prometheus.CounterOpts{
@@ -356,6 +931,29 @@ func Init(serviceName string) {
 			Help: "Heap allocated bytes",
 		},
 	)
+
+	ResidentMemory = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "process_runtime_resident_memory_bytes",
+			Help: "Resident set size of the process in bytes",
+		},
+	)
+
+	WatchdogCaptures = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "getmentor_watchdog_captures_total",
+			Help: "Profile captures taken by the runtime watchdog by the threshold that was exceeded (rss, goroutines)",
+		},
+		[]string{"reason"},
+	)
+
+	LabelOverflows = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "metrics_label_overflow_total",
+			Help: "Label values folded into the \"other\" bucket because the label hit its cardinality cap",
+		},
+		[]string{"metric", "label"},
+	)
 }
 
 // RecordInfrastructureMetrics collects infrastructure metrics periodically
@@ -369,6 +967,7 @@ func RecordInfrastructureMetrics() {
 
 			GoRoutines.Set(float64(runtime.NumGoroutine()))
 			HeapAlloc.Set(float64(m.HeapAlloc))
+			ResidentMemory.Set(float64(ResidentMemoryBytes(&m)))
 		}
 	}()
 }
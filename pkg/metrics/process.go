@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"bytes"
+	"os"
+	"runtime"
+	"strconv"
+)
+
+// ResidentMemoryBytes returns the resident set size of the process. It reads
+// /proc/self/statm on Linux and falls back to the memory obtained from the OS by
+// the Go runtime (MemStats.Sys) elsewhere.
+func ResidentMemoryBytes(m *runtime.MemStats) uint64 {
+	if data, err := os.ReadFile("/proc/self/statm"); err == nil {
+		// statm: size resident shared text lib data dt (in pages)
+		fields := bytes.Fields(data)
+		if len(fields) > 1 {
+			if pages, err := strconv.ParseUint(string(fields[1]), 10, 64); err == nil {
+				return pages * uint64(os.Getpagesize())
+			}
+		}
+	}
+	return m.Sys
+}
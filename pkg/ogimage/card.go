@@ -0,0 +1,291 @@
+// Package ogimage renders the social-share (Open Graph) card of a mentor profile as a PNG
+package ogimage
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"sync"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card size recommended by Facebook, Telegram and LinkedIn for link previews
+const (
+	Width  = 1200
+	Height = 630
+)
+
+const (
+	padding    = 72
+	photoSize  = 360
+	textLeft   = padding + photoSize + 64
+	textWidth  = Width - textLeft - padding
+	maxTags    = 6
+	tagPadX    = 20
+	tagHeight  = 48
+	tagGap     = 12
+	tagRadius  = tagHeight / 2
+	footerSize = 28
+)
+
+var (
+	colorBackground = color.RGBA{R: 0xfa, G: 0xf8, B: 0xf5, A: 0xff}
+	colorAccent     = color.RGBA{R: 0xff, G: 0x6b, B: 0x35, A: 0xff}
+	colorText       = color.RGBA{R: 0x1f, G: 0x23, B: 0x28, A: 0xff}
+	colorMuted      = color.RGBA{R: 0x5f, G: 0x66, B: 0x6d, A: 0xff}
+	colorTag        = color.RGBA{R: 0xff, G: 0xe8, B: 0xde, A: 0xff}
+	colorNoPhoto    = color.RGBA{R: 0xe6, G: 0xe1, B: 0xda, A: 0xff}
+)
+
+// Card is the content of a mentor's share card
+type Card struct {
+	Name   string
+	Title  string // job and workplace
+	Tags   []string
+	Photo  image.Image // nil draws the name's initials instead
+	Footer string      // site name shown in the bottom corner
+}
+
+type faces struct {
+	name, title, tag, initials, footer font.Face
+}
+
+var (
+	loadFacesOnce sync.Once
+	loadedFaces   *faces
+	loadFacesErr  error
+)
+
+// loadFaces parses the embedded Go fonts once. They cover Latin and Cyrillic.
+func loadFaces() (*faces, error) {
+	loadFacesOnce.Do(func() {
+		bold, err := opentype.Parse(gobold.TTF)
+		if err != nil {
+			loadFacesErr = fmt.Errorf("failed to parse bold font: %w", err)
+			return
+		}
+		regular, err := opentype.Parse(goregular.TTF)
+		if err != nil {
+			loadFacesErr = fmt.Errorf("failed to parse regular font: %w", err)
+			return
+		}
+
+		face := func(f *opentype.Font, size float64) font.Face {
+			if loadFacesErr != nil {
+				return nil
+			}
+			var ff font.Face
+			ff, loadFacesErr = opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+			return ff
+		}
+		loadedFaces = &faces{
+			name:     face(bold, 60),
+			title:    face(regular, 34),
+			tag:      face(regular, 26),
+			initials: face(bold, 128),
+			footer:   face(bold, footerSize),
+		}
+	})
+	return loadedFaces, loadFacesErr
+}
+
+// Render draws the card and encodes it as PNG
+func Render(card Card) ([]byte, error) {
+	f, err := loadFaces()
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	fill(img, img.Bounds(), colorBackground)
+	fill(img, image.Rect(0, 0, 16, Height), colorAccent)
+
+	photoRect := image.Rect(padding, (Height-photoSize)/2, padding+photoSize, (Height+photoSize)/2)
+	if card.Photo != nil {
+		drawPhoto(img, photoRect, card.Photo)
+	} else {
+		drawInitials(img, photoRect, card.Name, f.initials)
+	}
+
+	y := padding + 40
+	for _, line := range wrap(card.Name, f.name, textWidth, 2) {
+		y += 60
+		drawText(img, line, f.name, textLeft, y, colorText)
+	}
+	y += 16
+	for _, line := range wrap(card.Title, f.title, textWidth, 2) {
+		y += 44
+		drawText(img, line, f.title, textLeft, y, colorMuted)
+	}
+	drawTags(img, card.Tags, f.tag, y+40)
+
+	if card.Footer != "" {
+		footerWidth := font.MeasureString(f.footer, card.Footer).Ceil()
+		drawText(img, card.Footer, f.footer, Width-padding-footerWidth, Height-padding/2-8, colorAccent)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode card: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawPhoto scales the photo to cover the rect and clips it to a circle
+func drawPhoto(dst *image.RGBA, rect image.Rectangle, photo image.Image) {
+	src := photo.Bounds()
+	// Center-crop to a square before scaling so faces keep their proportions
+	side := min(src.Dx(), src.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(src.Min.X+(src.Dx()-side)/2, src.Min.Y+(src.Dy()-side)/2))
+
+	scaled := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	xdraw.CatmullRom.Scale(scaled, scaled.Bounds(), photo, crop, xdraw.Src, nil)
+	xdraw.DrawMask(dst, rect, scaled, image.Point{}, &circle{size: rect.Dx()}, image.Point{}, xdraw.Over)
+}
+
+// drawInitials draws a placeholder with the first letters of the name
+func drawInitials(dst *image.RGBA, rect image.Rectangle, name string, face font.Face) {
+	xdraw.DrawMask(dst, rect, image.NewUniform(colorNoPhoto), image.Point{}, &circle{size: rect.Dx()}, image.Point{}, xdraw.Over)
+
+	var initials []rune
+	for _, word := range strings.Fields(name) {
+		initials = append(initials, []rune(strings.ToUpper(word))[0])
+		if len(initials) == 2 {
+			break
+		}
+	}
+	text := string(initials)
+	width := font.MeasureString(face, text).Ceil()
+	ascent := face.Metrics().CapHeight.Ceil()
+	drawText(dst, text, face, rect.Min.X+(rect.Dx()-width)/2, rect.Min.Y+(rect.Dy()+ascent)/2, colorMuted)
+}
+
+// drawTags draws up to maxTags pills on as many rows as fit above the footer
+func drawTags(dst *image.RGBA, tags []string, face font.Face, top int) {
+	x, y := textLeft, top
+	for i, tag := range tags {
+		if i == maxTags {
+			break
+		}
+		width := font.MeasureString(face, tag).Ceil() + 2*tagPadX
+		if x+width > textLeft+textWidth {
+			x, y = textLeft, y+tagHeight+tagGap
+			if y+tagHeight > Height-padding-footerSize {
+				return
+			}
+			if width > textWidth {
+				continue
+			}
+		}
+
+		pill := image.Rect(x, y, x+width, y+tagHeight)
+		xdraw.DrawMask(dst, pill, image.NewUniform(colorTag), image.Point{},
+			&roundedRect{w: width, h: tagHeight, r: tagRadius}, image.Point{}, xdraw.Over)
+		drawText(dst, tag, face, x+tagPadX, y+(tagHeight+face.Metrics().CapHeight.Ceil())/2, colorText)
+		x += width + tagGap
+	}
+}
+
+// wrap splits text into at most maxLines lines of at most width pixels, ending with an
+// ellipsis when it doesn't fit
+func wrap(text string, face font.Face, width, maxLines int) []string {
+	words := strings.Fields(text)
+	var lines []string
+	var line string
+	for i, word := range words {
+		candidate := strings.TrimSpace(line + " " + word)
+		if font.MeasureString(face, candidate).Ceil() <= width || line == "" {
+			line = candidate
+			continue
+		}
+		if len(lines) == maxLines-1 {
+			return append(lines, ellipsize(strings.Join(append([]string{line}, words[i:]...), " "), face, width))
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, ellipsize(line, face, width))
+	}
+	return lines
+}
+
+func ellipsize(text string, face font.Face, width int) string {
+	if font.MeasureString(face, text).Ceil() <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "…"
+		if font.MeasureString(face, candidate).Ceil() <= width {
+			return candidate
+		}
+	}
+	return "…"
+}
+
+func drawText(dst *image.RGBA, text string, face font.Face, x, baseline int, c color.Color) {
+	d := font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, baseline),
+	}
+	d.DrawString(text)
+}
+
+func fill(dst *image.RGBA, rect image.Rectangle, c color.Color) {
+	xdraw.Draw(dst, rect, image.NewUniform(c), image.Point{}, xdraw.Src)
+}
+
+// circle is an alpha mask of a circle inscribed in a size×size square
+type circle struct {
+	size int
+}
+
+func (c *circle) ColorModel() color.Model { return color.AlphaModel }
+func (c *circle) Bounds() image.Rectangle { return image.Rect(0, 0, c.size, c.size) }
+func (c *circle) At(x, y int) color.Color {
+	r := float64(c.size) / 2
+	dx, dy := float64(x)+0.5-r, float64(y)+0.5-r
+	return color.Alpha{A: coverage(dx*dx+dy*dy, r)}
+}
+
+// roundedRect is an alpha mask of a w×h rectangle with corner radius r
+type roundedRect struct {
+	w, h, r int
+}
+
+func (m *roundedRect) ColorModel() color.Model { return color.AlphaModel }
+func (m *roundedRect) Bounds() image.Rectangle { return image.Rect(0, 0, m.w, m.h) }
+func (m *roundedRect) At(x, y int) color.Color {
+	r := float64(m.r)
+	px, py := float64(x)+0.5, float64(y)+0.5
+	cx := min(max(px, r), float64(m.w)-r)
+	cy := min(max(py, r), float64(m.h)-r)
+	dx, dy := px-cx, py-cy
+	return color.Alpha{A: coverage(dx*dx+dy*dy, r)}
+}
+
+// coverage anti-aliases a shape edge: full inside radius r, fading over one pixel
+func coverage(distSquared, r float64) uint8 {
+	switch {
+	case distSquared <= (r-1)*(r-1):
+		return 0xff
+	case distSquared >= r*r:
+		return 0
+	default:
+		// Linear falloff across the last pixel of the radius
+		return uint8(0xff * (r*r - distSquared) / (r*r - (r-1)*(r-1)))
+	}
+}
@@ -0,0 +1,126 @@
+// Package preflight checks external dependencies at startup and decides, per
+// dependency, whether a failure stops the process, is only reported, or is not checked
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Strictness is what a failed check means for startup
+type Strictness string
+
+const (
+	StrictnessFail Strictness = "fail" // abort startup
+	StrictnessWarn Strictness = "warn" // report and continue in degraded mode
+	StrictnessSkip Strictness = "skip" // don't run the check
+)
+
+// Status is the outcome of a single check
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusFailed   Status = "failed"
+	StatusDisabled Status = "disabled"
+	StatusSkipped  Status = "skipped"
+)
+
+// ErrDisabled is returned by a check when the dependency is intentionally turned off
+// in config. It is reported but never counts as a failure.
+var ErrDisabled = errors.New("disabled in config")
+
+// ParseStrictness parses a strictness from config. An empty value is defaultValue.
+func ParseStrictness(value string, defaultValue Strictness) (Strictness, error) {
+	switch s := Strictness(strings.ToLower(strings.TrimSpace(value))); s {
+	case "":
+		return defaultValue, nil
+	case StrictnessFail, StrictnessWarn, StrictnessSkip:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown preflight strictness %q (expected fail, warn or skip)", value)
+	}
+}
+
+// Check is a single dependency check
+type Check struct {
+	Name       string
+	Strictness Strictness
+	Run        func(ctx context.Context) error
+}
+
+// Result is the outcome of a Check
+type Result struct {
+	Name       string
+	Strictness Strictness
+	Status     Status
+	Detail     string
+	Duration   time.Duration
+}
+
+// Blocking reports whether the result must abort startup
+func (r Result) Blocking() bool {
+	return r.Status == StatusFailed && r.Strictness == StrictnessFail
+}
+
+// Run executes the checks one by one, each bounded by timeout. The returned error
+// lists the failed checks with fail strictness; warn failures only show up in results.
+func Run(ctx context.Context, timeout time.Duration, checks []Check) ([]Result, error) {
+	results := make([]Result, 0, len(checks))
+	var blocking []string
+
+	for _, check := range checks {
+		result := Result{Name: check.Name, Strictness: check.Strictness}
+		if check.Strictness == StrictnessSkip {
+			result.Status = StatusSkipped
+			results = append(results, result)
+			continue
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		err := check.Run(checkCtx)
+		result.Duration = time.Since(start)
+		cancel()
+
+		switch {
+		case err == nil:
+			result.Status = StatusOK
+		case errors.Is(err, ErrDisabled):
+			result.Status = StatusDisabled
+			result.Detail = err.Error()
+		default:
+			result.Status = StatusFailed
+			result.Detail = err.Error()
+		}
+		if result.Blocking() {
+			blocking = append(blocking, fmt.Sprintf("%s: %s", result.Name, result.Detail))
+		}
+		results = append(results, result)
+	}
+
+	if len(blocking) > 0 {
+		return results, fmt.Errorf("preflight failed: %s", strings.Join(blocking, "; "))
+	}
+	return results, nil
+}
+
+// Table renders the results as a plain-text table for the startup output
+func Table(results []Result) string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DEPENDENCY\tSTRICTNESS\tSTATUS\tLATENCY\tDETAIL")
+	for _, r := range results {
+		latency := "-"
+		if r.Duration > 0 {
+			latency = r.Duration.Round(time.Millisecond).String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Name, r.Strictness, r.Status, latency, r.Detail)
+	}
+	_ = w.Flush() //nolint:errcheck // writes to a strings.Builder
+	return b.String()
+}
@@ -0,0 +1,127 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	dialTimeout    = 5 * time.Second
+	commandTimeout = 5 * time.Second
+)
+
+// Client is a pooled Redis client for the commands the mentor cache uses. It wraps go-redis,
+// which keeps a connection pool, reconnects and supports TLS for rediss:// URLs.
+type Client struct {
+	client *goredis.Client
+}
+
+// NewClient parses a redis:// or rediss:// (TLS) URL, [[user]:password@]host[:port][/db], with
+// the go-redis query options such as ?pool_size=20 or ?protocol=2. No connection is opened yet.
+func NewClient(rawURL string) (*Client, error) {
+	if strings.TrimSpace(rawURL) == "" {
+		return nil, errors.New("redis URL is required")
+	}
+
+	options, err := goredis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	if !strings.Contains(rawURL, "dial_timeout=") {
+		options.DialTimeout = dialTimeout
+	}
+	if !strings.Contains(rawURL, "read_timeout=") {
+		options.ReadTimeout = commandTimeout
+	}
+	if !strings.Contains(rawURL, "write_timeout=") {
+		options.WriteTimeout = commandTimeout
+	}
+	// CLIENT SETINFO is not needed and is rejected by older servers and proxies
+	options.DisableIdentity = true
+	return &Client{client: goredis.NewClient(options)}, nil
+}
+
+// TLS reports whether the client connects with TLS (a rediss:// URL)
+func (c *Client) TLS() bool {
+	return c.client.Options().TLSConfig != nil
+}
+
+// Get returns the value of key; found is false when the key does not exist
+func (c *Client) Get(ctx context.Context, key string) (value []byte, found bool, err error) {
+	value, err = c.client.Get(ctx, key).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// MGet returns the values of keys in order, nil for missing keys
+func (c *Client) MGet(ctx context.Context, keys ...string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	reply, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, len(reply))
+	for i, item := range reply {
+		switch v := item.(type) {
+		case nil:
+		case string:
+			values[i] = []byte(v)
+		default:
+			return nil, fmt.Errorf("redis: unexpected MGET reply %T", item)
+		}
+	}
+	return values, nil
+}
+
+// Set stores value under key; a positive ttl makes the key expire
+func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < 0 {
+		ttl = 0
+	}
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+// MSet stores several keys without expiry; pairs alternate keys and values
+func (c *Client) MSet(ctx context.Context, pairs ...string) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+	if len(pairs)%2 != 0 {
+		return errors.New("redis: MSET needs key and value pairs")
+	}
+	values := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		values[i] = pair
+	}
+	return c.client.MSet(ctx, values...).Err()
+}
+
+// Del removes keys and returns how many existed
+func (c *Client) Del(ctx context.Context, keys ...string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	return c.client.Del(ctx, keys...).Result()
+}
+
+// Ping checks the server answers
+func (c *Client) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// Close closes the pooled connections
+func (c *Client) Close() {
+	_ = c.client.Close() //nolint:errcheck // Nothing to do about a failed close
+}
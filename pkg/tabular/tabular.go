@@ -0,0 +1,81 @@
+// Package tabular streams lists as XML or CSV documents for consumers that can't read JSON.
+// Items are encoded one by one and flushed in batches, so a long list is never held as a whole document.
+package tabular
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	XMLContentType = "application/xml; charset=utf-8"
+	CSVContentType = "text/csv; charset=utf-8"
+)
+
+// flushEvery is how many items are encoded between flushes to the writer
+const flushEvery = 50
+
+// WriteXML writes an XML document with a root element holding n elements named item.
+// value(i) returns the value encoded as the i-th element.
+func WriteXML(w io.Writer, root, item string, n int, value func(i int) interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	rootStart := xml.StartElement{Name: xml.Name{Local: root}}
+	if err := enc.EncodeToken(rootStart); err != nil {
+		return err
+	}
+	itemStart := xml.StartElement{Name: xml.Name{Local: item}}
+	for i := 0; i < n; i++ {
+		if err := enc.EncodeElement(value(i), itemStart); err != nil {
+			return fmt.Errorf("failed to encode %s %d: %w", item, i, err)
+		}
+		if (i+1)%flushEvery == 0 {
+			if err := enc.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := enc.EncodeToken(rootStart.End()); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
+
+// WriteCSV writes a header line and n rows; row(i) returns the cells of the i-th row.
+// Cells that a spreadsheet would run as a formula are prefixed with a quote.
+func WriteCSV(w io.Writer, header []string, n int, row func(i int) []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		cells := row(i)
+		for j, cell := range cells {
+			cells[j] = escapeFormula(cell)
+		}
+		if err := cw.Write(cells); err != nil {
+			return fmt.Errorf("failed to write row %d: %w", i, err)
+		}
+		if (i+1)%flushEvery == 0 {
+			cw.Flush()
+			if err := cw.Error(); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// escapeFormula neutralizes CSV formula injection (cells starting with = + - @ or a control character)
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
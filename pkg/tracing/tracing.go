@@ -105,3 +105,23 @@ func StartSpan(ctx context.Context, spanName string) (context.Context, trace.Spa
 	}
 	return tracer.Start(ctx, spanName)
 }
+
+// ParseTraceparent parses a W3C traceparent header value into a remote span context
+func ParseTraceparent(traceparent string) (trace.SpanContext, bool) {
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), carrier)
+	spanContext := trace.SpanContextFromContext(ctx)
+	return spanContext, spanContext.IsValid()
+}
+
+// RecordRemoteEvent records an event in a short span parented to a span context received
+// from another service (e.g. the frontend), so the event shows up in that trace.
+func RecordRemoteEvent(ctx context.Context, parent trace.SpanContext, spanName, eventName string, attrs ...attribute.KeyValue) {
+	if tracer == nil || !parent.IsValid() {
+		return
+	}
+	_, span := tracer.Start(trace.ContextWithRemoteSpanContext(ctx, parent), spanName,
+		trace.WithLinks(trace.LinkFromContext(ctx)))
+	span.AddEvent(eventName, trace.WithAttributes(attrs...))
+	span.End()
+}
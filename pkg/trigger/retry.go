@@ -0,0 +1,87 @@
+package trigger
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how failed asynchronous deliveries are retried
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy rides out Azure Functions cold starts without holding goroutines for long
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// Backoff returns the delay before the attempt following the given one: exponential
+// growth capped at MaxDelay with full jitter, so retries of a burst spread out
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	ceiling := p.BaseDelay
+	for i := 1; i < attempt && ceiling < p.MaxDelay; i++ {
+		ceiling *= 2
+	}
+	if p.MaxDelay > 0 && ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1)) //nolint:gosec // jitter does not need a secure source
+}
+
+// IsRetryable reports whether a failed delivery may succeed later: network errors
+// (status 0), rate limiting and server errors are retried, other client errors are not
+func IsRetryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// Attempt is one failed delivery attempt
+type Attempt struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error"`
+}
+
+// DeadLetter is a delivery that failed every attempt
+type DeadLetter struct {
+	Delivery Delivery
+	Attempts []Attempt
+}
+
+// DeadLetterSink stores deliveries the trigger package gave up on
+type DeadLetterSink interface {
+	SaveDeadLetter(ctx context.Context, letter DeadLetter) error
+}
+
+var (
+	settingsMu  sync.RWMutex
+	retryPolicy = DefaultRetryPolicy
+	deadLetters DeadLetterSink
+)
+
+// Configure sets the retry policy and the dead-letter sink used by CallAsync and
+// CallAsyncWithPayload. A nil sink only logs deliveries that gave up.
+func Configure(policy RetryPolicy, sink DeadLetterSink) {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	retryPolicy = policy
+	deadLetters = sink
+}
+
+func currentSettings() (RetryPolicy, DeadLetterSink) {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return retryPolicy, deadLetters
+}
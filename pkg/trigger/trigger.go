@@ -2,17 +2,57 @@ package trigger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/getmentor/getmentor-api/pkg/httpclient"
 	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
 	"go.uber.org/zap"
 )
 
+// deadLetterSaveTimeout bounds how long storing a dead letter may take
+const deadLetterSaveTimeout = 10 * time.Second
+
+// Delivery is one outbound trigger call: a GET of TriggerURL+RecordID, or a POST of Payload to TriggerURL
+type Delivery struct {
+	TriggerURL string          `json:"-"`
+	RecordID   string          `json:"recordId,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+// Method returns the HTTP method used for the delivery
+func (d Delivery) Method() string {
+	if d.Payload != nil {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+// Destination identifies the trigger without secrets: host and path of the trigger URL
+func (d Delivery) Destination() string {
+	return Destination(d.TriggerURL)
+}
+
+// Destination returns host and path of a trigger URL, dropping the query (Azure Functions keep
+// their access code there) so it is safe to log and to use as a metric label
+func Destination(triggerURL string) string {
+	parsed, err := url.Parse(triggerURL)
+	if err != nil || parsed.Host == "" {
+		return "unknown"
+	}
+	return parsed.Host + parsed.Path
+}
+
 // CallAsync calls a trigger URL asynchronously with a record_id query parameter.
 // This is used to trigger Azure Functions after database operations.
-// Failures are logged but don't block the operation.
+// Failures are retried with backoff and end up in the dead-letter sink; they don't block the operation.
 func CallAsync(triggerURL, recordID string, httpClient httpclient.Client) {
 	if triggerURL == "" {
 		// No trigger URL configured, skip silently
@@ -20,76 +60,142 @@ func CallAsync(triggerURL, recordID string, httpClient httpclient.Client) {
 	}
 
 	// Run in goroutine to avoid blocking
-	go func() {
-		targetURL := fmt.Sprintf("%s%s", triggerURL, recordID)
-
-		logger.Info("Calling trigger URL",
-			zap.String("url", targetURL),
-			zap.String("record_id", recordID))
-
-		resp, err := httpClient.Get(targetURL)
-		if err != nil {
-			logger.Error("Failed to call trigger URL",
-				zap.Error(err),
-				zap.String("url", targetURL),
-				zap.String("record_id", recordID))
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			logger.Info("Trigger URL called successfully",
-				zap.String("url", targetURL),
-				zap.String("record_id", recordID),
-				zap.Int("status_code", resp.StatusCode))
-		} else {
-			logger.Warn("Trigger URL returned non-success status",
-				zap.String("url", targetURL),
-				zap.String("record_id", recordID),
-				zap.Int("status_code", resp.StatusCode))
-		}
-	}()
+	go deliverWithRetry(Delivery{TriggerURL: triggerURL, RecordID: recordID}, httpClient)
 }
 
 // CallAsyncWithPayload calls a trigger URL asynchronously with a JSON payload.
 // This is used for triggers that need more than just a record ID.
-// Failures are logged but don't block the operation.
+// Failures are retried with backoff and end up in the dead-letter sink; they don't block the operation.
 func CallAsyncWithPayload(triggerURL string, payload interface{}, httpClient httpclient.Client) {
 	if triggerURL == "" {
 		// No trigger URL configured, skip silently
 		return
 	}
 
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("Failed to marshal trigger payload",
+			zap.Error(err),
+			zap.String("destination", Destination(triggerURL)))
+		return
+	}
+
 	// Run in goroutine to avoid blocking
-	go func() {
-		jsonData, err := json.Marshal(payload)
-		if err != nil {
-			logger.Error("Failed to marshal trigger payload",
-				zap.Error(err),
-				zap.String("url", triggerURL))
+	go deliverWithRetry(Delivery{TriggerURL: triggerURL, Payload: jsonData}, httpClient)
+}
+
+// Call synchronously calls a trigger URL with a record_id suffix (same request as CallAsync)
+// and returns the response status code. Used where the caller needs the delivery result.
+func Call(ctx context.Context, triggerURL, recordID string, httpClient httpclient.Client) (int, error) {
+	return Deliver(ctx, Delivery{TriggerURL: triggerURL, RecordID: recordID}, httpClient)
+}
+
+// CallWithPayload synchronously POSTs a JSON payload to a trigger URL (same request as CallAsyncWithPayload)
+// and returns the response status code.
+func CallWithPayload(ctx context.Context, triggerURL string, payload interface{}, httpClient httpclient.Client) (int, error) {
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal trigger payload: %w", err)
+	}
+	return Deliver(ctx, Delivery{TriggerURL: triggerURL, Payload: jsonData}, httpClient)
+}
+
+// Deliver makes a single delivery attempt and returns the response status code
+func Deliver(ctx context.Context, delivery Delivery, httpClient httpclient.Client) (int, error) {
+	var body io.Reader = http.NoBody
+	if delivery.Payload != nil {
+		body = bytes.NewReader(delivery.Payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, delivery.Method(), delivery.TriggerURL+delivery.RecordID, body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build trigger request: %w", err)
+	}
+	if delivery.Payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return do(req, httpClient)
+}
+
+// deliverWithRetry retries failed deliveries per the configured policy and hands the
+// delivery to the dead-letter sink once it gives up
+func deliverWithRetry(delivery Delivery, httpClient httpclient.Client) {
+	policy, sink := currentSettings()
+	destination := delivery.Destination()
+
+	var attempts []Attempt
+	for attempt := 1; ; attempt++ {
+		statusCode, err := Deliver(context.Background(), delivery, httpClient)
+		if err == nil {
+			recordDelivery(destination, "success")
+			logger.Info("Trigger URL called successfully",
+				zap.String("destination", destination),
+				zap.String("record_id", delivery.RecordID),
+				zap.Int("status_code", statusCode),
+				zap.Int("attempt", attempt))
 			return
 		}
+		attempts = append(attempts, Attempt{At: time.Now().UTC(), StatusCode: statusCode, Error: err.Error()})
 
-		logger.Info("Calling trigger URL with payload",
-			zap.String("url", triggerURL))
-
-		resp, err := httpClient.Post(triggerURL, "application/json", bytes.NewBuffer(jsonData))
-		if err != nil {
-			logger.Error("Failed to call trigger URL",
+		if attempt >= policy.MaxAttempts || !IsRetryable(statusCode) {
+			recordDelivery(destination, "failure")
+			logger.Error("Trigger delivery failed, giving up",
 				zap.Error(err),
-				zap.String("url", triggerURL))
+				zap.String("destination", destination),
+				zap.String("record_id", delivery.RecordID),
+				zap.Int("status_code", statusCode),
+				zap.Int("attempts", attempt))
+			saveDeadLetter(sink, DeadLetter{Delivery: delivery, Attempts: attempts})
 			return
 		}
-		defer resp.Body.Close()
 
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			logger.Info("Trigger URL called successfully",
-				zap.String("url", triggerURL),
-				zap.Int("status_code", resp.StatusCode))
-		} else {
-			logger.Warn("Trigger URL returned non-success status",
-				zap.String("url", triggerURL),
-				zap.Int("status_code", resp.StatusCode))
+		recordDelivery(destination, "retry")
+		logger.Warn("Trigger delivery failed, retrying",
+			zap.Error(err),
+			zap.String("destination", destination),
+			zap.String("record_id", delivery.RecordID),
+			zap.Int("status_code", statusCode),
+			zap.Int("attempt", attempt))
+		time.Sleep(policy.Backoff(attempt))
+	}
+}
+
+func saveDeadLetter(sink DeadLetterSink, letter DeadLetter) {
+	if sink == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), deadLetterSaveTimeout)
+	defer cancel()
+	if err := sink.SaveDeadLetter(ctx, letter); err != nil {
+		logger.Error("Failed to store trigger dead letter",
+			zap.Error(err),
+			zap.String("destination", letter.Delivery.Destination()),
+			zap.String("record_id", letter.Delivery.RecordID))
+	}
+}
+
+func recordDelivery(destination, outcome string) {
+	if metrics.TriggerDeliveries == nil {
+		return
+	}
+	metrics.TriggerDeliveries.WithLabelValues(destination, outcome).Inc()
+}
+
+func do(req *http.Request, httpClient httpclient.Client) (int, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// Drop the URL from the error: it carries the function access code and ends up in
+		// logs and in the dead-letter attempt history
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
 		}
-	}()
+		return 0, fmt.Errorf("failed to call trigger URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("trigger URL returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
 }
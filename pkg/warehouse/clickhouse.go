@@ -0,0 +1,112 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+)
+
+const maxErrorBody = 1024
+
+// ClickHouse is a Warehouse talking to a ClickHouse server over its HTTP interface
+type ClickHouse struct {
+	baseURL    string
+	database   string
+	user       string
+	password   string
+	httpClient httpclient.Client
+}
+
+// NewClickHouse creates a client for the HTTP interface at baseURL (e.g. https://host:8443)
+func NewClickHouse(baseURL, database, user, password string, httpClient httpclient.Client) (*ClickHouse, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid ClickHouse URL %q", baseURL)
+	}
+	if database == "" {
+		database = "default"
+	}
+	return &ClickHouse{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		database:   database,
+		user:       user,
+		password:   password,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Exec runs a statement that returns no rows, e.g. DDL
+func (c *ClickHouse) Exec(ctx context.Context, statement string) error {
+	return c.post(ctx, nil, strings.NewReader(statement))
+}
+
+// Ping checks that the server is reachable and the database exists
+func (c *ClickHouse) Ping(ctx context.Context) error {
+	return c.Exec(ctx, "SELECT 1")
+}
+
+// Insert writes rows to table as JSONEachRow. Rows are marshalled with encoding/json,
+// so their json tags must match the column names.
+func (c *ClickHouse) Insert(ctx context.Context, table string, rows []interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("failed to encode %s row: %w", table, err)
+		}
+	}
+
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", quoteIdentifier(table)))
+	// Timestamps are encoded as RFC 3339 by encoding/json
+	query.Set("date_time_input_format", "best_effort")
+	return c.post(ctx, query, &body)
+}
+
+func (c *ClickHouse) post(ctx context.Context, query url.Values, body io.Reader) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("database", c.database)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/?"+query.Encode(), body)
+	if err != nil {
+		return fmt.Errorf("failed to build ClickHouse request: %w", err)
+	}
+	if c.user != "" {
+		req.Header.Set("X-ClickHouse-User", c.user)
+		req.Header.Set("X-ClickHouse-Key", c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("ClickHouse request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck // best effort error detail
+		return fmt.Errorf("ClickHouse returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body) //nolint:errcheck // drain for connection reuse
+	return nil
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,31 @@
+package warehouse
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Hasher pseudonymizes personal data before it leaves the service. Values are
+// normalized (trimmed, lowercased) and hashed with a keyed HMAC, so the same person
+// gets the same hash across exports while the warehouse cannot reverse it.
+type Hasher struct {
+	key []byte
+}
+
+// NewHasher creates a hasher keyed with salt. Changing the salt changes every hash.
+func NewHasher(salt string) *Hasher {
+	return &Hasher{key: []byte(salt)}
+}
+
+// Hash returns the hex HMAC-SHA256 of the normalized value, or "" for an empty value
+func (h *Hasher) Hash(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,14 @@
+// Package warehouse ships anonymized analytics facts to an external data warehouse
+package warehouse
+
+import "context"
+
+// Warehouse is the destination of the analytics export
+type Warehouse interface {
+	// Exec runs an idempotent schema statement
+	Exec(ctx context.Context, statement string) error
+	// Insert appends rows to a table; rows use json tags for column names
+	Insert(ctx context.Context, table string, rows []interface{}) error
+}
+
+var _ Warehouse = (*ClickHouse)(nil)
@@ -0,0 +1,208 @@
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// profileKinds are the runtime/pprof profiles captured when a threshold is exceeded
+var profileKinds = []string{"heap", "goroutine"}
+
+// uploadTimeout bounds a single profile upload to object storage
+const uploadTimeout = 30 * time.Second
+
+// Config configures the watchdog
+type Config struct {
+	Interval           time.Duration
+	RSSThresholdBytes  uint64 // 0 disables the RSS check
+	GoroutineThreshold int    // 0 disables the goroutine check
+	Cooldown           time.Duration
+	DumpDir            string
+	KeepDumps          int
+}
+
+// Uploader stores captured profiles outside the container
+type Uploader interface {
+	UploadPrivateObject(ctx context.Context, key, contentType string, data []byte) error
+}
+
+// Stats is a single sample of the runtime
+type Stats struct {
+	RSSBytes       uint64
+	HeapAllocBytes uint64
+	Goroutines     int
+}
+
+// Watchdog periodically samples runtime stats and, when RSS or the goroutine count
+// exceeds its threshold, captures heap and goroutine profiles and logs an alert.
+// Captures are rate limited by the cooldown.
+type Watchdog struct {
+	cfg      Config
+	uploader Uploader // nil keeps profiles on disk only
+	instance string
+
+	lastCapture time.Time
+}
+
+// New creates a watchdog. instance names the upload prefix so profiles of several
+// replicas don't collide.
+func New(cfg Config, uploader Uploader, instance string) *Watchdog {
+	if instance == "" {
+		instance, _ = os.Hostname()
+	}
+	return &Watchdog{cfg: cfg, uploader: uploader, instance: instance}
+}
+
+// Start runs the watchdog loop in the background
+func (w *Watchdog) Start() {
+	logger.Info("Runtime watchdog started",
+		zap.Duration("interval", w.cfg.Interval),
+		zap.Uint64("rss_threshold_bytes", w.cfg.RSSThresholdBytes),
+		zap.Int("goroutine_threshold", w.cfg.GoroutineThreshold),
+		zap.String("dump_dir", w.cfg.DumpDir))
+
+	go func() {
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			w.Check(context.Background())
+		}
+	}()
+}
+
+// Check samples the runtime once and captures profiles if a threshold is exceeded
+// and the cooldown has passed. It returns the paths of the written profiles.
+func (w *Watchdog) Check(ctx context.Context) []string {
+	stats := Sample()
+
+	reasons := w.exceeded(stats)
+	if len(reasons) == 0 {
+		return nil
+	}
+	if !w.lastCapture.IsZero() && time.Since(w.lastCapture) < w.cfg.Cooldown {
+		return nil
+	}
+	w.lastCapture = time.Now()
+
+	paths, err := w.capture(ctx)
+	if err != nil {
+		logger.Error("Watchdog failed to capture profiles", zap.Error(err))
+	}
+
+	for _, reason := range reasons {
+		if metrics.WatchdogCaptures != nil {
+			metrics.WatchdogCaptures.WithLabelValues(reason).Inc()
+		}
+	}
+	logger.Error("Runtime watchdog threshold exceeded; profiles captured",
+		zap.Bool("alert", true),
+		zap.Strings("reasons", reasons),
+		zap.Uint64("rss_bytes", stats.RSSBytes),
+		zap.Uint64("heap_alloc_bytes", stats.HeapAllocBytes),
+		zap.Int("goroutines", stats.Goroutines),
+		zap.Strings("profiles", paths))
+
+	return paths
+}
+
+// Sample reads the current runtime stats
+func Sample() Stats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return Stats{
+		RSSBytes:       metrics.ResidentMemoryBytes(&m),
+		HeapAllocBytes: m.HeapAlloc,
+		Goroutines:     runtime.NumGoroutine(),
+	}
+}
+
+func (w *Watchdog) exceeded(stats Stats) []string {
+	var reasons []string
+	if w.cfg.RSSThresholdBytes > 0 && stats.RSSBytes >= w.cfg.RSSThresholdBytes {
+		reasons = append(reasons, "rss")
+	}
+	if w.cfg.GoroutineThreshold > 0 && stats.Goroutines >= w.cfg.GoroutineThreshold {
+		reasons = append(reasons, "goroutines")
+	}
+	return reasons
+}
+
+// capture writes every profile kind to the dump dir, uploads them when an uploader
+// is configured and prunes old dumps
+func (w *Watchdog) capture(ctx context.Context) ([]string, error) {
+	if err := os.MkdirAll(w.cfg.DumpDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dump dir: %w", err)
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	paths := make([]string, 0, len(profileKinds))
+	for _, kind := range profileKinds {
+		var buf bytes.Buffer
+		if err := pprof.Lookup(kind).WriteTo(&buf, 0); err != nil {
+			return paths, fmt.Errorf("failed to write %s profile: %w", kind, err)
+		}
+
+		name := fmt.Sprintf("%s-%s.pb.gz", kind, stamp)
+		path := filepath.Join(w.cfg.DumpDir, name)
+		if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+			return paths, fmt.Errorf("failed to save %s profile: %w", kind, err)
+		}
+		paths = append(paths, path)
+
+		if w.uploader != nil {
+			uploadCtx, cancel := context.WithTimeout(ctx, uploadTimeout)
+			key := fmt.Sprintf("diagnostics/%s/%s", w.instance, name)
+			if err := w.uploader.UploadPrivateObject(uploadCtx, key, "application/octet-stream", buf.Bytes()); err != nil {
+				logger.Warn("Watchdog failed to upload profile", zap.Error(err), zap.String("key", key))
+			}
+			cancel()
+		}
+	}
+
+	w.prune()
+	return paths, nil
+}
+
+// prune keeps the newest KeepDumps profile files in the dump dir
+func (w *Watchdog) prune() {
+	entries, err := os.ReadDir(w.cfg.DumpDir)
+	if err != nil {
+		return
+	}
+
+	var dumps []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".pb.gz") {
+			dumps = append(dumps, entry.Name())
+		}
+	}
+	if len(dumps) <= w.cfg.KeepDumps {
+		return
+	}
+
+	// Names end with a sortable UTC timestamp; order by it regardless of the kind prefix
+	sort.Slice(dumps, func(i, j int) bool { return dumpStamp(dumps[i]) < dumpStamp(dumps[j]) })
+	for _, name := range dumps[:len(dumps)-w.cfg.KeepDumps] {
+		if err := os.Remove(filepath.Join(w.cfg.DumpDir, name)); err != nil {
+			logger.Warn("Watchdog failed to remove old profile", zap.Error(err), zap.String("file", name))
+		}
+	}
+}
+
+func dumpStamp(name string) string {
+	name = strings.TrimSuffix(name, ".pb.gz")
+	return name[strings.LastIndex(name, "-")+1:]
+}
@@ -4,18 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/getmentor/getmentor-api/pkg/logger"
 	"github.com/getmentor/getmentor-api/pkg/metrics"
 	"go.uber.org/zap"
 )
 
+// ErrObjectNotFound is returned when a requested object doesn't exist
+var ErrObjectNotFound = errors.New("object not found")
+
 // StorageClient represents a Yandex Object Storage client (S3-compatible)
 type StorageClient struct {
 	s3Client   *s3.Client
@@ -113,11 +119,143 @@ func (s *StorageClient) UploadImage(ctx context.Context, imageData, key, content
 		zap.Int("size_bytes", len(imageBytes)),
 	)
 
-	// Construct public URL
-	// Format: https://storage.yandexcloud.net/{bucket}/{key}
-	imageURL := fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucketName, key)
+	return s.PublicURL(key), nil
+}
+
+// UploadPrivateObject uploads raw bytes with a private ACL, for files that must not be
+// served publicly (e.g. diagnostic profiles)
+func (s *StorageClient) UploadPrivateObject(ctx context.Context, key, contentType string, data []byte) error {
+	start := time.Now()
+	operation := "uploadPrivateObject"
+
+	_, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+		ACL:         types.ObjectCannedACLPrivate,
+	})
+
+	duration := metrics.MeasureDuration(start)
+
+	if err != nil {
+		metrics.YandexStorageRequestDuration.WithLabelValues(operation, "error").Observe(duration)
+		metrics.YandexStorageRequestTotal.WithLabelValues(operation, "error").Inc()
+		logger.LogAPICall(ctx, "yandex_storage", operation, "error", duration,
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return fmt.Errorf("failed to upload object to Yandex: %w", err)
+	}
+
+	metrics.YandexStorageRequestDuration.WithLabelValues(operation, "success").Observe(duration)
+	metrics.YandexStorageRequestTotal.WithLabelValues(operation, "success").Inc()
+	logger.LogAPICall(ctx, "yandex_storage", operation, "success", duration,
+		zap.String("key", key),
+		zap.Int("size_bytes", len(data)),
+	)
+
+	return nil
+}
+
+// UploadObject uploads raw bytes as a public object and returns its public URL
+func (s *StorageClient) UploadObject(ctx context.Context, key, contentType, cacheControl string, data []byte) (string, error) {
+	start := time.Now()
+	operation := "uploadObject"
+
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	}
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+	_, err := s.s3Client.PutObject(ctx, input)
+
+	duration := metrics.MeasureDuration(start)
+
+	if err != nil {
+		metrics.YandexStorageRequestDuration.WithLabelValues(operation, "error").Observe(duration)
+		metrics.YandexStorageRequestTotal.WithLabelValues(operation, "error").Inc()
+		logger.LogAPICall(ctx, "yandex_storage", operation, "error", duration,
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return "", fmt.Errorf("failed to upload object to Yandex: %w", err)
+	}
+
+	metrics.YandexStorageRequestDuration.WithLabelValues(operation, "success").Observe(duration)
+	metrics.YandexStorageRequestTotal.WithLabelValues(operation, "success").Inc()
+	logger.LogAPICall(ctx, "yandex_storage", operation, "success", duration,
+		zap.String("key", key),
+		zap.Int("size_bytes", len(data)),
+	)
+
+	return s.PublicURL(key), nil
+}
+
+// GetObject downloads an object. It returns ErrObjectNotFound when the key doesn't exist.
+func (s *StorageClient) GetObject(ctx context.Context, key string) ([]byte, error) {
+	start := time.Now()
+	operation := "getObject"
+
+	out, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		defer out.Body.Close()
+		var data []byte
+		data, err = io.ReadAll(out.Body)
+		if err == nil {
+			duration := metrics.MeasureDuration(start)
+			metrics.YandexStorageRequestDuration.WithLabelValues(operation, "success").Observe(duration)
+			metrics.YandexStorageRequestTotal.WithLabelValues(operation, "success").Inc()
+			return data, nil
+		}
+	}
+
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		metrics.YandexStorageRequestDuration.WithLabelValues(operation, "not_found").Observe(metrics.MeasureDuration(start))
+		metrics.YandexStorageRequestTotal.WithLabelValues(operation, "not_found").Inc()
+		return nil, ErrObjectNotFound
+	}
+	metrics.YandexStorageRequestDuration.WithLabelValues(operation, "error").Observe(metrics.MeasureDuration(start))
+	metrics.YandexStorageRequestTotal.WithLabelValues(operation, "error").Inc()
+	return nil, fmt.Errorf("failed to get object %s from Yandex: %w", key, err)
+}
+
+// ObjectExists reports whether an object with the key exists
+func (s *StorageClient) ObjectExists(ctx context.Context, key string) (bool, error) {
+	_, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		return true, nil
+	}
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to check object %s in Yandex: %w", key, err)
+}
+
+// Ping checks that the bucket exists and the credentials can access it
+func (s *StorageClient) Ping(ctx context.Context) error {
+	if _, err := s.s3Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.bucketName)}); err != nil {
+		return fmt.Errorf("bucket %s is not accessible: %w", s.bucketName, err)
+	}
+	return nil
+}
 
-	return imageURL, nil
+// PublicURL returns the public URL of an object
+// Format: https://storage.yandexcloud.net/{bucket}/{key}
+func (s *StorageClient) PublicURL(key string) string {
+	return fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucketName, key)
 }
 
 // ValidateImageType validates the image content type
@@ -402,6 +402,58 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "internal port equal to public port",
+			cfg: &config.Config{
+				Server: config.ServerConfig{
+					Port:           "8081",
+					BaseURL:        "https://example.com",
+					AllowedOrigins: []string{"https://example.com"},
+					InternalPort:   "8081",
+				},
+				Database: config.DatabaseConfig{
+					WorkOffline: true,
+				},
+				Auth: config.AuthConfig{
+					InternalMentorsAPI: "test-token",
+					MCPAuthToken:       "test-mcp-token",
+					MentorsAPIToken:    "public-token",
+				},
+				ReCAPTCHA: config.ReCAPTCHAConfig{
+					SecretKey: "recaptcha-secret",
+				},
+			},
+			expectError: true,
+			errorMsg:    "INTERNAL_PORT must differ from PORT",
+		},
+		{
+			name: "partner audit sample rate out of range",
+			cfg: &config.Config{
+				Server: config.ServerConfig{
+					Port:           "8081",
+					BaseURL:        "https://example.com",
+					AllowedOrigins: []string{"https://example.com"},
+				},
+				Database: config.DatabaseConfig{
+					WorkOffline: true,
+				},
+				Auth: config.AuthConfig{
+					InternalMentorsAPI: "test-token",
+					MCPAuthToken:       "test-mcp-token",
+					MentorsAPIToken:    "public-token",
+				},
+				ReCAPTCHA: config.ReCAPTCHAConfig{
+					SecretKey: "recaptcha-secret",
+				},
+				PartnerAudit: config.PartnerAuditConfig{
+					Enabled:       true,
+					SampleRate:    1.5,
+					RetentionDays: 30,
+				},
+			},
+			expectError: true,
+			errorMsg:    "PARTNER_AUDIT_SAMPLE_RATE",
+		},
 	}
 
 	for _, tt := range tests {
@@ -515,3 +567,81 @@ func TestLoad_ValidationFailure(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, cfg)
 }
+
+func TestConfig_ValidateLeaderboardPeriods(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Port:           "8081",
+			BaseURL:        "https://example.com",
+			AllowedOrigins: []string{"https://example.com"},
+		},
+		Database: config.DatabaseConfig{WorkOffline: true},
+		Auth: config.AuthConfig{
+			InternalMentorsAPI: "test-token",
+			MCPAuthToken:       "test-mcp-token",
+			MentorsAPIToken:    "public-token",
+		},
+		ReCAPTCHA:   config.ReCAPTCHAConfig{SecretKey: "recaptcha-secret"},
+		Leaderboard: config.LeaderboardConfig{Periods: []string{"30d", "all"}, Limit: 20, RefreshMinutes: 60},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Leaderboard.Periods = []string{"30d", "month"}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "LEADERBOARD_PERIODS")
+}
+
+func TestConfig_ValidateDataSource(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Port:           "8081",
+			BaseURL:        "https://example.com",
+			AllowedOrigins: []string{"https://example.com"},
+		},
+		Database: config.DatabaseConfig{WorkOffline: true},
+		Auth: config.AuthConfig{
+			InternalMentorsAPI: "test-token",
+			MCPAuthToken:       "test-mcp-token",
+			MentorsAPIToken:    "public-token",
+		},
+		ReCAPTCHA: config.ReCAPTCHAConfig{SecretKey: "recaptcha-secret"},
+		Cache:     config.CacheConfig{DataSource: "postgres"},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Cache.DataSource = "airtable"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "AIRTABLE_SYNC_BASE_ID")
+
+	cfg.AirtableSync = config.AirtableSyncConfig{BaseID: "appBase", Token: "token"}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Cache.DataSource = "sheets"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_ValidateDualWrite(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Port:           "8081",
+			BaseURL:        "https://example.com",
+			AllowedOrigins: []string{"https://example.com"},
+		},
+		Database: config.DatabaseConfig{WorkOffline: true},
+		Auth: config.AuthConfig{
+			InternalMentorsAPI: "test-token",
+			MCPAuthToken:       "test-mcp-token",
+			MentorsAPIToken:    "public-token",
+		},
+		ReCAPTCHA:    config.ReCAPTCHAConfig{SecretKey: "recaptcha-secret"},
+		AirtableSync: config.AirtableSyncConfig{DualWrite: true},
+	}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "AIRTABLE_DUAL_WRITE")
+
+	cfg.AirtableSync.BaseID, cfg.AirtableSync.Token = "appBase", "token"
+	assert.NoError(t, cfg.Validate())
+}
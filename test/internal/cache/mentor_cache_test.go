@@ -0,0 +1,158 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentorCache_ServesLastKnownListWhileRevalidating(t *testing.T) {
+	var failing atomic.Bool
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		if failing.Load() {
+			return nil, errors.New("data source down")
+		}
+		return []*models.Mentor{{Slug: "ann"}, {Slug: "bob"}}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		return &models.Mentor{Slug: slug}, nil
+	}
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 1)
+	require.NoError(t, mc.Initialize())
+	assert.False(t, mc.IsStale())
+
+	// The list expires while the data source is down
+	failing.Store(true)
+	time.Sleep(1100 * time.Millisecond)
+
+	mentors, err := mc.Get()
+	require.NoError(t, err)
+	assert.Len(t, mentors, 2)
+	stale, since := mc.Staleness()
+	assert.True(t, stale)
+	assert.False(t, since.IsZero())
+
+	// Once the data source is back, a revalidation clears the flag
+	failing.Store(false)
+	_, err = mc.Get()
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return !mc.IsStale() }, 2*time.Second, 20*time.Millisecond)
+}
+
+func TestMentorCache_FiltersByTagAndExperienceIndexes(t *testing.T) {
+	all := []*models.Mentor{
+		{Slug: "ann", Tags: []string{"Go", "Management"}, Experience: "10+", MenteeCount: 1},
+		{Slug: "bob", Tags: []string{"Python"}, Experience: "5-10", MenteeCount: 9},
+		{Slug: "eve", Tags: []string{"go"}, Experience: "5-10", MenteeCount: 5},
+	}
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) { return all, nil }
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) { return nil, errors.New("unused") }
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 60)
+	require.NoError(t, mc.Initialize())
+
+	byTag, err := mc.GetByTag("GO")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ann", "eve"}, mentorSlugs(byTag))
+
+	byExperience, err := mc.GetByExperience("5-10")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "eve"}, mentorSlugs(byExperience))
+
+	// Any of the tags, intersected with the experience level, in the requested order
+	filtered, err := mc.GetFiltered(models.MentorSortSessionsDesc, []string{"go", "python"}, "5-10")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "eve"}, mentorSlugs(filtered))
+
+	none, err := mc.GetByTag("rust")
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func mentorSlugs(mentors []*models.Mentor) []string {
+	slugs := make([]string, len(mentors))
+	for i, mentor := range mentors {
+		slugs[i] = mentor.Slug
+	}
+	return slugs
+}
+
+func TestMentorCache_StatusCountsLookups(t *testing.T) {
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		return []*models.Mentor{{Slug: "ann", Tags: []string{"Go"}}, {Slug: "bob", Tags: []string{"Python"}}}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) { return nil, errors.New("unused") }
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 60)
+	require.NoError(t, mc.Initialize())
+
+	_, err := mc.GetBySlug("ann")
+	require.NoError(t, err)
+	_, err = mc.GetBySlug("missing")
+	require.Error(t, err)
+
+	status := mc.Status()
+	assert.True(t, status.Ready)
+	assert.Equal(t, 60, status.TTLSeconds)
+	assert.Equal(t, 2, status.MentorCount)
+	assert.Equal(t, 2, status.ListedCount)
+	assert.Equal(t, 2, status.TagCount)
+	assert.False(t, status.Expired)
+	assert.False(t, status.Stale)
+	require.NotNil(t, status.LastRefreshTime)
+	assert.Equal(t, models.CacheLookupStats{Hits: 1, Misses: 1, HitRatio: 0.5}, status.Lookups["mentor_by_slug"])
+}
+
+// An update after a write must not reuse a fetch that read the mentor before the write
+func TestMentorCache_UpdateSingleMentorSkipsStaleFetch(t *testing.T) {
+	var name atomic.Value
+	name.Store("old")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var fetches atomic.Int32
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		return []*models.Mentor{{Slug: "ann", Name: "old"}}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		mentor := &models.Mentor{Slug: slug, Name: name.Load().(string)}
+		if fetches.Add(1) == 1 {
+			// The first fetch read the row before the write and is slow to return
+			close(started)
+			<-release
+		}
+		return mentor, nil
+	}
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 60)
+	require.NoError(t, mc.Initialize())
+
+	staleDone := make(chan error, 1)
+	go func() { staleDone <- mc.UpdateSingleMentor("ann") }()
+	<-started
+
+	// The write commits, then its update runs while the stale fetch is still in flight
+	name.Store("new")
+	freshDone := make(chan error, 1)
+	go func() { freshDone <- mc.UpdateSingleMentor("ann") }()
+	require.NoError(t, <-freshDone, "the update must not wait for the stale fetch")
+
+	mentor, err := mc.GetBySlug("ann")
+	require.NoError(t, err)
+	assert.Equal(t, "new", mentor.Name)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	// The stale fetch finishing last doesn't overwrite the newer mentor
+	close(release)
+	require.NoError(t, <-staleDone)
+	mentor, err = mc.GetBySlug("ann")
+	require.NoError(t, err)
+	assert.Equal(t, "new", mentor.Name)
+}
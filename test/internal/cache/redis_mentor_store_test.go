@@ -0,0 +1,238 @@
+package cache_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/getmentor/getmentor-api/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+	metrics.Init("test")
+}
+
+// fakeRedis answers GET, SET, MGET, MSET and DEL from a map; TTLs are ignored
+type fakeRedis struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func startFakeRedis(t *testing.T) (*fakeRedis, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeRedis{data: map[string]string{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server, "redis://" + listener.Addr().String()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		count, _ := strconv.Atoi(strings.TrimSpace(header[1:]))
+		args := make([]string, count)
+		for i := range args {
+			sizeLine, _ := reader.ReadString('\n')
+			size, _ := strconv.Atoi(strings.TrimSpace(sizeLine[1:]))
+			buf := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, buf); err != nil {
+				return
+			}
+			args[i] = string(buf[:size])
+		}
+		_, _ = conn.Write([]byte(f.handle(args)))
+	}
+}
+
+func bulk(value string, ok bool) string {
+	if !ok {
+		return "$-1\r\n"
+	}
+	return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+}
+
+func (f *fakeRedis) handle(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "GET":
+		value, ok := f.data[args[1]]
+		return bulk(value, ok)
+	case "SET":
+		f.data[args[1]] = args[2]
+		return "+OK\r\n"
+	case "MSET":
+		for i := 1; i+1 < len(args); i += 2 {
+			f.data[args[i]] = args[i+1]
+		}
+		return "+OK\r\n"
+	case "MGET":
+		reply := "*" + strconv.Itoa(len(args)-1) + "\r\n"
+		for _, key := range args[1:] {
+			value, ok := f.data[key]
+			reply += bulk(value, ok)
+		}
+		return reply
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := f.data[key]; ok {
+				delete(f.data, key)
+				deleted++
+			}
+		}
+		return ":" + strconv.Itoa(deleted) + "\r\n"
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func newRedisStore(t *testing.T) (cache.MentorStore, *fakeRedis) {
+	server, url := startFakeRedis(t)
+	client, err := redis.NewClient(url)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return cache.NewRedisMentorStore(client, "test:"), server
+}
+
+func TestRedisMentorStore_RoundTripsInternalFields(t *testing.T) {
+	store, _ := newRedisStore(t)
+
+	airtableID := "rec123"
+	chatID := int64(42)
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store.SetMentors([]*models.Mentor{
+		{Slug: "ann", Name: "Ann", Tags: []string{"Go"}, AirtableID: &airtableID, TelegramChatID: &chatID, CreatedAt: created},
+		{Slug: "bob", Name: "Bob"},
+	})
+
+	mentor, found := store.GetMentor("ann")
+	require.True(t, found)
+	assert.Equal(t, "Ann", mentor.Name)
+	assert.Equal(t, []string{"Go"}, mentor.Tags)
+	require.NotNil(t, mentor.AirtableID)
+	assert.Equal(t, airtableID, *mentor.AirtableID)
+	require.NotNil(t, mentor.TelegramChatID)
+	assert.Equal(t, chatID, *mentor.TelegramChatID)
+	assert.True(t, created.Equal(mentor.CreatedAt))
+
+	mentors := store.GetMentors([]string{"bob", "missing", "ann"})
+	require.Len(t, mentors, 2)
+	assert.Equal(t, "bob", mentors[0].Slug)
+	assert.Equal(t, "ann", mentors[1].Slug)
+}
+
+func TestMentorCache_ReplicasShareRedisStore(t *testing.T) {
+	store, server := newRedisStore(t)
+
+	names := map[string]string{"ann": "Ann"}
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		return []*models.Mentor{{Slug: "ann", Name: names["ann"]}}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		return &models.Mentor{Slug: slug, Name: names[slug]}, nil
+	}
+
+	first := cache.NewMentorCacheWithStore(store, fetchAll, fetchOne, 600)
+	second := cache.NewMentorCacheWithStore(store, fetchAll, fetchOne, 600)
+	require.NoError(t, first.Initialize())
+	require.NoError(t, second.Initialize())
+
+	// An update through one replica is read by the other at once
+	names["ann"] = "Ann Updated"
+	require.NoError(t, first.UpdateSingleMentor("ann"))
+
+	mentor, err := second.GetBySlug("ann")
+	require.NoError(t, err)
+	assert.Equal(t, "Ann Updated", mentor.Name)
+
+	server.mu.Lock()
+	_, listed := server.data["test:mentor:all"]
+	server.mu.Unlock()
+	assert.True(t, listed)
+}
+
+func TestMentorCache_ReplicasRebuildIndexesFromSharedStore(t *testing.T) {
+	store, _ := newRedisStore(t)
+
+	var mu sync.Mutex
+	tags := map[string][]string{"ann": {"Go"}}
+	mentor := func(slug string) *models.Mentor {
+		mu.Lock()
+		defer mu.Unlock()
+		return &models.Mentor{Slug: slug, Name: slug, Tags: tags[slug]}
+	}
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		return []*models.Mentor{mentor("ann")}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		return mentor(slug), nil
+	}
+
+	first := cache.NewMentorCacheWithStore(store, fetchAll, fetchOne, 600)
+	second := cache.NewMentorCacheWithStore(store, fetchAll, fetchOne, 600)
+	require.NoError(t, first.Initialize())
+	require.NoError(t, second.Initialize())
+
+	// One replica retags a mentor and adds another; the other rebuilds its indexes from the
+	// stored list once it sees the stored revision moved on
+	mu.Lock()
+	tags["ann"], tags["bob"] = []string{"Rust"}, []string{"Rust"}
+	mu.Unlock()
+	require.NoError(t, first.UpdateSingleMentor("ann"))
+	require.NoError(t, first.UpdateSingleMentor("bob"))
+	time.Sleep(1100 * time.Millisecond)
+
+	mentors, err := second.GetByTag("rust")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ann", "bob"}, slugsOf(mentors))
+
+	mentors, err = second.GetByTag("go")
+	require.NoError(t, err)
+	assert.Empty(t, mentors)
+
+	mentors, err = second.GetSorted(models.PresortedMentorSorts[0])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ann", "bob"}, slugsOf(mentors))
+}
+
+func slugsOf(mentors []*models.Mentor) []string {
+	slugs := make([]string, len(mentors))
+	for i, m := range mentors {
+		slugs[i] = m.Slug
+	}
+	return slugs
+}
@@ -0,0 +1,58 @@
+package cache_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshPolicy_JitterStaysWithinBounds(t *testing.T) {
+	policy := cache.RefreshPolicy{Jitter: 0.2}
+	spread := map[time.Duration]bool{}
+	for i := 0; i < 200; i++ {
+		delay := policy.Delay(100*time.Second, 0)
+		assert.GreaterOrEqual(t, delay, 80*time.Second)
+		assert.LessOrEqual(t, delay, 120*time.Second)
+		spread[delay] = true
+	}
+	assert.Greater(t, len(spread), 1, "replicas must not all wait the same")
+
+	assert.Equal(t, 100*time.Second, cache.RefreshPolicy{}.Delay(100*time.Second, 0))
+}
+
+func TestRefreshPolicy_BacksOffUpToMax(t *testing.T) {
+	policy := cache.RefreshPolicy{MaxBackoff: 5 * time.Minute}
+
+	assert.Equal(t, time.Minute, policy.Delay(time.Minute, 0))
+	assert.Equal(t, 2*time.Minute, policy.Delay(time.Minute, 1))
+	assert.Equal(t, 4*time.Minute, policy.Delay(time.Minute, 2))
+	assert.Equal(t, 5*time.Minute, policy.Delay(time.Minute, 3))
+	assert.Equal(t, 5*time.Minute, policy.Delay(time.Minute, 100))
+
+	// Without a max backoff a failing source is retried every interval
+	assert.Equal(t, time.Minute, cache.RefreshPolicy{}.Delay(time.Minute, 10))
+}
+
+func TestTagsCache_DisabledRefreshLoadsOnce(t *testing.T) {
+	var calls atomic.Int32
+	fetcher := func(ctx context.Context) (map[string]string, error) {
+		calls.Add(1)
+		return map[string]string{"Go": "t1"}, nil
+	}
+
+	tc := cache.NewTagsCache(fetcher, 1)
+	tc.SetRefreshPolicy(cache.RefreshPolicy{Disabled: true})
+	require.NoError(t, tc.Initialize())
+
+	time.Sleep(1500 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Explicit reloads still work
+	require.NoError(t, tc.Reload())
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,90 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mentors.json")
+	refreshedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mentors := []*models.Mentor{
+		{Slug: "ann", Name: "Ann", Tags: []string{"Go"}, Experience: "10+", MenteeCount: 3},
+		{Slug: "bob", Name: "Bob"},
+	}
+
+	require.NoError(t, cache.WriteSnapshot(path, mentors, refreshedAt))
+
+	loaded, loadedAt, err := cache.ReadSnapshot(path)
+	require.NoError(t, err)
+	assert.True(t, refreshedAt.Equal(loadedAt))
+	require.Len(t, loaded, 2)
+	assert.Equal(t, "ann", loaded[0].Slug)
+	assert.Equal(t, []string{"Go"}, loaded[0].Tags)
+	assert.Equal(t, 3, loaded[0].MenteeCount)
+	assert.Equal(t, "Bob", loaded[1].Name)
+}
+
+func TestSnapshot_ReadMissingFile(t *testing.T) {
+	_, _, err := cache.ReadSnapshot(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestMentorCache_StartsFromSnapshotWhenDataSourceIsDown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mentors.json")
+	refreshedAt := time.Now().Add(-time.Hour).UTC()
+	require.NoError(t, cache.WriteSnapshot(path, []*models.Mentor{{Slug: "ann"}, {Slug: "bob"}}, refreshedAt))
+
+	var failing atomic.Bool
+	failing.Store(true)
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		if failing.Load() {
+			return nil, errors.New("data source down")
+		}
+		return []*models.Mentor{{Slug: "ann"}, {Slug: "bob"}, {Slug: "eve"}}, nil
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		return nil, errors.New("unused")
+	}
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 60)
+	mc.EnableSnapshots(path, time.Hour)
+	require.NoError(t, mc.Initialize())
+
+	mentors, err := mc.Get()
+	require.NoError(t, err)
+	assert.Len(t, mentors, 2)
+	assert.True(t, mc.IsStale())
+	status := mc.Status()
+	assert.True(t, status.FromSnapshot)
+
+	// The background retry picks the data source up once it is back
+	failing.Store(false)
+	assert.Eventually(t, func() bool { return !mc.IsStale() }, 5*time.Second, 50*time.Millisecond)
+	mentors, err = mc.Get()
+	require.NoError(t, err)
+	assert.Len(t, mentors, 3)
+	assert.False(t, mc.Status().FromSnapshot)
+}
+
+func TestMentorCache_InitializeFailsWithoutSnapshot(t *testing.T) {
+	fetchAll := func(ctx context.Context) ([]*models.Mentor, error) {
+		return nil, errors.New("data source down")
+	}
+	fetchOne := func(ctx context.Context, slug string) (*models.Mentor, error) {
+		return nil, errors.New("unused")
+	}
+
+	mc := cache.NewMentorCache(fetchAll, fetchOne, 60)
+	mc.EnableSnapshots(filepath.Join(t.TempDir(), "missing.json"), time.Hour)
+	assert.Error(t, mc.Initialize())
+}
@@ -0,0 +1,53 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsCache_RefreshesInBackground(t *testing.T) {
+	var calls atomic.Int32
+	fetcher := func(ctx context.Context) (map[string]string, error) {
+		if calls.Add(1) == 1 {
+			return map[string]string{"Go": "t1"}, nil
+		}
+		return map[string]string{"Go": "t1", "Rust": "t2"}, nil
+	}
+
+	tc := cache.NewTagsCache(fetcher, 1)
+	require.NoError(t, tc.Initialize())
+	id, err := tc.GetTagIDByName("Rust")
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	assert.Eventually(t, func() bool {
+		id, _ := tc.GetTagIDByName("Rust")
+		return id == "t2"
+	}, 3*time.Second, 50*time.Millisecond)
+}
+
+func TestTagsCache_KeepsTagsWhenReloadFails(t *testing.T) {
+	var failing atomic.Bool
+	fetcher := func(ctx context.Context) (map[string]string, error) {
+		if failing.Load() {
+			return nil, errors.New("data source down")
+		}
+		return map[string]string{"Go": "t1"}, nil
+	}
+
+	tc := cache.NewTagsCache(fetcher, 0)
+	require.NoError(t, tc.Initialize())
+
+	failing.Store(true)
+	assert.Error(t, tc.Reload())
+	tags, err := tc.Get()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Go": "t1"}, tags)
+}
@@ -0,0 +1,134 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/database/postgres"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+type fakePool struct {
+	err    error
+	pings  int
+	resets int
+}
+
+func (p *fakePool) Ping(context.Context) error {
+	p.pings++
+	return p.err
+}
+
+func (p *fakePool) Reset() {
+	p.resets++
+}
+
+func newTestSupervisor(pool *fakePool) *postgres.Supervisor {
+	return postgres.NewSupervisor(pool, postgres.SupervisorConfig{
+		Interval:         time.Second,
+		PingTimeout:      time.Second,
+		FailureThreshold: 3,
+	})
+}
+
+func TestSupervisor_StaysReadyBelowThreshold(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	supervisor := newTestSupervisor(pool)
+
+	supervisor.Check(context.Background())
+	supervisor.Check(context.Background())
+
+	assert.True(t, supervisor.Ready())
+	assert.Equal(t, 0, pool.resets)
+}
+
+func TestSupervisor_ResetsPoolOnPersistentFailures(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	supervisor := newTestSupervisor(pool)
+
+	for i := 0; i < 3; i++ {
+		supervisor.Check(context.Background())
+	}
+	assert.False(t, supervisor.Ready())
+	assert.Equal(t, 1, pool.resets)
+
+	// The reset is repeated while the database stays down
+	for i := 0; i < 3; i++ {
+		supervisor.Check(context.Background())
+	}
+	assert.Equal(t, 2, pool.resets)
+}
+
+func TestSupervisor_RecoversAfterSuccessfulPing(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	supervisor := newTestSupervisor(pool)
+	for i := 0; i < 3; i++ {
+		supervisor.Check(context.Background())
+	}
+
+	pool.err = nil
+	supervisor.Check(context.Background())
+	assert.True(t, supervisor.Ready())
+
+	// A failure after recovery starts counting from zero again
+	pool.err = errors.New("connection refused")
+	supervisor.Check(context.Background())
+	assert.True(t, supervisor.Ready())
+	assert.Equal(t, 1, pool.resets)
+}
+
+func TestSupervisor_ResetIsReportedInMetrics(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	supervisor := newTestSupervisor(pool)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.DBReady))
+	resets := testutil.ToFloat64(metrics.DBPoolResets)
+
+	for i := 0; i < 3; i++ {
+		supervisor.Check(context.Background())
+	}
+	assert.Equal(t, 3, pool.pings)
+	assert.Equal(t, 1, pool.resets)
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.DBReady))
+	assert.Equal(t, resets+1, testutil.ToFloat64(metrics.DBPoolResets))
+
+	pool.err = nil
+	supervisor.Check(context.Background())
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.DBReady))
+	assert.Equal(t, 1, pool.resets, "a successful ping doesn't reset the pool")
+}
+
+// A pool opened while the database was down starts not ready, without waiting for the threshold
+func TestSupervisor_StartsNotReadyWhenUnreachable(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	supervisor := postgres.NewSupervisor(pool, postgres.SupervisorConfig{
+		Interval:         time.Second,
+		PingTimeout:      time.Second,
+		FailureThreshold: 3,
+		Unreachable:      true,
+	})
+	assert.False(t, supervisor.Ready())
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.DBReady))
+
+	supervisor.Check(context.Background())
+	assert.False(t, supervisor.Ready())
+
+	pool.err = nil
+	supervisor.Check(context.Background())
+	assert.True(t, supervisor.Ready())
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.DBReady))
+	assert.Equal(t, 0, pool.resets)
+}
@@ -0,0 +1,50 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMentorID  = "6f1c2b8e-3f4a-4c2d-9e1b-7a8d9c0e1f2a"
+	testRequestID = "0b7e6d5c-4a3b-4c2d-8e1f-9a8b7c6d5e4f"
+)
+
+func TestValidate_AcceptsEmittedPayloads(t *testing.T) {
+	payloads := []events.Payload{
+		events.MentorUpdated{MentorID: testMentorID, Slug: "ivan", Status: "active", ChangedFields: []string{"price"}, Actor: events.ActorMentor},
+		events.RequestCreated{RequestID: testRequestID, MentorID: testMentorID, Level: "Middle"},
+		events.RequestStatusChanged{RequestID: testRequestID, MentorID: testMentorID, FromStatus: "pending", ToStatus: "declined", DeclineReason: "no_time"},
+		// Backfilled status changes don't know the previous status
+		events.RequestStatusChanged{RequestID: testRequestID, MentorID: testMentorID, ToStatus: "done"},
+	}
+	for _, payload := range payloads {
+		data, err := events.Validate(payload)
+		require.NoError(t, err, payload.EventType())
+		assert.True(t, json.Valid(data))
+	}
+}
+
+func TestValidate_RejectsDrift(t *testing.T) {
+	_, err := events.Validate(events.RequestStatusChanged{RequestID: testRequestID, MentorID: testMentorID, FromStatus: "pending", ToStatus: "archived"})
+	assert.ErrorIs(t, err, events.ErrInvalidPayload)
+
+	_, err = events.Validate(events.MentorUpdated{MentorID: "42", Slug: "ivan", Status: "active", Actor: events.ActorAdmin})
+	assert.ErrorIs(t, err, events.ErrInvalidPayload)
+}
+
+func TestSchemas_CoverRequestStatuses(t *testing.T) {
+	for _, status := range models.AllStatuses {
+		_, err := events.Validate(events.RequestStatusChanged{RequestID: testRequestID, MentorID: testMentorID, FromStatus: string(status), ToStatus: string(status)})
+		assert.NoError(t, err, status)
+	}
+
+	schemas := events.Schemas()
+	assert.NotEmpty(t, schemas.Envelope)
+	assert.Len(t, schemas.Events, 3)
+}
@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAdminMentorsService implements AdminMentorsServiceInterface for testing
+type MockAdminMentorsService struct {
+	mock.Mock
+}
+
+func (m *MockAdminMentorsService) ListMentors(ctx context.Context, session *models.AdminSession, filter models.MentorModerationFilter) ([]models.AdminMentorListItem, error) {
+	args := m.Called(ctx, session, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.AdminMentorListItem), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) GetMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error) {
+	args := m.Called(ctx, session, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) UpdateMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, req *models.AdminMentorProfileUpdateRequest) (*models.AdminMentorDetails, error) {
+	args := m.Called(ctx, session, mentorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) PatchMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, patch models.MergePatch) (*models.AdminMentorDetails, []models.ProfileFieldChange, error) {
+	args := m.Called(ctx, session, mentorID, patch)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Get(1).([]models.ProfileFieldChange), args.Error(2)
+}
+
+func (m *MockAdminMentorsService) JSONPatchMentorProfile(ctx context.Context, session *models.AdminSession, mentorID string, patch models.JSONPatch) (*models.AdminMentorDetails, []models.ProfileFieldChange, error) {
+	args := m.Called(ctx, session, mentorID, patch)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Get(1).([]models.ProfileFieldChange), args.Error(2)
+}
+
+func (m *MockAdminMentorsService) ApproveMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error) {
+	args := m.Called(ctx, session, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) DeclineMentor(ctx context.Context, session *models.AdminSession, mentorID string) (*models.AdminMentorDetails, error) {
+	args := m.Called(ctx, session, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) UpdateMentorStatus(ctx context.Context, session *models.AdminSession, mentorID string, status string) (*models.AdminMentorDetails, error) {
+	args := m.Called(ctx, session, mentorID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AdminMentorDetails), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) UploadMentorPicture(ctx context.Context, session *models.AdminSession, mentorID string, req *models.UploadProfilePictureRequest) (string, error) {
+	args := m.Called(ctx, session, mentorID, req)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAdminMentorsService) DeleteMentor(ctx context.Context, session *models.AdminSession, mentorID string) error {
+	args := m.Called(ctx, session, mentorID)
+	return args.Error(0)
+}
+
+// MockMentorMergeService implements MentorMergeServiceInterface for testing
+type MockMentorMergeService struct {
+	mock.Mock
+}
+
+func (m *MockMentorMergeService) MergeMentors(ctx context.Context, session *models.AdminSession, req *models.MergeMentorsRequest) (*models.MentorMergeResult, error) {
+	args := m.Called(ctx, session, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MentorMergeResult), args.Error(1)
+}
+
+// dryRunContext matches the context the service gets for ?dryRun=true, or without it
+func dryRunContext(dryRun bool) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool { return services.IsDryRun(ctx) == dryRun })
+}
+
+func TestAdminMentorsHandler_DeleteMentorDryRun(t *testing.T) {
+	service := new(MockAdminMentorsService)
+	service.On("DeleteMentor", dryRunContext(true), mock.Anything, "mentor-1").Return(nil).Once()
+	service.On("DeleteMentor", dryRunContext(false), mock.Anything, "mentor-1").Return(nil).Once()
+
+	router := gin.New()
+	router.DELETE("/admin/mentors/:id", withAdminSession("moderator-1"), handlers.NewAdminMentorsHandler(service).DeleteMentor)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/admin/mentors/mentor-1?dryRun=true", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success":true,"dryRun":true}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/admin/mentors/mentor-1", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success":true}`, w.Body.String())
+
+	service.AssertExpectations(t)
+}
+
+func TestMentorMergeHandler_MergeMentorsDryRun(t *testing.T) {
+	const primaryID = "11111111-1111-1111-1111-111111111111"
+	const duplicateID = "22222222-2222-2222-2222-222222222222"
+	service := new(MockMentorMergeService)
+	service.On("MergeMentors", dryRunContext(true), mock.Anything, &models.MergeMentorsRequest{PrimaryID: primaryID, DuplicateID: duplicateID}).
+		Return(&models.MentorMergeResult{PrimaryID: primaryID, DuplicateID: duplicateID, TagsAdded: 2}, nil).Once()
+
+	router := gin.New()
+	router.POST("/admin/mentors/merge", withAdminSession("moderator-1"), handlers.NewMentorMergeHandler(service).MergeMentors)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/mentors/merge?dryRun=true",
+		bytes.NewBufferString(`{"primaryId":"`+primaryID+`","duplicateId":"`+duplicateID+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Merge  models.MentorMergeResult `json:"merge"`
+		DryRun bool                     `json:"dryRun"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, int64(2), resp.Merge.TagsAdded)
+	service.AssertExpectations(t)
+}
+
+func TestAdminMentorsHandler_JSONPatchMissingTarget(t *testing.T) {
+	service := new(MockAdminMentorsService)
+	service.On("JSONPatchMentorProfile", mock.Anything, mock.Anything, "mentor-1", mock.Anything).
+		Return(nil, nil, fmt.Errorf("operation 0: %w: /tags/3", models.ErrJSONPatchPathNotFound)).Once()
+
+	router := gin.New()
+	router.PATCH("/admin/mentors/:id", withAdminSession("moderator-1"), handlers.NewAdminMentorsHandler(service).PatchMentor)
+
+	req := httptest.NewRequest(http.MethodPatch, "/admin/mentors/mentor-1",
+		bytes.NewBufferString(`[{"op": "replace", "path": "/tags/3", "value": "Go"}]`))
+	req.Header.Set("Content-Type", models.JSONPatchContentType)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	service.AssertExpectations(t)
+}
@@ -0,0 +1,71 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBotHeartbeatService implements BotHeartbeatServiceInterface for testing
+type MockBotHeartbeatService struct {
+	mock.Mock
+}
+
+func (m *MockBotHeartbeatService) RecordHeartbeat(ctx context.Context, req *models.BotHeartbeatRequest) (*models.BotStatus, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BotStatus), args.Error(1)
+}
+
+func (m *MockBotHeartbeatService) Status(ctx context.Context) (*models.BotStatus, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BotStatus), args.Error(1)
+}
+
+func postHeartbeat(service *MockBotHeartbeatService, body string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.POST("/api/v1/bot/heartbeat", handlers.NewBotHeartbeatHandler(service).Heartbeat)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/heartbeat", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestBotHeartbeatHandler_RecordsHeartbeat(t *testing.T) {
+	lastUpdate := time.Date(2026, 5, 1, 11, 59, 0, 0, time.UTC)
+	service := new(MockBotHeartbeatService)
+	service.On("RecordHeartbeat", mock.Anything, mock.MatchedBy(func(req *models.BotHeartbeatRequest) bool {
+		return req.Version == "2.4.1" && req.LastUpdateAt != nil && req.LastUpdateAt.Equal(lastUpdate)
+	})).Return(&models.BotStatus{Heartbeat: &models.BotHeartbeat{Version: "2.4.1"}, SilentAfterMinutes: 10}, nil)
+
+	w := postHeartbeat(service, `{"version": "2.4.1", "lastUpdateAt": "2026-05-01T11:59:00Z"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"silentAfterMinutes":10`)
+	service.AssertExpectations(t)
+}
+
+func TestBotHeartbeatHandler_RequiresVersion(t *testing.T) {
+	service := new(MockBotHeartbeatService)
+
+	w := postHeartbeat(service, `{"lastUpdateAt": "2026-05-01T11:59:00Z"}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	service.AssertNotCalled(t, "RecordHeartbeat", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,82 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCohortService implements CohortServiceInterface for testing. Only public
+// enrollment is exercised; the rest satisfy the interface.
+type MockCohortService struct {
+	mock.Mock
+	services.CohortServiceInterface
+}
+
+func (m *MockCohortService) EnrollMentee(ctx context.Context, cohortID string, req *models.CohortEnrollRequest) (*models.CohortEnrollResponse, error) {
+	args := m.Called(ctx, cohortID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CohortEnrollResponse), args.Error(1)
+}
+
+const validEnrollBody = `{"name":"Ivan","email":"ivan@example.com","recaptchaToken":"token-token-token-token"}`
+
+func TestCohortHandler_Enroll(t *testing.T) {
+	tests := []struct {
+		name       string
+		resp       *models.CohortEnrollResponse
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "enrolled",
+			resp:       &models.CohortEnrollResponse{Success: true, ParticipantID: "p-1"},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "captcha failed",
+			resp:       &models.CohortEnrollResponse{Success: false, Error: "Captcha verification failed"},
+			err:        errors.New("captcha verification failed"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "full",
+			err:        services.ErrCohortFull,
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCohortService)
+			handler := handlers.NewCohortHandler(mockService)
+
+			router := gin.New()
+			router.POST("/cohorts/:id/enroll", handler.Enroll)
+
+			if tt.resp != nil {
+				mockService.On("EnrollMentee", mock.Anything, "c-1", mock.Anything).Return(tt.resp, tt.err)
+			} else {
+				mockService.On("EnrollMentee", mock.Anything, "c-1", mock.Anything).Return(nil, tt.err)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/cohorts/c-1/enroll", strings.NewReader(validEnrollBody)))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
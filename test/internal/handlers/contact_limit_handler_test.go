@@ -0,0 +1,122 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockContactLimitService implements ContactLimitServiceInterface for testing
+type MockContactLimitService struct {
+	mock.Mock
+}
+
+func (m *MockContactLimitService) ListCounters(ctx context.Context, session *models.AdminSession, kind, subject string) (*models.ContactLimitsResponse, error) {
+	args := m.Called(ctx, session, kind, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ContactLimitsResponse), args.Error(1)
+}
+
+func (m *MockContactLimitService) ResetCounter(ctx context.Context, session *models.AdminSession, kind, subject string) error {
+	args := m.Called(ctx, session, kind, subject)
+	return args.Error(0)
+}
+
+func contactLimitsRouter(service *MockContactLimitService) *gin.Engine {
+	handler := handlers.NewContactLimitHandler(service)
+	router := gin.New()
+	router.GET("/admin/contact-limits", withAdminSession("moderator-1"), handler.ListCounters)
+	router.DELETE("/admin/contact-limits", withAdminSession("moderator-1"), handler.ResetCounter)
+	return router
+}
+
+func TestContactLimitHandler_ListsCounters(t *testing.T) {
+	service := new(MockContactLimitService)
+	service.On("ListCounters", mock.Anything, mock.Anything, "email", "").Return(&models.ContactLimitsResponse{
+		Counters: []*models.ContactLimitCounter{
+			{Kind: "email", Subject: "spam@example.com", Submissions: 12.5, Limit: 10, Limited: true},
+		},
+		Total:         1,
+		WindowMinutes: 1440,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	contactLimitsRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/contact-limits?kind=email", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp models.ContactLimitsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Counters, 1)
+	assert.True(t, resp.Counters[0].Limited)
+	assert.Equal(t, 1440, resp.WindowMinutes)
+}
+
+func TestContactLimitHandler_ResetErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"reset", nil, http.StatusOK},
+		{"not_admin", services.ErrAdminForbiddenAction, http.StatusForbidden},
+		{"nothing_counted", services.ErrContactLimitNotFound, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockContactLimitService)
+			service.On("ResetCounter", mock.Anything, mock.Anything, "ip", "203.0.113.7").Return(tt.err)
+
+			w := httptest.NewRecorder()
+			contactLimitsRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/admin/contact-limits?kind=ip&subject=203.0.113.7", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestContactHandler_ContactMentor_RateLimited(t *testing.T) {
+	mockService := new(MockContactService)
+	handler := handlers.NewContactHandler(mockService)
+
+	router := gin.New()
+	router.POST("/contact", handler.ContactMentor)
+
+	mockService.On("SubmitContactForm", mock.Anything, mock.Anything).Return(
+		&models.ContactMentorResponse{Success: false, Error: "Too many requests, try again later"},
+		fmt.Errorf("%w: email over 10 per 24h0m0s", services.ErrContactRateLimited),
+	)
+
+	body, _ := json.Marshal(models.ContactMentorRequest{
+		Email:            "test@example.com",
+		Name:             "Test User",
+		Experience:       "Middle",
+		Intro:            "I want to learn Go programming",
+		TelegramUsername: "testuser",
+		MentorID:         "4821fee2-7601-41ad-8798-70d57f0b2acc",
+		RecaptchaToken:   "valid-token-12345678901234",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/contact", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	var resp models.ContactMentorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.False(t, resp.Success)
+}
@@ -39,7 +39,7 @@ func TestHealthHandler_Healthcheck(t *testing.T) {
 	defer pool.Close()
 
 	mockReadyFunc := func() bool { return true }
-	handler := handlers.NewHealthHandler(pool, mockReadyFunc)
+	handler := handlers.NewHealthHandler(pool, mockReadyFunc, nil, nil)
 	router := gin.New()
 	router.GET("/healthcheck", handler.Healthcheck)
 
@@ -56,3 +56,17 @@ func TestHealthHandler_Healthcheck(t *testing.T) {
 	assert.Equal(t, "no-cache, no-store, max-age=0, must-revalidate", w.Header().Get("Cache-Control"))
 	assert.JSONEq(t, `{"status":"healthy"}`, w.Body.String())
 }
+
+func TestHealthHandler_Healthcheck_DatabaseNotReady(t *testing.T) {
+	// The supervisor's verdict is enough: the pool is never pinged
+	handler := handlers.NewHealthHandler(nil, func() bool { return true }, func() bool { return false }, nil)
+	router := gin.New()
+	router.GET("/healthcheck", handler.Healthcheck)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/healthcheck", http.NoBody)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"status":"unhealthy","reason":"database unreachable"}`, w.Body.String())
+}
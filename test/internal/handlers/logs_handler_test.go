@@ -0,0 +1,110 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLogsRouter(t *testing.T) (*gin.Engine, string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+
+	logDir := t.TempDir()
+	handler := handlers.NewLogsHandler(logDir)
+	router := gin.New()
+	router.POST("/api/v1/logs", handler.ReceiveFrontendLogs)
+	return router, logDir
+}
+
+func postLogs(router *gin.Engine, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestLogsHandler_ReceiveFrontendLogs_PartialBatch(t *testing.T) {
+	router, logDir := setupLogsRouter(t)
+
+	w := postLogs(router, `{"logs": [
+		{"level": "error", "message": "Failed to load mentor", "route": "/mentor/ivan-1", "userAgent": "Mozilla/5.0"},
+		{"level": "verbose", "message": "bad level"},
+		{"level": "info"},
+		"not an object"
+	]}`)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp handlers.LogBatchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 1, resp.Received)
+	assert.Equal(t, 3, resp.Rejected)
+	require.Len(t, resp.Errors, 3)
+	assert.Equal(t, 1, resp.Errors[0].Index)
+	assert.Equal(t, "Level", resp.Errors[0].Errors[0].Field)
+	assert.Equal(t, "Message", resp.Errors[1].Errors[0].Field)
+	assert.Equal(t, "entry", resp.Errors[2].Errors[0].Field)
+
+	data, err := os.ReadFile(filepath.Join(logDir, "frontend.log"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"route":"/mentor/ivan-1"`)
+	assert.Contains(t, lines[0], `"user_agent":"Mozilla/5.0"`)
+}
+
+func TestLogsHandler_ReceiveFrontendLogs_Rejected(t *testing.T) {
+	router, _ := setupLogsRouter(t)
+
+	assert.Equal(t, http.StatusBadRequest, postLogs(router, `{"logs": []}`).Code)
+	assert.Equal(t, http.StatusBadRequest, postLogs(router, `{"logs": [{"level": "nope"}]}`).Code)
+
+	entries := make([]string, 101)
+	for i := range entries {
+		entries[i] = `{"level": "info", "message": "m"}`
+	}
+	assert.Equal(t, http.StatusBadRequest, postLogs(router, `{"logs": [`+strings.Join(entries, ",")+`]}`).Code)
+}
+
+func TestLogsHandler_ReceiveFrontendLogs_TraceCorrelation(t *testing.T) {
+	router, logDir := setupLogsRouter(t)
+
+	w := postLogs(router, `{"logs": [
+		{"level": "error", "message": "Checkout failed", "requestId": "req-42",
+		 "traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{"level": "error", "message": "Bad trace", "traceparent": "not-a-traceparent"}
+	]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp handlers.LogBatchResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 1, resp.Received)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "Traceparent", resp.Errors[0].Errors[0].Field)
+
+	data, err := os.ReadFile(filepath.Join(logDir, "frontend.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`)
+	assert.Contains(t, string(data), `"span_id":"00f067aa0ba902b7"`)
+	assert.Contains(t, string(data), `"request_id":"req-42"`)
+}
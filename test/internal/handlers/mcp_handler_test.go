@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mcpTestResponse struct {
+	Result struct {
+		Meta struct {
+			Quota models.MCPQuota `json:"quota"`
+		} `json:"_meta"`
+	} `json:"result"`
+	Error *struct {
+		Code int             `json:"code"`
+		Data models.MCPQuota `json:"data"`
+	} `json:"error"`
+	ID interface{} `json:"id"`
+}
+
+func setupMCPRouter(limiter *middleware.RateLimiter) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+
+	handler := handlers.NewMCPHandler(nil, limiter)
+	router := gin.New()
+	router.POST("/api/internal/mcp", handler.HandleMCPRequest)
+	return router
+}
+
+func postMCPInitialize(t *testing.T, router *gin.Engine, id int) (*httptest.ResponseRecorder, mcpTestResponse) {
+	t.Helper()
+	body := `{"jsonrpc":"2.0","method":"initialize","id":` + strconv.Itoa(id) + `}`
+	req := httptest.NewRequest(http.MethodPost, "/api/internal/mcp", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var resp mcpTestResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return w, resp
+}
+
+func TestMCPHandler_ReportsQuotaAndLimitsWithJSONRPCError(t *testing.T) {
+	router := setupMCPRouter(middleware.NewRateLimiter(0.01, 2))
+
+	w, resp := postMCPInitialize(t, router, 1)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, 2, resp.Result.Meta.Quota.Limit)
+	assert.Equal(t, 1, resp.Result.Meta.Quota.Remaining)
+
+	_, resp = postMCPInitialize(t, router, 2)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, 0, resp.Result.Meta.Quota.Remaining)
+
+	// Limited requests still get HTTP 200 and a JSON-RPC error carrying the retry delay
+	w, resp = postMCPInitialize(t, router, 3)
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, models.RateLimited, resp.Error.Code)
+	assert.Equal(t, float64(3), resp.ID)
+	assert.Equal(t, 2, resp.Error.Data.Limit)
+	assert.Equal(t, 0, resp.Error.Data.Remaining)
+	assert.Greater(t, resp.Error.Data.RetryAfterSeconds, 90)
+	assert.Equal(t, w.Header().Get("Retry-After"), strconv.Itoa(resp.Error.Data.RetryAfterSeconds))
+}
+
+func TestMCPHandler_RejectedRequestsDoNotExtendTheWait(t *testing.T) {
+	limiter := middleware.NewRateLimiter(1, 1)
+
+	assert.True(t, limiter.Take("10.0.0.1").Allowed)
+	first := limiter.Take("10.0.0.1")
+	second := limiter.Take("10.0.0.1")
+	assert.False(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	assert.LessOrEqual(t, second.RetryAfter, first.RetryAfter)
+
+	// Other clients have their own budget
+	assert.True(t, limiter.Take("10.0.0.2").Allowed)
+}
+
+func TestMCPHandler_WithoutLimiterOmitsQuota(t *testing.T) {
+	router := setupMCPRouter(nil)
+
+	w, resp := postMCPInitialize(t, router, 1)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Nil(t, resp.Error)
+	assert.Zero(t, resp.Result.Meta.Quota.Limit)
+}
+
+func TestMCPHandler_LabelsUnknownMethodsAndToolsAsOther(t *testing.T) {
+	router := setupMCPRouter(nil)
+	before := testutil.ToFloat64(metrics.MCPRequestTotal.WithLabelValues(metrics.OtherLabelValue, "400"))
+	toolsBefore := testutil.ToFloat64(metrics.MCPToolInvocations.WithLabelValues(metrics.OtherLabelValue, "error"))
+
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","method":"made/up-1","id":1}`,
+		`{"jsonrpc":"2.0","method":"made/up-2","id":2}`,
+		`{"jsonrpc":"2.0","method":"tools/call","params":{"name":"made_up_tool"},"id":3}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/internal/mcp", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, before+2, testutil.ToFloat64(metrics.MCPRequestTotal.WithLabelValues(metrics.OtherLabelValue, "400")))
+	assert.Equal(t, toolsBefore+1, testutil.ToFloat64(metrics.MCPToolInvocations.WithLabelValues(metrics.OtherLabelValue, "error")))
+}
@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMentorQuestionService implements MentorQuestionServiceInterface for testing
+type MockMentorQuestionService struct {
+	mock.Mock
+}
+
+func (m *MockMentorQuestionService) AskQuestion(ctx context.Context, mentorLegacyID int, req *models.AskMentorQuestionRequest) (*models.AskMentorQuestionResponse, error) {
+	args := m.Called(ctx, mentorLegacyID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AskMentorQuestionResponse), args.Error(1)
+}
+
+func (m *MockMentorQuestionService) ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicMentorQuestion, error) {
+	args := m.Called(ctx, mentorLegacyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PublicMentorQuestion), args.Error(1)
+}
+
+func (m *MockMentorQuestionService) ListQuestions(ctx context.Context, mentorID, status string) ([]*models.MentorQuestion, error) {
+	args := m.Called(ctx, mentorID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.MentorQuestion), args.Error(1)
+}
+
+func (m *MockMentorQuestionService) AnswerQuestion(ctx context.Context, mentorID, questionID string, req *models.AnswerMentorQuestionRequest) (*models.MentorQuestion, error) {
+	args := m.Called(ctx, mentorID, questionID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MentorQuestion), args.Error(1)
+}
+
+func (m *MockMentorQuestionService) RejectQuestion(ctx context.Context, mentorID, questionID string) error {
+	return m.Called(ctx, mentorID, questionID).Error(0)
+}
+
+const validQuestionBody = `{"question":"How do you prepare for system design interviews?","recaptchaToken":"token-token-token-token"}`
+
+func TestMentorQuestionHandler_AskQuestion(t *testing.T) {
+	mockService := new(MockMentorQuestionService)
+	handler := handlers.NewMentorQuestionHandler(mockService)
+
+	router := gin.New()
+	router.POST("/mentor/:id/question", handler.AskQuestion)
+
+	mockService.On("AskQuestion", mock.Anything, 42, mock.Anything).
+		Return(&models.AskMentorQuestionResponse{Success: true, QuestionID: "q-1"}, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentor/42/question", strings.NewReader(validQuestionBody)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"questionId":"q-1"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestMentorQuestionHandler_AskQuestion_InboxFull(t *testing.T) {
+	mockService := new(MockMentorQuestionService)
+	handler := handlers.NewMentorQuestionHandler(mockService)
+
+	router := gin.New()
+	router.POST("/mentor/:id/question", handler.AskQuestion)
+
+	mockService.On("AskQuestion", mock.Anything, 42, mock.Anything).Return(nil, services.ErrQuestionInboxFull)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentor/42/question", strings.NewReader(validQuestionBody)))
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+}
+
+func TestMentorQuestionHandler_AskQuestion_InvalidID(t *testing.T) {
+	mockService := new(MockMentorQuestionService)
+	handler := handlers.NewMentorQuestionHandler(mockService)
+
+	router := gin.New()
+	router.POST("/mentor/:id/question", handler.AskQuestion)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentor/anna/question", strings.NewReader(validQuestionBody)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "AskQuestion", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotificationService implements NotificationServiceInterface for testing
+type MockNotificationService struct {
+	mock.Mock
+}
+
+func (m *MockNotificationService) GetSettings(ctx context.Context, mentorID string) (*models.NotificationSettings, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.NotificationSettings), args.Error(1)
+}
+
+func (m *MockNotificationService) SetQuietHours(ctx context.Context, mentorID, quietHours string) (*models.NotificationSettings, error) {
+	args := m.Called(ctx, mentorID, quietHours)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.NotificationSettings), args.Error(1)
+}
+
+func postQuietHours(service *MockNotificationService, body string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.POST("/mentor/notifications/quiet-hours", withMentorSession("mentor-1"), handlers.NewNotificationHandler(service).SetMyQuietHours)
+
+	req := httptest.NewRequest(http.MethodPost, "/mentor/notifications/quiet-hours", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestNotificationHandler_SetsQuietHours(t *testing.T) {
+	service := new(MockNotificationService)
+	service.On("SetQuietHours", mock.Anything, "mentor-1", "22:00-08:00").
+		Return(&models.NotificationSettings{QuietHours: "22:00-08:00", Timezone: "Europe/Moscow"}, nil)
+
+	w := postQuietHours(service, `{"quietHours": "22:00-08:00"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"quietHours":"22:00-08:00"`)
+	service.AssertExpectations(t)
+}
+
+func TestNotificationHandler_TurnsQuietHoursOff(t *testing.T) {
+	service := new(MockNotificationService)
+	service.On("SetQuietHours", mock.Anything, "mentor-1", "").Return(&models.NotificationSettings{}, nil)
+
+	w := postQuietHours(service, `{"quietHours": ""}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	service.AssertExpectations(t)
+}
+
+func TestNotificationHandler_RejectsInvalidQuietHours(t *testing.T) {
+	service := new(MockNotificationService)
+	service.On("SetQuietHours", mock.Anything, "mentor-1", "late").
+		Return(nil, apperrors.InvalidInputError("quietHours", "quiet hours must be in HH:MM-HH:MM format"))
+
+	w := postQuietHours(service, `{"quietHours": "late"}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestNotificationHandler_RequiresQuietHours(t *testing.T) {
+	service := new(MockNotificationService)
+
+	w := postQuietHours(service, `{}`)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	service.AssertNotCalled(t, "SetQuietHours", mock.Anything, mock.Anything, mock.Anything)
+}
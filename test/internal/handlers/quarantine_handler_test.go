@@ -0,0 +1,81 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockQuarantineService implements QuarantineServiceInterface for testing
+type MockQuarantineService struct {
+	mock.Mock
+}
+
+func (m *MockQuarantineService) ListQuarantined(ctx context.Context, session *models.AdminSession) ([]*models.QuarantinedRequest, error) {
+	args := m.Called(ctx, session)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.QuarantinedRequest), args.Error(1)
+}
+
+func (m *MockQuarantineService) SetVerdict(ctx context.Context, session *models.AdminSession, requestID, verdict string) error {
+	args := m.Called(ctx, session, requestID, verdict)
+	return args.Error(0)
+}
+
+func quarantineRouter(service *MockQuarantineService) *gin.Engine {
+	handler := handlers.NewQuarantineHandler(service)
+	router := gin.New()
+	router.GET("/admin/quarantine", withAdminSession("moderator-1"), handler.ListQuarantined)
+	router.POST("/admin/quarantine/:id/verdict", withAdminSession("moderator-1"), handler.SetVerdict)
+	return router
+}
+
+func postVerdict(router *gin.Engine, requestID, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/quarantine/"+requestID+"/verdict", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestQuarantineHandler_ListsQueue(t *testing.T) {
+	service := new(MockQuarantineService)
+	service.On("ListQuarantined", mock.Anything, mock.Anything).Return([]*models.QuarantinedRequest{
+		{ID: "request-1", MentorID: "mentor-1", FlagReason: "blocklist:7", QuarantineStatus: models.QuarantineStatusQuarantined},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	quarantineRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/quarantine", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp models.QuarantinedRequestsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, "blocklist:7", resp.Requests[0].FlagReason)
+}
+
+func TestQuarantineHandler_SetVerdict(t *testing.T) {
+	service := new(MockQuarantineService)
+	service.On("SetVerdict", mock.Anything, mock.Anything, "request-1", models.QuarantineVerdictRelease).Return(nil)
+	service.On("SetVerdict", mock.Anything, mock.Anything, "request-2", models.QuarantineVerdictDiscard).
+		Return(repository.ErrQuarantinedRequestNotFound)
+	router := quarantineRouter(service)
+
+	assert.Equal(t, http.StatusOK, postVerdict(router, "request-1", `{"verdict":"release"}`).Code)
+	assert.Equal(t, http.StatusNotFound, postVerdict(router, "request-2", `{"verdict":"discard"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, postVerdict(router, "request-3", `{"verdict":"approve"}`).Code)
+	service.AssertNumberOfCalls(t, "SetVerdict", 2)
+}
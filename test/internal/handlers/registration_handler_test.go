@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -387,6 +388,12 @@ func TestRegistrationHandler_RegisterMentor_TooManyTags(t *testing.T) {
 	router := gin.New()
 	router.POST("/register", handler.RegisterMentor)
 
+	// 21 tags: the binding caps tags at 20, the moderation rules enforce the actual limit
+	tags := make([]string, 21)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("Tag %d", i)
+	}
+
 	reqBody := models.RegisterMentorRequest{
 		Name:         "John Doe",
 		Email:        "john@example.com",
@@ -395,7 +402,7 @@ func TestRegistrationHandler_RegisterMentor_TooManyTags(t *testing.T) {
 		Workplace:    "Company",
 		Experience:   "10+",
 		Price:        "5000 руб",
-		Tags:         []string{"Backend", "Frontend", "Mobile", "QA", "DevOps", "Security"}, // 6 tags (max is 5)
+		Tags:         tags,
 		About:        "About me",
 		Description:  "Description",
 		Competencies: "Skills",
@@ -0,0 +1,145 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReviewService implements ReviewServiceInterface for testing
+type MockReviewService struct {
+	mock.Mock
+}
+
+func (m *MockReviewService) CheckReview(ctx context.Context, requestID string) (*models.ReviewCheckResponse, error) {
+	args := m.Called(ctx, requestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ReviewCheckResponse), args.Error(1)
+}
+
+func (m *MockReviewService) SubmitReview(ctx context.Context, requestID string, req *models.SubmitReviewRequest) (*models.SubmitReviewResponse, error) {
+	args := m.Called(ctx, requestID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SubmitReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewService) ListPublished(ctx context.Context, mentorLegacyID int) ([]models.PublicReview, error) {
+	args := m.Called(ctx, mentorLegacyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PublicReview), args.Error(1)
+}
+
+func (m *MockReviewService) ListMentorReviews(ctx context.Context, mentorID string) ([]models.PublicReview, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PublicReview), args.Error(1)
+}
+
+func (m *MockReviewService) ReplyToReview(ctx context.Context, mentorID, reviewID string, req *models.ReviewReplyRequest) (*models.PublicReview, error) {
+	args := m.Called(ctx, mentorID, reviewID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PublicReview), args.Error(1)
+}
+
+func (m *MockReviewService) ListForModeration(ctx context.Context, session *models.AdminSession, filter models.AdminReviewFilter) ([]*models.Review, error) {
+	args := m.Called(ctx, session, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Review), args.Error(1)
+}
+
+func (m *MockReviewService) ModerateReview(ctx context.Context, session *models.AdminSession, reviewID, status string) error {
+	return m.Called(ctx, session, reviewID, status).Error(0)
+}
+
+func (m *MockReviewService) ModerateReply(ctx context.Context, session *models.AdminSession, reviewID, status string) error {
+	return m.Called(ctx, session, reviewID, status).Error(0)
+}
+
+func withMentorSession(mentorID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(middleware.MentorSessionContextKey, &models.MentorSession{MentorID: mentorID})
+		c.Next()
+	}
+}
+
+func withAdminSession(moderatorID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(middleware.AdminSessionContextKey, &models.AdminSession{ModeratorID: moderatorID})
+		c.Next()
+	}
+}
+
+func TestReviewHandler_ReplyToMyReview_AlreadyReplied(t *testing.T) {
+	mockService := new(MockReviewService)
+	handler := handlers.NewReviewHandler(mockService)
+
+	router := gin.New()
+	router.POST("/mentor/reviews/:id/reply", withMentorSession("mentor-1"), handler.ReplyToMyReview)
+
+	mockService.On("ReplyToReview", mock.Anything, "mentor-1", "review-1", mock.Anything).
+		Return(nil, repository.ErrReviewReplyExists)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentor/reviews/review-1/reply", strings.NewReader(`{"reply":"Thank you!"}`)))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestReviewHandler_ReplyToMyReview_RequiresReply(t *testing.T) {
+	mockService := new(MockReviewService)
+	handler := handlers.NewReviewHandler(mockService)
+
+	router := gin.New()
+	router.POST("/mentor/reviews/:id/reply", withMentorSession("mentor-1"), handler.ReplyToMyReview)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentor/reviews/review-1/reply", strings.NewReader(`{}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ReplyToReview", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReviewHandler_AdminModeration(t *testing.T) {
+	mockService := new(MockReviewService)
+	handler := handlers.NewReviewHandler(mockService)
+
+	router := gin.New()
+	router.POST("/admin/reviews/:id/approve", withAdminSession("mod-1"), handler.AdminApproveReview)
+	router.POST("/admin/reviews/:id/reply/reject", withAdminSession("mod-1"), handler.AdminRejectReply)
+
+	mockService.On("ModerateReview", mock.Anything, mock.Anything, "review-1", models.ReviewStatusApproved).Return(nil)
+	mockService.On("ModerateReply", mock.Anything, mock.Anything, "review-2", models.ReviewStatusRejected).Return(repository.ErrReviewNotFound)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/reviews/review-1/approve", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/reviews/review-2/reply/reject", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSessionCalendarService implements SessionCalendarServiceInterface for testing
+type MockSessionCalendarService struct {
+	mock.Mock
+}
+
+func (m *MockSessionCalendarService) GetMentorFeed(ctx context.Context, mentorID string) ([]byte, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockSessionCalendarService) GetFeedByToken(ctx context.Context, token string) ([]byte, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockSessionCalendarService) GetFeedURL(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SessionCalendarFeedResponse), args.Error(1)
+}
+
+func (m *MockSessionCalendarService) RotateFeedToken(ctx context.Context, mentorID string) (*models.SessionCalendarFeedResponse, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SessionCalendarFeedResponse), args.Error(1)
+}
+
+func sessionCalendarRouter(service *MockSessionCalendarService) *gin.Engine {
+	handler := handlers.NewSessionCalendarHandler(service)
+	router := gin.New()
+	router.GET("/mentor/sessions.ics", withMentorSession("mentor-1"), handler.GetMyFeed)
+	router.GET("/session-feeds/:token/sessions.ics", handler.GetFeedByToken)
+	return router
+}
+
+func TestSessionCalendarHandler_ServesMentorFeed(t *testing.T) {
+	service := new(MockSessionCalendarService)
+	service.On("GetMentorFeed", mock.Anything, "mentor-1").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	w := httptest.NewRecorder()
+	sessionCalendarRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mentor/sessions.ics", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "BEGIN:VCALENDAR")
+}
+
+func TestSessionCalendarHandler_UnknownToken(t *testing.T) {
+	service := new(MockSessionCalendarService)
+	service.On("GetFeedByToken", mock.Anything, "revoked").Return(nil, repository.ErrCalendarFeedNotFound)
+
+	w := httptest.NewRecorder()
+	sessionCalendarRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/session-feeds/revoked/sessions.ics", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,97 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockShortLinkService implements ShortLinkServiceInterface for testing
+type MockShortLinkService struct {
+	mock.Mock
+}
+
+func (m *MockShortLinkService) ListLinks(ctx context.Context, mentorID string) (*models.ShortLinkList, error) {
+	args := m.Called(ctx, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ShortLinkList), args.Error(1)
+}
+
+func (m *MockShortLinkService) CreateLink(ctx context.Context, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error) {
+	args := m.Called(ctx, mentorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ShortLink), args.Error(1)
+}
+
+func (m *MockShortLinkService) DeleteLink(ctx context.Context, mentorID, code string) error {
+	return m.Called(ctx, mentorID, code).Error(0)
+}
+
+func (m *MockShortLinkService) AdminListLinks(ctx context.Context, session *models.AdminSession, mentorID string) (*models.ShortLinkList, error) {
+	args := m.Called(ctx, session, mentorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ShortLinkList), args.Error(1)
+}
+
+func (m *MockShortLinkService) AdminCreateLink(ctx context.Context, session *models.AdminSession, mentorID string, req *models.CreateShortLinkRequest) (*models.ShortLink, error) {
+	args := m.Called(ctx, session, mentorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ShortLink), args.Error(1)
+}
+
+func (m *MockShortLinkService) Resolve(ctx context.Context, code, userAgent string) (string, error) {
+	args := m.Called(ctx, code, userAgent)
+	return args.String(0), args.Error(1)
+}
+
+func TestShortLinkHandler_Redirect(t *testing.T) {
+	mockService := new(MockShortLinkService)
+	handler := handlers.NewShortLinkHandler(mockService)
+
+	router := gin.New()
+	router.GET("/m/:code", handler.Redirect)
+
+	target := "https://getmentor.dev/mentor/anna?utm_content=abc2345&utm_medium=share&utm_source=getmentor_link"
+	mockService.On("Resolve", mock.Anything, "abc2345", "Mozilla/5.0").Return(target, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/m/abc2345", http.NoBody)
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, target, w.Header().Get("Location"))
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	mockService.AssertExpectations(t)
+}
+
+func TestShortLinkHandler_Redirect_NotFound(t *testing.T) {
+	mockService := new(MockShortLinkService)
+	handler := handlers.NewShortLinkHandler(mockService)
+
+	router := gin.New()
+	router.GET("/m/:code", handler.Redirect)
+
+	mockService.On("Resolve", mock.Anything, "missing", mock.Anything).Return("", repository.ErrShortLinkNotFound)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/m/missing", http.NoBody))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
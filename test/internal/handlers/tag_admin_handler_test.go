@@ -0,0 +1,119 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/handlers"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagAdminService implements TagAdminServiceInterface for testing
+type MockTagAdminService struct {
+	mock.Mock
+}
+
+func (m *MockTagAdminService) List(ctx context.Context) ([]models.Tag, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Tag), args.Error(1)
+}
+
+func (m *MockTagAdminService) Create(ctx context.Context, req *models.CreateTagRequest) (*models.Tag, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockTagAdminService) Delete(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func tagsRouter(service *MockTagAdminService) *gin.Engine {
+	handler := handlers.NewTagAdminHandler(service)
+	router := gin.New()
+	router.GET("/api/v1/internal/tags", handler.List)
+	router.POST("/api/v1/internal/tags", handler.Create)
+	router.DELETE("/api/v1/internal/tags", handler.Delete)
+	return router
+}
+
+func TestTagAdminHandler_ListsTags(t *testing.T) {
+	service := new(MockTagAdminService)
+	service.On("List", mock.Anything).Return([]models.Tag{{ID: "t1", Name: "Go", MentorCount: 4}}, nil)
+
+	w := httptest.NewRecorder()
+	tagsRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/internal/tags", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Tags []models.Tag `json:"tags"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Tags, 1)
+	assert.Equal(t, "Go", body.Tags[0].Name)
+	assert.Equal(t, 4, body.Tags[0].MentorCount)
+}
+
+func TestTagAdminHandler_CreatesTag(t *testing.T) {
+	service := new(MockTagAdminService)
+	service.On("Create", mock.Anything, &models.CreateTagRequest{Name: "Rust"}).Return(&models.Tag{ID: "t2", Name: "Rust"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/internal/tags", bytes.NewBufferString(`{"name": "Rust"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	tagsRouter(service).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	service.AssertExpectations(t)
+}
+
+func TestTagAdminHandler_RejectsDuplicateTag(t *testing.T) {
+	service := new(MockTagAdminService)
+	service.On("Create", mock.Anything, mock.Anything).Return(nil, repository.ErrTagExists)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/internal/tags", bytes.NewBufferString(`{"name": "go"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	tagsRouter(service).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestTagAdminHandler_DeleteMapsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"deleted", nil, http.StatusOK},
+		{"not found", repository.ErrTagNotFound, http.StatusNotFound},
+		{"in use", repository.ErrTagInUse, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := new(MockTagAdminService)
+			service.On("Delete", mock.Anything, "C/C++").Return(tt.err)
+
+			w := httptest.NewRecorder()
+			tagsRouter(service).ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/v1/internal/tags?name=C%2FC%2B%2B", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCachePolicyRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.SecurityHeadersMiddleware())
+	router.Use(middleware.CachePolicyMiddleware(middleware.CachePolicy{
+		ListRoutes:               []string{"/mentors"},
+		ListSMaxAge:              60,
+		ListStaleWhileRevalidate: 300,
+		DetailRoutes:             []string{"/mentor/:id"},
+		DetailMaxAge:             30,
+	}))
+	router.GET("/mentors", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{}) })
+	router.GET("/mentor/:id", func(c *gin.Context) {
+		if c.Param("id") == "missing" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+			return
+		}
+		_, _ = c.Writer.WriteString("{}") //nolint:errcheck // test handler
+	})
+	router.GET("/private", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/mentors", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	return router
+}
+
+func TestCachePolicyMiddleware_ListRoute(t *testing.T) {
+	w := httptest.NewRecorder()
+	setupCachePolicyRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mentors", nil))
+
+	assert.Equal(t, "public, max-age=0, s-maxage=60, stale-while-revalidate=300", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "max-age=60, stale-while-revalidate=300", w.Header().Get("Surrogate-Control"))
+	assert.Equal(t, "mentors_api_auth_token", w.Header().Get("Vary"))
+	assert.Empty(t, w.Header().Get("Pragma"))
+}
+
+func TestCachePolicyMiddleware_DetailRoute(t *testing.T) {
+	w := httptest.NewRecorder()
+	setupCachePolicyRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mentor/42", nil))
+
+	assert.Equal(t, "public, max-age=30", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "max-age=30", w.Header().Get("Surrogate-Control"))
+}
+
+func TestCachePolicyMiddleware_ErrorsAreNotCached(t *testing.T) {
+	w := httptest.NewRecorder()
+	setupCachePolicyRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mentor/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "no-store, no-cache, must-revalidate, private", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "no-store", w.Header().Get("Surrogate-Control"))
+}
+
+func TestCachePolicyMiddleware_MutationsAndOtherRoutes(t *testing.T) {
+	router := setupCachePolicyRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/mentors", nil))
+	assert.Equal(t, "no-store, no-cache, must-revalidate, private", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "no-store", w.Header().Get("Surrogate-Control"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/private", nil))
+	assert.Equal(t, "no-store, no-cache, must-revalidate, private", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("Surrogate-Control"))
+}
@@ -0,0 +1,100 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicCORSMiddleware(t *testing.T) {
+	restrictedCalled := false
+	restricted := func(c *gin.Context) {
+		restrictedCalled = true
+		c.AbortWithStatus(http.StatusForbidden)
+	}
+
+	router := gin.New()
+	router.Use(middleware.PublicCORSMiddleware(restricted, "/public"))
+	router.GET("/public", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/private", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// Public path is open to any origin and skips the restricted policy
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/public", http.NoBody)
+	req.Header.Set("Origin", "https://example.org")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.False(t, restrictedCalled)
+
+	// Preflight is answered directly
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodOptions, "/public", http.NoBody)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	// Other paths go through the restricted policy
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/private", http.NoBody)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.True(t, restrictedCalled)
+}
+
+func TestCORSMiddleware_PerOriginCapabilities(t *testing.T) {
+	metrics.Init("test")
+	policy := models.NewCORSPolicy([]*models.CORSOrigin{
+		{Origin: "https://getmentor.dev", AllowCredentials: true},
+		{Origin: "https://partner.example", AllowedMethods: []string{"GET", "OPTIONS"}, AllowedHeaders: []string{"Accept"}},
+	})
+
+	router := gin.New()
+	router.Use(middleware.CORSMiddleware(func() *models.CORSPolicy { return policy }, []string{"ETag"}, time.Hour))
+	router.GET("/mentors", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/contact", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	request := func(method, path, origin string, headers map[string]string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, http.NoBody)
+		req.Header.Set("Origin", origin)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Full origin: credentials and exposed headers
+	w := request(http.MethodPost, "/contact", "https://getmentor.dev", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://getmentor.dev", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "ETag", w.Header().Get("Access-Control-Expose-Headers"))
+
+	// Partner origin may read but not post, and gets no credentials
+	w = request(http.MethodGet, "/mentors", "https://partner.example", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/contact", "https://partner.example", nil).Code)
+
+	// Preflights are checked against the origin's methods and headers
+	w = request(http.MethodOptions, "/mentors", "https://partner.example", map[string]string{"Access-Control-Request-Method": "GET", "Access-Control-Request-Headers": "accept"})
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "GET, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "3600", w.Header().Get("Access-Control-Max-Age"))
+	w = request(http.MethodOptions, "/mentors", "https://partner.example", map[string]string{"Access-Control-Request-Method": "GET", "Access-Control-Request-Headers": "Authorization"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// Unknown origins are refused; requests without Origin pass
+	assert.Equal(t, http.StatusForbidden, request(http.MethodGet, "/mentors", "https://evil.example", nil).Code)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mentors", http.NoBody))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,79 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var testTraceID = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+
+func setupErrorReferenceRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(gin.CustomRecovery(middleware.RecoveryHandler))
+	router.Use(func(c *gin.Context) {
+		// Stands in for otelgin
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: testTraceID, SpanID: trace.SpanID{1}, TraceFlags: trace.FlagsSampled})
+		c.Request = c.Request.WithContext(trace.ContextWithSpanContext(c.Request.Context(), spanContext))
+	})
+	router.Use(middleware.ErrorReferenceMiddleware())
+	router.GET("/ok", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/fail", func(c *gin.Context) {
+		c.JSON(http.StatusBadGateway, gin.H{"error": "upstream failed"})
+	})
+	router.GET("/panic", func(c *gin.Context) { panic("boom") })
+	return router
+}
+
+func TestErrorReferenceMiddleware_Sets5xxHeaders(t *testing.T) {
+	router := setupErrorReferenceRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fail", nil))
+
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Equal(t, testTraceID.String(), w.Header().Get(middleware.TraceIDHeader))
+	assert.Equal(t, "GM-4BF9-2F35", w.Header().Get(middleware.IncidentCodeHeader))
+}
+
+func TestErrorReferenceMiddleware_SkipsSuccessfulResponses(t *testing.T) {
+	router := setupErrorReferenceRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ok", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(middleware.TraceIDHeader))
+	assert.Empty(t, w.Header().Get(middleware.IncidentCodeHeader))
+}
+
+func TestRecoveryHandler_ReturnsErrorReference(t *testing.T) {
+	router := setupErrorReferenceRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "GM-4BF9-2F35", body["incidentCode"])
+	assert.Equal(t, testTraceID.String(), body["traceId"])
+	assert.Equal(t, "GM-4BF9-2F35", w.Header().Get(middleware.IncidentCodeHeader))
+}
+
+func TestGetErrorReference_WithoutTraceHasRandomCode(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	ref := middleware.GetErrorReference(c)
+	assert.Empty(t, ref.TraceID)
+	assert.Regexp(t, `^GM-[0-9A-F]{4}-[0-9A-F]{4}$`, ref.IncidentCode)
+	assert.Equal(t, ref, middleware.GetErrorReference(c), "the reference is stable within a request")
+}
@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSessionChecker struct {
+	active  map[string]bool
+	checked []string
+}
+
+func (f *fakeSessionChecker) IsSessionActive(_ context.Context, _, sessionID string) (bool, error) {
+	f.checked = append(f.checked, sessionID)
+	return f.active[sessionID], nil
+}
+
+func serveWithSessionCookie(t *testing.T, checker middleware.MentorSessionChecker, token string) (*httptest.ResponseRecorder, *models.MentorSession) {
+	t.Helper()
+
+	var session *models.MentorSession
+	router := gin.New()
+	router.Use(middleware.MentorSessionMiddleware(jwt.NewTokenManager("test-secret", "test", 1), checker, "", false))
+	router.GET("/test", func(c *gin.Context) {
+		session, _ = middleware.GetMentorSession(c)
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.AddCookie(&http.Cookie{Name: middleware.MentorSessionCookieName, Value: token})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w, session
+}
+
+func TestMentorSessionMiddleware_DeviceSessions(t *testing.T) {
+	tm := jwt.NewTokenManager("test-secret", "test", 1)
+	checker := &fakeSessionChecker{active: map[string]bool{"active-session": true}}
+
+	token, err := tm.GenerateSessionToken("active-session", "mentor-1", 1, "", "Mentor")
+	require.NoError(t, err)
+	w, session := serveWithSessionCookie(t, checker, token)
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, session)
+	assert.Equal(t, "active-session", session.SessionID)
+
+	token, err = tm.GenerateSessionToken("revoked-session", "mentor-1", 1, "", "Mentor")
+	require.NoError(t, err)
+	w, _ = serveWithSessionCookie(t, checker, token)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Session revoked")
+
+	// Tokens issued before sessions were tracked are accepted without a lookup
+	checker.checked = nil
+	token, err = tm.GenerateToken("mentor-1", 1, "", "Mentor")
+	require.NoError(t, err)
+	w, _ = serveWithSessionCookie(t, checker, token)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, checker.checked)
+}
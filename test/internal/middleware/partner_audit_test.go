@@ -0,0 +1,108 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePartnerAuditRecorder struct {
+	entries []*models.PartnerRequestLog
+}
+
+func (f *fakePartnerAuditRecorder) Record(entry *models.PartnerRequestLog) {
+	f.entries = append(f.entries, entry)
+}
+
+func setupPartnerAuditRouter(recorder *fakePartnerAuditRecorder, sampleRate float64) *gin.Engine {
+	metrics.Init("test")
+	names := map[string]string{middleware.PartnerTokenID("partner-token"): "partner"}
+
+	router := gin.New()
+	router.Use(middleware.PartnerAuditMiddleware(recorder, sampleRate, names))
+	router.GET("/mentors", middleware.TokenAuthMiddleware("partner-token", "other-token"), func(c *gin.Context) {
+		middleware.SetResultCount(c, 3)
+		c.JSON(http.StatusOK, gin.H{"mentors": []int{1, 2, 3}})
+	})
+	router.GET("/public", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestPartnerAuditMiddleware_RecordsPartnerRequest(t *testing.T) {
+	recorder := &fakePartnerAuditRecorder{}
+	router := setupPartnerAuditRouter(recorder, 1)
+
+	req := httptest.NewRequest(http.MethodGet, "/mentors?tag=go&token=secret", http.NoBody)
+	req.Header.Set("mentors_api_auth_token", "partner-token")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, recorder.entries, 1)
+	entry := recorder.entries[0]
+	assert.Equal(t, "partner", entry.TokenID)
+	assert.Equal(t, "/mentors", entry.Route)
+	assert.Equal(t, "tag=go", entry.Query)
+	assert.Equal(t, http.StatusOK, entry.Status)
+	require.NotNil(t, entry.ResultCount)
+	assert.Equal(t, 3, *entry.ResultCount)
+	assert.Positive(t, entry.ResponseBytes)
+}
+
+func TestPartnerAuditMiddleware_UnknownTokenKeepsID(t *testing.T) {
+	recorder := &fakePartnerAuditRecorder{}
+	router := setupPartnerAuditRouter(recorder, 1)
+
+	req := httptest.NewRequest(http.MethodGet, "/mentors", http.NoBody)
+	req.Header.Set("mentors_api_auth_token", "other-token")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, recorder.entries, 1)
+	assert.Equal(t, middleware.PartnerTokenID("other-token"), recorder.entries[0].TokenID)
+	assert.NotContains(t, recorder.entries[0].TokenID, "other-token")
+}
+
+func TestPartnerAuditMiddleware_SkipsUnauthenticatedRoutes(t *testing.T) {
+	recorder := &fakePartnerAuditRecorder{}
+	router := setupPartnerAuditRouter(recorder, 1)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/public", http.NoBody))
+
+	// A rejected token never identifies a partner either
+	req := httptest.NewRequest(http.MethodGet, "/mentors", http.NoBody)
+	req.Header.Set("mentors_api_auth_token", "wrong")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Empty(t, recorder.entries)
+}
+
+func TestPartnerAuditMiddleware_SamplesOnlySuccesses(t *testing.T) {
+	recorder := &fakePartnerAuditRecorder{}
+	metrics.Init("test")
+	router := gin.New()
+	router.Use(middleware.PartnerAuditMiddleware(recorder, 0.0000001, nil))
+	router.GET("/mentors/:id", middleware.TokenAuthMiddleware("partner-token"), func(c *gin.Context) {
+		if c.Param("id") == "missing" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	for _, id := range []string{"1", "missing"} {
+		req := httptest.NewRequest(http.MethodGet, "/mentors/"+id, http.NoBody)
+		req.Header.Set("mentors_api_auth_token", "partner-token")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	require.Len(t, recorder.entries, 1)
+	assert.Equal(t, http.StatusNotFound, recorder.entries[0].Status)
+	assert.Equal(t, "/mentors/:id", recorder.entries[0].Route)
+}
@@ -0,0 +1,90 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/middleware"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePartnerQuotaConsumer struct {
+	usage *models.PartnerQuotaUsage
+	err   error
+	calls int
+}
+
+func (f *fakePartnerQuotaConsumer) Consume(ctx context.Context, tokenID string) (*models.PartnerQuotaUsage, error) {
+	f.calls++
+	return f.usage, f.err
+}
+
+func quotaUsage(used, limit int64) *models.PartnerQuotaUsage {
+	remaining := max(limit-used, 0)
+	return &models.PartnerQuotaUsage{
+		Token:     "inno",
+		Used:      used,
+		Limit:     limit,
+		Remaining: &remaining,
+		ResetsAt:  time.Now().Add(24 * time.Hour),
+	}
+}
+
+func servePartnerQuota(consumer *fakePartnerQuotaConsumer, token string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.Use(middleware.PartnerQuotaMiddleware(consumer))
+	router.GET("/mentors", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/mentors", http.NoBody)
+	if token != "" {
+		req.Header.Set("mentors_api_auth_token", token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestPartnerQuotaMiddleware_WithinQuota(t *testing.T) {
+	w := servePartnerQuota(&fakePartnerQuotaConsumer{usage: quotaUsage(10, 100)}, "partner-token")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "100", w.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, "90", w.Header().Get("X-Quota-Remaining"))
+}
+
+func TestPartnerQuotaMiddleware_Exceeded(t *testing.T) {
+	w := servePartnerQuota(&fakePartnerQuotaConsumer{usage: quotaUsage(101, 100)}, "partner-token")
+
+	assert.Equal(t, http.StatusPaymentRequired, w.Code)
+	assert.Equal(t, "0", w.Header().Get("X-Quota-Remaining"))
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
+func TestPartnerQuotaMiddleware_UnlimitedHasNoHeaders(t *testing.T) {
+	w := servePartnerQuota(&fakePartnerQuotaConsumer{usage: &models.PartnerQuotaUsage{Token: "inno", Used: 5000}}, "partner-token")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("X-Quota-Limit"))
+}
+
+func TestPartnerQuotaMiddleware_FailsOpen(t *testing.T) {
+	w := servePartnerQuota(&fakePartnerQuotaConsumer{err: errors.New("db down")}, "partner-token")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestPartnerQuotaMiddleware_SkipsRequestsWithoutToken(t *testing.T) {
+	consumer := &fakePartnerQuotaConsumer{usage: quotaUsage(101, 100)}
+	w := servePartnerQuota(consumer, "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Zero(t, consumer.calls)
+}
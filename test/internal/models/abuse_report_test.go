@@ -0,0 +1,25 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbuseReportFilter_Statuses(t *testing.T) {
+	statuses, ok := models.AbuseReportFilterOpen.Statuses()
+	assert.True(t, ok)
+	assert.Equal(t, []string{models.AbuseReportStatusOpen, models.AbuseReportStatusInReview}, statuses)
+
+	statuses, ok = models.AbuseReportFilterClosed.Statuses()
+	assert.True(t, ok)
+	assert.Equal(t, []string{models.AbuseReportStatusResolved, models.AbuseReportStatusDismissed}, statuses)
+
+	statuses, ok = models.AbuseReportFilterAll.Statuses()
+	assert.True(t, ok)
+	assert.Nil(t, statuses)
+
+	_, ok = models.AbuseReportFilter("pending").Statuses()
+	assert.False(t, ok)
+}
@@ -0,0 +1,128 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAirtableFieldsHash_MatchesAirtableRecord(t *testing.T) {
+	changed := time.Date(2026, 3, 4, 10, 20, 30, 500000000, time.FixedZone("MSK", 3*3600))
+	row := (&models.AirtableRequestChange{
+		Status:          "done",
+		StatusChangedAt: &changed,
+		DeclineReason:   "",
+	}).AirtableFields()
+	names := []string{"Decline Comment", "Decline Reason", "Last Status Change", "Scheduled At", "Status"}
+
+	// Airtable leaves empty fields out and returns timestamps in UTC with milliseconds
+	record := map[string]interface{}{
+		"Status":             "done",
+		"Last Status Change": "2026-03-04T07:20:30.000Z",
+	}
+	assert.Equal(t, models.AirtableFieldsHash(row, names), models.AirtableFieldsHash(record, names))
+
+	record["Status"] = "declined"
+	assert.NotEqual(t, models.AirtableFieldsHash(row, names), models.AirtableFieldsHash(record, names))
+}
+
+func TestAirtableFieldsHash_UncheckedCheckboxIsMissing(t *testing.T) {
+	row := (&models.AirtableMentorChange{Name: "Jane", RemoteOnly: false}).AirtableFields()
+	names := []string{"Name", "Remote Only"}
+	record := map[string]interface{}{"Name": "Jane"}
+	assert.Equal(t, models.AirtableFieldsHash(row, names), models.AirtableFieldsHash(record, names))
+
+	record["Remote Only"] = true
+	assert.NotEqual(t, models.AirtableFieldsHash(row, names), models.AirtableFieldsHash(record, names))
+}
+
+func TestAirtableFieldsHash_MultipleSelect(t *testing.T) {
+	row := (&models.AirtableMentorChange{Languages: []string{"ru", "en"}}).AirtableFields()
+	names := []string{"Languages"}
+	record := map[string]interface{}{"Languages": []interface{}{"ru", "en"}}
+	assert.Equal(t, models.AirtableFieldsHash(row, names), models.AirtableFieldsHash(record, names))
+
+	empty := (&models.AirtableMentorChange{Languages: []string{}}).AirtableFields()
+	assert.Equal(t, models.AirtableFieldsHash(empty, names), models.AirtableFieldsHash(map[string]interface{}{}, names))
+}
+
+func TestAirtableCutoverState_WritesFrozen(t *testing.T) {
+	now := time.Now()
+	assert.False(t, (&models.AirtableCutoverState{DataSource: models.DataSourceAirtable}).WritesFrozen())
+	assert.True(t, (&models.AirtableCutoverState{DataSource: models.DataSourceAirtable, WritesFrozenAt: &now}).WritesFrozen())
+	assert.True(t, (&models.AirtableCutoverState{DataSource: models.DataSourcePostgres}).WritesFrozen())
+}
+
+func TestMentor_ApplyAirtableFields(t *testing.T) {
+	chatID := int64(42)
+	mentor := &models.Mentor{
+		Slug:           "jane",
+		Name:           "Jane",
+		Job:            "Engineer",
+		Status:         "active",
+		CalendarURL:    "https://calendly.com/jane",
+		CalendarType:   models.CalendarTypeBroken,
+		TelegramChatID: &chatID,
+		IsVisible:      true,
+	}
+
+	mentor.ApplyAirtableFields(map[string]interface{}{
+		"Name":          "Jane Doe",
+		"Status":        "inactive",
+		"Calendly Url":  "https://calendly.com/jane",
+		"Timezone":      "Europe/Moscow",
+		"Contact Hours": "10:00-18:00",
+		"Country":       "RU",
+		"Remote Only":   true,
+		"Languages":     []interface{}{"ru", "en"},
+	})
+
+	assert.Equal(t, "jane", mentor.Slug)
+	assert.Equal(t, "Jane Doe", mentor.Name)
+	assert.Empty(t, mentor.Job, "fields Airtable leaves out are empty")
+	assert.False(t, mentor.IsVisible)
+	assert.Equal(t, models.CalendarTypeBroken, mentor.CalendarType, "the same link keeps its checked type")
+	assert.Equal(t, "Europe/Moscow", mentor.Timezone)
+	assert.Equal(t, "10:00-18:00", mentor.ContactHours)
+	assert.Equal(t, "RU", mentor.Country)
+	assert.Empty(t, mentor.City)
+	assert.True(t, mentor.RemoteOnly)
+	assert.Equal(t, []string{"ru", "en"}, mentor.Languages)
+
+	mentor.ApplyAirtableFields(map[string]interface{}{"Calendly Url": "https://cal.com/jane"})
+	assert.Equal(t, models.GetCalendarType("https://cal.com/jane"), mentor.CalendarType)
+	assert.False(t, mentor.RemoteOnly, "Airtable leaves unchecked checkboxes out")
+}
+
+func TestMentor_ApplyAirtableImportFields(t *testing.T) {
+	mentor := &models.Mentor{Slug: "jane", LeaderboardOptIn: true, Tags: []string{"Go"}}
+
+	mentor.ApplyAirtableImportFields(map[string]interface{}{
+		"Alias":            "jane-doe",
+		"Name":             "Jane Doe",
+		"Details":          "Backend mentoring",
+		"Status":           "active",
+		"Tags":             []interface{}{"Backend", "Python"},
+		"SortOrder":        float64(7),
+		"Telegram Chat Id": float64(1234567890123),
+	})
+
+	assert.Equal(t, "jane-doe", mentor.Slug)
+	assert.Equal(t, "Backend mentoring", mentor.Description)
+	assert.Equal(t, []string{"Backend", "Python"}, mentor.Tags)
+	assert.Equal(t, 7, mentor.SortOrder)
+	if assert.NotNil(t, mentor.TelegramChatID) {
+		assert.Equal(t, int64(1234567890123), *mentor.TelegramChatID, "large chat IDs are read exactly")
+	}
+	assert.True(t, mentor.IsVisible)
+	assert.True(t, mentor.LeaderboardOptIn, "fields the Mentors table doesn't hold are kept")
+
+	mentor.ApplyAirtableImportFields(map[string]interface{}{"Alias": "jane-doe", "Telegram Chat Id": "42"})
+	assert.Empty(t, mentor.Tags)
+	assert.Zero(t, mentor.SortOrder)
+	if assert.NotNil(t, mentor.TelegramChatID) {
+		assert.Equal(t, int64(42), *mentor.TelegramChatID)
+	}
+}
@@ -0,0 +1,42 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	before := map[string]interface{}{
+		"status": "pending",
+		"name":   "Anna",
+		"tags":   []interface{}{"Go", "SQL"},
+		"city":   nil,
+	}
+	after := map[string]interface{}{
+		"status": "active",
+		"name":   "Anna",
+		"tags":   []interface{}{"Go", "SQL"},
+		"city":   "Berlin",
+	}
+
+	changes := models.DiffAuditSnapshots(before, after)
+	assert.Equal(t, map[string]models.AuditChange{
+		"status": {From: "pending", To: "active"},
+		"city":   {From: nil, To: "Berlin"},
+	}, changes)
+
+	assert.Empty(t, models.DiffAuditSnapshots(before, before))
+	assert.Equal(t, map[string]models.AuditChange{"status": {From: nil, To: "active"}},
+		models.DiffAuditSnapshots(nil, map[string]interface{}{"status": "active"}))
+}
+
+func TestAuditActorFromContext(t *testing.T) {
+	assert.Equal(t, models.AuditActor{Type: models.AuditActorSystem}, models.AuditActorFromContext(context.Background()))
+
+	actor := models.AuditActor{Type: models.AuditActorAdmin, ID: "moderator-1"}
+	ctx := models.WithAuditActor(context.Background(), actor)
+	assert.Equal(t, actor, models.AuditActorFromContext(ctx))
+}
@@ -0,0 +1,52 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeBlocklistValue(t *testing.T) {
+	tests := []struct {
+		kind, value, want string
+	}{
+		{models.BlocklistKindEmail, " Spammer@Example.com ", "spammer@example.com"},
+		{models.BlocklistKindTelegram, "@Spammer", "spammer"},
+		{models.BlocklistKindTelegram, "https://t.me/spammer", "spammer"},
+		{models.BlocklistKindIP, "203.0.113.7", "203.0.113.7/32"},
+		{models.BlocklistKindIP, "203.0.113.7/24", "203.0.113.0/24"},
+		{models.BlocklistKindIP, "2001:db8::1", "2001:db8::1/128"},
+	}
+	for _, tt := range tests {
+		got, err := models.NormalizeBlocklistValue(tt.kind, tt.value)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, got)
+	}
+
+	for _, kind := range []string{models.BlocklistKindEmail, models.BlocklistKindIP, "phone"} {
+		_, err := models.NormalizeBlocklistValue(kind, "not an ip")
+		if kind == models.BlocklistKindEmail {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.Error(t, err, kind)
+	}
+}
+
+func TestBlocklistEntry_Matches(t *testing.T) {
+	email := &models.BlocklistEntry{Kind: models.BlocklistKindEmail, Value: "spammer@example.com"}
+	assert.True(t, email.Matches(models.BlocklistSubject{Email: "SPAMMER@example.com"}))
+	assert.False(t, email.Matches(models.BlocklistSubject{Email: "mentee@example.com"}))
+
+	telegram := &models.BlocklistEntry{Kind: models.BlocklistKindTelegram, Value: "spammer"}
+	assert.True(t, telegram.Matches(models.BlocklistSubject{Telegram: "@Spammer"}))
+	assert.False(t, telegram.Matches(models.BlocklistSubject{}))
+
+	ipRange := &models.BlocklistEntry{Kind: models.BlocklistKindIP, Value: "203.0.113.0/24"}
+	assert.True(t, ipRange.Matches(models.BlocklistSubject{IP: "203.0.113.42"}))
+	assert.True(t, ipRange.Matches(models.BlocklistSubject{IP: "::ffff:203.0.113.42"}))
+	assert.False(t, ipRange.Matches(models.BlocklistSubject{IP: "198.51.100.1"}))
+	assert.False(t, ipRange.Matches(models.BlocklistSubject{IP: ""}))
+}
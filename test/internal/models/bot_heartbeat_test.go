@@ -0,0 +1,34 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBotStatus_SilentAfterThreshold(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	heartbeat := &models.BotHeartbeat{Version: "2.4.1", ReceivedAt: now.Add(-9 * time.Minute)}
+
+	status := models.NewBotStatus(heartbeat, 10*time.Minute, now)
+	assert.False(t, status.Silent)
+	assert.Equal(t, int64(540), status.SecondsSinceHeartbeat)
+	assert.Equal(t, 10, status.SilentAfterMinutes)
+
+	status = models.NewBotStatus(heartbeat, 10*time.Minute, now.Add(time.Minute))
+	assert.True(t, status.Silent)
+}
+
+func TestNewBotStatus_NeverSilentWithoutHeartbeatOrThreshold(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	status := models.NewBotStatus(nil, 10*time.Minute, now)
+	assert.False(t, status.Silent)
+	assert.Nil(t, status.Heartbeat)
+
+	// A zero threshold turns the alert off
+	heartbeat := &models.BotHeartbeat{Version: "2.4.1", ReceivedAt: now.Add(-24 * time.Hour)}
+	assert.False(t, models.NewBotStatus(heartbeat, 0, now).Silent)
+}
@@ -0,0 +1,34 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCacheVersionsReport(t *testing.T) {
+	report := models.NewCacheVersionsReport(models.CacheVersionMentors, 5, []models.CacheReplica{
+		{Replica: "api-1", Region: "fra", Version: 5},
+		{Replica: "api-2", Region: "ams", Version: 4},
+	})
+
+	assert.Equal(t, int64(5), report.Current)
+	assert.False(t, report.Converged)
+	require.Len(t, report.Replicas, 2)
+	assert.False(t, report.Replicas[0].Stale)
+	assert.True(t, report.Replicas[1].Stale)
+}
+
+func TestNewCacheVersionsReport_Converged(t *testing.T) {
+	report := models.NewCacheVersionsReport(models.CacheVersionMentors, 5, []models.CacheReplica{
+		{Replica: "api-1", Version: 5},
+		{Replica: "api-2", Version: 5},
+	})
+	assert.True(t, report.Converged)
+
+	empty := models.NewCacheVersionsReport(models.CacheVersionMentors, 1, nil)
+	assert.True(t, empty.Converged)
+	assert.NotNil(t, empty.Replicas)
+}
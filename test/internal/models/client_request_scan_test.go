@@ -281,6 +281,10 @@ func TestRequestStatusTransitions(t *testing.T) {
 		{"contacted to declined", models.StatusContacted, models.StatusDeclined, true},
 		{"working to done", models.StatusWorking, models.StatusDone, true},
 		{"working to declined", models.StatusWorking, models.StatusDeclined, true},
+		{"working to reschedule", models.StatusWorking, models.StatusReschedule, true},
+		{"pending to reschedule", models.StatusPending, models.StatusReschedule, false},
+		{"reschedule to working", models.StatusReschedule, models.StatusWorking, true},
+		{"reschedule to done", models.StatusReschedule, models.StatusDone, false},
 		{"done to any", models.StatusDone, models.StatusPending, false},
 		{"declined to any", models.StatusDeclined, models.StatusWorking, false},
 	}
@@ -295,3 +299,49 @@ func TestRequestStatusTransitions(t *testing.T) {
 		})
 	}
 }
+
+// TestRequestStatusTransitions_Exhaustive checks every pair of statuses against the workflow
+func TestRequestStatusTransitions_Exhaustive(t *testing.T) {
+	allowed := map[models.RequestStatus][]models.RequestStatus{
+		models.StatusPending:    {models.StatusContacted, models.StatusDeclined, models.StatusUnavailable},
+		models.StatusContacted:  {models.StatusWorking, models.StatusReschedule, models.StatusDeclined, models.StatusUnavailable},
+		models.StatusWorking:    {models.StatusDone, models.StatusReschedule, models.StatusDeclined, models.StatusUnavailable},
+		models.StatusReschedule: {models.StatusContacted, models.StatusWorking, models.StatusDeclined, models.StatusUnavailable},
+	}
+
+	for _, from := range models.AllStatuses {
+		if !from.IsValid() {
+			t.Errorf("expected %s to be a valid status", from)
+		}
+		if from.IsTerminalStatus() != (len(allowed[from]) == 0) {
+			t.Errorf("unexpected IsTerminalStatus() for %s", from)
+		}
+		for _, to := range models.AllStatuses {
+			want := false
+			for _, next := range allowed[from] {
+				want = want || next == to
+			}
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("expected CanTransitionTo(%s -> %s) = %v, got %v", from, to, want, got)
+			}
+		}
+	}
+
+	unknown := models.RequestStatus("archived")
+	if unknown.IsValid() || unknown.IsTerminalStatus() || unknown.CanTransitionTo(models.StatusDone) {
+		t.Errorf("expected unknown status to be rejected")
+	}
+	if models.StatusPending.CanTransitionTo(unknown) {
+		t.Errorf("expected transition to unknown status to be rejected")
+	}
+}
+
+// TestRequestStatusCanSchedule checks that only answered, unfinished requests take a session time
+func TestRequestStatusCanSchedule(t *testing.T) {
+	for _, status := range models.AllStatuses {
+		want := status == models.StatusContacted || status == models.StatusWorking
+		if got := status.CanSchedule(); got != want {
+			t.Errorf("expected CanSchedule() = %v for %s, got %v", want, status, got)
+		}
+	}
+}
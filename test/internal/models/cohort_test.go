@@ -0,0 +1,47 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCohort_MenteeSeatsLeft(t *testing.T) {
+	cohort := &models.Cohort{MenteeCapacity: 10, MenteeCount: 7}
+	assert.Equal(t, 3, cohort.MenteeSeatsLeft())
+
+	cohort.MenteeCount = 12 // admins can enroll beyond capacity
+	assert.Equal(t, 0, cohort.MenteeSeatsLeft())
+}
+
+func TestCohort_IsOpenForEnrollment(t *testing.T) {
+	assert.True(t, (&models.Cohort{Status: models.CohortStatusEnrolling}).IsOpenForEnrollment())
+	assert.False(t, (&models.Cohort{Status: models.CohortStatusActive}).IsOpenForEnrollment())
+}
+
+func TestCohortParticipant_EligibleForCertificate(t *testing.T) {
+	checkpoints := []models.CohortCheckpoint{{ID: "cp-1"}, {ID: "cp-2"}}
+
+	mentee := &models.CohortParticipant{
+		Role:                 models.CohortRoleMentee,
+		Status:               models.CohortParticipantEnrolled,
+		CompletedCheckpoints: []string{"cp-2"},
+	}
+	assert.False(t, mentee.EligibleForCertificate(checkpoints))
+
+	mentee.CompletedCheckpoints = append(mentee.CompletedCheckpoints, "cp-1")
+	assert.True(t, mentee.EligibleForCertificate(checkpoints))
+
+	mentee.Status = models.CohortParticipantWithdrawn
+	assert.False(t, mentee.EligibleForCertificate(checkpoints))
+
+	mentor := &models.CohortParticipant{Role: models.CohortRoleMentor, Status: models.CohortParticipantCompleted}
+	assert.False(t, mentor.EligibleForCertificate(nil))
+
+	// Without checkpoints, completion is whatever the admin marked
+	mentee.Status = models.CohortParticipantEnrolled
+	assert.False(t, mentee.EligibleForCertificate(nil))
+	mentee.Status = models.CohortParticipantCompleted
+	assert.True(t, mentee.EligibleForCertificate(nil))
+}
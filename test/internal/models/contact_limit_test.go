@@ -0,0 +1,25 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlidingWindowCount_WeighsPreviousWindowByOverlap(t *testing.T) {
+	windowStart := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// At the start of a window the previous one counts in full
+	assert.InDelta(t, 8.0, models.SlidingWindowCount(2, 6, windowStart, windowStart, time.Hour), 0.001)
+	// A quarter into the window three quarters of it still overlap
+	assert.InDelta(t, 6.5, models.SlidingWindowCount(2, 6, windowStart, windowStart.Add(15*time.Minute), time.Hour), 0.001)
+	// At the end only the current window counts
+	assert.InDelta(t, 2.0, models.SlidingWindowCount(2, 6, windowStart, windowStart.Add(time.Hour), time.Hour), 0.001)
+}
+
+func TestNormalizeContactLimitSubject(t *testing.T) {
+	assert.Equal(t, "mentee@example.com", models.NormalizeContactLimitSubject("  Mentee@Example.COM "))
+	assert.Equal(t, "2001:db8::1", models.NormalizeContactLimitSubject("2001:DB8::1"))
+}
@@ -0,0 +1,33 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCORSOrigin(t *testing.T) {
+	origin, err := models.NormalizeCORSOrigin(" https://Partner.Example:8443/ ")
+	require.NoError(t, err)
+	assert.Equal(t, "https://partner.example:8443", origin)
+
+	for _, invalid := range []string{"", "partner.example", "ftp://partner.example", "https://partner.example/widget", "https://user@partner.example"} {
+		_, err := models.NormalizeCORSOrigin(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCORSPolicy_Lookup(t *testing.T) {
+	policy := models.NewCORSPolicy([]*models.CORSOrigin{{Origin: "https://getmentor.dev"}})
+
+	rule, ok := policy.Lookup("https://GetMentor.dev")
+	require.True(t, ok)
+	assert.True(t, rule.AllowsMethod("DELETE"))
+	assert.True(t, rule.AllowsHeader("if-match"))
+
+	_, ok = policy.Lookup("https://other.dev")
+	assert.False(t, ok)
+	assert.Equal(t, 1, policy.Len())
+}
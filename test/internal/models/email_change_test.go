@@ -0,0 +1,25 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailChange_IsOpen(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	change := &models.EmailChange{Status: models.EmailChangePending, ExpiresAt: now.Add(time.Hour)}
+	assert.True(t, change.IsOpen(now))
+	assert.False(t, change.IsOpen(now.Add(2*time.Hour)))
+
+	change.Status = models.EmailChangeSuperseded
+	assert.False(t, change.IsOpen(now))
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	assert.Equal(t, "mentor@example.com", models.NormalizeEmail("  Mentor@Example.COM "))
+	assert.Equal(t, "", models.NormalizeEmail("   "))
+}
@@ -0,0 +1,115 @@
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPatchToMergePatch(t *testing.T) {
+	patch, err := models.ParseJSONPatch([]byte(`[
+		{"op": "test", "path": "/name", "value": "Ivan"},
+		{"op": "replace", "path": "/name", "value": "Ivan Petrov"},
+		{"op": "remove", "path": "/calendarUrl"},
+		{"op": "copy", "from": "/job", "path": "/workplace"},
+		{"op": "add", "path": "/tags", "value": ["Go", "SQL"]}
+	]`))
+	require.NoError(t, err)
+
+	doc := map[string]interface{}{"name": "Ivan", "calendarUrl": "https://cal.com/ivan", "job": "Engineer"}
+	merge, err := patch.ToMergePatch(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"calendarUrl", "name", "tags", "workplace"}, merge.Keys())
+	assert.True(t, merge.IsNull("calendarUrl"))
+	assert.JSONEq(t, `"Ivan Petrov"`, string(merge["name"]))
+	assert.JSONEq(t, `"Engineer"`, string(merge["workplace"]))
+	assert.JSONEq(t, `["Go","SQL"]`, string(merge["tags"]))
+	assert.Equal(t, "Ivan", doc["name"], "the target must not be modified")
+}
+
+func TestJSONPatchMoveClearsSource(t *testing.T) {
+	patch, err := models.ParseJSONPatch([]byte(`[{"op": "move", "from": "/job", "path": "/workplace"}]`))
+	require.NoError(t, err)
+
+	merge, err := patch.ToMergePatch(map[string]interface{}{"job": "Engineer"})
+	require.NoError(t, err)
+
+	assert.True(t, merge.IsNull("job"))
+	assert.JSONEq(t, `"Engineer"`, string(merge["workplace"]))
+}
+
+func TestJSONPatchFailedTest(t *testing.T) {
+	patch, err := models.ParseJSONPatch([]byte(`[
+		{"op": "test", "path": "/name", "value": "Ivan"},
+		{"op": "replace", "path": "/name", "value": "Petr"}
+	]`))
+	require.NoError(t, err)
+
+	_, err = patch.ToMergePatch(map[string]interface{}{"name": "Anna"})
+	assert.True(t, errors.Is(err, models.ErrJSONPatchTestFailed))
+}
+
+func TestJSONPatchArrayPaths(t *testing.T) {
+	patch, err := models.ParseJSONPatch([]byte(`[
+		{"op": "test", "path": "/tags/0", "value": "Go"},
+		{"op": "add", "path": "/tags/-", "value": "SQL"},
+		{"op": "add", "path": "/tags/1", "value": "Kafka"},
+		{"op": "replace", "path": "/tags/0", "value": "Golang"},
+		{"op": "remove", "path": "/languages/0"},
+		{"op": "copy", "from": "/tags/2", "path": "/languages/-"}
+	]`))
+	require.NoError(t, err)
+
+	doc := map[string]interface{}{
+		"tags":      []interface{}{"Go", "Docker"},
+		"languages": []interface{}{"ru", "en"},
+	}
+	merge, err := patch.ToMergePatch(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"languages", "tags"}, merge.Keys())
+	assert.JSONEq(t, `["Golang","Kafka","Docker","SQL"]`, string(merge["tags"]))
+	assert.JSONEq(t, `["en","Docker"]`, string(merge["languages"]))
+	assert.Equal(t, []interface{}{"Go", "Docker"}, doc["tags"], "the target's arrays must not be modified")
+}
+
+func TestJSONPatchMissingTarget(t *testing.T) {
+	doc := map[string]interface{}{"name": "Ivan", "tags": []interface{}{"Go"}}
+	for _, body := range []string{
+		`[{"op": "replace", "path": "/calendarUrl", "value": "https://cal.com/ivan"}]`,
+		`[{"op": "remove", "path": "/calendarUrl"}]`,
+		`[{"op": "replace", "path": "/tags/1", "value": "SQL"}]`,
+		`[{"op": "remove", "path": "/tags/-"}]`,
+		`[{"op": "remove", "path": "/tags/01"}]`,
+		`[{"op": "add", "path": "/tags/2", "value": "SQL"}]`,
+		`[{"op": "add", "path": "/name/0", "value": "I"}]`,
+		`[{"op": "test", "path": "/tags/1", "value": "SQL"}]`,
+		`[{"op": "move", "from": "/job", "path": "/workplace"}]`,
+	} {
+		patch, err := models.ParseJSONPatch([]byte(body))
+		require.NoError(t, err, body)
+
+		_, err = patch.ToMergePatch(doc)
+		assert.ErrorIs(t, err, models.ErrJSONPatchPathNotFound, body)
+	}
+}
+
+func TestParseJSONPatchRejectsInvalidDocuments(t *testing.T) {
+	for _, body := range []string{
+		"",
+		`{"op": "add"}`,
+		`[]`,
+		`[{"op": "merge", "path": "/name", "value": 1}]`,
+		`[{"op": "replace", "path": "/name"}]`,
+		`[{"op": "move", "from": "/tags", "path": "/tags/0"}]`,
+		`[{"op": "remove", "path": ""}]`,
+		`[{"op": "copy", "from": "job", "path": "/name"}]`,
+	} {
+		_, err := models.ParseJSONPatch([]byte(body))
+		assert.Error(t, err, body)
+	}
+}
@@ -0,0 +1,23 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLeaderboardPeriod(t *testing.T) {
+	days, err := models.ParseLeaderboardPeriod("30d")
+	assert.NoError(t, err)
+	assert.Equal(t, 30, days)
+
+	days, err = models.ParseLeaderboardPeriod(models.LeaderboardPeriodAll)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, days)
+
+	for _, period := range []string{"", "30", "d", "0d", "-7d", "month"} {
+		_, err := models.ParseLeaderboardPeriod(period)
+		assert.Error(t, err, "period %q", period)
+	}
+}
@@ -0,0 +1,36 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCPCursor_RoundTrip(t *testing.T) {
+	encoded := (&models.MCPCursor{Tool: "list_mentors", Offset: 50, Version: "7", LastSlug: "ivan-petrov"}).Encode()
+
+	cursor, err := models.DecodeMCPCursor(encoded, "list_mentors")
+	require.NoError(t, err)
+	assert.Equal(t, &models.MCPCursor{Tool: "list_mentors", Offset: 50, Version: "7", LastSlug: "ivan-petrov"}, cursor)
+}
+
+func TestDecodeMCPCursor_EmptyIsFirstPage(t *testing.T) {
+	cursor, err := models.DecodeMCPCursor("", "search_mentors")
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestDecodeMCPCursor_RejectsForeignAndMalformedCursors(t *testing.T) {
+	encoded := (&models.MCPCursor{Tool: "list_mentors", Offset: 50}).Encode()
+	_, err := models.DecodeMCPCursor(encoded, "search_mentors")
+	assert.Error(t, err)
+
+	_, err = models.DecodeMCPCursor("not a cursor!", "list_mentors")
+	assert.Error(t, err)
+
+	negative := (&models.MCPCursor{Tool: "list_mentors", Offset: -1}).Encode()
+	_, err = models.DecodeMCPCursor(negative, "list_mentors")
+	assert.Error(t, err)
+}
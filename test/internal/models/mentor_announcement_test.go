@@ -0,0 +1,29 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMentorAnnouncement(t *testing.T) {
+	mentor := &models.Mentor{
+		MentorID:  "mentor-uuid",
+		Name:      "Ada <Lovelace>",
+		Job:       "Engineer",
+		Workplace: "Analytical Engines",
+		Price:     "Бесплатно",
+		Tags:      []string{"Backend", "Machine Learning", "C++"},
+	}
+
+	a := models.NewMentorAnnouncement(mentor, "https://getmentor.dev/mentor/ada", "https://cdn/ada/full")
+
+	assert.Equal(t, "mentor_channel_post", a.Type)
+	assert.Equal(t, "Engineer @ Analytical Engines", a.Job)
+	assert.Equal(t, "https://cdn/ada/full", a.PhotoURL)
+	assert.Contains(t, a.Text, "<b>Ada &lt;Lovelace&gt;</b>")
+	assert.Contains(t, a.Text, "#Backend #Machine_Learning #C")
+	assert.Contains(t, a.Text, "Цена: Бесплатно")
+	assert.Contains(t, a.Text, `<a href="https://getmentor.dev/mentor/ada">`)
+}
@@ -0,0 +1,56 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTimezone(t *testing.T) {
+	assert.NoError(t, models.ValidateTimezone(""))
+	assert.NoError(t, models.ValidateTimezone("Europe/Moscow"))
+	assert.Error(t, models.ValidateTimezone("Mars/Olympus"))
+}
+
+func TestParseContactHours(t *testing.T) {
+	start, end, err := models.ParseContactHours("10:00-19:30")
+	require.NoError(t, err)
+	assert.Equal(t, 600, start)
+	assert.Equal(t, 1170, end)
+
+	for _, value := range []string{"", "10:00", "10-19", "25:00-26:00", "10:00-10:00"} {
+		_, _, err := models.ParseContactHours(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestMentorIsWithinContactHours(t *testing.T) {
+	// 2026-03-10 12:00 UTC = 15:00 in Moscow
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		timezone     string
+		contactHours string
+		wantWithin   bool
+		wantKnown    bool
+	}{
+		{name: "inside range", timezone: "Europe/Moscow", contactHours: "10:00-19:00", wantWithin: true, wantKnown: true},
+		{name: "outside range", timezone: "Europe/Moscow", contactHours: "18:00-21:00", wantWithin: false, wantKnown: true},
+		{name: "range crossing midnight", timezone: "Europe/Moscow", contactHours: "22:00-16:00", wantWithin: true, wantKnown: true},
+		{name: "no timezone", timezone: "", contactHours: "10:00-19:00", wantWithin: false, wantKnown: false},
+		{name: "invalid hours", timezone: "Europe/Moscow", contactHours: "whenever", wantWithin: false, wantKnown: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mentor := &models.Mentor{Timezone: tt.timezone, ContactHours: tt.contactHours}
+			within, known := mentor.IsWithinContactHours(now)
+			assert.Equal(t, tt.wantWithin, within)
+			assert.Equal(t, tt.wantKnown, known)
+		})
+	}
+}
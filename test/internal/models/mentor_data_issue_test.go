@@ -0,0 +1,50 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidTelegramHandle(t *testing.T) {
+	for _, handle := range []string{"ada_lovelace", "@ada_lovelace", "https://t.me/ada_lovelace", "t.me/Ada42"} {
+		assert.True(t, models.ValidTelegramHandle(handle), handle)
+	}
+	for _, handle := range []string{"", "ada", "42ada", "ada lovelace", "+79991234567", "ada-lovelace"} {
+		assert.False(t, models.ValidTelegramHandle(handle), handle)
+	}
+}
+
+func TestCheckMentorData(t *testing.T) {
+	valid := &models.MentorDataSubject{
+		MentorID:     "m1",
+		Slug:         "ada",
+		Telegram:     "@ada_lovelace",
+		CalendarURL:  "https://calendly.com/ada",
+		Competencies: "Go, PostgreSQL",
+	}
+	assert.Empty(t, models.CheckMentorData(valid))
+
+	noCalendar := *valid
+	noCalendar.CalendarURL = ""
+	assert.Empty(t, models.CheckMentorData(&noCalendar))
+
+	broken := &models.MentorDataSubject{
+		MentorID:     "m2",
+		Slug:         "bob",
+		Telegram:     "bob",
+		CalendarURL:  "calendly.com/bob",
+		Competencies: "  ",
+	}
+	checks := []string{}
+	for _, issue := range models.CheckMentorData(broken) {
+		assert.Equal(t, "bob", issue.Slug)
+		checks = append(checks, issue.Check)
+	}
+	assert.ElementsMatch(t, []string{
+		models.MentorDataCheckCompetencies,
+		models.MentorDataCheckTelegram,
+		models.MentorDataCheckCalendarURL,
+	}, checks)
+}
@@ -0,0 +1,62 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreReviewSentiment(t *testing.T) {
+	assert.Equal(t, models.ReviewSentimentPositive, models.ScoreReviewSentiment("Спасибо, очень полезная встреча!"))
+	assert.Equal(t, models.ReviewSentimentPositive, models.ScoreReviewSentiment("Really helpful, thank you"))
+	assert.Equal(t, models.ReviewSentimentNegative, models.ScoreReviewSentiment("Ментор не помог и опоздал"))
+	assert.Equal(t, models.ReviewSentimentNeutral, models.ScoreReviewSentiment("Обсудили резюме"))
+	// The negation is not counted as praise
+	assert.Equal(t, models.ReviewSentimentNegative, models.ScoreReviewSentiment("Не помогло"))
+}
+
+func TestMentorInsights_ReportHidesSmallSamples(t *testing.T) {
+	median := 5.25
+	insights := &models.MentorInsights{
+		MentorRequestStats: models.MentorRequestStats{
+			Requests:            10,
+			Declined:            2,
+			DeclineReasons:      map[string]int{"no_time": 2},
+			Responded:           8,
+			RespondedWithinSLA:  6,
+			MedianResponseHours: &median,
+		},
+		WindowDays: 90,
+		SLAHours:   48,
+		ComputedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	insights.AddReview(models.ReviewSentimentPositive)
+	insights.AddReview(models.ReviewSentimentNegative)
+
+	report := insights.Report()
+	assert.Nil(t, report.DeclineReasons)
+	assert.Nil(t, report.Sentiment)
+	assert.Equal(t, 2, report.Reviews)
+	require.NotNil(t, report.WithinSLARate)
+	assert.Equal(t, 0.75, *report.WithinSLARate)
+	require.NotNil(t, report.MedianResponseHours)
+	assert.Equal(t, 5.3, *report.MedianResponseHours)
+
+	insights.Declined = 3
+	insights.DeclineReasons["other"] = 1
+	insights.AddReview(models.ReviewSentimentNeutral)
+
+	report = insights.Report()
+	assert.Equal(t, map[string]int{"no_time": 2, "other": 1}, report.DeclineReasons)
+	assert.Equal(t, &models.ReviewSentimentBreakdown{Positive: 1, Neutral: 1, Negative: 1}, report.Sentiment)
+}
+
+func TestMentorInsights_EmptyReport(t *testing.T) {
+	report := (&models.MentorInsights{WindowDays: 90, SLAHours: 48}).Report()
+	assert.Nil(t, report.ComputedAt)
+	assert.Nil(t, report.WithinSLARate)
+	assert.Equal(t, 48, report.ResponseSLAHours)
+}
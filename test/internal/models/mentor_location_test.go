@@ -0,0 +1,57 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCountryCode(t *testing.T) {
+	code, err := models.NormalizeCountryCode(" de ")
+	require.NoError(t, err)
+	assert.Equal(t, "DE", code)
+
+	code, err = models.NormalizeCountryCode("")
+	require.NoError(t, err)
+	assert.Empty(t, code)
+
+	for _, value := range []string{"D", "DEU", "D1", "Германия"} {
+		_, err := models.NormalizeCountryCode(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestNormalizeLanguages(t *testing.T) {
+	languages, err := models.NormalizeLanguages([]string{"EN", "other", " ru", "en"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ru", "en", "other"}, languages)
+
+	_, err = models.NormalizeLanguages([]string{"de"})
+	assert.Error(t, err)
+}
+
+func TestMentorSearchFilter(t *testing.T) {
+	remote := true
+	onsite := false
+	berlin := &models.Mentor{Slug: "berlin", Country: "DE", City: "Berlin", Languages: []string{"ru"}}
+	online := &models.Mentor{Slug: "online", Country: "DE", RemoteOnly: true, Languages: []string{"ru", "en"}}
+	unknown := &models.Mentor{Slug: "unknown"}
+	mentors := []*models.Mentor{berlin, online, unknown}
+
+	slugs := func(filter models.MentorSearchFilter) []string {
+		result := []string{}
+		for _, m := range filter.Apply(mentors) {
+			result = append(result, m.Slug)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"berlin", "online", "unknown"}, slugs(models.MentorSearchFilter{}))
+	assert.Equal(t, []string{"berlin", "online"}, slugs(models.MentorSearchFilter{Country: "DE"}))
+	assert.Equal(t, []string{"berlin"}, slugs(models.MentorSearchFilter{City: "berlin"}))
+	assert.Equal(t, []string{"online"}, slugs(models.MentorSearchFilter{RemoteOnly: &remote}))
+	assert.Equal(t, []string{"berlin"}, slugs(models.MentorSearchFilter{Country: "DE", RemoteOnly: &onsite}))
+	assert.Equal(t, []string{"online"}, slugs(models.MentorSearchFilter{Languages: []string{"en", "other"}}))
+}
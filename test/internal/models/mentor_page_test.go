@@ -0,0 +1,28 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentorPageCursorRoundTrip(t *testing.T) {
+	cursor := models.MentorPageCursorAfter(&models.Mentor{SortOrder: -3, MentorID: "7f1c2a9e-3b4d-4e5f-8a6b-1c2d3e4f5a6b"})
+
+	parsed, err := models.ParseMentorPageCursor(cursor.String())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+}
+
+func TestParseMentorPageCursor(t *testing.T) {
+	cursor, err := models.ParseMentorPageCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	for _, token := range []string{"not base64!", "MTI", "YWJjOmlk", "MTI6"} {
+		_, err := models.ParseMentorPageCursor(token)
+		assert.Error(t, err, token)
+	}
+}
@@ -53,6 +53,14 @@ func (m *mockRow) Scan(dest ...interface{}) error {
 				temp := num
 				*d = &temp
 			}
+		case *bool:
+			if b, ok := v.(bool); ok {
+				*d = b
+			}
+		case *[]string:
+			if items, ok := v.([]string); ok {
+				*d = items
+			}
 		case *time.Time:
 			if t, ok := v.(time.Time); ok {
 				*d = t
@@ -106,6 +114,15 @@ func TestScanMentor(t *testing.T) {
 			calendarURL,
 			sortOrder,
 			createdAt,
+			time.Now(),           // updated_at
+			0,                    // mentee_count
+			"Europe/Moscow",      // timezone
+			"10:00-19:00",        // contact_hours
+			true,                 // leaderboard_opt_in
+			"DE",                 // country
+			"Berlin",             // city
+			true,                 // remote_only
+			[]string{"ru", "en"}, // languages
 		},
 	}
 
@@ -157,6 +174,25 @@ func TestScanMentor(t *testing.T) {
 	if mentor.CalendarType != "calendly" {
 		t.Errorf("expected CalendarType 'calendly', got %s", mentor.CalendarType)
 	}
+
+	// Verify availability fields
+	if mentor.Timezone != "Europe/Moscow" {
+		t.Errorf("expected Timezone 'Europe/Moscow', got %s", mentor.Timezone)
+	}
+	if mentor.ContactHours != "10:00-19:00" {
+		t.Errorf("expected ContactHours '10:00-19:00', got %s", mentor.ContactHours)
+	}
+	if !mentor.LeaderboardOptIn {
+		t.Errorf("expected LeaderboardOptIn to be true")
+	}
+
+	// Verify location fields
+	if len(mentor.Languages) != 2 || mentor.Languages[1] != "en" {
+		t.Errorf("expected languages [ru en], got %v", mentor.Languages)
+	}
+	if mentor.Country != "DE" || mentor.City != "Berlin" || !mentor.RemoteOnly {
+		t.Errorf("expected location DE/Berlin/remote-only, got %s/%s/%v", mentor.Country, mentor.City, mentor.RemoteOnly)
+	}
 }
 
 // TestScanMentor_InactiveMentor verifies IsVisible computation for inactive mentors
@@ -214,3 +250,51 @@ func TestScanMentor_Error(t *testing.T) {
 		t.Errorf("expected pgx.ErrNoRows, got %v", err)
 	}
 }
+
+// TestScanMentor_BrokenCalendar verifies that a calendar link marked broken overrides the calendar type
+func TestScanMentor_BrokenCalendar(t *testing.T) {
+	row := &mockRow{
+		values: []interface{}{
+			"550e8400-e29b-41d4-a716-446655440000", // mentor_id
+			nil,                                    // airtable_id (null)
+			1,                                      // legacy_id
+			"test",                                 // slug
+			"Test",                                 // name
+			"Engineer",                             // job
+			"Company",                              // workplace
+			"About",                                // about
+			"Description",                          // description
+			"Skills",                               // competencies
+			"0-2",                                  // experience
+			"free",                                 // price
+			"active",                               // status
+			nil,                                    // tags (null)
+			nil,                                    // telegram_chat_id (null)
+			"https://calendly.com/test",            // calendar_url
+			0,                                      // sort_order
+			time.Now(),                             // created_at
+			time.Now(),                             // updated_at
+			0,                                      // mentee_count
+			nil,                                    // timezone
+			nil,                                    // contact_hours
+			false,                                  // leaderboard_opt_in
+			nil,                                    // country
+			nil,                                    // city
+			false,                                  // remote_only
+			[]string{},                             // languages
+			true,                                   // calendar_broken
+		},
+	}
+
+	mentor, err := models.ScanMentor(row)
+	if err != nil {
+		t.Fatalf("ScanMentor failed: %v", err)
+	}
+
+	if mentor.CalendarType != models.CalendarTypeBroken {
+		t.Errorf("expected CalendarType %q, got %q", models.CalendarTypeBroken, mentor.CalendarType)
+	}
+	if mentor.CalendarURL != "https://calendly.com/test" {
+		t.Errorf("expected CalendarURL to be kept, got %q", mentor.CalendarURL)
+	}
+}
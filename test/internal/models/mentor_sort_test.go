@@ -0,0 +1,119 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMentorSort(t *testing.T) {
+	sortBy, err := models.ParseMentorSort("")
+	require.NoError(t, err)
+	assert.Equal(t, models.MentorSortDefault, sortBy)
+
+	sortBy, err = models.ParseMentorSort("price_asc")
+	require.NoError(t, err)
+	assert.Equal(t, models.MentorSortPriceAsc, sortBy)
+
+	_, err = models.ParseMentorSort("rating")
+	assert.Error(t, err)
+}
+
+func TestMentorPriceValue(t *testing.T) {
+	cases := []struct {
+		price string
+		value int
+		ok    bool
+	}{
+		{"5000 ₽", 5000, true},
+		{"5 000 ₽", 5000, true},
+		{"5\u00a0000 ₽", 5000, true},
+		{"Бесплатно", 0, true},
+		{"от 3000 до 5000 ₽", 3000, true},
+		{"по договоренности", 0, false},
+		{"", 0, false},
+	}
+	for _, tc := range cases {
+		value, ok := models.MentorPriceValue(tc.price)
+		assert.Equal(t, tc.ok, ok, tc.price)
+		assert.Equal(t, tc.value, value, tc.price)
+	}
+}
+
+func sortTestMentors() []*models.Mentor {
+	now := time.Now()
+	return []*models.Mentor{
+		{Slug: "a", SortOrder: 1, MenteeCount: 5, Price: "5 000 ₽", CreatedAt: now.Add(-48 * time.Hour)},
+		{Slug: "b", SortOrder: 2, MenteeCount: 10, Price: "по договоренности", CreatedAt: now},
+		{Slug: "c", SortOrder: 3, MenteeCount: 5, Price: "Бесплатно", CreatedAt: now.Add(-24 * time.Hour)},
+	}
+}
+
+func slugsOf(mentors []*models.Mentor) []string {
+	slugs := make([]string, len(mentors))
+	for i, m := range mentors {
+		slugs[i] = m.Slug
+	}
+	return slugs
+}
+
+func TestSortMentors(t *testing.T) {
+	cases := map[models.MentorSort][]string{
+		models.MentorSortDefault:      {"a", "b", "c"},
+		models.MentorSortSessionsDesc: {"b", "a", "c"},
+		models.MentorSortNewest:       {"b", "c", "a"},
+		models.MentorSortPriceAsc:     {"c", "a", "b"},
+	}
+	for sortBy, want := range cases {
+		mentors := sortTestMentors()
+		models.SortMentors(mentors, sortBy, "")
+		assert.Equal(t, want, slugsOf(mentors), string(sortBy))
+	}
+}
+
+func TestMentorExposureBucket(t *testing.T) {
+	assert.Equal(t, models.MentorBucketNew, models.MentorExposureBucket(&models.Mentor{IsNew: true, MenteeCount: 50}))
+	assert.Equal(t, models.MentorBucketFewSessions, models.MentorExposureBucket(&models.Mentor{MenteeCount: 2}))
+	assert.Equal(t, models.MentorBucketEstablished, models.MentorExposureBucket(&models.Mentor{MenteeCount: 50}))
+}
+
+func TestSortMentorsDailyShuffleFavorsBoostedMentors(t *testing.T) {
+	mentors := make([]*models.Mentor, 0, 200)
+	for i := 0; i < 100; i++ {
+		mentors = append(mentors,
+			&models.Mentor{Slug: fmt.Sprintf("established-%d", i), MenteeCount: 50},
+			&models.Mentor{Slug: fmt.Sprintf("new-%d", i), IsNew: true})
+	}
+
+	models.SortMentors(mentors, models.MentorSortDailyShuffle, models.DailyShuffleSeed(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)))
+
+	newOnTop := 0
+	for _, m := range mentors[:models.MentorListFoldSize] {
+		if m.IsNew {
+			newOnTop++
+		}
+	}
+	// Weight 3 against 1: about three quarters of the first screen are new mentors
+	assert.Greater(t, newOnTop, models.MentorListFoldSize/2)
+}
+
+func TestDailyShuffleSeedUsesUTCDate(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	assert.Equal(t, "2026-02-28", models.DailyShuffleSeed(time.Date(2026, 3, 1, 1, 0, 0, 0, moscow)))
+}
+
+func TestSortMentorsRandomSeededIsStable(t *testing.T) {
+	first := sortTestMentors()
+	models.SortMentors(first, models.MentorSortRandomSeeded, "seed-1")
+
+	second := sortTestMentors()
+	second[0], second[2] = second[2], second[0]
+	models.SortMentors(second, models.MentorSortRandomSeeded, "seed-1")
+
+	assert.Equal(t, slugsOf(first), slugsOf(second))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slugsOf(first))
+}
@@ -0,0 +1,24 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentorStatsSetDeclineRate(t *testing.T) {
+	stats := models.MentorStats{Requests: 3, Declined: 1}
+	stats.SetDeclineRate()
+
+	require.NotNil(t, stats.DeclineRate)
+	assert.Equal(t, 0.33, *stats.DeclineRate)
+}
+
+func TestMentorStatsSetDeclineRate_NoRequests(t *testing.T) {
+	stats := models.MentorStats{}
+	stats.SetDeclineRate()
+
+	assert.Nil(t, stats.DeclineRate)
+}
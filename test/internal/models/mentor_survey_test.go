@@ -0,0 +1,53 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPSScore(t *testing.T) {
+	assert.Equal(t, 0.0, models.NPSScore(0, 0, 0))
+	assert.Equal(t, 100.0, models.NPSScore(4, 0, 4))
+	assert.Equal(t, -100.0, models.NPSScore(0, 3, 3))
+	assert.Equal(t, 33.3, models.NPSScore(2, 1, 3))
+}
+
+func TestQuarter(t *testing.T) {
+	at := time.Date(2026, 8, 15, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), models.QuarterStart(at))
+	assert.Equal(t, "2026-Q3", models.QuarterLabel(at))
+	assert.Equal(t, "2026-Q1", models.QuarterLabel(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2025-Q4", models.QuarterLabel(time.Date(2025, 12, 31, 23, 59, 0, 0, time.UTC)))
+}
+
+func TestMentorSurveyQuarter_Summary(t *testing.T) {
+	satisfaction := 4.25
+	q := models.MentorSurveyQuarter{
+		Start:              time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		Invited:            8,
+		Responded:          4,
+		Promoters:          2,
+		Passives:           1,
+		Detractors:         1,
+		AvgSatisfaction:    &satisfaction,
+		WouldContinueCount: 3,
+	}
+
+	summary := q.Summary()
+	assert.Equal(t, "2026-Q2", summary.Quarter)
+	assert.Equal(t, 0.5, summary.ResponseRate)
+	require.NotNil(t, summary.NPS)
+	assert.Equal(t, 25.0, *summary.NPS)
+	require.NotNil(t, summary.WouldContinueRate)
+	assert.Equal(t, 0.75, *summary.WouldContinueRate)
+	assert.Nil(t, summary.AvgRequestQuality)
+
+	empty := models.MentorSurveyQuarter{Start: q.Start, Invited: 5}.Summary()
+	assert.Nil(t, empty.NPS)
+	assert.Nil(t, empty.WouldContinueRate)
+	assert.Equal(t, 0.0, empty.ResponseRate)
+}
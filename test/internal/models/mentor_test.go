@@ -165,3 +165,30 @@ func TestMentorToPublicResponseWithEmptyTags(t *testing.T) {
 	assert.Equal(t, "", result.Tags, "Empty tags should result in empty string")
 	assert.Equal(t, "https://getmentor.dev/mentor/jane-doe", result.Link)
 }
+
+func TestMentorToPublicResponseRating(t *testing.T) {
+	rating := 4.5
+	mentor := &models.Mentor{LegacyID: 3, Slug: "rated", Tags: []string{}, Rating: &rating, ReviewCount: 4}
+
+	result := mentor.ToPublicResponse("https://getmentor.dev")
+	assert.Equal(t, &rating, result.Rating)
+	assert.Equal(t, 4, result.ReviewCount)
+
+	row := result.CSVRow()
+	assert.Len(t, row, len(models.PublicMentorCSVHeader))
+	assert.Equal(t, "4.5", row[10])
+	assert.Equal(t, "4", row[11])
+
+	unrated := (&models.Mentor{LegacyID: 4, Slug: "unrated", Tags: []string{}}).ToPublicResponse("https://getmentor.dev")
+	assert.Nil(t, unrated.Rating)
+	assert.Equal(t, "", unrated.CSVRow()[10])
+}
+
+func TestMentor_MatchesTagsAndExperience(t *testing.T) {
+	mentor := &models.Mentor{Tags: []string{"Go", "Management"}, Experience: "10+"}
+
+	assert.True(t, mentor.MatchesTagsAndExperience(nil, ""))
+	assert.True(t, mentor.MatchesTagsAndExperience([]string{"python", "go"}, "10+"))
+	assert.False(t, mentor.MatchesTagsAndExperience([]string{"python"}, ""))
+	assert.False(t, mentor.MatchesTagsAndExperience([]string{"go"}, "5-10"))
+}
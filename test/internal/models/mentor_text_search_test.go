@@ -0,0 +1,48 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentorSearchQueryFromKeywords(t *testing.T) {
+	assert.Equal(t, "python or machine learning", models.MentorSearchQueryFromKeywords(" python, ,machine learning,"))
+	assert.Equal(t, "", models.MentorSearchQueryFromKeywords(" , "))
+}
+
+func TestClampMentorSearchPage(t *testing.T) {
+	limit, offset := models.ClampMentorSearchPage(0, -5)
+	assert.Equal(t, models.DefaultMentorSearchLimit, limit)
+	assert.Equal(t, 0, offset)
+
+	limit, offset = models.ClampMentorSearchPage(1000, 40)
+	assert.Equal(t, models.MaxMentorSearchLimit, limit)
+	assert.Equal(t, 40, offset)
+}
+
+func TestParseMentorSearchKeywords_AppliesWeights(t *testing.T) {
+	keywords, err := models.ParseMentorSearchKeywords("Python, mentoring, python", map[string]float64{"python": 3, " Go ": 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.MentorSearchKeyword{
+		{Keyword: "Python", Weight: 3},
+		{Keyword: "mentoring", Weight: models.DefaultMentorKeywordWeight},
+		{Keyword: "go", Weight: 2},
+	}, keywords)
+	assert.Equal(t, "Python or mentoring or go", models.MentorSearchQueryFromWeightedKeywords(keywords))
+}
+
+func TestParseMentorSearchKeywords_RejectsInvalidWeights(t *testing.T) {
+	_, err := models.ParseMentorSearchKeywords("python", map[string]float64{"python": 0})
+	assert.Error(t, err)
+
+	_, err = models.ParseMentorSearchKeywords("python", map[string]float64{"python": models.MaxMentorKeywordWeight + 1})
+	assert.Error(t, err)
+}
+
+func TestSplitMentorSearchKeywords(t *testing.T) {
+	assert.Equal(t, []string{"management", "sales"}, models.SplitMentorSearchKeywords([]string{" management ", "", "sales"}))
+}
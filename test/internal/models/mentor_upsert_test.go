@@ -0,0 +1,23 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMentorUpsert(t *testing.T) {
+	valid := func(slug string) *models.Mentor {
+		return &models.Mentor{Slug: slug, Name: "Anna", Status: "active"}
+	}
+
+	assert.NoError(t, models.ValidateMentorUpsert(nil))
+	assert.NoError(t, models.ValidateMentorUpsert([]*models.Mentor{valid("anna-1"), valid("ivan-2")}))
+
+	assert.ErrorContains(t, models.ValidateMentorUpsert([]*models.Mentor{valid("anna-1"), valid("anna-1")}), "appears twice")
+	assert.ErrorContains(t, models.ValidateMentorUpsert([]*models.Mentor{{Name: "Anna", Status: "active"}}), "no slug")
+	assert.ErrorContains(t, models.ValidateMentorUpsert([]*models.Mentor{{Slug: "anna-1", Status: "active"}}), "no name")
+	assert.ErrorContains(t, models.ValidateMentorUpsert([]*models.Mentor{{Slug: "anna-1", Name: "Anna"}}), "no status")
+	assert.ErrorContains(t, models.ValidateMentorUpsert([]*models.Mentor{nil}), "is nil")
+}
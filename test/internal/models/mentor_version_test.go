@@ -0,0 +1,37 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatchVersion(t *testing.T) {
+	for _, header := range []string{`"7"`, `W/"7"`, `7`, ` "7" `} {
+		version, err := models.ParseIfMatchVersion(header)
+		require.NoError(t, err, header)
+		require.NotNil(t, version, header)
+		assert.Equal(t, 7, *version, header)
+	}
+
+	for _, header := range []string{"", "*"} {
+		version, err := models.ParseIfMatchVersion(header)
+		require.NoError(t, err, header)
+		assert.Nil(t, version, header)
+	}
+
+	for _, header := range []string{`"abc"`, `"0"`, `"1", "2"`, `"2026-01-01T00:00:00Z"`} {
+		_, err := models.ParseIfMatchVersion(header)
+		assert.Error(t, err, header)
+	}
+}
+
+func TestVersionETagRoundTrip(t *testing.T) {
+	assert.Equal(t, `"12"`, models.VersionETag(12))
+
+	version, err := models.ParseIfMatchVersion(models.VersionETag(12))
+	require.NoError(t, err)
+	assert.Equal(t, 12, *version)
+}
@@ -0,0 +1,30 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMergePatch(t *testing.T) {
+	patch, err := models.ParseMergePatch([]byte(`{"name": "Ivan", "calendarUrl": null, "tags": ["Go"]}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"calendarUrl", "name", "tags"}, patch.Keys())
+	assert.True(t, patch.IsNull("calendarUrl"))
+	assert.False(t, patch.IsNull("name"))
+	assert.False(t, patch.IsNull("missing"))
+
+	var name string
+	require.NoError(t, patch.Decode("name", &name))
+	assert.Equal(t, "Ivan", name)
+}
+
+func TestParseMergePatchRejectsNonObjects(t *testing.T) {
+	for _, body := range []string{"", "null", `["name"]`, `"name"`, `{"name":`} {
+		_, err := models.ParseMergePatch([]byte(body))
+		assert.Error(t, err, body)
+	}
+}
@@ -0,0 +1,45 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerationRules_Normalize(t *testing.T) {
+	rules := models.ModerationRules{BannedWords: []string{" Casino ", "casino", "", "easy  money", "Crypto"}}
+	rules.Normalize()
+	assert.Equal(t, []string{"casino", "crypto", "easy money"}, rules.BannedWords)
+}
+
+func TestModerationRules_FindBannedWord(t *testing.T) {
+	rules := models.ModerationRules{BannedWords: []string{"casino", "easy money", "казино"}}
+
+	tests := []struct {
+		name  string
+		texts []string
+		want  string
+	}{
+		{name: "no banned words", texts: []string{"Senior Go developer", "Mentoring juniors"}, want: ""},
+		{name: "case insensitive", texts: []string{"Best CASINO bonuses"}, want: "casino"},
+		{name: "punctuation around the word", texts: []string{"Ask me about casino!"}, want: "casino"},
+		{name: "phrase", texts: []string{"Learn how to make easy money fast"}, want: "easy money"},
+		{name: "part of a longer word", texts: []string{"Casinos and casinoroyale"}, want: ""},
+		{name: "cyrillic", texts: []string{"", "Онлайн-казино"}, want: "казино"},
+		{name: "cyrillic inside a word", texts: []string{"казиноленд"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rules.FindBannedWord(tt.texts...))
+		})
+	}
+}
+
+func TestDefaultModerationRules(t *testing.T) {
+	rules := models.DefaultModerationRules()
+	assert.Equal(t, 5, rules.MaxTags)
+	assert.Empty(t, rules.BannedWords)
+	assert.False(t, rules.AutoApprove.Enabled)
+}
@@ -0,0 +1,78 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursEnd_AcrossMidnight(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	// 23:30 in Moscow is inside 22:00-08:00; they end the next morning
+	end, quiet := models.QuietHoursEnd("22:00-08:00", "Europe/Moscow", time.Date(2026, 5, 1, 23, 30, 0, 0, moscow))
+	require.True(t, quiet)
+	assert.True(t, end.Equal(time.Date(2026, 5, 2, 8, 0, 0, 0, moscow)))
+
+	// 03:00 is inside as well and they end the same morning
+	end, quiet = models.QuietHoursEnd("22:00-08:00", "Europe/Moscow", time.Date(2026, 5, 2, 3, 0, 0, 0, moscow))
+	require.True(t, quiet)
+	assert.True(t, end.Equal(time.Date(2026, 5, 2, 8, 0, 0, 0, moscow)))
+
+	_, quiet = models.QuietHoursEnd("22:00-08:00", "Europe/Moscow", time.Date(2026, 5, 2, 8, 0, 0, 0, moscow))
+	assert.False(t, quiet)
+}
+
+func TestQuietHoursEnd_ReadsTheMentorTimezone(t *testing.T) {
+	// 20:00 UTC is 23:00 in Moscow but 13:00 in Los Angeles
+	now := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	_, quiet := models.QuietHoursEnd("22:00-08:00", "Europe/Moscow", now)
+	assert.True(t, quiet)
+	_, quiet = models.QuietHoursEnd("22:00-08:00", "America/Los_Angeles", now)
+	assert.False(t, quiet)
+}
+
+func TestQuietHoursEnd_UnsetOrInvalid(t *testing.T) {
+	now := time.Date(2026, 5, 1, 23, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct{ quietHours, timezone string }{
+		{"", "UTC"},
+		{"22:00-08:00", ""},
+		{"22:00-08:00", "Mars/Olympus"},
+		{"late", "UTC"},
+	} {
+		_, quiet := models.QuietHoursEnd(tt.quietHours, tt.timezone, now)
+		assert.False(t, quiet, "%q in %q", tt.quietHours, tt.timezone)
+	}
+}
+
+func TestBatchNotifications_DigestPerMentorAndChannel(t *testing.T) {
+	held := []models.QueuedNotification{
+		{ID: "1", MentorID: "ann", Channel: models.NotificationChannelMentorRequestCreated, RecordID: "r1"},
+		{ID: "2", MentorID: "bob", Channel: models.NotificationChannelMentorQuestion},
+		{ID: "3", MentorID: "ann", Channel: models.NotificationChannelMentorQuestion},
+		{ID: "4", MentorID: "ann", Channel: models.NotificationChannelMentorRequestCreated, RecordID: "r2"},
+		{ID: "5", MentorID: "ann", Channel: "other", RecordID: "x"},
+	}
+	digested := func(channel string) bool { return channel != "other" }
+
+	digests, individual := models.BatchNotifications(held, digested)
+
+	require.Len(t, digests, 2)
+	assert.Equal(t, "ann", digests[0].MentorID)
+	require.Len(t, digests[0].Batches, 2)
+	assert.Equal(t, models.NotificationChannelMentorRequestCreated, digests[0].Batches[0].Channel)
+	assert.Equal(t, 2, digests[0].Batches[0].Count)
+	assert.Equal(t, "r1", digests[0].Batches[0].Items[0].RecordID)
+	assert.Equal(t, "r2", digests[0].Batches[0].Items[1].RecordID)
+	assert.Equal(t, 1, digests[0].Batches[1].Count)
+	assert.Equal(t, "bob", digests[1].MentorID)
+
+	require.Len(t, individual, 1)
+	assert.Equal(t, "5", individual[0].ID)
+}
@@ -0,0 +1,38 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactPayload(t *testing.T) {
+	payload := json.RawMessage(`{
+		"type": "mentor_email_change_confirm",
+		"mentor_id": "m-1",
+		"new_email": "new@example.com",
+		"confirm_url": "https://getmentor.dev/mentor/email/confirm?token=secret",
+		"by_moderator": false,
+		"attendees": [{"name": "Ivan", "telegram": "ivan"}],
+		"note": "write to ivan@example.com"
+	}`)
+
+	var redacted map[string]interface{}
+	require.NoError(t, json.Unmarshal(models.RedactPayload(payload), &redacted))
+
+	assert.Equal(t, "mentor_email_change_confirm", redacted["type"])
+	assert.Equal(t, "m-1", redacted["mentor_id"])
+	assert.Equal(t, false, redacted["by_moderator"])
+	assert.Equal(t, models.RedactedValue, redacted["new_email"])
+	assert.Equal(t, models.RedactedValue, redacted["confirm_url"])
+	attendee := redacted["attendees"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, models.RedactedValue, attendee["name"])
+	assert.Equal(t, models.RedactedValue, attendee["telegram"])
+	assert.Equal(t, "write to [redacted]", redacted["note"])
+
+	assert.Nil(t, models.RedactPayload(nil))
+	assert.Equal(t, json.RawMessage(`not json`), models.RedactPayload(json.RawMessage(`not json`)))
+}
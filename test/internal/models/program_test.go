@@ -0,0 +1,38 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgram_IsOpenForRegistration(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	program := &models.Program{
+		Status:          models.ProgramStatusPublished,
+		StartsAt:        now.Add(time.Hour),
+		DurationMinutes: 90,
+	}
+	assert.True(t, program.IsOpenForRegistration(now))
+	assert.Equal(t, now.Add(150*time.Minute), program.EndsAt())
+
+	// Started programs are closed
+	assert.False(t, program.IsOpenForRegistration(now.Add(2*time.Hour)))
+
+	program.Status = models.ProgramStatusDraft
+	assert.False(t, program.IsOpenForRegistration(now))
+}
+
+func TestProgram_SeatsLeftAndFillRate(t *testing.T) {
+	program := &models.Program{Capacity: 4, RegisteredCount: 3}
+	assert.Equal(t, 1, program.SeatsLeft())
+	assert.InDelta(t, 0.75, program.FillRate(), 1e-9)
+
+	// Capacity lowered below the number of attendees
+	program.Capacity = 2
+	assert.Equal(t, 0, program.SeatsLeft())
+	assert.InDelta(t, 1.0, program.FillRate(), 1e-9)
+}
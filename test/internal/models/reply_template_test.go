@@ -0,0 +1,21 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderReplyTemplate(t *testing.T) {
+	values := map[string]string{
+		"name":         "Анна",
+		"calendar_url": "https://calendly.com/mentor",
+	}
+
+	text := models.RenderReplyTemplate("Привет, {{name}}! Запишись: {{ calendar_url }}", values)
+	assert.Equal(t, "Привет, Анна! Запишись: https://calendly.com/mentor", text)
+
+	// Unknown placeholders stay visible
+	assert.Equal(t, "Hi {{nmae}}", models.RenderReplyTemplate("Hi {{nmae}}", values))
+}
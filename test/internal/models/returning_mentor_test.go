@@ -0,0 +1,43 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoApproveRules_Allows(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		at := now.AddDate(0, 0, -days)
+		return &at
+	}
+	rules := models.AutoApproveRules{Enabled: true, MinCompletedSessions: 2, MaxInactiveDays: 180}
+
+	tests := []struct {
+		name   string
+		rules  models.AutoApproveRules
+		mentor models.ReturningMentor
+		want   bool
+	}{
+		{name: "eligible", rules: rules, mentor: models.ReturningMentor{CompletedSessions: 2, DeactivatedAt: daysAgo(180)}, want: true},
+		{name: "disabled", rules: models.AutoApproveRules{MinCompletedSessions: 2}, mentor: models.ReturningMentor{CompletedSessions: 5, DeactivatedAt: daysAgo(1)}, want: false},
+		{name: "too few sessions", rules: rules, mentor: models.ReturningMentor{CompletedSessions: 1, DeactivatedAt: daysAgo(1)}, want: false},
+		{name: "away too long", rules: rules, mentor: models.ReturningMentor{CompletedSessions: 3, DeactivatedAt: daysAgo(181)}, want: false},
+		{name: "unknown departure", rules: rules, mentor: models.ReturningMentor{CompletedSessions: 3}, want: false},
+		{
+			name:   "any gap allowed",
+			rules:  models.AutoApproveRules{Enabled: true, MaxInactiveDays: 0},
+			mentor: models.ReturningMentor{},
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rules.Allows(&tt.mentor, now))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRescheduleProposal_IsOpenAndHasSlot(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	slot := now.Add(24 * time.Hour)
+
+	proposal := &models.RescheduleProposal{
+		Slots:     []time.Time{slot, slot.Add(time.Hour)},
+		Status:    models.RescheduleProposalPending,
+		ExpiresAt: slot.Add(time.Hour),
+	}
+	assert.True(t, proposal.IsOpen(now))
+	assert.True(t, proposal.HasSlot(slot.In(time.FixedZone("MSK", 3*60*60))))
+	assert.False(t, proposal.HasSlot(slot.Add(30*time.Minute)))
+
+	// Expired and replaced proposals can't be confirmed
+	assert.False(t, proposal.IsOpen(slot.Add(2*time.Hour)))
+	proposal.Status = models.RescheduleProposalSuperseded
+	assert.False(t, proposal.IsOpen(now))
+}
@@ -0,0 +1,35 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestTags(t *testing.T) {
+	tags := []string{"Go", "Git", "Kubernetes", "PostgreSQL", "Machine Learning", "C++", "Эксперт Авито"}
+	text := "Пишу на Go и C++, поднимаю кластеры Kubernets, люблю Postgres. Machine learning тоже. Эксперт Авито"
+
+	suggestions := models.SuggestTags(text, tags, nil, 0)
+
+	names := []string{}
+	for _, s := range suggestions {
+		names = append(names, s.Tag)
+	}
+	assert.ElementsMatch(t, []string{"Go", "C++", "Kubernetes", "Machine Learning", "PostgreSQL"}, names)
+	// Exact mentions rank before fuzzy ones
+	assert.Equal(t, 1.0, suggestions[0].Score)
+	assert.Less(t, suggestions[len(suggestions)-1].Score, 1.0)
+}
+
+func TestSuggestTags_ExcludeAndLimit(t *testing.T) {
+	tags := []string{"Python", "Django", "Flask"}
+	text := "Python, Django, Flask, Python"
+
+	suggestions := models.SuggestTags(text, tags, []string{"django"}, 1)
+
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, "Python", suggestions[0].Tag)
+	assert.Equal(t, 2, suggestions[0].Mentions)
+}
@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	metrics.Init("test")
+}
+
+// Profile fields come from the Airtable record; the ID and slug stay those of PostgreSQL
+func TestAirtableMentorDataSource_AppliesRecordFields(t *testing.T) {
+	pool := getTestPool(t)
+	mentorID := createTestMentor(t, pool)
+	recordID := fmt.Sprintf("rec%d", time.Now().UnixNano())
+	var slug string
+	require.NoError(t, pool.QueryRow(context.Background(),
+		`UPDATE mentors SET airtable_id = $1 WHERE id = $2 RETURNING slug`, recordID, mentorID).Scan(&slug))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"records":[{"id":%q,"fields":{"Name":"Airtable Name","JobTitle":"Staff Engineer","Status":"active"}}]}`, recordID)
+	}))
+	defer server.Close()
+	client, err := airtable.NewClient(server.URL, "appBase", "token", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	source := repository.NewAirtableMentorDataSource(client, "Mentors", repository.NewMentorRepository(pool, nil, nil, true))
+
+	mentor, err := source.FetchSingleMentor(context.Background(), slug)
+	require.NoError(t, err)
+	assert.Equal(t, mentorID, mentor.MentorID)
+	assert.Equal(t, slug, mentor.Slug)
+	assert.Equal(t, "Airtable Name", mentor.Name)
+	assert.Equal(t, "Staff Engineer", mentor.Job)
+
+	mentors, err := source.FetchAllMentors(context.Background())
+	require.NoError(t, err)
+	found := false
+	for _, m := range mentors {
+		if m.MentorID == mentorID {
+			found = true
+			assert.Equal(t, "Airtable Name", m.Name)
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A committed save is mirrored to the mentor's Airtable record; a rolled-back one is not
+func TestDualWriteMentorRepository_MirrorsCommittedWrites(t *testing.T) {
+	pool := getTestPool(t)
+	mentorID := createTestMentor(t, pool)
+	recordID := fmt.Sprintf("rec%d", time.Now().UnixNano())
+	_, err := pool.Exec(context.Background(), `UPDATE mentors SET airtable_id = $1 WHERE id = $2`, recordID, mentorID)
+	require.NoError(t, err)
+
+	patches := make(chan map[string]interface{}, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Records []airtable.Record `json:"records"`
+		}
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		for _, record := range body.Records {
+			if record.ID == recordID {
+				patches <- record.Fields
+			}
+		}
+		_, _ = w.Write([]byte(`{"records":[]}`))
+	}))
+	defer server.Close()
+	client, err := airtable.NewClient(server.URL, "appBase", "token", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	repo := repository.NewDualWriteMentorRepository(repository.NewMentorRepository(pool, nil, nil, true),
+		repository.NewAirtableSyncRepository(pool), client, "Mentors")
+	uow := repository.NewUnitOfWork(pool)
+
+	rollback := errors.New("rolled back")
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, repo.UpdateIfUnmodified(ctx, mentorID, map[string]interface{}{"name": "Discarded Name"}, repository.MentorPrecondition{}))
+		return rollback
+	})
+	require.ErrorIs(t, err, rollback)
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		return repo.UpdateIfUnmodified(ctx, mentorID, map[string]interface{}{"name": "Mirrored Name"}, repository.MentorPrecondition{})
+	})
+	require.NoError(t, err)
+
+	select {
+	case fields := <-patches:
+		assert.Equal(t, "Mirrored Name", fields["Name"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("the committed save was not mirrored")
+	}
+	assert.Empty(t, patches, "the rolled-back save must not be mirrored")
+}
@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// getTestPool connects to DATABASE_URL with the schema migrated, or skips the test
+func getTestPool(t *testing.T) *pgxpool.Pool {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL not set, skipping repository test")
+	}
+	require.NoError(t, db.RunMigrations(dbURL))
+
+	pool, err := pgxpool.New(context.Background(), dbURL)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// createTestMentor inserts an active mentor deleted again when the test ends
+func createTestMentor(t *testing.T, pool *pgxpool.Pool) string {
+	id, _, _, err := repository.NewMentorRepository(pool, nil, nil, true).CreateMentor(context.Background(), map[string]interface{}{
+		"name":         "Test Mentor",
+		"email":        fmt.Sprintf("test-mentor-%d@example.com", time.Now().UnixNano()),
+		"telegram":     "test_mentor",
+		"job_title":    "Engineer",
+		"workplace":    "GetMentor",
+		"experience":   "5-10",
+		"price":        "Free",
+		"about":        "About",
+		"details":      "Details",
+		"competencies": "Go",
+		"status":       "active",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM mentors WHERE id = $1`, id) //nolint:errcheck
+	})
+	return id
+}
+
+// createTestModerator inserts a moderator deleted again when the test ends
+func createTestModerator(t *testing.T, pool *pgxpool.Pool) string {
+	var id string
+	require.NoError(t, pool.QueryRow(context.Background(),
+		`INSERT INTO moderators (name, role) VALUES ('Test Moderator', 'moderator') RETURNING id`).Scan(&id))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM moderators WHERE id = $1`, id) //nolint:errcheck
+	})
+	return id
+}
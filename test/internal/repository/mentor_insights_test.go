@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Requests that arrived during a vacation count their time to answer from its end; an answer
+// given during the vacation counts as immediate
+func TestListRequestStats_SLAStarts(t *testing.T) {
+	pool := getTestPool(t)
+	ctx := context.Background()
+	repo := repository.NewMentorInsightsRepository(pool)
+	mentorID := createTestMentor(t, pool)
+	_, err := pool.Exec(ctx, `UPDATE mentors SET calendar_url = 'https://example.com/vacation.ics' WHERE id = $1`, mentorID)
+	require.NoError(t, err)
+
+	created := time.Now().Add(-10 * 24 * time.Hour).Truncate(time.Second)
+	answeredIn2h := createTestRequest(t, pool, mentorID, created, created.Add(2*time.Hour))
+	answeredAfterVacation := createTestRequest(t, pool, mentorID, created, created.Add(5*24*time.Hour))
+	answeredDuringVacation := createTestRequest(t, pool, mentorID, created, created.Add(time.Hour))
+	unanswered := createTestRequest(t, pool, mentorID, created, time.Time{})
+
+	since := created.Add(-time.Hour)
+	calendars, err := repo.ListRequestCalendars(ctx, since)
+	require.NoError(t, err)
+	ids := []string{}
+	for _, rc := range calendars {
+		if rc.MentorID == mentorID {
+			assert.Equal(t, "https://example.com/vacation.ics", rc.CalendarURL)
+			ids = append(ids, rc.RequestID)
+		}
+	}
+	assert.ElementsMatch(t, []string{answeredIn2h, answeredAfterVacation, answeredDuringVacation, unanswered}, ids)
+
+	vacationEnd := created.Add(5*24*time.Hour - time.Hour)
+	stats, err := repo.ListRequestStats(ctx, since, 48, map[string]time.Time{
+		answeredAfterVacation:  vacationEnd,
+		answeredDuringVacation: vacationEnd,
+		unanswered:             vacationEnd,
+	})
+	require.NoError(t, err)
+
+	var mentorStats *models.MentorRequestStats
+	for _, st := range stats {
+		if st.MentorID == mentorID {
+			mentorStats = st
+		}
+	}
+	require.NotNil(t, mentorStats)
+	assert.Equal(t, 4, mentorStats.Requests)
+	assert.Equal(t, 3, mentorStats.Responded)
+	assert.Equal(t, 3, mentorStats.RespondedWithinSLA)
+	require.NotNil(t, mentorStats.MedianResponseHours)
+	assert.InDelta(t, 1.0, *mentorStats.MedianResponseHours, 0.001)
+}
+
+// createTestRequest inserts a request to the mentor, answered at firstResponse unless it is zero
+func createTestRequest(t *testing.T, pool *pgxpool.Pool, mentorID string, createdAt, firstResponse time.Time) string {
+	status := "contacted"
+	var answeredAt *time.Time
+	if firstResponse.IsZero() {
+		status = "pending"
+	} else {
+		answeredAt = &firstResponse
+	}
+
+	var id string
+	require.NoError(t, pool.QueryRow(context.Background(), `
+		INSERT INTO client_requests (mentor_id, email, name, status, created_at, first_response_at)
+		VALUES ($1, 'mentee@example.com', 'Test Mentee', $2, $3, $4)
+		RETURNING id`, mentorID, status, createdAt, answeredAt).Scan(&id))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM client_requests WHERE id = $1`, id) //nolint:errcheck
+	})
+	return id
+}
@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mentorUpdatedAt(t *testing.T, pool *pgxpool.Pool, mentorID string) time.Time {
+	var updatedAt time.Time
+	require.NoError(t, pool.QueryRow(context.Background(), `SELECT updated_at FROM mentors WHERE id = $1`, mentorID).Scan(&updatedAt))
+	return updatedAt
+}
+
+// Logging in must not invalidate an update that expects the updated_at read before it
+func TestLoginToken_KeepsUpdatedAtPrecondition(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createTestMentor(t, pool)
+	ctx := context.Background()
+
+	before := mentorUpdatedAt(t, pool, mentorID)
+	require.NoError(t, repo.SetLoginToken(ctx, mentorID, "login-token", time.Now().Add(time.Hour)))
+	require.NoError(t, repo.ClearLoginToken(ctx, mentorID))
+	assert.True(t, before.Equal(mentorUpdatedAt(t, pool, mentorID)))
+
+	err := repo.UpdateIfUnmodified(ctx, mentorID, map[string]interface{}{"name": "Renamed Mentor"},
+		repository.MentorPrecondition{UpdatedAt: &before})
+	require.NoError(t, err)
+}
+
+// The hot statements run on a pool made with pgxpool.New, without db.NewPool's setup
+func TestHotStatements_RunOnPlainPool(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createTestMentor(t, pool)
+	ctx := context.Background()
+
+	var slug string
+	require.NoError(t, pool.QueryRow(ctx, `SELECT slug FROM mentors WHERE id = $1`, mentorID).Scan(&slug))
+	mentor, err := repo.FetchSingleMentorFromDB(ctx, slug)
+	require.NoError(t, err)
+	assert.Equal(t, mentorID, mentor.MentorID)
+
+	requests, err := repository.NewClientRequestRepository(pool).GetByMentor(ctx, mentorID, []models.RequestStatus{models.StatusPending})
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+}
@@ -0,0 +1,52 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A second upsert of the same slug updates the row, moves its version on and replaces its tags
+func TestUpsertMentors_InsertsThenUpdatesBySlug(t *testing.T) {
+	pool := getTestPool(t)
+	ctx := context.Background()
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	slug := fmt.Sprintf("upsert-test-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM mentors WHERE slug = $1`, slug) //nolint:errcheck
+	})
+
+	mentor := &models.Mentor{Slug: slug, Name: "Upsert Mentor", Status: "pending", Tags: []string{"Backend"}}
+	result, err := repo.UpsertMentors(ctx, []*models.Mentor{mentor})
+	require.NoError(t, err)
+	assert.Equal(t, &models.MentorUpsertResult{Inserted: 1}, result)
+
+	var mentorID string
+	require.NoError(t, pool.QueryRow(ctx, `SELECT id FROM mentors WHERE slug = $1`, slug).Scan(&mentorID))
+	before, err := repo.GetVersion(ctx, mentorID)
+	require.NoError(t, err)
+
+	mentor.Name = "Upsert Mentor Renamed"
+	mentor.Tags = []string{"Frontend"}
+	result, err = repo.UpsertMentors(ctx, []*models.Mentor{mentor})
+	require.NoError(t, err)
+	assert.Equal(t, &models.MentorUpsertResult{Updated: 1}, result)
+
+	after, err := repo.GetVersion(ctx, mentorID)
+	require.NoError(t, err)
+	assert.Equal(t, before+1, after)
+
+	var name string
+	var tags []string
+	require.NoError(t, pool.QueryRow(ctx, `
+		SELECT m.name, ARRAY(SELECT t.name FROM mentor_tags mt JOIN tags t ON t.id = mt.tag_id WHERE mt.mentor_id = m.id)
+		FROM mentors m WHERE m.id = $1`, mentorID).Scan(&name, &tags))
+	assert.Equal(t, "Upsert Mentor Renamed", name)
+	assert.Equal(t, []string{"Frontend"}, tags)
+}
@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Signing in, checking the calendar link and rotating the feed token leave the version alone,
+// so an ETag read before them still matches
+func TestSignIn_KeepsETag(t *testing.T) {
+	pool := getTestPool(t)
+	ctx := context.Background()
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createTestMentor(t, pool)
+
+	calendarURL := "https://cal.com/version-test"
+	_, err := pool.Exec(ctx, `UPDATE mentors SET calendar_url = $2 WHERE id = $1`, mentorID, calendarURL)
+	require.NoError(t, err)
+
+	etag, err := repo.GetVersion(ctx, mentorID)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SetLoginToken(ctx, mentorID, fmt.Sprintf("token-%d", time.Now().UnixNano()), time.Now().Add(time.Hour)))
+	require.NoError(t, repo.ClearLoginToken(ctx, mentorID))
+	require.NoError(t, repo.SetCalendarFeedToken(ctx, mentorID, fmt.Sprintf("feed-%d", time.Now().UnixNano())))
+	_, err = repository.NewCalendarLinkRepository(pool).RecordCheck(ctx,
+		&models.CalendarLink{MentorID: mentorID, URL: calendarURL}, false, 3)
+	require.NoError(t, err)
+
+	current, err := repo.GetVersion(ctx, mentorID)
+	require.NoError(t, err)
+	assert.Equal(t, etag, current)
+
+	err = repo.UpdateIfUnmodified(ctx, mentorID, map[string]interface{}{"name": "Renamed Mentor"},
+		repository.MentorPrecondition{Version: &etag})
+	require.NoError(t, err)
+	current, err = repo.GetVersion(ctx, mentorID)
+	require.NoError(t, err)
+	assert.Equal(t, etag+1, current, "a profile save moves the version on")
+}
+
+// A merge moves both rows on, the primary also when it only takes over the Airtable record
+func TestMentorMerge_AdvancesVersions(t *testing.T) {
+	pool := getTestPool(t)
+	ctx := context.Background()
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	primaryID := createTestMentor(t, pool)
+	duplicateID := createTestMentor(t, pool)
+	_, err := pool.Exec(ctx, `UPDATE mentors SET airtable_id = $1 WHERE id = $2`,
+		fmt.Sprintf("recVersion%d", time.Now().UnixNano()), duplicateID)
+	require.NoError(t, err)
+
+	primaryBefore, err := repo.GetVersion(ctx, primaryID)
+	require.NoError(t, err)
+	duplicateBefore, err := repo.GetVersion(ctx, duplicateID)
+	require.NoError(t, err)
+
+	moderatorID := createTestModerator(t, pool)
+	var result *models.MentorMergeResult
+	err = repository.NewUnitOfWork(pool).Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = repository.NewMentorMergeRepository(pool).Merge(ctx, primaryID, duplicateID, moderatorID)
+		return err
+	})
+	require.NoError(t, err)
+	require.True(t, result.AirtableIDMoved)
+
+	primaryAfter, err := repo.GetVersion(ctx, primaryID)
+	require.NoError(t, err)
+	duplicateAfter, err := repo.GetVersion(ctx, duplicateID)
+	require.NoError(t, err)
+	assert.Greater(t, primaryAfter, primaryBefore)
+	assert.Greater(t, duplicateAfter, duplicateBefore)
+}
@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quarantinedIDs(t *testing.T, repo *repository.ClientRequestRepository) []string {
+	requests, err := repo.ListQuarantined(context.Background())
+	require.NoError(t, err)
+	ids := make([]string, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.ID)
+	}
+	return ids
+}
+
+// A quarantined request waits in the admin queue and stays hidden from the mentor until released
+func TestClientRequests_QuarantineRelease(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewClientRequestRepository(pool)
+	mentorID := createTestMentor(t, pool)
+	moderatorID := createTestModerator(t, pool)
+
+	requestID, err := repo.Create(context.Background(), &models.ClientRequest{
+		MentorID:    mentorID,
+		Email:       "mentee@example.com",
+		Name:        "Mentee",
+		Level:       "Middle",
+		Description: "Intro",
+		FlagReason:  "blocklist:1",
+		Quarantined: true,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, quarantinedIDs(t, repo), requestID)
+	_, err = repo.GetByID(context.Background(), requestID)
+	assert.Error(t, err, "the mentor must not see a quarantined request")
+
+	require.NoError(t, repo.SetQuarantineStatus(context.Background(), requestID, models.QuarantineStatusReleased, moderatorID))
+
+	assert.NotContains(t, quarantinedIDs(t, repo), requestID)
+	released, err := repo.GetByID(context.Background(), requestID)
+	require.NoError(t, err)
+	assert.Equal(t, requestID, released.ID)
+
+	// A verdict is given once
+	err = repo.SetQuarantineStatus(context.Background(), requestID, models.QuarantineStatusDiscarded, moderatorID)
+	assert.ErrorIs(t, err, repository.ErrQuarantinedRequestNotFound)
+}
+
+func TestClientRequests_QuarantineDiscard(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewClientRequestRepository(pool)
+	mentorID := createTestMentor(t, pool)
+
+	requestID, err := repo.Create(context.Background(), &models.ClientRequest{
+		MentorID:    mentorID,
+		Email:       "spam@example.com",
+		Name:        "Spam",
+		Level:       "Junior",
+		Description: "Buy now",
+		Quarantined: true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SetQuarantineStatus(context.Background(), requestID, models.QuarantineStatusDiscarded, ""))
+
+	assert.NotContains(t, quarantinedIDs(t, repo), requestID)
+	_, err = repo.GetByID(context.Background(), requestID)
+	assert.Error(t, err, "a discarded request never reaches the mentor")
+}
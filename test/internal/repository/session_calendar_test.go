@@ -0,0 +1,72 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scheduledSession(t *testing.T, repo *repository.ClientRequestRepository, mentorID, requestID string) *models.ScheduledSession {
+	sessions, err := repo.ListScheduledByMentor(context.Background(), mentorID, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	for _, session := range sessions {
+		if session.RequestID == requestID {
+			return session
+		}
+	}
+	t.Fatalf("session %s not in the feed", requestID)
+	return nil
+}
+
+// Rescheduling or closing a session raises its SEQUENCE so subscribed calendars replace their copy
+func TestSessionCalendar_SequenceGrowsOnReschedule(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewClientRequestRepository(pool)
+	mentorID := createTestMentor(t, pool)
+
+	requestID, err := repo.Create(context.Background(), &models.ClientRequest{
+		MentorID: mentorID, Email: "mentee@example.com", Name: "Mentee", Level: "Middle", Description: "Intro",
+	})
+	require.NoError(t, err)
+
+	first := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	require.NoError(t, repo.UpdateScheduledAt(context.Background(), requestID, first))
+	scheduled := scheduledSession(t, repo, mentorID, requestID)
+	assert.True(t, first.Equal(scheduled.ScheduledAt))
+
+	require.NoError(t, repo.UpdateScheduledAt(context.Background(), requestID, first.Add(time.Hour)))
+	rescheduled := scheduledSession(t, repo, mentorID, requestID)
+	assert.Equal(t, scheduled.Sequence+1, rescheduled.Sequence)
+
+	require.NoError(t, repo.UpdateStatus(context.Background(), requestID, models.StatusDeclined))
+	declined := scheduledSession(t, repo, mentorID, requestID)
+	assert.Equal(t, rescheduled.Sequence+1, declined.Sequence)
+	assert.Equal(t, models.StatusDeclined, declined.Status)
+}
+
+func TestSessionCalendar_FeedToken(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createTestMentor(t, pool)
+
+	token, err := repo.GetCalendarFeedToken(context.Background(), mentorID)
+	require.NoError(t, err)
+	assert.Empty(t, token, "no token is issued before the mentor asks for the feed")
+
+	issued := fmt.Sprintf("feed-token-%d", time.Now().UnixNano())
+	require.NoError(t, repo.SetCalendarFeedToken(context.Background(), mentorID, issued))
+	found, err := repo.GetIDByCalendarFeedToken(context.Background(), issued)
+	require.NoError(t, err)
+	assert.Equal(t, mentorID, found)
+
+	// Rotating the token retires the previous subscription URL
+	require.NoError(t, repo.SetCalendarFeedToken(context.Background(), mentorID, issued+"-rotated"))
+	_, err = repo.GetIDByCalendarFeedToken(context.Background(), issued)
+	assert.ErrorIs(t, err, repository.ErrCalendarFeedNotFound)
+}
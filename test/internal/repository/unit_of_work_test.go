@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unitOfWorkStore returns a repository writing through the unit of work of its context, and
+// the prefix of the contact limit subjects only this test writes
+func unitOfWorkStore(t *testing.T) (*pgxpool.Pool, *repository.ContactLimitRepository, string) {
+	pool := getTestPool(t)
+	prefix := fmt.Sprintf("uow-%d-", time.Now().UnixNano())
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), //nolint:errcheck
+			`DELETE FROM contact_submission_counters WHERE subject LIKE $1`, prefix+"%")
+	})
+	return pool, repository.NewContactLimitRepository(pool), prefix
+}
+
+// insert writes one row for value, in ctx's transaction when there is one
+func insert(ctx context.Context, repo *repository.ContactLimitRepository, prefix, value string) error {
+	now := time.Now()
+	_, _, err := repo.Record(ctx, "email", prefix+value, now.Truncate(time.Hour), now.Truncate(time.Hour).Add(-time.Hour))
+	return err
+}
+
+// storedValues returns the values written and committed, read outside any transaction
+func storedValues(t *testing.T, pool *pgxpool.Pool, prefix string) []string {
+	rows, err := pool.Query(context.Background(),
+		`SELECT substr(subject, $2) FROM contact_submission_counters WHERE subject LIKE $1 ORDER BY subject`,
+		prefix+"%", len(prefix)+1)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	values := []string{}
+	for rows.Next() {
+		var value string
+		require.NoError(t, rows.Scan(&value))
+		values = append(values, value)
+	}
+	require.NoError(t, rows.Err())
+	return values
+}
+
+func TestUnitOfWork_CommitsAndRunsHooksAfterCommit(t *testing.T) {
+	pool, repo, prefix := unitOfWorkStore(t)
+	uow := repository.NewUnitOfWork(pool)
+
+	var hooks []string
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		repository.AfterCommit(ctx, func() { hooks = append(hooks, "first") })
+		require.NoError(t, insert(ctx, repo, prefix, "a"))
+		// A nested unit of work joins the outer transaction
+		require.NoError(t, uow.Do(ctx, func(ctx context.Context) error {
+			repository.AfterCommit(ctx, func() { hooks = append(hooks, "second") })
+			return insert(ctx, repo, prefix, "b")
+		}))
+		assert.Empty(t, hooks, "hooks must wait for the commit")
+		assert.Empty(t, storedValues(t, pool, prefix), "writes must not be visible before the commit")
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, hooks)
+	assert.Equal(t, []string{"a", "b"}, storedValues(t, pool, prefix))
+}
+
+func TestUnitOfWork_RollsBackAndCompensatesInReverseOrder(t *testing.T) {
+	pool, repo, prefix := unitOfWorkStore(t)
+	uow := repository.NewUnitOfWork(pool)
+	failure := errors.New("step failed")
+
+	var compensated []string
+	hookRan := false
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, insert(ctx, repo, prefix, "a"))
+		repository.OnRollback(ctx, func(context.Context) error {
+			compensated = append(compensated, "upload")
+			return nil
+		})
+		repository.OnRollback(ctx, func(context.Context) error {
+			compensated = append(compensated, "airtable")
+			return nil
+		})
+		repository.AfterCommit(ctx, func() { hookRan = true })
+		return failure
+	})
+
+	require.ErrorIs(t, err, failure)
+	assert.Equal(t, []string{"airtable", "upload"}, compensated)
+	assert.False(t, hookRan)
+	assert.Empty(t, storedValues(t, pool, prefix))
+}
+
+func TestUnitOfWork_RehearseDiscardsWrites(t *testing.T) {
+	pool, repo, prefix := unitOfWorkStore(t)
+	uow := repository.NewUnitOfWork(pool)
+
+	hookRan := false
+	err := uow.Rehearse(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, insert(ctx, repo, prefix, "a"))
+		repository.AfterCommit(ctx, func() { hookRan = true })
+
+		counts, err := repo.List(ctx, "email", prefix+"a", time.Now().Truncate(time.Hour), time.Now().Truncate(time.Hour).Add(-time.Hour), 10)
+		require.NoError(t, err)
+		assert.Len(t, counts, 1, "writes must be visible inside the rehearsal")
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.False(t, hookRan)
+	assert.Empty(t, storedValues(t, pool, prefix))
+}
+
+func TestUnitOfWork_RehearseInsideDoKeepsOuterWrites(t *testing.T) {
+	pool, repo, prefix := unitOfWorkStore(t)
+	uow := repository.NewUnitOfWork(pool)
+
+	err := uow.Do(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, insert(ctx, repo, prefix, "kept"))
+		return uow.Rehearse(ctx, func(ctx context.Context) error {
+			return insert(ctx, repo, prefix, "discarded")
+		})
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kept"}, storedValues(t, pool, prefix))
+}
+
+// Outside a unit of work hooks run at once and compensations are dropped
+func TestUnitOfWork_HooksOutsideUnitOfWork(t *testing.T) {
+	hookRan := false
+	repository.AfterCommit(context.Background(), func() { hookRan = true })
+	assert.True(t, hookRan)
+
+	repository.OnRollback(context.Background(), func(context.Context) error {
+		t.Fatal("compensation must not run outside a unit of work")
+		return nil
+	})
+}
+
+// A read snapshot doesn't see rows committed after its first query
+func TestUnitOfWork_ReadSnapshotKeepsItsView(t *testing.T) {
+	pool, repo, prefix := unitOfWorkStore(t)
+	uow := repository.NewUnitOfWork(pool)
+	require.NoError(t, insert(context.Background(), repo, prefix, "a"))
+
+	list := func(ctx context.Context) int {
+		now := time.Now()
+		counts, err := repo.List(ctx, "email", prefix+"b", now.Truncate(time.Hour), now.Truncate(time.Hour).Add(-time.Hour), 10)
+		require.NoError(t, err)
+		return len(counts)
+	}
+
+	err := uow.ReadSnapshot(context.Background(), func(ctx context.Context) error {
+		assert.Equal(t, 0, list(ctx))
+		require.NoError(t, insert(context.Background(), repo, prefix, "b"))
+		assert.Equal(t, 0, list(ctx), "rows committed during the snapshot must stay invisible")
+
+		assert.Error(t, insert(ctx, repo, prefix, "c"), "a snapshot is read-only")
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, storedValues(t, pool, prefix))
+}
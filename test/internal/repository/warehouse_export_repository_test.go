@@ -0,0 +1,51 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GetWatermark reads the watermark AdvanceWatermark left, without waiting for its lock
+func TestWarehouseExport_GetWatermark(t *testing.T) {
+	pool := getTestPool(t)
+	repo := repository.NewWarehouseExportRepository(pool)
+	uow := repository.NewUnitOfWork(pool)
+	stream := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM warehouse_export_watermarks WHERE stream = $1`, stream) //nolint:errcheck
+	})
+
+	watermark, err := repo.GetWatermark(context.Background(), stream)
+	require.NoError(t, err)
+	assert.Nil(t, watermark, "a stream that never ran has no watermark")
+
+	at := time.Date(2026, 5, 6, 7, 8, 9, 0, time.UTC)
+	cursor := models.HistoryCursor{At: at, ID: "00000000-0000-0000-0000-000000000001"}
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		locked, err := repo.LockWatermark(ctx, stream)
+		require.NoError(t, err)
+		require.NotNil(t, locked)
+		if err := repo.AdvanceWatermark(ctx, stream, cursor, 3); err != nil {
+			return err
+		}
+
+		// Another connection still reads the committed watermark while this one holds the lock
+		_, err = repo.GetWatermark(context.Background(), stream)
+		return err
+	})
+	require.NoError(t, err)
+
+	watermark, err = repo.GetWatermark(context.Background(), stream)
+	require.NoError(t, err)
+	require.NotNil(t, watermark)
+	assert.True(t, watermark.Cursor.At.Equal(at))
+	assert.Equal(t, cursor.ID, watermark.Cursor.ID)
+	assert.Equal(t, int64(3), watermark.RowsExported)
+}
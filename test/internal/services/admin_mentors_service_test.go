@@ -0,0 +1,20 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/stretchr/testify/assert"
+)
+
+// Only admins may delete mentors; the role check runs before any database access
+func TestDeleteMentor_RejectsModerators(t *testing.T) {
+	service := services.NewAdminMentorsService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	moderator := &models.AdminSession{ModeratorID: "m1", Role: models.ModeratorRoleModerator}
+	err := service.DeleteMentor(context.Background(), moderator, "550e8400-e29b-41d4-a716-446655440000")
+	assert.True(t, errors.Is(err, services.ErrAdminForbiddenAction))
+}
@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHTTPClient records requests and responds with a fixed status code
+type recordingHTTPClient struct {
+	requests   []*http.Request
+	statusCode int
+}
+
+func (c *recordingHTTPClient) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	req, _ := http.NewRequest(http.MethodPost, url, body)
+	return c.Do(req)
+}
+
+func (c *recordingHTTPClient) Get(url string) (*http.Response, error) {
+	req, _ := http.NewRequest(http.MethodGet, url, http.NoBody)
+	return c.Do(req)
+}
+
+func (c *recordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, req)
+	return &http.Response{StatusCode: c.statusCode, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func newWebhooksTestService(client *recordingHTTPClient, sandboxURL string) *services.AdminWebhooksService {
+	cfg := &config.Config{
+		EventTriggers: config.EventTriggerFunctionsConfig{
+			MentorCreatedTriggerURL:     "https://triggers.example.com/mentor-created?record_id=",
+			MentorModerationTriggerURL:  "https://triggers.example.com/moderation",
+			MentorLoginEmailTriggerURL:  "https://triggers.example.com/mentor-login",
+			MentorEmailChangeTriggerURL: "https://triggers.example.com/email-change",
+			WebhookTestSandboxURL:       sandboxURL,
+		},
+	}
+	return services.NewAdminWebhooksService(cfg, client, nil)
+}
+
+const webhookSandboxURL = "https://sandbox.example.com/webhooks"
+
+var adminSession = &models.AdminSession{ModeratorID: "mod-1", Role: models.ModeratorRoleAdmin}
+
+func TestAdminWebhooksService_DryRunDoesNotSend(t *testing.T) {
+	client := &recordingHTTPClient{statusCode: http.StatusOK}
+	svc := newWebhooksTestService(client, webhookSandboxURL)
+
+	resp, err := svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{
+		Trigger:  "mentor_created",
+		RecordID: "rec-42",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.DryRun)
+	assert.False(t, resp.Delivered)
+	assert.Equal(t, http.MethodGet, resp.Method)
+	assert.Equal(t, "https://triggers.example.com/mentor-created?record_id=rec-42", resp.URL)
+	assert.Empty(t, client.requests)
+}
+
+func TestAdminWebhooksService_DeliversPayload(t *testing.T) {
+	client := &recordingHTTPClient{statusCode: http.StatusAccepted}
+	svc := newWebhooksTestService(client, webhookSandboxURL)
+	dryRun := false
+
+	resp, err := svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{
+		Trigger: "mentor_moderation",
+		Payload: map[string]interface{}{"action": "approve"},
+		DryRun:  &dryRun,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.Delivered)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, webhookSandboxURL, client.requests[0].URL.String(), "only the sandbox gets live tests")
+	assert.Equal(t, webhookSandboxURL, resp.URL)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Body, &body))
+	assert.Equal(t, "approve", body["action"])
+	assert.Equal(t, true, body["test"])
+	assert.Equal(t, "mentor_moderation", body["trigger"])
+}
+
+func TestAdminWebhooksService_WithoutSandboxDoesNotSend(t *testing.T) {
+	client := &recordingHTTPClient{statusCode: http.StatusOK}
+	svc := newWebhooksTestService(client, "")
+	dryRun := false
+
+	for _, name := range []string{"mentor_login_email", "mentor_created"} {
+		resp, err := svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{
+			Trigger: name,
+			DryRun:  &dryRun,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.Delivered, name)
+	}
+	assert.Empty(t, client.requests, "production trigger URLs are never called")
+}
+
+func TestAdminWebhooksService_DropsRealPayloadFields(t *testing.T) {
+	client := &recordingHTTPClient{statusCode: http.StatusOK}
+	svc := newWebhooksTestService(client, webhookSandboxURL)
+	dryRun := false
+
+	resp, err := svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{
+		Trigger: "mentor_email_change",
+		Payload: map[string]interface{}{
+			"recipient": "victim@example.com",
+			"new_email": "attacker@example.com",
+			"note":      "kept",
+		},
+		DryRun: &dryRun,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"new_email", "recipient"}, resp.DroppedKeys)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Body, &body))
+	assert.NotContains(t, body, "recipient")
+	assert.NotContains(t, body, "new_email")
+	assert.Equal(t, "kept", body["note"])
+}
+
+func TestAdminWebhooksService_Errors(t *testing.T) {
+	svc := newWebhooksTestService(&recordingHTTPClient{statusCode: http.StatusOK}, webhookSandboxURL)
+
+	_, err := svc.TestTrigger(context.Background(), &models.AdminSession{Role: models.ModeratorRoleModerator},
+		&models.AdminWebhookTestRequest{Trigger: "mentor_created"})
+	assert.ErrorIs(t, err, services.ErrAdminForbiddenAction)
+
+	_, err = svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{Trigger: "nope"})
+	assert.ErrorIs(t, err, services.ErrUnknownTrigger)
+
+	resp, err := svc.TestTrigger(context.Background(), adminSession, &models.AdminWebhookTestRequest{Trigger: "review_created"})
+	require.NoError(t, err)
+	assert.False(t, resp.Configured)
+}
@@ -0,0 +1,83 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/events"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingMentorCache records the reloads and single mentor updates of a replica
+type countingMentorCache struct {
+	mu      sync.Mutex
+	reloads int
+	updated []string
+}
+
+func (c *countingMentorCache) Reload() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reloads++
+	return nil
+}
+
+func (c *countingMentorCache) UpdateSingleMentor(slug string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updated = append(c.updated, slug)
+	return nil
+}
+
+func (c *countingMentorCache) counts() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.reloads, len(c.updated)
+}
+
+// The replica that changed a mentor adopts the new versions without reloading; another replica
+// reloads once for the whole burst of changes
+func TestCacheVersionService_AdoptsOwnChangesAndBatchesReloads(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	repo := repository.NewCacheVersionRepository(pool)
+	newReplica := func(name string, mentorCache *countingMentorCache) *services.CacheVersionService {
+		cfg := &config.Config{}
+		cfg.Cache.VersionPollSeconds = 1
+		cfg.Observability.ServiceInstanceID = name
+		service := services.NewCacheVersionService(repo, mentorCache, cfg)
+		service.Start()
+		return service
+	}
+	originCache, otherCache := &countingMentorCache{}, &countingMentorCache{}
+	origin := newReplica("cache-version-test-origin", originCache)
+	other := newReplica("cache-version-test-other", otherCache)
+
+	publisher := origin.Publisher(eventbus.NoopPublisher{})
+	before := origin.Version()
+	for i := int64(1); i <= 3; i++ {
+		events.Publish(context.Background(), publisher, events.MentorUpdated{
+			MentorID: "00000000-0000-0000-0000-000000000001",
+			Slug:     "cache-version-test",
+			Status:   "active",
+			Actor:    events.ActorAdmin,
+		})
+		require.Eventually(t, func() bool { return origin.Version() == before+i }, 3*time.Second, 10*time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool { return other.Version() == before+3 }, 5*time.Second, 50*time.Millisecond)
+	time.Sleep(1500 * time.Millisecond)
+
+	reloads, updates := otherCache.counts()
+	assert.Equal(t, 1, reloads)
+	assert.Zero(t, updates)
+	reloads, updates = originCache.counts()
+	assert.Zero(t, reloads)
+	assert.Equal(t, 3, updates)
+}
@@ -0,0 +1,172 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	metrics.Init("test")
+}
+
+// getDryRunTestPool connects to DATABASE_URL with the schema migrated, or skips the test
+func getDryRunTestPool(t *testing.T) *pgxpool.Pool {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL not set, skipping dry-run test")
+	}
+	require.NoError(t, db.RunMigrations(dbURL))
+
+	pool, err := pgxpool.New(context.Background(), dbURL)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// createDryRunTag inserts a tag only this test uses and returns its ID and name
+func createDryRunTag(t *testing.T, pool *pgxpool.Pool) (string, string) {
+	name := fmt.Sprintf("dry-run-%d", time.Now().UnixNano())
+	var id string
+	require.NoError(t, pool.QueryRow(context.Background(), `INSERT INTO tags (name) VALUES ($1) RETURNING id`, name).Scan(&id))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM tags WHERE id = $1`, id) //nolint:errcheck
+	})
+	return id, name
+}
+
+func createDryRunMentor(t *testing.T, pool *pgxpool.Pool, mentorRepo *repository.MentorRepository, email string) string {
+	id, _, _, err := mentorRepo.CreateMentor(context.Background(), map[string]interface{}{
+		"name":         "Dry Run Mentor",
+		"email":        email,
+		"telegram":     "dry_run_mentor",
+		"job_title":    "Engineer",
+		"workplace":    "GetMentor",
+		"experience":   "5-10",
+		"price":        "Free",
+		"about":        "About",
+		"details":      "Details",
+		"competencies": "Go",
+		"status":       "active",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM mentors WHERE id = $1`, id) //nolint:errcheck
+	})
+	return id
+}
+
+// createDryRunAdmin inserts an admin moderator, as merges reference the moderator
+func createDryRunAdmin(t *testing.T, pool *pgxpool.Pool) *models.AdminSession {
+	var id string
+	require.NoError(t, pool.QueryRow(context.Background(),
+		`INSERT INTO moderators (name, role) VALUES ('Dry Run Admin', 'admin') RETURNING id`).Scan(&id))
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM moderators WHERE id = $1`, id) //nolint:errcheck
+	})
+	return &models.AdminSession{ModeratorID: id, Role: models.ModeratorRoleAdmin}
+}
+
+func countRows(t *testing.T, pool *pgxpool.Pool, query string, args ...interface{}) int {
+	var count int
+	require.NoError(t, pool.QueryRow(context.Background(), query, args...).Scan(&count))
+	return count
+}
+
+// A dry-run import creates every valid row in a transaction that is rolled back
+func TestMentorImport_DryRunRollsBack(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	_, tagName := createDryRunTag(t, pool)
+
+	tagsCache := cache.NewTagsCache(repository.NewMentorRepository(pool, nil, nil, true).FetchAllTagsFromDB, 0)
+	require.NoError(t, tagsCache.Initialize())
+	mentorRepo := repository.NewMentorRepository(pool, nil, tagsCache, true)
+	service := services.NewMentorImportService(
+		mentorRepo,
+		repository.NewUnitOfWork(pool),
+		services.NewBlocklistService(repository.NewBlocklistRepository(pool), nil),
+		services.NewModerationRulesService(repository.NewModerationSettingsRepository(pool)),
+		&config.Config{},
+		httpclient.NewStandardClient(),
+	)
+
+	email := fmt.Sprintf("dry-run-%d@example.com", time.Now().UnixNano())
+	file := "name,email,telegram,job,workplace,experience,price,tags,about,description,competencies\n" +
+		"Dry Run," + email + ",dry_run,Engineer,GetMentor,5-10,Free," + tagName + ",About,Description,Go\n"
+	rows, err := services.ParseMentorImportCSV(strings.NewReader(file))
+	require.NoError(t, err)
+
+	report := service.Import(services.WithDryRun(context.Background()), rows)
+
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Valid)
+	assert.Equal(t, models.MentorImportValid, report.Rows[0].Status)
+	assert.Equal(t, 0, countRows(t, pool, `SELECT count(*) FROM mentors WHERE email = $1`, email))
+}
+
+// A dry-run merge reports the tags and records it would move and leaves both mentors as they were
+func TestMergeMentors_DryRunRollsBack(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	tagID, _ := createDryRunTag(t, pool)
+
+	mentorRepo := repository.NewMentorRepository(pool, nil, nil, true)
+	suffix := time.Now().UnixNano()
+	primaryID := createDryRunMentor(t, pool, mentorRepo, fmt.Sprintf("dry-run-primary-%d@example.com", suffix))
+	duplicateID := createDryRunMentor(t, pool, mentorRepo, fmt.Sprintf("dry-run-duplicate-%d@example.com", suffix))
+	require.NoError(t, mentorRepo.UpdateMentorTags(context.Background(), duplicateID, []string{tagID}))
+
+	service := services.NewMentorMergeService(
+		repository.NewMentorMergeRepository(pool), mentorRepo, repository.NewUnitOfWork(pool), nil)
+	result, err := service.MergeMentors(services.WithDryRun(context.Background()), createDryRunAdmin(t, pool), &models.MergeMentorsRequest{
+		PrimaryID:   primaryID,
+		DuplicateID: duplicateID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), result.TagsAdded)
+	assert.Equal(t, 0, countRows(t, pool, `SELECT count(*) FROM mentor_tags WHERE mentor_id = $1`, primaryID))
+	assert.Equal(t, 1, countRows(t, pool, `SELECT count(*) FROM mentor_tags WHERE mentor_id = $1`, duplicateID))
+	assert.Equal(t, 0, countRows(t, pool, `SELECT count(*) FROM mentors WHERE id = $1 AND merged_into IS NOT NULL`, duplicateID))
+	assert.Equal(t, 0, countRows(t, pool, `SELECT count(*) FROM mentor_merges WHERE duplicate_mentor_id = $1`, duplicateID))
+}
+
+// A dry-run status change returns the mentor as it would be saved and keeps the stored one
+func TestUpdateMentorStatus_DryRunRollsBack(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	mentorRepo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createDryRunMentor(t, pool, mentorRepo, fmt.Sprintf("dry-run-status-%d@example.com", time.Now().UnixNano()))
+
+	service := services.NewAdminMentorsService(mentorRepo, repository.NewUnitOfWork(pool), nil, nil, &config.Config{}, nil, nil, nil, nil)
+	mentor, err := service.UpdateMentorStatus(services.WithDryRun(context.Background()), createDryRunAdmin(t, pool), mentorID, "inactive")
+	require.NoError(t, err)
+
+	assert.Equal(t, "inactive", mentor.Status)
+	assert.Equal(t, 1, countRows(t, pool, `SELECT count(*) FROM mentors WHERE id = $1 AND status = 'active'`, mentorID))
+}
+
+// A dry-run delete succeeds and leaves the mentor in place
+func TestDeleteMentor_DryRunRollsBack(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	mentorRepo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createDryRunMentor(t, pool, mentorRepo, fmt.Sprintf("dry-run-delete-%d@example.com", time.Now().UnixNano()))
+
+	service := services.NewAdminMentorsService(mentorRepo, repository.NewUnitOfWork(pool), nil, nil, &config.Config{}, nil, nil, nil, nil)
+	require.NoError(t, service.DeleteMentor(services.WithDryRun(context.Background()), createDryRunAdmin(t, pool), mentorID))
+
+	assert.Equal(t, 1, countRows(t, pool, `SELECT count(*) FROM mentors WHERE id = $1 AND deleted_at IS NULL`, mentorID))
+}
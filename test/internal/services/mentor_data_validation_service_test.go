@@ -0,0 +1,46 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A calendar link pointing at an internal address is reported broken without being requested
+func TestMentorDataValidation_RefusesInternalCalendarLinks(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	mentorRepo := repository.NewMentorRepository(pool, nil, nil, true)
+	mentorID := createDryRunMentor(t, pool, mentorRepo, fmt.Sprintf("calendar-probe-%d@example.com", time.Now().UnixNano()))
+	_, err := pool.Exec(context.Background(), `UPDATE mentors SET calendar_url = $2 WHERE id = $1`, mentorID, server.URL)
+	require.NoError(t, err)
+
+	service := services.NewMentorDataValidationService(repository.NewMentorDataIssueRepository(pool), nil)
+	report, _, err := service.Validate(context.Background(), true)
+	require.NoError(t, err)
+
+	var issue *models.MentorDataIssue
+	for _, found := range report.Issues {
+		if found.MentorID == mentorID && found.Check == models.MentorDataCheckCalendarURL {
+			issue = found
+		}
+	}
+	require.NotNil(t, issue, "a loopback calendar link must be reported")
+	assert.Contains(t, issue.Detail, "blocked address")
+	assert.False(t, requested, "the loopback server must not be reached")
+}
@@ -0,0 +1,55 @@
+package services_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMentorImportCSV(t *testing.T) {
+	file := "\ufeffEmail,Name,tags,remote_only,languages\n" +
+		"Ivan@Example.com , Иван Петров,Go; Backend ;,да,ru;en\n" +
+		"\"anna@example.com\",\"Anna, PhD\",,no,\n"
+
+	rows, err := services.ParseMentorImportCSV(strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, 2, rows[0].Line)
+	assert.Equal(t, "ivan@example.com", rows[0].Email)
+	assert.Equal(t, "Иван Петров", rows[0].Name)
+	assert.Equal(t, []string{"Go", "Backend"}, rows[0].Tags)
+	assert.True(t, rows[0].RemoteOnly)
+	assert.Equal(t, []string{"ru", "en"}, rows[0].Languages)
+
+	assert.Equal(t, 3, rows[1].Line)
+	assert.Equal(t, "Anna, PhD", rows[1].Name)
+	assert.Empty(t, rows[1].Tags)
+	assert.False(t, rows[1].RemoteOnly)
+}
+
+func TestParseMentorImportCSV_InvalidFiles(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "empty", file: ""},
+		{name: "header only", file: "name,email\n"},
+		{name: "unknown column", file: "name,email,salary\nIvan,ivan@example.com,100\n"},
+		{name: "missing email column", file: "name,telegram\nIvan,ivan\n"},
+		{name: "ragged row", file: "name,email\nIvan\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := services.ParseMentorImportCSV(strings.NewReader(tt.file))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/services"
+	apperrors "github.com/getmentor/getmentor-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// The checks below run before any database access, so the service needs no repositories
+func TestMergeMentors_RejectsInvalidRequests(t *testing.T) {
+	service := services.NewMentorMergeService(nil, nil, nil, nil)
+	req := &models.MergeMentorsRequest{
+		PrimaryID:   "550e8400-e29b-41d4-a716-446655440000",
+		DuplicateID: "550e8400-e29b-41d4-a716-446655440001",
+	}
+
+	moderator := &models.AdminSession{ModeratorID: "m1", Role: models.ModeratorRoleModerator}
+	_, err := service.MergeMentors(context.Background(), moderator, req)
+	assert.True(t, errors.Is(err, services.ErrAdminForbiddenAction))
+
+	admin := &models.AdminSession{ModeratorID: "a1", Role: models.ModeratorRoleAdmin}
+	same := &models.MergeMentorsRequest{PrimaryID: req.PrimaryID, DuplicateID: req.PrimaryID}
+	_, err = service.MergeMentors(context.Background(), admin, same)
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+}
@@ -2,9 +2,24 @@ package services_test
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/getmentor/getmentor-api/config"
+	"github.com/getmentor/getmentor-api/internal/cache"
+	"github.com/getmentor/getmentor-api/internal/models"
+	"github.com/getmentor/getmentor-api/internal/repository"
+	"github.com/getmentor/getmentor-api/internal/services"
 	"github.com/getmentor/getmentor-api/pkg/slug"
+	"github.com/getmentor/getmentor-api/pkg/yandex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRegistrationService_UploadLogic(t *testing.T) {
@@ -257,3 +272,114 @@ func TestRegistrationService_DatabaseUpdateLogic(t *testing.T) {
 		t.Errorf("updated image URL = %v, want %v", mockRepo.updatedImageURL, imageURL)
 	}
 }
+
+// registrationHTTPClient accepts every ReCAPTCHA token and sends the other requests on
+type registrationHTTPClient struct{}
+
+func (registrationHTTPClient) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"success":true}`))}, nil
+}
+
+func (registrationHTTPClient) Get(url string) (*http.Response, error) {
+	return http.DefaultClient.Get(url)
+}
+
+func (registrationHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return http.DefaultClient.Do(req)
+}
+
+// A registration whose tags can't be saved leaves no mentor behind and neither uploads the
+// picture nor calls the mentor created trigger
+func TestRegistrationService_TagsFailureRollsBack(t *testing.T) {
+	pool := getDryRunTestPool(t)
+	tagID, tagName := createDryRunTag(t, pool)
+
+	tests := []struct {
+		name        string
+		tagID       string
+		wantSuccess bool
+		wantUploads int64
+		wantTrigger int64
+	}{
+		{name: "tags saved", tagID: tagID, wantSuccess: true, wantUploads: 3, wantTrigger: 1},
+		{name: "tag insert fails", tagID: "00000000-0000-0000-0000-000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uploads, triggers atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, "/mentor-created") {
+					triggers.Add(1)
+				} else if r.Method == http.MethodPut {
+					uploads.Add(1)
+				}
+			}))
+			defer server.Close()
+
+			storage, err := yandex.NewStorageClient("key", "secret", "bucket", server.URL, "")
+			require.NoError(t, err)
+			tagsCache := cache.NewTagsCache(func(context.Context) (map[string]string, error) {
+				return map[string]string{tagName: tt.tagID}, nil
+			}, 0)
+			require.NoError(t, tagsCache.Initialize())
+
+			cfg := &config.Config{}
+			cfg.EventTriggers.MentorCreatedTriggerURL = server.URL + "/mentor-created?record_id="
+			mentorRepo := repository.NewMentorRepository(pool, nil, tagsCache, true)
+			uow := repository.NewUnitOfWork(pool)
+			rules := services.NewModerationRulesService(repository.NewModerationSettingsRepository(pool))
+			service := services.NewRegistrationService(
+				mentorRepo,
+				uow,
+				services.NewBlocklistService(repository.NewBlocklistRepository(pool), nil),
+				rules,
+				services.NewReturningMentorService(mentorRepo, uow, rules, cfg, registrationHTTPClient{}, nil, nil),
+				storage,
+				cfg,
+				registrationHTTPClient{},
+				nil,
+			)
+
+			email := fmt.Sprintf("registration-%d@example.com", time.Now().UnixNano())
+			t.Cleanup(func() {
+				_, _ = pool.Exec(context.Background(), `DELETE FROM mentors WHERE email = $1`, email) //nolint:errcheck
+			})
+			resp, err := service.RegisterMentor(context.Background(), &models.RegisterMentorRequest{
+				Name:         "Registration Test",
+				Email:        email,
+				Telegram:     "registration_test",
+				Job:          "Engineer",
+				Workplace:    "GetMentor",
+				Experience:   "5-10",
+				Price:        "Free",
+				Tags:         []string{tagName},
+				About:        "About",
+				Description:  "Description",
+				Competencies: "Go",
+				ProfilePicture: models.ProfilePictureData{
+					Image:       "aGVsbG8=",
+					FileName:    "photo.jpg",
+					ContentType: "image/jpeg",
+				},
+			})
+
+			if tt.wantSuccess {
+				require.NoError(t, err)
+				assert.True(t, resp.Success)
+				assert.Eventually(t, func() bool {
+					return uploads.Load() == tt.wantUploads && triggers.Load() == tt.wantTrigger
+				}, 5*time.Second, 20*time.Millisecond)
+				assert.Equal(t, 1, countRows(t, pool, `SELECT count(*) FROM mentors WHERE email = $1`, email))
+				return
+			}
+
+			require.Error(t, err)
+			assert.False(t, resp.Success)
+			assert.Equal(t, 0, countRows(t, pool, `SELECT count(*) FROM mentors WHERE email = $1`, email))
+			time.Sleep(200 * time.Millisecond)
+			assert.Zero(t, uploads.Load())
+			assert.Zero(t, triggers.Load())
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package airtable_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/circuitbreaker"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+func TestNewClient_RequiresBaseAndToken(t *testing.T) {
+	_, err := airtable.NewClient("", "", "token", httpclient.NewStandardClient())
+	assert.Error(t, err)
+
+	_, err = airtable.NewClient("ftp://example.com", "appBase", "token", httpclient.NewStandardClient())
+	assert.Error(t, err)
+}
+
+func TestClient_GetFiltersByRecordID(t *testing.T) {
+	var path, formula, auth string
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		formula = r.URL.Query().Get("filterByFormula")
+		fields = r.URL.Query()["fields[]"]
+		auth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"records":[{"id":"rec1","fields":{"Last Modified":"2026-01-02T03:04:05.000Z"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	records, err := client.Get(context.Background(), "Client Requests", []string{"rec1", "rec2"}, []string{"Last Modified"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/appBase/Client%20Requests", path)
+	assert.Equal(t, "OR(RECORD_ID()='rec1',RECORD_ID()='rec2')", formula)
+	assert.Equal(t, []string{"Last Modified"}, fields)
+	assert.Equal(t, "Bearer secret", auth)
+	require.Len(t, records, 1)
+	assert.Equal(t, "rec1", records[0].ID)
+}
+
+func TestClient_UpdatePatchesWithTypecast(t *testing.T) {
+	var method string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		_, _ = w.Write([]byte(`{"records":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	err = client.Update(context.Background(), "Mentors", []airtable.Record{{ID: "rec1", Fields: map[string]interface{}{"Status": "active"}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPatch, method)
+	assert.Equal(t, true, body["typecast"])
+	assert.Len(t, body["records"], 1)
+}
+
+func TestClient_RejectsOversizedBatches(t *testing.T) {
+	client, err := airtable.NewClient("", "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	ids := make([]string, airtable.MaxBatch+1)
+	_, err = client.Get(context.Background(), "Mentors", ids, nil)
+	assert.Error(t, err)
+}
+
+func TestClient_ReportsServerErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error":{"type":"INVALID_VALUE_FOR_COLUMN"}}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	failures := testutil.ToFloat64(metrics.AirtableRequests.WithLabelValues("update", "error"))
+	err = client.Update(context.Background(), "Mentors", []airtable.Record{{ID: "rec1", Fields: map[string]interface{}{}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_VALUE_FOR_COLUMN")
+	assert.Equal(t, failures+1, testutil.ToFloat64(metrics.AirtableRequests.WithLabelValues("update", "error")))
+}
+
+// Outages open the circuit breaker after five failures in a row; rejected requests don't
+func TestClient_BreakerOpensOnOutages(t *testing.T) {
+	var calls atomic.Int32
+	var status atomic.Int32
+	status.Store(http.StatusUnprocessableEntity)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+	update := func() error {
+		return client.Update(context.Background(), "Mentors", []airtable.Record{{ID: "rec1", Fields: map[string]interface{}{}}})
+	}
+
+	for i := 0; i < 6; i++ {
+		var statusErr *airtable.StatusError
+		require.ErrorAs(t, update(), &statusErr)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusErr.StatusCode)
+	}
+
+	trips := testutil.ToFloat64(metrics.CircuitBreakerTrips.WithLabelValues(airtable.Dependency))
+	status.Store(http.StatusServiceUnavailable)
+	for i := 0; i < 5; i++ {
+		require.Error(t, update())
+	}
+	assert.Equal(t, int32(11), calls.Load())
+	assert.Equal(t, trips+1, testutil.ToFloat64(metrics.CircuitBreakerTrips.WithLabelValues(airtable.Dependency)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.CircuitBreakerState.WithLabelValues(airtable.Dependency, "open")))
+
+	require.ErrorIs(t, update(), circuitbreaker.ErrOpen)
+	assert.Equal(t, int32(11), calls.Load(), "an open breaker must not call Airtable")
+}
+
+func TestClient_ListFollowsOffset(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		if offset == "" {
+			_, _ = w.Write([]byte(`{"records":[{"id":"rec1","fields":{}}],"offset":"page2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"records":[{"id":"rec2","fields":{}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	listed := testutil.ToFloat64(metrics.AirtableRequests.WithLabelValues("list", "success"))
+	records, err := client.List(context.Background(), "Mentors", []string{"Alias"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "page2"}, offsets)
+	assert.Equal(t, listed+1, testutil.ToFloat64(metrics.AirtableRequests.WithLabelValues("list", "success")), "a listing counts once, whatever its pages")
+	require.Len(t, records, 2)
+	assert.Equal(t, "rec2", records[1].ID)
+}
+
+func TestClient_ConcurrentListsShareOneRequest(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"records":[{"id":"rec1","fields":{}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	results := make([][]airtable.Record, 5)
+	errs := make([]error, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = client.List(context.Background(), "Mentors", []string{"Alias"})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests.Load())
+	for i := range results {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i], 1)
+	}
+
+	// Reads of other fields are separate requests
+	_, err = client.List(context.Background(), "Mentors", []string{"Status"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestClient_SharedReadOutlivesCancelledCaller(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"records":[{"id":"rec1","fields":{}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := client.List(ctx, "Mentors", nil)
+		first <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	second := make(chan []airtable.Record, 1)
+	go func() {
+		records, _ := client.List(context.Background(), "Mentors", nil)
+		second <- records
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The first caller gives up; the second still gets the records
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+	close(release)
+	assert.Len(t, <-second, 1)
+}
@@ -0,0 +1,70 @@
+package airtable_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/airtable"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetAllClientRequestsMapsRecords(t *testing.T) {
+	var pages int
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		fields = r.URL.Query()["fields[]"]
+		if r.URL.Query().Get("offset") == "" {
+			_, _ = w.Write([]byte(`{"records":[
+				{"id":"rec1","createdTime":"2025-03-04T05:06:07.000Z","fields":{
+					"Mentor":["recMentor"],"Email":" client@example.com ","Name":"Client","Status":"Done",
+					"Last Status Change":"2025-03-05T10:00:00.000Z","Scheduled At":"2025-03-06",
+					"Decline Reason":"No time","Decline Comment":"Busy"}},
+				{"id":"rec2","createdTime":"2025-03-04T05:06:07.000Z","fields":{}}
+			],"offset":"page2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"records":[
+			{"id":"rec3","fields":{"Status":"Archived","Decline Reason":"Vacation"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := airtable.NewClient(server.URL, "appBase", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	requests, err := client.GetAllClientRequests(context.Background(), "Client Requests")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, pages)
+	assert.Equal(t, airtable.ClientRequestFields, fields)
+	require.Len(t, requests, 3)
+
+	done := requests[0]
+	assert.Equal(t, "rec1", done.AirtableID)
+	assert.Equal(t, "recMentor", done.MentorAirtableID)
+	assert.Equal(t, "client@example.com", done.Email)
+	assert.Equal(t, "done", done.Status)
+	assert.Equal(t, time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC), done.CreatedAt)
+	require.NotNil(t, done.StatusChangedAt)
+	assert.Equal(t, time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC), *done.StatusChangedAt)
+	require.NotNil(t, done.ScheduledAt)
+	assert.Equal(t, time.Date(2025, 3, 6, 0, 0, 0, 0, time.UTC), *done.ScheduledAt)
+	assert.Equal(t, "no_time", done.DeclineReason)
+
+	empty := requests[1]
+	assert.Empty(t, empty.MentorAirtableID)
+	assert.Equal(t, "pending", empty.Status, "a request without a status was never picked up")
+	assert.Nil(t, empty.StatusChangedAt)
+
+	unknown := requests[2]
+	assert.Empty(t, unknown.Status)
+	assert.Equal(t, "Archived", unknown.RawStatus)
+	assert.Equal(t, "other", unknown.DeclineReason)
+	assert.True(t, unknown.CreatedAt.IsZero())
+}
@@ -0,0 +1,63 @@
+package botcheck_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/botcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rejectionReason(t *testing.T, err error) string {
+	t.Helper()
+	var rejection *botcheck.Rejection
+	require.True(t, errors.As(err, &rejection), "expected a rejection, got %v", err)
+	assert.ErrorIs(t, err, botcheck.ErrRejected)
+	return rejection.Reason
+}
+
+func TestChecker_AcceptsFormFilledInByAPerson(t *testing.T) {
+	checker := botcheck.NewChecker("secret", 3*time.Second, 24*time.Hour)
+	renderedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	token := checker.Sign("contact", renderedAt)
+	assert.NoError(t, checker.Check("contact", "", token, renderedAt.Add(45*time.Second)))
+}
+
+func TestChecker_RejectionReasons(t *testing.T) {
+	checker := botcheck.NewChecker("secret", 3*time.Second, 24*time.Hour)
+	renderedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	token := checker.Sign("contact", renderedAt)
+
+	tests := []struct {
+		name     string
+		form     string
+		honeypot string
+		token    string
+		now      time.Time
+		reason   string
+	}{
+		{"honeypot", "contact", "https://spam.example", token, renderedAt.Add(time.Minute), botcheck.ReasonHoneypot},
+		{"missing_timestamp", "contact", "", "", renderedAt.Add(time.Minute), botcheck.ReasonMissingTimestamp},
+		{"garbage", "contact", "", "not-a-token", renderedAt.Add(time.Minute), botcheck.ReasonInvalidTimestamp},
+		{"forged_time", "contact", "", "1000." + token[len(token)-64:], renderedAt.Add(time.Minute), botcheck.ReasonInvalidTimestamp},
+		{"other_form", "registration", "", token, renderedAt.Add(time.Minute), botcheck.ReasonInvalidTimestamp},
+		{"too_fast", "contact", "", token, renderedAt.Add(time.Second), botcheck.ReasonTooFast},
+		{"expired", "contact", "", token, renderedAt.Add(25 * time.Hour), botcheck.ReasonExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checker.Check(tt.form, tt.honeypot, tt.token, tt.now)
+			assert.Equal(t, tt.reason, rejectionReason(t, err))
+		})
+	}
+}
+
+func TestChecker_WithoutSecretChecksOnlyHoneypot(t *testing.T) {
+	checker := botcheck.NewChecker("", 3*time.Second, 24*time.Hour)
+
+	assert.NoError(t, checker.Check("contact", "", "", time.Now()))
+	assert.Equal(t, botcheck.ReasonHoneypot, rejectionReason(t, checker.Check("contact", "filled", "", time.Now())))
+}
@@ -0,0 +1,151 @@
+package cdn_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/cdn"
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteURLs(t *testing.T) {
+	routes := []string{"/api/v1/mentors", "/api/v1/mentor/:id", "/api/v1/mentor/:id/reviews", "/api/v1/mentors/:slug/feed"}
+
+	urls := cdn.RouteURLs("https://api.getmentor.dev/", routes, map[string]string{"id": "42"})
+
+	assert.Equal(t, []string{
+		"https://api.getmentor.dev/api/v1/mentors",
+		"https://api.getmentor.dev/api/v1/mentor/42",
+		"https://api.getmentor.dev/api/v1/mentor/42/reviews",
+	}, urls)
+}
+
+func TestNew_NoneDisablesPurging(t *testing.T) {
+	purger, err := cdn.New(cdn.Config{Provider: cdn.ProviderNone}, httpclient.NewStandardClient())
+
+	require.NoError(t, err)
+	assert.Nil(t, purger)
+}
+
+func TestNew_RequiresCredentials(t *testing.T) {
+	_, err := cdn.New(cdn.Config{Provider: cdn.ProviderCloudflare, CloudflareZoneID: "zone"}, httpclient.NewStandardClient())
+	assert.Error(t, err)
+
+	_, err = cdn.New(cdn.Config{Provider: "akamai"}, httpclient.NewStandardClient())
+	assert.Error(t, err)
+}
+
+func TestCloudflarePurge_ChunksFiles(t *testing.T) {
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/zone-1/purge_cache", r.URL.Path)
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+
+		var body struct {
+			Files []string `json:"files"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		batches = append(batches, body.Files)
+		_, _ = w.Write([]byte(`{"success":true,"errors":[]}`))
+	}))
+	defer server.Close()
+
+	purger, err := cdn.New(cdn.Config{
+		Provider:           cdn.ProviderCloudflare,
+		APIURL:             server.URL,
+		CloudflareZoneID:   "zone-1",
+		CloudflareAPIToken: "token-1",
+	}, httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	urls := make([]string, 45)
+	for i := range urls {
+		urls[i] = "https://api.getmentor.dev/api/v1/mentors"
+	}
+	require.NoError(t, purger.Purge(context.Background(), urls))
+
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0], 30)
+	assert.Len(t, batches[1], 15)
+}
+
+func TestCloudflarePurge_ReportsUnsuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"success":false,"errors":[{"message":"Invalid zone"}]}`))
+	}))
+	defer server.Close()
+
+	purger, err := cdn.New(cdn.Config{
+		Provider:           cdn.ProviderCloudflare,
+		APIURL:             server.URL,
+		CloudflareZoneID:   "zone-1",
+		CloudflareAPIToken: "token-1",
+	}, httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	err = purger.Purge(context.Background(), []string{"https://api.getmentor.dev/api/v1/mentors"})
+
+	var statusErr *cdn.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, 0, statusErr.StatusCode)
+	assert.Equal(t, "Invalid zone", statusErr.Message)
+}
+
+func TestYandexPurge_SendsPaths(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cache/resource-1:purge", r.URL.Path)
+		assert.Equal(t, "Api-Key key-1", r.Header.Get("Authorization"))
+
+		var body struct {
+			Paths []string `json:"paths"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		paths = body.Paths
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	purger, err := cdn.New(cdn.Config{
+		Provider:         cdn.ProviderYandex,
+		APIURL:           server.URL,
+		YandexResourceID: "resource-1",
+		YandexAPIKey:     "key-1",
+	}, httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	err = purger.Purge(context.Background(), []string{
+		"https://api.getmentor.dev/api/v1/mentors",
+		"https://api.getmentor.dev/api/v1/mentor/42",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/v1/mentors", "/api/v1/mentor/42"}, paths)
+}
+
+func TestYandexPurge_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	purger, err := cdn.New(cdn.Config{
+		Provider:         cdn.ProviderYandex,
+		APIURL:           server.URL,
+		YandexResourceID: "resource-1",
+		YandexAPIKey:     "key-1",
+	}, httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	err = purger.Purge(context.Background(), []string{"https://api.getmentor.dev/api/v1/mentors"})
+
+	var statusErr *cdn.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+}
@@ -0,0 +1,67 @@
+package certificate_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/certificate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func sampleCertificate() certificate.Certificate {
+	return certificate.Certificate{
+		ID:                   "5b0f1c9e-8d4a-4f6e-9a51-2c7d3e8f1a20",
+		RecipientName:        "Анна Иванова",
+		CohortTitle:          "Весенний поток",
+		CompletedCheckpoints: 4,
+		IssuedAt:             time.Date(2026, 5, 30, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	c := sampleCertificate()
+	signature := certificate.Sign(secret, c)
+	assert.True(t, certificate.Verify(secret, c, signature))
+
+	// The printed link is not part of the signature
+	c.VerifyURL = "https://example.com/api/v1/certificates/" + c.ID
+	assert.True(t, certificate.Verify(secret, c, signature))
+
+	tampered := sampleCertificate()
+	tampered.RecipientName = "Иван Петров"
+	assert.False(t, certificate.Verify(secret, tampered, signature))
+
+	assert.False(t, certificate.Verify([]byte("another-secret-another-secret-xx"), c, signature))
+}
+
+func TestSign_FieldsCannotBeShifted(t *testing.T) {
+	a := sampleCertificate()
+	a.RecipientName, a.CohortTitle = "Анна\nВесенний", "поток"
+	b := sampleCertificate()
+	b.RecipientName, b.CohortTitle = "Анна", "Весенний\nпоток"
+	assert.NotEqual(t, certificate.Sign(secret, a), certificate.Sign(secret, b))
+}
+
+func TestCode(t *testing.T) {
+	assert.Equal(t, "0A1B-2C3D-4E5F-6071", certificate.Code("0a1b2c3d4e5f60718293a4b5c6d7e8f9"))
+}
+
+func TestNewID(t *testing.T) {
+	id, err := certificate.NewID()
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, id)
+}
+
+func TestRenderPDF(t *testing.T) {
+	c := sampleCertificate()
+	pdf, err := certificate.RenderPDF(c, certificate.Sign(secret, c))
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+	assert.Contains(t, string(pdf), "/Subtype /Image")
+}
@@ -0,0 +1,125 @@
+package circuitbreaker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/circuitbreaker"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+var errDown = errors.New("dependency down")
+
+func fail() error    { return errDown }
+func succeed() error { return nil }
+
+func stateGauge(dependency string, state circuitbreaker.State) float64 {
+	return testutil.ToFloat64(metrics.CircuitBreakerState.WithLabelValues(dependency, state.String()))
+}
+
+// testBreaker returns a breaker on a clock the test moves, under a dependency only it uses
+func testBreaker(t *testing.T, settings circuitbreaker.Settings) (*circuitbreaker.Breaker, *time.Time) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	settings.Dependency = fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())
+	return circuitbreaker.NewWithClock(settings, func() time.Time { return now }), &now
+}
+
+func TestBreaker_OpensAfterConsecutiveFailuresAndRecovers(t *testing.T) {
+	var transitions []string
+	breaker, now := testBreaker(t, circuitbreaker.Settings{
+		FailureThreshold: 3,
+		OpenTimeout:      time.Minute,
+		OnStateChange: func(_ string, from, to circuitbreaker.State) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		},
+	})
+	dependency := breaker.Dependency()
+	assert.Equal(t, 1.0, stateGauge(dependency, circuitbreaker.StateClosed))
+
+	// A success in between resets the count
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	require.NoError(t, breaker.Execute(succeed))
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	assert.Equal(t, circuitbreaker.StateOpen, breaker.State())
+	assert.Equal(t, 1.0, stateGauge(dependency, circuitbreaker.StateOpen))
+	assert.Equal(t, 0.0, stateGauge(dependency, circuitbreaker.StateClosed))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.CircuitBreakerTrips.WithLabelValues(dependency)))
+
+	called := false
+	err := breaker.Execute(func() error { called = true; return nil })
+	require.ErrorIs(t, err, circuitbreaker.ErrOpen)
+	assert.False(t, called, "an open breaker must not call the dependency")
+
+	// After the timeout a failed probe opens it again, a successful one closes it
+	*now = now.Add(time.Minute)
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	assert.Equal(t, circuitbreaker.StateOpen, breaker.State())
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.CircuitBreakerTrips.WithLabelValues(dependency)))
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, breaker.Execute(succeed))
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+	assert.Equal(t, 1.0, stateGauge(dependency, circuitbreaker.StateClosed))
+
+	assert.Equal(t, []string{
+		"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed",
+	}, transitions)
+}
+
+func TestBreaker_HalfOpenLetsOneProbeThrough(t *testing.T) {
+	breaker, now := testBreaker(t, circuitbreaker.Settings{FailureThreshold: 1, OpenTimeout: time.Second})
+	require.ErrorIs(t, breaker.Execute(fail), errDown)
+	*now = now.Add(time.Second)
+
+	err := breaker.Execute(func() error {
+		assert.Equal(t, 1.0, stateGauge(breaker.Dependency(), circuitbreaker.StateHalfOpen))
+		// A second call while the probe is in flight is refused
+		assert.ErrorIs(t, breaker.Execute(succeed), circuitbreaker.ErrOpen)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+}
+
+func TestBreaker_IgnoresErrorsThatAreNotFailures(t *testing.T) {
+	breaker, _ := testBreaker(t, circuitbreaker.Settings{FailureThreshold: 1, OpenTimeout: time.Second})
+
+	require.ErrorIs(t, breaker.Execute(func() error { return context.Canceled }), context.Canceled)
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State(), "a cancelled caller says nothing about the dependency")
+
+	rejected := errors.New("rejected")
+	breaker, _ = testBreaker(t, circuitbreaker.Settings{
+		FailureThreshold: 1,
+		OpenTimeout:      time.Second,
+		IsFailure:        func(err error) bool { return !errors.Is(err, rejected) },
+	})
+	require.ErrorIs(t, breaker.Execute(func() error { return rejected }), rejected)
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+}
+
+func TestObserveFallback(t *testing.T) {
+	dependency := fmt.Sprintf("fallback-%d", time.Now().UnixNano())
+	circuitbreaker.ObserveFallback(dependency)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.CircuitBreakerFallbacks.WithLabelValues(dependency)))
+}
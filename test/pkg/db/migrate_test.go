@@ -0,0 +1,86 @@
+package db_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/migrations"
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var migrationFileName = regexp.MustCompile(`^(\d{6})_[a-z0-9_]+\.(up|down)\.sql$`)
+
+// embeddedVersions returns the versions of the embedded migrations, checking each has an up
+// migration. Down migrations may be missing for data-only steps (000002 seeds the tags).
+func embeddedVersions(t *testing.T) []uint {
+	entries, err := fs.ReadDir(migrations.FS, ".")
+	require.NoError(t, err)
+
+	directions := map[uint]map[string]bool{}
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		require.NotNil(t, match, "unexpected migration file %s", entry.Name())
+		version, err := strconv.ParseUint(match[1], 10, 32)
+		require.NoError(t, err)
+		if directions[uint(version)] == nil {
+			directions[uint(version)] = map[string]bool{}
+		}
+		assert.False(t, directions[uint(version)][match[2]], "two %s migrations for version %d", match[2], version)
+		directions[uint(version)][match[2]] = true
+	}
+
+	versions := make([]uint, 0, len(directions))
+	for version := uint(1); version <= uint(len(directions)); version++ {
+		require.Contains(t, directions, version, "migration versions must have no gaps")
+		assert.True(t, directions[version]["up"], "version %d needs an up migration", version)
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	versions := embeddedVersions(t)
+	require.NotEmpty(t, versions)
+
+	// The latest migration can always be rolled back
+	latest := versions[len(versions)-1]
+	matches, err := fs.Glob(migrations.FS, fmt.Sprintf("%06d_*.down.sql", latest))
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+// Migrating down one version and back up leaves the schema at the latest version, not dirty
+func TestMigrations_RollbackAndReapply(t *testing.T) {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL not set, skipping migration test")
+	}
+	versions := embeddedVersions(t)
+	latest := versions[len(versions)-1]
+
+	require.NoError(t, db.RunMigrations(dbURL))
+	version, dirty, err := db.MigrationVersion(dbURL)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+	assert.False(t, dirty)
+
+	require.NoError(t, db.RollbackMigrations(dbURL, 1))
+	version, _, err = db.MigrationVersion(dbURL)
+	require.NoError(t, err)
+	assert.Equal(t, latest-1, version)
+
+	require.NoError(t, db.MigrateTo(dbURL, latest))
+	version, dirty, err = db.MigrationVersion(dbURL)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+	assert.False(t, dirty)
+
+	// Applying again is a no-op
+	require.NoError(t, db.RunMigrations(dbURL))
+}
@@ -0,0 +1,23 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedQuery(t *testing.T) {
+	sql := db.NamedQuery("test_query", "SELECT 1")
+	assert.Equal(t, "SELECT 1", sql, "the query runs by its SQL")
+
+	// Registering the same SQL again is harmless
+	assert.Equal(t, "SELECT 1", db.NamedQuery("test_query", "SELECT 1"))
+
+	assert.Panics(t, func() {
+		db.NamedQuery("test_query", "SELECT 2")
+	})
+	assert.Panics(t, func() {
+		db.NamedQuery("other_query", "SELECT 1")
+	})
+}
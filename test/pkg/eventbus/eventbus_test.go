@@ -0,0 +1,237 @@
+package eventbus_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/eventbus"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+type sentMessage struct {
+	subject string
+	payload []byte
+}
+
+type channelSink chan sentMessage
+
+func (s channelSink) Send(_ context.Context, subject string, payload []byte) error {
+	s <- sentMessage{subject: subject, payload: payload}
+	return nil
+}
+
+func TestBus_PublishesVersionedEnvelope(t *testing.T) {
+	sink := make(channelSink, 1)
+	bus := eventbus.NewBus("test", sink, "getmentor", 0)
+
+	event, err := eventbus.NewEvent("request.created", 1, map[string]string{"request_id": "r1"})
+	require.NoError(t, err)
+	bus.Publish(context.Background(), event)
+
+	select {
+	case msg := <-sink:
+		assert.Equal(t, "getmentor.request.created", msg.subject)
+
+		var event struct {
+			ID            string            `json:"id"`
+			Type          string            `json:"type"`
+			SchemaVersion int               `json:"schema_version"`
+			OccurredAt    time.Time         `json:"occurred_at"`
+			Data          map[string]string `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(msg.payload, &event))
+		assert.Len(t, event.ID, 36)
+		assert.Equal(t, "request.created", event.Type)
+		assert.Equal(t, 1, event.SchemaVersion)
+		assert.False(t, event.OccurredAt.IsZero())
+		assert.Equal(t, "r1", event.Data["request_id"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not sent")
+	}
+}
+
+func TestNew_NoProviderReturnsNoop(t *testing.T) {
+	publisher, err := eventbus.New(eventbus.Config{})
+	require.NoError(t, err)
+	assert.IsType(t, eventbus.NoopPublisher{}, publisher)
+
+	_, err = eventbus.New(eventbus.Config{Provider: "kafka"})
+	assert.Error(t, err)
+}
+
+// natsHandshake plays the server side of a NATS connection up to the client's first PONG and
+// reports whether the client sent the token
+func natsHandshake(conn net.Conn, reader *bufio.Reader) bool {
+	_, _ = conn.Write([]byte(`INFO {"server_id":"test","version":"2.10.0","proto":1,"max_payload":1048576}` + "\r\n"))
+	connect, _ := reader.ReadString('\n')
+	if !strings.HasPrefix(connect, "CONNECT ") || !strings.Contains(connect, `"auth_token":"secret"`) {
+		return false
+	}
+	if ping, _ := reader.ReadString('\n'); ping != "PING\r\n" {
+		return false
+	}
+	_, _ = conn.Write([]byte("PONG\r\n"))
+	return true
+}
+
+// readNATSPub reads the next PUB with its payload, answering the client's PINGs on the way
+func readNATSPub(conn net.Conn, reader *bufio.Reader) string {
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return ""
+		}
+		if line == "PING\r\n" {
+			_, _ = conn.Write([]byte("PONG\r\n"))
+			continue
+		}
+		payload, _ := reader.ReadString('\n')
+		return line + payload
+	}
+}
+
+func TestNATSSink_HandshakeAndPublish(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		if natsHandshake(conn, reader) {
+			received <- readNATSPub(conn, reader)
+		}
+	}()
+
+	sink, err := eventbus.NewNATSSink("nats://secret@"+listener.Addr().String(), "test")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	require.NoError(t, sink.Send(ctx, "getmentor.mentor.updated", []byte(`{"a":1}`)))
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "PUB getmentor.mentor.updated 7\r\n{\"a\":1}\r\n", msg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("message was not published")
+	}
+}
+
+// A message published while the connection is down is sent once the client reconnected
+func TestNATSSink_ReconnectsAndSendsBufferedMessages(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 2)
+	dropped := make(chan struct{})
+	go func() {
+		for i := 0; i < 2; i++ {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			reader := bufio.NewReader(conn)
+			if natsHandshake(conn, reader) {
+				received <- readNATSPub(conn, reader)
+			}
+			conn.Close()
+			if i == 0 {
+				close(dropped)
+			}
+		}
+	}()
+
+	sink, err := eventbus.NewNATSSink("nats://secret@"+listener.Addr().String(), "test")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx := context.Background()
+	require.NoError(t, sink.Send(ctx, "getmentor.first", []byte(`1`)))
+	select {
+	case msg := <-received:
+		assert.Equal(t, "PUB getmentor.first 1\r\n1\r\n", msg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("first message was not published")
+	}
+
+	// Give the client time to notice; core NATS loses a message written into the dying connection
+	<-dropped
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, sink.Send(ctx, "getmentor.second", []byte(`2`)))
+	select {
+	case msg := <-received:
+		assert.Equal(t, "PUB getmentor.second 1\r\n2\r\n", msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not sent after reconnecting")
+	}
+}
+
+// A server requiring TLS gets a TLS handshake after its INFO, also for a nats:// URL
+func TestNATSSink_UsesTLSWhenServerRequiresIt(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	firstByte := make(chan byte, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test","tls_required":true,"max_payload":1048576}` + "\r\n"))
+		buf := make([]byte, 1)
+		if _, err := conn.Read(buf); err == nil {
+			firstByte <- buf[0]
+		}
+	}()
+
+	sink, err := eventbus.NewNATSSink("nats://"+listener.Addr().String(), "test")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	go func() {
+		_ = sink.Ping(context.Background())
+	}()
+	select {
+	case b := <-firstByte:
+		assert.Equal(t, byte(0x16), b, "a TLS ClientHello record")
+	case <-time.After(2 * time.Second):
+		t.Fatal("client sent nothing")
+	}
+}
+
+func TestNewNATSSink_ValidatesURL(t *testing.T) {
+	_, err := eventbus.NewNATSSink("", "test")
+	assert.Error(t, err)
+	_, err = eventbus.NewNATSSink("http://localhost:4222", "test")
+	assert.Error(t, err)
+	_, err = eventbus.NewNATSSink("nats://", "test")
+	assert.Error(t, err)
+
+	_, err = eventbus.NewNATSSink("tls://token@nats-1:4222,tls://token@nats-2:4222", "test")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,62 @@
+package feed_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testFeed = feed.Feed{
+		ID:      "https://getmentor.dev/api/v1/mentors/new",
+		Title:   "New mentors",
+		Link:    "https://getmentor.dev",
+		Updated: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	testItems = []feed.Item{{
+		ID:        "https://getmentor.dev/mentor/ivan",
+		Title:     "Ivan — Backend <Go> & Postgres",
+		Link:      "https://getmentor.dev/mentor/ivan",
+		Published: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}}
+)
+
+func TestRSS(t *testing.T) {
+	data, err := feed.RSS(testFeed, testItems)
+	require.NoError(t, err)
+
+	var doc struct {
+		Items []struct {
+			Title   string `xml:"title"`
+			GUID    string `xml:"guid"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"channel>item"`
+	}
+	require.NoError(t, xml.Unmarshal(data, &doc))
+	require.Len(t, doc.Items, 1)
+	assert.Equal(t, testItems[0].Title, doc.Items[0].Title)
+	assert.Equal(t, testItems[0].ID, doc.Items[0].GUID)
+	assert.Equal(t, "Fri, 01 May 2026 12:00:00 +0000", doc.Items[0].PubDate)
+}
+
+func TestAtom(t *testing.T) {
+	data, err := feed.Atom(testFeed, testItems)
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		ID      string   `xml:"id"`
+		Entries []struct {
+			ID        string `xml:"id"`
+			Published string `xml:"published"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(data, &doc))
+	assert.Equal(t, testFeed.ID, doc.ID)
+	require.Len(t, doc.Entries, 1)
+	assert.Equal(t, "2026-05-01T12:00:00Z", doc.Entries[0].Published)
+}
@@ -0,0 +1,87 @@
+package ical_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBlockedAddress(t *testing.T) {
+	blocked := []string{
+		"10.0.0.1", "172.16.5.4", "192.168.1.1", "127.0.0.1", "169.254.169.254",
+		"100.64.0.1", "0.0.0.0", "::1", "fd00::1", "fe80::1", "fd00:ec2::254", "::ffff:10.0.0.1",
+	}
+	for _, addr := range blocked {
+		assert.True(t, ical.IsBlockedAddress(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{"8.8.8.8", "2a00:1450:4010:c05::71"} {
+		assert.False(t, ical.IsBlockedAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestGuardedClient_RefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"))
+	}))
+	defer server.Close()
+
+	_, err := ical.NewGuardedClient(time.Second).Get(server.URL)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ical.ErrBlockedAddress)
+}
+
+func TestGuardedClient_ChecksEveryRedirect(t *testing.T) {
+	// 127.0.0.2 stands in for an internal host: the filter only blocks it
+	blockSecondLoopback := func(addr netip.Addr) bool {
+		return addr == netip.MustParseAddr("127.0.0.2")
+	}
+	client := ical.NewGuardedClientWithFilter(time.Second, blockSecondLoopback)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			target := strings.Replace("http://"+r.Host+"/feed.ics", "127.0.0.1", "127.0.0.2", 1)
+			http.Redirect(w, r, target, http.StatusFound)
+			return
+		}
+		_, _ = w.Write([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"))
+	}))
+	defer server.Close()
+
+	resp, err := client.Get(server.URL + "/feed.ics")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "BEGIN:VCALENDAR")
+
+	_, err = client.Get(server.URL + "/redirect")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ical.ErrBlockedAddress)
+}
+
+func TestGuardedClient_RefusesOtherSchemes(t *testing.T) {
+	client := ical.NewGuardedClientWithFilter(time.Second, func(netip.Addr) bool { return false })
+
+	for _, rawURL := range []string{"file:///etc/passwd", "gopher://example.com/", "ftp://example.com/feed.ics"} {
+		_, err := client.Get(rawURL)
+		require.Error(t, err, rawURL)
+		assert.ErrorIs(t, err, ical.ErrUnsupportedScheme, rawURL)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	}))
+	defer server.Close()
+
+	_, err := client.Get(server.URL)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ical.ErrUnsupportedScheme)
+}
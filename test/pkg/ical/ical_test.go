@@ -0,0 +1,138 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	metrics.Init("test")
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+const sampleFeed = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:vacation-1\r\n" +
+	"SUMMARY:Отпуск\\, море\r\n" +
+	"DTSTART;VALUE=DATE:20260601\r\n" +
+	"DTEND;VALUE=DATE:20260615\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:meeting-1\r\n" +
+	"SUMMARY:Long meeting title that is\r\n" +
+	" folded\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20260602T100000\r\n" +
+	"DTEND;TZID=Europe/Moscow:20260602T110000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cancelled-1\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"DTSTART:20260603T100000Z\r\n" +
+	"DTEND:20260603T110000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	events, err := ical.Parse(strings.NewReader(sampleFeed), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 2, "cancelled events must be skipped")
+
+	vacation := events[0]
+	assert.Equal(t, "vacation-1", vacation.UID)
+	assert.Equal(t, "Отпуск, море", vacation.Summary)
+	assert.True(t, vacation.AllDay)
+	assert.Equal(t, 14*24*time.Hour, vacation.Duration())
+
+	meeting := events[1]
+	assert.Equal(t, "Long meeting title that isfolded", meeting.Summary)
+	assert.False(t, meeting.AllDay)
+	assert.Equal(t, time.Date(2026, 6, 2, 7, 0, 0, 0, time.UTC), meeting.Start.UTC())
+	assert.Equal(t, time.Hour, meeting.Duration())
+}
+
+// An event with a date that doesn't parse is skipped and counted; the events around it are kept
+func TestParse_SkipsEventWithInvalidDate(t *testing.T) {
+	feed := "BEGIN:VCALENDAR\n" +
+		"BEGIN:VEVENT\nUID:before\nDTSTART:20260602T100000Z\nDTEND:20260602T110000Z\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:broken-start\nDTSTART:not-a-date\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:broken-exdate\nDTSTART:20260603T100000Z\nRRULE:FREQ=DAILY\nEXDATE:20260604T100000Z,junk\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:after\nDTSTART:20260605T100000Z\nDTEND:2026-06-05\nDTEND:20260605T110000Z\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:last\nDTSTART;VALUE=DATE:20260610\nEND:VEVENT\n" +
+		"END:VCALENDAR\n"
+	skippedStart := testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("DTSTART"))
+	skippedExDate := testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("EXDATE"))
+	skippedEnd := testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("DTEND"))
+
+	events, err := ical.Parse(strings.NewReader(feed), nil)
+	require.NoError(t, err)
+
+	uids := make([]string, len(events))
+	for i, event := range events {
+		uids[i] = event.UID
+	}
+	assert.Equal(t, []string{"before", "last"}, uids)
+	assert.Equal(t, skippedStart+1, testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("DTSTART")))
+	assert.Equal(t, skippedExDate+1, testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("EXDATE")))
+	assert.Equal(t, skippedEnd+1, testutil.ToFloat64(metrics.CalendarEventsSkipped.WithLabelValues("DTEND")))
+}
+
+func TestIsFeedURL(t *testing.T) {
+	assert.True(t, ical.IsFeedURL("https://calendar.google.com/calendar/ical/abc/basic.ics"))
+	assert.True(t, ical.IsFeedURL("webcal://example.com/feed"))
+	assert.False(t, ical.IsFeedURL("https://calendly.com/johndoe"))
+	assert.False(t, ical.IsFeedURL(""))
+	assert.Equal(t, "https://example.com/feed", ical.FetchURL("webcal://example.com/feed"))
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	start := time.Date(2026, 6, 2, 16, 0, 0, 0, time.UTC)
+	title := "Воркшоп по системному дизайну; часть 1, вводная — длинное название для переноса строк"
+
+	data := ical.Encode("-//GetMentor//Programs//RU", ical.Invite{
+		UID:     "program-1@getmentor.dev",
+		Summary: title,
+		Start:   start,
+		End:     start.Add(90 * time.Minute),
+		Stamp:   start.Add(-24 * time.Hour),
+		Status:  "CONFIRMED",
+	})
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, "line %q is not folded", line)
+	}
+
+	events, err := ical.Parse(strings.NewReader(string(data)), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "program-1@getmentor.dev", events[0].UID)
+	assert.Equal(t, title, events[0].Summary)
+	assert.True(t, start.Equal(events[0].Start))
+	assert.Equal(t, 90*time.Minute, events[0].Duration())
+}
+
+func TestEncode_Location(t *testing.T) {
+	start := time.Date(2026, 7, 10, 16, 0, 0, 0, time.UTC)
+	data := ical.Encode("-//GetMentor//Community Events//RU", ical.Invite{
+		UID:      "event-1@getmentor.dev",
+		Summary:  "Митап",
+		Location: "Москва, Тверская 1",
+		Start:    start,
+		End:      start.Add(time.Hour),
+		Stamp:    start,
+	})
+
+	assert.Contains(t, string(data), "LOCATION:Москва\\, Тверская 1\r\n")
+}
@@ -0,0 +1,104 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func feed(events ...string) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\n")
+	for _, event := range events {
+		b.WriteString("BEGIN:VEVENT\r\n" + event + "END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+func expand(t *testing.T, data string, from, to time.Time) []ical.Event {
+	t.Helper()
+	events, err := ical.Parse(strings.NewReader(data), time.UTC)
+	require.NoError(t, err)
+	return ical.Expand(events, from, to)
+}
+
+func starts(events []ical.Event) []string {
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		result = append(result, event.Start.UTC().Format("2006-01-02T15:04"))
+	}
+	return result
+}
+
+func TestExpand_WeeklyByDayWithCount(t *testing.T) {
+	// Monday 2026-06-01; Monday, Wednesday and Friday for 5 occurrences
+	data := feed("UID:standup\r\n" +
+		"DTSTART:20260601T090000Z\r\nDTEND:20260601T093000Z\r\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5\r\n")
+
+	events := expand(t, data, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, []string{
+		"2026-06-01T09:00", "2026-06-03T09:00", "2026-06-05T09:00", "2026-06-08T09:00", "2026-06-10T09:00",
+	}, starts(events))
+	assert.Equal(t, 30*time.Minute, events[4].Duration())
+	assert.Nil(t, events[0].Recurrence)
+}
+
+func TestExpand_DailyUntilAndExDate(t *testing.T) {
+	data := feed("UID:daily\r\n" +
+		"DTSTART:20260601T120000Z\r\nDTEND:20260601T130000Z\r\n" +
+		"RRULE:FREQ=DAILY;UNTIL=20260605T120000Z\r\n" +
+		"EXDATE:20260602T120000Z,20260604T120000Z\r\n")
+
+	events := expand(t, data, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, []string{"2026-06-01T12:00", "2026-06-03T12:00", "2026-06-05T12:00"}, starts(events))
+}
+
+func TestExpand_RecurrenceIDOverride(t *testing.T) {
+	data := feed(
+		"UID:weekly\r\nDTSTART:20260601T100000Z\r\nDTEND:20260601T110000Z\r\nRRULE:FREQ=WEEKLY;COUNT=3\r\n",
+		"UID:weekly\r\nRECURRENCE-ID:20260608T100000Z\r\nDTSTART:20260609T150000Z\r\nDTEND:20260609T160000Z\r\n",
+	)
+
+	events := expand(t, data, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, []string{"2026-06-01T10:00", "2026-06-09T15:00", "2026-06-15T10:00"}, starts(events))
+}
+
+func TestExpand_MonthlySkipsMissingDays(t *testing.T) {
+	data := feed("UID:monthly\r\nDTSTART:20260131T080000Z\r\nDTEND:20260131T090000Z\r\nRRULE:FREQ=MONTHLY;COUNT=4\r\n")
+
+	events := expand(t, data, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// February, April and June have no 31st
+	assert.Equal(t, []string{"2026-01-31T08:00", "2026-03-31T08:00", "2026-05-31T08:00", "2026-07-31T08:00"}, starts(events))
+}
+
+func TestExpand_KeepsOnlyTheWindow(t *testing.T) {
+	data := feed(
+		"UID:forever\r\nDTSTART;TZID=Europe/Moscow:20200106T190000\r\nDTEND;TZID=Europe/Moscow:20200106T200000\r\n"+
+			"RRULE:FREQ=WEEKLY;INTERVAL=2\r\n",
+		"UID:single\r\nDTSTART:20260610T100000Z\r\nDTEND:20260610T110000Z\r\n",
+		"UID:past\r\nDTSTART:20250610T100000Z\r\nDTEND:20250610T110000Z\r\n",
+	)
+
+	events := expand(t, data, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC))
+
+	// Every other Monday from 2020-01-06, 19:00 Moscow time
+	assert.Equal(t, []string{"2026-06-01T16:00", "2026-06-10T10:00"}, starts(events))
+}
+
+func TestParse_UnsupportedRuleKeepsFirstOccurrence(t *testing.T) {
+	data := feed("UID:odd\r\nDTSTART:20260601T100000Z\r\nDTEND:20260601T110000Z\r\nRRULE:FREQ=MONTHLY;BYDAY=2TU\r\n")
+
+	events := expand(t, data, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, []string{"2026-06-01T10:00"}, starts(events))
+}
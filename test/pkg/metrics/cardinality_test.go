@@ -0,0 +1,39 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelGuard_FoldsValuesBeyondLimit(t *testing.T) {
+	guard := metrics.NewLabelGuard("test_metric", "route", 2)
+
+	assert.Equal(t, "/a", guard.Value("/a"))
+	assert.Equal(t, "/b", guard.Value("/b"))
+	assert.Equal(t, metrics.OtherLabelValue, guard.Value("/c"))
+	assert.Equal(t, metrics.OtherLabelValue, guard.Value("/d"))
+
+	// Values seen before the cap keep their own series
+	assert.Equal(t, "/a", guard.Value("/a"))
+}
+
+func TestRouteLabel(t *testing.T) {
+	assert.Equal(t, metrics.UnmatchedRoute, metrics.RouteLabel(""))
+	assert.Equal(t, "/api/v1/mentors/:slug", metrics.RouteLabel("/api/v1/mentors/:slug"))
+}
+
+func TestMethodLabel(t *testing.T) {
+	assert.Equal(t, "GET", metrics.MethodLabel("GET"))
+	assert.Equal(t, metrics.OtherLabelValue, metrics.MethodLabel("PROPFIND"))
+	assert.Equal(t, metrics.OtherLabelValue, metrics.MethodLabel("get"))
+}
+
+func TestKnownLabels(t *testing.T) {
+	known := metrics.NewKnownLabels("tools/list", "tools/call")
+
+	assert.Equal(t, "tools/list", known.Value("tools/list"))
+	assert.Equal(t, metrics.OtherLabelValue, known.Value("tools/delete"))
+	assert.Equal(t, metrics.OtherLabelValue, known.Value(""))
+}
@@ -0,0 +1,60 @@
+package metrics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func observe(t *testing.T, ctx context.Context) *dto.Histogram {
+	t.Helper()
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_duration_seconds", Buckets: []float64{1}})
+
+	metrics.ObserveWithTrace(ctx, histogram, 0.5)
+
+	var m dto.Metric
+	require.NoError(t, histogram.Write(&m))
+	return m.GetHistogram()
+}
+
+func spanContext(t *testing.T, flags trace.TraceFlags) trace.SpanContext {
+	t.Helper()
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	return trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: flags})
+}
+
+func TestObserveWithTrace_AttachesTraceIDForSampledSpan(t *testing.T) {
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext(t, trace.FlagsSampled))
+
+	h := observe(t, ctx)
+
+	assert.Equal(t, uint64(1), h.GetSampleCount())
+	exemplar := h.GetBucket()[0].GetExemplar()
+	require.NotNil(t, exemplar)
+	require.Len(t, exemplar.GetLabel(), 1)
+	assert.Equal(t, "trace_id", exemplar.GetLabel()[0].GetName())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", exemplar.GetLabel()[0].GetValue())
+}
+
+func TestObserveWithTrace_NoExemplarWithoutSampledSpan(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"no span":        context.Background(),
+		"unsampled span": trace.ContextWithSpanContext(context.Background(), spanContext(t, 0)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			h := observe(t, ctx)
+
+			assert.Equal(t, uint64(1), h.GetSampleCount())
+			assert.Nil(t, h.GetBucket()[0].GetExemplar())
+		})
+	}
+}
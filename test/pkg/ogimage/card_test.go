@@ -0,0 +1,68 @@
+package ogimage_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/ogimage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeCard(t *testing.T, data []byte) image.Image {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	return img
+}
+
+func TestRender_Size(t *testing.T) {
+	data, err := ogimage.Render(ogimage.Card{
+		Name:   "Анна Иванова",
+		Title:  "Staff Engineer @ Example",
+		Tags:   []string{"Backend", "Карьера", "System Design"},
+		Footer: "getmentor.dev",
+	})
+	require.NoError(t, err)
+
+	img := decodeCard(t, data)
+	assert.Equal(t, ogimage.Width, img.Bounds().Dx())
+	assert.Equal(t, ogimage.Height, img.Bounds().Dy())
+}
+
+func TestRender_DrawsPhotoInsideCircle(t *testing.T) {
+	photo := image.NewRGBA(image.Rect(0, 0, 800, 600))
+	for x := 0; x < 800; x++ {
+		for y := 0; y < 600; y++ {
+			photo.Set(x, y, color.RGBA{R: 0x00, G: 0x80, B: 0xff, A: 0xff})
+		}
+	}
+
+	data, err := ogimage.Render(ogimage.Card{Name: "Ivan Petrov", Photo: photo})
+	require.NoError(t, err)
+
+	img := decodeCard(t, data)
+	// Center of the photo circle is the photo; its bounding box corner is background
+	r, g, b, _ := img.At(72+180, ogimage.Height/2).RGBA()
+	assert.Equal(t, [3]uint32{0x00, 0x80, 0xff}, [3]uint32{r >> 8, g >> 8, b >> 8})
+	r, g, b, _ = img.At(72+2, (ogimage.Height-360)/2+2).RGBA()
+	assert.NotEqual(t, [3]uint32{0x00, 0x80, 0xff}, [3]uint32{r >> 8, g >> 8, b >> 8})
+}
+
+func TestRender_LongTextAndManyTags(t *testing.T) {
+	_, err := ogimage.Render(ogimage.Card{
+		Name:  strings.Repeat("Константинопольский ", 10),
+		Title: strings.Repeat("Principal Engineer ", 20),
+		Tags:  []string{"A", "Very long tag name that does not fit on a single pill row at all", "B", "C", "D", "E", "F", "G"},
+	})
+	require.NoError(t, err)
+}
+
+func TestRender_EmptyCard(t *testing.T) {
+	_, err := ogimage.Render(ogimage.Card{})
+	require.NoError(t, err)
+}
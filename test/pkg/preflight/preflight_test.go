@@ -0,0 +1,91 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ok(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestRun_StrictnessDecidesWhetherFailureBlocks(t *testing.T) {
+	skippedRan := false
+	results, err := preflight.Run(context.Background(), time.Second, []preflight.Check{
+		{Name: "postgres", Strictness: preflight.StrictnessFail, Run: ok},
+		{Name: "object_storage", Strictness: preflight.StrictnessWarn, Run: failing},
+		{Name: "warehouse", Strictness: preflight.StrictnessWarn, Run: func(context.Context) error {
+			return preflight.ErrDisabled
+		}},
+		{Name: "event_bus", Strictness: preflight.StrictnessSkip, Run: func(context.Context) error {
+			skippedRan = true
+			return nil
+		}},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, skippedRan)
+	require.Len(t, results, 4)
+	assert.Equal(t, preflight.StatusOK, results[0].Status)
+	assert.Equal(t, preflight.StatusFailed, results[1].Status)
+	assert.Equal(t, "connection refused", results[1].Detail)
+	assert.Equal(t, preflight.StatusDisabled, results[2].Status)
+	assert.Equal(t, preflight.StatusSkipped, results[3].Status)
+}
+
+func TestRun_FailStrictnessReturnsError(t *testing.T) {
+	results, err := preflight.Run(context.Background(), time.Second, []preflight.Check{
+		{Name: "object_storage", Strictness: preflight.StrictnessFail, Run: failing},
+		{Name: "warehouse", Strictness: preflight.StrictnessFail, Run: func(context.Context) error {
+			return preflight.ErrDisabled
+		}},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "object_storage: connection refused")
+	assert.NotContains(t, err.Error(), "warehouse")
+	assert.True(t, results[0].Blocking())
+	assert.False(t, results[1].Blocking())
+}
+
+func TestRun_AppliesTimeout(t *testing.T) {
+	results, err := preflight.Run(context.Background(), 10*time.Millisecond, []preflight.Check{
+		{Name: "event_bus", Strictness: preflight.StrictnessWarn, Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, preflight.StatusFailed, results[0].Status)
+}
+
+func TestParseStrictness(t *testing.T) {
+	strictness, err := preflight.ParseStrictness("", preflight.StrictnessWarn)
+	require.NoError(t, err)
+	assert.Equal(t, preflight.StrictnessWarn, strictness)
+
+	strictness, err = preflight.ParseStrictness(" FAIL ", preflight.StrictnessWarn)
+	require.NoError(t, err)
+	assert.Equal(t, preflight.StrictnessFail, strictness)
+
+	_, err = preflight.ParseStrictness("ignore", preflight.StrictnessWarn)
+	assert.Error(t, err)
+}
+
+func TestTable(t *testing.T) {
+	table := preflight.Table([]preflight.Result{
+		{Name: "postgres", Strictness: preflight.StrictnessFail, Status: preflight.StatusOK, Duration: 3 * time.Millisecond},
+		{Name: "warehouse", Strictness: preflight.StrictnessWarn, Status: preflight.StatusDisabled, Detail: "disabled in config"},
+	})
+
+	assert.Contains(t, table, "DEPENDENCY")
+	assert.Regexp(t, `postgres\s+fail\s+ok\s+3ms`, table)
+	assert.Regexp(t, `warehouse\s+warn\s+disabled\s+-\s+disabled in config`, table)
+}
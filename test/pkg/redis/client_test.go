@@ -0,0 +1,130 @@
+package redis_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient_RejectsInvalidURLs(t *testing.T) {
+	for _, rawURL := range []string{"", "http://cache:6379", "redis://cache:6379/x", "redis://cache:6379?unknown=1"} {
+		_, err := redis.NewClient(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
+
+func TestNewClient_TLSForRedissURLs(t *testing.T) {
+	client, err := redis.NewClient("rediss://:secret@cache.example.com:6380/1")
+	require.NoError(t, err)
+	defer client.Close()
+	assert.True(t, client.TLS())
+
+	client, err = redis.NewClient("redis://cache.example.com:6379")
+	require.NoError(t, err)
+	defer client.Close()
+	assert.False(t, client.TLS())
+}
+
+// readCommand reads one RESP array of bulk strings
+func readCommand(reader *bufio.Reader) []string {
+	header, err := reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(header, "*") {
+		return nil
+	}
+	count, _ := strconv.Atoi(strings.TrimSpace(header[1:]))
+	args := make([]string, count)
+	for i := range args {
+		sizeLine, _ := reader.ReadString('\n')
+		size, _ := strconv.Atoi(strings.TrimSpace(sizeLine[1:]))
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil
+		}
+		args[i] = string(buf[:size])
+	}
+	return args
+}
+
+func TestClient_AuthSelectAndCommands(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	commands := make(chan []string, 16)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+
+		replies := map[string]string{
+			"AUTH":   "+OK\r\n",
+			"SELECT": "+OK\r\n",
+			"GET":    "$5\r\nhello\r\n",
+			"MGET":   "*2\r\n$1\r\na\r\n$-1\r\n",
+			"DEL":    ":1\r\n",
+		}
+		for {
+			args := readCommand(reader)
+			if args == nil {
+				return
+			}
+			if strings.EqualFold(args[0], "HELLO") || strings.EqualFold(args[0], "CLIENT") {
+				// An older server: go-redis falls back to AUTH and skips the optional handshake
+				_, _ = conn.Write([]byte("-ERR unknown command\r\n"))
+				continue
+			}
+			commands <- args
+			reply, ok := replies[strings.ToUpper(args[0])]
+			if !ok {
+				reply = "-ERR unknown command\r\n"
+			}
+			_, _ = conn.Write([]byte(reply))
+		}
+	}()
+
+	client, err := redis.NewClient("redis://:secret@" + listener.Addr().String() + "/2")
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	value, found, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "hello", string(value))
+
+	values, err := client.MGet(ctx, "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), nil}, values)
+
+	deleted, err := client.Del(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	assert.Equal(t, []string{"auth", "secret"}, lower(<-commands))
+	assert.Equal(t, []string{"select", "2"}, lower(<-commands))
+	assert.Equal(t, []string{"get", "key"}, lower(<-commands))
+	assert.Equal(t, []string{"mget", "a", "b"}, lower(<-commands))
+	assert.Equal(t, []string{"del", "a"}, lower(<-commands))
+}
+
+// lower lowercases the command name, which go-redis sends in lower case
+func lower(args []string) []string {
+	if len(args) > 0 {
+		args[0] = strings.ToLower(args[0])
+	}
+	return args
+}
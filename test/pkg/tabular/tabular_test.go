@@ -0,0 +1,50 @@
+package tabular_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/tabular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name string   `xml:"name"`
+	Tags []string `xml:"tags>tag"`
+}
+
+func TestWriteXML(t *testing.T) {
+	items := []testItem{{Name: "Ivan <Go> & SQL", Tags: []string{"Go"}}, {Name: "Anna"}}
+
+	var buf bytes.Buffer
+	err := tabular.WriteXML(&buf, "mentors", "mentor", len(items), func(i int) interface{} { return items[i] })
+	require.NoError(t, err)
+
+	var decoded struct {
+		XMLName xml.Name   `xml:"mentors"`
+		Mentors []testItem `xml:"mentor"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "Ivan <Go> & SQL", decoded.Mentors[0].Name)
+	assert.Equal(t, []string{"Go"}, decoded.Mentors[0].Tags)
+	assert.Len(t, decoded.Mentors, 2)
+}
+
+func TestWriteCSVEscapesFormulas(t *testing.T) {
+	rows := [][]string{{"Ivan", "Go, SQL"}, {"=HYPERLINK(\"x\")", "-1"}}
+
+	var buf bytes.Buffer
+	err := tabular.WriteCSV(&buf, []string{"name", "tags"}, len(rows), func(i int) []string { return rows[i] })
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"name", "tags"},
+		{"Ivan", "Go, SQL"},
+		{"'=HYPERLINK(\"x\")", "'-1"},
+	}, records)
+}
@@ -0,0 +1,92 @@
+package trigger_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/trigger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+type channelSink chan trigger.DeadLetter
+
+func (s channelSink) SaveDeadLetter(_ context.Context, letter trigger.DeadLetter) error {
+	s <- letter
+	return nil
+}
+
+func TestCallAsyncWithPayload_RetriesUntilSuccess(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := make(channelSink, 1)
+	trigger.Configure(trigger.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}, sink)
+	defer trigger.Configure(trigger.DefaultRetryPolicy, nil)
+
+	trigger.CallAsyncWithPayload(server.URL+"/api/fn?code=secret", map[string]string{"type": "test"}, httpclient.NewStandardClient())
+
+	assert.Eventually(t, func() bool { return calls.Load() == 3 }, time.Second, 5*time.Millisecond)
+	assert.Empty(t, sink)
+}
+
+func TestCallAsync_DeadLettersPermanentFailure(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sink := make(channelSink, 1)
+	trigger.Configure(trigger.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}, sink)
+	defer trigger.Configure(trigger.DefaultRetryPolicy, nil)
+
+	trigger.CallAsync(server.URL+"/api/fn?code=secret&record_id=", "rec-1", httpclient.NewStandardClient())
+
+	select {
+	case letter := <-sink:
+		assert.Equal(t, "rec-1", letter.Delivery.RecordID)
+		assert.Equal(t, http.MethodGet, letter.Delivery.Method())
+		assert.NotContains(t, letter.Delivery.Destination(), "secret")
+		require.Len(t, letter.Attempts, 1)
+		assert.Equal(t, http.StatusBadRequest, letter.Attempts[0].StatusCode)
+	case <-time.After(time.Second):
+		t.Fatal("dead letter was not saved")
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := trigger.RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for attempt := 1; attempt <= 5; attempt++ {
+		delay := policy.Backoff(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+	assert.True(t, trigger.IsRetryable(0))
+	assert.True(t, trigger.IsRetryable(http.StatusServiceUnavailable))
+	assert.True(t, trigger.IsRetryable(http.StatusTooManyRequests))
+	assert.False(t, trigger.IsRetryable(http.StatusNotFound))
+}
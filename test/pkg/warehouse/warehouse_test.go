@@ -0,0 +1,66 @@
+package warehouse_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getmentor/getmentor-api/pkg/httpclient"
+	"github.com/getmentor/getmentor-api/pkg/warehouse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher_NormalizesAndHidesValue(t *testing.T) {
+	hasher := warehouse.NewHasher("0123456789abcdef")
+
+	hash := hasher.Hash(" Mentee@Example.com ")
+	assert.Equal(t, hash, hasher.Hash("mentee@example.com"))
+	assert.Len(t, hash, 64)
+	assert.NotContains(t, hash, "mentee")
+	assert.Empty(t, hasher.Hash("  "))
+
+	assert.NotEqual(t, hash, warehouse.NewHasher("another-salt-value").Hash("mentee@example.com"))
+}
+
+func TestClickHouse_InsertSendsJSONEachRow(t *testing.T) {
+	var query, user, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		user = r.Header.Get("X-ClickHouse-User")
+		raw, _ := io.ReadAll(r.Body)
+		body = string(raw)
+	}))
+	defer server.Close()
+
+	client, err := warehouse.NewClickHouse(server.URL, "analytics", "exporter", "secret", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	rows := []interface{}{
+		map[string]string{"request_id": "a"},
+		map[string]string{"request_id": "b"},
+	}
+	require.NoError(t, client.Insert(context.Background(), "request_facts", rows))
+
+	assert.Equal(t, "INSERT INTO `request_facts` FORMAT JSONEachRow", query)
+	assert.Equal(t, "exporter", user)
+	assert.Equal(t, []string{`{"request_id":"a"}`, `{"request_id":"b"}`}, strings.Split(strings.TrimSpace(body), "\n"))
+}
+
+func TestClickHouse_ReportsServerErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("Code: 60. DB::Exception: Table does not exist"))
+	}))
+	defer server.Close()
+
+	client, err := warehouse.NewClickHouse(server.URL, "", "", "", httpclient.NewStandardClient())
+	require.NoError(t, err)
+
+	err = client.Exec(context.Background(), "SELECT 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Table does not exist")
+}
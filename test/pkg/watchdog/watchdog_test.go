@@ -0,0 +1,98 @@
+package watchdog_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getmentor/getmentor-api/pkg/logger"
+	"github.com/getmentor/getmentor-api/pkg/watchdog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	_ = logger.Initialize(logger.Config{
+		Level:       "info",
+		Environment: "test",
+		ServiceName: "getmentor-api-test",
+	})
+}
+
+type fakeUploader struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (u *fakeUploader) UploadPrivateObject(_ context.Context, key, _ string, _ []byte) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.keys = append(u.keys, key)
+	return nil
+}
+
+func TestWatchdog_CapturesProfilesWhenThresholdExceeded(t *testing.T) {
+	dir := t.TempDir()
+	uploader := &fakeUploader{}
+	w := watchdog.New(watchdog.Config{
+		GoroutineThreshold: 1,
+		Cooldown:           time.Hour,
+		DumpDir:            dir,
+		KeepDumps:          10,
+	}, uploader, "api-1")
+
+	paths := w.Check(context.Background())
+
+	require.Len(t, paths, 2)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Positive(t, info.Size())
+		assert.Equal(t, dir, filepath.Dir(path))
+	}
+	require.Len(t, uploader.keys, 2)
+	assert.Equal(t, "diagnostics/api-1/"+filepath.Base(paths[0]), uploader.keys[0])
+
+	// The cooldown suppresses a second capture
+	assert.Empty(t, w.Check(context.Background()))
+}
+
+func TestWatchdog_NoCaptureBelowThreshold(t *testing.T) {
+	dir := t.TempDir()
+	w := watchdog.New(watchdog.Config{
+		GoroutineThreshold: 1 << 30,
+		DumpDir:            dir,
+		KeepDumps:          10,
+	}, nil, "api-1")
+
+	assert.Empty(t, w.Check(context.Background()))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestWatchdog_PrunesOldDumps(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"heap-20250101T000000Z.pb.gz", "goroutine-20250101T000000Z.pb.gz", "app.log"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o600))
+	}
+	w := watchdog.New(watchdog.Config{
+		GoroutineThreshold: 1,
+		DumpDir:            dir,
+		KeepDumps:          2,
+	}, nil, "api-1")
+
+	paths := w.Check(context.Background())
+	require.Len(t, paths, 2)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{filepath.Base(paths[0]), filepath.Base(paths[1]), "app.log"}, names)
+}